@@ -8,12 +8,14 @@ import (
 	"crypto/rand"
 	"fmt"
 	"net"
+	"net/http"
 	"os"
 	"path/filepath"
 
 	base58 "github.com/jbenet/go-base58"
 	"github.com/minio/cli"
 	minio "github.com/minio/minio/cmd"
+	"github.com/minio/minio/pkg/auth"
 	"github.com/spf13/cobra"
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
@@ -31,8 +33,9 @@ import (
 type GatewayFlags struct {
 	NonInteractive bool `help:"disable interactive mode" default:"false" setup:"true"`
 
-	Server miniogw.ServerConfig
-	Minio  miniogw.MinioConfig
+	Server      miniogw.ServerConfig
+	Minio       miniogw.MinioConfig
+	MultiTenant miniogw.MultiTenantConfig
 
 	uplink.Config
 }
@@ -132,8 +135,12 @@ func cmdRun(cmd *cobra.Command, args []string) (err error) {
 
 	fmt.Printf("Starting Storj S3-compatible gateway!\n\n")
 	fmt.Printf("Endpoint: %s\n", address)
-	fmt.Printf("Access key: %s\n", runCfg.Minio.AccessKey)
-	fmt.Printf("Secret key: %s\n", runCfg.Minio.SecretKey)
+	if runCfg.MultiTenant.Enabled {
+		fmt.Printf("Credentials: %s\n", runCfg.MultiTenant.CredentialsPath)
+	} else {
+		fmt.Printf("Access key: %s\n", runCfg.Minio.AccessKey)
+		fmt.Printf("Secret key: %s\n", runCfg.Minio.SecretKey)
+	}
 
 	ctx := process.Ctx(cmd)
 
@@ -160,6 +167,11 @@ func generateKey() (key string, err error) {
 }
 
 func checkCfg(ctx context.Context) (err error) {
+	if runCfg.MultiTenant.Enabled {
+		_, err = miniogw.LoadCredentials(runCfg.MultiTenant.CredentialsPath)
+		return err
+	}
+
 	proj, err := runCfg.openProject(ctx)
 	if err != nil {
 		return err
@@ -172,11 +184,28 @@ func checkCfg(ctx context.Context) (err error) {
 
 // Run starts a Minio Gateway given proper config
 func (flags GatewayFlags) Run(ctx context.Context) (err error) {
+	gw, err := flags.NewGateway(ctx)
+	if err != nil {
+		return err
+	}
+
+	address := flags.Server.Address
+	credentials := auth.Credentials{
+		AccessKey: flags.Minio.AccessKey,
+		SecretKey: flags.Minio.SecretKey,
+	}
+	if tenants, ok := gw.(*miniogw.MultiTenantGateway); ok {
+		address, credentials, err = flags.serveTenants(tenants)
+		if err != nil {
+			return err
+		}
+	}
+
 	err = minio.RegisterGatewayCommand(cli.Command{
 		Name:  "storj",
 		Usage: "Storj",
 		Action: func(cliCtx *cli.Context) error {
-			return flags.action(ctx, cliCtx)
+			return flags.action(ctx, cliCtx, gw)
 		},
 		HideHelpCommand: true,
 	})
@@ -185,32 +214,92 @@ func (flags GatewayFlags) Run(ctx context.Context) (err error) {
 	}
 
 	// TODO(jt): Surely there is a better way. This is so upsetting
-	err = os.Setenv("MINIO_ACCESS_KEY", flags.Minio.AccessKey)
+	err = os.Setenv("MINIO_ACCESS_KEY", credentials.AccessKey)
 	if err != nil {
 		return err
 	}
-	err = os.Setenv("MINIO_SECRET_KEY", flags.Minio.SecretKey)
+	err = os.Setenv("MINIO_SECRET_KEY", credentials.SecretKey)
 	if err != nil {
 		return err
 	}
 
 	minio.Main([]string{"storj", "gateway", "storj",
-		"--address", flags.Server.Address, "--config-dir", flags.Minio.Dir, "--quiet"})
+		"--address", address, "--config-dir", flags.Minio.Dir, "--quiet"})
 	return errs.New("unexpected minio exit")
 }
 
-func (flags GatewayFlags) action(ctx context.Context, cliCtx *cli.Context) (err error) {
-	gw, err := flags.NewGateway(ctx)
+// serveTenants serves the proxy of the multi-tenant gateway on the server address.
+// Minio listens on a loopback address behind it, with credentials only the proxy knows.
+func (flags GatewayFlags) serveTenants(gateway *miniogw.MultiTenantGateway) (minioAddress string, internal auth.Credentials, err error) {
+	internal.AccessKey, err = generateKey()
 	if err != nil {
-		return err
+		return "", internal, err
+	}
+	internal.SecretKey, err = generateKey()
+	if err != nil {
+		return "", internal, err
+	}
+
+	// the web browser of minio would sign in with the internal credentials
+	err = os.Setenv("MINIO_BROWSER", "off")
+	if err != nil {
+		return "", internal, err
+	}
+
+	// minio opens its own listener, so only a free port is picked for it
+	minioListener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return "", internal, err
+	}
+	minioAddress = minioListener.Addr().String()
+	err = minioListener.Close()
+	if err != nil {
+		return "", internal, err
+	}
+
+	proxy, err := miniogw.NewMultiTenantProxy(zap.L(), gateway, "http://"+minioAddress, internal)
+	if err != nil {
+		return "", internal, err
+	}
+
+	listener, err := net.Listen("tcp", flags.Server.Address)
+	if err != nil {
+		return "", internal, err
 	}
 
+	go func() {
+		err := (&http.Server{Handler: proxy}).Serve(listener)
+		zap.L().Fatal("multi-tenant proxy stopped", zap.Error(err))
+	}()
+
+	return minioAddress, internal, nil
+}
+
+func (flags GatewayFlags) action(ctx context.Context, cliCtx *cli.Context, gw minio.Gateway) (err error) {
 	minio.StartGateway(cliCtx, miniogw.Logging(gw, zap.L()))
 	return errs.New("unexpected minio exit")
 }
 
 // NewGateway creates a new minio Gateway
 func (flags GatewayFlags) NewGateway(ctx context.Context) (gw minio.Gateway, err error) {
+	if flags.MultiTenant.Enabled {
+		uplk, err := flags.newUplink(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		return miniogw.NewMultiTenantGateway(
+			zap.L(),
+			uplk,
+			miniogw.NewFileCredentials(flags.MultiTenant.CredentialsPath),
+			flags.MultiTenant.CacheSize,
+			storj.Cipher(flags.Enc.PathType).ToCipherSuite(),
+			flags.GetEncryptionScheme().ToEncryptionParameters(),
+			flags.GetRedundancyScheme(),
+			flags.Client.SegmentSize,
+		), nil
+	}
+
 	encKey, err := uplink.UseOrLoadEncryptionKey(flags.Enc.EncryptionKey, flags.Enc.KeyFilepath)
 	if err != nil {
 		return nil, err
@@ -232,17 +321,6 @@ func (flags GatewayFlags) NewGateway(ctx context.Context) (gw minio.Gateway, err
 }
 
 func (flags GatewayFlags) openProject(ctx context.Context) (*libuplink.Project, error) {
-	cfg := libuplink.Config{}
-	cfg.Volatile.TLS = struct {
-		SkipPeerCAWhitelist bool
-		PeerCAWhitelistPath string
-	}{
-		SkipPeerCAWhitelist: !flags.TLS.UsePeerCAWhitelist,
-		PeerCAWhitelistPath: flags.TLS.PeerCAWhitelistPath,
-	}
-	cfg.Volatile.MaxInlineSize = flags.Client.MaxInlineSize
-	cfg.Volatile.MaxMemory = flags.RS.MaxBufferMem
-
 	apiKey, err := libuplink.ParseAPIKey(flags.Client.APIKey)
 	if err != nil {
 		return nil, err
@@ -256,7 +334,7 @@ func (flags GatewayFlags) openProject(ctx context.Context) (*libuplink.Project,
 	var opts libuplink.ProjectOptions
 	opts.Volatile.EncryptionKey = encKey
 
-	uplk, err := libuplink.NewUplink(ctx, &cfg)
+	uplk, err := flags.newUplink(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -264,6 +342,21 @@ func (flags GatewayFlags) openProject(ctx context.Context) (*libuplink.Project,
 	return uplk.OpenProject(ctx, flags.Client.SatelliteAddr, apiKey, &opts)
 }
 
+func (flags GatewayFlags) newUplink(ctx context.Context) (*libuplink.Uplink, error) {
+	cfg := libuplink.Config{}
+	cfg.Volatile.TLS = struct {
+		SkipPeerCAWhitelist bool
+		PeerCAWhitelistPath string
+	}{
+		SkipPeerCAWhitelist: !flags.TLS.UsePeerCAWhitelist,
+		PeerCAWhitelistPath: flags.TLS.PeerCAWhitelistPath,
+	}
+	cfg.Volatile.MaxInlineSize = flags.Client.MaxInlineSize
+	cfg.Volatile.MaxMemory = flags.RS.MaxBufferMem
+
+	return libuplink.NewUplink(ctx, &cfg)
+}
+
 func (flags GatewayFlags) interactive(cmd *cobra.Command, setupDir string, overrides map[string]interface{}) error {
 	satelliteAddress, err := cfgstruct.PromptForSatelitte(cmd)
 	if err != nil {
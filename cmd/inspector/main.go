@@ -14,13 +14,16 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/gogo/protobuf/jsonpb"
 	"github.com/gogo/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
 	prompt "github.com/segmentio/go-prompt"
 	"github.com/spf13/cobra"
 	"github.com/zeebo/errs"
 
+	"storj.io/storj/internal/memory"
 	"storj.io/storj/pkg/eestream"
 	"storj.io/storj/pkg/identity"
 	"storj.io/storj/pkg/kademlia/routinggraph"
@@ -71,6 +74,14 @@ var (
 		Use:   "health",
 		Short: "commands for querying health of a stored data",
 	}
+	projectCmd = &cobra.Command{
+		Use:   "project",
+		Short: "commands for project usage limits",
+	}
+	shardsCmd = &cobra.Command{
+		Use:   "shards",
+		Short: "commands for pointer database shards",
+	}
 	irreparableCmd = &cobra.Command{
 		Use:   "irreparable",
 		Short: "list segments in irreparable database",
@@ -135,6 +146,18 @@ var (
 		Args:  cobra.MinimumNArgs(1),
 		RunE:  CreateCSVStats,
 	}
+	disqualifyCmd = &cobra.Command{
+		Use:   "disqualify <node_id>",
+		Short: "Disqualify a node regardless of its reputation",
+		Args:  cobra.MinimumNArgs(1),
+		RunE:  Disqualify,
+	}
+	reinstateCmd = &cobra.Command{
+		Use:   "reinstate <node_id>",
+		Short: "Clear the disqualification of a node",
+		Args:  cobra.MinimumNArgs(1),
+		RunE:  Reinstate,
+	}
 	objectHealthCmd = &cobra.Command{
 		Use:   "object <project-id> <bucket> <encrypted-path>",
 		Short: "Get stats about an object's health",
@@ -147,6 +170,24 @@ var (
 		Args:  cobra.MinimumNArgs(4),
 		RunE:  SegmentHealth,
 	}
+	projectLimitsCmd = &cobra.Command{
+		Use:   "limits <project-id>",
+		Short: "Get the usage limits and current usage of a project",
+		Args:  cobra.MinimumNArgs(1),
+		RunE:  GetProjectUsageLimits,
+	}
+	setProjectLimitsCmd = &cobra.Command{
+		Use:   "set-limits <project-id> <storage-limit> <egress-limit>",
+		Short: "Set the usage limits of a project, 0 resets a limit to the satellite default",
+		Args:  cobra.MinimumNArgs(3),
+		RunE:  SetProjectUsageLimits,
+	}
+	moveProjectCmd = &cobra.Command{
+		Use:   "move <project-id> <shard>",
+		Short: "Move the pointers of a project to another shard, while the satellite keeps serving them",
+		Args:  cobra.MinimumNArgs(2),
+		RunE:  MoveProject,
+	}
 )
 
 // Inspector gives access to kademlia, overlay cache
@@ -156,6 +197,8 @@ type Inspector struct {
 	overlayclient pb.OverlayInspectorClient
 	irrdbclient   pb.IrreparableInspectorClient
 	healthclient  pb.HealthInspectorClient
+	projectclient pb.ProjectInspectorClient
+	shardclient   pb.ShardInspectorClient
 }
 
 // NewInspector creates a new gRPC inspector client for access to kad,
@@ -182,6 +225,8 @@ func NewInspector(address, path string) (*Inspector, error) {
 		overlayclient: pb.NewOverlayInspectorClient(conn),
 		irrdbclient:   pb.NewIrreparableInspectorClient(conn),
 		healthclient:  pb.NewHealthInspectorClient(conn),
+		projectclient: pb.NewProjectInspectorClient(conn),
+		shardclient:   pb.NewShardInspectorClient(conn),
 	}, nil
 }
 
@@ -354,6 +399,13 @@ func GetStats(cmd *cobra.Command, args []string) (err error) {
 	fmt.Printf("Stats for ID %s:\n", nodeID)
 	fmt.Printf("AuditSuccessRatio: %f, AuditCount: %d, UptimeRatio: %f, UptimeCount: %d,\n",
 		res.AuditRatio, res.AuditCount, res.UptimeRatio, res.UptimeCount)
+	if res.Disqualified != nil {
+		disqualified, err := ptypes.Timestamp(res.Disqualified)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Disqualified: %s\n", disqualified.Format(time.RFC3339))
+	}
 	return nil
 }
 
@@ -437,6 +489,43 @@ func CreateStats(cmd *cobra.Command, args []string) (err error) {
 	return nil
 }
 
+// Disqualify disqualifies a node regardless of its reputation
+func Disqualify(cmd *cobra.Command, args []string) (err error) {
+	return setDisqualified(args[0], true)
+}
+
+// Reinstate clears the disqualification of a node
+func Reinstate(cmd *cobra.Command, args []string) (err error) {
+	return setDisqualified(args[0], false)
+}
+
+func setDisqualified(id string, disqualified bool) error {
+	i, err := NewInspector(*Addr, *IdentityPath)
+	if err != nil {
+		return ErrInspectorDial.Wrap(err)
+	}
+
+	nodeID, err := storj.NodeIDFromString(id)
+	if err != nil {
+		return err
+	}
+
+	_, err = i.overlayclient.SetDisqualified(context.Background(), &pb.SetDisqualifiedRequest{
+		NodeId:       nodeID,
+		Disqualified: disqualified,
+	})
+	if err != nil {
+		return ErrRequest.Wrap(err)
+	}
+
+	if disqualified {
+		fmt.Printf("Disqualified node %s\n", nodeID)
+	} else {
+		fmt.Printf("Reinstated node %s\n", nodeID)
+	}
+	return nil
+}
+
 // CreateCSVStats creates node with stats in overlay based on a CSV
 func CreateCSVStats(cmd *cobra.Command, args []string) (err error) {
 	i, err := NewInspector(*Addr, *IdentityPath)
@@ -628,6 +717,80 @@ func SegmentHealth(cmd *cobra.Command, args []string) (err error) {
 	return nil
 }
 
+// GetProjectUsageLimits gets the usage limits and current usage of a project
+func GetProjectUsageLimits(cmd *cobra.Command, args []string) (err error) {
+	ctx := context.Background()
+
+	i, err := NewInspector(*Addr, *IdentityPath)
+	if err != nil {
+		return ErrInspectorDial.Wrap(err)
+	}
+
+	resp, err := i.projectclient.GetProjectUsageLimits(ctx, &pb.GetProjectUsageLimitsRequest{
+		ProjectId: []byte(args[0]),
+	})
+	if err != nil {
+		return ErrRequest.Wrap(err)
+	}
+
+	fmt.Printf("Storage: %s used of %s limit (custom limit: %s)\n",
+		memory.Size(resp.StorageUsed), memory.Size(resp.EffectiveStorageLimit), memory.Size(resp.StorageLimit))
+	fmt.Printf("Egress: %s used of %s limit (custom limit: %s)\n",
+		memory.Size(resp.EgressUsed), memory.Size(resp.EffectiveEgressLimit), memory.Size(resp.EgressLimit))
+	return nil
+}
+
+// SetProjectUsageLimits sets the storage and egress limits of a project
+func SetProjectUsageLimits(cmd *cobra.Command, args []string) (err error) {
+	ctx := context.Background()
+
+	var storageLimit, egressLimit memory.Size
+	if err := storageLimit.Set(args[1]); err != nil {
+		return ErrArgs.Wrap(err)
+	}
+	if err := egressLimit.Set(args[2]); err != nil {
+		return ErrArgs.Wrap(err)
+	}
+
+	i, err := NewInspector(*Addr, *IdentityPath)
+	if err != nil {
+		return ErrInspectorDial.Wrap(err)
+	}
+
+	_, err = i.projectclient.SetProjectUsageLimits(ctx, &pb.SetProjectUsageLimitsRequest{
+		ProjectId:    []byte(args[0]),
+		StorageLimit: storageLimit.Int64(),
+		EgressLimit:  egressLimit.Int64(),
+	})
+	if err != nil {
+		return ErrRequest.Wrap(err)
+	}
+
+	fmt.Printf("Set usage limits of project %s to %s storage and %s egress\n", args[0], storageLimit, egressLimit)
+	return nil
+}
+
+// MoveProject moves the pointers of a project to another shard
+func MoveProject(cmd *cobra.Command, args []string) (err error) {
+	ctx := context.Background()
+
+	i, err := NewInspector(*Addr, *IdentityPath)
+	if err != nil {
+		return ErrInspectorDial.Wrap(err)
+	}
+
+	_, err = i.shardclient.MoveProject(ctx, &pb.MoveProjectRequest{
+		ProjectId: []byte(args[0]),
+		Shard:     args[1],
+	})
+	if err != nil {
+		return ErrRequest.Wrap(err)
+	}
+
+	fmt.Printf("Moved project %s to shard %s\n", args[0], args[1])
+	return nil
+}
+
 func csvOutput() (*os.File, error) {
 	if CSVPath == "stdout" {
 		return os.Stdout, nil
@@ -752,6 +915,8 @@ func init() {
 	rootCmd.AddCommand(statsCmd)
 	rootCmd.AddCommand(irreparableCmd)
 	rootCmd.AddCommand(healthCmd)
+	rootCmd.AddCommand(projectCmd)
+	rootCmd.AddCommand(shardsCmd)
 
 	kadCmd.AddCommand(countNodeCmd)
 	kadCmd.AddCommand(pingNodeCmd)
@@ -764,10 +929,17 @@ func init() {
 	statsCmd.AddCommand(getCSVStatsCmd)
 	statsCmd.AddCommand(createStatsCmd)
 	statsCmd.AddCommand(createCSVStatsCmd)
+	statsCmd.AddCommand(disqualifyCmd)
+	statsCmd.AddCommand(reinstateCmd)
 
 	healthCmd.AddCommand(objectHealthCmd)
 	healthCmd.AddCommand(segmentHealthCmd)
 
+	projectCmd.AddCommand(projectLimitsCmd)
+	projectCmd.AddCommand(setProjectLimitsCmd)
+
+	shardsCmd.AddCommand(moveProjectCmd)
+
 	objectHealthCmd.Flags().StringVar(&CSVPath, "csv-path", "stdout", "csv path where command output is written")
 
 	irreparableCmd.Flags().Int32Var(&irreparableLimit, "limit", 50, "max number of results per page")
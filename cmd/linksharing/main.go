@@ -0,0 +1,110 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/internal/fpath"
+	libuplink "storj.io/storj/lib/uplink"
+	"storj.io/storj/linksharing"
+	"storj.io/storj/pkg/cfgstruct"
+	"storj.io/storj/pkg/process"
+)
+
+// LinkSharing is the configuration of the link sharing service
+type LinkSharing struct {
+	linksharing.Config
+
+	TLS struct {
+		UsePeerCAWhitelist  bool   `help:"whether to use the peer CA whitelist" default:"true"`
+		PeerCAWhitelistPath string `help:"path to the CA cert whitelist (peer identities must be signed by one these to be verified). this will override the default peer whitelist"`
+	}
+}
+
+var (
+	rootCmd = &cobra.Command{
+		Use:   "linksharing",
+		Short: "HTTP service serving the objects shared with links",
+	}
+	runCmd = &cobra.Command{
+		Use:   "run",
+		Short: "Run the link sharing service",
+		RunE:  cmdRun,
+	}
+	setupCmd = &cobra.Command{
+		Use:         "setup",
+		Short:       "Create config files",
+		RunE:        cmdSetup,
+		Annotations: map[string]string{"type": "setup"},
+	}
+
+	runCfg   LinkSharing
+	setupCfg LinkSharing
+
+	confDir string
+)
+
+func init() {
+	defaultConfDir := fpath.ApplicationDir("storj", "linksharing")
+	cfgstruct.SetupFlag(zap.L(), rootCmd, &confDir, "config-dir", defaultConfDir, "main directory for link sharing configuration")
+	defaults := cfgstruct.DefaultsFlag(rootCmd)
+	rootCmd.AddCommand(runCmd)
+	rootCmd.AddCommand(setupCmd)
+	cfgstruct.Bind(runCmd.Flags(), &runCfg, defaults, cfgstruct.ConfDir(confDir))
+	cfgstruct.BindSetup(setupCmd.Flags(), &setupCfg, defaults, cfgstruct.ConfDir(confDir))
+}
+
+func cmdRun(cmd *cobra.Command, args []string) (err error) {
+	ctx := process.Ctx(cmd)
+	log := zap.L()
+
+	var cfg libuplink.Config
+	cfg.Volatile.TLS.SkipPeerCAWhitelist = !runCfg.TLS.UsePeerCAWhitelist
+	cfg.Volatile.TLS.PeerCAWhitelistPath = runCfg.TLS.PeerCAWhitelistPath
+
+	uplink, err := libuplink.NewUplink(ctx, &cfg)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errs.Combine(err, uplink.Close()) }()
+
+	peer, err := linksharing.New(log, uplink, runCfg.Config)
+	if err != nil {
+		return err
+	}
+
+	runError := peer.Run(ctx)
+	closeError := peer.Close()
+	return errs.Combine(runError, closeError)
+}
+
+func cmdSetup(cmd *cobra.Command, args []string) (err error) {
+	setupDir, err := filepath.Abs(confDir)
+	if err != nil {
+		return err
+	}
+
+	valid, _ := fpath.IsValidSetupDir(setupDir)
+	if !valid {
+		return fmt.Errorf("link sharing configuration already exists (%v)", setupDir)
+	}
+
+	err = os.MkdirAll(setupDir, 0700)
+	if err != nil {
+		return err
+	}
+
+	return process.SaveConfigWithAllDefaults(cmd.Flags(), filepath.Join(setupDir, "config.yaml"), nil)
+}
+
+func main() {
+	process.Exec(rootCmd)
+}
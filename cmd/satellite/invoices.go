@@ -0,0 +1,52 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/satellite/billing"
+	"storj.io/storj/satellite/satellitedb"
+)
+
+// generateInvoices creates the missing project invoices for the month containing period and exports all of them
+func generateInvoices(ctx context.Context, period time.Time, output io.Writer) (err error) {
+	db, err := satellitedb.New(zap.L().Named("db"), invoicesCfg.Database)
+	if err != nil {
+		return errs.New("error connecting to master database on satellite: %+v", err)
+	}
+	defer func() {
+		err = errs.Combine(err, db.Close())
+	}()
+
+	service := billing.NewService(zap.L().Named("billing"), invoicesCfg.Billing, db.Console())
+	invoices, err := service.GenerateInvoices(ctx, period)
+	if err != nil {
+		return err
+	}
+
+	switch invoicesCfg.Format {
+	case "csv":
+		err = billing.WriteCSV(output, invoices)
+	case "json":
+		err = billing.WriteJSON(output, invoices)
+	default:
+		return errs.New("unknown output format %q", invoicesCfg.Format)
+	}
+	if err != nil {
+		return err
+	}
+
+	if output != os.Stdout {
+		fmt.Println("Generated project invoices report")
+	}
+	return nil
+}
@@ -17,9 +17,11 @@ import (
 
 	"storj.io/storj/internal/fpath"
 	"storj.io/storj/internal/version"
+	"storj.io/storj/pkg/accounting/payout"
 	"storj.io/storj/pkg/cfgstruct"
 	"storj.io/storj/pkg/process"
 	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/billing"
 	"storj.io/storj/satellite/satellitedb"
 )
 
@@ -67,6 +69,26 @@ var (
 		Args:  cobra.MinimumNArgs(2),
 		RunE:  cmdNodeUsage,
 	}
+	invoicesCmd = &cobra.Command{
+		Use:   "project-invoices [month]",
+		Short: "Generate the project invoices for a given month and export them for billing",
+		Long:  "Generate the missing project invoices for a given month and export all invoices of that month for billing. Format the month using YYYY-MM",
+		Args:  cobra.ExactArgs(1),
+		RunE:  cmdInvoices,
+	}
+	payoutsCmd = &cobra.Command{
+		Use:   "payouts [month]",
+		Short: "Calculate the storage node payouts for a given month",
+		Long:  "Calculate and store the missing storage node payout statements for a given month and export all statements of that month. Format the month using YYYY-MM",
+		Args:  cobra.ExactArgs(1),
+		RunE:  cmdPayouts,
+	}
+	migrateSegmentsCmd = &cobra.Command{
+		Use:   "migrate-segments",
+		Short: "Store the metadata of all existing pointers in the segments database",
+		Long:  "Store the metadata of all existing pointers in the satellite database, where it can be queried by node, expiration or bucket. The metadata of segments without a pointer is removed. The migration can be run again after it was interrupted or to repair the metadata",
+		RunE:  cmdMigrateSegments,
+	}
 
 	runCfg   Satellite
 	setupCfg Satellite
@@ -82,6 +104,22 @@ var (
 		Database string `help:"satellite database connection string" releaseDefault:"postgres://" devDefault:"sqlite3://$CONFDIR/master.db"`
 		Output   string `help:"destination of report output" default:""`
 	}
+	invoicesCfg struct {
+		Database string `help:"satellite database connection string" releaseDefault:"postgres://" devDefault:"sqlite3://$CONFDIR/master.db"`
+		Output   string `help:"destination of report output" default:""`
+		Format   string `help:"format of report output (csv or json)" default:"csv"`
+		Billing  billing.Config
+	}
+	payoutsCfg struct {
+		Database string `help:"satellite database connection string" releaseDefault:"postgres://" devDefault:"sqlite3://$CONFDIR/master.db"`
+		Output   string `help:"destination of report output" default:""`
+		Format   string `help:"format of report output (csv or json)" default:"csv"`
+		Payouts  payout.Config
+	}
+	migrateSegmentsCfg struct {
+		Database     string `help:"satellite database connection string" releaseDefault:"postgres://" devDefault:"sqlite3://$CONFDIR/master.db"`
+		PointerDBURL string `help:"the pointer database connection string" releaseDefault:"postgres://" devDefault:"bolt://$CONFDIR/pointerdb.db"`
+	}
 	confDir     string
 	identityDir string
 )
@@ -97,12 +135,18 @@ func init() {
 	rootCmd.AddCommand(diagCmd)
 	rootCmd.AddCommand(qdiagCmd)
 	rootCmd.AddCommand(reportsCmd)
+	rootCmd.AddCommand(payoutsCmd)
+	rootCmd.AddCommand(migrateSegmentsCmd)
 	reportsCmd.AddCommand(nodeUsageCmd)
+	reportsCmd.AddCommand(invoicesCmd)
 	cfgstruct.Bind(runCmd.Flags(), &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	cfgstruct.BindSetup(setupCmd.Flags(), &setupCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	cfgstruct.Bind(diagCmd.Flags(), &diagCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	cfgstruct.Bind(qdiagCmd.Flags(), &qdiagCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	cfgstruct.Bind(nodeUsageCmd.Flags(), &nodeUsageCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+	cfgstruct.Bind(invoicesCmd.Flags(), &invoicesCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+	cfgstruct.Bind(payoutsCmd.Flags(), &payoutsCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+	cfgstruct.Bind(migrateSegmentsCmd.Flags(), &migrateSegmentsCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 }
 
 func cmdRun(cmd *cobra.Command, args []string) (err error) {
@@ -272,6 +316,58 @@ func cmdNodeUsage(cmd *cobra.Command, args []string) (err error) {
 	return generateCSV(ctx, start, end, file)
 }
 
+func cmdInvoices(cmd *cobra.Command, args []string) (err error) {
+	ctx := process.Ctx(cmd)
+
+	period, err := time.Parse("2006-01", args[0])
+	if err != nil {
+		return errs.New("Invalid month format. Please use YYYY-MM")
+	}
+
+	// send output to stdout
+	if invoicesCfg.Output == "" {
+		return generateInvoices(ctx, period, os.Stdout)
+	}
+
+	// send output to file
+	file, err := os.Create(invoicesCfg.Output)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		err = errs.Combine(err, file.Close())
+	}()
+
+	return generateInvoices(ctx, period, file)
+}
+
+func cmdPayouts(cmd *cobra.Command, args []string) (err error) {
+	ctx := process.Ctx(cmd)
+
+	period, err := time.Parse("2006-01", args[0])
+	if err != nil {
+		return errs.New("Invalid month format. Please use YYYY-MM")
+	}
+
+	// send output to stdout
+	if payoutsCfg.Output == "" {
+		return generatePayouts(ctx, period, os.Stdout)
+	}
+
+	// send output to file
+	file, err := os.Create(payoutsCfg.Output)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		err = errs.Combine(err, file.Close())
+	}()
+
+	return generatePayouts(ctx, period, file)
+}
+
 func main() {
 	process.Exec(rootCmd)
 }
@@ -0,0 +1,52 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/pkg/accounting/payout"
+	"storj.io/storj/satellite/satellitedb"
+)
+
+// generatePayouts creates the missing payout statements for the month containing period and exports all of them
+func generatePayouts(ctx context.Context, period time.Time, output io.Writer) (err error) {
+	db, err := satellitedb.New(zap.L().Named("db"), payoutsCfg.Database)
+	if err != nil {
+		return errs.New("error connecting to master database on satellite: %+v", err)
+	}
+	defer func() {
+		err = errs.Combine(err, db.Close())
+	}()
+
+	service := payout.NewService(zap.L().Named("payouts"), payoutsCfg.Payouts, db.StoragenodeAccounting(), db.OverlayCache(), db.Payouts())
+	statements, err := service.GenerateStatements(ctx, period)
+	if err != nil {
+		return err
+	}
+
+	switch payoutsCfg.Format {
+	case "csv":
+		err = payout.WriteCSV(output, statements)
+	case "json":
+		err = payout.WriteJSON(output, statements)
+	default:
+		return errs.New("unknown output format %q", payoutsCfg.Format)
+	}
+	if err != nil {
+		return err
+	}
+
+	if output != os.Stdout {
+		fmt.Println("Generated storage node payout statements")
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/pkg/process"
+	"storj.io/storj/satellite/metainfo"
+	"storj.io/storj/satellite/satellitedb"
+)
+
+// cmdMigrateSegments stores the metadata of all pointers in the segments database
+func cmdMigrateSegments(cmd *cobra.Command, args []string) (err error) {
+	ctx := process.Ctx(cmd)
+	log := zap.L()
+
+	db, err := satellitedb.New(log.Named("db"), migrateSegmentsCfg.Database)
+	if err != nil {
+		return errs.New("error connecting to master database on satellite: %+v", err)
+	}
+	defer func() {
+		err = errs.Combine(err, db.Close())
+	}()
+
+	pointers, err := metainfo.NewStore(log.Named("metainfo:store"), migrateSegmentsCfg.PointerDBURL)
+	if err != nil {
+		return errs.New("error connecting to pointer database on satellite: %+v", err)
+	}
+	defer func() {
+		err = errs.Combine(err, pointers.Close())
+	}()
+
+	count, err := metainfo.MigrateSegments(ctx, log.Named("migrate-segments"), pointers, db.Segments())
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Stored the metadata of %d segments\n", count)
+	return nil
+}
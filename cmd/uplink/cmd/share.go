@@ -13,8 +13,8 @@ import (
 
 	"storj.io/storj/internal/fpath"
 	libuplink "storj.io/storj/lib/uplink"
+	"storj.io/storj/linksharing"
 	"storj.io/storj/pkg/cfgstruct"
-	"storj.io/storj/pkg/encryption"
 	"storj.io/storj/pkg/macaroon"
 	"storj.io/storj/pkg/process"
 )
@@ -29,6 +29,8 @@ var shareCfg struct {
 	NotBefore         string   `help:"disallow access before this time"`
 	NotAfter          string   `help:"disallow access after this time"`
 	AllowedPathPrefix []string `help:"whitelist of bucket path prefixes to require"`
+	URL               bool     `default:"false" help:"if true, prints a link sharing url for the object of the single allowed path"`
+	BaseURL           string   `default:"http://localhost:8090" help:"url of the link sharing service"`
 }
 
 func init() {
@@ -79,9 +81,8 @@ func shareMain(cmd *cobra.Command, args []string) (err error) {
 		return err
 	}
 
-	// TODO(jeff): we have to have the server side of things expecting macaroons
-	// before we can change libuplink to use macaroons because of all the tests.
-	// For now, just use the raw macaroon library.
+	// TODO(jeff): the satellite validates macaroons now, so libuplink should
+	// learn how to restrict api keys. For now, just use the raw macaroon library.
 
 	key, err := macaroon.ParseAPIKey(cfg.Client.APIKey)
 	if err != nil {
@@ -107,6 +108,12 @@ func shareMain(cmd *cobra.Command, args []string) (err error) {
 		return err
 	}
 
+	if shareCfg.URL && len(shareCfg.AllowedPathPrefix) != 1 {
+		return errs.New("a link sharing url requires exactly one allowed path")
+	}
+
+	var link *linksharing.Link
+
 	cache := make(map[string]*libuplink.BucketConfig)
 
 	for _, path := range shareCfg.AllowedPathPrefix {
@@ -136,15 +143,26 @@ func shareMain(cmd *cobra.Command, args []string) (err error) {
 			cache[p.Bucket()] = bi
 		}
 
-		encPath, err := encryption.EncryptPath(path, bi.PathCipher.ToCipher(), &access.Key)
+		shared, err := access.ShareObject(p.Bucket(), p.Path(), bi.PathCipher)
 		if err != nil {
 			return err
 		}
 
 		caveat.AllowedPaths = append(caveat.AllowedPaths, &macaroon.Caveat_Path{
 			Bucket:              []byte(p.Bucket()),
-			EncryptedPathPrefix: []byte(encPath),
+			EncryptedPathPrefix: []byte(shared.EncryptedPathPrefix),
 		})
+
+		if shareCfg.URL {
+			if p.Path() == "" {
+				return errs.New("a link sharing url requires an object path: %q", path)
+			}
+			link = &linksharing.Link{
+				Bucket: p.Bucket(),
+				Path:   p.Path(),
+				Access: *shared,
+			}
+		}
 	}
 
 	{
@@ -170,5 +188,10 @@ func shareMain(cmd *cobra.Command, args []string) (err error) {
 	}
 
 	fmt.Println("new key:", key.Serialize())
+
+	if link != nil {
+		link.APIKey = key.Serialize()
+		fmt.Println("url:", link.URL(shareCfg.BaseURL))
+	}
 	return nil
 }
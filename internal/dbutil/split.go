@@ -15,8 +15,12 @@ func SplitConnstr(s string) (string, string, error) {
 	if len(parts) != 2 {
 		return "", "", fmt.Errorf("Could not parse DB URL %s", s)
 	}
-	if parts[0] == "postgres" {
+	switch parts[0] {
+	case "postgres":
 		parts[1] = s // postgres wants full URLS for its DSN
+	case "cockroach":
+		// cockroach speaks the postgres protocol, so it's opened with a postgres DSN
+		parts[1] = "postgres://" + parts[1]
 	}
 	return parts[0], parts[1], nil
 }
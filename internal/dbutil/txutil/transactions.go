@@ -0,0 +1,70 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package txutil
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/lib/pq"
+	"github.com/zeebo/errs"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+
+	"storj.io/storj/internal/errs2"
+)
+
+var mon = monkit.Package()
+
+// maxRetries is how many times a transaction is retried after a serialization failure
+const maxRetries = 10
+
+// WithTx runs fn in a transaction, which is committed when fn succeeds.
+//
+// The whole transaction is retried when the database reports a serialization
+// failure, which CockroachDB returns whenever concurrent transactions conflict.
+// fn must therefore be safe to run multiple times.
+func WithTx(ctx context.Context, db *sql.DB, opts *sql.TxOptions, fn func(context.Context, *sql.Tx) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return Retry(ctx, func() (err error) {
+		tx, err := db.BeginTx(ctx, opts)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if err == nil {
+				err = tx.Commit()
+			} else {
+				err = errs.Combine(err, tx.Rollback())
+			}
+		}()
+		return fn(ctx, tx)
+	})
+}
+
+// Retry runs fn, and runs it again as long as it fails with a serialization failure.
+func Retry(ctx context.Context, fn func() error) (err error) {
+	for retry := 0; ; retry++ {
+		err = fn()
+		if err == nil || retry >= maxRetries || !NeedsRetry(err) {
+			return err
+		}
+		mon.Meter("transaction_retries").Mark(1)
+
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return errs.Combine(err, ctxErr)
+		}
+	}
+}
+
+// NeedsRetry checks whether err is a serialization failure, after which
+// the transaction can be retried from the beginning.
+func NeedsRetry(err error) bool {
+	return errs2.IsFunc(err, func(err error) bool {
+		if e, ok := err.(*pq.Error); ok {
+			// serialization_failure, cockroach reports retryable errors with the same code
+			return e.Code == "40001"
+		}
+		return false
+	})
+}
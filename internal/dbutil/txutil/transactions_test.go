@@ -0,0 +1,79 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package txutil_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/internal/dbutil/txutil"
+)
+
+func TestNeedsRetry(t *testing.T) {
+	serializationFailure := &pq.Error{Code: "40001"}
+	wrapped := errs.Class("wrapped")
+
+	assert.True(t, txutil.NeedsRetry(serializationFailure))
+	assert.True(t, txutil.NeedsRetry(wrapped.Wrap(serializationFailure)))
+
+	assert.False(t, txutil.NeedsRetry(nil))
+	assert.False(t, txutil.NeedsRetry(errors.New("failure")))
+	assert.False(t, txutil.NeedsRetry(&pq.Error{Code: "23505"}))
+}
+
+func TestRetry(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("succeeds after serialization failures", func(t *testing.T) {
+		calls := 0
+		err := txutil.Retry(ctx, func() error {
+			calls++
+			if calls < 3 {
+				return &pq.Error{Code: "40001"}
+			}
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 3, calls)
+	})
+
+	t.Run("does not retry other errors", func(t *testing.T) {
+		calls := 0
+		failure := errors.New("failure")
+		err := txutil.Retry(ctx, func() error {
+			calls++
+			return failure
+		})
+		assert.Equal(t, failure, err)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("gives up eventually", func(t *testing.T) {
+		calls := 0
+		err := txutil.Retry(ctx, func() error {
+			calls++
+			return &pq.Error{Code: "40001"}
+		})
+		assert.True(t, txutil.NeedsRetry(err))
+		assert.True(t, calls > 1)
+	})
+
+	t.Run("stops when context is canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(ctx)
+		cancel()
+
+		calls := 0
+		err := txutil.Retry(ctx, func() error {
+			calls++
+			return &pq.Error{Code: "40001"}
+		})
+		assert.Error(t, err)
+		assert.Equal(t, 1, calls)
+	})
+}
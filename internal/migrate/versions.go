@@ -148,6 +148,52 @@ func (migration *Migration) Run(log *zap.Logger, db DB) error {
 	return nil
 }
 
+// RunOrCreate runs the migration steps on a database which already has a version.
+// An empty database is instead initialized with create and marked with the latest
+// version, which avoids replaying steps that the database may not support.
+func (migration *Migration) RunOrCreate(log *zap.Logger, db DB, create Action) error {
+	err := migration.ValidTableName()
+	if err != nil {
+		return err
+	}
+
+	err = migration.ensureVersionTable(log, db)
+	if err != nil {
+		return Error.New("creating version table failed: %v", err)
+	}
+
+	version, err := migration.getLatestVersion(log, db)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	if version < 0 && len(migration.Steps) > 0 {
+		latest := migration.Steps[len(migration.Steps)-1].Version
+		log.Info("Creating database", zap.Int("version", latest))
+
+		tx, err := db.Begin()
+		if err != nil {
+			return Error.Wrap(err)
+		}
+
+		err = create.Run(log, db, tx)
+		if err != nil {
+			return Error.Wrap(errs.Combine(err, tx.Rollback()))
+		}
+
+		err = migration.addVersion(tx, db, latest)
+		if err != nil {
+			return Error.Wrap(errs.Combine(err, tx.Rollback()))
+		}
+
+		if err := tx.Commit(); err != nil {
+			return Error.Wrap(err)
+		}
+	}
+
+	return migration.Run(log, db)
+}
+
 // createVersionTable creates a new version table
 func (migration *Migration) ensureVersionTable(log *zap.Logger, db DB) error {
 	tx, err := db.Begin()
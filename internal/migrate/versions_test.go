@@ -226,6 +226,86 @@ func failedMigration(t *testing.T, db *sql.DB, testDB migrate.DB) {
 	assert.Equal(t, false, version.Valid)
 }
 
+func TestRunOrCreateMigrationSqlite(t *testing.T) {
+	db, err := sql.Open("sqlite3", ":memory:")
+	require.NoError(t, err)
+	defer func() { assert.NoError(t, db.Close()) }()
+
+	runOrCreateMigration(t, db, &sqliteDB{DB: db})
+}
+
+func TestRunOrCreateMigrationPostgres(t *testing.T) {
+	if *pgtest.ConnStr == "" {
+		t.Skipf("postgres flag missing, example:\n-postgres-test-db=%s", pgtest.DefaultConnStr)
+	}
+
+	db, err := sql.Open("postgres", *pgtest.ConnStr)
+	require.NoError(t, err)
+	defer func() { assert.NoError(t, db.Close()) }()
+
+	runOrCreateMigration(t, db, &postgresDB{DB: db})
+}
+
+func runOrCreateMigration(t *testing.T, db *sql.DB, testDB migrate.DB) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	dbName := strings.ToLower(`versions_` + t.Name())
+	tableName := strings.ToLower(`users_` + t.Name())
+	defer func() { assert.NoError(t, dropTables(db, dbName, tableName)) }()
+
+	m := migrate.Migration{
+		Table: dbName,
+		Steps: []*migrate.Step{
+			{
+				Description: "Step 1",
+				Version:     1,
+				Action:      migrate.SQL{`CREATE TABLE ` + tableName + ` (id int)`},
+			},
+			{
+				Description: "Step 2",
+				Version:     2,
+				Action:      migrate.SQL{`INSERT INTO ` + tableName + ` (id) VALUES (2)`},
+			},
+		},
+	}
+
+	// the empty database is created at the latest version without running the steps
+	create := migrate.SQL{
+		`CREATE TABLE ` + tableName + ` (id int)`,
+		`INSERT INTO ` + tableName + ` (id) VALUES (1)`,
+	}
+	err := m.RunOrCreate(zap.NewNop(), testDB, create)
+	require.NoError(t, err)
+
+	var version, id int
+	err = db.QueryRow(`SELECT MAX(version) FROM ` + dbName).Scan(&version)
+	require.NoError(t, err)
+	assert.Equal(t, 2, version)
+
+	err = db.QueryRow(`SELECT MAX(id) FROM ` + tableName).Scan(&id)
+	require.NoError(t, err)
+	assert.Equal(t, 1, id)
+
+	// later steps are run as usual
+	m.Steps = append(m.Steps, &migrate.Step{
+		Description: "Step 3",
+		Version:     3,
+		Action:      migrate.SQL{`INSERT INTO ` + tableName + ` (id) VALUES (3)`},
+	})
+	err = m.RunOrCreate(zap.NewNop(), testDB, create)
+	require.NoError(t, err)
+
+	err = db.QueryRow(`SELECT MAX(version) FROM ` + dbName).Scan(&version)
+	require.NoError(t, err)
+	assert.Equal(t, 3, version)
+
+	var count int
+	err = db.QueryRow(`SELECT COUNT(*) FROM ` + tableName).Scan(&count)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+}
+
 func TestTargetVersion(t *testing.T) {
 	m := migrate.Migration{
 		Table: "test",
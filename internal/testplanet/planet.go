@@ -30,6 +30,7 @@ import (
 	"storj.io/storj/internal/memory"
 	"storj.io/storj/internal/testidentity"
 	"storj.io/storj/internal/version"
+	"storj.io/storj/pkg/accounting/payout"
 	"storj.io/storj/pkg/accounting/rollup"
 	"storj.io/storj/pkg/accounting/tally"
 	"storj.io/storj/pkg/audit"
@@ -46,10 +47,12 @@ import (
 	"storj.io/storj/pkg/server"
 	"storj.io/storj/pkg/storj"
 	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/billing"
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/console/consoleweb"
 	"storj.io/storj/satellite/mailservice"
 	"storj.io/storj/satellite/metainfo"
+	"storj.io/storj/satellite/notifications"
 	"storj.io/storj/satellite/satellitedb"
 	"storj.io/storj/storagenode"
 	"storj.io/storj/storagenode/collector"
@@ -455,6 +458,15 @@ func (planet *Planet) newSatellites(count int) ([]*satellite.Peer, error) {
 					NewNodePercentage: 0,
 					OnlineWindow:      time.Hour,
 					DistinctIP:        false,
+
+					AuditReputationLambda:  0.95,
+					AuditReputationWeight:  1,
+					AuditReputationAlpha0:  1,
+					AuditReputationBeta0:   0,
+					UptimeReputationLambda: 0.99,
+					UptimeReputationWeight: 1,
+					UptimeReputationAlpha0: 1,
+					UptimeReputationBeta0:  0,
 				},
 			},
 			Discovery: discovery.Config{
@@ -469,6 +481,9 @@ func (planet *Planet) newSatellites(count int) ([]*satellite.Peer, error) {
 				MaxInlineSegmentSize: 8000,
 				Overlay:              true,
 				BwExpiration:         45,
+				Reaper: metainfo.ReaperConfig{
+					Interval: 30 * time.Second,
+				},
 			},
 			BwAgreement: bwagreement.Config{},
 			Checker: checker.Config{
@@ -481,17 +496,34 @@ func (planet *Planet) newSatellites(count int) ([]*satellite.Peer, error) {
 				MaxBufferMem: 4 * memory.MiB,
 			},
 			Audit: audit.Config{
-				MaxRetriesStatDB:  0,
-				Interval:          30 * time.Second,
-				MinBytesPerSecond: 1 * memory.KB,
+				MaxRetriesStatDB:     0,
+				Interval:             30 * time.Second,
+				MinBytesPerSecond:    1 * memory.KB,
+				MaxReverifyCount:     3,
+				Workers:              2,
+				NodeAuditInterval:    time.Hour,
+				NewNodeAuditInterval: time.Minute,
 			},
 			Tally: tally.Config{
 				Interval: 30 * time.Second,
 			},
 			Rollup: rollup.Config{
-				Interval:      2 * time.Minute,
-				MaxAlphaUsage: 25 * memory.GB,
-				DeleteTallies: false,
+				Interval:            2 * time.Minute,
+				DefaultStorageLimit: 25 * memory.GB,
+				DefaultEgressLimit:  25 * memory.GB,
+				DeleteTallies:       false,
+			},
+			Billing: billing.Config{
+				Interval:     time.Hour,
+				StoragePrice: 1,
+				EgressPrice:  4.5,
+				ObjectPrice:  0.01,
+			},
+			Payouts: payout.Config{
+				AtRestPrice: 0.15,
+				EgressPrice: 2,
+				RepairPrice: 1,
+				AuditPrice:  1,
 			},
 			Mail: mailservice.Config{
 				SMTPServerAddress: "smtp.mail.example.com:587",
@@ -501,6 +533,14 @@ func (planet *Planet) newSatellites(count int) ([]*satellite.Peer, error) {
 			Console: consoleweb.Config{
 				Address:      "127.0.0.1:0",
 				PasswordCost: console.TestPasswordCost,
+				AuditLog: console.AuditLogConfig{
+					Retention: 90 * 24 * time.Hour,
+					Interval:  time.Hour,
+				},
+			},
+			Notifications: notifications.Config{
+				Interval:        time.Hour,
+				UsageThresholds: "80,100",
 			},
 			Version: planet.NewVersionConfig(),
 		}
@@ -8,12 +8,15 @@ import (
 	"io"
 	"time"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/zeebo/errs"
 
 	"storj.io/storj/pkg/metainfo/kvmetainfo"
+	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/storage/streams"
 	"storj.io/storj/pkg/storj"
 	"storj.io/storj/pkg/stream"
+	"storj.io/storj/storage"
 )
 
 // Bucket represents operations you can perform on a bucket
@@ -106,6 +109,57 @@ func (b *Bucket) UploadObject(ctx context.Context, path storj.Path, data io.Read
 	return errs.Combine(err, upload.Close())
 }
 
+// ConcatObjects creates a new object from the source objects in the bucket, in the given order, if authorized.
+// The data of the sources isn't downloaded and uploaded again, their segments are moved to the new object,
+// so the sources don't exist afterwards. Only ContentType and Metadata of opts are used.
+func (b *Bucket) ConcatObjects(ctx context.Context, path storj.Path, sources []storj.Path, opts *UploadOptions) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if opts == nil {
+		opts = &UploadOptions{}
+	}
+
+	metadata, err := proto.Marshal(&pb.SerializableMeta{
+		ContentType: opts.ContentType,
+		UserDefined: opts.Metadata,
+	})
+	if err != nil {
+		return err
+	}
+
+	fullSources := make([]storj.Path, len(sources))
+	for i, source := range sources {
+		fullSources[i] = storj.JoinPaths(b.Name, source)
+	}
+
+	_, err = b.streams.Concat(ctx, storj.JoinPaths(b.Name, path), b.bucket.PathCipher, fullSources, metadata)
+	return err
+}
+
+// UpdateObjectMetadata replaces the content type and the metadata of an object with the ones
+// of opts, if authorized. The data of the object isn't uploaded again.
+func (b *Bucket) UpdateObjectMetadata(ctx context.Context, path storj.Path, opts *UploadOptions) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if opts == nil {
+		opts = &UploadOptions{}
+	}
+
+	metadata, err := proto.Marshal(&pb.SerializableMeta{
+		ContentType: opts.ContentType,
+		UserDefined: opts.Metadata,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = b.streams.SetMetadata(ctx, storj.JoinPaths(b.Name, path), b.bucket.PathCipher, metadata)
+	if storage.ErrKeyNotFound.Has(err) {
+		return storj.ErrObjectNotFound.Wrap(err)
+	}
+	return err
+}
+
 // DeleteObject removes an object, if authorized.
 func (b *Bucket) DeleteObject(ctx context.Context, path storj.Path) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -4,6 +4,8 @@
 package uplink
 
 import (
+	"storj.io/storj/pkg/encryption"
+	"storj.io/storj/pkg/storage/streams"
 	"storj.io/storj/pkg/storj"
 )
 
@@ -21,3 +23,25 @@ type EncryptionAccess struct {
 	// necessary to have in order to derive further encryption keys.
 	EncryptedPathPrefix storj.Path
 }
+
+// ShareObject returns the EncryptionAccess of the Object at path in the
+// bucket, which opens the Object with Project.OpenSharedObject but can't
+// decrypt any other Object. a must hold the root encryption key.
+func (a *EncryptionAccess) ShareObject(bucketName string, path storj.Path, pathCipher storj.CipherSuite) (*EncryptionAccess, error) {
+	fullPath := storj.JoinPaths(bucketName, path)
+
+	encPath, err := streams.EncryptAfterBucket(fullPath, pathCipher.ToCipher(), &a.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	pathKey, err := encryption.DerivePathKey(fullPath, &a.Key, len(storj.SplitPath(fullPath)))
+	if err != nil {
+		return nil, err
+	}
+
+	return &EncryptionAccess{
+		Key:                 *pathKey,
+		EncryptedPathPrefix: storj.JoinPaths(storj.SplitPath(encPath)[1:]...),
+	}, nil
+}
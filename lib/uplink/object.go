@@ -10,6 +10,7 @@ import (
 
 	"storj.io/storj/internal/readcloser"
 	"storj.io/storj/pkg/metainfo/kvmetainfo"
+	"storj.io/storj/pkg/ranger"
 	"storj.io/storj/pkg/storage/streams"
 	"storj.io/storj/pkg/storj"
 	"storj.io/storj/pkg/stream"
@@ -76,11 +77,21 @@ type Object struct {
 
 	metainfoDB *kvmetainfo.DB
 	streams    streams.Store
+
+	// ranger is set for shared objects, which are opened without the root encryption key
+	ranger ranger.Ranger
 }
 
 // DownloadRange returns an Object's data. A length of -1 will mean
 // (Object.Size - offset).
 func (o *Object) DownloadRange(ctx context.Context, offset, length int64) (io.ReadCloser, error) {
+	if o.ranger != nil {
+		if length == -1 {
+			length = o.Meta.Size - offset
+		}
+		return o.ranger.Range(ctx, offset, length)
+	}
+
 	readOnlyStream, err := o.metainfoDB.GetObjectStream(ctx, o.Meta.Bucket, o.Meta.Path)
 	if err != nil {
 		return nil, err
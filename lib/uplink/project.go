@@ -6,18 +6,21 @@ package uplink
 import (
 	"context"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/vivint/infectious"
 
 	"storj.io/storj/internal/memory"
 	"storj.io/storj/pkg/eestream"
 	"storj.io/storj/pkg/encryption"
 	"storj.io/storj/pkg/metainfo/kvmetainfo"
+	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/storage/buckets"
 	ecclient "storj.io/storj/pkg/storage/ec"
 	"storj.io/storj/pkg/storage/segments"
 	"storj.io/storj/pkg/storage/streams"
 	"storj.io/storj/pkg/storj"
 	"storj.io/storj/pkg/transport"
+	"storj.io/storj/storage"
 	"storj.io/storj/uplink/metainfo"
 )
 
@@ -202,6 +205,52 @@ func (p *Project) OpenBucket(ctx context.Context, bucketName string, access *Enc
 	}, nil
 }
 
+// OpenSharedObject returns the Object at path in the bucket, which was shared
+// with access. The Key of access is the encryption key derived for the
+// unencrypted path of the Object, and its EncryptedPathPrefix is the
+// encrypted path of the Object. The root encryption key isn't needed.
+func (p *Project) OpenSharedObject(ctx context.Context, bucketName string, path storj.Path, access *EncryptionAccess) (o *Object, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if access == nil || access.Key == (storj.Key{}) {
+		return nil, Error.New("No encryption key chosen")
+	}
+	if access.EncryptedPathPrefix == "" {
+		return nil, Error.New("No encrypted path chosen")
+	}
+
+	// downloads take the redundancy of every segment from its pointer
+	ec := ecclient.NewClient(p.tc, p.uplinkCfg.Volatile.MaxMemory.Int())
+	segmentStore := segments.NewSegmentStore(p.metainfo, ec, eestream.RedundancyStrategy{}, p.maxInlineSize.Int(), 0)
+
+	rr, meta, err := streams.GetShared(ctx, segmentStore, bucketName, access.EncryptedPathPrefix, &access.Key)
+	if err != nil {
+		if storage.ErrKeyNotFound.Has(err) {
+			return nil, storj.ErrObjectNotFound.Wrap(err)
+		}
+		return nil, err
+	}
+
+	var serMeta pb.SerializableMeta
+	if err := proto.Unmarshal(meta.Data, &serMeta); err != nil {
+		return nil, err
+	}
+
+	return &Object{
+		Meta: ObjectMeta{
+			Bucket:      bucketName,
+			Path:        path,
+			ContentType: serMeta.ContentType,
+			Metadata:    serMeta.UserDefined,
+			Modified:    meta.Modified,
+			Expires:     meta.Expiration,
+			Size:        meta.Size,
+			Checksum:    meta.Checksum,
+		},
+		ranger: rr,
+	}, nil
+}
+
 // Close closes the Project.
 func (p *Project) Close() error {
 	return nil
@@ -0,0 +1,113 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package linksharing
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"path"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+
+	"storj.io/storj/lib/uplink"
+	"storj.io/storj/pkg/ranger"
+	"storj.io/storj/pkg/storj"
+)
+
+var (
+	mon = monkit.Package()
+
+	// Error is the error class of the link sharing service
+	Error = errs.Class("linksharing error")
+)
+
+// Handler serves the shared objects of a satellite over HTTP
+type Handler struct {
+	log       *zap.Logger
+	uplink    *uplink.Uplink
+	satellite string
+}
+
+// NewHandler creates a Handler, which downloads the shared objects from the satellite at satelliteAddr
+func NewHandler(log *zap.Logger, uplink *uplink.Uplink, satelliteAddr string) *Handler {
+	return &Handler{
+		log:       log,
+		uplink:    uplink,
+		satellite: satelliteAddr,
+	}
+}
+
+// ServeHTTP serves the shared object of the link in the request URL, supporting Range requests
+func (handler *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	defer mon.Task()(&ctx)(nil)
+
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	link, err := ParseLink(r.URL)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	err = handler.serveLink(ctx, w, r, link)
+	if err != nil {
+		switch {
+		case storj.ErrObjectNotFound.Has(err):
+			http.Error(w, "object not found", http.StatusNotFound)
+		default:
+			handler.log.Error("unable to serve link", zap.String("bucket", link.Bucket), zap.String("path", link.Path), zap.Error(err))
+			http.Error(w, "unable to serve link", http.StatusInternalServerError)
+		}
+	}
+}
+
+func (handler *Handler) serveLink(ctx context.Context, w http.ResponseWriter, r *http.Request, link *Link) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	apiKey, err := uplink.ParseAPIKey(link.APIKey)
+	if err != nil {
+		return err
+	}
+
+	project, err := handler.uplink.OpenProject(ctx, handler.satellite, apiKey, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errs.Combine(err, project.Close()) }()
+
+	object, err := project.OpenSharedObject(ctx, link.Bucket, link.Path, &link.Access)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errs.Combine(err, object.Close()) }()
+
+	if object.Meta.ContentType != "" {
+		w.Header().Set("Content-Type", object.Meta.ContentType)
+	}
+
+	ranger.ServeContent(ctx, w, r, path.Base(link.Path), object.Meta.Modified, &objectRanger{object: object})
+	return nil
+}
+
+// objectRanger downloads the ranges of an object
+type objectRanger struct {
+	object *uplink.Object
+}
+
+// Size returns the size of the object
+func (rr *objectRanger) Size() int64 {
+	return rr.object.Meta.Size
+}
+
+// Range downloads length bytes of the object from offset
+func (rr *objectRanger) Range(ctx context.Context, offset, length int64) (io.ReadCloser, error) {
+	return rr.object.DownloadRange(ctx, offset, length)
+}
@@ -0,0 +1,168 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package linksharing_test
+
+import (
+	"bytes"
+	"crypto/rand"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/lib/uplink"
+	"storj.io/storj/linksharing"
+	"storj.io/storj/pkg/macaroon"
+	"storj.io/storj/pkg/storj"
+)
+
+func TestHandler(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 5, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		apiKey := planet.Uplinks[0].APIKey[satellite.ID()]
+
+		var cfg uplink.Config
+		cfg.Volatile.TLS.SkipPeerCAWhitelist = true
+		up, err := uplink.NewUplink(ctx, &cfg)
+		require.NoError(t, err)
+		defer ctx.Check(up.Close)
+
+		var access uplink.EncryptionAccess
+		copy(access.Key[:], "linksharing")
+
+		key, err := uplink.ParseAPIKey(apiKey)
+		require.NoError(t, err)
+
+		var opts uplink.ProjectOptions
+		opts.Volatile.EncryptionKey = &access.Key
+		project, err := up.OpenProject(ctx, satellite.Addr(), key, &opts)
+		require.NoError(t, err)
+		defer ctx.Check(project.Close)
+
+		bucketConfig := uplink.BucketConfig{}
+		bucketConfig.Volatile.RedundancyScheme = storj.RedundancyScheme{
+			Algorithm:      storj.ReedSolomon,
+			ShareSize:      memory.KiB.Int32(),
+			RequiredShares: 2,
+			RepairShares:   3,
+			OptimalShares:  4,
+			TotalShares:    5,
+		}
+		_, err = project.CreateBucket(ctx, "bucket", &bucketConfig)
+		require.NoError(t, err)
+
+		bucket, err := project.OpenBucket(ctx, "bucket", &access)
+		require.NoError(t, err)
+		defer ctx.Check(bucket.Close)
+
+		data := make([]byte, 10*memory.KiB)
+		_, err = rand.Read(data)
+		require.NoError(t, err)
+
+		err = bucket.UploadObject(ctx, "dir/file.txt", bytes.NewReader(data), &uplink.UploadOptions{ContentType: "text/plain"})
+		require.NoError(t, err)
+
+		shared, err := access.ShareObject("bucket", "dir/file.txt", bucket.PathCipher)
+		require.NoError(t, err)
+
+		// the link only allows reading the shared object
+		restricted, err := macaroon.ParseAPIKey(apiKey)
+		require.NoError(t, err)
+		restricted, err = restricted.Restrict(macaroon.Caveat{
+			DisallowWrites:  true,
+			DisallowDeletes: true,
+			DisallowLists:   true,
+			AllowedPaths: []*macaroon.Caveat_Path{{
+				Bucket:              []byte("bucket"),
+				EncryptedPathPrefix: []byte(shared.EncryptedPathPrefix),
+			}},
+		})
+		require.NoError(t, err)
+
+		server := httptest.NewServer(linksharing.NewHandler(zaptest.NewLogger(t), up, satellite.Addr()))
+		defer server.Close()
+
+		link := linksharing.Link{
+			APIKey: restricted.Serialize(),
+			Bucket: "bucket",
+			Path:   "dir/file.txt",
+			Access: *shared,
+		}
+
+		{ // download the whole object
+			resp, err := http.Get(link.URL(server.URL))
+			require.NoError(t, err)
+			body, err := ioutil.ReadAll(resp.Body)
+			require.NoError(t, err)
+			require.NoError(t, resp.Body.Close())
+
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+			assert.Equal(t, "text/plain", resp.Header.Get("Content-Type"))
+			assert.Equal(t, data, body)
+		}
+
+		{ // download a range of the object
+			req, err := http.NewRequest(http.MethodGet, link.URL(server.URL), nil)
+			require.NoError(t, err)
+			req.Header.Set("Range", "bytes=100-1123")
+
+			resp, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			body, err := ioutil.ReadAll(resp.Body)
+			require.NoError(t, err)
+			require.NoError(t, resp.Body.Close())
+
+			assert.Equal(t, http.StatusPartialContent, resp.StatusCode)
+			assert.Equal(t, data[100:1124], body)
+		}
+
+		{ // the restricted key doesn't give access to other objects
+			err = bucket.UploadObject(ctx, "other", bytes.NewReader(data), nil)
+			require.NoError(t, err)
+
+			other, err := access.ShareObject("bucket", "other", bucket.PathCipher)
+			require.NoError(t, err)
+
+			otherLink := link
+			otherLink.Path = "other"
+			otherLink.Access = *other
+
+			resp, err := http.Get(otherLink.URL(server.URL))
+			require.NoError(t, err)
+			require.NoError(t, resp.Body.Close())
+			assert.NotEqual(t, http.StatusOK, resp.StatusCode)
+		}
+
+		{ // missing objects aren't found
+			missing, err := access.ShareObject("bucket", "missing", bucket.PathCipher)
+			require.NoError(t, err)
+
+			missingLink := link
+			missingLink.APIKey = apiKey
+			missingLink.Path = "missing"
+			missingLink.Access = *missing
+
+			resp, err := http.Get(missingLink.URL(server.URL))
+			require.NoError(t, err)
+			require.NoError(t, resp.Body.Close())
+			assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+		}
+
+		{ // links without the encryption key are invalid
+			resp, err := http.Get(server.URL + "/" + link.APIKey + "/bucket/dir/file.txt")
+			require.NoError(t, err)
+			require.NoError(t, resp.Body.Close())
+			assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		}
+	})
+}
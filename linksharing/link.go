@@ -0,0 +1,84 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package linksharing
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/btcsuite/btcutil/base58"
+
+	"storj.io/storj/lib/uplink"
+	"storj.io/storj/pkg/storj"
+)
+
+const (
+	keyVersion = 0
+
+	// keyParam is the query parameter with the encryption key derived for the path of the object
+	keyParam = "key"
+	// encryptedPathParam is the query parameter with the encrypted path of the object
+	encryptedPathParam = "enc"
+)
+
+// Link is a shared object, with the restricted API key and the encryption
+// access needed to download it.
+//
+// The URL of a link has the form
+// <base url>/<api key>/<bucket>/<path>?key=<derived key>&enc=<encrypted path>
+type Link struct {
+	APIKey string
+	Bucket string
+	Path   storj.Path
+	Access uplink.EncryptionAccess
+}
+
+// URL returns the URL of the link at the link sharing service at baseURL
+func (link *Link) URL(baseURL string) string {
+	query := url.Values{}
+	query.Set(keyParam, base58.CheckEncode(link.Access.Key[:], keyVersion))
+	query.Set(encryptedPathParam, link.Access.EncryptedPathPrefix)
+
+	comps := storj.SplitPath(link.Path)
+	for i, comp := range comps {
+		comps[i] = url.PathEscape(comp)
+	}
+
+	return strings.TrimSuffix(baseURL, "/") + "/" +
+		url.PathEscape(link.APIKey) + "/" +
+		url.PathEscape(link.Bucket) + "/" +
+		strings.Join(comps, "/") + "?" + query.Encode()
+}
+
+// ParseLink parses the link of the request URL u
+func ParseLink(u *url.URL) (*Link, error) {
+	parts := strings.SplitN(strings.TrimPrefix(u.Path, "/"), "/", 3)
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return nil, Error.New("invalid link path %q", u.Path)
+	}
+
+	link := &Link{
+		APIKey: parts[0],
+		Bucket: parts[1],
+		Path:   parts[2],
+	}
+
+	query := u.Query()
+
+	key, version, err := base58.CheckDecode(query.Get(keyParam))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if version != keyVersion || len(key) != len(link.Access.Key) {
+		return nil, Error.New("invalid encryption key")
+	}
+	copy(link.Access.Key[:], key)
+
+	link.Access.EncryptedPathPrefix = query.Get(encryptedPathParam)
+	if link.Access.EncryptedPathPrefix == "" {
+		return nil, Error.New("missing encrypted path")
+	}
+
+	return link, nil
+}
@@ -0,0 +1,77 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package linksharing
+
+import (
+	"context"
+	"net"
+	"net/http"
+
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+
+	"storj.io/storj/internal/errs2"
+	"storj.io/storj/lib/uplink"
+)
+
+// Config is the configuration of the link sharing service
+type Config struct {
+	Address       string `user:"true" help:"public address to listen on" default:":8090"`
+	SatelliteAddr string `user:"true" help:"address of the satellite of the shared objects" default:"127.0.0.1:7777"`
+}
+
+// Peer is the link sharing service
+type Peer struct {
+	Log *zap.Logger
+
+	// Web server
+	Server struct {
+		Endpoint http.Server
+		Listener net.Listener
+	}
+}
+
+// New creates the link sharing service, which downloads the shared objects with uplink
+func New(log *zap.Logger, uplink *uplink.Uplink, config Config) (peer *Peer, err error) {
+	peer = &Peer{
+		Log: log,
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/", NewHandler(log, uplink, config.SatelliteAddr))
+	peer.Server.Endpoint = http.Server{
+		Handler: mux,
+	}
+
+	peer.Server.Listener, err = net.Listen("tcp", config.Address)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return peer, nil
+}
+
+// Run runs the link sharing service until it's either closed or it errors.
+func (peer *Peer) Run(ctx context.Context) (err error) {
+	ctx, cancel := context.WithCancel(ctx)
+	var group errgroup.Group
+
+	group.Go(func() error {
+		<-ctx.Done()
+		return errs2.IgnoreCanceled(peer.Server.Endpoint.Shutdown(ctx))
+	})
+	group.Go(func() error {
+		defer cancel()
+		peer.Log.Sugar().Infof("Link sharing service started on %s", peer.Addr())
+		return errs2.IgnoreCanceled(peer.Server.Endpoint.Serve(peer.Server.Listener))
+	})
+	return group.Wait()
+}
+
+// Close closes all the resources.
+func (peer *Peer) Close() (err error) {
+	return peer.Server.Endpoint.Close()
+}
+
+// Addr returns the public address.
+func (peer *Peer) Addr() string { return peer.Server.Listener.Addr().String() }
@@ -9,6 +9,7 @@ import (
 
 	"github.com/skyrings/skyring-common/tools/uuid"
 
+	"storj.io/storj/internal/memory"
 	"storj.io/storj/pkg/storj"
 )
 
@@ -60,6 +61,10 @@ type StoragenodeAccounting interface {
 	LastTimestamp(ctx context.Context, timestampType string) (time.Time, error)
 	// QueryPaymentInfo queries Nodes and Accounting_Rollup on nodeID
 	QueryPaymentInfo(ctx context.Context, start time.Time, end time.Time) ([]*CSVRow, error)
+	// QueryPayoutInfo queries Nodes and Accounting_Rollup on nodeID, skipping rollups of nodes missing from Nodes
+	QueryPayoutInfo(ctx context.Context, start time.Time, end time.Time) ([]*CSVRow, error)
+	// QueryNodePaymentInfo queries Nodes and Accounting_Rollup for a single node, it returns nil when the node has no rollups
+	QueryNodePaymentInfo(ctx context.Context, nodeID storj.NodeID, start time.Time, end time.Time) (*CSVRow, error)
 	// DeleteTalliesBefore deletes all tallies prior to some time
 	DeleteTalliesBefore(ctx context.Context, latestRollup time.Time) error
 }
@@ -71,7 +76,9 @@ type ProjectAccounting interface {
 	// CreateStorageTally creates a record for BucketStorageTally in the accounting DB table
 	CreateStorageTally(ctx context.Context, tally BucketStorageTally) error
 	// GetAllocatedBandwidthTotal returns the sum of GET bandwidth usage allocated for a projectID in the past time frame
-	GetAllocatedBandwidthTotal(ctx context.Context, bucketID []byte, from time.Time) (int64, error)
+	GetAllocatedBandwidthTotal(ctx context.Context, projectID uuid.UUID, from time.Time) (int64, error)
 	// GetStorageTotals returns the current inline and remote storage usage for a projectID
 	GetStorageTotals(ctx context.Context, projectID uuid.UUID) (int64, int64, error)
+	// GetProjectUsageLimits returns the storage and egress limits of a projectID, zero means no custom limit is set
+	GetProjectUsageLimits(ctx context.Context, projectID uuid.UUID) (storageLimit, egressLimit memory.Size, err error)
 }
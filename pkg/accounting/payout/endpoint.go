@@ -0,0 +1,109 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package payout
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"storj.io/storj/pkg/identity"
+	"storj.io/storj/pkg/pb"
+)
+
+// Endpoint allows storage nodes to query their payout statements and expected earnings.
+// Only the satellite side exists, neither the storage node nor its dashboard call it yet.
+type Endpoint struct {
+	log     *zap.Logger
+	service *Service
+}
+
+// NewEndpoint creates a new payouts endpoint
+func NewEndpoint(log *zap.Logger, service *Service) *Endpoint {
+	return &Endpoint{
+		log:     log,
+		service: service,
+	}
+}
+
+// GetPayouts returns the payout statements of the requesting storage node together with
+// the expected earnings for the current period.
+func (endpoint *Endpoint) GetPayouts(ctx context.Context, req *pb.GetPayoutsRequest) (_ *pb.GetPayoutsResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	peer, err := identity.PeerIdentityFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	statements, err := endpoint.service.GetStatements(ctx, peer.ID)
+	if err != nil {
+		endpoint.log.Error("get payout statements failed", zap.String("Node ID", peer.ID.String()), zap.Error(err))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	estimate, err := endpoint.service.Estimate(ctx, peer.ID, time.Now())
+	if err != nil {
+		endpoint.log.Error("estimate payout failed", zap.String("Node ID", peer.ID.String()), zap.Error(err))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &pb.GetPayoutsResponse{}
+	for _, statement := range statements {
+		pbStatement, err := statementToProto(statement)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		resp.Statements = append(resp.Statements, pbStatement)
+	}
+
+	if estimate != nil {
+		resp.Estimate, err = statementToProto(*estimate)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return resp, nil
+}
+
+// statementToProto converts a payout statement to its protobuf representation
+func statementToProto(statement Statement) (*pb.PayoutStatement, error) {
+	periodStart, err := ptypes.TimestampProto(statement.PeriodStart)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	periodEnd, err := ptypes.TimestampProto(statement.PeriodEnd)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return &pb.PayoutStatement{
+		NodeId:      statement.NodeID,
+		PeriodStart: periodStart,
+		PeriodEnd:   periodEnd,
+
+		AtRestTotal:    statement.AtRestTotal,
+		GetTotal:       statement.GetTotal,
+		GetRepairTotal: statement.GetRepairTotal,
+		GetAuditTotal:  statement.GetAuditTotal,
+		PutTotal:       statement.PutTotal,
+		PutRepairTotal: statement.PutRepairTotal,
+
+		AtRestAmount: statement.AtRestAmount,
+		GetAmount:    statement.GetAmount,
+		RepairAmount: statement.RepairAmount,
+		AuditAmount:  statement.AuditAmount,
+		Earned:       statement.Earned,
+
+		HeldPercent:  int32(statement.HeldPercent),
+		Held:         statement.Held,
+		Disqualified: statement.Disqualified,
+		Paid:         statement.Paid,
+	}, nil
+}
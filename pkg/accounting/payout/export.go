@@ -0,0 +1,83 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package payout
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+)
+
+// WriteCSV writes payout statements as csv records with a header row
+func WriteCSV(output io.Writer, statements []Statement) error {
+	w := csv.NewWriter(output)
+	headers := []string{
+		"nodeID",
+		"periodStart",
+		"periodEnd",
+		"nodeCreationDate",
+		"walletAddress",
+		"byte-hours:AtRest",
+		"bytes:BWGet",
+		"bytes:BWRepair-GET",
+		"bytes:BWAudit",
+		"bytes:BWPut",
+		"bytes:BWRepair-PUT",
+		"cents:AtRest",
+		"cents:Get",
+		"cents:Repair",
+		"cents:Audit",
+		"cents:Earned",
+		"heldPercent",
+		"cents:Held",
+		"disqualified",
+		"cents:Paid",
+	}
+	if err := w.Write(headers); err != nil {
+		return Error.Wrap(err)
+	}
+
+	for _, statement := range statements {
+		record := []string{
+			statement.NodeID.String(),
+			statement.PeriodStart.Format("2006-01-02"),
+			statement.PeriodEnd.Format("2006-01-02"),
+			statement.NodeCreatedAt.Format("2006-01-02"),
+			statement.Wallet,
+			strconv.FormatFloat(statement.AtRestTotal, 'f', 5, 64),
+			strconv.FormatInt(statement.GetTotal, 10),
+			strconv.FormatInt(statement.GetRepairTotal, 10),
+			strconv.FormatInt(statement.GetAuditTotal, 10),
+			strconv.FormatInt(statement.PutTotal, 10),
+			strconv.FormatInt(statement.PutRepairTotal, 10),
+			strconv.FormatInt(statement.AtRestAmount, 10),
+			strconv.FormatInt(statement.GetAmount, 10),
+			strconv.FormatInt(statement.RepairAmount, 10),
+			strconv.FormatInt(statement.AuditAmount, 10),
+			strconv.FormatInt(statement.Earned, 10),
+			strconv.Itoa(statement.HeldPercent),
+			strconv.FormatInt(statement.Held, 10),
+			strconv.FormatBool(statement.Disqualified),
+			strconv.FormatInt(statement.Paid, 10),
+		}
+		if err := w.Write(record); err != nil {
+			return Error.Wrap(err)
+		}
+	}
+
+	w.Flush()
+	return Error.Wrap(w.Error())
+}
+
+// WriteJSON writes payout statements as an indented json array
+func WriteJSON(output io.Writer, statements []Statement) error {
+	if statements == nil {
+		statements = []Statement{}
+	}
+
+	encoder := json.NewEncoder(output)
+	encoder.SetIndent("", "  ")
+	return Error.Wrap(encoder.Encode(statements))
+}
@@ -0,0 +1,141 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package payout
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/zeebo/errs"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/pkg/accounting"
+	"storj.io/storj/pkg/storj"
+)
+
+var (
+	// Error is the default payout errs class
+	Error = errs.Class("payout error")
+	mon   = monkit.Package()
+)
+
+// DB stores payout statements
+type DB interface {
+	// Create inserts a payout statement
+	Create(ctx context.Context, statement Statement) error
+	// Get returns the statement of a node for the period starting at periodStart, it returns nil when the statement does not exist
+	Get(ctx context.Context, nodeID storj.NodeID, periodStart time.Time) (*Statement, error)
+	// GetByNodeID returns all statements of a node, newest first
+	GetByNodeID(ctx context.Context, nodeID storj.NodeID) ([]Statement, error)
+	// GetByPeriod returns the statements of all nodes for the period starting at periodStart
+	GetByPeriod(ctx context.Context, periodStart time.Time) ([]Statement, error)
+}
+
+// Config contains configurable values for payouts
+type Config struct {
+	AtRestPrice float64 `help:"payout in cents per GB-month of data stored" default:"0.15"`
+	EgressPrice float64 `help:"payout in cents per GB of egress" default:"2"`
+	RepairPrice float64 `help:"payout in cents per GB of repair egress" default:"1"`
+	AuditPrice  float64 `help:"payout in cents per GB of audit egress" default:"1"`
+}
+
+// Statement describes the earnings of a storage node for a payout period
+type Statement struct {
+	NodeID        storj.NodeID
+	PeriodStart   time.Time
+	PeriodEnd     time.Time
+	NodeCreatedAt time.Time
+	Wallet        string
+
+	// AtRestTotal is the data stored during the period in byte-hours
+	AtRestTotal float64
+	// bandwidth in bytes
+	GetTotal       int64
+	GetRepairTotal int64
+	GetAuditTotal  int64
+	PutTotal       int64
+	PutRepairTotal int64
+
+	// earnings in cents
+	AtRestAmount int64
+	GetAmount    int64
+	RepairAmount int64
+	AuditAmount  int64
+	Earned       int64
+
+	// HeldPercent is the percentage of the earnings held back based on node age
+	HeldPercent  int
+	Held         int64
+	Disqualified bool
+	Paid         int64
+
+	CreatedAt time.Time
+}
+
+// PeriodStart returns the beginning of the payout month containing t
+func PeriodStart(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// HeldPercent returns the percentage of earnings held back for a node
+// created at createdAt during the period starting at periodStart.
+func HeldPercent(createdAt, periodStart time.Time) int {
+	createdAt, periodStart = createdAt.UTC(), periodStart.UTC()
+	months := (periodStart.Year()-createdAt.Year())*12 + int(periodStart.Month()) - int(createdAt.Month())
+	switch {
+	case months < 3:
+		return 75
+	case months < 6:
+		return 50
+	case months < 9:
+		return 25
+	default:
+		return 0
+	}
+}
+
+// ComputeStatement calculates the earnings of a node from its usage during the given period
+func ComputeStatement(config Config, row accounting.CSVRow, disqualified bool, periodStart, periodEnd time.Time) Statement {
+	statement := Statement{
+		NodeID:        row.NodeID,
+		PeriodStart:   periodStart,
+		PeriodEnd:     periodEnd,
+		NodeCreatedAt: row.NodeCreationDate,
+		Wallet:        row.Wallet,
+
+		AtRestTotal:    row.AtRestTotal,
+		GetTotal:       row.GetTotal,
+		GetRepairTotal: row.GetRepairTotal,
+		GetAuditTotal:  row.GetAuditTotal,
+		PutTotal:       row.PutTotal,
+		PutRepairTotal: row.PutRepairTotal,
+
+		Disqualified: disqualified,
+	}
+
+	gb := memory.GB.Float64()
+	hours := periodEnd.Sub(periodStart).Hours()
+	if hours > 0 {
+		statement.AtRestAmount = int64(math.Round(row.AtRestTotal / gb / hours * config.AtRestPrice))
+	}
+	statement.GetAmount = int64(math.Round(float64(row.GetTotal) / gb * config.EgressPrice))
+	statement.RepairAmount = int64(math.Round(float64(row.GetRepairTotal) / gb * config.RepairPrice))
+	statement.AuditAmount = int64(math.Round(float64(row.GetAuditTotal) / gb * config.AuditPrice))
+	statement.Earned = statement.AtRestAmount + statement.GetAmount + statement.RepairAmount + statement.AuditAmount
+
+	statement.HeldPercent = HeldPercent(row.NodeCreationDate, periodStart)
+	if disqualified {
+		// disqualified nodes forfeit their earnings
+		statement.Held = statement.Earned
+		statement.Paid = 0
+		return statement
+	}
+
+	statement.Held = statement.Earned * int64(statement.HeldPercent) / 100
+	statement.Paid = statement.Earned - statement.Held
+	return statement
+}
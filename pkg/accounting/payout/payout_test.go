@@ -0,0 +1,148 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package payout_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/internal/teststorj"
+	"storj.io/storj/pkg/accounting"
+	"storj.io/storj/pkg/accounting/payout"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+)
+
+var testConfig = payout.Config{
+	AtRestPrice: 0.15,
+	EgressPrice: 2,
+	RepairPrice: 1,
+	AuditPrice:  1,
+}
+
+func TestHeldPercent(t *testing.T) {
+	created := time.Date(2019, 1, 15, 0, 0, 0, 0, time.UTC)
+	for _, tt := range []struct {
+		period time.Time
+		held   int
+	}{
+		{time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC), 75},
+		{time.Date(2019, 3, 1, 0, 0, 0, 0, time.UTC), 75},
+		{time.Date(2019, 4, 1, 0, 0, 0, 0, time.UTC), 50},
+		{time.Date(2019, 7, 1, 0, 0, 0, 0, time.UTC), 25},
+		{time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC), 0},
+		{time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC), 0},
+	} {
+		assert.Equal(t, tt.held, payout.HeldPercent(created, tt.period), tt.period.String())
+	}
+}
+
+func TestComputeStatement(t *testing.T) {
+	periodStart := time.Date(2019, 4, 1, 0, 0, 0, 0, time.UTC)
+	periodEnd := periodStart.AddDate(0, 1, 0)
+	hours := periodEnd.Sub(periodStart).Hours()
+
+	row := accounting.CSVRow{
+		NodeCreationDate: time.Date(2019, 2, 1, 0, 0, 0, 0, time.UTC),
+		// 1 TB stored for the whole month
+		AtRestTotal:    memory.TB.Float64() * hours,
+		GetTotal:       100 * memory.GB.Int64(),
+		GetRepairTotal: 2 * memory.GB.Int64(),
+		GetAuditTotal:  1 * memory.GB.Int64(),
+		PutTotal:       50 * memory.GB.Int64(),
+	}
+
+	statement := payout.ComputeStatement(testConfig, row, false, periodStart, periodEnd)
+	assert.Equal(t, int64(150), statement.AtRestAmount)
+	assert.Equal(t, int64(200), statement.GetAmount)
+	assert.Equal(t, int64(2), statement.RepairAmount)
+	assert.Equal(t, int64(1), statement.AuditAmount)
+	assert.Equal(t, int64(353), statement.Earned)
+	assert.Equal(t, 75, statement.HeldPercent)
+	assert.Equal(t, int64(264), statement.Held)
+	assert.Equal(t, int64(89), statement.Paid)
+
+	disqualified := payout.ComputeStatement(testConfig, row, true, periodStart, periodEnd)
+	assert.True(t, disqualified.Disqualified)
+	assert.Equal(t, int64(353), disqualified.Held)
+	assert.Equal(t, int64(0), disqualified.Paid)
+}
+
+func TestPayouts(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 2, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		node := planet.StorageNodes[0]
+
+		lastMonth := payout.PeriodStart(time.Now()).AddDate(0, -1, 0)
+		thisMonth := payout.PeriodStart(time.Now())
+
+		stats := accounting.RollupStats{}
+		for _, day := range []time.Time{lastMonth, thisMonth} {
+			stats[day] = map[storj.NodeID]*accounting.Rollup{}
+			for _, sn := range planet.StorageNodes {
+				stats[day][sn.ID()] = &accounting.Rollup{
+					NodeID:      sn.ID(),
+					StartTime:   day,
+					GetTotal:    100 * memory.GB.Int64(),
+					AtRestTotal: memory.TB.Float64() * 24,
+				}
+			}
+		}
+		// rollups of nodes removed from the overlay don't get a statement
+		deleted := teststorj.NodeIDFromString("deleted")
+		stats[lastMonth][deleted] = &accounting.Rollup{
+			NodeID:    deleted,
+			StartTime: lastMonth,
+			GetTotal:  100 * memory.GB.Int64(),
+		}
+		err := satellite.DB.StoragenodeAccounting().SaveRollup(ctx, thisMonth, stats)
+		require.NoError(t, err)
+
+		statements, err := satellite.Payouts.Service.GenerateStatements(ctx, lastMonth)
+		require.NoError(t, err)
+		require.Len(t, statements, len(planet.StorageNodes))
+		for _, statement := range statements {
+			assert.Equal(t, int64(200), statement.GetAmount)
+			assert.Equal(t, 75, statement.HeldPercent)
+			assert.False(t, statement.Disqualified)
+		}
+
+		// generating again must not create duplicates
+		statements, err = satellite.Payouts.Service.GenerateStatements(ctx, lastMonth)
+		require.NoError(t, err)
+		require.Len(t, statements, len(planet.StorageNodes))
+
+		_, err = satellite.Payouts.Service.GenerateStatements(ctx, thisMonth)
+		assert.Error(t, err, "current month has not ended yet")
+
+		var output bytes.Buffer
+		require.NoError(t, payout.WriteCSV(&output, statements))
+		lines := strings.Split(strings.TrimSpace(output.String()), "\n")
+		assert.Len(t, lines, len(planet.StorageNodes)+1)
+
+		satelliteNode := satellite.Local().Node
+		conn, err := node.Transport.DialNode(ctx, &satelliteNode)
+		require.NoError(t, err)
+		defer ctx.Check(conn.Close)
+
+		resp, err := pb.NewPayoutsClient(conn).GetPayouts(ctx, &pb.GetPayoutsRequest{})
+		require.NoError(t, err)
+		require.Len(t, resp.Statements, 1)
+		assert.Equal(t, node.ID(), resp.Statements[0].NodeId)
+		assert.Equal(t, int64(200), resp.Statements[0].GetAmount)
+		require.NotNil(t, resp.Estimate)
+		assert.Equal(t, node.ID(), resp.Estimate.NodeId)
+		assert.Equal(t, int64(200), resp.Estimate.GetAmount)
+	})
+}
@@ -0,0 +1,142 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package payout
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/pkg/accounting"
+	"storj.io/storj/pkg/overlay"
+	"storj.io/storj/pkg/storj"
+)
+
+// Service calculates and stores storage node payout statements
+type Service struct {
+	log        *zap.Logger
+	config     Config
+	accounting accounting.StoragenodeAccounting
+	overlay    overlay.DB
+	db         DB
+}
+
+// NewService creates a new payout service
+func NewService(log *zap.Logger, config Config, accounting accounting.StoragenodeAccounting, overlay overlay.DB, db DB) *Service {
+	return &Service{
+		log:        log,
+		config:     config,
+		accounting: accounting,
+		overlay:    overlay,
+		db:         db,
+	}
+}
+
+// GenerateStatements creates the missing payout statements of all nodes for the month containing period
+// and returns all statements of that month.
+func (service *Service) GenerateStatements(ctx context.Context, period time.Time) (_ []Statement, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	periodStart := PeriodStart(period)
+	periodEnd := periodStart.AddDate(0, 1, 0)
+	if periodEnd.After(time.Now()) {
+		return nil, Error.New("payout period %s has not ended yet", periodStart.Format("2006-01"))
+	}
+
+	rows, err := service.accounting.QueryPayoutInfo(ctx, periodStart, periodEnd)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	var errlist errs.Group
+	for _, row := range rows {
+		existing, err := service.db.Get(ctx, row.NodeID, periodStart)
+		if err != nil {
+			errlist.Add(err)
+			continue
+		}
+		if existing != nil {
+			continue
+		}
+
+		disqualified, err := service.isDisqualified(ctx, row.NodeID, periodEnd)
+		if err != nil {
+			service.log.Error("skipping payout statement",
+				zap.String("Node ID", row.NodeID.String()),
+				zap.String("Period", periodStart.Format("2006-01")),
+				zap.Error(err),
+			)
+			continue
+		}
+
+		statement := ComputeStatement(service.config, *row, disqualified, periodStart, periodEnd)
+		if err := service.db.Create(ctx, statement); err != nil {
+			errlist.Add(err)
+			continue
+		}
+
+		service.log.Debug("payout statement created",
+			zap.String("Node ID", row.NodeID.String()),
+			zap.String("Period", periodStart.Format("2006-01")),
+			zap.Int64("Paid", statement.Paid),
+		)
+	}
+
+	if err := errlist.Err(); err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	statements, err := service.db.GetByPeriod(ctx, periodStart)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return statements, nil
+}
+
+// GetStatements returns the stored payout statements of a node, newest first
+func (service *Service) GetStatements(ctx context.Context, nodeID storj.NodeID) (_ []Statement, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	statements, err := service.db.GetByNodeID(ctx, nodeID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return statements, nil
+}
+
+// Estimate calculates the expected earnings of a node for the current month up to now,
+// it returns nil when the node has no usage in the current month.
+func (service *Service) Estimate(ctx context.Context, nodeID storj.NodeID, now time.Time) (_ *Statement, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	periodStart := PeriodStart(now)
+	periodEnd := periodStart.AddDate(0, 1, 0)
+
+	row, err := service.accounting.QueryNodePaymentInfo(ctx, nodeID, periodStart, periodEnd)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if row == nil {
+		return nil, nil
+	}
+
+	disqualified, err := service.isDisqualified(ctx, nodeID, periodEnd)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	statement := ComputeStatement(service.config, *row, disqualified, periodStart, periodEnd)
+	return &statement, nil
+}
+
+// isDisqualified checks whether the node was disqualified before the end of the payout period
+func (service *Service) isDisqualified(ctx context.Context, nodeID storj.NodeID, periodEnd time.Time) (bool, error) {
+	node, err := service.overlay.Get(ctx, nodeID)
+	if err != nil {
+		return false, err
+	}
+	return node.Disqualified != nil && node.Disqualified.Before(periodEnd), nil
+}
@@ -4,7 +4,14 @@
 package accounting
 
 import (
+	"context"
+	"time"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"github.com/zeebo/errs"
+
 	"storj.io/storj/internal/memory"
+	"storj.io/storj/pkg/accounting/live"
 )
 
 const (
@@ -15,20 +22,118 @@ const (
 	ExpansionFactor = 3
 )
 
-// ExceedsAlphaUsage returns true if the storage or bandwidth usage limits have been exceeded
-// for a project in the past month (30 days). The usage limit is 25GB multiplied by the redundancy
-// expansion factor, so that the uplinks have a raw limit of 25GB.
-// TODO(jg): remove this code once we no longer need usage limiting for alpha release
-// Ref: https://storjlabs.atlassian.net/browse/V3-1274
-func ExceedsAlphaUsage(bandwidthGetTotal, inlineTotal, remoteTotal int64, maxAlphaUsageGB memory.Size) (bool, string) {
-	maxUsage := maxAlphaUsageGB.Int64() * int64(ExpansionFactor)
-	if bandwidthGetTotal >= maxUsage {
-		return true, "bandwidth"
+var (
+	// ErrProjectUsage general error for project usage
+	ErrProjectUsage = errs.Class("project usage error")
+)
+
+// ProjectUsage defines project usage limits and checks them against the
+// tallied and live accounting data.
+type ProjectUsage struct {
+	projectAccountingDB ProjectAccounting
+	liveAccounting      live.Service
+	defaultStorageLimit memory.Size
+	defaultEgressLimit  memory.Size
+}
+
+// NewProjectUsage created new instance of project usage service.
+// The default limits are used for projects which don't have a custom limit set.
+func NewProjectUsage(projectAccountingDB ProjectAccounting, liveAccounting live.Service, defaultStorageLimit, defaultEgressLimit memory.Size) *ProjectUsage {
+	return &ProjectUsage{
+		projectAccountingDB: projectAccountingDB,
+		liveAccounting:      liveAccounting,
+		defaultStorageLimit: defaultStorageLimit,
+		defaultEgressLimit:  defaultEgressLimit,
+	}
+}
+
+// ExceedsStorageUsage returns true if the storage used by a project has reached
+// the project storage limit. The project storage limit is returned as well.
+func (usage *ProjectUsage) ExceedsStorageUsage(ctx context.Context, projectID uuid.UUID) (_ bool, limit memory.Size, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	limit, _, err = usage.GetProjectUsageLimits(ctx, projectID)
+	if err != nil {
+		return false, 0, ErrProjectUsage.Wrap(err)
+	}
+
+	used, err := usage.GetProjectStorageTotals(ctx, projectID)
+	if err != nil {
+		return false, limit, ErrProjectUsage.Wrap(err)
+	}
+
+	return used >= limit, limit, nil
+}
+
+// ExceedsBandwidthUsage returns true if the egress of a project in the past
+// month has reached the project egress limit. The project egress limit is
+// returned as well.
+func (usage *ProjectUsage) ExceedsBandwidthUsage(ctx context.Context, projectID uuid.UUID) (_ bool, limit memory.Size, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, limit, err = usage.GetProjectUsageLimits(ctx, projectID)
+	if err != nil {
+		return false, 0, ErrProjectUsage.Wrap(err)
+	}
+
+	used, err := usage.GetProjectBandwidthTotals(ctx, projectID)
+	if err != nil {
+		return false, limit, ErrProjectUsage.Wrap(err)
+	}
+
+	return used >= limit, limit, nil
+}
+
+// GetProjectStorageTotals returns the storage used by a project, combining the
+// last tally with the usage tracked by live accounting since then. The usage is
+// scaled down by the ExpansionFactor, so it can be compared to the limits, which
+// are expressed in the amount of data an uplink can store.
+func (usage *ProjectUsage) GetProjectStorageTotals(ctx context.Context, projectID uuid.UUID) (_ memory.Size, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	lastCountInline, lastCountRemote, err := usage.projectAccountingDB.GetStorageTotals(ctx, projectID)
+	if err != nil {
+		return 0, ErrProjectUsage.Wrap(err)
+	}
+	rtInline, rtRemote, err := usage.liveAccounting.GetProjectStorageUsage(ctx, projectID)
+	if err != nil {
+		return 0, ErrProjectUsage.Wrap(err)
+	}
+
+	total := lastCountInline + lastCountRemote + rtInline + rtRemote
+	return memory.Size(total / ExpansionFactor), nil
+}
+
+// GetProjectBandwidthTotals returns the egress allocated for a project in the
+// past month (30 days), scaled down by the ExpansionFactor.
+func (usage *ProjectUsage) GetProjectBandwidthTotals(ctx context.Context, projectID uuid.UUID) (_ memory.Size, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	from := time.Now().AddDate(0, 0, -AverageDaysInMonth)
+	total, err := usage.projectAccountingDB.GetAllocatedBandwidthTotal(ctx, projectID, from)
+	if err != nil {
+		return 0, ErrProjectUsage.Wrap(err)
 	}
 
-	if inlineTotal+remoteTotal >= maxUsage {
-		return true, "storage"
+	return memory.Size(total / ExpansionFactor), nil
+}
+
+// GetProjectUsageLimits returns the storage and egress limits of a project,
+// falling back to the default limits when the project doesn't have custom ones.
+func (usage *ProjectUsage) GetProjectUsageLimits(ctx context.Context, projectID uuid.UUID) (storageLimit, egressLimit memory.Size, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	storageLimit, egressLimit, err = usage.projectAccountingDB.GetProjectUsageLimits(ctx, projectID)
+	if err != nil {
+		return 0, 0, ErrProjectUsage.Wrap(err)
+	}
+
+	if storageLimit == 0 {
+		storageLimit = usage.defaultStorageLimit
+	}
+	if egressLimit == 0 {
+		egressLimit = usage.defaultEgressLimit
 	}
 
-	return false, ""
+	return storageLimit, egressLimit, nil
 }
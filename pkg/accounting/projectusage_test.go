@@ -32,7 +32,7 @@ func TestProjectUsageStorage(t *testing.T) {
 		expectedErrMsg   string
 	}{
 		{name: "doesn't exceed storage or bandwidth project limit", expectedExceeded: false, expectedErrMsg: ""},
-		{name: "exceeds storage project limit", expectedExceeded: true, expectedResource: "storage", expectedErrMsg: "segment error: metainfo error: rpc error: code = ResourceExhausted desc = Exceeded Alpha Usage Limit; segment error: metainfo error: rpc error: code = ResourceExhausted desc = Exceeded Alpha Usage Limit"},
+		{name: "exceeds storage project limit", expectedExceeded: true, expectedResource: "storage", expectedErrMsg: "segment error: metainfo error: rpc error: code = ResourceExhausted desc = Exceeded Storage Usage Limit of 25.0 GB; segment error: metainfo error: rpc error: code = ResourceExhausted desc = Exceeded Storage Usage Limit of 25.0 GB"},
 	}
 
 	testplanet.Run(t, testplanet.Config{
@@ -40,6 +40,7 @@ func TestProjectUsageStorage(t *testing.T) {
 	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
 		saDB := planet.Satellites[0].DB
 		acctDB := saDB.ProjectAccounting()
+		projectUsage := planet.Satellites[0].Accounting.ProjectUsage
 
 		// Setup: create a new project to use the projectID
 		projects, err := planet.Satellites[0].DB.Console().Projects().GetAll(ctx)
@@ -56,13 +57,11 @@ func TestProjectUsageStorage(t *testing.T) {
 					require.NoError(t, err)
 				}
 
-				// Execute test: get storage totals for a project, then check if that exceeds the max usage limit
-				inlineTotal, remoteTotal, err := acctDB.GetStorageTotals(ctx, projectID)
+				// Execute test: check if the storage of the project exceeds the default usage limit
+				actualExceeded, limit, err := projectUsage.ExceedsStorageUsage(ctx, projectID)
 				require.NoError(t, err)
-				maxAlphaUsage := 25 * memory.GB
-				actualExceeded, actualResource := accounting.ExceedsAlphaUsage(0, inlineTotal, remoteTotal, maxAlphaUsage)
 				require.Equal(t, tt.expectedExceeded, actualExceeded)
-				require.Equal(t, tt.expectedResource, actualResource)
+				require.Equal(t, 25*memory.GB, limit)
 
 				// Setup: create some bytes for the uplink to upload
 				expectedData := make([]byte, 50*memory.KiB)
@@ -90,7 +89,7 @@ func TestProjectUsageBandwidth(t *testing.T) {
 		expectedErrMsg   string
 	}{
 		{name: "doesn't exceed storage or bandwidth project limit", expectedExceeded: false, expectedErrMsg: ""},
-		{name: "exceeds bandwidth project limit", expectedExceeded: true, expectedResource: "bandwidth", expectedErrMsg: "segment error: metainfo error: rpc error: code = ResourceExhausted desc = Exceeded Alpha Usage Limit"},
+		{name: "exceeds bandwidth project limit", expectedExceeded: true, expectedResource: "bandwidth", expectedErrMsg: "segment error: metainfo error: rpc error: code = ResourceExhausted desc = Exceeded Egress Usage Limit of 25.0 GB"},
 	}
 
 	testplanet.Run(t, testplanet.Config{
@@ -98,14 +97,13 @@ func TestProjectUsageBandwidth(t *testing.T) {
 	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
 		saDB := planet.Satellites[0].DB
 		orderDB := saDB.Orders()
-		acctDB := saDB.ProjectAccounting()
+		projectUsage := planet.Satellites[0].Accounting.ProjectUsage
 
-		// Setup: get projectID and create bucketID
+		// Setup: get projectID
 		projects, err := planet.Satellites[0].DB.Console().Projects().GetAll(ctx)
 		projectID := projects[0].ID
 		require.NoError(t, err)
 		bucketName := "testbucket"
-		bucketID := createBucketID(projectID, []byte(bucketName))
 
 		for _, tt := range cases {
 			t.Run(tt.name, func(t *testing.T) {
@@ -124,17 +122,11 @@ func TestProjectUsageBandwidth(t *testing.T) {
 				err := planet.Uplinks[0].Upload(ctx, planet.Satellites[0], bucketName, "test/path", expectedData)
 				require.NoError(t, err)
 
-				// Setup: This date represents the past 30 days so that we can check
-				// if the alpha max usage has been exceeded in the past month
-				from := time.Now().AddDate(0, 0, -accounting.AverageDaysInMonth)
-
-				// Execute test: get bandwidth totals for a project, then check if that exceeds the max usage limit
-				bandwidthTotal, err := acctDB.GetAllocatedBandwidthTotal(ctx, bucketID, from)
+				// Execute test: check if the egress of the project in the past month exceeds the default usage limit
+				actualExceeded, limit, err := projectUsage.ExceedsBandwidthUsage(ctx, projectID)
 				require.NoError(t, err)
-				maxAlphaUsage := 25 * memory.GB
-				actualExceeded, actualResource := accounting.ExceedsAlphaUsage(bandwidthTotal, 0, 0, maxAlphaUsage)
 				require.Equal(t, tt.expectedExceeded, actualExceeded)
-				require.Equal(t, tt.expectedResource, actualResource)
+				require.Equal(t, 25*memory.GB, limit)
 
 				// Execute test: check that the uplink gets an error when they have exceeded bandwidth limits and try to download a file
 				_, actualErr := planet.Uplinks[0].Download(ctx, planet.Satellites[0], bucketName, "test/path")
@@ -236,14 +228,56 @@ func TestProjectBandwidthTotal(t *testing.T) {
 		require.NoError(t, err)
 
 		// Execute test: get project bandwidth total
-		bucketID := createBucketID(*projectID, []byte("testbucket"))
 		from := time.Now().AddDate(0, 0, -accounting.AverageDaysInMonth) // past 30 days
-		actualBandwidthTotal, err := pdb.GetAllocatedBandwidthTotal(ctx, bucketID, from)
+		actualBandwidthTotal, err := pdb.GetAllocatedBandwidthTotal(ctx, *projectID, from)
 		require.NoError(t, err)
 		require.Equal(t, actualBandwidthTotal, expectedTotal)
 	})
 }
 
+func TestProjectUsageCustomLimits(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 6, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		projectsDB := planet.Satellites[0].DB.Console().Projects()
+		projectUsage := planet.Satellites[0].Accounting.ProjectUsage
+
+		projects, err := projectsDB.GetAll(ctx)
+		require.NoError(t, err)
+		projectID := projects[0].ID
+
+		// Setup: store tallies which exceed the default limit, but not the custom one
+		err = setUpStorageTallies(ctx, projectID, planet.Satellites[0].DB.ProjectAccounting(), time.Now())
+		require.NoError(t, err)
+
+		exceeded, limit, err := projectUsage.ExceedsStorageUsage(ctx, projectID)
+		require.NoError(t, err)
+		assert.True(t, exceeded)
+		assert.Equal(t, 25*memory.GB, limit)
+
+		err = projectsDB.UpdateUsageLimits(ctx, projectID, 100*memory.GB, 10*memory.GB)
+		require.NoError(t, err)
+
+		storageLimit, egressLimit, err := projectUsage.GetProjectUsageLimits(ctx, projectID)
+		require.NoError(t, err)
+		assert.Equal(t, 100*memory.GB, storageLimit)
+		assert.Equal(t, 10*memory.GB, egressLimit)
+
+		exceeded, limit, err = projectUsage.ExceedsStorageUsage(ctx, projectID)
+		require.NoError(t, err)
+		assert.False(t, exceeded)
+		assert.Equal(t, 100*memory.GB, limit)
+
+		// Execute test: the uplink is able to upload with the raised limit
+		expectedData := make([]byte, 50*memory.KiB)
+		_, err = rand.Read(expectedData)
+		require.NoError(t, err)
+
+		err = planet.Uplinks[0].Upload(ctx, planet.Satellites[0], "testbucket", "test/path", expectedData)
+		require.NoError(t, err)
+	})
+}
+
 func setUpBucketBandwidthAllocations(ctx *testcontext.Context, projectID uuid.UUID, orderDB orders.DB, now time.Time) error {
 
 	// Create many records that sum greater than project usage limit of 25GB
@@ -17,9 +17,10 @@ import (
 
 // Config contains configurable values for rollup
 type Config struct {
-	Interval      time.Duration `help:"how frequently rollup should run" releaseDefault:"24h" devDefault:"120s"`
-	MaxAlphaUsage memory.Size   `help:"the bandwidth and storage usage limit for the alpha release" default:"25GB"`
-	DeleteTallies bool          `help:"option for deleting tallies after they are rolled up" default:"false"`
+	Interval            time.Duration `help:"how frequently rollup should run" releaseDefault:"24h" devDefault:"120s"`
+	DefaultStorageLimit memory.Size   `help:"the default storage usage limit for projects without a custom limit" default:"25GB"`
+	DefaultEgressLimit  memory.Size   `help:"the default monthly egress usage limit for projects without a custom limit" default:"25GB"`
+	DeleteTallies       bool          `help:"option for deleting tallies after they are rolled up" default:"false"`
 }
 
 // Service is the rollup service for totalling data on storage nodes on daily intervals
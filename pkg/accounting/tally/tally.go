@@ -116,9 +116,10 @@ func (t *Service) CalculateAtRestData(ctx context.Context) (latestTally time.Tim
 	var bucketCount int64
 	var totalTallies, currentBucketTally accounting.BucketTally
 
-	err = t.metainfo.Iterate("", "", true, false,
+	err = t.metainfo.Iterate(ctx, "", "", true, false,
 		func(it storage.Iterator) error {
 			var item storage.ListItem
+			now := time.Now()
 			for it.Next(&item) {
 
 				pointer := &pb.Pointer{}
@@ -127,6 +128,11 @@ func (t *Service) CalculateAtRestData(ctx context.Context) (latestTally time.Tim
 					return Error.Wrap(err)
 				}
 
+				// expired objects are no longer stored for the user
+				if metainfo.IsExpired(pointer, now) {
+					continue
+				}
+
 				pathElements := storj.SplitPath(storj.Path(item.Key))
 				// check to make sure there are at least *4* path elements. the first three
 				// are project, segment, and bucket name, but we want to make sure we're talking
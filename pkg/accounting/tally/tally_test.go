@@ -81,7 +81,7 @@ func TestOnlyInline(t *testing.T) {
 			InlineFiles:    1,
 			Bytes:          expectedTotalBytes,
 			InlineBytes:    expectedTotalBytes,
-			MetadataSize:   111, // brittle, this is hardcoded since its too difficult to get this value progamatically
+			MetadataSize:   129, // brittle, this is hardcoded since its too difficult to get this value progamatically
 		}
 
 		// Execute test: upload a file, then calculate at rest data
@@ -133,7 +133,7 @@ func TestCalculateAtRestData(t *testing.T) {
 			RemoteFiles:    1,
 			Bytes:          expectedTotalBytes,
 			RemoteBytes:    expectedTotalBytes,
-			MetadataSize:   112, // brittle, this is hardcoded since its too difficult to get this value progamatically
+			MetadataSize:   130, // brittle, this is hardcoded since its too difficult to get this value progamatically
 		}
 
 		// Execute test: upload a file, then calculate at rest data
@@ -50,7 +50,7 @@ func (cursor *Cursor) NextStripe(ctx context.Context) (stripe *Stripe, more bool
 	var pointerItems []*pb.ListResponse_Item
 	var path storj.Path
 
-	pointerItems, more, err = cursor.metainfo.List("", cursor.lastPath, "", true, 0, meta.None)
+	pointerItems, more, err = cursor.metainfo.List(ctx, "", cursor.lastPath, "", true, 0, meta.None)
 	if err != nil {
 		return nil, more, err
 	}
@@ -62,7 +62,7 @@ func (cursor *Cursor) NextStripe(ctx context.Context) (stripe *Stripe, more bool
 		cursor.lastPath = pointerItems[len(pointerItems)-1].Path
 	}
 
-	pointer, path, err := cursor.getRandomValidPointer(pointerItems)
+	pointer, path, err := cursor.getRandomValidPointer(ctx, pointerItems)
 	if err != nil {
 		return nil, more, err
 	}
@@ -102,7 +102,7 @@ func getRandomStripe(pointer *pb.Pointer) (index int64, err error) {
 }
 
 // getRandomValidPointer attempts to get a random remote pointer from a list. If it sees expired pointers in the process of looking, deletes them
-func (cursor *Cursor) getRandomValidPointer(pointerItems []*pb.ListResponse_Item) (pointer *pb.Pointer, path storj.Path, err error) {
+func (cursor *Cursor) getRandomValidPointer(ctx context.Context, pointerItems []*pb.ListResponse_Item) (pointer *pb.Pointer, path storj.Path, err error) {
 	var src cryptoSource
 	rnd := rand.New(src)
 	errGroup := new(errs.Group)
@@ -113,7 +113,7 @@ func (cursor *Cursor) getRandomValidPointer(pointerItems []*pb.ListResponse_Item
 		path := pointerItem.Path
 
 		// get pointer info
-		pointer, err := cursor.metainfo.Get(path)
+		pointer, err := cursor.metainfo.Get(ctx, path)
 		if err != nil {
 			errGroup.Add(err)
 			continue
@@ -127,7 +127,7 @@ func (cursor *Cursor) getRandomValidPointer(pointerItems []*pb.ListResponse_Item
 				continue
 			}
 			if t.Before(time.Now()) {
-				err := cursor.metainfo.Delete(path)
+				err := cursor.metainfo.Delete(ctx, path)
 				if err != nil {
 					errGroup.Add(err)
 				}
@@ -22,6 +22,7 @@ import (
 	"storj.io/storj/pkg/storage/meta"
 	"storj.io/storj/pkg/storj"
 	"storj.io/storj/satellite/metainfo"
+	"storj.io/storj/storage"
 )
 
 func TestAuditSegment(t *testing.T) {
@@ -37,7 +38,7 @@ func TestAuditSegment(t *testing.T) {
 		// change limit in library to 5 in
 		// list api call, default is  0 == 1000 listing
 		//populate metainfo with 10 non-expired pointers of test data
-		tests, cursor, metainfo := populateTestData(t, planet, &timestamp.Timestamp{Seconds: time.Now().Unix() + 3000})
+		tests, cursor, metainfo := populateTestData(t, ctx, planet, &timestamp.Timestamp{Seconds: time.Now().Unix() + 3000})
 
 		t.Run("NextStripe", func(t *testing.T) {
 			for _, tt := range tests {
@@ -55,7 +56,7 @@ func TestAuditSegment(t *testing.T) {
 
 		// test to see how random paths are
 		t.Run("probabilisticTest", func(t *testing.T) {
-			list, _, err := metainfo.List("", "", "", true, 10, meta.None)
+			list, _, err := metainfo.List(ctx, "", "", "", true, 10, meta.None)
 			require.NoError(t, err)
 			require.Len(t, list, 10)
 
@@ -117,21 +118,27 @@ func TestDeleteExpired(t *testing.T) {
 		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
 	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
 		//populate metainfo with 10 expired pointers of test data
-		_, cursor, metainfo := populateTestData(t, planet, &timestamp.Timestamp{})
-		//make sure it they're in there
-		list, _, err := metainfo.List("", "", "", true, 10, meta.None)
-		require.NoError(t, err)
-		require.Len(t, list, 10)
+		tests, cursor, metainfo := populateTestData(t, ctx, planet, &timestamp.Timestamp{})
+		//make sure it they're in there, listing hides expired pointers
+		for _, tt := range tests {
+			_, err := metainfo.Get(ctx, tt.path)
+			require.NoError(t, err)
+		}
 		// make sure an error and no pointer is returned
 		t.Run("NextStripe", func(t *testing.T) {
 			stripe, _, err := cursor.NextStripe(ctx)
 			require.NoError(t, err)
 			require.Nil(t, stripe)
 		})
-		//make sure it they're not in there anymore
-		list, _, err = metainfo.List("", "", "", true, 10, meta.None)
+		// expired pointers are deleted by the reaper
+		deleted, err := planet.Satellites[0].Metainfo.Reaper.DeleteExpired(ctx, time.Now())
 		require.NoError(t, err)
-		require.Len(t, list, 0)
+		require.Equal(t, int64(len(tests)), deleted)
+		//make sure it they're not in there anymore
+		for _, tt := range tests {
+			_, err := metainfo.Get(ctx, tt.path)
+			require.True(t, storage.ErrKeyNotFound.Has(err))
+		}
 	})
 }
 
@@ -140,7 +147,7 @@ type testData struct {
 	path storj.Path
 }
 
-func populateTestData(t *testing.T, planet *testplanet.Planet, expiration *timestamp.Timestamp) ([]testData, *audit.Cursor, *metainfo.Service) {
+func populateTestData(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet, expiration *timestamp.Timestamp) ([]testData, *audit.Cursor, *metainfo.Service) {
 	tests := []testData{
 		{bm: "success-1", path: "folder1/file1"},
 		{bm: "success-2", path: "foodFolder1/file1/file2"},
@@ -161,7 +168,7 @@ func populateTestData(t *testing.T, planet *testplanet.Planet, expiration *times
 		for _, tt := range tests {
 			t.Run(tt.bm, func(t *testing.T) {
 				pointer := makePointer(tt.path, expiration)
-				require.NoError(t, metainfo.Put(tt.path, pointer))
+				require.NoError(t, metainfo.Put(ctx, tt.path, pointer))
 			})
 		}
 	})
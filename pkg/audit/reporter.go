@@ -47,7 +47,7 @@ func (reporter *Reporter) RecordAudits(ctx context.Context, req *Report) (failed
 
 	retries := 0
 	for retries < reporter.maxRetries {
-		if len(successes) == 0 && len(fails) == 0 && len(offlines) == 0 {
+		if len(successes) == 0 && len(fails) == 0 && len(offlines) == 0 && len(pendingAudits) == 0 {
 			return nil, nil
 		}
 
@@ -94,6 +94,7 @@ func (reporter *Reporter) RecordAudits(ctx context.Context, req *Report) (failed
 }
 
 // recordAuditFailStatus updates nodeIDs in overlay with isup=true, auditsuccess=false
+// and removes them from containment
 func (reporter *Reporter) recordAuditFailStatus(ctx context.Context, failedAuditNodeIDs storj.NodeIDList) (failed storj.NodeIDList, err error) {
 	var errlist errs.Group
 	for _, nodeID := range failedAuditNodeIDs {
@@ -102,6 +103,14 @@ func (reporter *Reporter) recordAuditFailStatus(ctx context.Context, failedAudit
 			IsUp:         true,
 			AuditSuccess: false,
 		})
+		if err != nil {
+			failed = append(failed, nodeID)
+			errlist.Add(err)
+			continue
+		}
+
+		// a finished audit resolves any pending audit of the node
+		_, err = reporter.containment.Delete(ctx, nodeID)
 		if err != nil {
 			failed = append(failed, nodeID)
 			errlist.Add(err)
@@ -130,6 +139,7 @@ func (reporter *Reporter) recordOfflineStatus(ctx context.Context, offlineNodeID
 }
 
 // recordAuditSuccessStatus updates nodeIDs in overlay with isup=true, auditsuccess=true
+// and removes them from containment
 func (reporter *Reporter) recordAuditSuccessStatus(ctx context.Context, successNodeIDs storj.NodeIDList) (failed storj.NodeIDList, err error) {
 	var errlist errs.Group
 	for _, nodeID := range successNodeIDs {
@@ -138,6 +148,14 @@ func (reporter *Reporter) recordAuditSuccessStatus(ctx context.Context, successN
 			IsUp:         true,
 			AuditSuccess: true,
 		})
+		if err != nil {
+			failed = append(failed, nodeID)
+			errlist.Add(err)
+			continue
+		}
+
+		// a finished audit resolves any pending audit of the node
+		_, err = reporter.containment.Delete(ctx, nodeID)
 		if err != nil {
 			failed = append(failed, nodeID)
 			errlist.Add(err)
@@ -0,0 +1,173 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package audit_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/pkg/audit"
+	"storj.io/storj/pkg/pkcrypto"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/satellite"
+	"storj.io/storj/uplink"
+)
+
+func TestReverifySuccess(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite, stripe := uploadStripe(t, ctx, planet)
+
+		verifier := satellite.Audit.Service.Verifier
+		containment := satellite.DB.Containment()
+
+		pointer := stripe.Segment
+		comps := storj.SplitPath(stripe.SegmentPath)
+		bucketID := []byte(storj.JoinPaths(comps[0], comps[2]))
+		limits, err := satellite.Orders.Service.CreateAuditOrderLimits(ctx, satellite.Identity.PeerIdentity(), bucketID, pointer, nil)
+		require.NoError(t, err)
+
+		pending := pendingAudit(stripe)
+		shares, _, err := verifier.DownloadShares(ctx, limits, stripe.Index, pending.ShareSize)
+		require.NoError(t, err)
+		share := shares[int(pending.PieceNum)]
+		require.NoError(t, share.Error)
+
+		pending.ExpectedShareHash = pkcrypto.SHA256Hash(share.Data)
+		err = containment.IncrementPending(ctx, pending)
+		require.NoError(t, err)
+
+		report, err := verifier.Reverify(ctx, stripe)
+		require.NoError(t, err)
+		require.Len(t, report.Successes, 1)
+		require.Equal(t, pending.NodeID, report.Successes[0])
+		require.Len(t, report.Fails, 0)
+		require.Len(t, report.PendingAudits, 0)
+
+		// recording the successful reverification removes the node from containment
+		reporter := audit.NewReporter(satellite.Overlay.Service, containment, 1)
+		_, err = reporter.RecordAudits(ctx, report)
+		require.NoError(t, err)
+
+		_, err = containment.Get(ctx, pending.NodeID)
+		require.True(t, audit.ErrContainedNotFound.Has(err))
+	})
+}
+
+func TestReverifyFailBadHash(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite, stripe := uploadStripe(t, ctx, planet)
+
+		pending := pendingAudit(stripe)
+		err := satellite.DB.Containment().IncrementPending(ctx, pending)
+		require.NoError(t, err)
+
+		report, err := satellite.Audit.Service.Verifier.Reverify(ctx, stripe)
+		require.NoError(t, err)
+		require.Len(t, report.Successes, 0)
+		require.Len(t, report.Fails, 1)
+		require.Equal(t, pending.NodeID, report.Fails[0])
+	})
+}
+
+func TestReverifyFailMissingPiece(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite, stripe := uploadStripe(t, ctx, planet)
+
+		pending := pendingAudit(stripe)
+		err := satellite.DB.Containment().IncrementPending(ctx, pending)
+		require.NoError(t, err)
+
+		// the node lost the piece, which fails the audit without waiting for further reverifications
+		for _, node := range planet.StorageNodes {
+			if node.ID() == pending.NodeID {
+				err = node.Storage2.Store.Delete(ctx, satellite.ID(), pending.PieceID.Derive(pending.NodeID))
+				require.NoError(t, err)
+			}
+		}
+
+		report, err := satellite.Audit.Service.Verifier.Reverify(ctx, stripe)
+		require.NoError(t, err)
+		require.Len(t, report.Successes, 0)
+		require.Len(t, report.PendingAudits, 0)
+		require.Len(t, report.Fails, 1)
+		require.Equal(t, pending.NodeID, report.Fails[0])
+	})
+}
+
+func TestReverifyDeletedSegment(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite, stripe := uploadStripe(t, ctx, planet)
+		containment := satellite.DB.Containment()
+
+		// the pending audit refers to a segment that no longer exists
+		pending := pendingAudit(stripe)
+		pending.Path = stripe.SegmentPath + "/deleted"
+		err := containment.IncrementPending(ctx, pending)
+		require.NoError(t, err)
+
+		report, err := satellite.Audit.Service.Verifier.Reverify(ctx, stripe)
+		require.NoError(t, err)
+		require.Len(t, report.Successes, 0)
+		require.Len(t, report.Fails, 0)
+
+		_, err = containment.Get(ctx, pending.NodeID)
+		require.True(t, audit.ErrContainedNotFound.Has(err))
+	})
+}
+
+// uploadStripe uploads random data with the audit service stopped and returns the first stripe to audit
+func uploadStripe(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) (*satellite.Peer, *audit.Stripe) {
+	satellite := planet.Satellites[0]
+	err := satellite.Audit.Service.Close()
+	require.NoError(t, err)
+
+	testData := make([]byte, 1*memory.MiB)
+	_, err = rand.Read(testData)
+	require.NoError(t, err)
+
+	err = planet.Uplinks[0].UploadWithConfig(ctx, satellite, &uplink.RSConfig{
+		MinThreshold:     2,
+		RepairThreshold:  3,
+		SuccessThreshold: 4,
+		MaxThreshold:     4,
+	}, "testbucket", "test/path", testData)
+	require.NoError(t, err)
+
+	cursor := audit.NewCursor(satellite.Metainfo.Service)
+	stripe, _, err := cursor.NextStripe(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, stripe)
+
+	return satellite, stripe
+}
+
+// pendingAudit creates a pending audit of the first piece of the stripe that expects an empty share
+func pendingAudit(stripe *audit.Stripe) *audit.PendingAudit {
+	pointer := stripe.Segment
+	piece := pointer.GetRemote().GetRemotePieces()[0]
+
+	return &audit.PendingAudit{
+		NodeID:            piece.NodeId,
+		PieceID:           pointer.GetRemote().RootPieceId,
+		StripeIndex:       stripe.Index,
+		ShareSize:         pointer.GetRemote().GetRedundancy().GetErasureShareSize(),
+		ExpectedShareHash: pkcrypto.SHA256Hash(nil),
+		ReverifyCount:     0,
+		PieceNum:          piece.PieceNum,
+		Path:              stripe.SegmentPath,
+	}
+}
@@ -0,0 +1,409 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package audit
+
+import (
+	"context"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"go.uber.org/zap"
+
+	"storj.io/storj/pkg/overlay"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/satellite/metainfo"
+	"storj.io/storj/storage"
+)
+
+// Scheduler picks the stripes to audit, so that every node is audited at a target rate.
+//
+// Nodes which haven't been audited within their target interval are due for an audit.
+// The scheduler periodically scans metainfo to pick a random segment for every due node,
+// new nodes first. When no node is due, it falls back to random stripes from the cursor.
+//
+// The segments of the pending audits of contained nodes are scheduled ahead of all other segments,
+// so that contained nodes are reverified even when their segments aren't picked otherwise.
+//
+// The times of the last audits are stored with the nodes in the overlay when the audits are recorded,
+// so they survive restarts. Audits which left the node contained are only tracked in memory.
+type Scheduler struct {
+	log         *zap.Logger
+	metainfo    *metainfo.Service
+	overlay     *overlay.Cache
+	containment Containment
+	cursor      *Cursor
+
+	nodeInterval    time.Duration
+	newNodeInterval time.Duration
+
+	mu         sync.Mutex
+	lastAudit  map[storj.NodeID]time.Time
+	queue      []scheduled
+	refilledAt time.Time
+}
+
+// NewScheduler creates a Scheduler
+func NewScheduler(log *zap.Logger, metainfo *metainfo.Service, overlay *overlay.Cache, containment Containment, cursor *Cursor, nodeInterval, newNodeInterval time.Duration) *Scheduler {
+	return &Scheduler{
+		log:             log,
+		metainfo:        metainfo,
+		overlay:         overlay,
+		containment:     containment,
+		cursor:          cursor,
+		nodeInterval:    nodeInterval,
+		newNodeInterval: newNodeInterval,
+		lastAudit:       make(map[storj.NodeID]time.Time),
+	}
+}
+
+// NextStripe returns the next stripe to be audited, nil when there is nothing to audit
+func (scheduler *Scheduler) NextStripe(ctx context.Context) (stripe *Stripe, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = scheduler.refillIfEmpty(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		next, ok := scheduler.dequeue()
+		if !ok {
+			break
+		}
+
+		pointer, err := scheduler.metainfo.Get(ctx, next.path)
+		if err != nil {
+			if storage.ErrKeyNotFound.Has(err) {
+				// the segment has been deleted since the queue was filled
+				if next.pending != nil {
+					// nothing is left to reverify, so the node is released from containment
+					_, err = scheduler.containment.Delete(ctx, next.pending.NodeID)
+					if err != nil {
+						return nil, err
+					}
+				}
+				continue
+			}
+			return nil, err
+		}
+		if pointer.GetType() != pb.Pointer_REMOTE || pointer.GetSegmentSize() == 0 {
+			continue
+		}
+
+		if next.pending != nil {
+			if pointer.GetRemote().RootPieceId != next.pending.PieceID || !holdsPiece(pointer, next.pending.NodeID, next.pending.PieceNum) {
+				// the segment has been replaced or the piece has been repaired away from the node
+				_, err = scheduler.containment.Delete(ctx, next.pending.NodeID)
+				if err != nil {
+					return nil, err
+				}
+				continue
+			}
+
+			// the pending stripe is audited, so that the other nodes are verified on the same stripe
+			return &Stripe{
+				Index:       next.pending.StripeIndex,
+				Segment:     pointer,
+				SegmentPath: next.path,
+			}, nil
+		}
+
+		index, err := getRandomStripe(pointer)
+		if err != nil {
+			return nil, err
+		}
+
+		return &Stripe{
+			Index:       index,
+			Segment:     pointer,
+			SegmentPath: next.path,
+		}, nil
+	}
+
+	for {
+		stripe, more, err := scheduler.cursor.NextStripe(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if stripe != nil {
+			return stripe, nil
+		}
+		if !more {
+			return nil, nil
+		}
+	}
+}
+
+// Audited records that the nodes in the report have been audited
+func (scheduler *Scheduler) Audited(report *Report) {
+	if report == nil {
+		return
+	}
+
+	now := time.Now()
+
+	scheduler.mu.Lock()
+	defer scheduler.mu.Unlock()
+
+	audited := func(nodeID storj.NodeID) {
+		mon.Meter("node_audits").Mark(1)
+		if last, ok := scheduler.lastAudit[nodeID]; ok {
+			mon.IntVal("node_audit_interval_seconds").Observe(int64(now.Sub(last) / time.Second))
+		}
+		scheduler.lastAudit[nodeID] = now
+	}
+
+	for _, nodeID := range report.Successes {
+		audited(nodeID)
+	}
+	for _, nodeID := range report.Fails {
+		audited(nodeID)
+	}
+	for _, pending := range report.PendingAudits {
+		audited(pending.NodeID)
+	}
+}
+
+// LastAudit returns when the node has last been audited by this scheduler since it started
+func (scheduler *Scheduler) LastAudit(nodeID storj.NodeID) (last time.Time, ok bool) {
+	scheduler.mu.Lock()
+	defer scheduler.mu.Unlock()
+
+	last, ok = scheduler.lastAudit[nodeID]
+	return last, ok
+}
+
+// candidate is a segment picked for auditing a node
+type candidate struct {
+	nodeID    storj.NodeID
+	isNew     bool
+	due       bool
+	lastAudit time.Time
+	segments  int64
+	path      storj.Path
+	pending   *PendingAudit
+}
+
+// scheduled is a segment in the queue, pending is set when the segment is scheduled for reverifying a contained node
+type scheduled struct {
+	path    storj.Path
+	pending *PendingAudit
+}
+
+// dequeue removes the first segment from the queue
+func (scheduler *Scheduler) dequeue() (next scheduled, ok bool) {
+	scheduler.mu.Lock()
+	defer scheduler.mu.Unlock()
+
+	if len(scheduler.queue) == 0 {
+		return scheduled{}, false
+	}
+
+	next = scheduler.queue[0]
+	scheduler.queue = scheduler.queue[1:]
+	return next, true
+}
+
+// refillIfEmpty refills the queue when it is empty and hasn't been refilled recently,
+// metainfo is scanned without holding the lock, so that the other workers aren't blocked
+func (scheduler *Scheduler) refillIfEmpty(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	scheduler.mu.Lock()
+	if len(scheduler.queue) > 0 || time.Since(scheduler.refilledAt) < scheduler.newNodeInterval {
+		scheduler.mu.Unlock()
+		return nil
+	}
+	// the other workers don't start a refill of their own while this one is running
+	scheduler.refilledAt = time.Now()
+
+	lastAudit := make(map[storj.NodeID]time.Time, len(scheduler.lastAudit))
+	for nodeID, last := range scheduler.lastAudit {
+		lastAudit[nodeID] = last
+	}
+	scheduler.mu.Unlock()
+
+	queue, err := scheduler.fill(ctx, lastAudit)
+	if err != nil {
+		return err
+	}
+
+	scheduler.mu.Lock()
+	scheduler.queue = append(scheduler.queue, queue...)
+	scheduler.mu.Unlock()
+
+	return nil
+}
+
+// fill scans metainfo and returns the segments of the pending audits of contained nodes,
+// followed by a random segment for every node that is due for an audit
+func (scheduler *Scheduler) fill(ctx context.Context, lastAudit map[storj.NodeID]time.Time) (queue []scheduled, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// nodes caches the candidates of the nodes, nil means the node can't be audited
+	nodes := make(map[storj.NodeID]*candidate)
+
+	err = scheduler.metainfo.Iterate(ctx, "", "", true, false,
+		func(it storage.Iterator) error {
+			var item storage.ListItem
+			for it.Next(&item) {
+				pointer := &pb.Pointer{}
+				err := proto.Unmarshal(item.Value, pointer)
+				if err != nil {
+					return Error.Wrap(err)
+				}
+
+				if pointer.GetType() != pb.Pointer_REMOTE || pointer.GetSegmentSize() == 0 {
+					continue
+				}
+				if expiration := pointer.GetExpirationDate(); expiration != nil {
+					t, err := ptypes.Timestamp(expiration)
+					if err == nil && t.Before(time.Now()) {
+						continue
+					}
+				}
+
+				for _, piece := range pointer.GetRemote().GetRemotePieces() {
+					c, known := nodes[piece.NodeId]
+					if !known {
+						c = scheduler.nodeCandidate(ctx, piece.NodeId, lastAudit)
+						nodes[piece.NodeId] = c
+					}
+					if c == nil || !c.due {
+						continue
+					}
+
+					// reservoir sampling, so that every segment of the node is equally likely to be picked
+					c.segments++
+					if rand.Int63n(c.segments) == 0 {
+						c.path = item.Key.String()
+					}
+				}
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var candidates []*candidate
+	var contained []*candidate
+	var oldest *candidate
+	var overdue int64
+	for _, c := range nodes {
+		if c == nil {
+			continue
+		}
+		if c.pending != nil {
+			contained = append(contained, c)
+		}
+		if !c.lastAudit.IsZero() {
+			if oldest == nil || c.lastAudit.Before(oldest.lastAudit) {
+				oldest = c
+			}
+			if c.due {
+				overdue++
+			}
+		}
+		if c.due {
+			candidates = append(candidates, c)
+		}
+	}
+
+	// new nodes first, then the nodes which have waited the longest
+	sort.Slice(candidates, func(i, k int) bool {
+		if candidates[i].isNew != candidates[k].isNew {
+			return candidates[i].isNew
+		}
+		return candidates[i].lastAudit.Before(candidates[k].lastAudit)
+	})
+
+	// contained nodes first, the nodes which have been waiting for a reverify the longest come first
+	sort.Slice(contained, func(i, k int) bool {
+		return contained[i].lastAudit.Before(contained[k].lastAudit)
+	})
+
+	queued := make(map[storj.Path]bool)
+	for _, c := range contained {
+		queued[c.pending.Path] = true
+		queue = append(queue, scheduled{path: c.pending.Path, pending: c.pending})
+	}
+
+	for _, c := range candidates {
+		if queued[c.path] {
+			continue
+		}
+		queued[c.path] = true
+		queue = append(queue, scheduled{path: c.path})
+	}
+
+	mon.IntVal("contained_nodes").Observe(int64(len(contained)))
+	mon.IntVal("due_nodes").Observe(int64(len(candidates)))
+	mon.IntVal("overdue_nodes").Observe(overdue)
+	mon.IntVal("scheduled_segments").Observe(int64(len(queue)))
+	if oldest != nil {
+		age := time.Since(oldest.lastAudit)
+		mon.IntVal("oldest_audit_age_seconds").Observe(int64(age / time.Second))
+		if oldest.due {
+			scheduler.log.Info("longest unaudited node",
+				zap.String("Node ID", oldest.nodeID.String()),
+				zap.Duration("Since last audit", age),
+				zap.Int64("Overdue nodes", overdue),
+			)
+		}
+	}
+
+	return queue, nil
+}
+
+// nodeCandidate returns the candidate of the node, which is due when the node hasn't been audited within its interval,
+// the pending audit of a contained node is included in the candidate. It returns nil when the node is unknown or disqualified
+func (scheduler *Scheduler) nodeCandidate(ctx context.Context, nodeID storj.NodeID, lastAudits map[storj.NodeID]time.Time) *candidate {
+	node, err := scheduler.overlay.Get(ctx, nodeID)
+	if err != nil {
+		scheduler.log.Debug("unable to get node for scheduling", zap.String("Node ID", nodeID.String()), zap.Error(err))
+		return nil
+	}
+	if node.Disqualified != nil {
+		return nil
+	}
+
+	isNew := scheduler.overlay.IsNew(node)
+	interval := scheduler.nodeInterval
+	if isNew {
+		interval = scheduler.newNodeInterval
+	}
+
+	// audits which left the node contained aren't recorded in the overlay
+	lastAudit := lastAudits[nodeID]
+	if recorded := node.Reputation.LastAudited; recorded != nil && recorded.After(lastAudit) {
+		lastAudit = *recorded
+	}
+
+	c := &candidate{
+		nodeID:    nodeID,
+		isNew:     isNew,
+		due:       lastAudit.IsZero() || time.Since(lastAudit) >= interval,
+		lastAudit: lastAudit,
+	}
+
+	if node.Contained {
+		pending, err := scheduler.containment.Get(ctx, nodeID)
+		if err != nil {
+			if !ErrContainedNotFound.Has(err) {
+				scheduler.log.Debug("unable to get pending audit for scheduling", zap.String("Node ID", nodeID.String()), zap.Error(err))
+			}
+			return c
+		}
+		c.pending = pending
+	}
+
+	return c
+}
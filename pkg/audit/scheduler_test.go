@@ -0,0 +1,199 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package audit_test
+
+import (
+	"math/rand"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/pkg/audit"
+	"storj.io/storj/pkg/overlay"
+	"storj.io/storj/pkg/pkcrypto"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/satellite/metainfo"
+	"storj.io/storj/storage/teststore"
+	"storj.io/storj/uplink"
+)
+
+func TestSchedulerAuditsEveryNode(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 8, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		err := satellite.Audit.Service.Close()
+		require.NoError(t, err)
+
+		for i := 0; i < 5; i++ {
+			testData := make([]byte, 1*memory.MiB)
+			_, err = rand.Read(testData)
+			require.NoError(t, err)
+
+			err = planet.Uplinks[0].UploadWithConfig(ctx, satellite, &uplink.RSConfig{
+				MinThreshold:     2,
+				RepairThreshold:  3,
+				SuccessThreshold: 4,
+				MaxThreshold:     4,
+			}, "testbucket", "test/path"+strconv.Itoa(i), testData)
+			require.NoError(t, err)
+		}
+
+		metainfo := satellite.Metainfo.Service
+		scheduler := audit.NewScheduler(zap.L(), metainfo, satellite.Overlay.Service, satellite.DB.Containment(), audit.NewCursor(metainfo), time.Hour, time.Hour)
+
+		// collect all nodes holding pieces
+		holders := make(map[storj.NodeID]bool)
+		items, _, err := metainfo.List(ctx, "", "", "", true, 0, 0)
+		require.NoError(t, err)
+		for _, item := range items {
+			pointer, err := metainfo.Get(ctx, item.Path)
+			require.NoError(t, err)
+			for _, piece := range pointer.GetRemote().GetRemotePieces() {
+				holders[piece.NodeId] = true
+			}
+		}
+
+		// every scheduled stripe covers at least one node which hasn't been audited yet,
+		// so all nodes are audited after at most one stripe per node
+		for i := 0; i < len(holders); i++ {
+			stripe, err := scheduler.NextStripe(ctx)
+			require.NoError(t, err)
+			require.NotNil(t, stripe)
+
+			report := &audit.Report{}
+			for _, piece := range stripe.Segment.GetRemote().GetRemotePieces() {
+				report.Successes = append(report.Successes, piece.NodeId)
+			}
+			scheduler.Audited(report)
+		}
+
+		for nodeID := range holders {
+			_, ok := scheduler.LastAudit(nodeID)
+			require.True(t, ok, nodeID.String())
+		}
+	})
+}
+
+func TestSchedulerUsesRecordedAudits(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		err := satellite.Audit.Service.Close()
+		require.NoError(t, err)
+
+		testData := make([]byte, 1*memory.MiB)
+		_, err = rand.Read(testData)
+		require.NoError(t, err)
+
+		err = planet.Uplinks[0].UploadWithConfig(ctx, satellite, &uplink.RSConfig{
+			MinThreshold:     2,
+			RepairThreshold:  3,
+			SuccessThreshold: 4,
+			MaxThreshold:     4,
+		}, "testbucket", "test/path", testData)
+		require.NoError(t, err)
+
+		// the cursor doesn't find any stripes, so only due nodes are scheduled
+		emptyCursor := audit.NewCursor(metainfo.NewService(zap.L(), teststore.New(), nil))
+		newScheduler := func() *audit.Scheduler {
+			return audit.NewScheduler(zap.L(), satellite.Metainfo.Service, satellite.Overlay.Service, satellite.DB.Containment(), emptyCursor, time.Hour, time.Hour)
+		}
+
+		stripe, err := newScheduler().NextStripe(ctx)
+		require.NoError(t, err)
+		require.NotNil(t, stripe)
+
+		for _, piece := range stripe.Segment.GetRemote().GetRemotePieces() {
+			_, err := satellite.Overlay.Service.UpdateStats(ctx, &overlay.UpdateRequest{
+				NodeID:       piece.NodeId,
+				IsUp:         true,
+				AuditSuccess: true,
+			})
+			require.NoError(t, err)
+
+			node, err := satellite.Overlay.Service.Get(ctx, piece.NodeId)
+			require.NoError(t, err)
+			require.NotNil(t, node.Reputation.LastAudited)
+		}
+
+		// a restarted scheduler knows about the recorded audits
+		stripe, err = newScheduler().NextStripe(ctx)
+		require.NoError(t, err)
+		require.Nil(t, stripe)
+	})
+}
+
+func TestSchedulerReverifiesContainedNodes(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		err := satellite.Audit.Service.Close()
+		require.NoError(t, err)
+
+		testData := make([]byte, 1*memory.MiB)
+		_, err = rand.Read(testData)
+		require.NoError(t, err)
+
+		err = planet.Uplinks[0].UploadWithConfig(ctx, satellite, &uplink.RSConfig{
+			MinThreshold:     2,
+			RepairThreshold:  3,
+			SuccessThreshold: 4,
+			MaxThreshold:     4,
+		}, "testbucket", "test/path", testData)
+		require.NoError(t, err)
+
+		// the cursor doesn't find any stripes, so only due and contained nodes are scheduled
+		emptyCursor := audit.NewCursor(metainfo.NewService(zap.L(), teststore.New(), nil))
+		newScheduler := func() *audit.Scheduler {
+			return audit.NewScheduler(zap.L(), satellite.Metainfo.Service, satellite.Overlay.Service, satellite.DB.Containment(), emptyCursor, time.Hour, time.Hour)
+		}
+
+		stripe, err := newScheduler().NextStripe(ctx)
+		require.NoError(t, err)
+		require.NotNil(t, stripe)
+
+		// none of the nodes is due, so the segment isn't picked anymore
+		for _, piece := range stripe.Segment.GetRemote().GetRemotePieces() {
+			_, err := satellite.Overlay.Service.UpdateStats(ctx, &overlay.UpdateRequest{
+				NodeID:       piece.NodeId,
+				IsUp:         true,
+				AuditSuccess: true,
+			})
+			require.NoError(t, err)
+		}
+
+		next, err := newScheduler().NextStripe(ctx)
+		require.NoError(t, err)
+		require.Nil(t, next)
+
+		piece := stripe.Segment.GetRemote().GetRemotePieces()[0]
+		pending := &audit.PendingAudit{
+			NodeID:            piece.NodeId,
+			PieceID:           stripe.Segment.GetRemote().RootPieceId,
+			StripeIndex:       stripe.Index,
+			ShareSize:         stripe.Segment.GetRemote().GetRedundancy().GetErasureShareSize(),
+			ExpectedShareHash: pkcrypto.SHA256Hash(nil),
+			PieceNum:          piece.PieceNum,
+			Path:              stripe.SegmentPath,
+		}
+		err = satellite.DB.Containment().IncrementPending(ctx, pending)
+		require.NoError(t, err)
+
+		// the segment of the pending audit is scheduled for the contained node
+		next, err = newScheduler().NextStripe(ctx)
+		require.NoError(t, err)
+		require.NotNil(t, next)
+		require.Equal(t, pending.Path, next.SegmentPath)
+		require.Equal(t, pending.StripeIndex, next.Index)
+	})
+}
@@ -14,6 +14,7 @@ import (
 	"storj.io/storj/internal/sync2"
 	"storj.io/storj/pkg/identity"
 	"storj.io/storj/pkg/overlay"
+	"storj.io/storj/pkg/storj"
 	"storj.io/storj/pkg/transport"
 	"storj.io/storj/satellite/metainfo"
 	"storj.io/storj/satellite/orders"
@@ -24,34 +25,50 @@ var Error = errs.Class("audit error")
 
 // Config contains configurable values for audit service
 type Config struct {
-	MaxRetriesStatDB  int           `help:"max number of times to attempt updating a statdb batch" default:"3"`
-	Interval          time.Duration `help:"how frequently segments are audited" default:"30s"`
-	MinBytesPerSecond memory.Size   `help:"the minimum acceptable bytes that storage nodes can transfer per second to the satellite" default:"128B"`
+	MaxRetriesStatDB     int           `help:"max number of times to attempt updating a statdb batch" default:"3"`
+	Interval             time.Duration `help:"how frequently segments are audited" default:"30s"`
+	MinBytesPerSecond    memory.Size   `help:"the minimum acceptable bytes that storage nodes can transfer per second to the satellite" default:"128B"`
+	MaxReverifyCount     int           `help:"limit above which we consider an audit is failed" default:"3"`
+	Workers              int           `help:"number of stripes that are audited concurrently" default:"2"`
+	NodeAuditInterval    time.Duration `help:"target interval between audits of a vetted node" default:"6h"`
+	NewNodeAuditInterval time.Duration `help:"target interval between audits of a node that is still being vetted" default:"10m"`
 }
 
-// Service helps coordinate Cursor and Verifier to run the audit process continuously
+// Service helps coordinate Scheduler and Verifier to run the audit process continuously
 type Service struct {
-	log *zap.Logger
+	log     *zap.Logger
+	workers int
 
-	Cursor   *Cursor
-	Verifier *Verifier
-	Reporter reporter
+	Cursor    *Cursor
+	Scheduler *Scheduler
+	Verifier  *Verifier
+	Reporter  reporter
 
-	Loop sync2.Cycle
+	Limiter *sync2.Limiter
+	Loop    sync2.Cycle
 }
 
 // NewService instantiates a Service with access to a Cursor and Verifier
 func NewService(log *zap.Logger, config Config, metainfo *metainfo.Service,
 	orders *orders.Service, transport transport.Client, overlay *overlay.Cache,
 	containment Containment, identity *identity.FullIdentity) (service *Service, err error) {
+	workers := config.Workers
+	if workers <= 0 {
+		workers = 1
+	}
+
+	cursor := NewCursor(metainfo)
 	return &Service{
-		log: log,
+		log:     log,
+		workers: workers,
 
-		Cursor:   NewCursor(metainfo),
-		Verifier: NewVerifier(log.Named("audit:verifier"), transport, overlay, orders, identity, config.MinBytesPerSecond),
-		Reporter: NewReporter(overlay, containment, config.MaxRetriesStatDB),
+		Cursor:    cursor,
+		Scheduler: NewScheduler(log.Named("audit:scheduler"), metainfo, overlay, containment, cursor, config.NodeAuditInterval, config.NewNodeAuditInterval),
+		Verifier:  NewVerifier(log.Named("audit:verifier"), transport, overlay, metainfo, containment, orders, identity, config.MinBytesPerSecond, int32(config.MaxReverifyCount)),
+		Reporter:  NewReporter(overlay, containment, config.MaxRetriesStatDB),
 
-		Loop: *sync2.NewCycle(config.Interval),
+		Limiter: sync2.NewLimiter(workers),
+		Loop:    *sync2.NewCycle(config.Interval),
 	}, nil
 }
 
@@ -60,6 +77,9 @@ func (service *Service) Run(ctx context.Context) (err error) {
 	defer mon.Task()(&ctx)(&err)
 	service.log.Info("Audit cron is starting up")
 
+	// wait for all audits to complete
+	defer service.Limiter.Wait()
+
 	return service.Loop.Run(ctx, func(ctx context.Context) error {
 		err := service.process(ctx)
 		if err != nil {
@@ -75,27 +95,69 @@ func (service *Service) Close() error {
 	return nil
 }
 
-// process picks a random stripe and verifies correctness
+// process picks a stripe for every worker and audits them concurrently
 func (service *Service) process(ctx context.Context) error {
-	var stripe *Stripe
-	for {
-		s, more, err := service.Cursor.NextStripe(ctx)
+	for i := 0; i < service.workers; i++ {
+		stripe, err := service.Scheduler.NextStripe(ctx)
 		if err != nil {
 			return err
 		}
-		if s != nil {
-			stripe = s
-			break
-		}
-		if !more {
+		if stripe == nil {
 			return nil
 		}
+
+		started := service.Limiter.Go(ctx, func() {
+			err := service.audit(ctx, stripe)
+			if err != nil {
+				service.log.Error("audit", zap.Error(err))
+			}
+		})
+		if !started {
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+// audit reverifies the contained nodes of the stripe and verifies the correctness of the remaining ones
+func (service *Service) audit(ctx context.Context, stripe *Stripe) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// contained nodes are reverified first, so that they are not audited twice for the same stripe
+	reverifiedNodes, reverifyErr := service.Verifier.Reverify(ctx, stripe)
+	if reverifyErr != nil {
+		service.log.Error("reverify", zap.Error(reverifyErr))
+	}
+
+	skip := make(map[storj.NodeID]bool)
+	if reverifiedNodes != nil {
+		for _, nodeID := range reverifiedNodes.Successes {
+			skip[nodeID] = true
+		}
+		for _, nodeID := range reverifiedNodes.Fails {
+			skip[nodeID] = true
+		}
+		for _, nodeID := range reverifiedNodes.Offlines {
+			skip[nodeID] = true
+		}
+		for _, pending := range reverifiedNodes.PendingAudits {
+			skip[pending.NodeID] = true
+		}
+
+		service.Scheduler.Audited(reverifiedNodes)
+
+		// TODO(moby) we need to decide if we want to do something with nodes that the reporter failed to update
+		_, reporterErr := service.Reporter.RecordAudits(ctx, reverifiedNodes)
+		if reporterErr != nil {
+			service.log.Error("record reverify results", zap.Error(reporterErr))
+		}
 	}
 
-	verifiedNodes, verifierErr := service.Verifier.Verify(ctx, stripe)
+	verifiedNodes, verifierErr := service.Verifier.Verify(ctx, stripe, skip)
 	if verifierErr != nil && verifiedNodes == nil {
 		return verifierErr
 	}
+	service.Scheduler.Audited(verifiedNodes)
 
 	// TODO(moby) we need to decide if we want to do something with nodes that the reporter failed to update
 	_, reporterErr := service.Reporter.RecordAudits(ctx, verifiedNodes)
@@ -12,6 +12,8 @@ import (
 	"github.com/vivint/infectious"
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	monkit "gopkg.in/spacemonkeygo/monkit.v2"
 
 	"storj.io/storj/internal/memory"
@@ -22,7 +24,9 @@ import (
 	"storj.io/storj/pkg/pkcrypto"
 	"storj.io/storj/pkg/storj"
 	"storj.io/storj/pkg/transport"
+	"storj.io/storj/satellite/metainfo"
 	"storj.io/storj/satellite/orders"
+	"storj.io/storj/storage"
 	"storj.io/storj/uplink/piecestore"
 )
 
@@ -44,23 +48,27 @@ type Verifier struct {
 	auditor           *identity.PeerIdentity
 	transport         transport.Client
 	overlay           *overlay.Cache
+	metainfo          *metainfo.Service
+	containment       Containment
 	minBytesPerSecond memory.Size
+	maxReverifyCount  int32
 }
 
 // NewVerifier creates a Verifier
-func NewVerifier(log *zap.Logger, transport transport.Client, overlay *overlay.Cache, orders *orders.Service, id *identity.FullIdentity, minBytesPerSecond memory.Size) *Verifier {
-	return &Verifier{log: log, orders: orders, auditor: id.PeerIdentity(), transport: transport, overlay: overlay, minBytesPerSecond: minBytesPerSecond}
+func NewVerifier(log *zap.Logger, transport transport.Client, overlay *overlay.Cache, metainfo *metainfo.Service, containment Containment, orders *orders.Service, id *identity.FullIdentity, minBytesPerSecond memory.Size, maxReverifyCount int32) *Verifier {
+	return &Verifier{log: log, orders: orders, auditor: id.PeerIdentity(), transport: transport, overlay: overlay, metainfo: metainfo, containment: containment, minBytesPerSecond: minBytesPerSecond, maxReverifyCount: maxReverifyCount}
 }
 
-// Verify downloads shares then verifies the data correctness at the given stripe
-func (verifier *Verifier) Verify(ctx context.Context, stripe *Stripe) (verifiedNodes *Report, err error) {
+// Verify downloads shares then verifies the data correctness at the given stripe.
+// Nodes in skip are not audited, e.g. because they have just been reverified.
+func (verifier *Verifier) Verify(ctx context.Context, stripe *Stripe, skip map[storj.NodeID]bool) (verifiedNodes *Report, err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	pointer := stripe.Segment
 	shareSize := pointer.GetRemote().GetRedundancy().GetErasureShareSize()
 	bucketID := createBucketID(stripe.SegmentPath)
 
-	orderLimits, err := verifier.orders.CreateAuditOrderLimits(ctx, verifier.auditor, bucketID, pointer)
+	orderLimits, err := verifier.orders.CreateAuditOrderLimits(ctx, verifier.auditor, bucketID, pointer, skip)
 	if err != nil {
 		return nil, err
 	}
@@ -127,6 +135,100 @@ func (verifier *Verifier) Verify(ctx context.Context, stripe *Stripe) (verifiedN
 	}, nil
 }
 
+// Reverify reaudits the contained nodes holding pieces of the given stripe. Each contained
+// node is asked for exactly the share recorded in its pending audit, which is then compared
+// against the expected share hash.
+func (verifier *Verifier) Reverify(ctx context.Context, stripe *Stripe) (report *Report, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	report = &Report{}
+	var errlist errs.Group
+
+	for _, piece := range stripe.Segment.GetRemote().GetRemotePieces() {
+		pending, err := verifier.containment.Get(ctx, piece.NodeId)
+		if err != nil {
+			if !ErrContainedNotFound.Has(err) {
+				errlist.Add(err)
+			}
+			continue
+		}
+
+		err = verifier.reverifyPending(ctx, pending, report)
+		if err != nil {
+			errlist.Add(err)
+		}
+	}
+
+	return report, errlist.Err()
+}
+
+// reverifyPending reaudits a single pending audit and adds the outcome to report
+func (verifier *Verifier) reverifyPending(ctx context.Context, pending *PendingAudit, report *Report) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	pointer, err := verifier.metainfo.Get(ctx, pending.Path)
+	if err != nil {
+		if storage.ErrKeyNotFound.Has(err) {
+			// the segment has been deleted, so there is nothing left to reverify
+			_, err = verifier.containment.Delete(ctx, pending.NodeID)
+			return err
+		}
+		return Error.Wrap(err)
+	}
+
+	if pointer.GetRemote().RootPieceId != pending.PieceID || !holdsPiece(pointer, pending.NodeID, pending.PieceNum) {
+		// the segment has been replaced or the piece has been repaired away from the node
+		_, err = verifier.containment.Delete(ctx, pending.NodeID)
+		return err
+	}
+
+	limit, err := verifier.orders.CreateAuditOrderLimit(ctx, verifier.auditor, createBucketID(pending.Path), pending.NodeID, pending.PieceID, pending.ShareSize)
+	if err != nil {
+		if overlay.ErrNodeOffline.Has(err) {
+			report.Offlines = append(report.Offlines, pending.NodeID)
+			return nil
+		}
+		return err
+	}
+
+	share, err := verifier.getShare(ctx, limit, pending.StripeIndex, pending.ShareSize, int(pending.PieceNum))
+	if err != nil {
+		if status.Code(errs.Unwrap(err)) == codes.NotFound {
+			// the node lost the piece, retrying won't bring it back
+			report.Fails = append(report.Fails, pending.NodeID)
+			return nil
+		}
+		if err != context.DeadlineExceeded && transport.Error.Has(err) {
+			report.Offlines = append(report.Offlines, pending.NodeID)
+			return nil
+		}
+		if pending.ReverifyCount < verifier.maxReverifyCount {
+			report.PendingAudits = append(report.PendingAudits, pending)
+			return nil
+		}
+		verifier.log.Debug("reverify count exceeded", zap.String("Node ID", pending.NodeID.String()))
+		report.Fails = append(report.Fails, pending.NodeID)
+		return nil
+	}
+
+	if bytes.Equal(pkcrypto.SHA256Hash(share.Data), pending.ExpectedShareHash) {
+		report.Successes = append(report.Successes, pending.NodeID)
+	} else {
+		report.Fails = append(report.Fails, pending.NodeID)
+	}
+	return nil
+}
+
+// holdsPiece checks whether the node still holds the piece with the given number in the pointer
+func holdsPiece(pointer *pb.Pointer, nodeID storj.NodeID, pieceNum int32) bool {
+	for _, piece := range pointer.GetRemote().GetRemotePieces() {
+		if piece.NodeId == nodeID && piece.PieceNum == pieceNum {
+			return true
+		}
+	}
+	return false
+}
+
 // DownloadShares downloads shares from the nodes where remote pieces are located
 func (verifier *Verifier) DownloadShares(ctx context.Context, limits []*pb.AddressedOrderLimit, stripeIndex int64, shareSize int32) (shares map[int]Share, nodes map[int]storj.NodeID, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -288,7 +390,7 @@ func createBucketID(path storj.Path) []byte {
 }
 
 func createPendingAudits(containedNodes map[int]storj.NodeID, correctedShares []infectious.Share, stripe *Stripe) ([]*PendingAudit, error) {
-	if len(containedNodes) > 0 {
+	if len(containedNodes) == 0 {
 		return nil, nil
 	}
 
@@ -320,6 +422,8 @@ func createPendingAudits(containedNodes map[int]storj.NodeID, correctedShares []
 			StripeIndex:       stripe.Index,
 			ShareSize:         shareSize,
 			ExpectedShareHash: pkcrypto.SHA256Hash(share),
+			PieceNum:          int32(pieceNum),
+			Path:              stripe.SegmentPath,
 		})
 	}
 
@@ -299,7 +299,8 @@ func (authDB *AuthorizationDB) Create(userID string, count int) (Authorizations,
 
 // Get retrieves authorizations by user ID.
 func (authDB *AuthorizationDB) Get(userID string) (Authorizations, error) {
-	authsBytes, err := authDB.DB.Get(storage.Key(userID))
+	ctx := context.TODO()
+	authsBytes, err := authDB.DB.Get(ctx, storage.Key(userID))
 	if err != nil && !storage.ErrKeyNotFound.Has(err) {
 		return nil, ErrAuthorizationDB.Wrap(err)
 	}
@@ -316,7 +317,8 @@ func (authDB *AuthorizationDB) Get(userID string) (Authorizations, error) {
 
 // UserIDs returns a list of all userIDs present in the authorization database.
 func (authDB *AuthorizationDB) UserIDs() (userIDs []string, err error) {
-	err = authDB.DB.Iterate(storage.IterateOptions{
+	ctx := context.TODO()
+	err = authDB.DB.Iterate(ctx, storage.IterateOptions{
 		Recurse: true,
 	}, func(iterator storage.Iterator) error {
 		var listItem storage.ListItem
@@ -330,7 +332,8 @@ func (authDB *AuthorizationDB) UserIDs() (userIDs []string, err error) {
 
 // List returns all authorizations in the database.
 func (authDB *AuthorizationDB) List() (auths Authorizations, err error) {
-	err = authDB.DB.Iterate(storage.IterateOptions{
+	ctx := context.TODO()
+	err = authDB.DB.Iterate(ctx, storage.IterateOptions{
 		Recurse: true,
 	}, func(iterator storage.Iterator) error {
 		var listErrs errs.Group
@@ -435,12 +438,13 @@ func (authDB *AuthorizationDB) add(userID string, newAuths Authorizations) error
 }
 
 func (authDB *AuthorizationDB) put(userID string, auths Authorizations) error {
+	ctx := context.TODO()
 	authsBytes, err := auths.Marshal()
 	if err != nil {
 		return ErrAuthorizationDB.Wrap(err)
 	}
 
-	if err := authDB.DB.Put(storage.Key(userID), authsBytes); err != nil {
+	if err := authDB.DB.Put(ctx, storage.Key(userID), authsBytes); err != nil {
 		return ErrAuthorizationDB.Wrap(err)
 	}
 	return nil
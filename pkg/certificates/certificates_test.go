@@ -106,10 +106,10 @@ func TestAuthorizationDB_Create(t *testing.T) {
 			emailKey := storage.Key(c.email)
 
 			if c.startCount == 0 {
-				_, err = authDB.DB.Get(emailKey)
+				_, err = authDB.DB.Get(ctx, emailKey)
 				assert.Error(t, err)
 			} else {
-				v, err := authDB.DB.Get(emailKey)
+				v, err := authDB.DB.Get(ctx, emailKey)
 				require.NoError(t, err)
 				require.NotEmpty(t, v)
 
@@ -131,7 +131,7 @@ func TestAuthorizationDB_Create(t *testing.T) {
 			}
 			assert.Len(t, expectedAuths, c.newCount)
 
-			v, err := authDB.DB.Get(emailKey)
+			v, err := authDB.DB.Get(ctx, emailKey)
 			assert.NoError(t, err)
 			assert.NotEmpty(t, v)
 
@@ -161,7 +161,7 @@ func TestAuthorizationDB_Get(t *testing.T) {
 	authsBytes, err := expectedAuths.Marshal()
 	require.NoError(t, err)
 
-	err = authDB.DB.Put(storage.Key("user@example.com"), authsBytes)
+	err = authDB.DB.Put(ctx, storage.Key("user@example.com"), authsBytes)
 	require.NoError(t, err)
 
 	cases := []struct {
@@ -91,7 +91,7 @@ func (checker *Checker) Close() error {
 func (checker *Checker) IdentifyInjuredSegments(ctx context.Context) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	err = checker.metainfo.Iterate("", checker.lastChecked, true, false,
+	err = checker.metainfo.Iterate(ctx, "", checker.lastChecked, true, false,
 		func(it storage.Iterator) error {
 			var item storage.ListItem
 
@@ -119,6 +119,7 @@ func (checker *Checker) IdentifyInjuredSegments(ctx context.Context) (err error)
 				}
 			}()
 
+			now := time.Now()
 			for it.Next(&item) {
 				pointer := &pb.Pointer{}
 
@@ -127,6 +128,11 @@ func (checker *Checker) IdentifyInjuredSegments(ctx context.Context) (err error)
 					return Error.New("error unmarshalling pointer %s", err)
 				}
 
+				// expired segments are deleted by the reaper, there is no need to repair them
+				if metainfo.IsExpired(pointer, now) {
+					continue
+				}
+
 				remote := pointer.GetRemote()
 				if remote == nil {
 					continue
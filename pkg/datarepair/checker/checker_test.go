@@ -31,13 +31,13 @@ func TestIdentifyInjuredSegments(t *testing.T) {
 
 		//add noise to metainfo before bad record
 		for x := 0; x < 1000; x++ {
-			makePointer(t, planet, fmt.Sprintf("a-%d", x), false)
+			makePointer(t, ctx, planet, fmt.Sprintf("a-%d", x), false)
 		}
 		//create piece that needs repair
-		makePointer(t, planet, fmt.Sprintf("b"), true)
+		makePointer(t, ctx, planet, fmt.Sprintf("b"), true)
 		//add more noise to metainfo after bad record
 		for x := 0; x < 1000; x++ {
-			makePointer(t, planet, fmt.Sprintf("c-%d", x), false)
+			makePointer(t, ctx, planet, fmt.Sprintf("c-%d", x), false)
 		}
 		err := checker.IdentifyInjuredSegments(ctx)
 		require.NoError(t, err)
@@ -100,7 +100,7 @@ func TestIdentifyIrreparableSegments(t *testing.T) {
 
 		// put test pointer to db
 		metainfo := planet.Satellites[0].Metainfo.Service
-		err := metainfo.Put("fake-piece-id", pointer)
+		err := metainfo.Put(ctx, "fake-piece-id", pointer)
 		require.NoError(t, err)
 
 		err = checker.IdentifyInjuredSegments(ctx)
@@ -135,7 +135,7 @@ func TestIdentifyIrreparableSegments(t *testing.T) {
 	})
 }
 
-func makePointer(t *testing.T, planet *testplanet.Planet, pieceID string, createLost bool) {
+func makePointer(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet, pieceID string, createLost bool) {
 	numOfStorageNodes := len(planet.StorageNodes)
 	pieces := make([]*pb.RemotePiece, 0, numOfStorageNodes)
 	// use online nodes
@@ -170,7 +170,7 @@ func makePointer(t *testing.T, planet *testplanet.Planet, pieceID string, create
 	}
 	// put test pointer to db
 	pointerdb := planet.Satellites[0].Metainfo.Service
-	err := pointerdb.Put(pieceID, pointer)
+	err := pointerdb.Put(ctx, pieceID, pointer)
 	require.NoError(t, err)
 }
 
@@ -182,13 +182,13 @@ func TestCheckerResume(t *testing.T) {
 		c := checker.NewChecker(planet.Satellites[0].Metainfo.Service, repairQueue, planet.Satellites[0].Overlay.Service, nil, 0, nil, 1*time.Second)
 
 		// create pointer that needs repair
-		makePointer(t, planet, "a", true)
+		makePointer(t, ctx, planet, "a", true)
 		// create pointer that will cause an error
-		makePointer(t, planet, "b", true)
+		makePointer(t, ctx, planet, "b", true)
 		// create pointer that needs repair
-		makePointer(t, planet, "c", true)
+		makePointer(t, ctx, planet, "c", true)
 		// create pointer that will cause an error
-		makePointer(t, planet, "d", true)
+		makePointer(t, ctx, planet, "d", true)
 
 		err := c.IdentifyInjuredSegments(ctx)
 		require.Error(t, err)
@@ -4,6 +4,7 @@
 package identity
 
 import (
+	"context"
 	"crypto/x509"
 	"crypto/x509/pkix"
 
@@ -73,12 +74,13 @@ func newRevocationDBRedis(address string) (*RevocationDB, error) {
 // Get attempts to retrieve the most recent revocation for the given cert chain
 // (the  key used in the underlying database is the nodeID of the certificate chain).
 func (r RevocationDB) Get(chain []*x509.Certificate) (*extensions.Revocation, error) {
+	ctx := context.TODO()
 	nodeID, err := NodeIDFromCert(chain[peertls.CAIndex])
 	if err != nil {
 		return nil, extensions.ErrRevocation.Wrap(err)
 	}
 
-	revBytes, err := r.DB.Get(nodeID.Bytes())
+	revBytes, err := r.DB.Get(ctx, nodeID.Bytes())
 	if err != nil && !storage.ErrKeyNotFound.Has(err) {
 		return nil, extensions.ErrRevocationDB.Wrap(err)
 	}
@@ -97,6 +99,7 @@ func (r RevocationDB) Get(chain []*x509.Certificate) (*extensions.Revocation, er
 // is newer than the current value (the  key used in the underlying database is
 // the nodeID of the certificate chain).
 func (r RevocationDB) Put(chain []*x509.Certificate, revExt pkix.Extension) error {
+	ctx := context.TODO()
 	ca := chain[peertls.CAIndex]
 	var rev extensions.Revocation
 	if err := rev.Unmarshal(revExt.Value); err != nil {
@@ -121,7 +124,7 @@ func (r RevocationDB) Put(chain []*x509.Certificate, revExt pkix.Extension) erro
 	if err != nil {
 		return extensions.ErrRevocationDB.Wrap(err)
 	}
-	if err := r.DB.Put(nodeID.Bytes(), revExt.Value); err != nil {
+	if err := r.DB.Put(ctx, nodeID.Bytes(), revExt.Value); err != nil {
 		return extensions.ErrRevocationDB.Wrap(err)
 	}
 	return nil
@@ -129,12 +132,13 @@ func (r RevocationDB) Put(chain []*x509.Certificate, revExt pkix.Extension) erro
 
 // List lists all revocations in the store
 func (r RevocationDB) List() (revs []*extensions.Revocation, err error) {
-	keys, err := r.DB.List([]byte{}, 0)
+	ctx := context.TODO()
+	keys, err := r.DB.List(ctx, []byte{}, 0)
 	if err != nil {
 		return nil, extensions.ErrRevocationDB.Wrap(err)
 	}
 
-	marshaledRevs, err := r.DB.GetAll(keys)
+	marshaledRevs, err := r.DB.GetAll(ctx, keys)
 	if err != nil {
 		return nil, extensions.ErrRevocationDB.Wrap(err)
 	}
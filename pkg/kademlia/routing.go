@@ -150,7 +150,8 @@ func (rt *RoutingTable) GetNodes(id storj.NodeID) ([]*pb.Node, bool) {
 
 // GetBucketIds returns a storage.Keys type of bucket ID's in the Kademlia instance
 func (rt *RoutingTable) GetBucketIds() (storage.Keys, error) {
-	kbuckets, err := rt.kadBucketDB.List(nil, 0)
+	ctx := context.TODO()
+	kbuckets, err := rt.kadBucketDB.List(ctx, nil, 0)
 	if err != nil {
 		return nil, err
 	}
@@ -210,6 +211,7 @@ func (rt *RoutingTable) FindNear(target storj.NodeID, limit int) ([]*pb.Node, er
 // ConnectionSuccess updates or adds a node to the routing table when
 // a successful connection is made to the node on the network
 func (rt *RoutingTable) ConnectionSuccess(node *pb.Node) error {
+	ctx := context.TODO()
 	// valid to connect to node without ID but don't store connection
 	if node.Id == (storj.NodeID{}) {
 		return nil
@@ -218,7 +220,7 @@ func (rt *RoutingTable) ConnectionSuccess(node *pb.Node) error {
 	rt.mutex.Lock()
 	rt.seen[node.Id] = node
 	rt.mutex.Unlock()
-	v, err := rt.nodeBucketDB.Get(storage.Key(node.Id.Bytes()))
+	v, err := rt.nodeBucketDB.Get(ctx, storage.Key(node.Id.Bytes()))
 	if err != nil && !storage.ErrKeyNotFound.Has(err) {
 		return RoutingErr.New("could not get node %s", err)
 	}
@@ -260,7 +262,8 @@ func (rt *RoutingTable) SetBucketTimestamp(bIDBytes []byte, now time.Time) error
 
 // GetBucketTimestamp retrieves time of the last node lookup for a bucket
 func (rt *RoutingTable) GetBucketTimestamp(bIDBytes []byte) (time.Time, error) {
-	t, err := rt.kadBucketDB.Get(bIDBytes)
+	ctx := context.TODO()
+	t, err := rt.kadBucketDB.Get(ctx, bIDBytes)
 	if err != nil {
 		return time.Now(), RoutingErr.New("could not get bucket timestamp %s", err)
 	}
@@ -269,7 +272,8 @@ func (rt *RoutingTable) GetBucketTimestamp(bIDBytes []byte) (time.Time, error) {
 }
 
 func (rt *RoutingTable) iterateNodes(start storj.NodeID, f func(storj.NodeID, []byte) error, skipSelf bool) error {
-	return rt.nodeBucketDB.Iterate(storage.IterateOptions{First: storage.Key(start.Bytes()), Recurse: true},
+	ctx := context.TODO()
+	return rt.nodeBucketDB.Iterate(ctx, storage.IterateOptions{First: storage.Key(start.Bytes()), Recurse: true},
 		func(it storage.Iterator) error {
 			var item storage.ListItem
 			for it.Next(&item) {
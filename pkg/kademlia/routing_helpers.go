@@ -4,6 +4,7 @@
 package kademlia
 
 import (
+	"context"
 	"encoding/binary"
 	"time"
 
@@ -100,6 +101,7 @@ func (rt *RoutingTable) updateNode(node *pb.Node) error {
 
 // removeNode will remove churned nodes and replace those entries with nodes from the replacement cache.
 func (rt *RoutingTable) removeNode(node *pb.Node) error {
+	ctx := context.TODO()
 	rt.mutex.Lock()
 	defer rt.mutex.Unlock()
 	kadBucketID, err := rt.getKBucketID(node.Id)
@@ -108,7 +110,7 @@ func (rt *RoutingTable) removeNode(node *pb.Node) error {
 		return RoutingErr.New("could not get k bucket %s", err)
 	}
 
-	existingMarshalled, err := rt.nodeBucketDB.Get(node.Id.Bytes())
+	existingMarshalled, err := rt.nodeBucketDB.Get(ctx, node.Id.Bytes())
 	if storage.ErrKeyNotFound.Has(err) {
 		//check replacement cache
 		rt.removeFromReplacementCache(kadBucketID, node)
@@ -127,7 +129,7 @@ func (rt *RoutingTable) removeNode(node *pb.Node) error {
 		// don't remove a node if the address is different
 		return nil
 	}
-	err = rt.nodeBucketDB.Delete(node.Id.Bytes())
+	err = rt.nodeBucketDB.Delete(ctx, node.Id.Bytes())
 	if err != nil {
 		return RoutingErr.New("could not delete node %s", err)
 	}
@@ -146,12 +148,13 @@ func (rt *RoutingTable) removeNode(node *pb.Node) error {
 
 // putNode: helper, adds or updates Node and ID to nodeBucketDB
 func (rt *RoutingTable) putNode(node *pb.Node) error {
+	ctx := context.TODO()
 	v, err := proto.Marshal(node)
 	if err != nil {
 		return RoutingErr.Wrap(err)
 	}
 
-	err = rt.nodeBucketDB.Put(node.Id.Bytes(), v)
+	err = rt.nodeBucketDB.Put(ctx, node.Id.Bytes(), v)
 	if err != nil {
 		return RoutingErr.New("could not add key value pair to nodeBucketDB: %s", err)
 	}
@@ -160,9 +163,10 @@ func (rt *RoutingTable) putNode(node *pb.Node) error {
 
 // createOrUpdateKBucket: helper, adds or updates given kbucket
 func (rt *RoutingTable) createOrUpdateKBucket(bID bucketID, now time.Time) error {
+	ctx := context.TODO()
 	dateTime := make([]byte, binary.MaxVarintLen64)
 	binary.PutVarint(dateTime, now.UnixNano())
-	err := rt.kadBucketDB.Put(bID[:], dateTime)
+	err := rt.kadBucketDB.Put(ctx, bID[:], dateTime)
 	if err != nil {
 		return RoutingErr.New("could not add or update k bucket: %s", err)
 	}
@@ -172,8 +176,9 @@ func (rt *RoutingTable) createOrUpdateKBucket(bID bucketID, now time.Time) error
 // getKBucketID: helper, returns the id of the corresponding k bucket given a node id.
 // The node doesn't have to be in the routing table at time of search
 func (rt *RoutingTable) getKBucketID(nodeID storj.NodeID) (bucketID, error) {
+	ctx := context.TODO()
 	match := bucketID{}
-	err := rt.kadBucketDB.Iterate(storage.IterateOptions{First: storage.Key{}, Recurse: true},
+	err := rt.kadBucketDB.Iterate(ctx, storage.IterateOptions{First: storage.Key{}, Recurse: true},
 		func(it storage.Iterator) error {
 			var item storage.ListItem
 			for it.Next(&item) {
@@ -257,9 +262,10 @@ func (rt *RoutingTable) getNodeIDsWithinKBucket(bID bucketID) (storj.NodeIDList,
 
 // getNodesFromIDsBytes: helper, returns array of encoded nodes from node ids
 func (rt *RoutingTable) getNodesFromIDsBytes(nodeIDs storj.NodeIDList) ([]*pb.Node, error) {
+	ctx := context.TODO()
 	var marshaledNodes []storage.Value
 	for _, v := range nodeIDs {
-		n, err := rt.nodeBucketDB.Get(v.Bytes())
+		n, err := rt.nodeBucketDB.Get(ctx, v.Bytes())
 		if err != nil {
 			return nil, RoutingErr.New("could not get node id %v, %s", v, err)
 		}
@@ -297,9 +303,10 @@ func (rt *RoutingTable) getUnmarshaledNodesFromBucket(bID bucketID) ([]*pb.Node,
 
 // getKBucketRange: helper, returns the left and right endpoints of the range of node ids contained within the bucket
 func (rt *RoutingTable) getKBucketRange(bID bucketID) ([]bucketID, error) {
+	ctx := context.TODO()
 	previousBucket := bucketID{}
 	endpoints := []bucketID{}
-	err := rt.kadBucketDB.Iterate(storage.IterateOptions{First: storage.Key{}, Recurse: true},
+	err := rt.kadBucketDB.Iterate(ctx, storage.IterateOptions{First: storage.Key{}, Recurse: true},
 		func(it storage.Iterator) error {
 			var item storage.ListItem
 			for it.Next(&item) {
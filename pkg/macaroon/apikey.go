@@ -80,7 +80,8 @@ func NewAPIKey(secret []byte) (*APIKey, error) {
 
 // Check makes sure that the key authorizes the provided action given the root
 // project secret and any possible revocations, returning an error if the action
-// is not authorized. 'revoked' is a list of revoked heads.
+// is not authorized. 'revoked' is a list of revoked heads and tails. Revoking
+// a tail revokes the key with that tail and every key restricted from it.
 func (a *APIKey) Check(secret []byte, action Action, revoked [][]byte) error {
 	if !a.mac.Validate(secret) {
 		return ErrInvalid.New("macaroon unauthorized")
@@ -103,6 +104,10 @@ func (a *APIKey) Check(secret []byte, action Action, revoked [][]byte) error {
 		}
 	}
 
+	if len(revoked) == 0 {
+		return nil
+	}
+
 	head := a.mac.Head()
 	for _, revokedID := range revoked {
 		if bytes.Equal(revokedID, head) {
@@ -110,6 +115,14 @@ func (a *APIKey) Check(secret []byte, action Action, revoked [][]byte) error {
 		}
 	}
 
+	for _, tail := range a.mac.Tails(secret) {
+		for _, revokedID := range revoked {
+			if bytes.Equal(revokedID, tail) {
+				return ErrRevoked.New("macaroon tail revoked")
+			}
+		}
+	}
+
 	return nil
 }
 
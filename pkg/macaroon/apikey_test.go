@@ -82,6 +82,11 @@ func TestRevocation(t *testing.T) {
 
 	require.True(t, ErrRevoked.Has(key.Check(secret, action, [][]byte{restricted.Head()})))
 	require.True(t, ErrRevoked.Has(restricted.Check(secret, action, [][]byte{restricted.Head()})))
+
+	// revoking a tail revokes the key and everything restricted from it
+	require.NoError(t, key.Check(secret, action, [][]byte{restricted.Tail()}))
+	require.True(t, ErrRevoked.Has(restricted.Check(secret, action, [][]byte{restricted.Tail()})))
+	require.True(t, ErrRevoked.Has(restricted.Check(secret, action, [][]byte{key.Tail()})))
 }
 
 func TestExpiration(t *testing.T) {
@@ -73,6 +73,7 @@ func (db *DB) GetObjectStream(ctx context.Context, bucket string, path storj.Pat
 		info:          info,
 		encryptedPath: meta.encryptedPath,
 		streamKey:     streamKey,
+		layout:        streams.NewLayout(&meta.streamInfo),
 	}, nil
 }
 
@@ -315,8 +316,14 @@ func objectStreamFromMeta(bucket storj.Bucket, path storj.Path, lastSegment segm
 	var nonce storj.Nonce
 	copy(nonce[:], streamMeta.LastSegmentMeta.KeyNonce)
 
+	layout := streams.NewLayout(&stream)
+	lastSegmentSize, _, err := layout.Segment(layout.NumberOfSegments() - 1)
+	if err != nil {
+		return storj.Object{}, err
+	}
+
 	serMetaInfo := pb.SerializableMeta{}
-	err := proto.Unmarshal(stream.Metadata, &serMetaInfo)
+	err = proto.Unmarshal(stream.Metadata, &serMetaInfo)
 	if err != nil {
 		return storj.Object{}, err
 	}
@@ -335,11 +342,11 @@ func objectStreamFromMeta(bucket storj.Bucket, path storj.Path, lastSegment segm
 		Expires:     lastSegment.Expiration, // TODO: use correct field
 
 		Stream: storj.Stream{
-			Size: stream.SegmentsSize*(stream.NumberOfSegments-1) + stream.LastSegmentSize,
-			// Checksum: []byte(object.Checksum),
+			Size:     layout.Size(),
+			Checksum: stream.Checksum,
 
-			SegmentCount:     stream.NumberOfSegments,
-			FixedSegmentSize: stream.SegmentsSize,
+			SegmentCount:     layout.NumberOfSegments(),
+			FixedSegmentSize: layout.FixedSegmentSize(),
 
 			RedundancyScheme: storj.RedundancyScheme{
 				Algorithm:      storj.ReedSolomon,
@@ -354,7 +361,7 @@ func objectStreamFromMeta(bucket storj.Bucket, path storj.Path, lastSegment segm
 				BlockSize: streamMeta.EncryptionBlockSize,
 			},
 			LastSegment: storj.LastSegment{
-				Size:              stream.LastSegmentSize,
+				Size:              lastSegmentSize,
 				EncryptedKeyNonce: nonce,
 				EncryptedKey:      streamMeta.LastSegmentMeta.EncryptedKey,
 			},
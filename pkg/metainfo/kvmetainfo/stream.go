@@ -11,6 +11,7 @@ import (
 
 	"storj.io/storj/pkg/encryption"
 	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storage/streams"
 	"storj.io/storj/pkg/storj"
 )
 
@@ -22,6 +23,7 @@ type readonlyStream struct {
 	info          storj.Object
 	encryptedPath storj.Path
 	streamKey     *storj.Key // lazySegmentReader derivedKey
+	layout        streams.Layout
 }
 
 func (stream *readonlyStream) Info() storj.Object { return stream.info }
@@ -59,11 +61,9 @@ func (stream *readonlyStream) segment(ctx context.Context, index int64) (segment
 			return segment, err
 		}
 
-		segment.Size = stream.info.FixedSegmentSize
 		copy(segment.EncryptedKeyNonce[:], segmentMeta.KeyNonce)
 		segment.EncryptedKey = segmentMeta.EncryptedKey
 	} else {
-		segment.Size = stream.info.LastSegment.Size
 		segment.EncryptedKeyNonce = stream.info.LastSegment.EncryptedKeyNonce
 		segment.EncryptedKey = stream.info.LastSegment.EncryptedKey
 	}
@@ -73,11 +73,11 @@ func (stream *readonlyStream) segment(ctx context.Context, index int64) (segment
 		return segment, err
 	}
 
-	nonce := new(storj.Nonce)
-	_, err = encryption.Increment(nonce, index+1)
+	size, nonce, err := stream.layout.Segment(index)
 	if err != nil {
 		return segment, err
 	}
+	segment.Size = size
 
 	pathComponents := storj.SplitPath(stream.encryptedPath)
 	bucket := pathComponents[0]
@@ -93,7 +93,7 @@ func (stream *readonlyStream) segment(ctx context.Context, index int64) (segment
 	}
 
 	if pointer.GetType() == pb.Pointer_INLINE {
-		segment.Inline, err = encryption.Decrypt(pointer.InlineSegment, stream.info.EncryptionScheme.Cipher, contentKey, nonce)
+		segment.Inline, err = encryption.Decrypt(pointer.InlineSegment, stream.info.EncryptionScheme.Cipher, contentKey, &nonce)
 	} else {
 		segment.PieceID = pointer.Remote.RootPieceId
 		segment.Pieces = make([]storj.Piece, 0, len(pointer.Remote.RemotePieces))
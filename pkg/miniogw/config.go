@@ -15,3 +15,10 @@ type MinioConfig struct {
 type ServerConfig struct {
 	Address string `help:"address to serve S3 api over" default:"127.0.0.1:7777"`
 }
+
+// MultiTenantConfig determines whether the gateway serves a project for each S3 credential
+type MultiTenantConfig struct {
+	Enabled         bool   `help:"serve a project for each credential of the credentials file instead of a single project" default:"false"`
+	CredentialsPath string `help:"path to the JSON file mapping S3 access keys to satellite addresses, API keys and encryption keys" default:"$CONFDIR/credentials.json"`
+	CacheSize       int    `help:"maximum number of projects kept open in multi-tenant mode" default:"100"`
+}
@@ -5,7 +5,6 @@ package miniogw
 
 import (
 	"context"
-	"encoding/hex"
 	"io"
 	"strings"
 
@@ -38,7 +37,6 @@ func NewStorjGateway(project *uplink.Project, rootEncKey *storj.Key, pathCipher
 		encryption:  encryption,
 		redundancy:  redundancy,
 		segmentSize: segmentSize,
-		multipart:   NewMultipartUploads(),
 	}
 }
 
@@ -50,7 +48,19 @@ type Gateway struct {
 	encryption  storj.EncryptionParameters
 	redundancy  storj.RedundancyScheme
 	segmentSize memory.Size
-	multipart   *MultipartUploads
+
+	// tenants is set when the gateway serves the projects of the
+	// credentials of a multi-tenant gateway
+	tenants *MultiTenantGateway
+}
+
+// openProject returns the project served by the gateway for the request and its encryption key,
+// release has to be called when the project isn't used anymore
+func (gateway *Gateway) openProject(ctx context.Context) (_ *uplink.Project, encKey *storj.Key, release func(), err error) {
+	if gateway.tenants != nil {
+		return gateway.tenants.tenantProject(ctx)
+	}
+	return gateway.project, gateway.rootEncKey, func() {}, nil
 }
 
 // Name implements cmd.Gateway
@@ -85,13 +95,19 @@ func (layer *gatewayLayer) DeleteBucket(ctx context.Context, bucketName string)
 		return minio.BucketNotEmpty{Bucket: bucketName}
 	}
 
-	err = layer.gateway.project.DeleteBucket(ctx, bucketName)
+	project, _, release, err := layer.gateway.openProject(ctx)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	err = project.DeleteBucket(ctx, bucketName)
 
 	return convertError(err, bucketName, "")
 }
 
 func (layer *gatewayLayer) bucketEmpty(ctx context.Context, bucketName string) (empty bool, err error) {
-	bucket, err := layer.gateway.project.OpenBucket(ctx, bucketName, &uplink.EncryptionAccess{Key: *layer.gateway.rootEncKey})
+	bucket, err := layer.openBucket(ctx, bucketName)
 	if err != nil {
 		return false, convertError(err, bucketName, "")
 	}
@@ -108,7 +124,7 @@ func (layer *gatewayLayer) bucketEmpty(ctx context.Context, bucketName string) (
 func (layer *gatewayLayer) DeleteObject(ctx context.Context, bucketName, objectPath string) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	bucket, err := layer.gateway.project.OpenBucket(ctx, bucketName, &uplink.EncryptionAccess{Key: *layer.gateway.rootEncKey})
+	bucket, err := layer.openBucket(ctx, bucketName)
 	if err != nil {
 		return convertError(err, bucketName, "")
 	}
@@ -122,7 +138,13 @@ func (layer *gatewayLayer) DeleteObject(ctx context.Context, bucketName, objectP
 func (layer *gatewayLayer) GetBucketInfo(ctx context.Context, bucketName string) (bucketInfo minio.BucketInfo, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	bucket, _, err := layer.gateway.project.GetBucketInfo(ctx, bucketName)
+	project, _, release, err := layer.gateway.openProject(ctx)
+	if err != nil {
+		return minio.BucketInfo{}, err
+	}
+	defer release()
+
+	bucket, _, err := project.GetBucketInfo(ctx, bucketName)
 
 	if err != nil {
 		return minio.BucketInfo{}, convertError(err, bucketName, "")
@@ -134,7 +156,7 @@ func (layer *gatewayLayer) GetBucketInfo(ctx context.Context, bucketName string)
 func (layer *gatewayLayer) GetObject(ctx context.Context, bucketName, objectPath string, startOffset int64, length int64, writer io.Writer, etag string) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	bucket, err := layer.gateway.project.OpenBucket(ctx, bucketName, &uplink.EncryptionAccess{Key: *layer.gateway.rootEncKey})
+	bucket, err := layer.openBucket(ctx, bucketName)
 	if err != nil {
 		return convertError(err, bucketName, "")
 	}
@@ -146,6 +168,11 @@ func (layer *gatewayLayer) GetObject(ctx context.Context, bucketName, objectPath
 	}
 	defer func() { err = errs.Combine(err, object.Close()) }()
 
+	// the object was replaced after minio checked the preconditions of the request
+	if etag != "" && etag != objectETag(object.Meta.Checksum, object.Meta.Metadata) {
+		return minio.InvalidETag{}
+	}
+
 	if startOffset < 0 || length < -1 || startOffset+length > object.Meta.Size {
 		return minio.InvalidRange{
 			OffsetBegin:  startOffset,
@@ -168,7 +195,7 @@ func (layer *gatewayLayer) GetObject(ctx context.Context, bucketName, objectPath
 func (layer *gatewayLayer) GetObjectInfo(ctx context.Context, bucketName, objectPath string) (objInfo minio.ObjectInfo, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	bucket, err := layer.gateway.project.OpenBucket(ctx, bucketName, &uplink.EncryptionAccess{Key: *layer.gateway.rootEncKey})
+	bucket, err := layer.openBucket(ctx, bucketName)
 	if err != nil {
 		return minio.ObjectInfo{}, convertError(err, bucketName, "")
 	}
@@ -180,24 +207,22 @@ func (layer *gatewayLayer) GetObjectInfo(ctx context.Context, bucketName, object
 	}
 	defer func() { err = errs.Combine(err, object.Close()) }()
 
-	return minio.ObjectInfo{
-		Name:        object.Meta.Path,
-		Bucket:      object.Meta.Bucket,
-		ModTime:     object.Meta.Modified,
-		Size:        object.Meta.Size,
-		ETag:        hex.EncodeToString(object.Meta.Checksum),
-		ContentType: object.Meta.ContentType,
-		UserDefined: object.Meta.Metadata,
-	}, err
+	return objectInfo(object.Meta), err
 }
 
 func (layer *gatewayLayer) ListBuckets(ctx context.Context) (bucketItems []minio.BucketInfo, err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	project, _, release, err := layer.gateway.openProject(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
 	startAfter := ""
 
 	for {
-		list, err := layer.gateway.project.ListBuckets(ctx, &storj.BucketListOptions{Direction: storj.After, Cursor: startAfter})
+		list, err := project.ListBuckets(ctx, &storj.BucketListOptions{Direction: storj.After, Cursor: startAfter})
 		if err != nil {
 			return nil, err
 		}
@@ -223,7 +248,7 @@ func (layer *gatewayLayer) ListObjects(ctx context.Context, bucketName, prefix,
 		return minio.ListObjectsInfo{}, minio.UnsupportedDelimiter{Delimiter: delimiter}
 	}
 
-	bucket, err := layer.gateway.project.OpenBucket(ctx, bucketName, &uplink.EncryptionAccess{Key: *layer.gateway.rootEncKey})
+	bucket, err := layer.openBucket(ctx, bucketName)
 	if err != nil {
 		return minio.ListObjectsInfo{}, convertError(err, bucketName, "")
 	}
@@ -252,19 +277,21 @@ func (layer *gatewayLayer) ListObjects(ctx context.Context, bucketName, prefix,
 			if recursive && prefix != "" {
 				path = storj.JoinPaths(strings.TrimSuffix(prefix, "/"), path)
 			}
+			if isMultipartPath(path) {
+				// pending multipart uploads are not objects
+				continue
+			}
 			if item.IsPrefix {
 				prefixes = append(prefixes, path)
 				continue
 			}
-			objects = append(objects, minio.ObjectInfo{
+			objects = append(objects, withMetadata(minio.ObjectInfo{
 				Name:        path,
 				Bucket:      item.Bucket.Name,
 				ModTime:     item.Modified,
 				Size:        item.Size,
-				ETag:        hex.EncodeToString(item.Checksum),
 				ContentType: item.ContentType,
-				UserDefined: item.Metadata,
-			})
+			}, item.Checksum, item.Metadata))
 		}
 		startAfter = list.Items[len(list.Items)-1].Path
 	}
@@ -289,7 +316,7 @@ func (layer *gatewayLayer) ListObjectsV2(ctx context.Context, bucketName, prefix
 		return minio.ListObjectsV2Info{ContinuationToken: continuationToken}, minio.UnsupportedDelimiter{Delimiter: delimiter}
 	}
 
-	bucket, err := layer.gateway.project.OpenBucket(ctx, bucketName, &uplink.EncryptionAccess{Key: *layer.gateway.rootEncKey})
+	bucket, err := layer.openBucket(ctx, bucketName)
 	if err != nil {
 		return minio.ListObjectsV2Info{}, convertError(err, bucketName, "")
 	}
@@ -326,19 +353,21 @@ func (layer *gatewayLayer) ListObjectsV2(ctx context.Context, bucketName, prefix
 			if recursive && prefix != "" {
 				path = storj.JoinPaths(strings.TrimSuffix(prefix, "/"), path)
 			}
+			if isMultipartPath(path) {
+				// pending multipart uploads are not objects
+				continue
+			}
 			if item.IsPrefix {
 				prefixes = append(prefixes, path)
 				continue
 			}
-			objects = append(objects, minio.ObjectInfo{
+			objects = append(objects, withMetadata(minio.ObjectInfo{
 				Name:        path,
 				Bucket:      item.Bucket.Name,
 				ModTime:     item.Modified,
 				Size:        item.Size,
-				ETag:        hex.EncodeToString(item.Checksum),
 				ContentType: item.ContentType,
-				UserDefined: item.Metadata,
-			})
+			}, item.Checksum, item.Metadata))
 		}
 
 		nextContinuationToken = list.Items[len(list.Items)-1].Path + "\x00"
@@ -366,7 +395,13 @@ func (layer *gatewayLayer) MakeBucketWithLocation(ctx context.Context, bucketNam
 	// therefore try to Put a bucket at the same time.
 	// The reason for the Get call to check if the
 	// bucket already exists is to match S3 CLI behavior.
-	_, _, err = layer.gateway.project.GetBucketInfo(ctx, bucketName)
+	project, _, release, err := layer.gateway.openProject(ctx)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	_, _, err = project.GetBucketInfo(ctx, bucketName)
 	if err == nil {
 		return minio.BucketAlreadyExists{Bucket: bucketName}
 	}
@@ -382,7 +417,7 @@ func (layer *gatewayLayer) MakeBucketWithLocation(ctx context.Context, bucketNam
 	cfg.Volatile.RedundancyScheme = layer.gateway.redundancy
 	cfg.Volatile.SegmentsSize = layer.gateway.segmentSize
 
-	_, err = layer.gateway.project.CreateBucket(ctx, bucketName, &cfg)
+	_, err = project.CreateBucket(ctx, bucketName, &cfg)
 
 	return err
 }
@@ -390,7 +425,7 @@ func (layer *gatewayLayer) MakeBucketWithLocation(ctx context.Context, bucketNam
 func (layer *gatewayLayer) CopyObject(ctx context.Context, srcBucket, srcObject, destBucket, destObject string, srcInfo minio.ObjectInfo) (objInfo minio.ObjectInfo, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	bucket, err := layer.gateway.project.OpenBucket(ctx, srcBucket, &uplink.EncryptionAccess{Key: *layer.gateway.rootEncKey})
+	bucket, err := layer.openBucket(ctx, srcBucket)
 	if err != nil {
 		return minio.ObjectInfo{}, convertError(err, srcBucket, "")
 	}
@@ -408,9 +443,11 @@ func (layer *gatewayLayer) CopyObject(ctx context.Context, srcBucket, srcObject,
 	}
 	defer func() { err = errs.Combine(err, reader.Close()) }()
 
+	contentType, metadata := copyMetadata(srcInfo)
+
 	opts := uplink.UploadOptions{
-		ContentType: object.Meta.ContentType,
-		Metadata:    object.Meta.Metadata,
+		ContentType: contentType,
+		Metadata:    metadata,
 		Expires:     object.Meta.Expires,
 	}
 	opts.Volatile.EncryptionParameters = object.Meta.Volatile.EncryptionParameters
@@ -419,10 +456,37 @@ func (layer *gatewayLayer) CopyObject(ctx context.Context, srcBucket, srcObject,
 	return layer.putObject(ctx, destBucket, destObject, reader, &opts)
 }
 
+// openedBucket is a bucket, which releases its project when it is closed
+type openedBucket struct {
+	*uplink.Bucket
+	release func()
+}
+
+// Close closes the bucket and releases its project
+func (bucket *openedBucket) Close() error {
+	defer bucket.release()
+	return bucket.Bucket.Close()
+}
+
+// openBucket opens the bucket with the encryption key of the gateway
+func (layer *gatewayLayer) openBucket(ctx context.Context, bucketName string) (*openedBucket, error) {
+	project, encKey, release, err := layer.gateway.openProject(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	bucket, err := project.OpenBucket(ctx, bucketName, &uplink.EncryptionAccess{Key: *encKey})
+	if err != nil {
+		release()
+		return nil, err
+	}
+	return &openedBucket{Bucket: bucket, release: release}, nil
+}
+
 func (layer *gatewayLayer) putObject(ctx context.Context, bucketName, objectPath string, reader io.Reader, opts *uplink.UploadOptions) (objInfo minio.ObjectInfo, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	bucket, err := layer.gateway.project.OpenBucket(ctx, bucketName, &uplink.EncryptionAccess{Key: *layer.gateway.rootEncKey})
+	bucket, err := layer.openBucket(ctx, bucketName)
 	if err != nil {
 		return minio.ObjectInfo{}, convertError(err, bucketName, "")
 	}
@@ -439,15 +503,7 @@ func (layer *gatewayLayer) putObject(ctx context.Context, bucketName, objectPath
 	}
 	defer func() { err = errs.Combine(err, object.Close()) }()
 
-	return minio.ObjectInfo{
-		Name:        object.Meta.Path,
-		Bucket:      object.Meta.Bucket,
-		ModTime:     object.Meta.Modified,
-		Size:        object.Meta.Size,
-		ETag:        hex.EncodeToString(object.Meta.Checksum),
-		ContentType: object.Meta.ContentType,
-		UserDefined: object.Meta.Metadata,
-	}, nil
+	return objectInfo(object.Meta), nil
 }
 
 func upload(ctx context.Context, streams streams.Store, mutableObject storj.MutableObject, reader io.Reader) error {
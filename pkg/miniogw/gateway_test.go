@@ -8,6 +8,7 @@ import (
 	"context"
 	"encoding/hex"
 	"fmt"
+	"io/ioutil"
 	"strings"
 	"testing"
 	"time"
@@ -23,6 +24,7 @@ import (
 	"storj.io/storj/internal/testplanet"
 	libuplink "storj.io/storj/lib/uplink"
 	"storj.io/storj/pkg/eestream"
+	"storj.io/storj/pkg/macaroon"
 	"storj.io/storj/pkg/metainfo/kvmetainfo"
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/storage/buckets"
@@ -162,16 +164,22 @@ func TestPutObject(t *testing.T) {
 	}
 
 	metadata := map[string]string{
-		"content-type": "media/foo",
-		"key1":         "value1",
-		"key2":         "value2",
+		"content-type":        "media/foo",
+		"cache-control":       "no-cache",
+		"content-disposition": "attachment",
+		"content-encoding":    "gzip",
+		"X-Amz-Meta-Key1":     "value1",
+		"X-Amz-Meta-Key2":     "value2",
 	}
 
 	serMetaInfo := pb.SerializableMeta{
 		ContentType: metadata["content-type"],
 		UserDefined: map[string]string{
-			"key1": metadata["key1"],
-			"key2": metadata["key2"],
+			"cache-control":       metadata["cache-control"],
+			"content-disposition": metadata["content-disposition"],
+			"content-encoding":    metadata["content-encoding"],
+			"X-Amz-Meta-Key1":     metadata["X-Amz-Meta-Key1"],
+			"X-Amz-Meta-Key2":     metadata["X-Amz-Meta-Key2"],
 		},
 	}
 
@@ -200,8 +208,9 @@ func TestPutObject(t *testing.T) {
 			assert.False(t, info.IsDir)
 			assert.True(t, time.Since(info.ModTime) < 1*time.Minute)
 			assert.Equal(t, data.Size(), info.Size)
-			// assert.Equal(t, data.SHA256HexString(), info.ETag) TODO: when we start calculating checksums
+			assert.Equal(t, data.MD5HexString(), info.ETag)
 			assert.Equal(t, serMetaInfo.ContentType, info.ContentType)
+			assert.Equal(t, "gzip", info.ContentEncoding)
 			assert.Equal(t, serMetaInfo.UserDefined, info.UserDefined)
 		}
 
@@ -295,6 +304,14 @@ func TestGetObject(t *testing.T) {
 		_, err = createFile(ctx, metainfo, streams, TestBucket, TestFile, &createInfo, []byte("abcdef"))
 		assert.NoError(t, err)
 
+		// Check the error when the object has changed since its info was got
+		info, err := layer.GetObjectInfo(ctx, TestBucket, TestFile)
+		assert.NoError(t, err)
+		assert.Equal(t, "e80b5017098950fc58aad83c8c14978e", info.ETag)
+
+		err = layer.GetObject(ctx, TestBucket, TestFile, 0, -1, ioutil.Discard, "d41d8cd98f00b204e9800998ecf8427e")
+		assert.Equal(t, minio.InvalidETag{}, err)
+
 		for i, tt := range []struct {
 			offset, length int64
 			substr         string
@@ -317,7 +334,7 @@ func TestGetObject(t *testing.T) {
 			var buf bytes.Buffer
 
 			// Get the object info using the Minio API
-			err = layer.GetObject(ctx, TestBucket, TestFile, tt.offset, tt.length, &buf, "")
+			err = layer.GetObject(ctx, TestBucket, TestFile, tt.offset, tt.length, &buf, info.ETag)
 
 			if tt.err != nil {
 				assert.Equal(t, tt.err, err, errTag)
@@ -648,6 +665,9 @@ func runTest(t *testing.T, test func(context.Context, minio.ObjectLayer, storj.M
 
 	planet.Start(ctx)
 
+	// make sure the satellite knows the storage nodes, so that remote segments can be uploaded
+	planet.Satellites[0].Discovery.Service.Refresh.TriggerWait()
+
 	layer, metainfo, streams, err := initEnv(ctx, planet)
 	if !assert.NoError(t, err) {
 		return
@@ -667,19 +687,29 @@ func initEnv(ctx context.Context, planet *testplanet.Planet) (minio.ObjectLayer,
 		return nil, nil, nil, err
 	}
 
-	apiKey := console.APIKey{}
+	secret, err := macaroon.NewSecret()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	apiKey, err := macaroon.NewAPIKey(secret)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
 	apiKeyInfo := console.APIKeyInfo{
 		ProjectID: project.ID,
 		Name:      "testKey",
+		Secret:    secret,
 	}
 
 	// add api key to db
-	_, err = planet.Satellites[0].DB.Console().APIKeys().Create(ctx, apiKey, apiKeyInfo)
+	_, err = planet.Satellites[0].DB.Console().APIKeys().Create(ctx, apiKey.Head(), apiKeyInfo)
 	if err != nil {
 		return nil, nil, nil, err
 	}
 
-	metainfo, err := planet.Uplinks[0].DialMetainfo(ctx, planet.Satellites[0], apiKey.String())
+	metainfo, err := planet.Uplinks[0].DialMetainfo(ctx, planet.Satellites[0], apiKey.Serialize())
 	if err != nil {
 		return nil, nil, nil, err
 	}
@@ -722,7 +752,7 @@ func initEnv(ctx context.Context, planet *testplanet.Planet) (minio.ObjectLayer,
 		return nil, nil, nil, err
 	}
 
-	parsedAPIKey, err := libuplink.ParseAPIKey(apiKey.String())
+	parsedAPIKey, err := libuplink.ParseAPIKey(apiKey.Serialize())
 	if err != nil {
 		return nil, nil, nil, err
 	}
@@ -28,6 +28,7 @@ import (
 	libuplink "storj.io/storj/lib/uplink"
 	"storj.io/storj/pkg/cfgstruct"
 	"storj.io/storj/pkg/identity"
+	"storj.io/storj/pkg/macaroon"
 	"storj.io/storj/pkg/miniogw"
 	"storj.io/storj/pkg/storj"
 	"storj.io/storj/satellite/console"
@@ -57,14 +58,20 @@ func TestUploadDownload(t *testing.T) {
 
 	assert.NoError(t, err)
 
-	apiKey := console.APIKey{}
+	secret, err := macaroon.NewSecret()
+	assert.NoError(t, err)
+
+	apiKey, err := macaroon.NewAPIKey(secret)
+	assert.NoError(t, err)
+
 	apiKeyInfo := console.APIKeyInfo{
 		ProjectID: project.ID,
 		Name:      "testKey",
+		Secret:    secret,
 	}
 
 	// add api key to db
-	_, err = planet.Satellites[0].DB.Console().APIKeys().Create(context.Background(), apiKey, apiKeyInfo)
+	_, err = planet.Satellites[0].DB.Console().APIKeys().Create(context.Background(), apiKey.Head(), apiKeyInfo)
 	assert.NoError(t, err)
 
 	// bind default values to config
@@ -81,7 +88,7 @@ func TestUploadDownload(t *testing.T) {
 	uplinkCfg.Client.SatelliteAddr = planet.Satellites[0].Addr()
 
 	// keys
-	uplinkCfg.Client.APIKey = "apiKey"
+	uplinkCfg.Client.APIKey = apiKey.Serialize()
 
 	// Encryption key
 	passphrase := make([]byte, rand.Intn(100)+1)
@@ -0,0 +1,73 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package miniogw
+
+import (
+	"encoding/hex"
+
+	minio "github.com/minio/minio/cmd"
+
+	"storj.io/storj/lib/uplink"
+)
+
+// The metadata of an object keeps all the headers minio extracts on upload
+// (Content-Type is stored separately), together with the following reserved
+// key, which minio doesn't send back to clients.
+//
+// Object tags aren't supported, the vendored minio answers the tagging
+// requests with NotImplemented, so they need a minio upgrade first.
+const (
+	// etagMetadataKey keeps the ETag of an object, whose ETag isn't the MD5 of its content
+	etagMetadataKey = minio.ReservedMetadataPrefix + "Etag"
+)
+
+// objectInfo returns the minio.ObjectInfo of an object
+func objectInfo(meta uplink.ObjectMeta) minio.ObjectInfo {
+	return withMetadata(minio.ObjectInfo{
+		Name:        meta.Path,
+		Bucket:      meta.Bucket,
+		ModTime:     meta.Modified,
+		Size:        meta.Size,
+		ContentType: meta.ContentType,
+	}, meta.Checksum, meta.Metadata)
+}
+
+// withMetadata sets the fields of info, which are derived from the checksum and the metadata of the object
+func withMetadata(info minio.ObjectInfo, checksum []byte, metadata map[string]string) minio.ObjectInfo {
+	info.ETag = objectETag(checksum, metadata)
+	info.ContentEncoding = metadata["content-encoding"]
+	info.StorageClass = metadata["x-amz-storage-class"]
+	info.UserDefined = metadata
+	return info
+}
+
+// objectETag returns the ETag of an object, which is the MD5 of its content
+// unless the metadata keeps another one
+func objectETag(checksum []byte, metadata map[string]string) string {
+	if etag, ok := metadata[etagMetadataKey]; ok {
+		return etag
+	}
+	return hex.EncodeToString(checksum)
+}
+
+// copyMetadata returns the metadata of a copy of an object from the metadata minio passes to CopyObject
+func copyMetadata(srcInfo minio.ObjectInfo) (contentType string, metadata map[string]string) {
+	contentType = srcInfo.ContentType
+
+	metadata = make(map[string]string, len(srcInfo.UserDefined))
+	for key, value := range srcInfo.UserDefined {
+		metadata[key] = value
+	}
+
+	// the metadata is replaced from the headers of the request
+	if replaced, ok := metadata["content-type"]; ok {
+		contentType = replaced
+		delete(metadata, "content-type")
+	}
+
+	// the copy is uploaded at once, so its ETag is the MD5 of its content
+	delete(metadata, etagMetadataKey)
+
+	return contentType, metadata
+}
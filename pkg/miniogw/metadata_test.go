@@ -0,0 +1,60 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package miniogw
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	minio "github.com/minio/minio/cmd"
+	"github.com/minio/minio/pkg/hash"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/pkg/storage/streams"
+	"storj.io/storj/pkg/storj"
+)
+
+func TestCopyObjectMetadata(t *testing.T) {
+	runTest(t, func(ctx context.Context, layer minio.ObjectLayer, metainfo storj.Metainfo, streams streams.Store) {
+		_, err := metainfo.CreateBucket(ctx, TestBucket, nil)
+		require.NoError(t, err)
+
+		_, err = putObject(ctx, layer, TestFile, "data", map[string]string{
+			"content-type":    "text/plain",
+			"cache-control":   "no-cache",
+			"X-Amz-Meta-Key1": "value1",
+		})
+		require.NoError(t, err)
+
+		srcInfo, err := layer.GetObjectInfo(ctx, TestBucket, TestFile)
+		require.NoError(t, err)
+
+		// the metadata is copied by default
+		info, err := layer.CopyObject(ctx, TestBucket, TestFile, TestBucket, "copy", srcInfo)
+		require.NoError(t, err)
+		assert.Equal(t, srcInfo.ETag, info.ETag)
+		assert.Equal(t, "text/plain", info.ContentType)
+		assert.Equal(t, srcInfo.UserDefined, info.UserDefined)
+
+		// minio passes the metadata of the request, when it replaces the metadata
+		srcInfo.UserDefined = map[string]string{
+			"content-type":    "application/json",
+			"X-Amz-Meta-Key2": "value2",
+		}
+		info, err = layer.CopyObject(ctx, TestBucket, TestFile, TestBucket, "replaced", srcInfo)
+		require.NoError(t, err)
+		assert.Equal(t, "application/json", info.ContentType)
+		assert.Equal(t, map[string]string{"X-Amz-Meta-Key2": "value2"}, info.UserDefined)
+	})
+}
+
+func putObject(ctx context.Context, layer minio.ObjectLayer, object, data string, metadata map[string]string) (minio.ObjectInfo, error) {
+	reader, err := hash.NewReader(strings.NewReader(data), int64(len(data)), "", "")
+	if err != nil {
+		return minio.ObjectInfo{}, err
+	}
+	return layer.PutObject(ctx, TestBucket, object, reader, metadata)
+}
@@ -4,452 +4,470 @@
 package miniogw
 
 import (
+	"bytes"
 	"context"
-	"io"
+	"crypto/md5"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
 	"sort"
 	"strconv"
-	"sync"
-	"sync/atomic"
-	"time"
+	"strings"
 
 	minio "github.com/minio/minio/cmd"
 	"github.com/minio/minio/pkg/hash"
+	"github.com/zeebo/errs"
 
 	"storj.io/storj/lib/uplink"
+	"storj.io/storj/pkg/storj"
 )
 
-func (layer *gatewayLayer) NewMultipartUpload(ctx context.Context, bucket, object string, metadata map[string]string) (uploadID string, err error) {
+// Pending multipart uploads are stored in the bucket itself under multipartPrefix,
+// so that they survive restarts and are shared by all gateways using the same bucket:
+//
+//	<multipartPrefix>/uploads/<object>/<upload id>  marks the upload and keeps its metadata
+//	<multipartPrefix>/parts/<upload id>/<part number> is an uploaded part, its metadata keeps the ETag
+//	<multipartPrefix>/parts/<upload id>/<part number>.<random> is a part being uploaded
+//
+// Completing the upload concatenates the parts into the object without uploading them again.
+const (
+	multipartPrefix = ".storj-multipart"
+	uploadsPrefix   = multipartPrefix + "/uploads/"
+	partsPrefix     = multipartPrefix + "/parts/"
+
+	// etagKey is the metadata key of the part's ETag
+	etagKey = "etag"
+	// maxPartNumber is the largest part number allowed by S3
+	maxPartNumber = 10000
+	// uploadIDLength is the length of the hex encoded upload id
+	uploadIDLength = 32
+)
+
+// isMultipartPath returns whether the path is used for storing pending multipart uploads
+func isMultipartPath(path string) bool {
+	return path == multipartPrefix || strings.HasPrefix(path, multipartPrefix+"/")
+}
+
+// uploadPath returns the path of the marker of the pending upload
+func uploadPath(object, uploadID string) storj.Path {
+	return uploadsPrefix + object + "/" + uploadID
+}
+
+// partPath returns the path of the uploaded part
+func partPath(uploadID string, partNumber int) storj.Path {
+	return partsPrefix + uploadID + "/" + fmt.Sprintf("%05d", partNumber)
+}
+
+// newUploadID returns a new random upload id
+func newUploadID() (string, error) {
+	var id [uploadIDLength / 2]byte
+	if _, err := rand.Read(id[:]); err != nil {
+		return "", Error.Wrap(err)
+	}
+	return hex.EncodeToString(id[:]), nil
+}
+
+// validUploadID checks that the upload id was created by newUploadID, so that it is safe to use in paths
+func validUploadID(uploadID string) bool {
+	if len(uploadID) != uploadIDLength {
+		return false
+	}
+	_, err := hex.DecodeString(uploadID)
+	return err == nil
+}
+
+func (layer *gatewayLayer) NewMultipartUpload(ctx context.Context, bucketName, object string, metadata map[string]string) (uploadID string, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	// Check that the bucket exists
-	_, _, err = layer.gateway.project.GetBucketInfo(ctx, bucket)
+	bucket, err := layer.openBucket(ctx, bucketName)
 	if err != nil {
-		return "", convertError(err, bucket, "")
+		return "", convertError(err, bucketName, "")
 	}
+	defer func() { err = errs.Combine(err, bucket.Close()) }()
 
-	uploads := layer.gateway.multipart
-
-	upload, err := uploads.Create(bucket, object, metadata)
+	uploadID, err = newUploadID()
 	if err != nil {
 		return "", err
 	}
 
-	go func() {
-		contentType := metadata["content-type"]
-		delete(metadata, "content-type")
+	contentType := metadata["content-type"]
+	delete(metadata, "content-type")
 
-		opts := uplink.UploadOptions{
-			ContentType: contentType,
-			Metadata:    metadata,
-		}
-		objInfo, err := layer.putObject(ctx, bucket, object, upload.Stream, &opts)
-
-		uploads.RemoveByID(upload.ID)
-
-		if err != nil {
-			upload.fail(err)
-		} else {
-			upload.complete(objInfo)
-		}
-	}()
+	err = bucket.UploadObject(ctx, uploadPath(object, uploadID), bytes.NewReader(nil), &uplink.UploadOptions{
+		ContentType: contentType,
+		Metadata:    metadata,
+	})
+	if err != nil {
+		return "", convertError(err, bucketName, object)
+	}
 
-	return upload.ID, nil
+	return uploadID, nil
 }
 
-func (layer *gatewayLayer) PutObjectPart(ctx context.Context, bucket, object, uploadID string, partID int, data *hash.Reader) (info minio.PartInfo, err error) {
+func (layer *gatewayLayer) PutObjectPart(ctx context.Context, bucketName, object, uploadID string, partID int, data *hash.Reader) (info minio.PartInfo, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	uploads := layer.gateway.multipart
+	if partID < 1 || partID > maxPartNumber {
+		return minio.PartInfo{}, minio.InvalidPart{}
+	}
 
-	upload, err := uploads.Get(bucket, object, uploadID)
+	bucket, err := layer.openBucket(ctx, bucketName)
 	if err != nil {
-		return minio.PartInfo{}, err
+		return minio.PartInfo{}, convertError(err, bucketName, "")
 	}
+	defer func() { err = errs.Combine(err, bucket.Close()) }()
 
-	part, err := upload.Stream.AddPart(partID, data)
+	_, err = layer.getUpload(ctx, bucket.Bucket, object, uploadID)
 	if err != nil {
 		return minio.PartInfo{}, err
 	}
 
-	err = <-part.Done
+	suffix, err := newUploadID()
 	if err != nil {
 		return minio.PartInfo{}, err
 	}
 
-	partInfo := minio.PartInfo{
-		PartNumber:   part.Number,
-		LastModified: time.Now(),
-		ETag:         data.SHA256HexString(),
-		Size:         atomic.LoadInt64(&part.Size),
+	// parts are uploaded directly as separate objects, so they can be uploaded in parallel and in any order
+	path := partPath(uploadID, partID)
+	stagingPath := path + "." + suffix
+	err = bucket.UploadObject(ctx, stagingPath, data, nil)
+	if err != nil {
+		return minio.PartInfo{}, convertError(err, bucketName, object)
 	}
 
-	upload.addCompletedPart(partInfo)
-
-	return partInfo, nil
-}
-
-func (layer *gatewayLayer) AbortMultipartUpload(ctx context.Context, bucket, object, uploadID string) (err error) {
-	defer mon.Task()(&ctx)(&err)
-
-	uploads := layer.gateway.multipart
+	etag := hex.EncodeToString(data.MD5Current())
 
-	upload, err := uploads.Remove(bucket, object, uploadID)
+	// the ETag is known only after the data was read, so the part is moved to its final path
+	// together with the ETag, which also replaces a previous upload of the same part
+	err = bucket.ConcatObjects(ctx, path, []storj.Path{stagingPath}, &uplink.UploadOptions{
+		Metadata: map[string]string{etagKey: etag},
+	})
 	if err != nil {
-		return err
+		return minio.PartInfo{}, errs.Combine(convertError(err, bucketName, object), bucket.DeleteObject(ctx, stagingPath))
 	}
 
-	errAbort := Error.New("abort")
-	upload.Stream.Abort(errAbort)
-	r := <-upload.Done
-	if r.Error != errAbort {
-		return r.Error
+	part, err := bucket.OpenObject(ctx, path)
+	if err != nil {
+		return minio.PartInfo{}, convertError(err, bucketName, object)
 	}
-	return nil
+	defer func() { err = errs.Combine(err, part.Close()) }()
+
+	return minio.PartInfo{
+		PartNumber:   partID,
+		LastModified: part.Meta.Modified,
+		ETag:         etag,
+		Size:         part.Meta.Size,
+	}, nil
 }
 
-func (layer *gatewayLayer) CompleteMultipartUpload(ctx context.Context, bucket, object, uploadID string, uploadedParts []minio.CompletePart) (objInfo minio.ObjectInfo, err error) {
+func (layer *gatewayLayer) AbortMultipartUpload(ctx context.Context, bucketName, object, uploadID string) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	uploads := layer.gateway.multipart
-	upload, err := uploads.Remove(bucket, object, uploadID)
+	bucket, err := layer.openBucket(ctx, bucketName)
 	if err != nil {
-		return minio.ObjectInfo{}, err
+		return convertError(err, bucketName, "")
 	}
+	defer func() { err = errs.Combine(err, bucket.Close()) }()
 
-	// notify stream that there aren't more parts coming
-	upload.Stream.Close()
-	// wait for completion
-	result := <-upload.Done
-	// return the final info
-	return result.Info, result.Error
-}
-
-func (layer *gatewayLayer) ListObjectParts(ctx context.Context, bucket, object, uploadID string, partNumberMarker int, maxParts int) (result minio.ListPartsInfo, err error) {
-	defer mon.Task()(&ctx)(&err)
-
-	uploads := layer.gateway.multipart
-	upload, err := uploads.Get(bucket, object, uploadID)
+	_, err = layer.getUpload(ctx, bucket.Bucket, object, uploadID)
 	if err != nil {
-		return minio.ListPartsInfo{}, err
+		return err
 	}
 
-	list := minio.ListPartsInfo{}
-
-	list.Bucket = bucket
-	list.Object = object
-	list.UploadID = uploadID
-	list.PartNumberMarker = partNumberMarker
-	list.MaxParts = maxParts
-	list.UserDefined = upload.Metadata
-	list.Parts = upload.getCompletedParts()
-
-	sort.Slice(list.Parts, func(i, k int) bool {
-		return list.Parts[i].PartNumber < list.Parts[k].PartNumber
+	// remove also the parts, which are being uploaded
+	var paths []storj.Path
+	err = listAll(ctx, bucket.Bucket, partsPrefix+uploadID+"/", func(item storj.Object) {
+		paths = append(paths, partsPrefix+uploadID+"/"+item.Path)
 	})
-
-	var first int
-	for i, p := range list.Parts {
-		first = i
-		if partNumberMarker <= p.PartNumber {
-			break
-		}
+	if err != nil {
+		return convertError(err, bucketName, object)
 	}
 
-	list.Parts = list.Parts[first:]
-	if len(list.Parts) > maxParts {
-		list.NextPartNumberMarker = list.Parts[maxParts].PartNumber
-		list.Parts = list.Parts[:maxParts]
-		list.IsTruncated = true
+	for _, path := range paths {
+		err = bucket.DeleteObject(ctx, path)
+		if err != nil && !storj.ErrObjectNotFound.Has(err) {
+			return convertError(err, bucketName, object)
+		}
 	}
 
-	return list, nil
+	return convertError(bucket.DeleteObject(ctx, uploadPath(object, uploadID)), bucketName, object)
 }
 
-// TODO: implement
-// func (layer *gatewayLayer) ListMultipartUploads(ctx context.Context, bucket, prefix, keyMarker, uploadIDMarker, delimiter string, maxUploads int) (result minio.ListMultipartsInfo, err error) {
-// func (layer *gatewayLayer) CopyObjectPart(ctx context.Context, srcBucket, srcObject, destBucket, destObject string, uploadID string, partID int, startOffset int64, length int64, srcInfo minio.ObjectInfo) (info minio.PartInfo, err error) {
+func (layer *gatewayLayer) CompleteMultipartUpload(ctx context.Context, bucketName, object, uploadID string, uploadedParts []minio.CompletePart) (objInfo minio.ObjectInfo, err error) {
+	defer mon.Task()(&ctx)(&err)
 
-// MultipartUploads manages pending multipart uploads
-type MultipartUploads struct {
-	mu      sync.RWMutex
-	lastID  int
-	pending map[string]*MultipartUpload
-}
+	if len(uploadedParts) == 0 {
+		return minio.ObjectInfo{}, minio.InvalidPart{}
+	}
 
-// NewMultipartUploads creates new MultipartUploads
-func NewMultipartUploads() *MultipartUploads {
-	return &MultipartUploads{
-		pending: map[string]*MultipartUpload{},
+	bucket, err := layer.openBucket(ctx, bucketName)
+	if err != nil {
+		return minio.ObjectInfo{}, convertError(err, bucketName, "")
 	}
-}
+	defer func() { err = errs.Combine(err, bucket.Close()) }()
 
-// Create creates a new upload
-func (uploads *MultipartUploads) Create(bucket, object string, metadata map[string]string) (*MultipartUpload, error) {
-	uploads.mu.Lock()
-	defer uploads.mu.Unlock()
+	upload, err := layer.getUpload(ctx, bucket.Bucket, object, uploadID)
+	if err != nil {
+		return minio.ObjectInfo{}, err
+	}
+	defer func() { err = errs.Combine(err, upload.Close()) }()
 
-	for id, upload := range uploads.pending {
-		if upload.Bucket == bucket && upload.Object == object {
-			upload.Stream.Abort(Error.New("aborted by another upload to the same location"))
-			delete(uploads.pending, id)
-		}
+	parts, err := listParts(ctx, bucket.Bucket, uploadID)
+	if err != nil {
+		return minio.ObjectInfo{}, convertError(err, bucketName, object)
 	}
 
-	uploads.lastID++
-	uploadID := "Upload" + strconv.Itoa(uploads.lastID)
+	uploaded := make(map[int]minio.PartInfo, len(parts))
+	for _, part := range parts {
+		uploaded[part.PartNumber] = part
+	}
 
-	upload := NewMultipartUpload(uploadID, bucket, object, metadata)
-	uploads.pending[uploadID] = upload
+	sources := make([]storj.Path, 0, len(uploadedParts))
+	var etags []byte
+	for i, completed := range uploadedParts {
+		if i > 0 && completed.PartNumber <= uploadedParts[i-1].PartNumber {
+			return minio.ObjectInfo{}, minio.InvalidPart{}
+		}
 
-	return upload, nil
-}
+		part, ok := uploaded[completed.PartNumber]
+		if !ok || part.ETag != strings.Trim(completed.ETag, `"`) {
+			return minio.ObjectInfo{}, minio.InvalidPart{}
+		}
+		delete(uploaded, completed.PartNumber)
 
-// Get finds a pending upload
-func (uploads *MultipartUploads) Get(bucket, object, uploadID string) (*MultipartUpload, error) {
-	uploads.mu.Lock()
-	defer uploads.mu.Unlock()
+		etag, err := hex.DecodeString(part.ETag)
+		if err != nil {
+			return minio.ObjectInfo{}, minio.InvalidPart{}
+		}
+		etags = append(etags, etag...)
 
-	upload, ok := uploads.pending[uploadID]
-	if !ok {
-		return nil, Error.New("pending upload %q missing", uploadID)
-	}
-	if upload.Bucket != bucket || upload.Object != object {
-		return nil, Error.New("pending upload %q bucket/object name mismatch", uploadID)
+		sources = append(sources, partPath(uploadID, part.PartNumber))
 	}
 
-	return upload, nil
-}
+	// the ETag of a multipart object is the MD5 of the MD5s of its parts followed by the number of parts
+	etag := md5.Sum(etags)
 
-// Remove returns and removes a pending upload
-func (uploads *MultipartUploads) Remove(bucket, object, uploadID string) (*MultipartUpload, error) {
-	uploads.mu.RLock()
-	defer uploads.mu.RUnlock()
-
-	upload, ok := uploads.pending[uploadID]
-	if !ok {
-		return nil, Error.New("pending upload %q missing", uploadID)
-	}
-	if upload.Bucket != bucket || upload.Object != object {
-		return nil, Error.New("pending upload %q bucket/object name mismatch", uploadID)
+	metadata := make(map[string]string, len(upload.Meta.Metadata)+1)
+	for key, value := range upload.Meta.Metadata {
+		metadata[key] = value
 	}
+	metadata[etagMetadataKey] = hex.EncodeToString(etag[:]) + "-" + strconv.Itoa(len(uploadedParts))
 
-	delete(uploads.pending, uploadID)
-
-	return upload, nil
-}
+	err = bucket.ConcatObjects(ctx, object, sources, &uplink.UploadOptions{
+		ContentType: upload.Meta.ContentType,
+		Metadata:    metadata,
+	})
+	if err != nil {
+		return minio.ObjectInfo{}, convertError(err, bucketName, object)
+	}
 
-// RemoveByID removes pending upload by id
-func (uploads *MultipartUploads) RemoveByID(uploadID string) {
-	uploads.mu.RLock()
-	defer uploads.mu.RUnlock()
-	delete(uploads.pending, uploadID)
-}
+	// remove the parts, which were uploaded but not included in the object
+	for partNumber := range uploaded {
+		err = bucket.DeleteObject(ctx, partPath(uploadID, partNumber))
+		if err != nil && !storj.ErrObjectNotFound.Has(err) {
+			return minio.ObjectInfo{}, convertError(err, bucketName, object)
+		}
+	}
 
-// MultipartUpload is partial info about a pending upload
-type MultipartUpload struct {
-	ID       string
-	Bucket   string
-	Object   string
-	Metadata map[string]string
-	Done     chan (*MultipartUploadResult)
-	Stream   *MultipartStream
-
-	mu        sync.Mutex
-	completed []minio.PartInfo
-}
+	err = bucket.DeleteObject(ctx, uploadPath(object, uploadID))
+	if err != nil {
+		return minio.ObjectInfo{}, convertError(err, bucketName, object)
+	}
 
-// MultipartUploadResult contains either an Error or the uploaded ObjectInfo
-type MultipartUploadResult struct {
-	Error error
-	Info  minio.ObjectInfo
-}
+	result, err := bucket.OpenObject(ctx, object)
+	if err != nil {
+		return minio.ObjectInfo{}, convertError(err, bucketName, object)
+	}
+	defer func() { err = errs.Combine(err, result.Close()) }()
 
-// NewMultipartUpload creates a new MultipartUpload
-func NewMultipartUpload(uploadID string, bucket, object string, metadata map[string]string) *MultipartUpload {
-	upload := &MultipartUpload{
-		ID:       uploadID,
-		Bucket:   bucket,
-		Object:   object,
-		Metadata: metadata,
-		Done:     make(chan *MultipartUploadResult, 1),
-		Stream:   NewMultipartStream(),
-	}
-	return upload
+	return objectInfo(result.Meta), nil
 }
 
-// addCompletedPart adds a completed part to the list
-func (upload *MultipartUpload) addCompletedPart(part minio.PartInfo) {
-	upload.mu.Lock()
-	defer upload.mu.Unlock()
-
-	upload.completed = append(upload.completed, part)
-}
+func (layer *gatewayLayer) ListObjectParts(ctx context.Context, bucketName, object, uploadID string, partNumberMarker int, maxParts int) (result minio.ListPartsInfo, err error) {
+	defer mon.Task()(&ctx)(&err)
 
-func (upload *MultipartUpload) getCompletedParts() []minio.PartInfo {
-	upload.mu.Lock()
-	defer upload.mu.Unlock()
+	bucket, err := layer.openBucket(ctx, bucketName)
+	if err != nil {
+		return minio.ListPartsInfo{}, convertError(err, bucketName, "")
+	}
+	defer func() { err = errs.Combine(err, bucket.Close()) }()
 
-	return append([]minio.PartInfo{}, upload.completed...)
-}
+	upload, err := layer.getUpload(ctx, bucket.Bucket, object, uploadID)
+	if err != nil {
+		return minio.ListPartsInfo{}, err
+	}
+	defer func() { err = errs.Combine(err, upload.Close()) }()
 
-// fail aborts the upload with an error
-func (upload *MultipartUpload) fail(err error) {
-	upload.Done <- &MultipartUploadResult{Error: err}
-	close(upload.Done)
-}
+	parts, err := listParts(ctx, bucket.Bucket, uploadID)
+	if err != nil {
+		return minio.ListPartsInfo{}, convertError(err, bucketName, object)
+	}
 
-// complete completes the upload
-func (upload *MultipartUpload) complete(info minio.ObjectInfo) {
-	upload.Done <- &MultipartUploadResult{Info: info}
-	close(upload.Done)
-}
+	list := minio.ListPartsInfo{
+		Bucket:           bucketName,
+		Object:           object,
+		UploadID:         uploadID,
+		PartNumberMarker: partNumberMarker,
+		MaxParts:         maxParts,
+		UserDefined:      upload.Meta.Metadata,
+	}
 
-// MultipartStream serializes multiple readers into a single reader
-type MultipartStream struct {
-	mu          sync.Mutex
-	moreParts   sync.Cond
-	err         error
-	closed      bool
-	finished    bool
-	nextID      int
-	nextNumber  int
-	currentPart *StreamPart
-	parts       []*StreamPart
-}
+	first := sort.Search(len(parts), func(i int) bool {
+		return parts[i].PartNumber > partNumberMarker
+	})
+	list.Parts = parts[first:]
 
-// StreamPart is a reader waiting in MultipartStream
-type StreamPart struct {
-	Number int
-	ID     int
-	Size   int64
-	Reader *hash.Reader
-	Done   chan error
-}
+	if len(list.Parts) > maxParts {
+		list.Parts = list.Parts[:maxParts]
+		list.NextPartNumberMarker = list.Parts[maxParts-1].PartNumber
+		list.IsTruncated = true
+	}
 
-// NewMultipartStream creates a new MultipartStream
-func NewMultipartStream() *MultipartStream {
-	stream := &MultipartStream{}
-	stream.moreParts.L = &stream.mu
-	stream.nextID = 1
-	return stream
+	return list, nil
 }
 
-// Abort aborts the stream reading
-func (stream *MultipartStream) Abort(err error) {
-	stream.mu.Lock()
-	defer stream.mu.Unlock()
+func (layer *gatewayLayer) ListMultipartUploads(ctx context.Context, bucketName, prefix, keyMarker, uploadIDMarker, delimiter string, maxUploads int) (result minio.ListMultipartsInfo, err error) {
+	defer mon.Task()(&ctx)(&err)
 
-	if stream.finished {
-		return
+	if delimiter != "" && delimiter != "/" {
+		return minio.ListMultipartsInfo{}, minio.UnsupportedDelimiter{Delimiter: delimiter}
 	}
 
-	if stream.err == nil {
-		stream.err = err
+	bucket, err := layer.openBucket(ctx, bucketName)
+	if err != nil {
+		return minio.ListMultipartsInfo{}, convertError(err, bucketName, "")
 	}
-	stream.finished = true
-	stream.closed = true
-
-	for _, part := range stream.parts {
-		part.Done <- err
-		close(part.Done)
+	defer func() { err = errs.Combine(err, bucket.Close()) }()
+
+	result = minio.ListMultipartsInfo{
+		KeyMarker:      keyMarker,
+		UploadIDMarker: uploadIDMarker,
+		MaxUploads:     maxUploads,
+		Prefix:         prefix,
+		Delimiter:      delimiter,
 	}
-	stream.parts = nil
-
-	stream.moreParts.Broadcast()
-}
 
-// Close closes the stream, but lets it complete
-func (stream *MultipartStream) Close() {
-	stream.mu.Lock()
-	defer stream.mu.Unlock()
+	var uploads []minio.MultipartInfo
+	err = listAll(ctx, bucket.Bucket, uploadsPrefix, func(item storj.Object) {
+		slash := strings.LastIndexByte(item.Path, '/')
+		if slash < 0 || !validUploadID(item.Path[slash+1:]) {
+			return
+		}
+		uploads = append(uploads, minio.MultipartInfo{
+			Object:    item.Path[:slash],
+			UploadID:  item.Path[slash+1:],
+			Initiated: item.Created,
+		})
+	})
+	if err != nil {
+		return minio.ListMultipartsInfo{}, convertError(err, bucketName, "")
+	}
 
-	stream.closed = true
-	stream.moreParts.Broadcast()
-}
+	// the paths are encrypted, so they have to be sorted after listing
+	sort.Slice(uploads, func(i, k int) bool {
+		if uploads[i].Object != uploads[k].Object {
+			return uploads[i].Object < uploads[k].Object
+		}
+		return uploads[i].UploadID < uploads[k].UploadID
+	})
 
-// Read implements io.Reader interface, blocking when there's no part
-func (stream *MultipartStream) Read(data []byte) (n int, err error) {
-	stream.mu.Lock()
-	for {
-		// has an error occurred?
-		if stream.err != nil {
-			stream.mu.Unlock()
-			return 0, Error.Wrap(err)
+	prefixes := make(map[string]bool)
+	for _, upload := range uploads {
+		if !strings.HasPrefix(upload.Object, prefix) {
+			continue
 		}
-		// still uploading the current part?
-		if stream.currentPart != nil {
-			break
+		if upload.Object < keyMarker || (upload.Object == keyMarker && (uploadIDMarker == "" || upload.UploadID <= uploadIDMarker)) {
+			continue
 		}
-		// do we have the next part?
-		if len(stream.parts) > 0 && stream.nextID == stream.parts[0].ID {
-			stream.currentPart = stream.parts[0]
-			stream.parts = stream.parts[1:]
-			stream.nextID++
+
+		if len(result.Uploads)+len(result.CommonPrefixes) >= maxUploads {
+			result.IsTruncated = true
 			break
 		}
-		// we don't have the next part and are closed, hence we are complete
-		if stream.closed {
-			stream.finished = true
-			stream.mu.Unlock()
-			return 0, io.EOF
-		}
-
-		stream.moreParts.Wait()
-	}
-	stream.mu.Unlock()
 
-	// read as much as we can
-	n, err = stream.currentPart.Reader.Read(data)
-	atomic.AddInt64(&stream.currentPart.Size, int64(n))
+		if delimiter != "" {
+			if i := strings.Index(upload.Object[len(prefix):], delimiter); i >= 0 {
+				commonPrefix := upload.Object[:len(prefix)+i+len(delimiter)]
+				if !prefixes[commonPrefix] {
+					prefixes[commonPrefix] = true
+					result.CommonPrefixes = append(result.CommonPrefixes, commonPrefix)
+				}
+				continue
+			}
+		}
 
-	if err == io.EOF {
-		// the part completed, hence advance to the next one
-		err = nil
-		close(stream.currentPart.Done)
-		stream.currentPart = nil
-	} else if err != nil {
-		// something bad happened, abort the whole thing
-		stream.Abort(err)
-		return n, Error.Wrap(err)
+		result.Uploads = append(result.Uploads, upload)
+		result.NextKeyMarker = upload.Object
+		result.NextUploadIDMarker = upload.UploadID
 	}
 
-	return n, err
+	return result, nil
 }
 
-// AddPart adds a new part to the stream to wait
-func (stream *MultipartStream) AddPart(partID int, data *hash.Reader) (*StreamPart, error) {
-	stream.mu.Lock()
-	defer stream.mu.Unlock()
-
-	if partID < stream.nextID {
-		return nil, Error.New("part %d already uploaded, next part ID is %d", partID, stream.nextID)
+// getUpload opens the marker of the pending upload
+func (layer *gatewayLayer) getUpload(ctx context.Context, bucket *uplink.Bucket, object, uploadID string) (*uplink.Object, error) {
+	if !validUploadID(uploadID) {
+		return nil, minio.InvalidUploadID{UploadID: uploadID}
 	}
 
-	for _, p := range stream.parts {
-		if p.ID == partID {
-			// Replace the reader of this part with the new one.
-			// This could happen if the read timeout for this part has expired
-			// and the client tries to upload the part again.
-			p.Reader = data
-			return p, nil
+	upload, err := bucket.OpenObject(ctx, uploadPath(object, uploadID))
+	if err != nil {
+		if storj.ErrObjectNotFound.Has(err) {
+			return nil, minio.InvalidUploadID{UploadID: uploadID}
 		}
+		return nil, convertError(err, bucket.Name, object)
 	}
 
-	stream.nextNumber++
-	part := &StreamPart{
-		Number: stream.nextNumber - 1,
-		ID:     partID,
-		Size:   0,
-		Reader: data,
-		Done:   make(chan error, 1),
-	}
+	return upload, nil
+}
+
+// listParts returns the uploaded parts of the upload sorted by the part number
+func listParts(ctx context.Context, bucket *uplink.Bucket, uploadID string) (parts []minio.PartInfo, err error) {
+	err = listAll(ctx, bucket, partsPrefix+uploadID+"/", func(item storj.Object) {
+		partNumber, err := strconv.Atoi(item.Path)
+		if err != nil {
+			// a part being uploaded
+			return
+		}
+		parts = append(parts, minio.PartInfo{
+			PartNumber:   partNumber,
+			LastModified: item.Modified,
+			ETag:         item.Metadata[etagKey],
+			Size:         item.Size,
+		})
+	})
 
-	stream.parts = append(stream.parts, part)
-	sort.Slice(stream.parts, func(i, k int) bool {
-		return stream.parts[i].ID < stream.parts[k].ID
+	sort.Slice(parts, func(i, k int) bool {
+		return parts[i].PartNumber < parts[k].PartNumber
 	})
 
-	stream.moreParts.Broadcast()
+	return parts, err
+}
+
+// listAll lists recursively all objects with the prefix, the paths are relative to the prefix
+func listAll(ctx context.Context, bucket *uplink.Bucket, prefix string, fn func(storj.Object)) (err error) {
+	cursor := ""
+	for {
+		list, err := bucket.ListObjects(ctx, &storj.ListOptions{
+			Direction: storj.After,
+			Cursor:    cursor,
+			Prefix:    prefix,
+			Recursive: true,
+		})
+		if err != nil {
+			if storj.ErrObjectNotFound.Has(err) {
+				return nil
+			}
+			return err
+		}
 
-	return part, nil
+		for _, item := range list.Items {
+			fn(item)
+		}
+
+		if !list.More || len(list.Items) == 0 {
+			return nil
+		}
+		cursor = list.Items[len(list.Items)-1].Path
+	}
 }
@@ -0,0 +1,226 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package miniogw
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"math/rand"
+	"sync"
+	"testing"
+
+	minio "github.com/minio/minio/cmd"
+	"github.com/minio/minio/pkg/hash"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/pkg/storage/streams"
+	"storj.io/storj/pkg/storj"
+)
+
+func TestMultipartUpload(t *testing.T) {
+	runTest(t, func(ctx context.Context, layer minio.ObjectLayer, metainfo storj.Metainfo, streams streams.Store) {
+		// small segments, so that every part has several of them
+		_, err := metainfo.CreateBucket(ctx, TestBucket, &storj.Bucket{
+			PathCipher:   storj.AESGCM,
+			SegmentsSize: 8 * memory.KiB.Int64(),
+		})
+		require.NoError(t, err)
+
+		_, err = layer.NewMultipartUpload(ctx, "non-existing", TestFile, nil)
+		assert.Equal(t, minio.BucketNotFound{Bucket: "non-existing"}, err)
+
+		uploadID, err := layer.NewMultipartUpload(ctx, TestBucket, TestFile, map[string]string{
+			"content-type": "text/plain",
+			"key":          "value",
+		})
+		require.NoError(t, err)
+
+		otherID, err := layer.NewMultipartUpload(ctx, TestBucket, "other/file", nil)
+		require.NoError(t, err)
+
+		parts := [][]byte{
+			randomData(20 * memory.KiB.Int()),
+			randomData(17 * memory.KiB.Int()),
+			randomData(5 * memory.KiB.Int()),
+		}
+
+		// upload the parts in parallel and in reverse order
+		infos := make([]minio.PartInfo, len(parts))
+		var wg sync.WaitGroup
+		for i := len(parts) - 1; i >= 0; i-- {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				info, err := putPart(ctx, layer, TestFile, uploadID, i+1, parts[i])
+				assert.NoError(t, err)
+				infos[i] = info
+			}(i)
+		}
+		wg.Wait()
+
+		for i, info := range infos {
+			sum := md5.Sum(parts[i])
+			assert.Equal(t, hex.EncodeToString(sum[:]), info.ETag)
+			assert.Equal(t, int64(len(parts[i])), info.Size)
+		}
+
+		// uploaded parts are listed
+		list, err := layer.ListObjectParts(ctx, TestBucket, TestFile, uploadID, 0, 2)
+		require.NoError(t, err)
+		assert.True(t, list.IsTruncated)
+		assert.Equal(t, 2, list.NextPartNumberMarker)
+		assert.Equal(t, map[string]string{"key": "value"}, list.UserDefined)
+		require.Len(t, list.Parts, 2)
+		assert.Equal(t, infos[0].ETag, list.Parts[0].ETag)
+		assert.Equal(t, infos[1].Size, list.Parts[1].Size)
+
+		list, err = layer.ListObjectParts(ctx, TestBucket, TestFile, uploadID, 2, 2)
+		require.NoError(t, err)
+		assert.False(t, list.IsTruncated)
+		require.Len(t, list.Parts, 1)
+		assert.Equal(t, 3, list.Parts[0].PartNumber)
+
+		// pending uploads are listed
+		uploads, err := layer.ListMultipartUploads(ctx, TestBucket, "", "", "", "", 10)
+		require.NoError(t, err)
+		require.Len(t, uploads.Uploads, 2)
+		assert.Equal(t, "other/file", uploads.Uploads[0].Object)
+		assert.Equal(t, otherID, uploads.Uploads[0].UploadID)
+		assert.Equal(t, TestFile, uploads.Uploads[1].Object)
+		assert.Equal(t, uploadID, uploads.Uploads[1].UploadID)
+
+		uploads, err = layer.ListMultipartUploads(ctx, TestBucket, "", "", "", "/", 10)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"other/"}, uploads.CommonPrefixes)
+		require.Len(t, uploads.Uploads, 1)
+		assert.Equal(t, TestFile, uploads.Uploads[0].Object)
+
+		// pending uploads are not objects
+		objects, err := layer.ListObjects(ctx, TestBucket, "", "", "", 100)
+		require.NoError(t, err)
+		assert.Empty(t, objects.Objects)
+
+		// a wrong ETag is rejected
+		_, err = layer.CompleteMultipartUpload(ctx, TestBucket, TestFile, uploadID, []minio.CompletePart{
+			{PartNumber: 1, ETag: infos[0].ETag},
+			{PartNumber: 2, ETag: infos[0].ETag},
+		})
+		assert.Equal(t, minio.InvalidPart{}, err)
+
+		completed := make([]minio.CompletePart, len(infos))
+		for i, info := range infos {
+			completed[i] = minio.CompletePart{PartNumber: info.PartNumber, ETag: info.ETag}
+		}
+
+		info, err := layer.CompleteMultipartUpload(ctx, TestBucket, TestFile, uploadID, completed)
+		require.NoError(t, err)
+
+		expected := bytes.Join(parts, nil)
+		assert.Equal(t, int64(len(expected)), info.Size)
+		assert.Equal(t, "text/plain", info.ContentType)
+		assert.Equal(t, "value", info.UserDefined["key"])
+		assert.Contains(t, info.ETag, "-3")
+
+		// the ETag of the completed upload is kept with the object
+		stored, err := layer.GetObjectInfo(ctx, TestBucket, TestFile)
+		require.NoError(t, err)
+		assert.Equal(t, info.ETag, stored.ETag)
+
+		var downloaded bytes.Buffer
+		err = layer.GetObject(ctx, TestBucket, TestFile, 0, info.Size, &downloaded, "")
+		require.NoError(t, err)
+		assert.Equal(t, expected, downloaded.Bytes())
+
+		// ranges across the boundaries of the parts
+		downloaded.Reset()
+		err = layer.GetObject(ctx, TestBucket, TestFile, 19*memory.KiB.Int64(), 20*memory.KiB.Int64(), &downloaded, "")
+		require.NoError(t, err)
+		assert.Equal(t, expected[19*memory.KiB.Int():39*memory.KiB.Int()], downloaded.Bytes())
+
+		// the completed upload is gone
+		_, err = layer.ListObjectParts(ctx, TestBucket, TestFile, uploadID, 0, 10)
+		assert.Equal(t, minio.InvalidUploadID{UploadID: uploadID}, err)
+
+		// the other upload is aborted with its parts
+		_, err = putPart(ctx, layer, "other/file", otherID, 1, randomData(memory.KiB.Int()))
+		require.NoError(t, err)
+		require.NoError(t, layer.AbortMultipartUpload(ctx, TestBucket, "other/file", otherID))
+
+		uploads, err = layer.ListMultipartUploads(ctx, TestBucket, "", "", "", "", 10)
+		require.NoError(t, err)
+		assert.Empty(t, uploads.Uploads)
+
+		objects, err = layer.ListObjects(ctx, TestBucket, "", "", "", 100)
+		require.NoError(t, err)
+		require.Len(t, objects.Objects, 1)
+		assert.Equal(t, TestFile, objects.Objects[0].Name)
+
+		err = layer.AbortMultipartUpload(ctx, TestBucket, "other/file", otherID)
+		assert.Equal(t, minio.InvalidUploadID{UploadID: otherID}, err)
+	})
+}
+
+func putPart(ctx context.Context, layer minio.ObjectLayer, object, uploadID string, partID int, data []byte) (minio.PartInfo, error) {
+	reader, err := hash.NewReader(bytes.NewReader(data), int64(len(data)), "", "")
+	if err != nil {
+		return minio.PartInfo{}, err
+	}
+	return layer.PutObjectPart(ctx, TestBucket, object, uploadID, partID, reader)
+}
+
+func randomData(size int) []byte {
+	data := make([]byte, size)
+	_, _ = rand.Read(data)
+	return data
+}
+
+func TestConcatKeepsStreamsOnFailure(t *testing.T) {
+	runTest(t, func(ctx context.Context, layer minio.ObjectLayer, metainfo storj.Metainfo, streams streams.Store) {
+		_, err := metainfo.CreateBucket(ctx, TestBucket, &storj.Bucket{
+			PathCipher:   storj.AESGCM,
+			SegmentsSize: 8 * memory.KiB.Int64(),
+		})
+		require.NoError(t, err)
+
+		existing := randomData(10 * memory.KiB.Int())
+		source := randomData(20 * memory.KiB.Int())
+		_, err = putObject(ctx, layer, TestFile, string(existing), nil)
+		require.NoError(t, err)
+		_, err = putObject(ctx, layer, "source", string(source), nil)
+		require.NoError(t, err)
+
+		// a missing source doesn't destroy the existing stream or the other sources
+		_, err = streams.Concat(ctx, TestBucket+"/"+TestFile, storj.AESGCM, []storj.Path{
+			TestBucket + "/source",
+			TestBucket + "/missing",
+		}, nil)
+		require.Error(t, err)
+
+		assert.Equal(t, existing, getObject(ctx, t, layer, TestFile))
+		assert.Equal(t, source, getObject(ctx, t, layer, "source"))
+
+		// a successful concatenation replaces the existing stream
+		_, err = streams.Concat(ctx, TestBucket+"/"+TestFile, storj.AESGCM, []storj.Path{
+			TestBucket + "/source",
+		}, nil)
+		require.NoError(t, err)
+		assert.Equal(t, source, getObject(ctx, t, layer, TestFile))
+
+		objects, err := layer.ListObjects(ctx, TestBucket, "", "", "", 100)
+		require.NoError(t, err)
+		require.Len(t, objects.Objects, 1)
+		assert.Equal(t, TestFile, objects.Objects[0].Name)
+	})
+}
+
+func getObject(ctx context.Context, t *testing.T, layer minio.ObjectLayer, object string) []byte {
+	var downloaded bytes.Buffer
+	err := layer.GetObject(ctx, TestBucket, object, 0, -1, &downloaded, "")
+	require.NoError(t, err)
+	return downloaded.Bytes()
+}
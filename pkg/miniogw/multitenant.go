@@ -0,0 +1,424 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package miniogw
+
+import (
+	"container/list"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"sync"
+	"time"
+
+	minio "github.com/minio/minio/cmd"
+	"github.com/minio/minio/cmd/logger"
+	"github.com/minio/minio/pkg/auth"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/lib/uplink"
+	"storj.io/storj/pkg/storj"
+)
+
+// ErrCredentials is the errs class of unknown or invalid S3 credentials
+var ErrCredentials = errs.Class("invalid credentials")
+
+// TenantCredentials describes the project served for an S3 access key
+type TenantCredentials struct {
+	SecretKey     string `json:"secret-key"`
+	SatelliteAddr string `json:"satellite-addr"`
+	APIKey        string `json:"api-key"`
+	EncryptionKey string `json:"encryption-key"`
+}
+
+// CredentialsStore maps S3 access keys to the credentials of their projects
+type CredentialsStore interface {
+	// Get returns the credentials for the access key
+	Get(ctx context.Context, accessKey string) (*TenantCredentials, error)
+}
+
+// FileCredentials is a CredentialsStore kept in a local JSON file, which maps
+// access keys to TenantCredentials. The file is read again when it changes,
+// so credentials can be added and revoked without restarting the gateway.
+type FileCredentials struct {
+	path string
+
+	mu          sync.Mutex
+	modified    time.Time
+	credentials map[string]TenantCredentials
+}
+
+// NewFileCredentials returns a credentials store kept in the file at path
+func NewFileCredentials(path string) *FileCredentials {
+	return &FileCredentials{path: path}
+}
+
+// Get returns the credentials for the access key
+func (store *FileCredentials) Get(ctx context.Context, accessKey string) (_ *TenantCredentials, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	info, err := os.Stat(store.path)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	if store.credentials == nil || !info.ModTime().Equal(store.modified) {
+		credentials, err := LoadCredentials(store.path)
+		if err != nil {
+			return nil, err
+		}
+		store.credentials, store.modified = credentials, info.ModTime()
+	}
+
+	credentials, ok := store.credentials[accessKey]
+	if !ok {
+		return nil, ErrCredentials.New("unknown access key %q", accessKey)
+	}
+	return &credentials, nil
+}
+
+// LoadCredentials reads the credentials of all access keys from the file at path
+func LoadCredentials(path string) (map[string]TenantCredentials, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	credentials := map[string]TenantCredentials{}
+	if err := json.Unmarshal(data, &credentials); err != nil {
+		return nil, Error.New("invalid credentials file %q: %v", path, err)
+	}
+	return credentials, nil
+}
+
+// SaveCredentials writes the credentials of all access keys to the file at path
+func SaveCredentials(path string, credentials map[string]TenantCredentials) error {
+	data, err := json.MarshalIndent(credentials, "", "\t")
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	return Error.Wrap(ioutil.WriteFile(path, data, 0600))
+}
+
+// MultiTenantGateway is a minio cmd.Gateway serving a project for each S3
+// credential. The credentials are resolved with a CredentialsStore and the
+// opened projects are kept in a cache with LRU eviction.
+//
+// Minio checks the signatures of all requests against its own credentials,
+// so the requests have to reach minio through a MultiTenantProxy, which checks
+// the signatures against the CredentialsStore and tells the gateway the tenant
+// of every request.
+type MultiTenantGateway struct {
+	log         *zap.Logger
+	uplink      *uplink.Uplink
+	credentials CredentialsStore
+	projects    *projectCache
+
+	pathCipher  storj.CipherSuite
+	encryption  storj.EncryptionParameters
+	redundancy  storj.RedundancyScheme
+	segmentSize memory.Size
+
+	mu       sync.Mutex
+	requests map[string]*tenantRequest // by the token passed as User-Agent to minio
+}
+
+// tenantRequest is a request the proxy has authenticated with the credentials of an access key
+type tenantRequest struct {
+	accessKey   string
+	credentials TenantCredentials
+}
+
+// NewMultiTenantGateway creates a gateway opening the projects of the credentials
+// with the uplink and keeping at most cacheSize of them open
+func NewMultiTenantGateway(log *zap.Logger, uplink *uplink.Uplink, credentials CredentialsStore, cacheSize int, pathCipher storj.CipherSuite, encryption storj.EncryptionParameters, redundancy storj.RedundancyScheme, segmentSize memory.Size) *MultiTenantGateway {
+	gateway := &MultiTenantGateway{
+		log:         log,
+		uplink:      uplink,
+		credentials: credentials,
+		pathCipher:  pathCipher,
+		encryption:  encryption,
+		redundancy:  redundancy,
+		segmentSize: segmentSize,
+		requests:    map[string]*tenantRequest{},
+	}
+	gateway.projects = newProjectCache(log, cacheSize, gateway.openProject)
+	return gateway
+}
+
+// Name implements cmd.Gateway
+func (gateway *MultiTenantGateway) Name() string {
+	return "storj"
+}
+
+// NewGatewayLayer implements cmd.Gateway. The returned layer serves every request
+// from the project of the credentials the proxy has authenticated it with.
+func (gateway *MultiTenantGateway) NewGatewayLayer(creds auth.Credentials) (minio.ObjectLayer, error) {
+	return &gatewayLayer{gateway: &Gateway{
+		pathCipher:  gateway.pathCipher,
+		encryption:  gateway.encryption,
+		redundancy:  gateway.redundancy,
+		segmentSize: gateway.segmentSize,
+		tenants:     gateway,
+	}}, nil
+}
+
+// Production implements cmd.Gateway
+func (gateway *MultiTenantGateway) Production() bool {
+	return false
+}
+
+// Close closes all the open projects
+func (gateway *MultiTenantGateway) Close() error {
+	return gateway.projects.Close()
+}
+
+// begin registers a request authenticated with the credentials of the access key, it returns
+// the token identifying the request to the gateway layer and the func ending the request
+func (gateway *MultiTenantGateway) begin(accessKey string, credentials TenantCredentials) (token string, end func(), err error) {
+	var data [32]byte
+	if _, err := rand.Read(data[:]); err != nil {
+		return "", nil, Error.Wrap(err)
+	}
+	token = hex.EncodeToString(data[:])
+
+	gateway.mu.Lock()
+	gateway.requests[token] = &tenantRequest{accessKey: accessKey, credentials: credentials}
+	gateway.mu.Unlock()
+
+	return token, func() {
+		gateway.mu.Lock()
+		delete(gateway.requests, token)
+		gateway.mu.Unlock()
+	}, nil
+}
+
+// tenantProject returns the project and the encryption key of the request, which minio passes in ctx.
+// release has to be called when the project isn't used anymore.
+func (gateway *MultiTenantGateway) tenantProject(ctx context.Context) (_ *uplink.Project, encKey *storj.Key, release func(), err error) {
+	// minio passes the User-Agent of the request, which the proxy sets to the token of the request
+	var token string
+	if info := logger.GetReqInfo(ctx); info != nil {
+		token = info.UserAgent
+	}
+
+	gateway.mu.Lock()
+	request, ok := gateway.requests[token]
+	gateway.mu.Unlock()
+	if !ok {
+		return nil, nil, nil, ErrCredentials.New("request wasn't authenticated")
+	}
+
+	encKey, err = storj.NewKey([]byte(request.credentials.EncryptionKey))
+	if err != nil {
+		return nil, nil, nil, Error.Wrap(err)
+	}
+
+	project, release, err := gateway.projects.Get(ctx, request.accessKey, request.credentials)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return project, encKey, release, nil
+}
+
+// openProject opens the project described by the credentials
+func (gateway *MultiTenantGateway) openProject(ctx context.Context, credentials TenantCredentials) (_ *uplink.Project, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	apiKey, err := uplink.ParseAPIKey(credentials.APIKey)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	encKey, err := storj.NewKey([]byte(credentials.EncryptionKey))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	var opts uplink.ProjectOptions
+	opts.Volatile.EncryptionKey = encKey
+
+	return gateway.uplink.OpenProject(ctx, credentials.SatelliteAddr, apiKey, &opts)
+}
+
+// projectCache keeps the projects opened for access keys, evicting the least
+// recently used one when there are more than capacity of them.
+//
+// The projects are reference counted, an evicted project is closed when the
+// last request using it releases it. A project is opened once for concurrent
+// requests of the same access key, without blocking the requests of others.
+type projectCache struct {
+	log      *zap.Logger
+	capacity int
+	open     func(context.Context, TenantCredentials) (*uplink.Project, error)
+	close    func(*uplink.Project) error
+
+	mu      sync.Mutex
+	recent  *list.List // of *cachedProject, most recently used first
+	entries map[string]*list.Element
+}
+
+type cachedProject struct {
+	accessKey   string
+	credentials TenantCredentials
+
+	// opened is closed, when the project has been opened or err has been set
+	opened  chan struct{}
+	project *uplink.Project
+	err     error
+
+	// refs is the number of requests using the project, and one more while the project is cached,
+	// it is protected by the lock of the cache
+	refs int
+}
+
+func newProjectCache(log *zap.Logger, capacity int, open func(context.Context, TenantCredentials) (*uplink.Project, error)) *projectCache {
+	if capacity < 1 {
+		capacity = 1
+	}
+	return &projectCache{
+		log:      log,
+		capacity: capacity,
+		open:     open,
+		close:    (*uplink.Project).Close,
+		recent:   list.New(),
+		entries:  map[string]*list.Element{},
+	}
+}
+
+// Get returns the project for the access key, opening it with the credentials
+// when it isn't cached or the credentials have changed. The caller has to call
+// release when it doesn't use the project anymore.
+func (cache *projectCache) Get(ctx context.Context, accessKey string, credentials TenantCredentials) (_ *uplink.Project, release func(), err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var closing []*cachedProject
+	defer func() {
+		for _, cached := range closing {
+			cache.closeProject(cached)
+		}
+	}()
+
+	cache.mu.Lock()
+	if element, ok := cache.entries[accessKey]; ok {
+		cached := element.Value.(*cachedProject)
+		if cached.credentials == credentials {
+			cached.refs++
+			cache.recent.MoveToFront(element)
+			cache.mu.Unlock()
+
+			return cache.wait(ctx, cached)
+		}
+		closing = cache.remove(element, closing)
+	}
+
+	cached := &cachedProject{
+		accessKey:   accessKey,
+		credentials: credentials,
+		opened:      make(chan struct{}),
+		refs:        2,
+	}
+	cache.entries[accessKey] = cache.recent.PushFront(cached)
+
+	for cache.recent.Len() > cache.capacity {
+		closing = cache.remove(cache.recent.Back(), closing)
+	}
+	cache.mu.Unlock()
+
+	// the project is opened without holding the lock, the other requests of the access key wait for it
+	project, err := cache.open(ctx, credentials)
+
+	cache.mu.Lock()
+	cached.project, cached.err = project, err
+	if err != nil {
+		// the next request opens the project again
+		if element, ok := cache.entries[accessKey]; ok && element.Value == cached {
+			closing = cache.remove(element, closing)
+		}
+	}
+	close(cached.opened)
+	cache.mu.Unlock()
+
+	return cache.wait(ctx, cached)
+}
+
+// wait waits until the project of the entry has been opened, the caller holds a reference to the entry
+func (cache *projectCache) wait(ctx context.Context, cached *cachedProject) (_ *uplink.Project, release func(), err error) {
+	select {
+	case <-cached.opened:
+	case <-ctx.Done():
+		cache.release(cached)
+		return nil, nil, ctx.Err()
+	}
+
+	if cached.err != nil {
+		cache.release(cached)
+		return nil, nil, cached.err
+	}
+
+	var once sync.Once
+	return cached.project, func() { once.Do(func() { cache.release(cached) }) }, nil
+}
+
+// Len returns the number of cached projects
+func (cache *projectCache) Len() int {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	return cache.recent.Len()
+}
+
+// Close removes all the projects from the cache, they are closed when they are released
+func (cache *projectCache) Close() (err error) {
+	cache.mu.Lock()
+	var closing []*cachedProject
+	for cache.recent.Len() > 0 {
+		closing = cache.remove(cache.recent.Front(), closing)
+	}
+	cache.mu.Unlock()
+
+	var errlist errs.Group
+	for _, cached := range closing {
+		errlist.Add(cache.close(cached.project))
+	}
+	return errlist.Err()
+}
+
+// release drops a reference to the entry, the project is closed when it isn't cached and used anymore
+func (cache *projectCache) release(cached *cachedProject) {
+	cache.mu.Lock()
+	cached.refs--
+	closing := cached.refs == 0 && cached.project != nil
+	cache.mu.Unlock()
+
+	if closing {
+		cache.closeProject(cached)
+	}
+}
+
+// remove removes the element from the cache and drops the reference of the cache, the entry is
+// appended to closing, when its project isn't used anymore and has to be closed. The caller must hold the lock.
+func (cache *projectCache) remove(element *list.Element, closing []*cachedProject) []*cachedProject {
+	cached := cache.recent.Remove(element).(*cachedProject)
+	delete(cache.entries, cached.accessKey)
+
+	cached.refs--
+	if cached.refs == 0 && cached.project != nil {
+		closing = append(closing, cached)
+	}
+	return closing
+}
+
+// closeProject closes the project of the entry
+func (cache *projectCache) closeProject(cached *cachedProject) {
+	if err := cache.close(cached.project); err != nil {
+		cache.log.Warn("failed to close project", zap.String("access key", cached.accessKey), zap.Error(err))
+	}
+}
@@ -0,0 +1,361 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package miniogw
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	minio "github.com/minio/minio-go"
+	minioserver "github.com/minio/minio/cmd"
+	"github.com/minio/minio/cmd/logger"
+	"github.com/minio/minio/pkg/auth"
+	"github.com/minio/minio/pkg/hash"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap/zaptest"
+	"golang.org/x/sync/errgroup"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testplanet"
+	libuplink "storj.io/storj/lib/uplink"
+	"storj.io/storj/pkg/storj"
+)
+
+func TestProjectCache(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	var mu sync.Mutex
+	var opened []TenantCredentials
+	closed := map[*libuplink.Project]bool{}
+
+	cache := newProjectCache(zaptest.NewLogger(t), 2, func(ctx context.Context, credentials TenantCredentials) (*libuplink.Project, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		opened = append(opened, credentials)
+		return &libuplink.Project{}, nil
+	})
+	cache.close = func(project *libuplink.Project) error {
+		mu.Lock()
+		defer mu.Unlock()
+		closed[project] = true
+		return nil
+	}
+	isClosed := func(project *libuplink.Project) bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return closed[project]
+	}
+
+	a := TenantCredentials{APIKey: "a"}
+	b := TenantCredentials{APIKey: "b"}
+	c := TenantCredentials{APIKey: "c"}
+
+	projectA, releaseA, err := cache.Get(ctx, "a", a)
+	require.NoError(t, err)
+	projectB, releaseB, err := cache.Get(ctx, "b", b)
+	require.NoError(t, err)
+	releaseB()
+
+	// cached projects are reused
+	project, release, err := cache.Get(ctx, "a", a)
+	require.NoError(t, err)
+	assert.True(t, projectA == project)
+	release()
+	assert.Equal(t, []TenantCredentials{a, b}, opened)
+
+	// the least recently used project is evicted and closed, as it isn't used
+	_, releaseC, err := cache.Get(ctx, "c", c)
+	require.NoError(t, err)
+	releaseC()
+	assert.Equal(t, 2, cache.Len())
+	assert.True(t, isClosed(projectB))
+
+	// an evicted project is closed, when the last request releases it
+	_, releaseB, err = cache.Get(ctx, "b", b)
+	require.NoError(t, err)
+	releaseB()
+	_, releaseC, err = cache.Get(ctx, "c", c)
+	require.NoError(t, err)
+	releaseC()
+	assert.Equal(t, []TenantCredentials{a, b, c, b}, opened)
+	assert.False(t, isClosed(projectA))
+
+	releaseA()
+	assert.True(t, isClosed(projectA))
+
+	// changed credentials open the project again
+	changed := TenantCredentials{APIKey: "b2"}
+	_, release, err = cache.Get(ctx, "b", changed)
+	require.NoError(t, err)
+	release()
+	assert.Equal(t, []TenantCredentials{a, b, c, b, changed}, opened)
+	assert.Equal(t, 2, cache.Len())
+
+	require.NoError(t, cache.Close())
+	assert.Equal(t, 0, cache.Len())
+}
+
+func TestProjectCacheOpensOnce(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	unblock := make(chan struct{})
+	var mu sync.Mutex
+	opens := map[string]int{}
+
+	cache := newProjectCache(zaptest.NewLogger(t), 10, func(ctx context.Context, credentials TenantCredentials) (*libuplink.Project, error) {
+		mu.Lock()
+		opens[credentials.APIKey]++
+		mu.Unlock()
+
+		switch credentials.APIKey {
+		case "slow":
+			<-unblock
+		case "invalid":
+			return nil, errs.New("invalid api key")
+		}
+		return &libuplink.Project{}, nil
+	})
+	defer ctx.Check(cache.Close)
+
+	const concurrency = 5
+	projects := make(chan *libuplink.Project, concurrency)
+	var group errgroup.Group
+	for i := 0; i < concurrency; i++ {
+		group.Go(func() error {
+			project, release, err := cache.Get(ctx, "slow", TenantCredentials{APIKey: "slow"})
+			if err != nil {
+				return err
+			}
+			defer release()
+			projects <- project
+			return nil
+		})
+	}
+
+	// the other access keys aren't blocked by a project being opened
+	_, release, err := cache.Get(ctx, "fast", TenantCredentials{APIKey: "fast"})
+	require.NoError(t, err)
+	release()
+
+	// a failed open isn't cached
+	for i := 0; i < 2; i++ {
+		_, _, err = cache.Get(ctx, "invalid", TenantCredentials{APIKey: "invalid"})
+		require.Error(t, err)
+	}
+
+	close(unblock)
+	require.NoError(t, group.Wait())
+	close(projects)
+
+	first := <-projects
+	for project := range projects {
+		assert.True(t, first == project)
+	}
+
+	assert.Equal(t, map[string]int{"slow": 1, "fast": 1, "invalid": 2}, opens)
+	assert.Equal(t, 2, cache.Len())
+}
+
+func TestMultiTenantGateway(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 2,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+
+		credentialsPath := filepath.Join(ctx.Dir("gateway"), "credentials.json")
+		err := SaveCredentials(credentialsPath, map[string]TenantCredentials{
+			"alice": {
+				SecretKey:     "alice-secret",
+				SatelliteAddr: satellite.Addr(),
+				APIKey:        planet.Uplinks[0].APIKey[satellite.ID()],
+				EncryptionKey: "alice-encryption-key",
+			},
+			"bob": {
+				SecretKey:     "bob-secret",
+				SatelliteAddr: satellite.Addr(),
+				APIKey:        planet.Uplinks[1].APIKey[satellite.ID()],
+				EncryptionKey: "bob-encryption-key",
+			},
+		})
+		require.NoError(t, err)
+
+		cfg := libuplink.Config{}
+		cfg.Volatile.TLS.SkipPeerCAWhitelist = true
+		uplink, err := libuplink.NewUplink(ctx, &cfg)
+		require.NoError(t, err)
+		defer ctx.Check(uplink.Close)
+
+		gateway := NewMultiTenantGateway(zaptest.NewLogger(t), uplink, NewFileCredentials(credentialsPath), 1,
+			storj.EncAESGCM,
+			storj.EncryptionParameters{CipherSuite: storj.EncAESGCM, BlockSize: 1 * memory.KiB.Int32()},
+			storj.RedundancyScheme{},
+			64*memory.MiB,
+		)
+		defer ctx.Check(gateway.Close)
+
+		internal := auth.Credentials{AccessKey: "internal", SecretKey: "internal-secret"}
+		layer, err := gateway.NewGatewayLayer(internal)
+		require.NoError(t, err)
+
+		backend := httptest.NewServer(newFakeMinio(t, layer, internal))
+		defer backend.Close()
+
+		proxy, err := NewMultiTenantProxy(zaptest.NewLogger(t), gateway, backend.URL, internal)
+		require.NoError(t, err)
+
+		server := httptest.NewServer(proxy)
+		defer server.Close()
+
+		newClient := func(accessKey, secretKey string) *minio.Client {
+			client, err := minio.NewWithRegion(server.Listener.Addr().String(), accessKey, secretKey, false, "us-east-1")
+			require.NoError(t, err)
+			return client
+		}
+		alice := newClient("alice", "alice-secret")
+		bob := newClient("bob", "bob-secret")
+
+		for _, invalid := range []struct {
+			client *minio.Client
+			code   string
+		}{
+			{newClient("alice", "bob-secret"), "SignatureDoesNotMatch"},
+			{newClient("carol", "carol-secret"), "InvalidAccessKeyId"},
+			{newClient("internal", "internal-secret"), "InvalidAccessKeyId"},
+		} {
+			_, err := invalid.client.ListBuckets()
+			require.Error(t, err)
+			assert.Equal(t, invalid.code, minio.ToErrorResponse(err).Code)
+			assert.Equal(t, http.StatusForbidden, minio.ToErrorResponse(err).StatusCode)
+		}
+
+		require.NoError(t, alice.MakeBucket("alice-bucket", ""))
+		require.NoError(t, bob.MakeBucket("bob-bucket", ""))
+
+		// every credential sees the buckets of its own project only,
+		// even though the projects are evicted from the cache in between
+		for client, expected := range map[*minio.Client]string{alice: "alice-bucket", bob: "bob-bucket"} {
+			buckets, err := client.ListBuckets()
+			require.NoError(t, err)
+			require.Len(t, buckets, 1)
+			assert.Equal(t, expected, buckets[0].Name)
+		}
+		assert.Equal(t, 1, gateway.projects.Len())
+
+		// the upload is signed chunk by chunk over plain http, small enough to be stored inline
+		data := make([]byte, 3*memory.KiB.Int())
+		_, err = rand.Read(data)
+		require.NoError(t, err)
+		_, err = alice.PutObject("alice-bucket", "object", bytes.NewReader(data), int64(len(data)), minio.PutObjectOptions{})
+		require.NoError(t, err)
+
+		object, err := alice.GetObject("alice-bucket", "object", minio.GetObjectOptions{})
+		require.NoError(t, err)
+		downloaded, err := ioutil.ReadAll(object)
+		require.NoError(t, err)
+		assert.Equal(t, data, downloaded)
+
+		_, err = bob.PutObject("alice-bucket", "object", bytes.NewReader(data), int64(len(data)), minio.PutObjectOptions{})
+		require.Error(t, err)
+
+		presigned, err := alice.PresignedGetObject("alice-bucket", "object", time.Hour, nil)
+		require.NoError(t, err)
+		resp, err := http.Get(presigned.String())
+		require.NoError(t, err)
+		downloaded, err = ioutil.ReadAll(resp.Body)
+		require.NoError(t, resp.Body.Close())
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, data, downloaded)
+
+		resp, err = http.Get(server.URL + "/minio/admin/v1/info")
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	})
+}
+
+// newFakeMinio mimics the parts of minio the test uses, it checks the requests are signed
+// with the internal credentials and passes the User-Agent to the layer, like minio does
+func newFakeMinio(t *testing.T, layer minioserver.ObjectLayer, internal auth.Credentials) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sig, err := parseSignatureV4(r)
+		if err != nil || sig == nil || sig.accessKey != internal.AccessKey || sig.verify(r, internal.SecretKey, time.Now()) != nil {
+			writeS3Error(w, r, http.StatusForbidden, "SignatureDoesNotMatch", "internal signature")
+			return
+		}
+
+		ctx := logger.SetReqInfo(context.Background(), &logger.ReqInfo{UserAgent: r.UserAgent()})
+		bucket, object := splitPair(strings.TrimPrefix(r.URL.Path, "/"), "/")
+
+		switch {
+		case r.Method == http.MethodGet && bucket == "":
+			buckets, err := layer.ListBuckets(ctx)
+			if err != nil {
+				break
+			}
+			var result struct {
+				XMLName xml.Name `xml:"ListAllMyBucketsResult"`
+				Buckets []string `xml:"Buckets>Bucket>Name"`
+			}
+			for _, bucket := range buckets {
+				result.Buckets = append(result.Buckets, bucket.Name)
+			}
+			data, err := xml.Marshal(result)
+			require.NoError(t, err)
+			_, _ = w.Write(data)
+			return
+		case r.Method == http.MethodPut && object == "":
+			err = layer.MakeBucketWithLocation(ctx, bucket, "")
+			if err != nil {
+				break
+			}
+			return
+		case r.Method == http.MethodPut:
+			var reader *hash.Reader
+			reader, err = hash.NewReader(r.Body, r.ContentLength, "", "")
+			if err != nil {
+				break
+			}
+			var info minioserver.ObjectInfo
+			info, err = layer.PutObject(ctx, bucket, object, reader, nil)
+			if err != nil {
+				break
+			}
+			w.Header().Set("ETag", `"`+info.ETag+`"`)
+			return
+		case r.Method == http.MethodGet:
+			var info minioserver.ObjectInfo
+			info, err = layer.GetObjectInfo(ctx, bucket, object)
+			if err != nil {
+				break
+			}
+			w.Header().Set("Last-Modified", info.ModTime.UTC().Format(http.TimeFormat))
+			w.Header().Set("Content-Length", strconv.FormatInt(info.Size, 10))
+			err = layer.GetObject(ctx, bucket, object, 0, info.Size, w, "")
+			if err != nil {
+				break
+			}
+			return
+		}
+
+		writeS3Error(w, r, http.StatusBadRequest, "InvalidRequest", fmt.Sprint(err))
+	})
+}
@@ -0,0 +1,215 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package miniogw
+
+import (
+	"encoding/xml"
+	"io/ioutil"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/minio/minio/pkg/auth"
+	"go.uber.org/zap"
+)
+
+const (
+	// minioPrefix is the path, under which minio serves its own apis, e.g. the admin api
+	minioPrefix = "/minio/"
+	// healthPrefix is the path of the health checks of minio, which don't need credentials
+	healthPrefix = "/minio/health/"
+)
+
+// MultiTenantProxy serves the S3 requests of a MultiTenantGateway. It checks the
+// AWS Signature Version 4 of every request against the credentials store of the
+// gateway, and forwards the authenticated requests to the minio server running
+// the gateway, signed again with the credentials of minio.
+//
+// Signature Version 2 and anonymous requests aren't supported.
+type MultiTenantProxy struct {
+	log       *zap.Logger
+	gateway   *MultiTenantGateway
+	minio     *url.URL
+	internal  auth.Credentials
+	transport http.RoundTripper
+
+	nowFn func() time.Time
+}
+
+// NewMultiTenantProxy creates a proxy for the minio server at minioURL,
+// whose credentials are internal. They must not be given to anyone else.
+func NewMultiTenantProxy(log *zap.Logger, gateway *MultiTenantGateway, minioURL string, internal auth.Credentials) (*MultiTenantProxy, error) {
+	target, err := url.Parse(minioURL)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return &MultiTenantProxy{
+		log:       log,
+		gateway:   gateway,
+		minio:     target,
+		internal:  internal,
+		transport: http.DefaultTransport,
+		nowFn:     time.Now,
+	}, nil
+}
+
+// ServeHTTP authenticates the request and forwards it to minio
+func (proxy *MultiTenantProxy) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	if strings.HasPrefix(r.URL.Path, minioPrefix) {
+		if strings.HasPrefix(r.URL.Path, healthPrefix) {
+			proxy.forward(w, r.WithContext(ctx), nil)
+			return
+		}
+		// the admin api and the web browser of minio would be authorized with the credentials of minio
+		writeS3Error(w, r, http.StatusForbidden, "AccessDenied", "Access Denied.")
+		return
+	}
+
+	if isSignedV2(r) {
+		writeS3Error(w, r, http.StatusForbidden, "AccessDenied", "Signature Version 2 isn't supported.")
+		return
+	}
+
+	sig, err := parseSignatureV4(r)
+	if err != nil {
+		writeS3Error(w, r, http.StatusBadRequest, "AuthorizationHeaderMalformed", err.Error())
+		return
+	}
+	if sig == nil {
+		writeS3Error(w, r, http.StatusForbidden, "AccessDenied", "Anonymous requests aren't supported.")
+		return
+	}
+
+	credentials, err := proxy.gateway.credentials.Get(ctx, sig.accessKey)
+	if err != nil {
+		if ErrCredentials.Has(err) {
+			writeS3Error(w, r, http.StatusForbidden, "InvalidAccessKeyId", "The access key doesn't exist.")
+			return
+		}
+		proxy.log.Error("reading credentials failed", zap.Error(err))
+		writeS3Error(w, r, http.StatusInternalServerError, "InternalError", "We encountered an internal error, please try again.")
+		return
+	}
+
+	err = sig.verify(r, credentials.SecretKey, proxy.nowFn())
+	if err != nil {
+		writeS3Error(w, r, http.StatusForbidden, "SignatureDoesNotMatch", err.Error())
+		return
+	}
+
+	token, end, err := proxy.gateway.begin(sig.accessKey, *credentials)
+	if err != nil {
+		proxy.log.Error("registering request failed", zap.Error(err))
+		writeS3Error(w, r, http.StatusInternalServerError, "InternalError", "We encountered an internal error, please try again.")
+		return
+	}
+	defer end()
+
+	out := r.Clone(ctx)
+	out.Header.Set("X-Amz-Content-Sha256", sig.payloadHash)
+
+	if sig.presigned {
+		query := out.URL.Query()
+		for key := range query {
+			if strings.HasPrefix(strings.ToLower(key), "x-amz-") {
+				query.Del(key)
+			}
+		}
+		out.URL.RawQuery = query.Encode()
+	}
+
+	// the chunks are signed with the secret key of the tenant, so they are verified here
+	// and minio receives the decoded content as an unsigned payload
+	var chunked *chunkedReader
+	if sig.payloadHash == streamingPayload {
+		size, err := strconv.ParseInt(r.Header.Get("X-Amz-Decoded-Content-Length"), 10, 64)
+		if err != nil || size < 0 {
+			writeS3Error(w, r, http.StatusLengthRequired, "MissingContentLength", "You must provide the Content-Length HTTP header.")
+			return
+		}
+
+		chunked = newChunkedReader(r.Body, sig, credentials.SecretKey)
+		out.Body = ioutil.NopCloser(chunked)
+		out.ContentLength = size
+		out.Header.Del("X-Amz-Decoded-Content-Length")
+		out.Header.Set("X-Amz-Content-Sha256", unsignedPayload)
+
+		var encodings []string
+		for _, encoding := range strings.Split(out.Header.Get("Content-Encoding"), ",") {
+			if encoding = strings.TrimSpace(encoding); encoding != "" && encoding != "aws-chunked" {
+				encodings = append(encodings, encoding)
+			}
+		}
+		out.Header.Del("Content-Encoding")
+		if len(encodings) > 0 {
+			out.Header.Set("Content-Encoding", strings.Join(encodings, ","))
+		}
+	}
+
+	// minio passes the User-Agent to the gateway layer, which serves the request from the project of the token
+	out.Header.Set("User-Agent", token)
+
+	err = signRequest(out, proxy.internal.AccessKey, proxy.internal.SecretKey, sig.region, proxy.nowFn())
+	if err != nil {
+		proxy.log.Error("signing request failed", zap.Error(err))
+		writeS3Error(w, r, http.StatusInternalServerError, "InternalError", "We encountered an internal error, please try again.")
+		return
+	}
+
+	proxy.forward(w, out, chunked)
+}
+
+// forward sends the request to minio and copies the response back
+func (proxy *MultiTenantProxy) forward(w http.ResponseWriter, r *http.Request, chunked *chunkedReader) {
+	reverse := &httputil.ReverseProxy{
+		Director: func(req *http.Request) {
+			req.URL.Scheme = proxy.minio.Scheme
+			req.URL.Host = proxy.minio.Host
+		},
+		Transport: proxy.transport,
+		ErrorHandler: func(w http.ResponseWriter, req *http.Request, err error) {
+			if chunked != nil && chunked.err != nil {
+				if ErrSignature.Has(chunked.err) {
+					writeS3Error(w, r, http.StatusForbidden, "SignatureDoesNotMatch", chunked.err.Error())
+					return
+				}
+				writeS3Error(w, r, http.StatusBadRequest, "IncompleteBody", chunked.err.Error())
+				return
+			}
+			proxy.log.Error("forwarding request to minio failed", zap.Error(err))
+			writeS3Error(w, r, http.StatusBadGateway, "InternalError", "We encountered an internal error, please try again.")
+		},
+	}
+	reverse.ServeHTTP(w, r)
+}
+
+// s3Error is the body of an S3 error response
+type s3Error struct {
+	XMLName  xml.Name `xml:"Error"`
+	Code     string   `xml:"Code"`
+	Message  string   `xml:"Message"`
+	Resource string   `xml:"Resource"`
+}
+
+// writeS3Error responds to the request with an S3 error
+func writeS3Error(w http.ResponseWriter, r *http.Request, status int, code, message string) {
+	data, err := xml.Marshal(s3Error{Code: code, Message: message, Resource: r.URL.Path})
+	if err != nil {
+		http.Error(w, message, status)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(status)
+	_, _ = w.Write([]byte(xml.Header))
+	_, _ = w.Write(data)
+}
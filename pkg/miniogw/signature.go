@@ -0,0 +1,397 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package miniogw
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/internal/memory"
+)
+
+const (
+	signV4Algorithm    = "AWS4-HMAC-SHA256"
+	signV4ChunkPrefix  = "AWS4-HMAC-SHA256-PAYLOAD"
+	signV4Service      = "s3"
+	signV4Terminator   = "aws4_request"
+	iso8601Format      = "20060102T150405Z"
+	yyyymmdd           = "20060102"
+	unsignedPayload    = "UNSIGNED-PAYLOAD"
+	streamingPayload   = "STREAMING-AWS4-HMAC-SHA256-PAYLOAD"
+	emptySHA256        = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+	maxRequestSkew     = 15 * time.Minute
+	maxPresignExpires  = 7 * 24 * time.Hour
+	maxSignedChunkSize = 16 * memory.MiB
+)
+
+var (
+	// ErrSignature is the errs class of requests, whose signature can't be verified
+	ErrSignature = errs.Class("signature mismatch")
+	// ErrMalformedSignature is the errs class of requests, whose signature can't be parsed
+	ErrMalformedSignature = errs.Class("malformed signature")
+)
+
+// signatureV4 is the AWS Signature Version 4 of a request, signed either in
+// the Authorization header or in the query of a presigned url
+type signatureV4 struct {
+	accessKey     string
+	scopeDate     string
+	region        string
+	signedHeaders []string
+	signature     string
+
+	date        time.Time
+	payloadHash string
+
+	presigned bool
+	expires   time.Duration
+}
+
+// scope returns the credential scope of the signature
+func (sig *signatureV4) scope() string {
+	return strings.Join([]string{sig.scopeDate, sig.region, signV4Service, signV4Terminator}, "/")
+}
+
+// isSignedV2 returns whether the request is signed with AWS Signature Version 2
+func isSignedV2(r *http.Request) bool {
+	return strings.HasPrefix(r.Header.Get("Authorization"), "AWS ") || r.URL.Query().Get("AWSAccessKeyId") != ""
+}
+
+// parseSignatureV4 parses the signature of the request, it returns nil for anonymous requests
+func parseSignatureV4(r *http.Request) (_ *signatureV4, err error) {
+	query := r.URL.Query()
+
+	sig := &signatureV4{}
+	var credential, signedHeaders, date string
+
+	switch {
+	case strings.HasPrefix(r.Header.Get("Authorization"), signV4Algorithm+" "):
+		fields := strings.Split(strings.TrimPrefix(r.Header.Get("Authorization"), signV4Algorithm+" "), ",")
+		for _, field := range fields {
+			key, value := splitPair(strings.TrimSpace(field), "=")
+			switch key {
+			case "Credential":
+				credential = value
+			case "SignedHeaders":
+				signedHeaders = value
+			case "Signature":
+				sig.signature = value
+			}
+		}
+
+		date = r.Header.Get("X-Amz-Date")
+		if date == "" {
+			date = r.Header.Get("Date")
+		}
+
+		sig.payloadHash = emptySHA256
+		if hashes, ok := r.Header["X-Amz-Content-Sha256"]; ok && len(hashes) > 0 {
+			sig.payloadHash = hashes[0]
+		}
+
+	case query.Get("X-Amz-Algorithm") != "":
+		if query.Get("X-Amz-Algorithm") != signV4Algorithm {
+			return nil, ErrMalformedSignature.New("unsupported algorithm %q", query.Get("X-Amz-Algorithm"))
+		}
+
+		sig.presigned = true
+		credential = query.Get("X-Amz-Credential")
+		signedHeaders = query.Get("X-Amz-SignedHeaders")
+		sig.signature = query.Get("X-Amz-Signature")
+		date = query.Get("X-Amz-Date")
+
+		expires, err := strconv.ParseInt(query.Get("X-Amz-Expires"), 10, 64)
+		if err != nil || expires < 0 || time.Duration(expires)*time.Second > maxPresignExpires {
+			return nil, ErrMalformedSignature.New("invalid expiration %q", query.Get("X-Amz-Expires"))
+		}
+		sig.expires = time.Duration(expires) * time.Second
+
+		sig.payloadHash = unsignedPayload
+		if hash := query.Get("X-Amz-Content-Sha256"); hash != "" {
+			sig.payloadHash = hash
+		}
+
+	default:
+		return nil, nil
+	}
+
+	scope := strings.Split(credential, "/")
+	if len(scope) != 5 || scope[0] == "" || scope[3] != signV4Service || scope[4] != signV4Terminator {
+		return nil, ErrMalformedSignature.New("invalid credential %q", credential)
+	}
+	sig.accessKey, sig.scopeDate, sig.region = scope[0], scope[1], scope[2]
+
+	if signedHeaders == "" || sig.signature == "" {
+		return nil, ErrMalformedSignature.New("missing signed headers or signature")
+	}
+	sig.signedHeaders = strings.Split(signedHeaders, ";")
+
+	sig.date, err = time.Parse(iso8601Format, date)
+	if err != nil {
+		return nil, ErrMalformedSignature.New("invalid date %q", date)
+	}
+	if sig.date.Format(yyyymmdd) != sig.scopeDate {
+		return nil, ErrMalformedSignature.New("date %q doesn't match credential scope", date)
+	}
+
+	return sig, nil
+}
+
+// verify checks that the request was signed with the secret key at a time valid at now
+func (sig *signatureV4) verify(r *http.Request, secretKey string, now time.Time) error {
+	if sig.presigned {
+		if sig.date.After(now.Add(maxRequestSkew)) {
+			return ErrSignature.New("request is not valid yet")
+		}
+		if now.Sub(sig.date) > sig.expires {
+			return ErrSignature.New("request has expired")
+		}
+	} else if sig.date.After(now.Add(maxRequestSkew)) || sig.date.Before(now.Add(-maxRequestSkew)) {
+		return ErrSignature.New("request time too skewed")
+	}
+
+	query := r.URL.Query()
+	if sig.presigned {
+		query.Del("X-Amz-Signature")
+	}
+
+	canonical, err := canonicalRequest(r, sig.signedHeaders, query, sig.payloadHash)
+	if err != nil {
+		return err
+	}
+
+	expected := signV4(secretKey, sig.date, sig.region, sig.scope(), canonical)
+	if subtle.ConstantTimeCompare([]byte(expected), []byte(sig.signature)) != 1 {
+		return ErrSignature.New("access key %q", sig.accessKey)
+	}
+	return nil
+}
+
+// signRequest signs the request in the Authorization header with the credentials at now
+func signRequest(r *http.Request, accessKey, secretKey, region string, now time.Time) error {
+	now = now.UTC()
+	scope := strings.Join([]string{now.Format(yyyymmdd), region, signV4Service, signV4Terminator}, "/")
+
+	payloadHash := r.Header.Get("X-Amz-Content-Sha256")
+	if payloadHash == "" {
+		payloadHash = emptySHA256
+		r.Header.Set("X-Amz-Content-Sha256", payloadHash)
+	}
+	r.Header.Set("X-Amz-Date", now.Format(iso8601Format))
+	r.Header.Del("Authorization")
+
+	signedHeaders := []string{"host", "x-amz-content-sha256", "x-amz-date"}
+	canonical, err := canonicalRequest(r, signedHeaders, r.URL.Query(), payloadHash)
+	if err != nil {
+		return err
+	}
+
+	r.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		signV4Algorithm, accessKey, scope, strings.Join(signedHeaders, ";"),
+		signV4(secretKey, now, region, scope, canonical)))
+	return nil
+}
+
+// canonicalRequest returns the canonical request of the signature, as minio calculates it
+func canonicalRequest(r *http.Request, signedHeaders []string, query url.Values, payloadHash string) (string, error) {
+	headers := make([]string, len(signedHeaders))
+	copy(headers, signedHeaders)
+	sort.Strings(headers)
+
+	hasHost := false
+	var canonicalHeaders strings.Builder
+	for _, header := range headers {
+		header = strings.ToLower(header)
+
+		values, ok := r.Header[http.CanonicalHeaderKey(header)]
+		if !ok {
+			// the go http server removes some of the headers from the request
+			switch header {
+			case "host":
+				values = []string{r.Host}
+			case "expect":
+				values = []string{"100-continue"}
+			case "transfer-encoding":
+				values = r.TransferEncoding
+			case "content-length":
+				values = []string{strconv.FormatInt(r.ContentLength, 10)}
+			default:
+				return "", ErrSignature.New("signed header %q is missing", header)
+			}
+		}
+		hasHost = hasHost || header == "host"
+
+		canonicalHeaders.WriteString(header)
+		canonicalHeaders.WriteByte(':')
+		for i, value := range values {
+			if i > 0 {
+				canonicalHeaders.WriteByte(',')
+			}
+			canonicalHeaders.WriteString(strings.Join(strings.Fields(value), " "))
+		}
+		canonicalHeaders.WriteByte('\n')
+	}
+	if !hasHost {
+		return "", ErrSignature.New("host header isn't signed")
+	}
+
+	return strings.Join([]string{
+		r.Method,
+		encodePath(r.URL.Path),
+		strings.Replace(query.Encode(), "+", "%20", -1),
+		canonicalHeaders.String(),
+		strings.ToLower(strings.Join(headers, ";")),
+		payloadHash,
+	}, "\n"), nil
+}
+
+// signV4 returns the signature of the canonical request
+func signV4(secretKey string, date time.Time, region, scope, canonical string) string {
+	hash := sha256.Sum256([]byte(canonical))
+	stringToSign := strings.Join([]string{
+		signV4Algorithm,
+		date.UTC().Format(iso8601Format),
+		scope,
+		hex.EncodeToString(hash[:]),
+	}, "\n")
+	return hex.EncodeToString(sumHMAC(signingKey(secretKey, date, region), []byte(stringToSign)))
+}
+
+// signingKey derives the key signing the requests of a day and a region
+func signingKey(secretKey string, date time.Time, region string) []byte {
+	key := sumHMAC([]byte("AWS4"+secretKey), []byte(date.UTC().Format(yyyymmdd)))
+	key = sumHMAC(key, []byte(region))
+	key = sumHMAC(key, []byte(signV4Service))
+	return sumHMAC(key, []byte(signV4Terminator))
+}
+
+func sumHMAC(key []byte, data []byte) []byte {
+	hash := hmac.New(sha256.New, key)
+	_, _ = hash.Write(data)
+	return hash.Sum(nil)
+}
+
+// encodePath escapes the path for the canonical request, keeping the slashes
+func encodePath(path string) string {
+	var encoded strings.Builder
+	for _, r := range path {
+		if 'A' <= r && r <= 'Z' || 'a' <= r && r <= 'z' || '0' <= r && r <= '9' || strings.ContainsRune("-_.~/", r) {
+			encoded.WriteRune(r)
+			continue
+		}
+
+		var buf [utf8.UTFMax]byte
+		for _, b := range buf[:utf8.EncodeRune(buf[:], r)] {
+			fmt.Fprintf(&encoded, "%%%02X", b)
+		}
+	}
+	return encoded.String()
+}
+
+func splitPair(s, separator string) (key, value string) {
+	parts := strings.SplitN(s, separator, 2)
+	if len(parts) < 2 {
+		return parts[0], ""
+	}
+	return parts[0], parts[1]
+}
+
+// chunkedReader decodes a body uploaded with streaming signature, it verifies the signature of
+// every chunk, which is chained to the signature of the previous one, before returning its data
+type chunkedReader struct {
+	reader    *bufio.Reader
+	key       []byte
+	date      string
+	scope     string
+	signature string
+
+	chunk []byte
+	done  bool
+	err   error
+}
+
+// newChunkedReader returns a reader of the content of body, whose seed signature is sig
+func newChunkedReader(body io.Reader, sig *signatureV4, secretKey string) *chunkedReader {
+	return &chunkedReader{
+		reader:    bufio.NewReader(body),
+		key:       signingKey(secretKey, sig.date, sig.region),
+		date:      sig.date.UTC().Format(iso8601Format),
+		scope:     sig.scope(),
+		signature: sig.signature,
+	}
+}
+
+// Read implements io.Reader
+func (chunked *chunkedReader) Read(p []byte) (n int, err error) {
+	for len(chunked.chunk) == 0 {
+		if chunked.err != nil {
+			return 0, chunked.err
+		}
+		if chunked.done {
+			return 0, io.EOF
+		}
+		chunked.err = chunked.next()
+	}
+
+	n = copy(p, chunked.chunk)
+	chunked.chunk = chunked.chunk[n:]
+	return n, nil
+}
+
+// next reads and verifies the next chunk
+func (chunked *chunkedReader) next() error {
+	header, err := chunked.reader.ReadString('\n')
+	if err != nil {
+		return ErrMalformedSignature.New("reading chunk header: %v", err)
+	}
+
+	sizeHex, signature := splitPair(strings.TrimSuffix(header, "\r\n"), ";chunk-signature=")
+	size, err := strconv.ParseInt(sizeHex, 16, 64)
+	if err != nil || size < 0 || size > maxSignedChunkSize.Int64() || signature == "" {
+		return ErrMalformedSignature.New("invalid chunk header %q", header)
+	}
+
+	chunk := make([]byte, size+2)
+	if _, err := io.ReadFull(chunked.reader, chunk); err != nil {
+		return ErrMalformedSignature.New("reading chunk: %v", err)
+	}
+	if !bytes.HasSuffix(chunk, []byte("\r\n")) {
+		return ErrMalformedSignature.New("chunk isn't terminated")
+	}
+	chunk = chunk[:size]
+
+	hash := sha256.Sum256(chunk)
+	stringToSign := strings.Join([]string{
+		signV4ChunkPrefix,
+		chunked.date,
+		chunked.scope,
+		chunked.signature,
+		emptySHA256,
+		hex.EncodeToString(hash[:]),
+	}, "\n")
+	expected := hex.EncodeToString(sumHMAC(chunked.key, []byte(stringToSign)))
+	if subtle.ConstantTimeCompare([]byte(expected), []byte(signature)) != 1 {
+		return ErrSignature.New("chunk signature")
+	}
+
+	chunked.signature = signature
+	chunked.chunk = chunk
+	chunked.done = size == 0
+	return nil
+}
@@ -0,0 +1,91 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package miniogw
+
+import (
+	"bytes"
+	"crypto/rand"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/minio/minio-go/pkg/s3signer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignatureV4(t *testing.T) {
+	now := time.Now()
+
+	// clients send the hash of the payload along with the signature in the header
+	signedRequest := httptest.NewRequest("GET", "http://gateway.test/bucket?prefix=a%20b", nil)
+	signedRequest.Header.Set("X-Amz-Content-Sha256", emptySHA256)
+	presignedRequest := httptest.NewRequest("GET", "http://gateway.test/bucket/object", nil)
+
+	for _, signed := range []struct {
+		name    string
+		request *http.Request
+	}{
+		{"header", s3signer.SignV4(*signedRequest, "alice", "alice-secret", "", "us-east-1")},
+		{"presigned", s3signer.PreSignV4(*presignedRequest, "alice", "alice-secret", "", "us-east-1", 60)},
+	} {
+		sig, err := parseSignatureV4(signed.request)
+		require.NoError(t, err, signed.name)
+		require.NotNil(t, sig, signed.name)
+		assert.Equal(t, "alice", sig.accessKey, signed.name)
+		assert.Equal(t, signed.name == "presigned", sig.presigned, signed.name)
+
+		assert.NoError(t, sig.verify(signed.request, "alice-secret", now), signed.name)
+		assert.True(t, ErrSignature.Has(sig.verify(signed.request, "bob-secret", now)), signed.name)
+		assert.True(t, ErrSignature.Has(sig.verify(signed.request, "alice-secret", now.Add(time.Hour))), signed.name)
+	}
+
+	sig, err := parseSignatureV4(httptest.NewRequest("GET", "http://gateway.test/bucket", nil))
+	require.NoError(t, err)
+	assert.Nil(t, sig)
+
+	// the signature of the proxy is checked the same way
+	request := httptest.NewRequest("GET", "http://gateway.test/bucket?prefix=a", nil)
+	require.NoError(t, signRequest(request, "internal", "internal-secret", "us-east-1", now))
+	sig, err = parseSignatureV4(request)
+	require.NoError(t, err)
+	assert.NoError(t, sig.verify(request, "internal-secret", now))
+}
+
+func TestChunkedReader(t *testing.T) {
+	data := make([]byte, 100000)
+	_, err := rand.Read(data)
+	require.NoError(t, err)
+
+	upload := func() (*http.Request, []byte) {
+		request := httptest.NewRequest("PUT", "http://gateway.test/bucket/object", bytes.NewReader(data))
+		request = s3signer.StreamingSignV4(request, "alice", "alice-secret", "", "us-east-1", int64(len(data)), time.Now())
+		body, err := ioutil.ReadAll(request.Body)
+		require.NoError(t, err)
+		return request, body
+	}
+
+	request, body := upload()
+	sig, err := parseSignatureV4(request)
+	require.NoError(t, err)
+	require.NoError(t, sig.verify(request, "alice-secret", time.Now()))
+
+	decoded, err := ioutil.ReadAll(newChunkedReader(bytes.NewReader(body), sig, "alice-secret"))
+	require.NoError(t, err)
+	assert.Equal(t, data, decoded)
+
+	_, err = ioutil.ReadAll(newChunkedReader(bytes.NewReader(body), sig, "bob-secret"))
+	assert.True(t, ErrSignature.Has(err))
+
+	// flip a byte of the content in the second chunk
+	tampered := append([]byte{}, body...)
+	tampered[len(tampered)-200] ^= 1
+	_, err = ioutil.ReadAll(newChunkedReader(bytes.NewReader(tampered), sig, "alice-secret"))
+	assert.True(t, ErrSignature.Has(err))
+
+	_, err = ioutil.ReadAll(newChunkedReader(bytes.NewReader(body[:len(body)/2]), sig, "alice-secret"))
+	assert.True(t, ErrMalformedSignature.Has(err))
+}
@@ -22,6 +22,9 @@ var ErrEmptyNode = errs.New("empty node ID")
 // ErrNodeNotFound is returned if a node does not exist in database
 var ErrNodeNotFound = errs.Class("node not found")
 
+// ErrNodeOffline is returned if a node is offline
+var ErrNodeOffline = errs.Class("node is offline")
+
 // ErrBucketNotFound is returned if a bucket is unable to be found in the routing table
 var ErrBucketNotFound = errs.New("bucket not found")
 
@@ -45,16 +48,21 @@ type DB interface {
 	// Paginate will page through the database nodes
 	Paginate(ctx context.Context, offset int64, limit int) ([]*NodeDossier, bool, error)
 
-	// CreateStats initializes the stats for node.
-	CreateStats(ctx context.Context, nodeID storj.NodeID, initial *NodeStats) (stats *NodeStats, err error)
-	// Update updates node address
-	UpdateAddress(ctx context.Context, value *pb.Node) error
+	// CreateStats initializes the stats for node, the reputation is seeded from the initial counts using the parameters from config.
+	CreateStats(ctx context.Context, nodeID storj.NodeID, initial *NodeStats, config NodeSelectionConfig) (stats *NodeStats, err error)
+	// Update updates node address, new nodes start with the initial reputation from defaults
+	UpdateAddress(ctx context.Context, value *pb.Node, defaults NodeSelectionConfig) error
 	// UpdateStats all parts of single storagenode's stats.
 	UpdateStats(ctx context.Context, request *UpdateRequest) (stats *NodeStats, err error)
 	// UpdateNodeInfo updates node dossier with info requested from the node itself like node type, email, wallet, capacity, and version.
 	UpdateNodeInfo(ctx context.Context, node storj.NodeID, nodeInfo *pb.InfoResponse) (stats *NodeDossier, err error)
 	// UpdateUptime updates a single storagenode's uptime stats.
-	UpdateUptime(ctx context.Context, nodeID storj.NodeID, isUp bool) (stats *NodeStats, err error)
+	UpdateUptime(ctx context.Context, nodeID storj.NodeID, isUp bool, lambda, weight float64) (stats *NodeStats, err error)
+
+	// DisqualifyNode marks the node as disqualified at disqualifiedAt, unless it is already disqualified.
+	DisqualifyNode(ctx context.Context, nodeID storj.NodeID, disqualifiedAt time.Time) error
+	// ReinstateNode clears the disqualification of the node.
+	ReinstateNode(ctx context.Context, nodeID storj.NodeID) error
 }
 
 // FindStorageNodesRequest defines easy request parameters.
@@ -69,17 +77,17 @@ type FindStorageNodesRequest struct {
 
 // NodeCriteria are the requirements for selecting nodes
 type NodeCriteria struct {
-	FreeBandwidth      int64
-	FreeDisk           int64
-	AuditCount         int64
-	AuditSuccessRatio  float64
-	UptimeCount        int64
-	UptimeSuccessRatio float64
-	ExcludedNodes      []storj.NodeID
-	ExcludedIPs        []string
-	MinimumVersion     string // semver or empty
-	OnlineWindow       time.Duration
-	DistinctIP         bool
+	FreeBandwidth    int64
+	FreeDisk         int64
+	AuditCount       int64
+	AuditReputation  float64 // minimum audit reputation score
+	UptimeCount      int64
+	UptimeReputation float64 // minimum uptime reputation score
+	ExcludedNodes    []storj.NodeID
+	ExcludedIPs      []string
+	MinimumVersion   string // semver or empty
+	OnlineWindow     time.Duration
+	DistinctIP       bool
 }
 
 // UpdateRequest is used to update a node status.
@@ -87,6 +95,11 @@ type UpdateRequest struct {
 	NodeID       storj.NodeID
 	AuditSuccess bool
 	IsUp         bool
+	// reputation parameters, Cache fills them in from its configuration
+	AuditLambda  float64
+	AuditWeight  float64
+	UptimeLambda float64
+	UptimeWeight float64
 }
 
 // NodeDossier is the complete info that the satellite tracks for a storage node
@@ -98,6 +111,8 @@ type NodeDossier struct {
 	Reputation NodeStats
 	Version    pb.NodeVersion
 	Contained  bool
+	// Disqualified is the time the node was disqualified, nil when the node is in good standing
+	Disqualified *time.Time
 }
 
 // NodeStats contains statistics about a node.
@@ -111,6 +126,29 @@ type NodeStats struct {
 	UptimeCount        int64
 	LastContactSuccess time.Time
 	LastContactFailure time.Time
+	LastAudited        *time.Time // nil when the node hasn't been audited yet
+
+	AuditReputationAlpha  float64
+	AuditReputationBeta   float64
+	UptimeReputationAlpha float64
+	UptimeReputationBeta  float64
+}
+
+// AuditReputation returns the audit reputation score of the node, between 0 and 1.
+func (stats *NodeStats) AuditReputation() float64 {
+	return reputationScore(stats.AuditReputationAlpha, stats.AuditReputationBeta)
+}
+
+// UptimeReputation returns the uptime reputation score of the node, between 0 and 1.
+func (stats *NodeStats) UptimeReputation() float64 {
+	return reputationScore(stats.UptimeReputationAlpha, stats.UptimeReputationBeta)
+}
+
+func reputationScore(alpha, beta float64) float64 {
+	if alpha+beta <= 0 {
+		return 0
+	}
+	return alpha / (alpha + beta)
 }
 
 // Cache is used to store and handle node information
@@ -159,6 +197,11 @@ func (cache *Cache) IsOnline(node *NodeDossier) bool {
 		node.Reputation.LastContactSuccess.After(node.Reputation.LastContactFailure)
 }
 
+// IsNew checks if a node is 'new', i.e. it hasn't been audited enough times to be vetted.
+func (cache *Cache) IsNew(node *NodeDossier) bool {
+	return node.Reputation.AuditCount < cache.preferences.AuditCount
+}
+
 // FindStorageNodes searches the overlay network for nodes that meet the provided requirements
 func (cache *Cache) FindStorageNodes(ctx context.Context, req FindStorageNodesRequest) ([]*pb.Node, error) {
 	return cache.FindStorageNodesWithPreferences(ctx, req, &cache.preferences)
@@ -185,14 +228,14 @@ func (cache *Cache) FindStorageNodesWithPreferences(ctx context.Context, req Fin
 	var newNodes []*pb.Node
 	if newNodeCount > 0 {
 		newNodes, err = cache.db.SelectNewStorageNodes(ctx, newNodeCount, &NodeCriteria{
-			FreeBandwidth:     req.FreeBandwidth,
-			FreeDisk:          req.FreeDisk,
-			AuditCount:        preferences.AuditCount,
-			AuditSuccessRatio: preferences.AuditSuccessRatio,
-			ExcludedNodes:     excludedNodes,
-			MinimumVersion:    preferences.MinimumVersion,
-			OnlineWindow:      preferences.OnlineWindow,
-			DistinctIP:        preferences.DistinctIP,
+			FreeBandwidth:   req.FreeBandwidth,
+			FreeDisk:        req.FreeDisk,
+			AuditCount:      preferences.AuditCount,
+			AuditReputation: preferences.AuditSuccessRatio,
+			ExcludedNodes:   excludedNodes,
+			MinimumVersion:  preferences.MinimumVersion,
+			OnlineWindow:    preferences.OnlineWindow,
+			DistinctIP:      preferences.DistinctIP,
 		})
 		if err != nil {
 			return nil, err
@@ -209,17 +252,17 @@ func (cache *Cache) FindStorageNodesWithPreferences(ctx context.Context, req Fin
 	}
 
 	criteria := NodeCriteria{
-		FreeBandwidth:      req.FreeBandwidth,
-		FreeDisk:           req.FreeDisk,
-		AuditCount:         preferences.AuditCount,
-		AuditSuccessRatio:  preferences.AuditSuccessRatio,
-		UptimeCount:        preferences.UptimeCount,
-		UptimeSuccessRatio: preferences.UptimeRatio,
-		ExcludedNodes:      excludedNodes,
-		ExcludedIPs:        excludedIPs,
-		MinimumVersion:     preferences.MinimumVersion,
-		OnlineWindow:       preferences.OnlineWindow,
-		DistinctIP:         preferences.DistinctIP,
+		FreeBandwidth:    req.FreeBandwidth,
+		FreeDisk:         req.FreeDisk,
+		AuditCount:       preferences.AuditCount,
+		AuditReputation:  preferences.AuditSuccessRatio,
+		UptimeCount:      preferences.UptimeCount,
+		UptimeReputation: preferences.UptimeRatio,
+		ExcludedNodes:    excludedNodes,
+		ExcludedIPs:      excludedIPs,
+		MinimumVersion:   preferences.MinimumVersion,
+		OnlineWindow:     preferences.OnlineWindow,
+		DistinctIP:       preferences.DistinctIP,
 	}
 	reputableNodes, err := cache.db.SelectStorageNodes(ctx, reputableNodeCount-len(newNodes), &criteria)
 	if err != nil {
@@ -240,11 +283,11 @@ func (cache *Cache) FindStorageNodesWithPreferences(ctx context.Context, req Fin
 func (cache *Cache) KnownUnreliableOrOffline(ctx context.Context, nodeIds storj.NodeIDList) (badNodes storj.NodeIDList, err error) {
 	defer mon.Task()(&ctx)(&err)
 	criteria := &NodeCriteria{
-		AuditCount:         cache.preferences.AuditCount,
-		AuditSuccessRatio:  cache.preferences.AuditSuccessRatio,
-		OnlineWindow:       cache.preferences.OnlineWindow,
-		UptimeCount:        cache.preferences.UptimeCount,
-		UptimeSuccessRatio: cache.preferences.UptimeRatio,
+		AuditCount:       cache.preferences.AuditCount,
+		AuditReputation:  cache.preferences.AuditSuccessRatio,
+		OnlineWindow:     cache.preferences.OnlineWindow,
+		UptimeCount:      cache.preferences.UptimeCount,
+		UptimeReputation: cache.preferences.UptimeRatio,
 	}
 	return cache.db.KnownUnreliableOrOffline(ctx, criteria, nodeIds)
 }
@@ -261,19 +304,30 @@ func (cache *Cache) Put(ctx context.Context, nodeID storj.NodeID, value pb.Node)
 	if nodeID != value.Id {
 		return errors.New("invalid request")
 	}
-	return cache.db.UpdateAddress(ctx, &value)
+	return cache.db.UpdateAddress(ctx, &value, cache.preferences)
 }
 
 // Create adds a new stats entry for node.
 func (cache *Cache) Create(ctx context.Context, nodeID storj.NodeID, initial *NodeStats) (stats *NodeStats, err error) {
 	defer mon.Task()(&ctx)(&err)
-	return cache.db.CreateStats(ctx, nodeID, initial)
+	return cache.db.CreateStats(ctx, nodeID, initial, cache.preferences)
 }
 
 // UpdateStats all parts of single storagenode's stats.
 func (cache *Cache) UpdateStats(ctx context.Context, request *UpdateRequest) (stats *NodeStats, err error) {
 	defer mon.Task()(&ctx)(&err)
-	return cache.db.UpdateStats(ctx, request)
+
+	update := *request
+	update.AuditLambda = cache.preferences.AuditReputationLambda
+	update.AuditWeight = cache.preferences.AuditReputationWeight
+	update.UptimeLambda = cache.preferences.UptimeReputationLambda
+	update.UptimeWeight = cache.preferences.UptimeReputationWeight
+
+	stats, err = cache.db.UpdateStats(ctx, &update)
+	if err != nil {
+		return nil, err
+	}
+	return stats, cache.disqualifyIfUnreliable(ctx, request.NodeID, stats)
 }
 
 // UpdateNodeInfo updates node dossier with info requested from the node itself like node type, email, wallet, capacity, and version.
@@ -285,7 +339,63 @@ func (cache *Cache) UpdateNodeInfo(ctx context.Context, node storj.NodeID, nodeI
 // UpdateUptime updates a single storagenode's uptime stats.
 func (cache *Cache) UpdateUptime(ctx context.Context, nodeID storj.NodeID, isUp bool) (stats *NodeStats, err error) {
 	defer mon.Task()(&ctx)(&err)
-	return cache.db.UpdateUptime(ctx, nodeID, isUp)
+	stats, err = cache.db.UpdateUptime(ctx, nodeID, isUp,
+		cache.preferences.UptimeReputationLambda, cache.preferences.UptimeReputationWeight)
+	if err != nil {
+		return nil, err
+	}
+	return stats, cache.disqualifyIfUnreliable(ctx, nodeID, stats)
+}
+
+// Disqualify disqualifies the node regardless of its reputation.
+func (cache *Cache) Disqualify(ctx context.Context, nodeID storj.NodeID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return cache.db.DisqualifyNode(ctx, nodeID, time.Now())
+}
+
+// Reinstate clears the disqualification of the node.
+func (cache *Cache) Reinstate(ctx context.Context, nodeID storj.NodeID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return cache.db.ReinstateNode(ctx, nodeID)
+}
+
+// IsDisqualified checks whether the node has been disqualified.
+func (cache *Cache) IsDisqualified(ctx context.Context, nodeID storj.NodeID) (_ bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+	node, err := cache.Get(ctx, nodeID)
+	if err != nil {
+		return false, err
+	}
+	return node.Disqualified != nil, nil
+}
+
+// ShouldDisqualify checks whether the reputation of a node crossed the disqualification thresholds.
+func (cache *Cache) ShouldDisqualify(stats *NodeStats) bool {
+	preferences := cache.preferences
+	if preferences.DisqualifyAuditReputation > 0 &&
+		stats.AuditCount >= preferences.DisqualifyAuditCount &&
+		stats.AuditReputation() < preferences.DisqualifyAuditReputation {
+		return true
+	}
+	if preferences.DisqualifyUptimeReputation > 0 &&
+		stats.UptimeCount >= preferences.DisqualifyUptimeCount &&
+		stats.UptimeReputation() < preferences.DisqualifyUptimeReputation {
+		return true
+	}
+	return false
+}
+
+// disqualifyIfUnreliable disqualifies the node when its updated stats crossed the disqualification thresholds.
+func (cache *Cache) disqualifyIfUnreliable(ctx context.Context, nodeID storj.NodeID, stats *NodeStats) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	if stats == nil || !cache.ShouldDisqualify(stats) {
+		return nil
+	}
+
+	cache.log.Info("disqualifying node", zap.String("Node ID", nodeID.String()),
+		zap.Float64("audit reputation", stats.AuditReputation()),
+		zap.Float64("uptime reputation", stats.UptimeReputation()))
+	return cache.db.DisqualifyNode(ctx, nodeID, time.Now())
 }
 
 // ConnFailure implements the Transport Observer `ConnFailure` function
@@ -296,7 +406,7 @@ func (cache *Cache) ConnFailure(ctx context.Context, node *pb.Node, failureError
 	// TODO: Kademlia paper specifies 5 unsuccessful PINGs before removing the node
 	// from our routing table, but this is the cache so maybe we want to treat
 	// it differently.
-	_, err = cache.db.UpdateUptime(ctx, node.Id, false)
+	_, err = cache.UpdateUptime(ctx, node.Id, false)
 	if err != nil {
 		zap.L().Debug("error updating uptime for node", zap.Error(err))
 	}
@@ -311,7 +421,7 @@ func (cache *Cache) ConnSuccess(ctx context.Context, node *pb.Node) {
 	if err != nil {
 		zap.L().Debug("error updating uptime for node", zap.Error(err))
 	}
-	_, err = cache.db.UpdateUptime(ctx, node.Id, true)
+	_, err = cache.UpdateUptime(ctx, node.Id, true)
 	if err != nil {
 		zap.L().Debug("error updating node connection info", zap.Error(err))
 	}
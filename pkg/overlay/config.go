@@ -33,15 +33,32 @@ type LookupConfig struct {
 
 // NodeSelectionConfig is a configuration struct to determine the minimum
 // values for nodes to select
+//
+// UptimeRatio and AuditSuccessRatio are compared against the reputation scores rather than
+// the lifetime success ratios, their names are kept so that the existing flags keep working.
 type NodeSelectionConfig struct {
-	UptimeRatio       float64       `help:"a node's ratio of being up/online vs. down/offline" releaseDefault:"0.9" devDefault:"0"`
+	UptimeRatio       float64       `help:"the minimum uptime reputation score of a node to be selected" releaseDefault:"0.9" devDefault:"0"`
 	UptimeCount       int64         `help:"the number of times a node's uptime has been checked to not be considered a New Node" releaseDefault:"500" devDefault:"0"`
-	AuditSuccessRatio float64       `help:"a node's ratio of successful audits" releaseDefault:"0.4" devDefault:"0"` // TODO: update after beta
+	AuditSuccessRatio float64       `help:"the minimum audit reputation score of a node to be selected" releaseDefault:"0.4" devDefault:"0"` // TODO: update after beta
 	AuditCount        int64         `help:"the number of times a node has been audited to not be considered a New Node" releaseDefault:"500" devDefault:"0"`
 	NewNodePercentage float64       `help:"the percentage of new nodes allowed per request" default:"0.05"` // TODO: fix, this is not percentage, it's ratio
 	MinimumVersion    string        `help:"the minimum node software version for node selection queries" default:""`
 	OnlineWindow      time.Duration `help:"the amount of time without seeing a node before its considered offline" default:"1h"`
 	DistinctIP        bool          `help:"require distinct IPs when choosing nodes for upload" releaseDefault:"true" devDefault:"false"`
+
+	AuditReputationLambda  float64 `help:"the forgetting factor used to calculate the audit reputation score" default:"0.95"`
+	AuditReputationWeight  float64 `help:"the normalization weight used to calculate the audit reputation score" default:"1"`
+	AuditReputationAlpha0  float64 `help:"the initial alpha value of the audit reputation of new nodes" default:"1"`
+	AuditReputationBeta0   float64 `help:"the initial beta value of the audit reputation of new nodes" default:"0"`
+	UptimeReputationLambda float64 `help:"the forgetting factor used to calculate the uptime reputation score" default:"0.99"`
+	UptimeReputationWeight float64 `help:"the normalization weight used to calculate the uptime reputation score" default:"1"`
+	UptimeReputationAlpha0 float64 `help:"the initial alpha value of the uptime reputation of new nodes" default:"1"`
+	UptimeReputationBeta0  float64 `help:"the initial beta value of the uptime reputation of new nodes" default:"0"`
+
+	DisqualifyAuditReputation  float64 `help:"a node is disqualified when its audit reputation score drops below this value, zero disables it" releaseDefault:"0.2" devDefault:"0"`
+	DisqualifyAuditCount       int64   `help:"the number of times a node must have been audited before it can be disqualified for failing audits" releaseDefault:"100" devDefault:"0"`
+	DisqualifyUptimeReputation float64 `help:"a node is disqualified when its uptime reputation score drops below this value, zero disables it" releaseDefault:"0.5" devDefault:"0"`
+	DisqualifyUptimeCount      int64   `help:"the number of times a node's uptime must have been checked before it can be disqualified for being offline" releaseDefault:"500" devDefault:"0"`
 }
 
 // ParseIDs converts the base58check encoded node ID strings from the config into node IDs
@@ -0,0 +1,111 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package overlay_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/teststorj"
+	"storj.io/storj/pkg/overlay"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestDisqualification(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		cache := overlay.NewCache(zaptest.NewLogger(t), db.OverlayCache(), overlay.NodeSelectionConfig{
+			OnlineWindow: time.Hour,
+
+			// without forgetting the reputation matches the lifetime ratios
+			AuditReputationLambda:  1,
+			AuditReputationWeight:  1,
+			UptimeReputationLambda: 1,
+			UptimeReputationWeight: 1,
+
+			DisqualifyAuditReputation:  0.5,
+			DisqualifyAuditCount:       4,
+			DisqualifyUptimeReputation: 0.5,
+			DisqualifyUptimeCount:      4,
+		})
+
+		goodID := teststorj.NodeIDFromString("good")
+		auditID := teststorj.NodeIDFromString("failing audits")
+		uptimeID := teststorj.NodeIDFromString("offline")
+		for _, id := range []storj.NodeID{goodID, auditID, uptimeID} {
+			require.NoError(t, cache.Put(ctx, id, pb.Node{Id: id}))
+			_, err := cache.Create(ctx, id, &overlay.NodeStats{
+				AuditCount: 3, AuditSuccessCount: 2,
+				UptimeCount: 3, UptimeSuccessCount: 2,
+			})
+			require.NoError(t, err)
+		}
+
+		_, err := cache.UpdateStats(ctx, &overlay.UpdateRequest{NodeID: goodID, AuditSuccess: true, IsUp: true})
+		require.NoError(t, err)
+		// not enough audits yet to be disqualified
+		_, err = cache.UpdateStats(ctx, &overlay.UpdateRequest{NodeID: auditID, AuditSuccess: false, IsUp: true})
+		require.NoError(t, err)
+		for _, id := range []storj.NodeID{goodID, auditID} {
+			disqualified, err := cache.IsDisqualified(ctx, id)
+			require.NoError(t, err)
+			assert.False(t, disqualified)
+		}
+
+		// 2 out of 5 audits succeeded
+		_, err = cache.UpdateStats(ctx, &overlay.UpdateRequest{NodeID: auditID, AuditSuccess: false, IsUp: true})
+		require.NoError(t, err)
+		// 2 out of 5 uptime checks succeeded
+		for i := 0; i < 2; i++ {
+			_, err = cache.UpdateUptime(ctx, uptimeID, false)
+			require.NoError(t, err)
+		}
+
+		for _, id := range []storj.NodeID{auditID, uptimeID} {
+			node, err := cache.Get(ctx, id)
+			require.NoError(t, err)
+			require.NotNil(t, node.Disqualified)
+		}
+
+		// the disqualification time doesn't change with further failures
+		auditNode, err := cache.Get(ctx, auditID)
+		require.NoError(t, err)
+		_, err = cache.UpdateStats(ctx, &overlay.UpdateRequest{NodeID: auditID, AuditSuccess: false, IsUp: true})
+		require.NoError(t, err)
+		updated, err := cache.Get(ctx, auditID)
+		require.NoError(t, err)
+		assert.True(t, auditNode.Disqualified.Equal(*updated.Disqualified))
+
+		// pieces on disqualified nodes are missing
+		missing, err := cache.GetMissingPieces(ctx, []*pb.RemotePiece{
+			{PieceNum: 0, NodeId: goodID},
+			{PieceNum: 1, NodeId: auditID},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []int32{1}, missing)
+
+		require.NoError(t, cache.Reinstate(ctx, auditID))
+		disqualified, err := cache.IsDisqualified(ctx, auditID)
+		require.NoError(t, err)
+		assert.False(t, disqualified)
+
+		require.NoError(t, cache.Disqualify(ctx, goodID))
+		disqualified, err = cache.IsDisqualified(ctx, goodID)
+		require.NoError(t, err)
+		assert.True(t, disqualified)
+
+		err = cache.Disqualify(ctx, teststorj.NodeIDFromString("missing"))
+		assert.True(t, overlay.ErrNodeNotFound.Has(err))
+	})
+}
@@ -6,6 +6,7 @@ package overlay
 import (
 	"context"
 
+	"github.com/golang/protobuf/ptypes"
 	"github.com/zeebo/errs"
 
 	"storj.io/storj/pkg/pb"
@@ -45,12 +46,19 @@ func (srv *Inspector) GetStats(ctx context.Context, req *pb.GetStatsRequest) (*p
 		return nil, err
 	}
 
-	return &pb.GetStatsResponse{
+	resp := &pb.GetStatsResponse{
 		AuditCount:  node.Reputation.AuditCount,
 		AuditRatio:  node.Reputation.AuditSuccessRatio,
 		UptimeCount: node.Reputation.UptimeCount,
 		UptimeRatio: node.Reputation.UptimeRatio,
-	}, nil
+	}
+	if node.Disqualified != nil {
+		resp.Disqualified, err = ptypes.TimestampProto(*node.Disqualified)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
 }
 
 // CreateStats creates a node with specified stats
@@ -69,3 +77,18 @@ func (srv *Inspector) CreateStats(ctx context.Context, req *pb.CreateStatsReques
 
 	return &pb.CreateStatsResponse{}, nil
 }
+
+// SetDisqualified disqualifies or reinstates a node regardless of its reputation
+func (srv *Inspector) SetDisqualified(ctx context.Context, req *pb.SetDisqualifiedRequest) (*pb.SetDisqualifiedResponse, error) {
+	var err error
+	if req.Disqualified {
+		err = srv.cache.Disqualify(ctx, req.NodeId)
+	} else {
+		err = srv.cache.Reinstate(ctx, req.NodeId)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.SetDisqualifiedResponse{}, nil
+}
@@ -64,7 +64,7 @@ func TestNodeSelection(t *testing.T) {
 		// This sets a reputable audit count for a certain number of nodes.
 		for i, node := range planet.StorageNodes {
 			for k := 0; k < i; k++ {
-				_, err := satellite.DB.OverlayCache().UpdateStats(ctx, &overlay.UpdateRequest{
+				_, err := satellite.Overlay.Service.UpdateStats(ctx, &overlay.UpdateRequest{
 					NodeID:       node.ID(),
 					IsUp:         true,
 					AuditSuccess: true,
@@ -286,7 +286,7 @@ func TestDistinctIPs(t *testing.T) {
 
 		// This sets a reputable audit count for nodes[8] and nodes[9].
 		for i := 9; i > 7; i-- {
-			_, err := satellite.DB.OverlayCache().UpdateStats(ctx, &overlay.UpdateRequest{
+			_, err := satellite.Overlay.Service.UpdateStats(ctx, &overlay.UpdateRequest{
 				NodeID:       planet.StorageNodes[i].ID(),
 				IsUp:         true,
 				AuditSuccess: true,
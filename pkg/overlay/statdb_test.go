@@ -24,6 +24,17 @@ func getRatio(success, total int64) (ratio float64) {
 	return ratio
 }
 
+var reputationConfig = overlay.NodeSelectionConfig{
+	AuditReputationLambda:  0.95,
+	AuditReputationWeight:  1,
+	AuditReputationAlpha0:  1,
+	AuditReputationBeta0:   0,
+	UptimeReputationLambda: 0.99,
+	UptimeReputationWeight: 1,
+	UptimeReputationAlpha0: 1,
+	UptimeReputationBeta0:  0,
+}
+
 func TestStatDB(t *testing.T) {
 	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
 		ctx := testcontext.New(t)
@@ -53,10 +64,10 @@ func testDatabase(ctx context.Context, t *testing.T, cache overlay.DB) {
 			UptimeSuccessCount: currUptimeSuccess,
 		}
 
-		err := cache.UpdateAddress(ctx, &pb.Node{Id: nodeID})
+		err := cache.UpdateAddress(ctx, &pb.Node{Id: nodeID}, reputationConfig)
 		require.NoError(t, err)
 
-		stats, err := cache.CreateStats(ctx, nodeID, nodeStats)
+		stats, err := cache.CreateStats(ctx, nodeID, nodeStats, reputationConfig)
 		require.NoError(t, err)
 		assert.EqualValues(t, auditSuccessRatio, stats.AuditSuccessRatio)
 		assert.EqualValues(t, uptimeRatio, stats.UptimeRatio)
@@ -70,6 +81,72 @@ func testDatabase(ctx context.Context, t *testing.T, cache overlay.DB) {
 		assert.EqualValues(t, currUptimeCount, node.Reputation.UptimeCount)
 		assert.EqualValues(t, currUptimeSuccess, node.Reputation.UptimeSuccessCount)
 		assert.EqualValues(t, uptimeRatio, node.Reputation.UptimeRatio)
+
+		// the reputation is seeded from the counts
+		assert.InDelta(t, 4, node.Reputation.AuditReputationAlpha, 1e-6)
+		assert.InDelta(t, 6, node.Reputation.AuditReputationBeta, 1e-6)
+		assert.InDelta(t, 8, node.Reputation.UptimeReputationAlpha, 1e-6)
+		assert.InDelta(t, 17, node.Reputation.UptimeReputationBeta, 1e-6)
+		assert.InDelta(t, auditSuccessRatio, node.Reputation.AuditReputation(), 1e-6)
+		assert.InDelta(t, uptimeRatio, node.Reputation.UptimeReputation(), 1e-6)
+	}
+
+	{ // TestCreateWithStatsCapsReputation
+		cappedID := storj.NodeID{254}
+		err := cache.UpdateAddress(ctx, &pb.Node{Id: cappedID}, reputationConfig)
+		require.NoError(t, err)
+
+		_, err = cache.CreateStats(ctx, cappedID, &overlay.NodeStats{
+			AuditCount:         1000,
+			AuditSuccessCount:  500,
+			UptimeCount:        1000,
+			UptimeSuccessCount: 900,
+		}, reputationConfig)
+		require.NoError(t, err)
+
+		node, err := cache.Get(ctx, cappedID)
+		require.NoError(t, err)
+
+		// the weight of the results is capped at what the lambdas accumulate
+		assert.InDelta(t, 20, node.Reputation.AuditReputationAlpha+node.Reputation.AuditReputationBeta, 1e-6)
+		assert.InDelta(t, 100, node.Reputation.UptimeReputationAlpha+node.Reputation.UptimeReputationBeta, 1e-6)
+		assert.InDelta(t, 0.5, node.Reputation.AuditReputation(), 1e-6)
+		assert.InDelta(t, 0.9, node.Reputation.UptimeReputation(), 1e-6)
+	}
+
+	{ // TestUpdateStatsCapsSeededReputation
+		seededID := storj.NodeID{253}
+		err := cache.UpdateAddress(ctx, &pb.Node{Id: seededID}, reputationConfig)
+		require.NoError(t, err)
+
+		// without decay the lifetime counts are kept, as the migration seeds them
+		uncapped := reputationConfig
+		uncapped.AuditReputationLambda = 1
+		uncapped.UptimeReputationLambda = 1
+		_, err = cache.CreateStats(ctx, seededID, &overlay.NodeStats{
+			AuditCount:         1000,
+			AuditSuccessCount:  500,
+			UptimeCount:        1000,
+			UptimeSuccessCount: 900,
+		}, uncapped)
+		require.NoError(t, err)
+
+		stats, err := cache.UpdateStats(ctx, &overlay.UpdateRequest{
+			NodeID:       seededID,
+			IsUp:         true,
+			AuditSuccess: true,
+			AuditLambda:  reputationConfig.AuditReputationLambda,
+			AuditWeight:  reputationConfig.AuditReputationWeight,
+			UptimeLambda: reputationConfig.UptimeReputationLambda,
+			UptimeWeight: reputationConfig.UptimeReputationWeight,
+		})
+		require.NoError(t, err)
+
+		// the seeded values are capped at what the configured lambdas accumulate before they decay
+		assert.InDelta(t, 0.95*10+1, stats.AuditReputationAlpha, 1e-6)
+		assert.InDelta(t, 0.95*10, stats.AuditReputationBeta, 1e-6)
+		assert.InDelta(t, 0.99*90+1, stats.UptimeReputationAlpha, 1e-6)
+		assert.InDelta(t, 0.99*10, stats.UptimeReputationBeta, 1e-6)
 	}
 
 	{ // TestGetDoesNotExist
@@ -106,10 +183,10 @@ func testDatabase(ctx context.Context, t *testing.T, cache overlay.DB) {
 				UptimeSuccessCount: tt.uptimeSuccessCount,
 			}
 
-			err := cache.UpdateAddress(ctx, &pb.Node{Id: tt.nodeID})
+			err := cache.UpdateAddress(ctx, &pb.Node{Id: tt.nodeID}, reputationConfig)
 			require.NoError(t, err)
 
-			_, err = cache.CreateStats(ctx, tt.nodeID, nodeStats)
+			_, err = cache.CreateStats(ctx, tt.nodeID, nodeStats, reputationConfig)
 			require.NoError(t, err)
 		}
 
@@ -119,9 +196,9 @@ func testDatabase(ctx context.Context, t *testing.T, cache overlay.DB) {
 			storj.NodeID{5}, storj.NodeID{6},
 		}
 		criteria := &overlay.NodeCriteria{
-			AuditSuccessRatio:  0.5,
-			UptimeSuccessRatio: 0.5,
-			OnlineWindow:       time.Hour,
+			AuditReputation:  0.5,
+			UptimeReputation: 0.5,
+			OnlineWindow:     time.Hour,
 		}
 
 		invalid, err := cache.KnownUnreliableOrOffline(ctx, criteria, nodeIds)
@@ -137,7 +214,7 @@ func testDatabase(ctx context.Context, t *testing.T, cache overlay.DB) {
 
 	{ // TestUpdateOperator
 		nodeID := storj.NodeID{10}
-		err := cache.UpdateAddress(ctx, &pb.Node{Id: nodeID})
+		err := cache.UpdateAddress(ctx, &pb.Node{Id: nodeID}, reputationConfig)
 		require.NoError(t, err)
 
 		update, err := cache.UpdateNodeInfo(ctx, nodeID, &pb.InfoResponse{
@@ -200,10 +277,20 @@ func testDatabase(ctx context.Context, t *testing.T, cache overlay.DB) {
 			NodeID:       nodeID,
 			AuditSuccess: true,
 			IsUp:         false,
+			AuditLambda:  reputationConfig.AuditReputationLambda,
+			AuditWeight:  reputationConfig.AuditReputationWeight,
+			UptimeLambda: reputationConfig.UptimeReputationLambda,
+			UptimeWeight: reputationConfig.UptimeReputationWeight,
 		}
 		stats, err := cache.UpdateStats(ctx, updateReq)
 		require.NoError(t, err)
 
+		// alpha' = lambda * alpha + weight on success, beta' = lambda * beta + weight on failure
+		assert.InDelta(t, 0.95*4+1, stats.AuditReputationAlpha, 1e-6)
+		assert.InDelta(t, 0.95*6, stats.AuditReputationBeta, 1e-6)
+		assert.InDelta(t, 0.99*8, stats.UptimeReputationAlpha, 1e-6)
+		assert.InDelta(t, 0.99*17+1, stats.UptimeReputationBeta, 1e-6)
+
 		currAuditSuccess++
 		currAuditCount++
 		currUptimeCount++
@@ -228,8 +315,10 @@ func testDatabase(ctx context.Context, t *testing.T, cache overlay.DB) {
 		assert.EqualValues(t, currUptimeSuccess, node.Reputation.UptimeSuccessCount)
 		assert.EqualValues(t, uptimeRatio, node.Reputation.UptimeRatio)
 
-		stats, err := cache.UpdateUptime(ctx, nodeID, false)
+		stats, err := cache.UpdateUptime(ctx, nodeID, false, reputationConfig.UptimeReputationLambda, reputationConfig.UptimeReputationWeight)
 		require.NoError(t, err)
+		assert.InDelta(t, 0.99*0.99*8, stats.UptimeReputationAlpha, 1e-6)
+		assert.InDelta(t, 0.99*(0.99*17+1)+1, stats.UptimeReputationBeta, 1e-6)
 
 		currUptimeCount++
 		newUptimeRatio := getRatio(currUptimeSuccess, currUptimeCount)
@@ -256,6 +345,10 @@ func testDatabase(ctx context.Context, t *testing.T, cache overlay.DB) {
 			NodeID:       nodeID,
 			IsUp:         true,
 			AuditSuccess: false,
+			AuditLambda:  reputationConfig.AuditReputationLambda,
+			AuditWeight:  reputationConfig.AuditReputationWeight,
+			UptimeLambda: reputationConfig.UptimeReputationLambda,
+			UptimeWeight: reputationConfig.UptimeReputationWeight,
 		})
 		require.NoError(t, err)
 
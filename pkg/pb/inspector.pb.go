@@ -213,13 +213,14 @@ func (m *GetStatsRequest) XXX_DiscardUnknown() {
 var xxx_messageInfo_GetStatsRequest proto.InternalMessageInfo
 
 type GetStatsResponse struct {
-	AuditCount           int64    `protobuf:"varint,1,opt,name=audit_count,json=auditCount,proto3" json:"audit_count,omitempty"`
-	AuditRatio           float64  `protobuf:"fixed64,2,opt,name=audit_ratio,json=auditRatio,proto3" json:"audit_ratio,omitempty"`
-	UptimeCount          int64    `protobuf:"varint,3,opt,name=uptime_count,json=uptimeCount,proto3" json:"uptime_count,omitempty"`
-	UptimeRatio          float64  `protobuf:"fixed64,4,opt,name=uptime_ratio,json=uptimeRatio,proto3" json:"uptime_ratio,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	AuditCount           int64                `protobuf:"varint,1,opt,name=audit_count,json=auditCount,proto3" json:"audit_count,omitempty"`
+	AuditRatio           float64              `protobuf:"fixed64,2,opt,name=audit_ratio,json=auditRatio,proto3" json:"audit_ratio,omitempty"`
+	UptimeCount          int64                `protobuf:"varint,3,opt,name=uptime_count,json=uptimeCount,proto3" json:"uptime_count,omitempty"`
+	UptimeRatio          float64              `protobuf:"fixed64,4,opt,name=uptime_ratio,json=uptimeRatio,proto3" json:"uptime_ratio,omitempty"`
+	Disqualified         *timestamp.Timestamp `protobuf:"bytes,5,opt,name=disqualified,proto3" json:"disqualified,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
 func (m *GetStatsResponse) Reset()         { *m = GetStatsResponse{} }
@@ -274,6 +275,13 @@ func (m *GetStatsResponse) GetUptimeRatio() float64 {
 	return 0
 }
 
+func (m *GetStatsResponse) GetDisqualified() *timestamp.Timestamp {
+	if m != nil {
+		return m.Disqualified
+	}
+	return nil
+}
+
 // CreateStats
 type CreateStatsRequest struct {
 	NodeId               NodeID   `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3,customtype=NodeID" json:"node_id"`
@@ -368,6 +376,76 @@ func (m *CreateStatsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CreateStatsResponse proto.InternalMessageInfo
 
+// SetDisqualified
+type SetDisqualifiedRequest struct {
+	NodeId               NodeID   `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3,customtype=NodeID" json:"node_id"`
+	Disqualified         bool     `protobuf:"varint,2,opt,name=disqualified,proto3" json:"disqualified,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetDisqualifiedRequest) Reset()         { *m = SetDisqualifiedRequest{} }
+func (m *SetDisqualifiedRequest) String() string { return proto.CompactTextString(m) }
+func (*SetDisqualifiedRequest) ProtoMessage()    {}
+func (*SetDisqualifiedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{7}
+}
+func (m *SetDisqualifiedRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetDisqualifiedRequest.Unmarshal(m, b)
+}
+func (m *SetDisqualifiedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetDisqualifiedRequest.Marshal(b, m, deterministic)
+}
+func (m *SetDisqualifiedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetDisqualifiedRequest.Merge(m, src)
+}
+func (m *SetDisqualifiedRequest) XXX_Size() int {
+	return xxx_messageInfo_SetDisqualifiedRequest.Size(m)
+}
+func (m *SetDisqualifiedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetDisqualifiedRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetDisqualifiedRequest proto.InternalMessageInfo
+
+func (m *SetDisqualifiedRequest) GetDisqualified() bool {
+	if m != nil {
+		return m.Disqualified
+	}
+	return false
+}
+
+type SetDisqualifiedResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetDisqualifiedResponse) Reset()         { *m = SetDisqualifiedResponse{} }
+func (m *SetDisqualifiedResponse) String() string { return proto.CompactTextString(m) }
+func (*SetDisqualifiedResponse) ProtoMessage()    {}
+func (*SetDisqualifiedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{8}
+}
+func (m *SetDisqualifiedResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetDisqualifiedResponse.Unmarshal(m, b)
+}
+func (m *SetDisqualifiedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetDisqualifiedResponse.Marshal(b, m, deterministic)
+}
+func (m *SetDisqualifiedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetDisqualifiedResponse.Merge(m, src)
+}
+func (m *SetDisqualifiedResponse) XXX_Size() int {
+	return xxx_messageInfo_SetDisqualifiedResponse.Size(m)
+}
+func (m *SetDisqualifiedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetDisqualifiedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetDisqualifiedResponse proto.InternalMessageInfo
+
 // CountNodes
 type CountNodesResponse struct {
 	Count                int64    `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
@@ -380,7 +458,7 @@ func (m *CountNodesResponse) Reset()         { *m = CountNodesResponse{} }
 func (m *CountNodesResponse) String() string { return proto.CompactTextString(m) }
 func (*CountNodesResponse) ProtoMessage()    {}
 func (*CountNodesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{7}
+	return fileDescriptor_a07d9034b2dd9d26, []int{9}
 }
 func (m *CountNodesResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CountNodesResponse.Unmarshal(m, b)
@@ -417,7 +495,7 @@ func (m *CountNodesRequest) Reset()         { *m = CountNodesRequest{} }
 func (m *CountNodesRequest) String() string { return proto.CompactTextString(m) }
 func (*CountNodesRequest) ProtoMessage()    {}
 func (*CountNodesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{8}
+	return fileDescriptor_a07d9034b2dd9d26, []int{10}
 }
 func (m *CountNodesRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CountNodesRequest.Unmarshal(m, b)
@@ -447,7 +525,7 @@ func (m *GetBucketListRequest) Reset()         { *m = GetBucketListRequest{} }
 func (m *GetBucketListRequest) String() string { return proto.CompactTextString(m) }
 func (*GetBucketListRequest) ProtoMessage()    {}
 func (*GetBucketListRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{9}
+	return fileDescriptor_a07d9034b2dd9d26, []int{11}
 }
 func (m *GetBucketListRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetBucketListRequest.Unmarshal(m, b)
@@ -478,7 +556,7 @@ func (m *GetBucketListResponse) Reset()         { *m = GetBucketListResponse{} }
 func (m *GetBucketListResponse) String() string { return proto.CompactTextString(m) }
 func (*GetBucketListResponse) ProtoMessage()    {}
 func (*GetBucketListResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{10}
+	return fileDescriptor_a07d9034b2dd9d26, []int{12}
 }
 func (m *GetBucketListResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetBucketListResponse.Unmarshal(m, b)
@@ -518,7 +596,7 @@ func (m *GetBucketListResponse_Bucket) Reset()         { *m = GetBucketListRespo
 func (m *GetBucketListResponse_Bucket) String() string { return proto.CompactTextString(m) }
 func (*GetBucketListResponse_Bucket) ProtoMessage()    {}
 func (*GetBucketListResponse_Bucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{10, 0}
+	return fileDescriptor_a07d9034b2dd9d26, []int{12, 0}
 }
 func (m *GetBucketListResponse_Bucket) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetBucketListResponse_Bucket.Unmarshal(m, b)
@@ -563,7 +641,7 @@ func (m *GetBucketsRequest) Reset()         { *m = GetBucketsRequest{} }
 func (m *GetBucketsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetBucketsRequest) ProtoMessage()    {}
 func (*GetBucketsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{11}
+	return fileDescriptor_a07d9034b2dd9d26, []int{13}
 }
 func (m *GetBucketsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetBucketsRequest.Unmarshal(m, b)
@@ -595,7 +673,7 @@ func (m *GetBucketsResponse) Reset()         { *m = GetBucketsResponse{} }
 func (m *GetBucketsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetBucketsResponse) ProtoMessage()    {}
 func (*GetBucketsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{12}
+	return fileDescriptor_a07d9034b2dd9d26, []int{14}
 }
 func (m *GetBucketsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetBucketsResponse.Unmarshal(m, b)
@@ -634,7 +712,7 @@ func (m *GetBucketRequest) Reset()         { *m = GetBucketRequest{} }
 func (m *GetBucketRequest) String() string { return proto.CompactTextString(m) }
 func (*GetBucketRequest) ProtoMessage()    {}
 func (*GetBucketRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{13}
+	return fileDescriptor_a07d9034b2dd9d26, []int{15}
 }
 func (m *GetBucketRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetBucketRequest.Unmarshal(m, b)
@@ -666,7 +744,7 @@ func (m *GetBucketResponse) Reset()         { *m = GetBucketResponse{} }
 func (m *GetBucketResponse) String() string { return proto.CompactTextString(m) }
 func (*GetBucketResponse) ProtoMessage()    {}
 func (*GetBucketResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{14}
+	return fileDescriptor_a07d9034b2dd9d26, []int{16}
 }
 func (m *GetBucketResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetBucketResponse.Unmarshal(m, b)
@@ -704,7 +782,7 @@ func (m *Bucket) Reset()         { *m = Bucket{} }
 func (m *Bucket) String() string { return proto.CompactTextString(m) }
 func (*Bucket) ProtoMessage()    {}
 func (*Bucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{15}
+	return fileDescriptor_a07d9034b2dd9d26, []int{17}
 }
 func (m *Bucket) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Bucket.Unmarshal(m, b)
@@ -742,7 +820,7 @@ func (m *BucketList) Reset()         { *m = BucketList{} }
 func (m *BucketList) String() string { return proto.CompactTextString(m) }
 func (*BucketList) ProtoMessage()    {}
 func (*BucketList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{16}
+	return fileDescriptor_a07d9034b2dd9d26, []int{18}
 }
 func (m *BucketList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BucketList.Unmarshal(m, b)
@@ -782,7 +860,7 @@ func (m *PingNodeRequest) Reset()         { *m = PingNodeRequest{} }
 func (m *PingNodeRequest) String() string { return proto.CompactTextString(m) }
 func (*PingNodeRequest) ProtoMessage()    {}
 func (*PingNodeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{17}
+	return fileDescriptor_a07d9034b2dd9d26, []int{19}
 }
 func (m *PingNodeRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PingNodeRequest.Unmarshal(m, b)
@@ -820,7 +898,7 @@ func (m *PingNodeResponse) Reset()         { *m = PingNodeResponse{} }
 func (m *PingNodeResponse) String() string { return proto.CompactTextString(m) }
 func (*PingNodeResponse) ProtoMessage()    {}
 func (*PingNodeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{18}
+	return fileDescriptor_a07d9034b2dd9d26, []int{20}
 }
 func (m *PingNodeResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PingNodeResponse.Unmarshal(m, b)
@@ -859,7 +937,7 @@ func (m *LookupNodeRequest) Reset()         { *m = LookupNodeRequest{} }
 func (m *LookupNodeRequest) String() string { return proto.CompactTextString(m) }
 func (*LookupNodeRequest) ProtoMessage()    {}
 func (*LookupNodeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{19}
+	return fileDescriptor_a07d9034b2dd9d26, []int{21}
 }
 func (m *LookupNodeRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LookupNodeRequest.Unmarshal(m, b)
@@ -905,7 +983,7 @@ func (m *LookupNodeResponse) Reset()         { *m = LookupNodeResponse{} }
 func (m *LookupNodeResponse) String() string { return proto.CompactTextString(m) }
 func (*LookupNodeResponse) ProtoMessage()    {}
 func (*LookupNodeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{20}
+	return fileDescriptor_a07d9034b2dd9d26, []int{22}
 }
 func (m *LookupNodeResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LookupNodeResponse.Unmarshal(m, b)
@@ -951,7 +1029,7 @@ func (m *NodeInfoRequest) Reset()         { *m = NodeInfoRequest{} }
 func (m *NodeInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*NodeInfoRequest) ProtoMessage()    {}
 func (*NodeInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{21}
+	return fileDescriptor_a07d9034b2dd9d26, []int{23}
 }
 func (m *NodeInfoRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NodeInfoRequest.Unmarshal(m, b)
@@ -992,7 +1070,7 @@ func (m *NodeInfoResponse) Reset()         { *m = NodeInfoResponse{} }
 func (m *NodeInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*NodeInfoResponse) ProtoMessage()    {}
 func (*NodeInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{22}
+	return fileDescriptor_a07d9034b2dd9d26, []int{24}
 }
 func (m *NodeInfoResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NodeInfoResponse.Unmarshal(m, b)
@@ -1053,7 +1131,7 @@ func (m *FindNearRequest) Reset()         { *m = FindNearRequest{} }
 func (m *FindNearRequest) String() string { return proto.CompactTextString(m) }
 func (*FindNearRequest) ProtoMessage()    {}
 func (*FindNearRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{23}
+	return fileDescriptor_a07d9034b2dd9d26, []int{25}
 }
 func (m *FindNearRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FindNearRequest.Unmarshal(m, b)
@@ -1091,7 +1169,7 @@ func (m *FindNearResponse) Reset()         { *m = FindNearResponse{} }
 func (m *FindNearResponse) String() string { return proto.CompactTextString(m) }
 func (*FindNearResponse) ProtoMessage()    {}
 func (*FindNearResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{24}
+	return fileDescriptor_a07d9034b2dd9d26, []int{26}
 }
 func (m *FindNearResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FindNearResponse.Unmarshal(m, b)
@@ -1128,7 +1206,7 @@ func (m *DumpNodesRequest) Reset()         { *m = DumpNodesRequest{} }
 func (m *DumpNodesRequest) String() string { return proto.CompactTextString(m) }
 func (*DumpNodesRequest) ProtoMessage()    {}
 func (*DumpNodesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{25}
+	return fileDescriptor_a07d9034b2dd9d26, []int{27}
 }
 func (m *DumpNodesRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DumpNodesRequest.Unmarshal(m, b)
@@ -1159,7 +1237,7 @@ func (m *DumpNodesResponse) Reset()         { *m = DumpNodesResponse{} }
 func (m *DumpNodesResponse) String() string { return proto.CompactTextString(m) }
 func (*DumpNodesResponse) ProtoMessage()    {}
 func (*DumpNodesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{26}
+	return fileDescriptor_a07d9034b2dd9d26, []int{28}
 }
 func (m *DumpNodesResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DumpNodesResponse.Unmarshal(m, b)
@@ -1196,7 +1274,7 @@ func (m *StatsRequest) Reset()         { *m = StatsRequest{} }
 func (m *StatsRequest) String() string { return proto.CompactTextString(m) }
 func (*StatsRequest) ProtoMessage()    {}
 func (*StatsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{27}
+	return fileDescriptor_a07d9034b2dd9d26, []int{29}
 }
 func (m *StatsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StatsRequest.Unmarshal(m, b)
@@ -1232,7 +1310,7 @@ func (m *StatSummaryResponse) Reset()         { *m = StatSummaryResponse{} }
 func (m *StatSummaryResponse) String() string { return proto.CompactTextString(m) }
 func (*StatSummaryResponse) ProtoMessage()    {}
 func (*StatSummaryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{28}
+	return fileDescriptor_a07d9034b2dd9d26, []int{30}
 }
 func (m *StatSummaryResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StatSummaryResponse.Unmarshal(m, b)
@@ -1304,7 +1382,7 @@ func (m *DashboardRequest) Reset()         { *m = DashboardRequest{} }
 func (m *DashboardRequest) String() string { return proto.CompactTextString(m) }
 func (*DashboardRequest) ProtoMessage()    {}
 func (*DashboardRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{29}
+	return fileDescriptor_a07d9034b2dd9d26, []int{31}
 }
 func (m *DashboardRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DashboardRequest.Unmarshal(m, b)
@@ -1343,7 +1421,7 @@ func (m *DashboardResponse) Reset()         { *m = DashboardResponse{} }
 func (m *DashboardResponse) String() string { return proto.CompactTextString(m) }
 func (*DashboardResponse) ProtoMessage()    {}
 func (*DashboardResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{30}
+	return fileDescriptor_a07d9034b2dd9d26, []int{32}
 }
 func (m *DashboardResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DashboardResponse.Unmarshal(m, b)
@@ -1433,7 +1511,7 @@ func (m *SegmentHealthRequest) Reset()         { *m = SegmentHealthRequest{} }
 func (m *SegmentHealthRequest) String() string { return proto.CompactTextString(m) }
 func (*SegmentHealthRequest) ProtoMessage()    {}
 func (*SegmentHealthRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{31}
+	return fileDescriptor_a07d9034b2dd9d26, []int{33}
 }
 func (m *SegmentHealthRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SegmentHealthRequest.Unmarshal(m, b)
@@ -1493,7 +1571,7 @@ func (m *SegmentHealth) Reset()         { *m = SegmentHealth{} }
 func (m *SegmentHealth) String() string { return proto.CompactTextString(m) }
 func (*SegmentHealth) ProtoMessage()    {}
 func (*SegmentHealth) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{32}
+	return fileDescriptor_a07d9034b2dd9d26, []int{34}
 }
 func (m *SegmentHealth) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SegmentHealth.Unmarshal(m, b)
@@ -1539,7 +1617,7 @@ func (m *SegmentHealthResponse) Reset()         { *m = SegmentHealthResponse{} }
 func (m *SegmentHealthResponse) String() string { return proto.CompactTextString(m) }
 func (*SegmentHealthResponse) ProtoMessage()    {}
 func (*SegmentHealthResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{33}
+	return fileDescriptor_a07d9034b2dd9d26, []int{35}
 }
 func (m *SegmentHealthResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SegmentHealthResponse.Unmarshal(m, b)
@@ -1589,7 +1667,7 @@ func (m *ObjectHealthRequest) Reset()         { *m = ObjectHealthRequest{} }
 func (m *ObjectHealthRequest) String() string { return proto.CompactTextString(m) }
 func (*ObjectHealthRequest) ProtoMessage()    {}
 func (*ObjectHealthRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{34}
+	return fileDescriptor_a07d9034b2dd9d26, []int{36}
 }
 func (m *ObjectHealthRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ObjectHealthRequest.Unmarshal(m, b)
@@ -1663,7 +1741,7 @@ func (m *ObjectHealthResponse) Reset()         { *m = ObjectHealthResponse{} }
 func (m *ObjectHealthResponse) String() string { return proto.CompactTextString(m) }
 func (*ObjectHealthResponse) ProtoMessage()    {}
 func (*ObjectHealthResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{35}
+	return fileDescriptor_a07d9034b2dd9d26, []int{37}
 }
 func (m *ObjectHealthResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ObjectHealthResponse.Unmarshal(m, b)
@@ -1697,6 +1775,282 @@ func (m *ObjectHealthResponse) GetRedundancy() *RedundancyScheme {
 	return nil
 }
 
+type GetProjectUsageLimitsRequest struct {
+	ProjectId            []byte   `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetProjectUsageLimitsRequest) Reset()         { *m = GetProjectUsageLimitsRequest{} }
+func (m *GetProjectUsageLimitsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetProjectUsageLimitsRequest) ProtoMessage()    {}
+func (*GetProjectUsageLimitsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{38}
+}
+func (m *GetProjectUsageLimitsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetProjectUsageLimitsRequest.Unmarshal(m, b)
+}
+func (m *GetProjectUsageLimitsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetProjectUsageLimitsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetProjectUsageLimitsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetProjectUsageLimitsRequest.Merge(m, src)
+}
+func (m *GetProjectUsageLimitsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetProjectUsageLimitsRequest.Size(m)
+}
+func (m *GetProjectUsageLimitsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetProjectUsageLimitsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetProjectUsageLimitsRequest proto.InternalMessageInfo
+
+func (m *GetProjectUsageLimitsRequest) GetProjectId() []byte {
+	if m != nil {
+		return m.ProjectId
+	}
+	return nil
+}
+
+type GetProjectUsageLimitsResponse struct {
+	StorageLimit          int64    `protobuf:"varint,1,opt,name=storage_limit,json=storageLimit,proto3" json:"storage_limit,omitempty"`
+	EgressLimit           int64    `protobuf:"varint,2,opt,name=egress_limit,json=egressLimit,proto3" json:"egress_limit,omitempty"`
+	EffectiveStorageLimit int64    `protobuf:"varint,3,opt,name=effective_storage_limit,json=effectiveStorageLimit,proto3" json:"effective_storage_limit,omitempty"`
+	EffectiveEgressLimit  int64    `protobuf:"varint,4,opt,name=effective_egress_limit,json=effectiveEgressLimit,proto3" json:"effective_egress_limit,omitempty"`
+	StorageUsed           int64    `protobuf:"varint,5,opt,name=storage_used,json=storageUsed,proto3" json:"storage_used,omitempty"`
+	EgressUsed            int64    `protobuf:"varint,6,opt,name=egress_used,json=egressUsed,proto3" json:"egress_used,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
+	XXX_unrecognized      []byte   `json:"-"`
+	XXX_sizecache         int32    `json:"-"`
+}
+
+func (m *GetProjectUsageLimitsResponse) Reset()         { *m = GetProjectUsageLimitsResponse{} }
+func (m *GetProjectUsageLimitsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetProjectUsageLimitsResponse) ProtoMessage()    {}
+func (*GetProjectUsageLimitsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{39}
+}
+func (m *GetProjectUsageLimitsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetProjectUsageLimitsResponse.Unmarshal(m, b)
+}
+func (m *GetProjectUsageLimitsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetProjectUsageLimitsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetProjectUsageLimitsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetProjectUsageLimitsResponse.Merge(m, src)
+}
+func (m *GetProjectUsageLimitsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetProjectUsageLimitsResponse.Size(m)
+}
+func (m *GetProjectUsageLimitsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetProjectUsageLimitsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetProjectUsageLimitsResponse proto.InternalMessageInfo
+
+func (m *GetProjectUsageLimitsResponse) GetStorageLimit() int64 {
+	if m != nil {
+		return m.StorageLimit
+	}
+	return 0
+}
+
+func (m *GetProjectUsageLimitsResponse) GetEgressLimit() int64 {
+	if m != nil {
+		return m.EgressLimit
+	}
+	return 0
+}
+
+func (m *GetProjectUsageLimitsResponse) GetEffectiveStorageLimit() int64 {
+	if m != nil {
+		return m.EffectiveStorageLimit
+	}
+	return 0
+}
+
+func (m *GetProjectUsageLimitsResponse) GetEffectiveEgressLimit() int64 {
+	if m != nil {
+		return m.EffectiveEgressLimit
+	}
+	return 0
+}
+
+func (m *GetProjectUsageLimitsResponse) GetStorageUsed() int64 {
+	if m != nil {
+		return m.StorageUsed
+	}
+	return 0
+}
+
+func (m *GetProjectUsageLimitsResponse) GetEgressUsed() int64 {
+	if m != nil {
+		return m.EgressUsed
+	}
+	return 0
+}
+
+type SetProjectUsageLimitsRequest struct {
+	ProjectId            []byte   `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	StorageLimit         int64    `protobuf:"varint,2,opt,name=storage_limit,json=storageLimit,proto3" json:"storage_limit,omitempty"`
+	EgressLimit          int64    `protobuf:"varint,3,opt,name=egress_limit,json=egressLimit,proto3" json:"egress_limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetProjectUsageLimitsRequest) Reset()         { *m = SetProjectUsageLimitsRequest{} }
+func (m *SetProjectUsageLimitsRequest) String() string { return proto.CompactTextString(m) }
+func (*SetProjectUsageLimitsRequest) ProtoMessage()    {}
+func (*SetProjectUsageLimitsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{40}
+}
+func (m *SetProjectUsageLimitsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetProjectUsageLimitsRequest.Unmarshal(m, b)
+}
+func (m *SetProjectUsageLimitsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetProjectUsageLimitsRequest.Marshal(b, m, deterministic)
+}
+func (m *SetProjectUsageLimitsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetProjectUsageLimitsRequest.Merge(m, src)
+}
+func (m *SetProjectUsageLimitsRequest) XXX_Size() int {
+	return xxx_messageInfo_SetProjectUsageLimitsRequest.Size(m)
+}
+func (m *SetProjectUsageLimitsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetProjectUsageLimitsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetProjectUsageLimitsRequest proto.InternalMessageInfo
+
+func (m *SetProjectUsageLimitsRequest) GetProjectId() []byte {
+	if m != nil {
+		return m.ProjectId
+	}
+	return nil
+}
+
+func (m *SetProjectUsageLimitsRequest) GetStorageLimit() int64 {
+	if m != nil {
+		return m.StorageLimit
+	}
+	return 0
+}
+
+func (m *SetProjectUsageLimitsRequest) GetEgressLimit() int64 {
+	if m != nil {
+		return m.EgressLimit
+	}
+	return 0
+}
+
+type SetProjectUsageLimitsResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetProjectUsageLimitsResponse) Reset()         { *m = SetProjectUsageLimitsResponse{} }
+func (m *SetProjectUsageLimitsResponse) String() string { return proto.CompactTextString(m) }
+func (*SetProjectUsageLimitsResponse) ProtoMessage()    {}
+func (*SetProjectUsageLimitsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{41}
+}
+func (m *SetProjectUsageLimitsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetProjectUsageLimitsResponse.Unmarshal(m, b)
+}
+func (m *SetProjectUsageLimitsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetProjectUsageLimitsResponse.Marshal(b, m, deterministic)
+}
+func (m *SetProjectUsageLimitsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetProjectUsageLimitsResponse.Merge(m, src)
+}
+func (m *SetProjectUsageLimitsResponse) XXX_Size() int {
+	return xxx_messageInfo_SetProjectUsageLimitsResponse.Size(m)
+}
+func (m *SetProjectUsageLimitsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetProjectUsageLimitsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetProjectUsageLimitsResponse proto.InternalMessageInfo
+
+type MoveProjectRequest struct {
+	ProjectId            []byte   `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Shard                string   `protobuf:"bytes,2,opt,name=shard,proto3" json:"shard,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MoveProjectRequest) Reset()         { *m = MoveProjectRequest{} }
+func (m *MoveProjectRequest) String() string { return proto.CompactTextString(m) }
+func (*MoveProjectRequest) ProtoMessage()    {}
+func (*MoveProjectRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{42}
+}
+func (m *MoveProjectRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MoveProjectRequest.Unmarshal(m, b)
+}
+func (m *MoveProjectRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MoveProjectRequest.Marshal(b, m, deterministic)
+}
+func (m *MoveProjectRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MoveProjectRequest.Merge(m, src)
+}
+func (m *MoveProjectRequest) XXX_Size() int {
+	return xxx_messageInfo_MoveProjectRequest.Size(m)
+}
+func (m *MoveProjectRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MoveProjectRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MoveProjectRequest proto.InternalMessageInfo
+
+func (m *MoveProjectRequest) GetProjectId() []byte {
+	if m != nil {
+		return m.ProjectId
+	}
+	return nil
+}
+
+func (m *MoveProjectRequest) GetShard() string {
+	if m != nil {
+		return m.Shard
+	}
+	return ""
+}
+
+type MoveProjectResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MoveProjectResponse) Reset()         { *m = MoveProjectResponse{} }
+func (m *MoveProjectResponse) String() string { return proto.CompactTextString(m) }
+func (*MoveProjectResponse) ProtoMessage()    {}
+func (*MoveProjectResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{43}
+}
+func (m *MoveProjectResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MoveProjectResponse.Unmarshal(m, b)
+}
+func (m *MoveProjectResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MoveProjectResponse.Marshal(b, m, deterministic)
+}
+func (m *MoveProjectResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MoveProjectResponse.Merge(m, src)
+}
+func (m *MoveProjectResponse) XXX_Size() int {
+	return xxx_messageInfo_MoveProjectResponse.Size(m)
+}
+func (m *MoveProjectResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MoveProjectResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MoveProjectResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*ListIrreparableSegmentsRequest)(nil), "inspector.ListIrreparableSegmentsRequest")
 	proto.RegisterType((*IrreparableSegment)(nil), "inspector.IrreparableSegment")
@@ -1705,6 +2059,8 @@ func init() {
 	proto.RegisterType((*GetStatsResponse)(nil), "inspector.GetStatsResponse")
 	proto.RegisterType((*CreateStatsRequest)(nil), "inspector.CreateStatsRequest")
 	proto.RegisterType((*CreateStatsResponse)(nil), "inspector.CreateStatsResponse")
+	proto.RegisterType((*SetDisqualifiedRequest)(nil), "inspector.SetDisqualifiedRequest")
+	proto.RegisterType((*SetDisqualifiedResponse)(nil), "inspector.SetDisqualifiedResponse")
 	proto.RegisterType((*CountNodesResponse)(nil), "inspector.CountNodesResponse")
 	proto.RegisterType((*CountNodesRequest)(nil), "inspector.CountNodesRequest")
 	proto.RegisterType((*GetBucketListRequest)(nil), "inspector.GetBucketListRequest")
@@ -1735,124 +2091,149 @@ func init() {
 	proto.RegisterType((*SegmentHealthResponse)(nil), "inspector.SegmentHealthResponse")
 	proto.RegisterType((*ObjectHealthRequest)(nil), "inspector.ObjectHealthRequest")
 	proto.RegisterType((*ObjectHealthResponse)(nil), "inspector.ObjectHealthResponse")
+	proto.RegisterType((*GetProjectUsageLimitsRequest)(nil), "inspector.GetProjectUsageLimitsRequest")
+	proto.RegisterType((*GetProjectUsageLimitsResponse)(nil), "inspector.GetProjectUsageLimitsResponse")
+	proto.RegisterType((*SetProjectUsageLimitsRequest)(nil), "inspector.SetProjectUsageLimitsRequest")
+	proto.RegisterType((*SetProjectUsageLimitsResponse)(nil), "inspector.SetProjectUsageLimitsResponse")
+	proto.RegisterType((*MoveProjectRequest)(nil), "inspector.MoveProjectRequest")
+	proto.RegisterType((*MoveProjectResponse)(nil), "inspector.MoveProjectResponse")
 }
 
 func init() { proto.RegisterFile("inspector.proto", fileDescriptor_a07d9034b2dd9d26) }
 
 var fileDescriptor_a07d9034b2dd9d26 = []byte{
-	// 1782 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4b, 0x93, 0x1b, 0x49,
-	0x11, 0x76, 0xeb, 0x35, 0x52, 0x4a, 0xa3, 0x47, 0x49, 0xf6, 0x0a, 0xad, 0x3d, 0x1a, 0x9a, 0x87,
-	0xbd, 0x36, 0xc8, 0x46, 0x98, 0xc3, 0xb2, 0xb1, 0x07, 0x8f, 0xcc, 0xae, 0x15, 0x6b, 0xec, 0xd9,
-	0x1e, 0xc3, 0x81, 0xd8, 0x40, 0x51, 0xea, 0xae, 0xd1, 0x34, 0x96, 0xba, 0x7a, 0xbb, 0x4b, 0x66,
-	0xe7, 0x0f, 0x10, 0x70, 0xe2, 0xc4, 0x01, 0xae, 0xfc, 0x09, 0x82, 0x2b, 0x17, 0x6e, 0xdc, 0x39,
-	0xf8, 0x42, 0x04, 0xdc, 0xb9, 0x71, 0x23, 0x2a, 0xab, 0xfa, 0x2d, 0x31, 0x13, 0xc0, 0xde, 0xd4,
-	0x5f, 0x7e, 0x95, 0x95, 0xf9, 0xd5, 0x23, 0xb3, 0x04, 0x1d, 0xd7, 0x0b, 0x7d, 0x66, 0x0b, 0x1e,
-	0x4c, 0xfc, 0x80, 0x0b, 0x4e, 0x1a, 0x31, 0x30, 0x82, 0x15, 0x5f, 0x71, 0x05, 0x8f, 0xc0, 0xe3,
-	0x0e, 0xd3, 0xbf, 0x3b, 0x3e, 0x77, 0x3d, 0xc1, 0x02, 0x67, 0xa9, 0x81, 0xa3, 0x15, 0xe7, 0xab,
-	0x35, 0x7b, 0x88, 0x5f, 0xcb, 0xed, 0xf9, 0x43, 0x67, 0x1b, 0x50, 0xe1, 0x72, 0x4f, 0xdb, 0xc7,
-	0x79, 0xbb, 0x70, 0x37, 0x2c, 0x14, 0x74, 0xe3, 0x2b, 0x82, 0xf9, 0x02, 0x8e, 0x9e, 0xbb, 0xa1,
-	0x98, 0x07, 0x01, 0xf3, 0x69, 0x40, 0x97, 0x6b, 0x76, 0xc6, 0x56, 0x1b, 0xe6, 0x89, 0xd0, 0x62,
-	0x9f, 0x6f, 0x59, 0x28, 0xc8, 0x00, 0xaa, 0x6b, 0x77, 0xe3, 0x8a, 0xa1, 0x71, 0x6c, 0xdc, 0xab,
-	0x5a, 0xea, 0x83, 0xdc, 0x82, 0x1a, 0x3f, 0x3f, 0x0f, 0x99, 0x18, 0x96, 0x10, 0xd6, 0x5f, 0xe6,
-	0xdf, 0x0d, 0x20, 0x45, 0x67, 0x84, 0x40, 0xc5, 0xa7, 0xe2, 0x02, 0x7d, 0xb4, 0x2c, 0xfc, 0x4d,
-	0xde, 0x87, 0x76, 0xa8, 0xcc, 0x0b, 0x87, 0x09, 0xea, 0xae, 0xd1, 0x55, 0x73, 0x4a, 0x26, 0x49,
-	0x96, 0xa7, 0xea, 0x97, 0x75, 0xa8, 0x99, 0x4f, 0x91, 0x48, 0xc6, 0xd0, 0x5c, 0xf3, 0x50, 0x2c,
-	0x7c, 0x97, 0xd9, 0x2c, 0x1c, 0x96, 0x31, 0x04, 0x90, 0xd0, 0x29, 0x22, 0x64, 0x02, 0xfd, 0x35,
-	0x0d, 0xc5, 0x42, 0x06, 0xe2, 0x06, 0x0b, 0x2a, 0x04, 0xdb, 0xf8, 0x62, 0x58, 0x39, 0x36, 0xee,
-	0x95, 0xad, 0x9e, 0x34, 0x59, 0x68, 0x79, 0xa2, 0x0c, 0xe4, 0x11, 0x0c, 0xb2, 0xd4, 0x85, 0xcd,
-	0xb7, 0x9e, 0x18, 0x56, 0x71, 0x00, 0x09, 0xd2, 0xe4, 0x99, 0xb4, 0x98, 0x9f, 0xc1, 0x78, 0xaf,
-	0x70, 0xa1, 0xcf, 0xbd, 0x90, 0x91, 0xf7, 0xa1, 0xae, 0xc3, 0x0e, 0x87, 0xc6, 0x71, 0xf9, 0x5e,
-	0x73, 0x7a, 0x67, 0x92, 0x2c, 0x7a, 0x71, 0xa4, 0x15, 0xd3, 0xcd, 0xef, 0x43, 0xe7, 0x63, 0x26,
-	0xce, 0x04, 0x4d, 0xd6, 0xe1, 0x2e, 0x1c, 0xc8, 0x9d, 0xb0, 0x70, 0x1d, 0xa5, 0xe2, 0x49, 0xfb,
-	0xcf, 0x6f, 0xc7, 0x37, 0xfe, 0xfa, 0x76, 0x5c, 0x7b, 0xc1, 0x1d, 0x36, 0x7f, 0x6a, 0xd5, 0xa4,
-	0x79, 0xee, 0x98, 0xbf, 0x33, 0xa0, 0x9b, 0x0c, 0xd6, 0xb1, 0x8c, 0xa1, 0x49, 0xb7, 0x8e, 0x1b,
-	0xe5, 0x65, 0x60, 0x5e, 0x80, 0x10, 0xe6, 0x93, 0x10, 0x70, 0xff, 0xe0, 0x52, 0x18, 0x9a, 0x60,
-	0x49, 0x84, 0x7c, 0x15, 0x5a, 0x5b, 0x5f, 0x6e, 0x1f, 0xed, 0xa2, 0x8c, 0x2e, 0x9a, 0x0a, 0x53,
-	0x3e, 0x12, 0x8a, 0x72, 0x52, 0x41, 0x27, 0x9a, 0x82, 0x5e, 0xcc, 0xbf, 0x19, 0x40, 0x66, 0x01,
-	0xa3, 0x82, 0xfd, 0x57, 0xc9, 0xe5, 0xf3, 0x28, 0x15, 0xf2, 0x98, 0x40, 0x5f, 0x11, 0xc2, 0xad,
-	0x6d, 0xb3, 0x30, 0xcc, 0x44, 0xdb, 0x43, 0xd3, 0x99, 0xb2, 0xe4, 0x63, 0x56, 0xc4, 0x4a, 0x31,
-	0xad, 0x47, 0x30, 0xd0, 0x94, 0xac, 0x4f, 0xbd, 0x39, 0x94, 0x2d, 0xed, 0xd4, 0xbc, 0x09, 0xfd,
-	0x4c, 0x92, 0x6a, 0x11, 0xcc, 0xfb, 0x40, 0xd0, 0x2e, 0x73, 0x4a, 0x96, 0x66, 0x00, 0xd5, 0xf4,
-	0xa2, 0xa8, 0x0f, 0xb3, 0x0f, 0xbd, 0x34, 0x17, 0x65, 0x32, 0x6f, 0xc1, 0xe0, 0x63, 0x26, 0x4e,
-	0xb6, 0xf6, 0x6b, 0x26, 0xe4, 0xee, 0x8b, 0xf0, 0x7f, 0x1a, 0x70, 0x33, 0x67, 0xd0, 0xce, 0x9f,
-	0xc0, 0xc1, 0x12, 0xd1, 0x68, 0x0b, 0xde, 0x4d, 0x6d, 0xc1, 0x9d, 0x43, 0x26, 0x0a, 0xb2, 0xa2,
-	0x71, 0xa3, 0xdf, 0x18, 0x50, 0x53, 0x18, 0x79, 0x00, 0x0d, 0x85, 0xee, 0x5f, 0xa8, 0xba, 0x22,
-	0xcc, 0x1d, 0xf2, 0x10, 0x0e, 0x03, 0xbe, 0x15, 0xae, 0xb7, 0x5a, 0xc8, 0xc5, 0x0b, 0x87, 0x25,
-	0x0c, 0x00, 0x26, 0x78, 0xa1, 0x49, 0xba, 0xd5, 0xd2, 0x04, 0x4c, 0x92, 0x7c, 0x1b, 0x5a, 0x36,
-	0xb5, 0x2f, 0x98, 0xa3, 0xf9, 0xe5, 0x02, 0xbf, 0xa9, 0xec, 0x48, 0x97, 0x0a, 0xc5, 0x09, 0xc4,
-	0x0a, 0x3d, 0x03, 0x92, 0x06, 0x13, 0x89, 0x05, 0x17, 0x74, 0x1d, 0x49, 0x8c, 0x1f, 0xe4, 0x36,
-	0x94, 0x5d, 0x47, 0x85, 0xd5, 0x3a, 0x81, 0x54, 0x0e, 0x12, 0x36, 0xa7, 0x78, 0x8a, 0xb4, 0x18,
-	0x7a, 0x9b, 0x1e, 0x41, 0x69, 0x6f, 0xe2, 0x25, 0xd7, 0x31, 0x7f, 0x94, 0x0a, 0x29, 0x9e, 0xfc,
-	0x8a, 0x41, 0xe4, 0x18, 0xaa, 0xfb, 0xf4, 0x51, 0x06, 0xf3, 0x7e, 0xbc, 0x00, 0x57, 0x73, 0x27,
-	0x00, 0xc9, 0x9a, 0x26, 0x7c, 0x63, 0x1f, 0xff, 0x13, 0xe8, 0x9c, 0xea, 0x15, 0xb8, 0x66, 0x96,
-	0x64, 0x08, 0x07, 0xd4, 0x71, 0x02, 0x16, 0x86, 0x78, 0xfe, 0x1a, 0x56, 0xf4, 0x69, 0x9a, 0xd0,
-	0x4d, 0x9c, 0xe9, 0xf4, 0xdb, 0x50, 0xe2, 0xaf, 0xd1, 0x5b, 0xdd, 0x2a, 0xf1, 0xd7, 0xe6, 0x87,
-	0xd0, 0x7b, 0xce, 0xf9, 0xeb, 0xad, 0x9f, 0x9e, 0xb2, 0x1d, 0x4f, 0xd9, 0xb8, 0x62, 0x8a, 0xcf,
-	0x80, 0xa4, 0x87, 0xc7, 0x1a, 0x57, 0x64, 0x3a, 0xe8, 0x21, 0x9b, 0x26, 0xe2, 0xe4, 0x9b, 0x50,
-	0xd9, 0x30, 0x41, 0xe3, 0x0a, 0x13, 0xdb, 0x7f, 0xc8, 0x04, 0x75, 0xa8, 0xa0, 0x16, 0xda, 0xcd,
-	0x9f, 0x42, 0x07, 0x13, 0xf5, 0xce, 0xf9, 0x75, 0xd5, 0x78, 0x90, 0x0d, 0xb5, 0x39, 0xed, 0x25,
-	0xde, 0x9f, 0x28, 0x43, 0x12, 0xfd, 0x9f, 0x0c, 0xe8, 0x26, 0x13, 0xe8, 0xe0, 0x4d, 0xa8, 0x88,
-	0x4b, 0x5f, 0x05, 0xdf, 0x9e, 0xb6, 0x93, 0xe1, 0xaf, 0x2e, 0x7d, 0x66, 0xa1, 0x8d, 0x4c, 0xa0,
-	0xce, 0x7d, 0x16, 0x50, 0xc1, 0x83, 0x62, 0x12, 0x2f, 0xb5, 0xc5, 0x8a, 0x39, 0x92, 0x6f, 0x53,
-	0x9f, 0xda, 0xae, 0xb8, 0xc4, 0xbb, 0x2f, 0xc3, 0x9f, 0x69, 0x8b, 0x15, 0x73, 0x64, 0x16, 0x6f,
-	0x58, 0x10, 0xba, 0xdc, 0xc3, 0x1b, 0x30, 0x93, 0xc5, 0x8f, 0x95, 0xc1, 0x8a, 0x18, 0xe6, 0x06,
-	0x3a, 0x1f, 0xb9, 0x9e, 0xf3, 0x82, 0xd1, 0xe0, 0xba, 0x2a, 0x7d, 0x1d, 0xaa, 0xa1, 0xa0, 0x81,
-	0xba, 0xb1, 0x8b, 0x14, 0x65, 0x4c, 0x7a, 0x0d, 0x75, 0x5d, 0xab, 0x0f, 0xf3, 0x31, 0x74, 0x93,
-	0xe9, 0xb4, 0x66, 0x57, 0x1f, 0x04, 0x02, 0xdd, 0xa7, 0xdb, 0x8d, 0x9f, 0xb9, 0x3f, 0xbf, 0x07,
-	0xbd, 0x14, 0x96, 0x77, 0xb5, 0xf7, 0x8c, 0xb4, 0xa1, 0x95, 0xae, 0x56, 0xe6, 0xbf, 0x0c, 0xe8,
-	0x4b, 0xe0, 0x6c, 0xbb, 0xd9, 0xd0, 0xe0, 0x32, 0xf6, 0x74, 0x07, 0x60, 0x1b, 0x32, 0x67, 0x11,
-	0xfa, 0xd4, 0x66, 0xfa, 0xae, 0x69, 0x48, 0xe4, 0x4c, 0x02, 0xe4, 0x2e, 0x74, 0xe8, 0x1b, 0xea,
-	0xae, 0x65, 0xc9, 0xd7, 0x1c, 0x55, 0xbf, 0xda, 0x31, 0xac, 0x88, 0xb2, 0x26, 0x49, 0x3f, 0xae,
-	0xb7, 0xc2, 0x7d, 0x15, 0x95, 0xda, 0x90, 0x39, 0x73, 0x05, 0xc9, 0x3a, 0x88, 0x14, 0xa6, 0x18,
-	0xaa, 0x6a, 0xe1, 0xec, 0x3f, 0x50, 0x84, 0x6f, 0x40, 0x1b, 0x09, 0x4b, 0xea, 0x39, 0x3f, 0x77,
-	0x1d, 0x71, 0xa1, 0xcb, 0xd5, 0xa1, 0x44, 0x4f, 0x22, 0x90, 0x3c, 0x84, 0x7e, 0x12, 0x53, 0xc2,
-	0xad, 0xa9, 0xd2, 0x16, 0x9b, 0xe2, 0x01, 0x28, 0x2b, 0x0d, 0x2f, 0x96, 0x9c, 0x06, 0x4e, 0xa4,
-	0xc7, 0x5f, 0xca, 0xd0, 0x4b, 0x81, 0x5a, 0x8d, 0x6b, 0xd7, 0xf4, 0xf7, 0xa0, 0x8b, 0x44, 0x9b,
-	0x7b, 0x1e, 0xb3, 0x65, 0xf7, 0x1a, 0x6a, 0x61, 0x3a, 0x12, 0x9f, 0x25, 0x30, 0x79, 0x00, 0xbd,
-	0x25, 0xe7, 0x22, 0x14, 0x01, 0xf5, 0x17, 0xd1, 0xb1, 0x2b, 0xe3, 0x0d, 0xd1, 0x8d, 0x0d, 0xfa,
-	0xd4, 0x49, 0xbf, 0xd8, 0x3d, 0x7a, 0x74, 0x1d, 0x73, 0x2b, 0xc8, 0xed, 0x44, 0x78, 0x8a, 0xca,
-	0xbe, 0xc8, 0x51, 0xab, 0x8a, 0x1a, 0xe1, 0x11, 0xf5, 0x31, 0xee, 0x64, 0x11, 0xa2, 0x46, 0xcd,
-	0xe9, 0x51, 0xaa, 0x9e, 0xee, 0xd8, 0x13, 0x96, 0x22, 0x93, 0xef, 0x40, 0x4d, 0xf5, 0x09, 0xc3,
-	0x03, 0x1c, 0xf6, 0x95, 0x89, 0xea, 0xcc, 0x27, 0x51, 0x67, 0x3e, 0x79, 0xaa, 0x3b, 0x77, 0x4b,
-	0x13, 0xc9, 0x07, 0xd0, 0xc4, 0x1e, 0xd6, 0x77, 0xbd, 0x15, 0x73, 0x86, 0x75, 0x1c, 0x37, 0x2a,
-	0x8c, 0x7b, 0x15, 0x75, 0xf4, 0x16, 0x48, 0xfa, 0x29, 0xb2, 0xc9, 0x87, 0xd0, 0xc2, 0xc1, 0x9f,
-	0x6f, 0x59, 0xe0, 0x32, 0x67, 0xd8, 0xb8, 0x72, 0x34, 0x4e, 0xf6, 0xa9, 0xa2, 0x9b, 0xbf, 0x35,
-	0x60, 0xa0, 0xbb, 0xd2, 0x67, 0x8c, 0xae, 0xc5, 0x45, 0x74, 0xce, 0x6f, 0x41, 0x4d, 0x15, 0x78,
-	0xdd, 0xca, 0xeb, 0x2f, 0xb9, 0xdd, 0x98, 0x67, 0x07, 0x97, 0xbe, 0x60, 0xce, 0x02, 0x5b, 0x7d,
-	0x3c, 0xe8, 0xd6, 0x61, 0x8c, 0x9e, 0xca, 0x9e, 0xff, 0x6b, 0x10, 0x75, 0xf2, 0x0b, 0xd7, 0x73,
-	0xd8, 0x17, 0x7a, 0x6b, 0xb7, 0x34, 0x38, 0x97, 0x98, 0x3c, 0x46, 0x7e, 0xc0, 0x7f, 0xc6, 0x6c,
-	0x6c, 0x33, 0x2a, 0xe8, 0xa7, 0xa1, 0x91, 0xb9, 0x63, 0x3e, 0x87, 0xc3, 0x4c, 0x68, 0xf2, 0xb8,
-	0x70, 0x6f, 0xed, 0x7a, 0x6c, 0x11, 0x9d, 0x63, 0xf9, 0x1c, 0x68, 0x2a, 0x4c, 0xb5, 0x16, 0x43,
-	0x38, 0xd0, 0x53, 0xe8, 0xb8, 0xa2, 0x4f, 0xf3, 0x17, 0x06, 0xdc, 0xcc, 0x65, 0xaa, 0xf7, 0xef,
-	0x23, 0xa8, 0x5d, 0x20, 0xa2, 0xab, 0xca, 0x30, 0xbd, 0xd2, 0x99, 0x11, 0x9a, 0x47, 0x3e, 0x00,
-	0x08, 0x98, 0xb3, 0xf5, 0x1c, 0xea, 0xd9, 0x97, 0xfa, 0x9a, 0x7e, 0x37, 0xf5, 0x9a, 0xb1, 0x62,
-	0xe3, 0x99, 0x7d, 0xc1, 0x36, 0xcc, 0x4a, 0xd1, 0xcd, 0x7f, 0x18, 0xd0, 0x7f, 0xb9, 0x94, 0x39,
-	0x66, 0x15, 0x2f, 0x2a, 0x6b, 0xec, 0x52, 0x36, 0x59, 0x98, 0x52, 0x66, 0x61, 0xb2, 0x62, 0x96,
-	0x73, 0x62, 0xca, 0x76, 0x19, 0xaf, 0xde, 0x05, 0x3d, 0x17, 0x2c, 0x58, 0x44, 0x22, 0xe9, 0x87,
-	0x12, 0x9a, 0x9e, 0x48, 0x4b, 0xf4, 0x90, 0xfb, 0x16, 0x10, 0xe6, 0x39, 0x8b, 0x25, 0x3b, 0xe7,
-	0x01, 0x8b, 0xe9, 0xea, 0x6a, 0xe9, 0x32, 0xcf, 0x39, 0x41, 0x43, 0xc4, 0x8e, 0xef, 0xf3, 0x5a,
-	0xea, 0xed, 0x68, 0xfe, 0xca, 0x80, 0x41, 0x36, 0x53, 0xad, 0xf8, 0xe3, 0xc2, 0x83, 0x69, 0xbf,
-	0xe6, 0x31, 0xf3, 0x7f, 0x52, 0x7d, 0xfa, 0xeb, 0x0a, 0xb4, 0x3e, 0xa1, 0xce, 0x3c, 0x9a, 0x85,
-	0xcc, 0x01, 0x92, 0xbe, 0x9b, 0xdc, 0x4e, 0xcd, 0x5f, 0x68, 0xc7, 0x47, 0x77, 0xf6, 0x58, 0x75,
-	0x3a, 0x33, 0xa8, 0x47, 0xdd, 0x10, 0x19, 0xa5, 0xa8, 0xb9, 0x7e, 0x6b, 0xf4, 0xee, 0x4e, 0x9b,
-	0x76, 0x32, 0x07, 0x48, 0xfa, 0x9d, 0x4c, 0x3c, 0x85, 0x2e, 0x2a, 0x13, 0xcf, 0x8e, 0x26, 0x69,
-	0x06, 0xf5, 0xa8, 0xf7, 0xc8, 0xc4, 0x93, 0xeb, 0x78, 0x32, 0xf1, 0x14, 0x9a, 0x95, 0x19, 0xd4,
-	0xa3, 0x62, 0x9c, 0x71, 0x92, 0x6b, 0x08, 0x32, 0x4e, 0x0a, 0xd5, 0xfb, 0x23, 0x68, 0xc4, 0x75,
-	0x98, 0xa4, 0x99, 0xf9, 0x8a, 0x3d, 0xba, 0xbd, 0xdb, 0xa8, 0xfd, 0x58, 0x70, 0x98, 0x79, 0xc3,
-	0x90, 0xf1, 0xfe, 0xd7, 0x8d, 0xf2, 0x77, 0x7c, 0xd5, 0xf3, 0x67, 0xfa, 0x87, 0x12, 0x74, 0x5f,
-	0xbe, 0x61, 0xc1, 0x9a, 0x5e, 0x7e, 0x29, 0xbb, 0xe2, 0xff, 0x95, 0xfb, 0x0c, 0xea, 0xd1, 0x2b,
-	0x3f, 0xb3, 0x10, 0xb9, 0xff, 0x0d, 0x32, 0x0b, 0x51, 0xf8, 0x5b, 0xe0, 0x39, 0x34, 0x53, 0x0f,
-	0x55, 0x92, 0x09, 0xbd, 0xf0, 0x4a, 0x1f, 0x1d, 0xed, 0x33, 0x6b, 0xe9, 0x7e, 0x6f, 0x40, 0x1f,
-	0xff, 0x80, 0x39, 0x13, 0x3c, 0x60, 0x89, 0x7a, 0x27, 0x50, 0x55, 0xfe, 0xdf, 0xc9, 0x15, 0xcb,
-	0x9d, 0x9e, 0x77, 0x54, 0x51, 0xf3, 0x06, 0x79, 0x06, 0x8d, 0xb8, 0xc5, 0xc8, 0xca, 0x96, 0xeb,
-	0x46, 0xb2, 0xb2, 0xe5, 0xbb, 0x12, 0xf3, 0xc6, 0xf4, 0x97, 0x06, 0x0c, 0x52, 0x7f, 0xbe, 0x24,
-	0x61, 0xfa, 0xf0, 0xce, 0x9e, 0xbf, 0x74, 0xc8, 0x7b, 0xe9, 0x93, 0xf5, 0x1f, 0xff, 0x2f, 0x1b,
-	0xdd, 0xbf, 0x0e, 0x55, 0x0b, 0xf6, 0x47, 0x03, 0x3a, 0xea, 0x3e, 0x4b, 0xa2, 0xf8, 0x14, 0x5a,
-	0xe9, 0xcb, 0x91, 0xa4, 0xa5, 0xd9, 0x51, 0x1f, 0x46, 0xe3, 0xbd, 0xf6, 0x58, 0xbb, 0x57, 0xf9,
-	0x8a, 0x39, 0xde, 0x7b, 0xad, 0xee, 0x38, 0x26, 0x3b, 0xab, 0xa3, 0x79, 0xe3, 0xa4, 0xf2, 0x93,
-	0x92, 0xbf, 0x5c, 0xd6, 0xb0, 0x95, 0xf8, 0xee, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0x8f, 0x28,
-	0x82, 0xe5, 0xcf, 0x14, 0x00, 0x00,
+	// 2081 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcd, 0x8f, 0x1b, 0x49,
+	0x15, 0x4f, 0xfb, 0x6b, 0xc6, 0xcf, 0x1e, 0xdb, 0x53, 0xe3, 0x4c, 0xbc, 0x3d, 0x1f, 0x9e, 0x6d,
+	0x3e, 0x92, 0x4d, 0xc0, 0x09, 0x26, 0x20, 0x2d, 0xab, 0x20, 0x65, 0x66, 0x76, 0x13, 0x6b, 0x67,
+	0x93, 0xd9, 0x76, 0x82, 0x10, 0x5a, 0xd1, 0x94, 0xdd, 0x65, 0x4f, 0x13, 0xbb, 0xab, 0xd3, 0x5d,
+	0x1e, 0x32, 0x7f, 0x00, 0x08, 0x4e, 0x48, 0x48, 0x1c, 0xf6, 0xcc, 0x7f, 0xc1, 0x95, 0x0b, 0x37,
+	0xee, 0x7b, 0xd8, 0x0b, 0x12, 0xdc, 0x38, 0x70, 0xe3, 0x86, 0xea, 0xa3, 0xbf, 0xed, 0x78, 0x58,
+	0xe0, 0xd6, 0xfd, 0xde, 0xaf, 0x5e, 0xbd, 0xf7, 0xab, 0x57, 0xf5, 0x5e, 0x15, 0x34, 0x1d, 0x37,
+	0xf0, 0xc8, 0x98, 0x51, 0xbf, 0xe7, 0xf9, 0x94, 0x51, 0x54, 0x8d, 0x04, 0x3a, 0x4c, 0xe9, 0x94,
+	0x4a, 0xb1, 0x0e, 0x2e, 0xb5, 0x89, 0xfa, 0x6e, 0x7a, 0xd4, 0x71, 0x19, 0xf1, 0xed, 0x91, 0x12,
+	0x1c, 0x4e, 0x29, 0x9d, 0xce, 0xc8, 0x7d, 0xf1, 0x37, 0x5a, 0x4c, 0xee, 0xdb, 0x0b, 0x1f, 0x33,
+	0x87, 0xba, 0x4a, 0xdf, 0xcd, 0xea, 0x99, 0x33, 0x27, 0x01, 0xc3, 0x73, 0x4f, 0x02, 0x8c, 0x67,
+	0x70, 0x78, 0xe6, 0x04, 0x6c, 0xe0, 0xfb, 0xc4, 0xc3, 0x3e, 0x1e, 0xcd, 0xc8, 0x90, 0x4c, 0xe7,
+	0xc4, 0x65, 0x81, 0x49, 0x5e, 0x2f, 0x48, 0xc0, 0x50, 0x1b, 0xca, 0x33, 0x67, 0xee, 0xb0, 0x8e,
+	0x76, 0xa4, 0xdd, 0x29, 0x9b, 0xf2, 0x07, 0xed, 0x42, 0x85, 0x4e, 0x26, 0x01, 0x61, 0x9d, 0x82,
+	0x10, 0xab, 0x3f, 0xe3, 0x6f, 0x1a, 0xa0, 0xbc, 0x31, 0x84, 0xa0, 0xe4, 0x61, 0x76, 0x21, 0x6c,
+	0xd4, 0x4d, 0xf1, 0x8d, 0xde, 0x87, 0x46, 0x20, 0xd5, 0x96, 0x4d, 0x18, 0x76, 0x66, 0xc2, 0x54,
+	0xad, 0x8f, 0x7a, 0x71, 0x94, 0xe7, 0xf2, 0xcb, 0xdc, 0x52, 0xc8, 0x53, 0x01, 0x44, 0x5d, 0xa8,
+	0xcd, 0x68, 0xc0, 0x2c, 0xcf, 0x21, 0x63, 0x12, 0x74, 0x8a, 0xc2, 0x05, 0xe0, 0xa2, 0x73, 0x21,
+	0x41, 0x3d, 0xd8, 0x99, 0xe1, 0x80, 0x59, 0xdc, 0x11, 0xc7, 0xb7, 0x30, 0x63, 0x64, 0xee, 0xb1,
+	0x4e, 0xe9, 0x48, 0xbb, 0x53, 0x34, 0xb7, 0xb9, 0xca, 0x14, 0x9a, 0xc7, 0x52, 0x81, 0x1e, 0x40,
+	0x3b, 0x0d, 0xb5, 0xc6, 0x74, 0xe1, 0xb2, 0x4e, 0x59, 0x0c, 0x40, 0x7e, 0x12, 0x7c, 0xc2, 0x35,
+	0xc6, 0x67, 0xd0, 0x5d, 0x49, 0x5c, 0xe0, 0x51, 0x37, 0x20, 0xe8, 0x7d, 0xd8, 0x54, 0x6e, 0x07,
+	0x1d, 0xed, 0xa8, 0x78, 0xa7, 0xd6, 0x3f, 0xe8, 0xc5, 0x8b, 0x9e, 0x1f, 0x69, 0x46, 0x70, 0xe3,
+	0x07, 0xd0, 0x7c, 0x42, 0xd8, 0x90, 0xe1, 0x78, 0x1d, 0x6e, 0xc3, 0x06, 0xcf, 0x04, 0xcb, 0xb1,
+	0x25, 0x8b, 0xc7, 0x8d, 0x3f, 0x7f, 0xd9, 0xbd, 0xf1, 0xc5, 0x97, 0xdd, 0xca, 0x33, 0x6a, 0x93,
+	0xc1, 0xa9, 0x59, 0xe1, 0xea, 0x81, 0x6d, 0x7c, 0xa1, 0x41, 0x2b, 0x1e, 0xac, 0x7c, 0xe9, 0x42,
+	0x0d, 0x2f, 0x6c, 0x27, 0x8c, 0x4b, 0x13, 0x71, 0x81, 0x10, 0x89, 0x78, 0x62, 0x80, 0xc8, 0x1f,
+	0xb1, 0x14, 0x9a, 0x02, 0x98, 0x5c, 0x82, 0xde, 0x85, 0xfa, 0xc2, 0xe3, 0xe9, 0xa3, 0x4c, 0x14,
+	0x85, 0x89, 0x9a, 0x94, 0x49, 0x1b, 0x31, 0x44, 0x1a, 0x29, 0x09, 0x23, 0x0a, 0x22, 0xad, 0xfc,
+	0x10, 0xea, 0xb6, 0x13, 0xbc, 0x5e, 0xe0, 0x99, 0x33, 0x71, 0x88, 0x2d, 0x08, 0xae, 0xf5, 0xf5,
+	0x9e, 0xcc, 0xd3, 0x5e, 0x98, 0xa7, 0xbd, 0x17, 0x61, 0x9e, 0x9a, 0x29, 0xbc, 0xf1, 0x57, 0x0d,
+	0xd0, 0x89, 0x4f, 0x30, 0x23, 0x5f, 0x89, 0x9c, 0x2c, 0x0f, 0x85, 0x1c, 0x0f, 0x3d, 0xd8, 0x91,
+	0x80, 0x60, 0x31, 0x1e, 0x93, 0x20, 0x48, 0x45, 0xbb, 0x2d, 0x54, 0x43, 0xa9, 0xc9, 0xc6, 0x2c,
+	0x81, 0xa5, 0x3c, 0x2d, 0x0f, 0xa0, 0xad, 0x20, 0x69, 0x9b, 0x2a, 0xb9, 0xa4, 0x2e, 0x69, 0xd4,
+	0xb8, 0x09, 0x3b, 0xa9, 0x20, 0xe5, 0x22, 0x1a, 0x04, 0x76, 0x87, 0x84, 0x9d, 0x26, 0xf8, 0xf8,
+	0x8f, 0xe3, 0x37, 0x32, 0xfc, 0x73, 0x02, 0x36, 0x33, 0x1c, 0xbf, 0x03, 0xb7, 0x72, 0xd3, 0x28,
+	0x0f, 0xee, 0x02, 0x12, 0x1e, 0x72, 0xab, 0x71, 0x72, 0xb5, 0xa1, 0x9c, 0x4c, 0x2b, 0xf9, 0x63,
+	0xec, 0xc0, 0x76, 0x12, 0x2b, 0x1c, 0x35, 0x76, 0xa1, 0xfd, 0x84, 0xb0, 0xe3, 0xc5, 0xf8, 0x15,
+	0x61, 0x7c, 0xff, 0x84, 0xf2, 0x7f, 0x6a, 0x70, 0x33, 0xa3, 0x50, 0xc6, 0x1f, 0xc3, 0xc6, 0x48,
+	0x48, 0xc3, 0x4d, 0x74, 0x3b, 0xb1, 0x89, 0x96, 0x0e, 0xe9, 0x49, 0x91, 0x19, 0x8e, 0xd3, 0x7f,
+	0xaf, 0x41, 0x45, 0xca, 0xd0, 0x3d, 0xa8, 0x4a, 0xe9, 0x6a, 0xaa, 0x36, 0x25, 0x60, 0x60, 0xa3,
+	0xfb, 0xb0, 0xe5, 0xd3, 0x05, 0x73, 0xdc, 0xa9, 0xc5, 0xe9, 0x0b, 0x3a, 0x05, 0xe1, 0x00, 0xf4,
+	0xf8, 0x5f, 0x8f, 0xc3, 0xcd, 0xba, 0x02, 0xf0, 0x9f, 0x00, 0x7d, 0x1b, 0xea, 0x63, 0x3c, 0xbe,
+	0x20, 0xb6, 0xc2, 0x17, 0x73, 0xf8, 0x9a, 0xd4, 0x0b, 0x38, 0x67, 0x28, 0x0a, 0x20, 0x62, 0xe8,
+	0x29, 0xa0, 0xa4, 0x30, 0xa6, 0x98, 0x51, 0x86, 0x67, 0x21, 0xc5, 0xe2, 0x07, 0xed, 0x43, 0xd1,
+	0xb1, 0xa5, 0x5b, 0xf5, 0x63, 0x48, 0xc4, 0xc0, 0xc5, 0x46, 0x1f, 0x5a, 0x91, 0xa5, 0x30, 0x51,
+	0x0e, 0xa1, 0xb0, 0x32, 0xf0, 0x82, 0x63, 0x1b, 0x2f, 0x13, 0x2e, 0x45, 0x93, 0xaf, 0x19, 0x84,
+	0x8e, 0xa0, 0xbc, 0x8a, 0x1f, 0xa9, 0x30, 0xee, 0x46, 0x0b, 0xb0, 0x1e, 0xdb, 0x03, 0x88, 0xd7,
+	0x34, 0xc6, 0x6b, 0xab, 0xf0, 0x1f, 0x43, 0xf3, 0x5c, 0xad, 0xc0, 0x35, 0xa3, 0x44, 0x1d, 0xd8,
+	0xc0, 0xb6, 0xed, 0x93, 0x20, 0x10, 0x1b, 0xa0, 0x6a, 0x86, 0xbf, 0x86, 0x01, 0xad, 0xd8, 0x98,
+	0x0a, 0xbf, 0x01, 0x05, 0xfa, 0x4a, 0x58, 0xdb, 0x34, 0x0b, 0xf4, 0x95, 0xf1, 0x08, 0xb6, 0xcf,
+	0x28, 0x7d, 0xb5, 0xf0, 0x92, 0x53, 0x36, 0xa2, 0x29, 0xab, 0x6b, 0xa6, 0xf8, 0x0c, 0x50, 0x72,
+	0x78, 0xc4, 0x71, 0x89, 0x87, 0x23, 0x2c, 0xa4, 0xc3, 0x14, 0x72, 0xf4, 0x4d, 0x28, 0xcd, 0x09,
+	0xc3, 0x51, 0x8d, 0x8c, 0xf4, 0x9f, 0x10, 0x86, 0x6d, 0xcc, 0xb0, 0x29, 0xf4, 0xc6, 0x4f, 0xa1,
+	0x29, 0x02, 0x75, 0x27, 0xf4, 0xba, 0x6c, 0xdc, 0x4b, 0xbb, 0x5a, 0xeb, 0x6f, 0xc7, 0xd6, 0x1f,
+	0x4b, 0x45, 0xec, 0xfd, 0x9f, 0x34, 0x68, 0xc5, 0x13, 0x28, 0xe7, 0x0d, 0x28, 0xb1, 0x2b, 0x4f,
+	0x3a, 0xdf, 0xe8, 0x37, 0xe2, 0xe1, 0x2f, 0xae, 0x3c, 0x62, 0x0a, 0x1d, 0xea, 0xc1, 0x26, 0xf5,
+	0x88, 0x8f, 0x19, 0xf5, 0xf3, 0x41, 0x3c, 0x57, 0x1a, 0x33, 0xc2, 0x70, 0xfc, 0x18, 0x7b, 0x78,
+	0xec, 0xb0, 0xab, 0x4e, 0x31, 0x8b, 0x3f, 0x51, 0x1a, 0x33, 0xc2, 0xf0, 0x28, 0x2e, 0x89, 0x1f,
+	0x38, 0xd4, 0xed, 0x94, 0xb2, 0x51, 0xfc, 0x48, 0x2a, 0xcc, 0x10, 0x61, 0xcc, 0xa1, 0xf9, 0x91,
+	0xe3, 0xda, 0xcf, 0x08, 0xf6, 0xaf, 0xcb, 0xd2, 0xd7, 0xa1, 0x1c, 0x30, 0xec, 0xcb, 0x9a, 0x91,
+	0x87, 0x48, 0x65, 0xdc, 0x2d, 0xc9, 0x82, 0x21, 0x7f, 0x8c, 0x87, 0xd0, 0x8a, 0xa7, 0x53, 0x9c,
+	0xad, 0xdf, 0x08, 0x08, 0x5a, 0xa7, 0x8b, 0xb9, 0x97, 0x3a, 0x3f, 0xbf, 0x07, 0xdb, 0x09, 0x59,
+	0xd6, 0xd4, 0xca, 0x3d, 0xd2, 0x80, 0x7a, 0xb2, 0x5e, 0x1a, 0xff, 0xd2, 0x60, 0x87, 0x0b, 0x86,
+	0x8b, 0xf9, 0x1c, 0xfb, 0x57, 0x91, 0xa5, 0x03, 0x80, 0x45, 0x40, 0x6c, 0x2b, 0xf0, 0xf0, 0x98,
+	0xa8, 0xb3, 0xa6, 0xca, 0x25, 0x43, 0x2e, 0x40, 0xb7, 0xa1, 0x89, 0x2f, 0xb1, 0x33, 0xe3, 0x4d,
+	0x8b, 0xc2, 0xc8, 0x0a, 0xda, 0x88, 0xc4, 0x12, 0xc8, 0xab, 0x22, 0xb7, 0xe3, 0xb8, 0x53, 0x91,
+	0x57, 0x61, 0xb3, 0x10, 0x10, 0x7b, 0x20, 0x45, 0xbc, 0x12, 0x0b, 0x08, 0x91, 0x08, 0x59, 0x37,
+	0xc5, 0xec, 0x1f, 0x4a, 0xc0, 0x37, 0xa0, 0x21, 0x00, 0x23, 0xec, 0xda, 0xbf, 0x70, 0x6c, 0x76,
+	0xa1, 0x0a, 0xe6, 0x16, 0x97, 0x1e, 0x87, 0x42, 0x74, 0x1f, 0x76, 0x62, 0x9f, 0x62, 0x6c, 0x45,
+	0x60, 0x51, 0xa4, 0x8a, 0x06, 0x08, 0x5a, 0x71, 0x70, 0x31, 0xa2, 0xd8, 0x0f, 0xeb, 0xa7, 0xf1,
+	0x97, 0x22, 0x6c, 0x27, 0x84, 0x8a, 0x8d, 0x6b, 0x57, 0xd5, 0xf7, 0xa0, 0x25, 0x80, 0x63, 0xea,
+	0xba, 0x64, 0xcc, 0xfb, 0xef, 0x40, 0x11, 0xd3, 0xe4, 0xf2, 0x93, 0x58, 0x8c, 0xee, 0xc1, 0xf6,
+	0x88, 0x52, 0x16, 0x30, 0x1f, 0x7b, 0x56, 0xb8, 0xed, 0x8a, 0xe2, 0x84, 0x68, 0x45, 0x0a, 0xb5,
+	0xeb, 0xb8, 0x5d, 0xd1, 0xff, 0xba, 0x78, 0x16, 0x61, 0x4b, 0x02, 0xdb, 0x0c, 0xe5, 0x09, 0x28,
+	0x79, 0x93, 0x81, 0x96, 0x25, 0x94, 0xbc, 0x49, 0x43, 0x1f, 0x8a, 0x4c, 0x66, 0x81, 0xe0, 0xa8,
+	0xd6, 0x3f, 0x4c, 0xd4, 0xd3, 0x25, 0x39, 0x61, 0x4a, 0x30, 0xfa, 0x0e, 0x54, 0x64, 0xa7, 0xd2,
+	0xd9, 0x10, 0xc3, 0xde, 0xc9, 0xf5, 0x6c, 0xa7, 0xea, 0xee, 0x61, 0x2a, 0x20, 0xfa, 0x00, 0x6a,
+	0xa2, 0x0b, 0xf7, 0x1c, 0x77, 0x4a, 0xec, 0xce, 0xe6, 0xda, 0x5e, 0x0f, 0x38, 0xfc, 0x5c, 0xa0,
+	0xd1, 0x23, 0xa8, 0x8b, 0xc1, 0xaf, 0x17, 0xc4, 0xe7, 0x9d, 0x4a, 0x75, 0xed, 0x68, 0x31, 0xd9,
+	0xa7, 0x12, 0x6e, 0x7c, 0xae, 0x41, 0x5b, 0xf5, 0xd5, 0x4f, 0x09, 0x9e, 0xb1, 0x8b, 0x70, 0x9f,
+	0xef, 0x42, 0x45, 0x16, 0x78, 0x75, 0x19, 0x51, 0x7f, 0x3c, 0xdd, 0x88, 0x3b, 0xf6, 0xaf, 0x3c,
+	0x46, 0x6c, 0x4b, 0x5c, 0x56, 0xc4, 0x46, 0x37, 0xb7, 0x22, 0xe9, 0x39, 0xbf, 0xb5, 0x7c, 0x0d,
+	0xc2, 0xbb, 0x88, 0xe5, 0xb8, 0x36, 0x79, 0xa3, 0x52, 0xbb, 0xae, 0x84, 0x03, 0x2e, 0xe3, 0xdb,
+	0xc8, 0xf3, 0xe9, 0xcf, 0xc9, 0x58, 0xb4, 0x19, 0x25, 0x61, 0xa7, 0xaa, 0x24, 0x03, 0xdb, 0x38,
+	0x83, 0xad, 0x94, 0x6b, 0x7c, 0xbb, 0x50, 0x77, 0xe6, 0xb8, 0xc4, 0x0a, 0xf7, 0x31, 0xbf, 0xd0,
+	0xd4, 0xa4, 0x4c, 0xb6, 0x16, 0x1d, 0xd8, 0x50, 0x53, 0x28, 0xbf, 0xc2, 0x5f, 0xe3, 0x57, 0x1a,
+	0xdc, 0xcc, 0x44, 0xaa, 0xf2, 0xf7, 0x01, 0x54, 0x2e, 0x84, 0x44, 0x55, 0x95, 0x4e, 0x72, 0xa5,
+	0x53, 0x23, 0x14, 0x0e, 0x7d, 0x00, 0xe0, 0x13, 0x7b, 0xe1, 0xda, 0xd8, 0x1d, 0x5f, 0xa9, 0x63,
+	0x7a, 0x2f, 0x71, 0x1f, 0x33, 0x23, 0xe5, 0x70, 0x7c, 0x41, 0xe6, 0xc4, 0x4c, 0xc0, 0x8d, 0xbf,
+	0x6b, 0xb0, 0xf3, 0x7c, 0xc4, 0x63, 0x4c, 0x33, 0x9e, 0x67, 0x56, 0x5b, 0xc6, 0x6c, 0xbc, 0x30,
+	0x85, 0xd4, 0xc2, 0xa4, 0xc9, 0x2c, 0x66, 0xc8, 0xe4, 0x0d, 0xbb, 0x38, 0x7a, 0x2d, 0x3c, 0x61,
+	0xc4, 0xb7, 0x42, 0x92, 0xd4, 0x55, 0x4f, 0xa8, 0x1e, 0x73, 0x4d, 0x78, 0x15, 0xfd, 0x16, 0x20,
+	0xe2, 0xda, 0xd6, 0x88, 0x4c, 0xa8, 0x4f, 0x22, 0xb8, 0x3c, 0x5a, 0x5a, 0xc4, 0xb5, 0x8f, 0x85,
+	0x22, 0x44, 0x47, 0xe7, 0x79, 0x25, 0x71, 0xfb, 0x35, 0x7e, 0xa3, 0x41, 0x3b, 0x1d, 0xa9, 0x62,
+	0xfc, 0x61, 0xee, 0xca, 0xb7, 0x9a, 0xf3, 0x08, 0xf9, 0xdf, 0xb1, 0xfe, 0x08, 0xf6, 0x9f, 0x10,
+	0x76, 0x2e, 0xf9, 0x78, 0x19, 0xe0, 0x29, 0x39, 0xe3, 0x3e, 0x46, 0x57, 0xa3, 0x34, 0x7d, 0x5a,
+	0x36, 0x17, 0x3f, 0x2f, 0xc0, 0xc1, 0x8a, 0xf1, 0x2a, 0x26, 0x9e, 0xf1, 0x8c, 0xfa, 0x78, 0x4a,
+	0xac, 0xf8, 0x21, 0x80, 0x67, 0xbc, 0x14, 0x0a, 0x34, 0xcf, 0x60, 0x79, 0x90, 0x2b, 0x8c, 0x3c,
+	0xfd, 0x6a, 0x52, 0x26, 0x21, 0xdf, 0x87, 0x5b, 0x64, 0x32, 0xe1, 0xe7, 0xe0, 0x25, 0xb1, 0xd2,
+	0x16, 0xe5, 0x1e, 0xba, 0x19, 0xa9, 0x87, 0x49, 0xd3, 0x0f, 0x61, 0x37, 0x1e, 0x97, 0x9a, 0x44,
+	0xae, 0x71, 0x3b, 0xd2, 0x7e, 0x98, 0x98, 0xed, 0x5d, 0x08, 0x1d, 0xb4, 0x78, 0xbd, 0x50, 0x0b,
+	0x5c, 0x53, 0xb2, 0x97, 0x01, 0x11, 0x77, 0x41, 0x65, 0x4e, 0x20, 0x64, 0xc5, 0x00, 0x29, 0xe2,
+	0x00, 0xe3, 0x97, 0x1a, 0xec, 0x0f, 0xbf, 0x3a, 0xb7, 0x79, 0xe6, 0x0a, 0xd7, 0x60, 0xae, 0x98,
+	0x63, 0xce, 0xe8, 0xc2, 0xc1, 0xf0, 0x6d, 0x4b, 0x64, 0x0c, 0x00, 0x7d, 0x42, 0x2f, 0x89, 0x42,
+	0x5c, 0xd3, 0xbb, 0x36, 0x94, 0x83, 0x0b, 0xec, 0xdb, 0xaa, 0x3f, 0x95, 0x3f, 0xfc, 0xea, 0x99,
+	0x32, 0x25, 0x67, 0xe8, 0xff, 0xb6, 0x04, 0xf5, 0x8f, 0xb1, 0x3d, 0x08, 0x73, 0x19, 0x0d, 0x00,
+	0xe2, 0xdb, 0x1d, 0xda, 0x4f, 0x64, 0x79, 0xee, 0xd2, 0xa7, 0x1f, 0xac, 0xd0, 0xaa, 0x04, 0x3b,
+	0x81, 0xcd, 0xb0, 0xe7, 0x46, 0x7a, 0x02, 0x9a, 0xe9, 0xea, 0xf5, 0xbd, 0xa5, 0x3a, 0x65, 0x64,
+	0x00, 0x10, 0x77, 0xd5, 0x29, 0x7f, 0x72, 0xbd, 0xba, 0x7e, 0xb0, 0x42, 0x1b, 0xfb, 0x13, 0x76,
+	0xb8, 0x29, 0x7f, 0x32, 0x7d, 0xb5, 0xbe, 0xb7, 0x54, 0x17, 0x1b, 0x09, 0x5b, 0xbe, 0x94, 0x91,
+	0x4c, 0xdb, 0xa9, 0xef, 0x2d, 0xd5, 0x29, 0x23, 0x1f, 0x41, 0x35, 0xea, 0xf6, 0x50, 0x12, 0x99,
+	0xed, 0x0b, 0xf5, 0xfd, 0xe5, 0x4a, 0x65, 0xc7, 0x84, 0xad, 0xd4, 0x4d, 0x19, 0x75, 0x57, 0xdf,
+	0xa1, 0xa5, 0xbd, 0xa3, 0x75, 0x97, 0xec, 0xfe, 0xef, 0x8a, 0xd0, 0x7a, 0x7e, 0x49, 0xfc, 0x19,
+	0xbe, 0xfa, 0xbf, 0x64, 0xc5, 0xff, 0x2a, 0xf6, 0x13, 0xd8, 0x0c, 0x5f, 0xc3, 0x52, 0x0b, 0x91,
+	0x79, 0x5f, 0xd3, 0xf7, 0x96, 0xea, 0x94, 0x91, 0x33, 0xa8, 0x25, 0x1e, 0x64, 0x50, 0xca, 0xf5,
+	0xdc, 0x6b, 0x94, 0x7e, 0xb8, 0x4a, 0xad, 0xac, 0xfd, 0x18, 0x9a, 0x99, 0x07, 0x16, 0xf4, 0x6e,
+	0xaa, 0x4c, 0x2c, 0x7b, 0xe3, 0xd1, 0x8d, 0xb7, 0x41, 0xd4, 0xa2, 0xfc, 0x41, 0x83, 0x1d, 0xf1,
+	0x04, 0xca, 0x4f, 0x50, 0x12, 0xaf, 0xcb, 0x31, 0x94, 0xa5, 0xe7, 0xb7, 0x32, 0xcd, 0xde, 0x52,
+	0x9f, 0x97, 0x74, 0x81, 0xc6, 0x0d, 0xf4, 0x14, 0xaa, 0x51, 0x8b, 0x9c, 0x5e, 0x90, 0x4c, 0x37,
+	0xad, 0xef, 0x2f, 0x57, 0x86, 0x96, 0xfa, 0xbf, 0xd6, 0xa0, 0x9d, 0x78, 0xfe, 0x8c, 0xdd, 0xf4,
+	0xe0, 0xd6, 0x8a, 0x47, 0x55, 0xf4, 0x5e, 0x72, 0xcf, 0xbe, 0xf5, 0xc5, 0x5a, 0xbf, 0x7b, 0x1d,
+	0xa8, 0x22, 0xec, 0x8f, 0x1a, 0x34, 0x65, 0x3d, 0x8e, 0xbd, 0xf8, 0x14, 0xea, 0xc9, 0xe2, 0x8e,
+	0x92, 0xd4, 0x2c, 0xe9, 0x6f, 0xf4, 0xee, 0x4a, 0x7d, 0xc4, 0xdd, 0x8b, 0x6c, 0xc7, 0xd7, 0x5d,
+	0xd9, 0x16, 0x2c, 0xd9, 0x80, 0x4b, 0xbb, 0x3b, 0xe3, 0x46, 0xff, 0x1f, 0x1a, 0xb4, 0xd4, 0x41,
+	0x1d, 0x7b, 0x3f, 0x13, 0x0f, 0x69, 0xf9, 0x62, 0x81, 0x32, 0xef, 0x66, 0x2b, 0xab, 0x9a, 0x7e,
+	0x67, 0x3d, 0x30, 0x0a, 0x6c, 0xc6, 0x7b, 0xcf, 0x75, 0xb3, 0x0d, 0xaf, 0x3b, 0xdb, 0xdb, 0xab,
+	0xdc, 0x8d, 0xfe, 0xcf, 0xa0, 0x31, 0xe4, 0x55, 0x2a, 0x8e, 0xf6, 0x19, 0xd4, 0x12, 0xe5, 0x2a,
+	0xb5, 0x31, 0xf3, 0x15, 0x51, 0x3f, 0x5c, 0xa5, 0x0e, 0x67, 0x38, 0x2e, 0xfd, 0xa4, 0xe0, 0x8d,
+	0x46, 0x15, 0x71, 0xbb, 0xf8, 0xee, 0xbf, 0x07, 0x00, 0xd5, 0x8d, 0x23, 0xdb, 0xa4, 0x19, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2151,6 +2532,8 @@ type OverlayInspectorClient interface {
 	GetStats(ctx context.Context, in *GetStatsRequest, opts ...grpc.CallOption) (*GetStatsResponse, error)
 	// CreateStats creates a node with specified stats
 	CreateStats(ctx context.Context, in *CreateStatsRequest, opts ...grpc.CallOption) (*CreateStatsResponse, error)
+	// SetDisqualified disqualifies or reinstates a node regardless of its reputation
+	SetDisqualified(ctx context.Context, in *SetDisqualifiedRequest, opts ...grpc.CallOption) (*SetDisqualifiedResponse, error)
 }
 
 type overlayInspectorClient struct {
@@ -2197,6 +2580,15 @@ func (c *overlayInspectorClient) CreateStats(ctx context.Context, in *CreateStat
 	return out, nil
 }
 
+func (c *overlayInspectorClient) SetDisqualified(ctx context.Context, in *SetDisqualifiedRequest, opts ...grpc.CallOption) (*SetDisqualifiedResponse, error) {
+	out := new(SetDisqualifiedResponse)
+	err := c.cc.Invoke(ctx, "/inspector.OverlayInspector/SetDisqualified", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OverlayInspectorServer is the server API for OverlayInspector service.
 type OverlayInspectorServer interface {
 	// CountNodes returns the number of nodes in the cache
@@ -2207,6 +2599,8 @@ type OverlayInspectorServer interface {
 	GetStats(context.Context, *GetStatsRequest) (*GetStatsResponse, error)
 	// CreateStats creates a node with specified stats
 	CreateStats(context.Context, *CreateStatsRequest) (*CreateStatsResponse, error)
+	// SetDisqualified disqualifies or reinstates a node regardless of its reputation
+	SetDisqualified(context.Context, *SetDisqualifiedRequest) (*SetDisqualifiedResponse, error)
 }
 
 func RegisterOverlayInspectorServer(s *grpc.Server, srv OverlayInspectorServer) {
@@ -2285,6 +2679,24 @@ func _OverlayInspector_CreateStats_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OverlayInspector_SetDisqualified_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDisqualifiedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OverlayInspectorServer).SetDisqualified(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inspector.OverlayInspector/SetDisqualified",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OverlayInspectorServer).SetDisqualified(ctx, req.(*SetDisqualifiedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _OverlayInspector_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "inspector.OverlayInspector",
 	HandlerType: (*OverlayInspectorServer)(nil),
@@ -2305,6 +2717,10 @@ var _OverlayInspector_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CreateStats",
 			Handler:    _OverlayInspector_CreateStats_Handler,
 		},
+		{
+			MethodName: "SetDisqualified",
+			Handler:    _OverlayInspector_SetDisqualified_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "inspector.proto",
@@ -2577,3 +2993,170 @@ var _HealthInspector_serviceDesc = grpc.ServiceDesc{
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "inspector.proto",
 }
+
+// ProjectInspectorClient is the client API for ProjectInspector service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ProjectInspectorClient interface {
+	// GetProjectUsageLimits returns the usage limits and current usage of a project
+	GetProjectUsageLimits(ctx context.Context, in *GetProjectUsageLimitsRequest, opts ...grpc.CallOption) (*GetProjectUsageLimitsResponse, error)
+	// SetProjectUsageLimits updates the usage limits of a project
+	SetProjectUsageLimits(ctx context.Context, in *SetProjectUsageLimitsRequest, opts ...grpc.CallOption) (*SetProjectUsageLimitsResponse, error)
+}
+
+type projectInspectorClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewProjectInspectorClient(cc *grpc.ClientConn) ProjectInspectorClient {
+	return &projectInspectorClient{cc}
+}
+
+func (c *projectInspectorClient) GetProjectUsageLimits(ctx context.Context, in *GetProjectUsageLimitsRequest, opts ...grpc.CallOption) (*GetProjectUsageLimitsResponse, error) {
+	out := new(GetProjectUsageLimitsResponse)
+	err := c.cc.Invoke(ctx, "/inspector.ProjectInspector/GetProjectUsageLimits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectInspectorClient) SetProjectUsageLimits(ctx context.Context, in *SetProjectUsageLimitsRequest, opts ...grpc.CallOption) (*SetProjectUsageLimitsResponse, error) {
+	out := new(SetProjectUsageLimitsResponse)
+	err := c.cc.Invoke(ctx, "/inspector.ProjectInspector/SetProjectUsageLimits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ProjectInspectorServer is the server API for ProjectInspector service.
+type ProjectInspectorServer interface {
+	// GetProjectUsageLimits returns the usage limits and current usage of a project
+	GetProjectUsageLimits(context.Context, *GetProjectUsageLimitsRequest) (*GetProjectUsageLimitsResponse, error)
+	// SetProjectUsageLimits updates the usage limits of a project
+	SetProjectUsageLimits(context.Context, *SetProjectUsageLimitsRequest) (*SetProjectUsageLimitsResponse, error)
+}
+
+func RegisterProjectInspectorServer(s *grpc.Server, srv ProjectInspectorServer) {
+	s.RegisterService(&_ProjectInspector_serviceDesc, srv)
+}
+
+func _ProjectInspector_GetProjectUsageLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProjectUsageLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectInspectorServer).GetProjectUsageLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inspector.ProjectInspector/GetProjectUsageLimits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectInspectorServer).GetProjectUsageLimits(ctx, req.(*GetProjectUsageLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectInspector_SetProjectUsageLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetProjectUsageLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectInspectorServer).SetProjectUsageLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inspector.ProjectInspector/SetProjectUsageLimits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectInspectorServer).SetProjectUsageLimits(ctx, req.(*SetProjectUsageLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ProjectInspector_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "inspector.ProjectInspector",
+	HandlerType: (*ProjectInspectorServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetProjectUsageLimits",
+			Handler:    _ProjectInspector_GetProjectUsageLimits_Handler,
+		},
+		{
+			MethodName: "SetProjectUsageLimits",
+			Handler:    _ProjectInspector_SetProjectUsageLimits_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "inspector.proto",
+}
+
+// ShardInspectorClient is the client API for ShardInspector service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ShardInspectorClient interface {
+	// MoveProject moves the pointers of a project to another pointer database shard
+	MoveProject(ctx context.Context, in *MoveProjectRequest, opts ...grpc.CallOption) (*MoveProjectResponse, error)
+}
+
+type shardInspectorClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewShardInspectorClient(cc *grpc.ClientConn) ShardInspectorClient {
+	return &shardInspectorClient{cc}
+}
+
+func (c *shardInspectorClient) MoveProject(ctx context.Context, in *MoveProjectRequest, opts ...grpc.CallOption) (*MoveProjectResponse, error) {
+	out := new(MoveProjectResponse)
+	err := c.cc.Invoke(ctx, "/inspector.ShardInspector/MoveProject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ShardInspectorServer is the server API for ShardInspector service.
+type ShardInspectorServer interface {
+	// MoveProject moves the pointers of a project to another pointer database shard
+	MoveProject(context.Context, *MoveProjectRequest) (*MoveProjectResponse, error)
+}
+
+func RegisterShardInspectorServer(s *grpc.Server, srv ShardInspectorServer) {
+	s.RegisterService(&_ShardInspector_serviceDesc, srv)
+}
+
+func _ShardInspector_MoveProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShardInspectorServer).MoveProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inspector.ShardInspector/MoveProject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShardInspectorServer).MoveProject(ctx, req.(*MoveProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ShardInspector_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "inspector.ShardInspector",
+	HandlerType: (*ShardInspectorServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "MoveProject",
+			Handler:    _ShardInspector_MoveProject_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "inspector.proto",
+}
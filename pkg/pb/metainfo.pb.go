@@ -765,6 +765,122 @@ func (m *ListSegmentsResponse_Item) GetIsPrefix() bool {
 	return false
 }
 
+type SegmentMoveRequest struct {
+	Bucket               []byte   `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Path                 []byte   `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Segment              int64    `protobuf:"varint,3,opt,name=segment,proto3" json:"segment,omitempty"`
+	NewPath              []byte   `protobuf:"bytes,4,opt,name=new_path,json=newPath,proto3" json:"new_path,omitempty"`
+	NewSegment           int64    `protobuf:"varint,5,opt,name=new_segment,json=newSegment,proto3" json:"new_segment,omitempty"`
+	NewMetadata          []byte   `protobuf:"bytes,6,opt,name=new_metadata,json=newMetadata,proto3" json:"new_metadata,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SegmentMoveRequest) Reset()         { *m = SegmentMoveRequest{} }
+func (m *SegmentMoveRequest) String() string { return proto.CompactTextString(m) }
+func (*SegmentMoveRequest) ProtoMessage()    {}
+func (*SegmentMoveRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{13}
+}
+func (m *SegmentMoveRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SegmentMoveRequest.Unmarshal(m, b)
+}
+func (m *SegmentMoveRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SegmentMoveRequest.Marshal(b, m, deterministic)
+}
+func (m *SegmentMoveRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SegmentMoveRequest.Merge(m, src)
+}
+func (m *SegmentMoveRequest) XXX_Size() int {
+	return xxx_messageInfo_SegmentMoveRequest.Size(m)
+}
+func (m *SegmentMoveRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SegmentMoveRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SegmentMoveRequest proto.InternalMessageInfo
+
+func (m *SegmentMoveRequest) GetBucket() []byte {
+	if m != nil {
+		return m.Bucket
+	}
+	return nil
+}
+
+func (m *SegmentMoveRequest) GetPath() []byte {
+	if m != nil {
+		return m.Path
+	}
+	return nil
+}
+
+func (m *SegmentMoveRequest) GetSegment() int64 {
+	if m != nil {
+		return m.Segment
+	}
+	return 0
+}
+
+func (m *SegmentMoveRequest) GetNewPath() []byte {
+	if m != nil {
+		return m.NewPath
+	}
+	return nil
+}
+
+func (m *SegmentMoveRequest) GetNewSegment() int64 {
+	if m != nil {
+		return m.NewSegment
+	}
+	return 0
+}
+
+func (m *SegmentMoveRequest) GetNewMetadata() []byte {
+	if m != nil {
+		return m.NewMetadata
+	}
+	return nil
+}
+
+type SegmentMoveResponse struct {
+	Pointer              *Pointer `protobuf:"bytes,1,opt,name=pointer,proto3" json:"pointer,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SegmentMoveResponse) Reset()         { *m = SegmentMoveResponse{} }
+func (m *SegmentMoveResponse) String() string { return proto.CompactTextString(m) }
+func (*SegmentMoveResponse) ProtoMessage()    {}
+func (*SegmentMoveResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{14}
+}
+func (m *SegmentMoveResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SegmentMoveResponse.Unmarshal(m, b)
+}
+func (m *SegmentMoveResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SegmentMoveResponse.Marshal(b, m, deterministic)
+}
+func (m *SegmentMoveResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SegmentMoveResponse.Merge(m, src)
+}
+func (m *SegmentMoveResponse) XXX_Size() int {
+	return xxx_messageInfo_SegmentMoveResponse.Size(m)
+}
+func (m *SegmentMoveResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SegmentMoveResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SegmentMoveResponse proto.InternalMessageInfo
+
+func (m *SegmentMoveResponse) GetPointer() *Pointer {
+	if m != nil {
+		return m.Pointer
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*AddressedOrderLimit)(nil), "metainfo.AddressedOrderLimit")
 	proto.RegisterType((*SegmentWriteRequest)(nil), "metainfo.SegmentWriteRequest")
@@ -780,66 +896,73 @@ func init() {
 	proto.RegisterType((*ListSegmentsRequest)(nil), "metainfo.ListSegmentsRequest")
 	proto.RegisterType((*ListSegmentsResponse)(nil), "metainfo.ListSegmentsResponse")
 	proto.RegisterType((*ListSegmentsResponse_Item)(nil), "metainfo.ListSegmentsResponse.Item")
+	proto.RegisterType((*SegmentMoveRequest)(nil), "metainfo.SegmentMoveRequest")
+	proto.RegisterType((*SegmentMoveResponse)(nil), "metainfo.SegmentMoveResponse")
 }
 
 func init() { proto.RegisterFile("metainfo.proto", fileDescriptor_631e2f30a93cd64e) }
 
 var fileDescriptor_631e2f30a93cd64e = []byte{
-	// 856 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0x67, 0xed, 0x38, 0x4e, 0x9e, 0x9d, 0x1a, 0x26, 0x21, 0x5d, 0x6d, 0x1b, 0x6c, 0x96, 0x4b,
-	0x90, 0xd0, 0x56, 0x4a, 0x4f, 0x50, 0x2e, 0x4d, 0x52, 0x44, 0x50, 0x5b, 0xa2, 0x09, 0x02, 0xa9,
-	0x42, 0xac, 0xc6, 0xde, 0xe7, 0xed, 0x08, 0xef, 0xce, 0x32, 0x33, 0x86, 0xb4, 0x77, 0x3e, 0x40,
-	0x0f, 0x7c, 0xa7, 0x1e, 0xf8, 0x00, 0x88, 0x43, 0xbe, 0x03, 0xdf, 0x00, 0xed, 0xec, 0x8c, 0xbd,
-	0x89, 0x6d, 0x42, 0x25, 0xdf, 0xe6, 0xbd, 0xf9, 0xcd, 0xfb, 0xf7, 0x7b, 0xef, 0x0d, 0xdc, 0xc9,
-	0x50, 0x33, 0x9e, 0x8f, 0x45, 0x54, 0x48, 0xa1, 0x05, 0xd9, 0x72, 0x72, 0x00, 0xa9, 0x48, 0xad,
-	0x36, 0xe8, 0xa7, 0x42, 0xa4, 0x13, 0x7c, 0x60, 0xa4, 0xe1, 0x74, 0xfc, 0x40, 0xf3, 0x0c, 0x95,
-	0x66, 0x59, 0x61, 0x01, 0x90, 0x8b, 0x04, 0xed, 0xb9, 0x57, 0x08, 0x9e, 0x6b, 0x94, 0xc9, 0xd0,
-	0x2a, 0xba, 0x42, 0x26, 0x28, 0x55, 0x25, 0x85, 0xbf, 0x7b, 0xb0, 0xfb, 0x38, 0x49, 0x24, 0x2a,
-	0x85, 0xc9, 0xb7, 0xe5, 0xcd, 0x53, 0x9e, 0x71, 0x4d, 0x3e, 0x85, 0xd6, 0xa4, 0x3c, 0xf8, 0xde,
-	0xc0, 0x3b, 0xec, 0x1c, 0xed, 0x46, 0xf6, 0xd5, 0x1c, 0x72, 0x44, 0x2b, 0x04, 0x39, 0x81, 0x3d,
-	0xa5, 0x85, 0x64, 0x29, 0xc6, 0xa5, 0xdf, 0x98, 0x55, 0xe6, 0xfc, 0x86, 0x79, 0xf9, 0x41, 0x64,
-	0x82, 0x79, 0x2e, 0x12, 0xb4, 0x7e, 0x28, 0xb1, 0xf0, 0x9a, 0x2e, 0x7c, 0xd3, 0x80, 0xdd, 0x0b,
-	0x4c, 0x33, 0xcc, 0xf5, 0x0f, 0x92, 0x6b, 0xa4, 0xf8, 0xcb, 0x14, 0x95, 0x26, 0xfb, 0xb0, 0x39,
-	0x9c, 0x8e, 0x7e, 0xc6, 0x2a, 0x90, 0x2e, 0xb5, 0x12, 0x21, 0xb0, 0x51, 0x30, 0xfd, 0xd2, 0x38,
-	0xe9, 0x52, 0x73, 0x26, 0x3e, 0xb4, 0x55, 0x65, 0xc2, 0x6f, 0x0e, 0xbc, 0xc3, 0x26, 0x75, 0x22,
-	0x79, 0x04, 0x20, 0x31, 0x99, 0xe6, 0x09, 0xcb, 0x47, 0xaf, 0xfc, 0x0d, 0x13, 0xd8, 0xbd, 0x68,
-	0x5e, 0x19, 0x3a, 0xbb, 0xbc, 0x18, 0xbd, 0xc4, 0x0c, 0x69, 0x0d, 0x4e, 0x1e, 0x41, 0x90, 0xb1,
-	0xcb, 0x18, 0xf3, 0x91, 0x7c, 0x55, 0x68, 0x4c, 0x62, 0x6b, 0x35, 0x56, 0xfc, 0x35, 0xfa, 0x2d,
-	0xe3, 0xe9, 0x6e, 0xc6, 0x2e, 0x9f, 0x38, 0x80, 0xcd, 0xe3, 0x82, 0xbf, 0x46, 0xf2, 0x05, 0x00,
-	0x5e, 0x16, 0x5c, 0x32, 0xcd, 0x45, 0xee, 0x6f, 0x1a, 0xcf, 0x41, 0x54, 0x11, 0x18, 0x39, 0x02,
-	0xa3, 0xef, 0x1c, 0x81, 0xb4, 0x86, 0x0e, 0xff, 0xf0, 0x60, 0xef, 0x7a, 0x4d, 0x54, 0x21, 0x72,
-	0x85, 0xe4, 0x6b, 0x78, 0x9f, 0x39, 0xce, 0x62, 0x43, 0x82, 0xf2, 0xbd, 0x41, 0xf3, 0xb0, 0x73,
-	0x74, 0x10, 0xcd, 0x3a, 0x68, 0x09, 0xab, 0xb4, 0x37, 0x7b, 0x66, 0x64, 0x45, 0x1e, 0xc2, 0x8e,
-	0x14, 0x42, 0xc7, 0x05, 0xc7, 0x11, 0xc6, 0x3c, 0xa9, 0xea, 0x79, 0xdc, 0x7b, 0x7b, 0xd5, 0x7f,
-	0xef, 0xef, 0xab, 0x7e, 0xfb, 0xbc, 0xd4, 0x9f, 0x9d, 0xd2, 0x4e, 0x89, 0xaa, 0x84, 0x24, 0x7c,
-	0x3b, 0x8f, 0xeb, 0x44, 0x64, 0xa5, 0xdd, 0xb5, 0x92, 0xf5, 0x19, 0xb4, 0x2d, 0x33, 0x96, 0x29,
-	0x52, 0x63, 0xea, 0xbc, 0x3a, 0x51, 0x07, 0x21, 0x5f, 0x42, 0x4f, 0x48, 0x9e, 0xf2, 0x9c, 0x4d,
-	0x5c, 0x29, 0x5a, 0xa6, 0x14, 0x4b, 0x5b, 0xf6, 0x8e, 0xc3, 0x56, 0xf9, 0x87, 0x4f, 0xe0, 0xc3,
-	0x1b, 0x99, 0xd8, 0x12, 0xd7, 0x82, 0xf0, 0x6e, 0x0d, 0x22, 0xfc, 0x09, 0xf6, 0xad, 0x99, 0x53,
-	0xf1, 0x5b, 0x3e, 0x11, 0x2c, 0x59, 0x6b, 0x49, 0xc2, 0x37, 0x1e, 0xdc, 0x5d, 0x70, 0xb0, 0xf6,
-	0x66, 0xa8, 0xe5, 0xdc, 0xb8, 0x3d, 0xe7, 0x17, 0x40, 0x6c, 0x48, 0x67, 0xf9, 0x58, 0xac, 0x37,
-	0xdf, 0x93, 0xd9, 0x32, 0xa8, 0x6c, 0x2f, 0x92, 0xf2, 0x3f, 0x02, 0xfc, 0x71, 0xd6, 0xa5, 0xa7,
-	0x38, 0xc1, 0x35, 0xaf, 0x94, 0x90, 0xcd, 0x3a, 0xc7, 0x59, 0x5f, 0x37, 0x1f, 0xe1, 0x5f, 0x1e,
-	0xec, 0x3e, 0xe5, 0x4a, 0x5b, 0x3f, 0xea, 0xb6, 0x04, 0xf6, 0x61, 0xb3, 0x90, 0x38, 0xe6, 0x97,
-	0x36, 0x05, 0x2b, 0x91, 0x3e, 0x74, 0x94, 0x66, 0x52, 0xc7, 0x6c, 0x5c, 0x96, 0xae, 0x69, 0x2e,
-	0xc1, 0xa8, 0x1e, 0x97, 0x1a, 0x72, 0x00, 0x80, 0x79, 0x12, 0x0f, 0x71, 0x2c, 0x24, 0x9a, 0xa1,
-	0xeb, 0xd2, 0x6d, 0xcc, 0x93, 0x63, 0xa3, 0x20, 0xf7, 0x61, 0x5b, 0xe2, 0x68, 0x2a, 0x15, 0xff,
-	0xb5, 0xda, 0x77, 0x5b, 0x74, 0xae, 0x20, 0x7b, 0xee, 0xa7, 0x28, 0x97, 0x5b, 0xcb, 0x7d, 0x0a,
-	0x07, 0x00, 0x65, 0xb2, 0xf1, 0x78, 0xc2, 0x52, 0xe5, 0xb7, 0x07, 0xde, 0x61, 0x9b, 0x6e, 0x97,
-	0x9a, 0xaf, 0x4a, 0x45, 0xf8, 0xa7, 0x07, 0x7b, 0xd7, 0x53, 0xb3, 0xd5, 0xfb, 0x1c, 0x5a, 0x5c,
-	0x63, 0xe6, 0x4a, 0xf6, 0xc9, 0xbc, 0x64, 0xcb, 0xe0, 0xd1, 0x99, 0xc6, 0x8c, 0x56, 0x2f, 0x4a,
-	0xfe, 0xb2, 0x32, 0xfe, 0x86, 0x89, 0xd0, 0x9c, 0x03, 0x84, 0x8d, 0x12, 0x32, 0xe3, 0xd6, 0xab,
-	0x71, 0xfb, 0x4e, 0xdd, 0x44, 0xee, 0xc1, 0x36, 0x57, 0xb1, 0xad, 0x6f, 0xd3, 0xb8, 0xd8, 0xe2,
-	0xea, 0xdc, 0xc8, 0x47, 0xff, 0x34, 0x61, 0xeb, 0x99, 0x0d, 0x94, 0x3c, 0x87, 0x9d, 0x13, 0x89,
-	0x4c, 0xa3, 0x8d, 0x96, 0xd4, 0x78, 0x5f, 0xf2, 0xc5, 0x05, 0x1f, 0xad, 0xba, 0xb6, 0x25, 0x39,
-	0x87, 0x9d, 0x6a, 0x39, 0x39, 0x7b, 0x8b, 0x0f, 0xae, 0xad, 0xe1, 0xa0, 0xbf, 0xf2, 0xde, 0x5a,
-	0xfc, 0x06, 0x3a, 0xb5, 0xf1, 0x22, 0xf7, 0x17, 0xf0, 0xb5, 0x89, 0x0e, 0x0e, 0x56, 0xdc, 0x5a,
-	0x5b, 0xdf, 0x43, 0xcf, 0xad, 0x24, 0x17, 0xdf, 0x60, 0xe1, 0xc5, 0x8d, 0xad, 0x18, 0x7c, 0xfc,
-	0x1f, 0x88, 0x79, 0xd6, 0xd5, 0x60, 0xad, 0xce, 0xfa, 0xda, 0x58, 0x2f, 0xc9, 0xfa, 0xc6, 0x60,
-	0x3e, 0x83, 0x6e, 0xbd, 0x87, 0xea, 0xb4, 0x2c, 0x99, 0xb2, 0x3a, 0x2d, 0xcb, 0x5a, 0xef, 0x78,
-	0xe3, 0x45, 0xa3, 0x18, 0x0e, 0x37, 0xcd, 0x1f, 0xfe, 0xf0, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0xbd, 0xa9, 0x5c, 0xa6, 0xba, 0x09, 0x00, 0x00,
+	// 932 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0x67, 0xfd, 0x27, 0x76, 0x9e, 0x9d, 0x1a, 0xc6, 0x69, 0x6a, 0xb6, 0x75, 0xed, 0x2e, 0x97,
+	0x20, 0xa1, 0xad, 0x94, 0x9e, 0xa0, 0x5c, 0x9a, 0xa4, 0x88, 0xa0, 0xa6, 0x58, 0x1b, 0x04, 0x52,
+	0x85, 0x58, 0x8d, 0xbd, 0xcf, 0xee, 0x08, 0xef, 0xce, 0xb2, 0x33, 0x6e, 0xd2, 0xde, 0xf9, 0x00,
+	0x3d, 0xf0, 0x61, 0x38, 0x70, 0xef, 0x81, 0x0f, 0x80, 0x38, 0xe4, 0xb3, 0xa0, 0x99, 0x9d, 0xb1,
+	0xd7, 0x71, 0x4c, 0xa0, 0xf2, 0x6d, 0xdf, 0xff, 0xdf, 0x7b, 0xbf, 0x99, 0x37, 0x0b, 0xb7, 0x62,
+	0x94, 0x94, 0x25, 0x63, 0xee, 0xa7, 0x19, 0x97, 0x9c, 0xd4, 0xad, 0xec, 0xc2, 0x84, 0x4f, 0x8c,
+	0xd6, 0xed, 0x4d, 0x38, 0x9f, 0x4c, 0xf1, 0xa1, 0x96, 0x86, 0xb3, 0xf1, 0x43, 0xc9, 0x62, 0x14,
+	0x92, 0xc6, 0xa9, 0x71, 0x80, 0x84, 0x47, 0x68, 0xbe, 0x5b, 0x29, 0x67, 0x89, 0xc4, 0x2c, 0x1a,
+	0x1a, 0x45, 0x93, 0x67, 0x11, 0x66, 0x22, 0x97, 0xbc, 0x5f, 0x1d, 0x68, 0x3f, 0x89, 0xa2, 0x0c,
+	0x85, 0xc0, 0xe8, 0x5b, 0x65, 0x79, 0xc6, 0x62, 0x26, 0xc9, 0xa7, 0x50, 0x9d, 0xaa, 0x8f, 0x8e,
+	0xd3, 0x77, 0xf6, 0x1b, 0x07, 0x6d, 0xdf, 0x44, 0x2d, 0x5c, 0x0e, 0x82, 0xdc, 0x83, 0x1c, 0xc1,
+	0xae, 0x90, 0x3c, 0xa3, 0x13, 0x0c, 0x55, 0xdd, 0x90, 0xe6, 0xe9, 0x3a, 0x25, 0x1d, 0xf9, 0x91,
+	0xaf, 0xc1, 0x3c, 0xe7, 0x11, 0x9a, 0x3a, 0x01, 0x31, 0xee, 0x05, 0x9d, 0xf7, 0xb6, 0x04, 0xed,
+	0x33, 0x9c, 0xc4, 0x98, 0xc8, 0x1f, 0x32, 0x26, 0x31, 0xc0, 0x5f, 0x66, 0x28, 0x24, 0xd9, 0x83,
+	0xad, 0xe1, 0x6c, 0xf4, 0x33, 0xe6, 0x40, 0x9a, 0x81, 0x91, 0x08, 0x81, 0x4a, 0x4a, 0xe5, 0x4b,
+	0x5d, 0xa4, 0x19, 0xe8, 0x6f, 0xd2, 0x81, 0x9a, 0xc8, 0x53, 0x74, 0xca, 0x7d, 0x67, 0xbf, 0x1c,
+	0x58, 0x91, 0x3c, 0x06, 0xc8, 0x30, 0x9a, 0x25, 0x11, 0x4d, 0x46, 0xaf, 0x3b, 0x15, 0x0d, 0xec,
+	0xae, 0xbf, 0x98, 0x4c, 0x30, 0x37, 0x9e, 0x8d, 0x5e, 0x62, 0x8c, 0x41, 0xc1, 0x9d, 0x3c, 0x06,
+	0x37, 0xa6, 0x17, 0x21, 0x26, 0xa3, 0xec, 0x75, 0x2a, 0x31, 0x0a, 0x4d, 0xd6, 0x50, 0xb0, 0x37,
+	0xd8, 0xa9, 0xea, 0x4a, 0x77, 0x62, 0x7a, 0xf1, 0xd4, 0x3a, 0x98, 0x3e, 0xce, 0xd8, 0x1b, 0x24,
+	0x5f, 0x00, 0xe0, 0x45, 0xca, 0x32, 0x2a, 0x19, 0x4f, 0x3a, 0x5b, 0xba, 0xb2, 0xeb, 0xe7, 0x04,
+	0xfa, 0x96, 0x40, 0xff, 0x3b, 0x4b, 0x60, 0x50, 0xf0, 0xf6, 0x7e, 0x73, 0x60, 0x77, 0x79, 0x26,
+	0x22, 0xe5, 0x89, 0x40, 0xf2, 0x35, 0x7c, 0x48, 0x2d, 0x67, 0xa1, 0x26, 0x41, 0x74, 0x9c, 0x7e,
+	0x79, 0xbf, 0x71, 0xd0, 0xf5, 0xe7, 0x27, 0xe8, 0x1a, 0x56, 0x83, 0xd6, 0x3c, 0x4c, 0xcb, 0x82,
+	0x3c, 0x82, 0x9d, 0x8c, 0x73, 0x19, 0xa6, 0x0c, 0x47, 0x18, 0xb2, 0x28, 0x9f, 0xe7, 0x61, 0xeb,
+	0xdd, 0x65, 0xef, 0x83, 0xbf, 0x2f, 0x7b, 0xb5, 0x81, 0xd2, 0x9f, 0x1c, 0x07, 0x0d, 0xe5, 0x95,
+	0x0b, 0x91, 0xf7, 0x6e, 0x81, 0xeb, 0x88, 0xc7, 0x2a, 0xef, 0x46, 0xc9, 0xfa, 0x0c, 0x6a, 0x86,
+	0x19, 0xc3, 0x14, 0x29, 0x30, 0x35, 0xc8, 0xbf, 0x02, 0xeb, 0x42, 0xbe, 0x84, 0x16, 0xcf, 0xd8,
+	0x84, 0x25, 0x74, 0x6a, 0x47, 0x51, 0xed, 0x97, 0xd7, 0x1d, 0xd9, 0x5b, 0xd6, 0x37, 0xef, 0xdf,
+	0x7b, 0x0a, 0xb7, 0xaf, 0x74, 0x62, 0x46, 0x5c, 0x00, 0xe1, 0xdc, 0x08, 0xc2, 0xfb, 0x09, 0xf6,
+	0x4c, 0x9a, 0x63, 0x7e, 0x9e, 0x4c, 0x39, 0x8d, 0x36, 0x3a, 0x12, 0xef, 0xad, 0x03, 0x77, 0x56,
+	0x0a, 0x6c, 0xfc, 0x30, 0x14, 0x7a, 0x2e, 0xdd, 0xdc, 0xf3, 0x0b, 0x20, 0x06, 0xd2, 0x49, 0x32,
+	0xe6, 0x9b, 0xed, 0xf7, 0x08, 0xda, 0x4b, 0xb9, 0x57, 0x49, 0xf9, 0x0f, 0x00, 0x7f, 0x9c, 0x9f,
+	0xd2, 0x63, 0x9c, 0xe2, 0x86, 0x57, 0x8a, 0x47, 0xe1, 0xf6, 0x95, 0xec, 0x9b, 0xe6, 0xc3, 0xfb,
+	0xcb, 0x81, 0xf6, 0x33, 0x26, 0xa4, 0xa9, 0x23, 0x6e, 0x6a, 0x60, 0x0f, 0xb6, 0xd2, 0x0c, 0xc7,
+	0xec, 0xc2, 0xb4, 0x60, 0x24, 0xd2, 0x83, 0x86, 0x90, 0x34, 0x93, 0x21, 0x1d, 0xab, 0xd1, 0x95,
+	0xb5, 0x11, 0xb4, 0xea, 0x89, 0xd2, 0x90, 0x2e, 0x00, 0x26, 0x51, 0x38, 0xc4, 0x31, 0xcf, 0x50,
+	0x5f, 0xba, 0x66, 0xb0, 0x8d, 0x49, 0x74, 0xa8, 0x15, 0xe4, 0x1e, 0x6c, 0x67, 0x38, 0x9a, 0x65,
+	0x82, 0xbd, 0xca, 0xf7, 0x5d, 0x3d, 0x58, 0x28, 0xc8, 0xae, 0x7d, 0x29, 0xd4, 0x72, 0xab, 0xda,
+	0x47, 0xa1, 0x0b, 0xa0, 0x9a, 0x0d, 0xc7, 0x53, 0x3a, 0x11, 0x9d, 0x5a, 0xdf, 0xd9, 0xaf, 0x05,
+	0xdb, 0x4a, 0xf3, 0x95, 0x52, 0x78, 0x7f, 0x3a, 0xb0, 0xbb, 0xdc, 0x9a, 0x99, 0xde, 0xe7, 0x50,
+	0x65, 0x12, 0x63, 0x3b, 0xb2, 0x4f, 0x16, 0x23, 0xbb, 0xce, 0xdd, 0x3f, 0x91, 0x18, 0x07, 0x79,
+	0x84, 0xe2, 0x2f, 0x56, 0xf8, 0x4b, 0x1a, 0xa1, 0xfe, 0x76, 0x11, 0x2a, 0xca, 0x65, 0xce, 0xad,
+	0x53, 0xe0, 0xf6, 0x7f, 0x9d, 0x26, 0x72, 0x17, 0xb6, 0x99, 0x08, 0xcd, 0x7c, 0xcb, 0xba, 0x44,
+	0x9d, 0x89, 0x81, 0x96, 0xbd, 0xdf, 0x9d, 0xf9, 0x65, 0x38, 0xe5, 0xaf, 0x36, 0xfc, 0x78, 0x7d,
+	0x0c, 0xf5, 0x04, 0xcf, 0x43, 0x1d, 0x91, 0x73, 0x53, 0x4b, 0xf0, 0x7c, 0xa0, 0x82, 0x7a, 0xd0,
+	0x50, 0x26, 0x1b, 0x98, 0xbf, 0x45, 0x90, 0xe0, 0xb9, 0x01, 0x43, 0x1e, 0x40, 0x53, 0x39, 0xa8,
+	0x21, 0x46, 0x54, 0x52, 0xcd, 0x51, 0x33, 0x50, 0x41, 0xa7, 0x46, 0x55, 0xb8, 0x6b, 0x39, 0xf4,
+	0xf7, 0x59, 0x80, 0x07, 0x7f, 0x54, 0xa0, 0x7e, 0x6a, 0x98, 0x22, 0xcf, 0x61, 0xe7, 0x28, 0x43,
+	0x2a, 0xd1, 0xa2, 0x28, 0x1c, 0xfc, 0x6b, 0xde, 0x78, 0xf7, 0xfe, 0x3a, 0xb3, 0x81, 0x32, 0x80,
+	0x9d, 0x7c, 0x3b, 0xdb, 0x7c, 0xab, 0x01, 0x4b, 0xef, 0x90, 0xdb, 0x5b, 0x6b, 0x37, 0x19, 0xbf,
+	0x81, 0x46, 0x61, 0xbf, 0x90, 0x7b, 0x2b, 0xfe, 0x85, 0x95, 0xe6, 0x76, 0xd7, 0x58, 0x4d, 0xae,
+	0xef, 0xa1, 0x65, 0x77, 0xb2, 0xc5, 0xd7, 0x5f, 0x89, 0xb8, 0xf2, 0x2c, 0xb8, 0x0f, 0xfe, 0xc5,
+	0x63, 0xd1, 0x75, 0xbe, 0x59, 0xd6, 0x77, 0xbd, 0xb4, 0xd7, 0xdc, 0xde, 0x5a, 0xbb, 0xc9, 0x78,
+	0x0a, 0xcd, 0xe2, 0x25, 0x2a, 0xd2, 0x72, 0xcd, 0x9a, 0x71, 0xef, 0xaf, 0x33, 0x2f, 0x86, 0xa8,
+	0x4e, 0x8c, 0x85, 0xb7, 0x3a, 0xc4, 0xc2, 0x55, 0x70, 0xbb, 0x6b, 0xac, 0x79, 0xae, 0xc3, 0xca,
+	0x8b, 0x52, 0x3a, 0x1c, 0x6e, 0xe9, 0x1f, 0xa2, 0x47, 0xff, 0x0c, 0x00, 0x12, 0x06, 0xdc, 0x05,
+	0x07, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -860,6 +983,7 @@ type MetainfoClient interface {
 	DownloadSegment(ctx context.Context, in *SegmentDownloadRequest, opts ...grpc.CallOption) (*SegmentDownloadResponse, error)
 	DeleteSegment(ctx context.Context, in *SegmentDeleteRequest, opts ...grpc.CallOption) (*SegmentDeleteResponse, error)
 	ListSegments(ctx context.Context, in *ListSegmentsRequest, opts ...grpc.CallOption) (*ListSegmentsResponse, error)
+	MoveSegment(ctx context.Context, in *SegmentMoveRequest, opts ...grpc.CallOption) (*SegmentMoveResponse, error)
 }
 
 type metainfoClient struct {
@@ -924,6 +1048,15 @@ func (c *metainfoClient) ListSegments(ctx context.Context, in *ListSegmentsReque
 	return out, nil
 }
 
+func (c *metainfoClient) MoveSegment(ctx context.Context, in *SegmentMoveRequest, opts ...grpc.CallOption) (*SegmentMoveResponse, error) {
+	out := new(SegmentMoveResponse)
+	err := c.cc.Invoke(ctx, "/metainfo.Metainfo/MoveSegment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MetainfoServer is the server API for Metainfo service.
 type MetainfoServer interface {
 	CreateSegment(context.Context, *SegmentWriteRequest) (*SegmentWriteResponse, error)
@@ -932,6 +1065,7 @@ type MetainfoServer interface {
 	DownloadSegment(context.Context, *SegmentDownloadRequest) (*SegmentDownloadResponse, error)
 	DeleteSegment(context.Context, *SegmentDeleteRequest) (*SegmentDeleteResponse, error)
 	ListSegments(context.Context, *ListSegmentsRequest) (*ListSegmentsResponse, error)
+	MoveSegment(context.Context, *SegmentMoveRequest) (*SegmentMoveResponse, error)
 }
 
 func RegisterMetainfoServer(s *grpc.Server, srv MetainfoServer) {
@@ -1046,6 +1180,24 @@ func _Metainfo_ListSegments_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Metainfo_MoveSegment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SegmentMoveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetainfoServer).MoveSegment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/metainfo.Metainfo/MoveSegment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetainfoServer).MoveSegment(ctx, req.(*SegmentMoveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Metainfo_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "metainfo.Metainfo",
 	HandlerType: (*MetainfoServer)(nil),
@@ -1074,6 +1226,10 @@ var _Metainfo_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListSegments",
 			Handler:    _Metainfo_ListSegments_Handler,
 		},
+		{
+			MethodName: "MoveSegment",
+			Handler:    _Metainfo_MoveSegment_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "metainfo.proto",
@@ -0,0 +1,390 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: payouts.proto
+
+package pb
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
+	grpc "google.golang.org/grpc"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+// PayoutStatement describes the earnings of a storage node for a payout period
+type PayoutStatement struct {
+	NodeId      NodeID               `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3,customtype=NodeID" json:"node_id"`
+	PeriodStart *timestamp.Timestamp `protobuf:"bytes,2,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`
+	PeriodEnd   *timestamp.Timestamp `protobuf:"bytes,3,opt,name=period_end,json=periodEnd,proto3" json:"period_end,omitempty"`
+	// usage during the period
+	AtRestTotal    float64 `protobuf:"fixed64,4,opt,name=at_rest_total,json=atRestTotal,proto3" json:"at_rest_total,omitempty"`
+	GetTotal       int64   `protobuf:"varint,5,opt,name=get_total,json=getTotal,proto3" json:"get_total,omitempty"`
+	GetRepairTotal int64   `protobuf:"varint,6,opt,name=get_repair_total,json=getRepairTotal,proto3" json:"get_repair_total,omitempty"`
+	GetAuditTotal  int64   `protobuf:"varint,7,opt,name=get_audit_total,json=getAuditTotal,proto3" json:"get_audit_total,omitempty"`
+	PutTotal       int64   `protobuf:"varint,8,opt,name=put_total,json=putTotal,proto3" json:"put_total,omitempty"`
+	PutRepairTotal int64   `protobuf:"varint,9,opt,name=put_repair_total,json=putRepairTotal,proto3" json:"put_repair_total,omitempty"`
+	// earnings in cents
+	AtRestAmount         int64    `protobuf:"varint,10,opt,name=at_rest_amount,json=atRestAmount,proto3" json:"at_rest_amount,omitempty"`
+	GetAmount            int64    `protobuf:"varint,11,opt,name=get_amount,json=getAmount,proto3" json:"get_amount,omitempty"`
+	RepairAmount         int64    `protobuf:"varint,12,opt,name=repair_amount,json=repairAmount,proto3" json:"repair_amount,omitempty"`
+	AuditAmount          int64    `protobuf:"varint,13,opt,name=audit_amount,json=auditAmount,proto3" json:"audit_amount,omitempty"`
+	Earned               int64    `protobuf:"varint,14,opt,name=earned,proto3" json:"earned,omitempty"`
+	HeldPercent          int32    `protobuf:"varint,15,opt,name=held_percent,json=heldPercent,proto3" json:"held_percent,omitempty"`
+	Held                 int64    `protobuf:"varint,16,opt,name=held,proto3" json:"held,omitempty"`
+	Disqualified         bool     `protobuf:"varint,17,opt,name=disqualified,proto3" json:"disqualified,omitempty"`
+	Paid                 int64    `protobuf:"varint,18,opt,name=paid,proto3" json:"paid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PayoutStatement) Reset()         { *m = PayoutStatement{} }
+func (m *PayoutStatement) String() string { return proto.CompactTextString(m) }
+func (*PayoutStatement) ProtoMessage()    {}
+func (*PayoutStatement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_abfb9c4b4f60e63a, []int{0}
+}
+func (m *PayoutStatement) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PayoutStatement.Unmarshal(m, b)
+}
+func (m *PayoutStatement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PayoutStatement.Marshal(b, m, deterministic)
+}
+func (m *PayoutStatement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PayoutStatement.Merge(m, src)
+}
+func (m *PayoutStatement) XXX_Size() int {
+	return xxx_messageInfo_PayoutStatement.Size(m)
+}
+func (m *PayoutStatement) XXX_DiscardUnknown() {
+	xxx_messageInfo_PayoutStatement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PayoutStatement proto.InternalMessageInfo
+
+func (m *PayoutStatement) GetPeriodStart() *timestamp.Timestamp {
+	if m != nil {
+		return m.PeriodStart
+	}
+	return nil
+}
+
+func (m *PayoutStatement) GetPeriodEnd() *timestamp.Timestamp {
+	if m != nil {
+		return m.PeriodEnd
+	}
+	return nil
+}
+
+func (m *PayoutStatement) GetAtRestTotal() float64 {
+	if m != nil {
+		return m.AtRestTotal
+	}
+	return 0
+}
+
+func (m *PayoutStatement) GetGetTotal() int64 {
+	if m != nil {
+		return m.GetTotal
+	}
+	return 0
+}
+
+func (m *PayoutStatement) GetGetRepairTotal() int64 {
+	if m != nil {
+		return m.GetRepairTotal
+	}
+	return 0
+}
+
+func (m *PayoutStatement) GetGetAuditTotal() int64 {
+	if m != nil {
+		return m.GetAuditTotal
+	}
+	return 0
+}
+
+func (m *PayoutStatement) GetPutTotal() int64 {
+	if m != nil {
+		return m.PutTotal
+	}
+	return 0
+}
+
+func (m *PayoutStatement) GetPutRepairTotal() int64 {
+	if m != nil {
+		return m.PutRepairTotal
+	}
+	return 0
+}
+
+func (m *PayoutStatement) GetAtRestAmount() int64 {
+	if m != nil {
+		return m.AtRestAmount
+	}
+	return 0
+}
+
+func (m *PayoutStatement) GetGetAmount() int64 {
+	if m != nil {
+		return m.GetAmount
+	}
+	return 0
+}
+
+func (m *PayoutStatement) GetRepairAmount() int64 {
+	if m != nil {
+		return m.RepairAmount
+	}
+	return 0
+}
+
+func (m *PayoutStatement) GetAuditAmount() int64 {
+	if m != nil {
+		return m.AuditAmount
+	}
+	return 0
+}
+
+func (m *PayoutStatement) GetEarned() int64 {
+	if m != nil {
+		return m.Earned
+	}
+	return 0
+}
+
+func (m *PayoutStatement) GetHeldPercent() int32 {
+	if m != nil {
+		return m.HeldPercent
+	}
+	return 0
+}
+
+func (m *PayoutStatement) GetHeld() int64 {
+	if m != nil {
+		return m.Held
+	}
+	return 0
+}
+
+func (m *PayoutStatement) GetDisqualified() bool {
+	if m != nil {
+		return m.Disqualified
+	}
+	return false
+}
+
+func (m *PayoutStatement) GetPaid() int64 {
+	if m != nil {
+		return m.Paid
+	}
+	return 0
+}
+
+type GetPayoutsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetPayoutsRequest) Reset()         { *m = GetPayoutsRequest{} }
+func (m *GetPayoutsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPayoutsRequest) ProtoMessage()    {}
+func (*GetPayoutsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_abfb9c4b4f60e63a, []int{1}
+}
+func (m *GetPayoutsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPayoutsRequest.Unmarshal(m, b)
+}
+func (m *GetPayoutsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPayoutsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetPayoutsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPayoutsRequest.Merge(m, src)
+}
+func (m *GetPayoutsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetPayoutsRequest.Size(m)
+}
+func (m *GetPayoutsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPayoutsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPayoutsRequest proto.InternalMessageInfo
+
+type GetPayoutsResponse struct {
+	// statements of the finished periods, newest first
+	Statements []*PayoutStatement `protobuf:"bytes,1,rep,name=statements,proto3" json:"statements,omitempty"`
+	// expected earnings for the current period so far
+	Estimate             *PayoutStatement `protobuf:"bytes,2,opt,name=estimate,proto3" json:"estimate,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *GetPayoutsResponse) Reset()         { *m = GetPayoutsResponse{} }
+func (m *GetPayoutsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetPayoutsResponse) ProtoMessage()    {}
+func (*GetPayoutsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_abfb9c4b4f60e63a, []int{2}
+}
+func (m *GetPayoutsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPayoutsResponse.Unmarshal(m, b)
+}
+func (m *GetPayoutsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPayoutsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetPayoutsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPayoutsResponse.Merge(m, src)
+}
+func (m *GetPayoutsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetPayoutsResponse.Size(m)
+}
+func (m *GetPayoutsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPayoutsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPayoutsResponse proto.InternalMessageInfo
+
+func (m *GetPayoutsResponse) GetStatements() []*PayoutStatement {
+	if m != nil {
+		return m.Statements
+	}
+	return nil
+}
+
+func (m *GetPayoutsResponse) GetEstimate() *PayoutStatement {
+	if m != nil {
+		return m.Estimate
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*PayoutStatement)(nil), "payouts.PayoutStatement")
+	proto.RegisterType((*GetPayoutsRequest)(nil), "payouts.GetPayoutsRequest")
+	proto.RegisterType((*GetPayoutsResponse)(nil), "payouts.GetPayoutsResponse")
+}
+
+func init() { proto.RegisterFile("payouts.proto", fileDescriptor_abfb9c4b4f60e63a) }
+
+var fileDescriptor_abfb9c4b4f60e63a = []byte{
+	// 522 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0x4d, 0x6f, 0xd3, 0x4e,
+	0x10, 0xc6, 0xeb, 0xbe, 0x24, 0xe9, 0xd8, 0x49, 0xda, 0xfd, 0x4b, 0x7f, 0xad, 0x52, 0xa1, 0x18,
+	0x83, 0xc0, 0xa7, 0x54, 0x0a, 0x1c, 0xe0, 0xc0, 0xa1, 0x15, 0xa8, 0xea, 0x05, 0x55, 0x6e, 0x4f,
+	0x5c, 0xa2, 0x0d, 0x3b, 0x35, 0x96, 0x12, 0xef, 0xd6, 0x3b, 0x3e, 0x70, 0xe7, 0x93, 0xf0, 0x69,
+	0xf8, 0x0c, 0x1c, 0xfa, 0x59, 0xd0, 0xbe, 0x38, 0x7d, 0x01, 0xc4, 0xcd, 0xf3, 0xcc, 0xef, 0xf1,
+	0x33, 0x23, 0xcd, 0xc2, 0x50, 0x8b, 0xaf, 0xaa, 0x25, 0x33, 0xd3, 0x8d, 0x22, 0xc5, 0xfa, 0xa1,
+	0x9c, 0x40, 0xa9, 0x4a, 0xe5, 0xc5, 0xc9, 0xb4, 0x54, 0xaa, 0x5c, 0xe1, 0xb1, 0xab, 0x96, 0xed,
+	0xf5, 0x31, 0x55, 0x6b, 0x34, 0x24, 0xd6, 0xda, 0x03, 0xd9, 0xf7, 0x3d, 0x18, 0x5f, 0x38, 0xe3,
+	0x25, 0x09, 0xc2, 0x35, 0xd6, 0xc4, 0x5e, 0x42, 0xbf, 0x56, 0x12, 0x17, 0x95, 0xe4, 0x51, 0x1a,
+	0xe5, 0xc9, 0xe9, 0xe8, 0xc7, 0xed, 0x74, 0xeb, 0xe7, 0xed, 0xb4, 0xf7, 0x51, 0x49, 0x3c, 0x7f,
+	0x5f, 0xf4, 0x6c, 0xfb, 0x5c, 0xb2, 0x77, 0x90, 0x68, 0x6c, 0x2a, 0x25, 0x17, 0x86, 0x44, 0x43,
+	0x7c, 0x3b, 0x8d, 0xf2, 0x78, 0x3e, 0x99, 0xf9, 0xd0, 0x59, 0x17, 0x3a, 0xbb, 0xea, 0x42, 0x8b,
+	0xd8, 0xf3, 0x97, 0x16, 0x67, 0x6f, 0x01, 0x82, 0x1d, 0x6b, 0xc9, 0x77, 0xfe, 0x69, 0xde, 0xf7,
+	0xf4, 0x87, 0x5a, 0xb2, 0x0c, 0x86, 0x82, 0x16, 0x0d, 0x1a, 0x5a, 0x90, 0x22, 0xb1, 0xe2, 0xbb,
+	0x69, 0x94, 0x47, 0x45, 0x2c, 0xa8, 0x40, 0x43, 0x57, 0x56, 0x62, 0x47, 0xb0, 0x5f, 0x62, 0xd7,
+	0xdf, 0x4b, 0xa3, 0x7c, 0xa7, 0x18, 0x94, 0x18, 0x9a, 0x39, 0x1c, 0xd8, 0x66, 0x83, 0x5a, 0x54,
+	0x4d, 0x60, 0x7a, 0x8e, 0x19, 0x95, 0x48, 0x85, 0x93, 0x3d, 0xf9, 0x02, 0xc6, 0x96, 0x14, 0xad,
+	0xac, 0xba, 0x9f, 0xf5, 0x1d, 0x38, 0x2c, 0x91, 0x4e, 0xac, 0xba, 0x89, 0xd3, 0x6d, 0x47, 0x0c,
+	0x7c, 0x9c, 0x6e, 0xef, 0xe2, 0x74, 0xfb, 0x28, 0x6e, 0xdf, 0xc7, 0xe9, 0xf6, 0x41, 0xdc, 0x73,
+	0x18, 0x75, 0x9b, 0x89, 0xb5, 0x6a, 0x6b, 0xe2, 0xe0, 0xb8, 0xc4, 0xaf, 0x76, 0xe2, 0x34, 0xf6,
+	0x04, 0xc0, 0x0d, 0xe5, 0x89, 0xd8, 0x11, 0x76, 0xdb, 0xd0, 0x7e, 0x06, 0xc3, 0x10, 0x15, 0x88,
+	0xc4, 0xff, 0xc3, 0x8b, 0x01, 0x7a, 0x0a, 0x89, 0x5f, 0x2a, 0x30, 0x43, 0xc7, 0xc4, 0x4e, 0x0b,
+	0xc8, 0xff, 0xd0, 0x43, 0xd1, 0xd4, 0x28, 0xf9, 0xc8, 0x35, 0x43, 0x65, 0xad, 0x5f, 0x70, 0x25,
+	0x17, 0x1a, 0x9b, 0xcf, 0x58, 0x13, 0x1f, 0xa7, 0x51, 0xbe, 0x57, 0xc4, 0x56, 0xbb, 0xf0, 0x12,
+	0x63, 0xb0, 0x6b, 0x4b, 0x7e, 0xe0, 0x8c, 0xee, 0x9b, 0x65, 0x90, 0xc8, 0xca, 0xdc, 0xb4, 0x62,
+	0x55, 0x5d, 0x57, 0x28, 0xf9, 0x61, 0x1a, 0xe5, 0x83, 0xe2, 0x81, 0x66, 0x7d, 0x5a, 0x54, 0x92,
+	0x33, 0xef, 0xb3, 0xdf, 0xd9, 0x7f, 0x70, 0x78, 0x86, 0xe4, 0xcf, 0xd4, 0x14, 0x78, 0xd3, 0xa2,
+	0xa1, 0xec, 0x5b, 0x04, 0xec, 0xbe, 0x6a, 0xb4, 0xaa, 0x0d, 0xb2, 0x37, 0x00, 0xa6, 0xbb, 0x64,
+	0xc3, 0xa3, 0x74, 0x27, 0x8f, 0xe7, 0x7c, 0xd6, 0x3d, 0x95, 0x47, 0xa7, 0x5e, 0xdc, 0x63, 0xd9,
+	0x6b, 0x18, 0xa0, 0xa1, 0x6a, 0x2d, 0x08, 0xc3, 0x25, 0xff, 0xdd, 0xb7, 0x21, 0xe7, 0x05, 0xf4,
+	0xc3, 0x08, 0xec, 0x0c, 0xe0, 0x6e, 0x20, 0x36, 0xd9, 0x98, 0x7f, 0x9b, 0x7d, 0x72, 0xf4, 0xc7,
+	0x9e, 0xdf, 0x20, 0xdb, 0x3a, 0xdd, 0xfd, 0xb4, 0xad, 0x97, 0xcb, 0x9e, 0x7b, 0x02, 0xaf, 0x7e,
+	0x0d, 0x00, 0xee, 0xa7, 0x63, 0x48, 0xe8, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// PayoutsClient is the client API for Payouts service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type PayoutsClient interface {
+	GetPayouts(ctx context.Context, in *GetPayoutsRequest, opts ...grpc.CallOption) (*GetPayoutsResponse, error)
+}
+
+type payoutsClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewPayoutsClient(cc *grpc.ClientConn) PayoutsClient {
+	return &payoutsClient{cc}
+}
+
+func (c *payoutsClient) GetPayouts(ctx context.Context, in *GetPayoutsRequest, opts ...grpc.CallOption) (*GetPayoutsResponse, error) {
+	out := new(GetPayoutsResponse)
+	err := c.cc.Invoke(ctx, "/payouts.Payouts/GetPayouts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PayoutsServer is the server API for Payouts service.
+type PayoutsServer interface {
+	GetPayouts(context.Context, *GetPayoutsRequest) (*GetPayoutsResponse, error)
+}
+
+func RegisterPayoutsServer(s *grpc.Server, srv PayoutsServer) {
+	s.RegisterService(&_Payouts_serviceDesc, srv)
+}
+
+func _Payouts_GetPayouts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPayoutsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PayoutsServer).GetPayouts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/payouts.Payouts/GetPayouts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PayoutsServer).GetPayouts(ctx, req.(*GetPayoutsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Payouts_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "payouts.Payouts",
+	HandlerType: (*PayoutsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetPayouts",
+			Handler:    _Payouts_GetPayouts_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "payouts.proto",
+}
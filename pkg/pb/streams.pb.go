@@ -67,10 +67,16 @@ func (m *SegmentMeta) GetKeyNonce() []byte {
 }
 
 type StreamInfo struct {
-	NumberOfSegments     int64    `protobuf:"varint,1,opt,name=number_of_segments,json=numberOfSegments,proto3" json:"number_of_segments,omitempty"`
-	SegmentsSize         int64    `protobuf:"varint,2,opt,name=segments_size,json=segmentsSize,proto3" json:"segments_size,omitempty"`
-	LastSegmentSize      int64    `protobuf:"varint,3,opt,name=last_segment_size,json=lastSegmentSize,proto3" json:"last_segment_size,omitempty"`
-	Metadata             []byte   `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	NumberOfSegments int64  `protobuf:"varint,1,opt,name=number_of_segments,json=numberOfSegments,proto3" json:"number_of_segments,omitempty"`
+	SegmentsSize     int64  `protobuf:"varint,2,opt,name=segments_size,json=segmentsSize,proto3" json:"segments_size,omitempty"`
+	LastSegmentSize  int64  `protobuf:"varint,3,opt,name=last_segment_size,json=lastSegmentSize,proto3" json:"last_segment_size,omitempty"`
+	Metadata         []byte `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// ranges are set for streams concatenated from other streams,
+	// the segment size and the content nonce restart in every range
+	Ranges []*SegmentRange `protobuf:"bytes,5,rep,name=ranges,proto3" json:"ranges,omitempty"`
+	// checksum is the MD5 hash of the unencrypted content,
+	// it isn't known for streams concatenated from other streams
+	Checksum             []byte   `protobuf:"bytes,6,opt,name=checksum,proto3" json:"checksum,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -128,21 +134,92 @@ func (m *StreamInfo) GetMetadata() []byte {
 	return nil
 }
 
+func (m *StreamInfo) GetRanges() []*SegmentRange {
+	if m != nil {
+		return m.Ranges
+	}
+	return nil
+}
+
+func (m *StreamInfo) GetChecksum() []byte {
+	if m != nil {
+		return m.Checksum
+	}
+	return nil
+}
+
+type SegmentRange struct {
+	NumberOfSegments     int64    `protobuf:"varint,1,opt,name=number_of_segments,json=numberOfSegments,proto3" json:"number_of_segments,omitempty"`
+	SegmentsSize         int64    `protobuf:"varint,2,opt,name=segments_size,json=segmentsSize,proto3" json:"segments_size,omitempty"`
+	LastSegmentSize      int64    `protobuf:"varint,3,opt,name=last_segment_size,json=lastSegmentSize,proto3" json:"last_segment_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SegmentRange) Reset()         { *m = SegmentRange{} }
+func (m *SegmentRange) String() string { return proto.CompactTextString(m) }
+func (*SegmentRange) ProtoMessage()    {}
+func (*SegmentRange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6bbf8af0ec331d6, []int{2}
+}
+func (m *SegmentRange) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SegmentRange.Unmarshal(m, b)
+}
+func (m *SegmentRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SegmentRange.Marshal(b, m, deterministic)
+}
+func (m *SegmentRange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SegmentRange.Merge(m, src)
+}
+func (m *SegmentRange) XXX_Size() int {
+	return xxx_messageInfo_SegmentRange.Size(m)
+}
+func (m *SegmentRange) XXX_DiscardUnknown() {
+	xxx_messageInfo_SegmentRange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SegmentRange proto.InternalMessageInfo
+
+func (m *SegmentRange) GetNumberOfSegments() int64 {
+	if m != nil {
+		return m.NumberOfSegments
+	}
+	return 0
+}
+
+func (m *SegmentRange) GetSegmentsSize() int64 {
+	if m != nil {
+		return m.SegmentsSize
+	}
+	return 0
+}
+
+func (m *SegmentRange) GetLastSegmentSize() int64 {
+	if m != nil {
+		return m.LastSegmentSize
+	}
+	return 0
+}
+
 type StreamMeta struct {
-	EncryptedStreamInfo  []byte       `protobuf:"bytes,1,opt,name=encrypted_stream_info,json=encryptedStreamInfo,proto3" json:"encrypted_stream_info,omitempty"`
-	EncryptionType       int32        `protobuf:"varint,2,opt,name=encryption_type,json=encryptionType,proto3" json:"encryption_type,omitempty"`
-	EncryptionBlockSize  int32        `protobuf:"varint,3,opt,name=encryption_block_size,json=encryptionBlockSize,proto3" json:"encryption_block_size,omitempty"`
-	LastSegmentMeta      *SegmentMeta `protobuf:"bytes,4,opt,name=last_segment_meta,json=lastSegmentMeta,proto3" json:"last_segment_meta,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+	EncryptedStreamInfo []byte       `protobuf:"bytes,1,opt,name=encrypted_stream_info,json=encryptedStreamInfo,proto3" json:"encrypted_stream_info,omitempty"`
+	EncryptionType      int32        `protobuf:"varint,2,opt,name=encryption_type,json=encryptionType,proto3" json:"encryption_type,omitempty"`
+	EncryptionBlockSize int32        `protobuf:"varint,3,opt,name=encryption_block_size,json=encryptionBlockSize,proto3" json:"encryption_block_size,omitempty"`
+	LastSegmentMeta     *SegmentMeta `protobuf:"bytes,4,opt,name=last_segment_meta,json=lastSegmentMeta,proto3" json:"last_segment_meta,omitempty"`
+	// stream_info_nonce is used instead of the zero nonce for encrypting the stream info,
+	// when the content key of the last segment was already used for another stream info
+	StreamInfoNonce      []byte   `protobuf:"bytes,5,opt,name=stream_info_nonce,json=streamInfoNonce,proto3" json:"stream_info_nonce,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *StreamMeta) Reset()         { *m = StreamMeta{} }
 func (m *StreamMeta) String() string { return proto.CompactTextString(m) }
 func (*StreamMeta) ProtoMessage()    {}
 func (*StreamMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6bbf8af0ec331d6, []int{2}
+	return fileDescriptor_c6bbf8af0ec331d6, []int{3}
 }
 func (m *StreamMeta) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StreamMeta.Unmarshal(m, b)
@@ -190,33 +267,46 @@ func (m *StreamMeta) GetLastSegmentMeta() *SegmentMeta {
 	return nil
 }
 
+func (m *StreamMeta) GetStreamInfoNonce() []byte {
+	if m != nil {
+		return m.StreamInfoNonce
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*SegmentMeta)(nil), "streams.SegmentMeta")
 	proto.RegisterType((*StreamInfo)(nil), "streams.StreamInfo")
+	proto.RegisterType((*SegmentRange)(nil), "streams.SegmentRange")
 	proto.RegisterType((*StreamMeta)(nil), "streams.StreamMeta")
 }
 
 func init() { proto.RegisterFile("streams.proto", fileDescriptor_c6bbf8af0ec331d6) }
 
 var fileDescriptor_c6bbf8af0ec331d6 = []byte{
-	// 304 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x51, 0xcb, 0x4e, 0xc3, 0x30,
-	0x10, 0x54, 0x5f, 0x50, 0xb6, 0x29, 0x05, 0x03, 0x52, 0x04, 0x17, 0x14, 0x0e, 0x20, 0x84, 0x7a,
-	0x28, 0x3f, 0x80, 0x7a, 0x43, 0x08, 0x2a, 0x25, 0x9c, 0xb8, 0x58, 0x4e, 0xba, 0x41, 0x51, 0x1a,
-	0x3b, 0x8a, 0xcd, 0xc1, 0xfd, 0x21, 0x3e, 0x8b, 0x5f, 0x41, 0xb6, 0xf3, 0x82, 0xe3, 0xce, 0x8c,
-	0x66, 0x67, 0x76, 0x61, 0x2e, 0x55, 0x85, 0xac, 0x90, 0xcb, 0xb2, 0x12, 0x4a, 0x90, 0xc3, 0x7a,
-	0x0c, 0x36, 0x30, 0x8b, 0xf0, 0xb3, 0x40, 0xae, 0x5e, 0x51, 0x31, 0x72, 0x03, 0x73, 0xe4, 0x49,
-	0xa5, 0x4b, 0x85, 0x5b, 0x9a, 0xa3, 0xf6, 0x07, 0xd7, 0x83, 0x3b, 0x2f, 0xf4, 0x5a, 0xf0, 0x05,
-	0x35, 0xb9, 0x82, 0xa3, 0x1c, 0x35, 0xe5, 0x82, 0x27, 0xe8, 0x0f, 0xad, 0x60, 0x9a, 0xa3, 0x7e,
-	0x33, 0x73, 0xf0, 0x3d, 0x00, 0x88, 0xac, 0xf9, 0x33, 0x4f, 0x05, 0x79, 0x00, 0xc2, 0xbf, 0x8a,
-	0x18, 0x2b, 0x2a, 0x52, 0x2a, 0xdd, 0x26, 0x69, 0x5d, 0x47, 0xe1, 0x89, 0x63, 0x36, 0x69, 0x9d,
-	0x40, 0x9a, 0xf5, 0x8d, 0x86, 0xca, 0x6c, 0xef, 0xdc, 0x47, 0xa1, 0xd7, 0x80, 0x51, 0xb6, 0x47,
-	0x72, 0x0f, 0xa7, 0x3b, 0x26, 0x55, 0xe3, 0xe6, 0x84, 0x23, 0x2b, 0x5c, 0x18, 0xa2, 0x76, 0xb3,
-	0xda, 0x4b, 0x98, 0x16, 0xa8, 0xd8, 0x96, 0x29, 0xe6, 0x8f, 0x5d, 0xd2, 0x66, 0x0e, 0x7e, 0xda,
-	0xa4, 0xb6, 0xfa, 0x0a, 0x2e, 0xba, 0xea, 0xee, 0x3c, 0x34, 0xe3, 0xa9, 0xa8, 0x4f, 0x70, 0xd6,
-	0x92, 0xbd, 0x76, 0xb7, 0xb0, 0xa8, 0xe1, 0x4c, 0x70, 0xaa, 0x74, 0xe9, 0x12, 0x4f, 0xc2, 0xe3,
-	0x0e, 0x7e, 0xd7, 0x25, 0xf6, 0xcc, 0x8d, 0x30, 0xde, 0x89, 0x24, 0xef, 0x72, 0x4f, 0x5a, 0xf3,
-	0x4c, 0xf0, 0xb5, 0xe1, 0x6c, 0xf6, 0xa7, 0x7f, 0x3d, 0x4d, 0x70, 0x5b, 0x62, 0xb6, 0x3a, 0x5f,
-	0x36, 0xef, 0xec, 0x3d, 0xef, 0x4f, 0x7b, 0x03, 0xac, 0xc7, 0x1f, 0xc3, 0x32, 0x8e, 0x0f, 0xec,
-	0xcb, 0x1f, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xdf, 0x5e, 0x32, 0x31, 0x03, 0x02, 0x00, 0x00,
+	// 369 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x92, 0xcf, 0x6e, 0xaa, 0x50,
+	0x10, 0xc6, 0x83, 0x88, 0xd7, 0x3b, 0xe2, 0xf5, 0xca, 0xbd, 0x26, 0xa4, 0xdd, 0x18, 0xba, 0xa8,
+	0x31, 0xad, 0x0b, 0xfb, 0x02, 0x8d, 0xbb, 0xa6, 0x69, 0x4d, 0xb0, 0xab, 0x6e, 0x08, 0xe0, 0x60,
+	0x09, 0x72, 0x0e, 0xe1, 0x1c, 0x17, 0xf8, 0x0a, 0x4d, 0x5f, 0xb6, 0x4f, 0xd0, 0x9c, 0x3f, 0x20,
+	0xf5, 0x05, 0xba, 0x9c, 0x99, 0x8f, 0xef, 0xcc, 0x8f, 0x6f, 0x60, 0xc8, 0x78, 0x89, 0x61, 0xce,
+	0x16, 0x45, 0x49, 0x39, 0x75, 0x7e, 0xe9, 0xd2, 0x5b, 0xc3, 0x60, 0x83, 0xbb, 0x1c, 0x09, 0x7f,
+	0x42, 0x1e, 0x3a, 0x57, 0x30, 0x44, 0x12, 0x97, 0x55, 0xc1, 0x71, 0x1b, 0x64, 0x58, 0xb9, 0xc6,
+	0xd4, 0x98, 0xd9, 0xbe, 0xdd, 0x34, 0x1f, 0xb1, 0x72, 0x2e, 0xe1, 0x77, 0x86, 0x55, 0x40, 0x28,
+	0x89, 0xd1, 0xed, 0x48, 0x41, 0x3f, 0xc3, 0xea, 0x59, 0xd4, 0xde, 0xa7, 0x01, 0xb0, 0x91, 0xe6,
+	0x0f, 0x24, 0xa1, 0xce, 0x0d, 0x38, 0xe4, 0x90, 0x47, 0x58, 0x06, 0x34, 0x09, 0x98, 0x7a, 0x89,
+	0x49, 0x57, 0xd3, 0xff, 0xab, 0x26, 0xeb, 0x44, 0x6f, 0xc0, 0xc4, 0xf3, 0xb5, 0x26, 0x60, 0xe9,
+	0x51, 0xb9, 0x9b, 0xbe, 0x5d, 0x37, 0x37, 0xe9, 0x11, 0x9d, 0x39, 0x8c, 0xf7, 0x21, 0xe3, 0xb5,
+	0x9b, 0x12, 0x9a, 0x52, 0x38, 0x12, 0x03, 0xed, 0x26, 0xb5, 0x17, 0xd0, 0xcf, 0x91, 0x87, 0xdb,
+	0x90, 0x87, 0x6e, 0x57, 0x6d, 0x5a, 0xd7, 0xce, 0x2d, 0xf4, 0xca, 0x90, 0xec, 0x90, 0xb9, 0xd6,
+	0xd4, 0x9c, 0x0d, 0x96, 0x93, 0x45, 0xfd, 0x8f, 0xb4, 0x83, 0x2f, 0xa6, 0xbe, 0x16, 0x09, 0xab,
+	0xf8, 0x0d, 0xe3, 0x8c, 0x1d, 0x72, 0xb7, 0xa7, 0xac, 0xea, 0xda, 0xfb, 0x30, 0xc0, 0x6e, 0x7f,
+	0xf4, 0xc3, 0xd8, 0xde, 0x7b, 0xa7, 0x0e, 0x41, 0xa6, 0xba, 0x84, 0xc9, 0x29, 0x55, 0x05, 0x19,
+	0xa4, 0x24, 0xa1, 0x3a, 0xdd, 0x7f, 0xcd, 0xb0, 0x15, 0xdc, 0x35, 0x8c, 0x74, 0x3b, 0xa5, 0x24,
+	0xe0, 0x55, 0xa1, 0xb6, 0xb2, 0xfc, 0x3f, 0xa7, 0xf6, 0x4b, 0x55, 0x60, 0xcb, 0x5c, 0x08, 0xa3,
+	0x3d, 0x8d, 0xb3, 0xd3, 0x6e, 0x56, 0x63, 0x9e, 0x52, 0xb2, 0x12, 0x33, 0xc9, 0x72, 0x7f, 0xc6,
+	0x92, 0xa3, 0xce, 0x67, 0xb0, 0xfc, 0x7f, 0x9e, 0x82, 0x20, 0xf8, 0x46, 0x28, 0x91, 0xe6, 0x30,
+	0x6e, 0x81, 0xe8, 0x5b, 0xb4, 0x24, 0xce, 0x88, 0x35, 0x14, 0xf2, 0x24, 0x57, 0xdd, 0xd7, 0x4e,
+	0x11, 0x45, 0x3d, 0x79, 0xf9, 0x77, 0x5f, 0x03, 0x00, 0xa3, 0xe4, 0x95, 0xec, 0x0a, 0x03, 0x00,
+	0x00,
 }
@@ -155,6 +155,7 @@ func convertMeta(m streams.Meta) Meta {
 		Modified:         m.Modified,
 		Expiration:       m.Expiration,
 		Size:             m.Size,
+		Checksum:         string(m.Checksum),
 		SerializableMeta: ser,
 	}
 }
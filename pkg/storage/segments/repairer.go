@@ -17,6 +17,7 @@ import (
 	"storj.io/storj/pkg/storj"
 	"storj.io/storj/satellite/metainfo"
 	"storj.io/storj/satellite/orders"
+	"storj.io/storj/storage"
 )
 
 // Repairer for segments
@@ -45,8 +46,9 @@ func NewSegmentRepairer(metainfo *metainfo.Service, orders *orders.Service, cach
 func (repairer *Repairer) Repair(ctx context.Context, path storj.Path) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	// Read the segment pointer from the metainfo
-	pointer, err := repairer.metainfo.Get(path)
+	// Read the segment pointer from the metainfo, keeping the original bytes
+	// to detect concurrent modifications when committing the repair
+	pointerBytes, pointer, err := repairer.metainfo.GetWithBytes(ctx, path)
 	if err != nil {
 		return Error.Wrap(err)
 	}
@@ -55,6 +57,12 @@ func (repairer *Repairer) Repair(ctx context.Context, path storj.Path) (err erro
 		return Error.New("cannot repair inline segment %s", path)
 	}
 
+	// the segment may have expired after it was queued for repair,
+	// the reaper deletes its pointer
+	if metainfo.IsExpired(pointer, time.Now()) {
+		return nil
+	}
+
 	redundancy, err := eestream.NewRedundancyStrategyFromProto(pointer.GetRemote().GetRedundancy())
 	if err != nil {
 		return Error.Wrap(err)
@@ -140,22 +148,57 @@ func (repairer *Repairer) Repair(ctx context.Context, path storj.Path) (err erro
 	}
 
 	// Add the successfully uploaded pieces to the healthyPieces
+	var uploadedPieces []*pb.RemotePiece
 	for i, node := range successfulNodes {
 		if node == nil {
 			continue
 		}
-		healthyPieces = append(healthyPieces, &pb.RemotePiece{
+		uploadedPieces = append(uploadedPieces, &pb.RemotePiece{
 			PieceNum: int32(i),
 			NodeId:   node.Id,
 			Hash:     hashes[i],
 		})
 	}
+	healthyPieces = append(healthyPieces, uploadedPieces...)
 
 	// Update the remote pieces in the pointer
 	pointer.GetRemote().RemotePieces = healthyPieces
 
-	// Update the segment pointer in the metainfo
-	return repairer.metainfo.Put(path, pointer)
+	// Update the segment pointer in the metainfo, unless the segment
+	// was deleted or overwritten while it was being repaired
+	err = repairer.metainfo.CompareAndSwap(ctx, path, pointerBytes, pointer)
+	if storage.ErrValueChanged.Has(err) || storage.ErrKeyNotFound.Has(err) {
+		mon.Meter("repair_pointer_conflicts").Mark(1)
+
+		cleanupErr := repairer.deletePieces(ctx, bucketID, pointer, uploadedPieces)
+		return Error.New("segment %s was modified during repair: %v", path, errs.Combine(err, cleanupErr))
+	}
+	return Error.Wrap(err)
+}
+
+// deletePieces deletes the given pieces of pointer from the storage nodes
+func (repairer *Repairer) deletePieces(ctx context.Context, bucketID []byte, pointer *pb.Pointer, pieces []*pb.RemotePiece) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	if len(pieces) == 0 {
+		return nil
+	}
+
+	// only the root piece id and the pieces are needed for the delete order limits
+	uploaded := &pb.Pointer{
+		Type:           pb.Pointer_REMOTE,
+		ExpirationDate: pointer.GetExpirationDate(),
+		Remote: &pb.RemoteSegment{
+			RootPieceId:  pointer.GetRemote().RootPieceId,
+			RemotePieces: pieces,
+		},
+	}
+
+	limits, err := repairer.orders.CreateDeleteOrderLimits(ctx, repairer.identity.PeerIdentity(), bucketID, uploaded)
+	if err != nil {
+		return err
+	}
+
+	return repairer.ec.Delete(ctx, limits)
 }
 
 // sliceToSet converts the given slice to a set
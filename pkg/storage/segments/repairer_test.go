@@ -49,14 +49,14 @@ func TestSegmentStoreRepair(t *testing.T) {
 
 		// get a remote segment from metainfo
 		metainfo := satellite.Metainfo.Service
-		listResponse, _, err := metainfo.List("", "", "", true, 0, 0)
+		listResponse, _, err := metainfo.List(ctx, "", "", "", true, 0, 0)
 		require.NoError(t, err)
 
 		var path string
 		var pointer *pb.Pointer
 		for _, v := range listResponse {
 			path = v.GetPath()
-			pointer, err = metainfo.Get(path)
+			pointer, err = metainfo.Get(ctx, path)
 			require.NoError(t, err)
 			if pointer.GetType() == pb.Pointer_REMOTE {
 				break
@@ -121,7 +121,7 @@ func TestSegmentStoreRepair(t *testing.T) {
 		assert.Equal(t, newData, testData)
 
 		// updated pointer should not contain any of the killed nodes
-		pointer, err = metainfo.Get(path)
+		pointer, err = metainfo.Get(ctx, path)
 		assert.NoError(t, err)
 
 		remotePieces = pointer.GetRemote().GetRemotePieces()
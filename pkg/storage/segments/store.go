@@ -49,6 +49,7 @@ type Store interface {
 	Get(ctx context.Context, path storj.Path) (rr ranger.Ranger, meta Meta, err error)
 	Put(ctx context.Context, data io.Reader, expiration time.Time, segmentInfo func() (storj.Path, []byte, error)) (meta Meta, err error)
 	Delete(ctx context.Context, path storj.Path) (err error)
+	Move(ctx context.Context, path, newPath storj.Path, metadata []byte) (meta Meta, err error)
 	List(ctx context.Context, prefix, startAfter, endBefore storj.Path, recursive bool, limit int, metaFlags uint32) (items []ListItem, more bool, err error)
 }
 
@@ -305,6 +306,33 @@ func (s *segmentStore) Delete(ctx context.Context, path storj.Path) (err error)
 	return nil
 }
 
+// Move requests the satellite to move a segment to another path in the same bucket and replace its metadata,
+// the pieces on the storage nodes are kept.
+func (s *segmentStore) Move(ctx context.Context, path, newPath storj.Path, metadata []byte) (meta Meta, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	bucket, objectPath, segmentIndex, err := splitPathFragments(path)
+	if err != nil {
+		return Meta{}, err
+	}
+
+	newBucket, newObjectPath, newSegmentIndex, err := splitPathFragments(newPath)
+	if err != nil {
+		return Meta{}, err
+	}
+
+	if bucket != newBucket {
+		return Meta{}, Error.New("segments can be moved only within a bucket")
+	}
+
+	pointer, err := s.metainfo.MoveSegment(ctx, bucket, objectPath, segmentIndex, newObjectPath, newSegmentIndex, metadata)
+	if err != nil {
+		return Meta{}, Error.Wrap(err)
+	}
+
+	return convertMeta(pointer), nil
+}
+
 // List retrieves paths to segments and their metadata stored in the metainfo
 func (s *segmentStore) List(ctx context.Context, prefix, startAfter, endBefore storj.Path, recursive bool, limit int, metaFlags uint32) (items []ListItem, more bool, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -0,0 +1,75 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package streams
+
+import (
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/pkg/encryption"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+)
+
+// Layout describes the segments of a stream. A stream uploaded at once is a single range,
+// a stream concatenated from other streams keeps a range for each of them.
+type Layout []*pb.SegmentRange
+
+// NewLayout returns the layout of the stream described by stream info
+func NewLayout(stream *pb.StreamInfo) Layout {
+	if len(stream.Ranges) > 0 {
+		return Layout(stream.Ranges)
+	}
+	return Layout{{
+		NumberOfSegments: stream.NumberOfSegments,
+		SegmentsSize:     stream.SegmentsSize,
+		LastSegmentSize:  stream.LastSegmentSize,
+	}}
+}
+
+// NumberOfSegments returns the number of segments in the stream
+func (layout Layout) NumberOfSegments() (count int64) {
+	for _, r := range layout {
+		count += r.NumberOfSegments
+	}
+	return count
+}
+
+// Size returns the size of the unencrypted stream
+func (layout Layout) Size() (size int64) {
+	for _, r := range layout {
+		size += (r.NumberOfSegments-1)*r.SegmentsSize + r.LastSegmentSize
+	}
+	return size
+}
+
+// FixedSegmentSize returns the size of all but the last segment, or -1 when the sizes differ
+func (layout Layout) FixedSegmentSize() int64 {
+	if len(layout) == 1 {
+		return layout[0].SegmentsSize
+	}
+	return -1
+}
+
+// Segment returns the unencrypted size and the starting content nonce of the segment with the given index
+func (layout Layout) Segment(index int64) (size int64, contentNonce storj.Nonce, err error) {
+	local := index
+	for _, r := range layout {
+		if local >= r.NumberOfSegments {
+			local -= r.NumberOfSegments
+			continue
+		}
+
+		size = r.SegmentsSize
+		if local == r.NumberOfSegments-1 {
+			size = r.LastSegmentSize
+		}
+
+		// the content nonce starts from the index of the segment within the range incremented by 1,
+		// as it was when the range was uploaded
+		_, err = encryption.Increment(&contentNonce, local+1)
+		return size, contentNonce, err
+	}
+
+	return 0, contentNonce, errs.New("segment index %d out of range", index)
+}
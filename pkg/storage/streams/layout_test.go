@@ -0,0 +1,63 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package streams
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/pkg/encryption"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+)
+
+func TestLayout(t *testing.T) {
+	nonce := func(counter int64) storj.Nonce {
+		var nonce storj.Nonce
+		_, err := encryption.Increment(&nonce, counter)
+		require.NoError(t, err)
+		return nonce
+	}
+
+	uploaded := NewLayout(&pb.StreamInfo{NumberOfSegments: 3, SegmentsSize: 10, LastSegmentSize: 4})
+	assert.Equal(t, int64(3), uploaded.NumberOfSegments())
+	assert.Equal(t, int64(24), uploaded.Size())
+	assert.Equal(t, int64(10), uploaded.FixedSegmentSize())
+
+	concatenated := NewLayout(&pb.StreamInfo{
+		NumberOfSegments: 4,
+		Ranges: []*pb.SegmentRange{
+			{NumberOfSegments: 2, SegmentsSize: 10, LastSegmentSize: 7},
+			{NumberOfSegments: 1, SegmentsSize: 20, LastSegmentSize: 0},
+			{NumberOfSegments: 1, SegmentsSize: 10, LastSegmentSize: 3},
+		},
+	})
+	assert.Equal(t, int64(4), concatenated.NumberOfSegments())
+	assert.Equal(t, int64(20), concatenated.Size())
+	assert.Equal(t, int64(-1), concatenated.FixedSegmentSize())
+
+	for i, tt := range []struct {
+		layout Layout
+		index  int64
+		size   int64
+		nonce  storj.Nonce
+	}{
+		{uploaded, 0, 10, nonce(1)},
+		{uploaded, 2, 4, nonce(3)},
+		{concatenated, 0, 10, nonce(1)},
+		{concatenated, 1, 7, nonce(2)},
+		{concatenated, 2, 0, nonce(1)},
+		{concatenated, 3, 3, nonce(1)},
+	} {
+		size, contentNonce, err := tt.layout.Segment(tt.index)
+		require.NoError(t, err, i)
+		assert.Equal(t, tt.size, size, i)
+		assert.Equal(t, tt.nonce, contentNonce, i)
+	}
+
+	_, _, err := concatenated.Segment(4)
+	assert.Error(t, err)
+}
@@ -6,6 +6,7 @@ package streams
 import (
 	"bytes"
 	"context"
+	"crypto/md5"
 	"crypto/rand"
 	"fmt"
 	"io"
@@ -36,6 +37,8 @@ type Meta struct {
 	Expiration time.Time
 	Size       int64
 	Data       []byte
+	// Checksum is the MD5 hash of the content, empty when it isn't known
+	Checksum []byte
 }
 
 // convertMeta converts segment metadata to stream metadata
@@ -43,8 +46,9 @@ func convertMeta(lastSegmentMeta segments.Meta, stream pb.StreamInfo, streamMeta
 	return Meta{
 		Modified:   lastSegmentMeta.Modified,
 		Expiration: lastSegmentMeta.Expiration,
-		Size:       ((stream.NumberOfSegments - 1) * stream.SegmentsSize) + stream.LastSegmentSize,
+		Size:       NewLayout(&stream).Size(),
 		Data:       stream.Metadata,
+		Checksum:   stream.Checksum,
 	}
 }
 
@@ -54,6 +58,8 @@ type Store interface {
 	Get(ctx context.Context, path storj.Path, pathCipher storj.Cipher) (ranger.Ranger, Meta, error)
 	Put(ctx context.Context, path storj.Path, pathCipher storj.Cipher, data io.Reader, metadata []byte, expiration time.Time) (Meta, error)
 	Delete(ctx context.Context, path storj.Path, pathCipher storj.Cipher) error
+	Concat(ctx context.Context, path storj.Path, pathCipher storj.Cipher, sources []storj.Path, metadata []byte) (Meta, error)
+	SetMetadata(ctx context.Context, path storj.Path, pathCipher storj.Cipher, metadata []byte) (Meta, error)
 	List(ctx context.Context, prefix, startAfter, endBefore storj.Path, pathCipher storj.Cipher, recursive bool, limit int, metaFlags uint32) (items []ListItem, more bool, err error)
 }
 
@@ -129,7 +135,8 @@ func (s *streamStore) upload(ctx context.Context, path storj.Path, pathCipher st
 		return Meta{}, currentSegment, err
 	}
 
-	eofReader := NewEOFReader(data)
+	checksum := md5.New()
+	eofReader := NewEOFReader(io.TeeReader(data, checksum))
 
 	for !eofReader.isEOF() && !eofReader.hasError() {
 		// generate random key for encrypting the segment's content
@@ -219,6 +226,7 @@ func (s *streamStore) upload(ctx context.Context, path storj.Path, pathCipher st
 				SegmentsSize:     s.segmentSize,
 				LastSegmentSize:  sizeReader.Size(),
 				Metadata:         metadata,
+				Checksum:         checksum.Sum(nil),
 			})
 			if err != nil {
 				return "", nil, err
@@ -267,6 +275,7 @@ func (s *streamStore) upload(ctx context.Context, path storj.Path, pathCipher st
 		Expiration: expiration,
 		Size:       streamSize,
 		Data:       metadata,
+		Checksum:   checksum.Sum(nil),
 	}
 
 	return resultMeta, currentSegment, nil
@@ -288,38 +297,57 @@ func (s *streamStore) Get(ctx context.Context, path storj.Path, pathCipher storj
 		return nil, Meta{}, err
 	}
 
-	lastSegmentRanger, lastSegmentMeta, err := s.segments.Get(ctx, storj.JoinPaths("l", encPath))
+	derivedKey, err := encryption.DeriveContentKey(path, s.rootKey)
 	if err != nil {
 		return nil, Meta{}, err
 	}
 
-	streamInfo, streamMeta, err := DecryptStreamInfo(ctx, lastSegmentMeta.Data, path, s.rootKey)
+	return get(ctx, s.segments, encPath, derivedKey)
+}
+
+// GetShared returns a ranger of the stream at the encrypted path encPath of the bucket,
+// without the root key. pathKey is the key derived for the unencrypted path of the stream.
+func GetShared(ctx context.Context, segments segments.Store, bucket string, encPath storj.Path, pathKey *storj.Key) (rr ranger.Ranger, meta Meta, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	derivedKey, err := encryption.DeriveKey(pathKey, "content")
 	if err != nil {
 		return nil, Meta{}, err
 	}
 
-	stream := pb.StreamInfo{}
-	err = proto.Unmarshal(streamInfo, &stream)
+	return get(ctx, segments, storj.JoinPaths(bucket, encPath), derivedKey)
+}
+
+// get returns a ranger of the stream at encPath, whose content key is derivedKey
+func get(ctx context.Context, segments segments.Store, encPath storj.Path, derivedKey *storj.Key) (rr ranger.Ranger, meta Meta, err error) {
+	lastSegmentRanger, lastSegmentMeta, err := segments.Get(ctx, storj.JoinPaths("l", encPath))
 	if err != nil {
 		return nil, Meta{}, err
 	}
 
-	derivedKey, err := encryption.DeriveContentKey(path, s.rootKey)
+	streamInfo, streamMeta, err := decryptStreamInfo(lastSegmentMeta.Data, derivedKey)
 	if err != nil {
 		return nil, Meta{}, err
 	}
 
+	stream := pb.StreamInfo{}
+	err = proto.Unmarshal(streamInfo, &stream)
+	if err != nil {
+		return nil, Meta{}, err
+	}
+
+	layout := NewLayout(&stream)
+	numberOfSegments := layout.NumberOfSegments()
+
 	var rangers []ranger.Ranger
-	for i := int64(0); i < stream.NumberOfSegments-1; i++ {
+	for i := int64(0); i < numberOfSegments-1; i++ {
 		currentPath := getSegmentPath(encPath, i)
-		size := stream.SegmentsSize
-		var contentNonce storj.Nonce
-		_, err := encryption.Increment(&contentNonce, i+1)
+		size, contentNonce, err := layout.Segment(i)
 		if err != nil {
 			return nil, Meta{}, err
 		}
 		rr := &lazySegmentRanger{
-			segments:      s.segments,
+			segments:      segments,
 			path:          currentPath,
 			size:          size,
 			derivedKey:    derivedKey,
@@ -330,8 +358,7 @@ func (s *streamStore) Get(ctx context.Context, path storj.Path, pathCipher storj
 		rangers = append(rangers, rr)
 	}
 
-	var contentNonce storj.Nonce
-	_, err = encryption.Increment(&contentNonce, stream.NumberOfSegments)
+	lastSegmentSize, contentNonce, err := layout.Segment(numberOfSegments - 1)
 	if err != nil {
 		return nil, Meta{}, err
 	}
@@ -339,7 +366,7 @@ func (s *streamStore) Get(ctx context.Context, path storj.Path, pathCipher storj
 	decryptedLastSegmentRanger, err := decryptRanger(
 		ctx,
 		lastSegmentRanger,
-		stream.LastSegmentSize,
+		lastSegmentSize,
 		storj.Cipher(streamMeta.EncryptionType),
 		derivedKey,
 		encryptedKey,
@@ -405,7 +432,7 @@ func (s *streamStore) Delete(ctx context.Context, path storj.Path, pathCipher st
 		return err
 	}
 
-	for i := 0; i < int(stream.NumberOfSegments-1); i++ {
+	for i := 0; i < int(NewLayout(&stream).NumberOfSegments()-1); i++ {
 		encPath, err = EncryptAfterBucket(path, pathCipher, s.rootKey)
 		if err != nil {
 			return err
@@ -420,6 +447,416 @@ func (s *streamStore) Delete(ctx context.Context, path storj.Path, pathCipher st
 	return s.segments.Delete(ctx, storj.JoinPaths("l", encPath))
 }
 
+// Concat assembles the stream at path from the source streams in the same bucket, without downloading
+// and uploading their data again. The segments of the sources are moved to the new stream, so the sources
+// don't exist anymore afterwards. All sources have to use the same encryption scheme.
+//
+// All sources are checked before anything is moved. A previous stream at path is moved aside and deleted
+// only after the sources were moved, so when the concatenation fails the moved segments are moved back
+// and the concatenation can be retried.
+func (s *streamStore) Concat(ctx context.Context, path storj.Path, pathCipher storj.Cipher, sources []storj.Path, metadata []byte) (m Meta, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(sources) == 0 {
+		return Meta{}, errs.New("no streams to concatenate")
+	}
+
+	encPath, err := EncryptAfterBucket(path, pathCipher, s.rootKey)
+	if err != nil {
+		return Meta{}, err
+	}
+
+	derivedKey, err := encryption.DeriveContentKey(path, s.rootKey)
+	if err != nil {
+		return Meta{}, err
+	}
+
+	moves, layout, err := s.planConcat(ctx, path, encPath, derivedKey, pathCipher, sources, metadata)
+	if err != nil {
+		return Meta{}, err
+	}
+
+	var done segmentMoves
+	defer func() {
+		if err != nil {
+			err = errs.Combine(err, done.undo(ctx, s.segments))
+		}
+	}()
+
+	// previously file uploaded? it is moved aside, so it can be restored when the concatenation fails
+	replaced, err := s.planReplace(ctx, path, encPath, pathCipher)
+	if err != nil {
+		return Meta{}, err
+	}
+	for _, move := range replaced {
+		if _, err := done.move(ctx, s.segments, move); err != nil {
+			return Meta{}, err
+		}
+	}
+
+	var putMeta segments.Meta
+	for _, move := range moves {
+		putMeta, err = done.move(ctx, s.segments, move)
+		if err != nil {
+			return Meta{}, err
+		}
+	}
+
+	for _, move := range replaced {
+		if err := s.segments.Delete(ctx, move.to); err != nil {
+			zap.S().Warnf("Failed deleting a segment of the replaced stream %v %v", move.to, err)
+		}
+	}
+
+	return Meta{
+		Modified:   putMeta.Modified,
+		Expiration: putMeta.Expiration,
+		Size:       layout.Size(),
+		Data:       metadata,
+	}, nil
+}
+
+// planConcat checks the sources of a concatenation and returns the moves of their segments,
+// which assemble the stream at path, together with the layout of the assembled stream.
+func (s *streamStore) planConcat(ctx context.Context, path, encPath storj.Path, derivedKey *storj.Key, pathCipher storj.Cipher, sources []storj.Path, metadata []byte) (moves []segmentMove, layout Layout, err error) {
+	var cipher storj.Cipher
+	var encBlockSize int32
+	var currentSegment int64
+
+	for i, source := range sources {
+		if source == path {
+			return nil, nil, errs.New("stream %q cannot be concatenated into itself", source)
+		}
+
+		encSourcePath, err := EncryptAfterBucket(source, pathCipher, s.rootKey)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		lastSegmentPath := storj.JoinPaths("l", encSourcePath)
+		lastSegmentMeta, err := s.segments.Meta(ctx, lastSegmentPath)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		streamInfo, streamMeta, err := DecryptStreamInfo(ctx, lastSegmentMeta.Data, source, s.rootKey)
+		if err != nil {
+			return nil, nil, err
+		}
+		var stream pb.StreamInfo
+		if err := proto.Unmarshal(streamInfo, &stream); err != nil {
+			return nil, nil, err
+		}
+
+		if i == 0 {
+			cipher, encBlockSize = storj.Cipher(streamMeta.EncryptionType), streamMeta.EncryptionBlockSize
+		} else if cipher != storj.Cipher(streamMeta.EncryptionType) || encBlockSize != streamMeta.EncryptionBlockSize {
+			return nil, nil, errs.New("stream %q uses a different encryption scheme", source)
+		}
+
+		sourceKey, err := encryption.DeriveContentKey(source, s.rootKey)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		sourceLayout := NewLayout(&stream)
+		if sourceLayout.NumberOfSegments() < 1 {
+			return nil, nil, errs.New("stream %q has an invalid layout", source)
+		}
+
+		for index := int64(0); index < sourceLayout.NumberOfSegments()-1; index++ {
+			segmentPath := getSegmentPath(encSourcePath, index)
+
+			segmentMeta, err := s.segments.Meta(ctx, segmentPath)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			newSegmentMeta, err := reencryptSegmentMeta(segmentMeta.Data, cipher, sourceKey, derivedKey)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			moves = append(moves, segmentMove{
+				from:        segmentPath,
+				to:          getSegmentPath(encPath, currentSegment),
+				metadata:    newSegmentMeta,
+				oldMetadata: segmentMeta.Data,
+			})
+			currentSegment++
+		}
+		layout = append(layout, sourceLayout...)
+
+		lastSegmentKey := streamMeta.LastSegmentMeta
+		if cipher != storj.Unencrypted {
+			lastSegmentKey, err = reencryptKey(streamMeta.LastSegmentMeta, cipher, sourceKey, derivedKey)
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+
+		if i < len(sources)-1 {
+			var newSegmentMeta []byte
+			if cipher != storj.Unencrypted {
+				newSegmentMeta, err = proto.Marshal(lastSegmentKey)
+				if err != nil {
+					return nil, nil, err
+				}
+			}
+
+			moves = append(moves, segmentMove{
+				from:        lastSegmentPath,
+				to:          getSegmentPath(encPath, currentSegment),
+				metadata:    newSegmentMeta,
+				oldMetadata: lastSegmentMeta.Data,
+			})
+			currentSegment++
+			continue
+		}
+
+		// the last segment of the last source becomes the last segment of the stream
+		newStreamMeta, err := s.concatStreamMeta(layout, metadata, cipher, encBlockSize, lastSegmentKey, derivedKey)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		moves = append(moves, segmentMove{
+			from:        lastSegmentPath,
+			to:          storj.JoinPaths("l", encPath),
+			metadata:    newStreamMeta,
+			oldMetadata: lastSegmentMeta.Data,
+		})
+	}
+
+	return moves, layout, nil
+}
+
+// planReplace returns the moves, which move the segments of an existing stream at path aside
+// to a path without a last segment, so it is neither listed nor overwritten by the concatenation.
+func (s *streamStore) planReplace(ctx context.Context, path, encPath storj.Path, pathCipher storj.Cipher) (moves []segmentMove, err error) {
+	lastSegmentPath := storj.JoinPaths("l", encPath)
+	lastSegmentMeta, err := s.segments.Meta(ctx, lastSegmentPath)
+	if err != nil {
+		if storage.ErrKeyNotFound.Has(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	streamInfo, _, err := DecryptStreamInfo(ctx, lastSegmentMeta.Data, path, s.rootKey)
+	if err != nil {
+		return nil, err
+	}
+	var stream pb.StreamInfo
+	if err := proto.Unmarshal(streamInfo, &stream); err != nil {
+		return nil, err
+	}
+
+	var suffix [8]byte
+	if _, err := rand.Read(suffix[:]); err != nil {
+		return nil, err
+	}
+	encAsidePath, err := EncryptAfterBucket(fmt.Sprintf("%s.replaced-%x", path, suffix), pathCipher, s.rootKey)
+	if err != nil {
+		return nil, err
+	}
+
+	numberOfSegments := NewLayout(&stream).NumberOfSegments()
+	for index := int64(0); index < numberOfSegments-1; index++ {
+		segmentPath := getSegmentPath(encPath, index)
+		segmentMeta, err := s.segments.Meta(ctx, segmentPath)
+		if err != nil {
+			return nil, err
+		}
+		moves = append(moves, segmentMove{
+			from:        segmentPath,
+			to:          getSegmentPath(encAsidePath, index),
+			metadata:    segmentMeta.Data,
+			oldMetadata: segmentMeta.Data,
+		})
+	}
+
+	// the metadata is kept, the segments are only deleted or moved back
+	return append(moves, segmentMove{
+		from:        lastSegmentPath,
+		to:          getSegmentPath(encAsidePath, numberOfSegments-1),
+		metadata:    lastSegmentMeta.Data,
+		oldMetadata: lastSegmentMeta.Data,
+	}), nil
+}
+
+// segmentMove moves a segment and replaces its metadata
+type segmentMove struct {
+	from, to    storj.Path
+	metadata    []byte
+	oldMetadata []byte
+}
+
+// segmentMoves records the moves, which have been done, so they can be undone
+type segmentMoves []segmentMove
+
+// move moves the segment and records the move
+func (done *segmentMoves) move(ctx context.Context, store segments.Store, move segmentMove) (segments.Meta, error) {
+	meta, err := store.Move(ctx, move.from, move.to, move.metadata)
+	if err != nil {
+		return segments.Meta{}, err
+	}
+	*done = append(*done, move)
+	return meta, nil
+}
+
+// undo moves the segments back in reverse order
+func (done *segmentMoves) undo(ctx context.Context, store segments.Store) error {
+	var errlist errs.Group
+	for i := len(*done) - 1; i >= 0; i-- {
+		move := (*done)[i]
+		if _, err := store.Move(ctx, move.to, move.from, move.oldMetadata); err != nil {
+			errlist.Add(errs.New("failed to move segment %q back to %q: %v", move.to, move.from, err))
+		}
+	}
+	*done = nil
+	return errlist.Err()
+}
+
+// concatStreamMeta creates the metadata of the last segment of a concatenated stream
+func (s *streamStore) concatStreamMeta(layout Layout, metadata []byte, cipher storj.Cipher, encBlockSize int32, lastSegmentKey *pb.SegmentMeta, derivedKey *storj.Key) ([]byte, error) {
+	last := layout[len(layout)-1]
+	return encryptStreamInfo(&pb.StreamInfo{
+		NumberOfSegments: layout.NumberOfSegments(),
+		SegmentsSize:     layout.FixedSegmentSize(),
+		LastSegmentSize:  last.LastSegmentSize,
+		Metadata:         metadata,
+		Ranges:           layout,
+	}, cipher, encBlockSize, lastSegmentKey, derivedKey)
+}
+
+// encryptStreamInfo creates the metadata of the last segment with the stream info encrypted
+// by the content key of the last segment and a new random nonce, as the content key already
+// encrypted another stream info with the zero nonce
+func encryptStreamInfo(stream *pb.StreamInfo, cipher storj.Cipher, encBlockSize int32, lastSegmentKey *pb.SegmentMeta, derivedKey *storj.Key) ([]byte, error) {
+	streamInfo, err := proto.Marshal(stream)
+	if err != nil {
+		return nil, err
+	}
+
+	streamMeta := pb.StreamMeta{
+		EncryptionType:      int32(cipher),
+		EncryptionBlockSize: encBlockSize,
+	}
+
+	var streamInfoNonce storj.Nonce
+	if cipher != storj.Unencrypted {
+		streamMeta.LastSegmentMeta = lastSegmentKey
+
+		_, err = rand.Read(streamInfoNonce[:])
+		if err != nil {
+			return nil, err
+		}
+		streamMeta.StreamInfoNonce = streamInfoNonce[:]
+	}
+
+	encryptedKey, keyNonce := getEncryptedKeyAndNonce(lastSegmentKey)
+	contentKey, err := encryption.DecryptKey(encryptedKey, cipher, derivedKey, keyNonce)
+	if err != nil {
+		return nil, err
+	}
+
+	streamMeta.EncryptedStreamInfo, err = encryption.Encrypt(streamInfo, cipher, contentKey, &streamInfoNonce)
+	if err != nil {
+		return nil, err
+	}
+
+	return proto.Marshal(&streamMeta)
+}
+
+// SetMetadata replaces the metadata of the stream without uploading its content again
+func (s *streamStore) SetMetadata(ctx context.Context, path storj.Path, pathCipher storj.Cipher, metadata []byte) (m Meta, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	encPath, err := EncryptAfterBucket(path, pathCipher, s.rootKey)
+	if err != nil {
+		return Meta{}, err
+	}
+	lastSegmentPath := storj.JoinPaths("l", encPath)
+
+	lastSegmentMeta, err := s.segments.Meta(ctx, lastSegmentPath)
+	if err != nil {
+		return Meta{}, err
+	}
+
+	streamInfo, streamMeta, err := DecryptStreamInfo(ctx, lastSegmentMeta.Data, path, s.rootKey)
+	if err != nil {
+		return Meta{}, err
+	}
+
+	var stream pb.StreamInfo
+	if err := proto.Unmarshal(streamInfo, &stream); err != nil {
+		return Meta{}, err
+	}
+	stream.Metadata = metadata
+
+	derivedKey, err := encryption.DeriveContentKey(path, s.rootKey)
+	if err != nil {
+		return Meta{}, err
+	}
+
+	newStreamMeta, err := encryptStreamInfo(&stream, storj.Cipher(streamMeta.EncryptionType), streamMeta.EncryptionBlockSize, streamMeta.LastSegmentMeta, derivedKey)
+	if err != nil {
+		return Meta{}, err
+	}
+
+	putMeta, err := s.segments.Move(ctx, lastSegmentPath, lastSegmentPath, newStreamMeta)
+	if err != nil {
+		return Meta{}, err
+	}
+
+	return convertMeta(putMeta, stream, streamMeta), nil
+}
+
+// reencryptSegmentMeta encrypts the content key in the segment metadata with another derived key
+func reencryptSegmentMeta(data []byte, cipher storj.Cipher, oldKey, newKey *storj.Key) ([]byte, error) {
+	if cipher == storj.Unencrypted {
+		return data, nil
+	}
+
+	var segmentMeta pb.SegmentMeta
+	if err := proto.Unmarshal(data, &segmentMeta); err != nil {
+		return nil, err
+	}
+
+	newSegmentMeta, err := reencryptKey(&segmentMeta, cipher, oldKey, newKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return proto.Marshal(newSegmentMeta)
+}
+
+// reencryptKey decrypts the content key with oldKey and encrypts it with newKey and a new random nonce
+func reencryptKey(segmentMeta *pb.SegmentMeta, cipher storj.Cipher, oldKey, newKey *storj.Key) (*pb.SegmentMeta, error) {
+	encryptedKey, keyNonce := getEncryptedKeyAndNonce(segmentMeta)
+	contentKey, err := encryption.DecryptKey(encryptedKey, cipher, oldKey, keyNonce)
+	if err != nil {
+		return nil, err
+	}
+
+	var newKeyNonce storj.Nonce
+	_, err = rand.Read(newKeyNonce[:])
+	if err != nil {
+		return nil, err
+	}
+
+	newEncryptedKey, err := encryption.EncryptKey(contentKey, cipher, newKey, &newKeyNonce)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.SegmentMeta{
+		EncryptedKey: newEncryptedKey,
+		KeyNonce:     newKeyNonce[:],
+	}, nil
+}
+
 // ListItem is a single item in a listing
 type ListItem struct {
 	Path     storj.Path
@@ -646,12 +1083,17 @@ func getEncryptedKeyAndNonce(m *pb.SegmentMeta) (storj.EncryptedPrivateKey, *sto
 // DecryptStreamInfo decrypts stream info
 func DecryptStreamInfo(ctx context.Context, streamMetaBytes []byte, path storj.Path, rootKey *storj.Key) (
 	streamInfo []byte, streamMeta pb.StreamMeta, err error) {
-	err = proto.Unmarshal(streamMetaBytes, &streamMeta)
+	derivedKey, err := encryption.DeriveContentKey(path, rootKey)
 	if err != nil {
 		return nil, pb.StreamMeta{}, err
 	}
 
-	derivedKey, err := encryption.DeriveContentKey(path, rootKey)
+	return decryptStreamInfo(streamMetaBytes, derivedKey)
+}
+
+// decryptStreamInfo decrypts stream info with the content key derived for the path of the stream
+func decryptStreamInfo(streamMetaBytes []byte, derivedKey *storj.Key) (streamInfo []byte, streamMeta pb.StreamMeta, err error) {
+	err = proto.Unmarshal(streamMetaBytes, &streamMeta)
 	if err != nil {
 		return nil, pb.StreamMeta{}, err
 	}
@@ -663,7 +1105,11 @@ func DecryptStreamInfo(ctx context.Context, streamMetaBytes []byte, path storj.P
 		return nil, pb.StreamMeta{}, err
 	}
 
-	// decrypt metadata with the content encryption key and zero nonce
-	streamInfo, err = encryption.Decrypt(streamMeta.EncryptedStreamInfo, cipher, contentKey, &storj.Nonce{})
+	// decrypt metadata with the content encryption key and zero nonce,
+	// unless the stream info of a concatenated stream was encrypted with its own nonce
+	var streamInfoNonce storj.Nonce
+	copy(streamInfoNonce[:], streamMeta.StreamInfoNonce)
+
+	streamInfo, err = encryption.Decrypt(streamMeta.EncryptedStreamInfo, cipher, contentKey, &streamInfoNonce)
 	return streamInfo, streamMeta, err
 }
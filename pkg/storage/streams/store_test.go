@@ -5,6 +5,7 @@ package streams
 
 import (
 	"context"
+	"crypto/md5"
 	"fmt"
 	"io"
 	"strings"
@@ -124,11 +125,13 @@ func TestStreamStorePut(t *testing.T) {
 		Data:       []byte{},
 	}
 
+	checksum := md5.Sum([]byte("data"))
 	streamMeta := Meta{
 		Modified:   segmentMeta.Modified,
 		Expiration: segmentMeta.Expiration,
 		Size:       4,
 		Data:       []byte("metadata"),
+		Checksum:   checksum[:],
 	}
 
 	for i, test := range []struct {
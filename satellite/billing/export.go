@@ -0,0 +1,71 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package billing
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+
+	"storj.io/storj/satellite/console"
+)
+
+// WriteCSV writes invoices as csv records with a header row
+func WriteCSV(output io.Writer, invoices []console.Invoice) error {
+	w := csv.NewWriter(output)
+	headers := []string{
+		"invoiceID",
+		"projectID",
+		"periodStart",
+		"periodEnd",
+		"gb-hours:Storage",
+		"gb:Egress",
+		"object-hours:Objects",
+		"segment-hours:Segments",
+		"cents:Storage",
+		"cents:Egress",
+		"cents:Objects",
+		"cents:Segments",
+		"cents:Total",
+	}
+	if err := w.Write(headers); err != nil {
+		return Error.Wrap(err)
+	}
+
+	for _, invoice := range invoices {
+		record := []string{
+			invoice.ID.String(),
+			invoice.ProjectID.String(),
+			invoice.PeriodStart.Format("2006-01-02"),
+			invoice.PeriodEnd.Format("2006-01-02"),
+			strconv.FormatFloat(invoice.Storage, 'f', 5, 64),
+			strconv.FormatFloat(invoice.Egress, 'f', 5, 64),
+			strconv.FormatFloat(invoice.ObjectCount, 'f', 5, 64),
+			strconv.FormatFloat(invoice.SegmentCount, 'f', 5, 64),
+			strconv.FormatInt(invoice.StorageAmount, 10),
+			strconv.FormatInt(invoice.EgressAmount, 10),
+			strconv.FormatInt(invoice.ObjectAmount, 10),
+			strconv.FormatInt(invoice.SegmentAmount, 10),
+			strconv.FormatInt(invoice.Total, 10),
+		}
+		if err := w.Write(record); err != nil {
+			return Error.Wrap(err)
+		}
+	}
+
+	w.Flush()
+	return Error.Wrap(w.Error())
+}
+
+// WriteJSON writes invoices as an indented json array
+func WriteJSON(output io.Writer, invoices []console.Invoice) error {
+	if invoices == nil {
+		invoices = []console.Invoice{}
+	}
+
+	encoder := json.NewEncoder(output)
+	encoder.SetIndent("", "  ")
+	return Error.Wrap(encoder.Encode(invoices))
+}
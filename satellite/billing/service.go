@@ -0,0 +1,186 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package billing
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+
+	"storj.io/storj/internal/sync2"
+	"storj.io/storj/satellite/console"
+)
+
+var (
+	// Error is the default billing errs class
+	Error = errs.Class("billing error")
+	mon   = monkit.Package()
+)
+
+// Config contains configurable values for billing
+type Config struct {
+	Interval     time.Duration `help:"how frequently invoices are generated for the previous month" default:"24h"`
+	StoragePrice float64       `help:"price in cents per GB-month of data stored" default:"1"`
+	EgressPrice  float64       `help:"price in cents per GB of egress" default:"4.5"`
+	ObjectPrice  float64       `help:"price in cents per object-month stored" default:"0.01"`
+	SegmentPrice float64       `help:"price in cents per segment-month stored" default:"0.01"`
+}
+
+// Prices contains the prices used for computing the charges of an invoice
+type Prices struct {
+	// StoragePrice is in cents per GB-month
+	StoragePrice float64
+	// EgressPrice is in cents per GB
+	EgressPrice float64
+	// ObjectPrice is in cents per object-month
+	ObjectPrice float64
+	// SegmentPrice is in cents per segment-month
+	SegmentPrice float64
+}
+
+// Prices returns the prices from the config
+func (config Config) Prices() Prices {
+	return Prices{
+		StoragePrice: config.StoragePrice,
+		EgressPrice:  config.EgressPrice,
+		ObjectPrice:  config.ObjectPrice,
+		SegmentPrice: config.SegmentPrice,
+	}
+}
+
+// Service generates monthly invoices for projects
+type Service struct {
+	log    *zap.Logger
+	prices Prices
+	db     console.DB
+
+	Loop sync2.Cycle
+}
+
+// NewService creates a new billing service
+func NewService(log *zap.Logger, config Config, db console.DB) *Service {
+	return &Service{
+		log:    log,
+		prices: config.Prices(),
+		db:     db,
+
+		Loop: *sync2.NewCycle(config.Interval),
+	}
+}
+
+// Run periodically generates the invoices for the previous month
+func (service *Service) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	service.log.Info("Billing service starting up")
+
+	return service.Loop.Run(ctx, func(ctx context.Context) error {
+		previousMonth := PeriodStart(time.Now()).AddDate(0, -1, 0)
+		_, err := service.GenerateInvoices(ctx, previousMonth)
+		if err != nil {
+			service.log.Error("generating invoices failed", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// Close halts the billing loop
+func (service *Service) Close() error {
+	service.Loop.Close()
+	return nil
+}
+
+// GenerateInvoices creates the missing invoices of all projects for the month containing period
+// and returns all invoices of that month.
+func (service *Service) GenerateInvoices(ctx context.Context, period time.Time) (_ []console.Invoice, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	periodStart := PeriodStart(period)
+	periodEnd := periodStart.AddDate(0, 1, 0)
+	if periodEnd.After(time.Now()) {
+		return nil, Error.New("billing period %s has not ended yet", periodStart.Format("2006-01"))
+	}
+
+	projects, err := service.db.Projects().GetAll(ctx)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	var errlist errs.Group
+	for _, project := range projects {
+		existing, err := service.db.Invoices().GetByProjectIDAndPeriod(ctx, project.ID, periodStart)
+		if err != nil {
+			errlist.Add(err)
+			continue
+		}
+		if existing != nil {
+			continue
+		}
+
+		usage, err := service.db.UsageRollups().GetProjectBillableUsage(ctx, project.ID, periodStart, periodEnd)
+		if err != nil {
+			errlist.Add(err)
+			continue
+		}
+
+		invoice := ComputeInvoice(service.prices, *usage, periodStart, periodEnd)
+		invoice.ProjectID = project.ID
+
+		_, err = service.db.Invoices().Create(ctx, invoice)
+		if err != nil {
+			errlist.Add(err)
+			continue
+		}
+
+		service.log.Debug("invoice created",
+			zap.String("Project ID", project.ID.String()),
+			zap.String("Period", periodStart.Format("2006-01")),
+			zap.Int64("Total", invoice.Total),
+		)
+	}
+
+	if err := errlist.Err(); err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	invoices, err := service.db.Invoices().GetByPeriod(ctx, periodStart)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return invoices, nil
+}
+
+// PeriodStart returns the beginning of the billing month containing t
+func PeriodStart(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// ComputeInvoice calculates the charges for the usage of a project during the given period
+func ComputeInvoice(prices Prices, usage console.ProjectUsage, periodStart, periodEnd time.Time) console.Invoice {
+	hours := periodEnd.Sub(periodStart).Hours()
+
+	invoice := console.Invoice{
+		PeriodStart:  periodStart,
+		PeriodEnd:    periodEnd,
+		Storage:      usage.Storage,
+		Egress:       usage.Egress,
+		ObjectCount:  usage.ObjectCount,
+		SegmentCount: usage.SegmentCount,
+	}
+
+	if hours > 0 {
+		invoice.StorageAmount = int64(math.Round(usage.Storage / hours * prices.StoragePrice))
+		invoice.ObjectAmount = int64(math.Round(usage.ObjectCount / hours * prices.ObjectPrice))
+		invoice.SegmentAmount = int64(math.Round(usage.SegmentCount / hours * prices.SegmentPrice))
+	}
+	invoice.EgressAmount = int64(math.Round(usage.Egress * prices.EgressPrice))
+	invoice.Total = invoice.StorageAmount + invoice.EgressAmount + invoice.ObjectAmount + invoice.SegmentAmount
+
+	return invoice
+}
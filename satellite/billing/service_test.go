@@ -0,0 +1,124 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package billing_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/billing"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestComputeInvoice(t *testing.T) {
+	prices := billing.Prices{
+		StoragePrice: 1,
+		EgressPrice:  4.5,
+		ObjectPrice:  0.01,
+		SegmentPrice: 0.01,
+	}
+
+	periodStart := time.Date(2019, 4, 1, 0, 0, 0, 0, time.UTC)
+	periodEnd := periodStart.AddDate(0, 1, 0)
+	hours := periodEnd.Sub(periodStart).Hours()
+
+	invoice := billing.ComputeInvoice(prices, console.ProjectUsage{
+		// 10 GB stored for the whole month
+		Storage: 10 * hours,
+		Egress:  100,
+		// 100 objects stored for the whole month
+		ObjectCount: 100 * hours,
+		// 400 segments stored for the whole month
+		SegmentCount: 400 * hours,
+	}, periodStart, periodEnd)
+
+	assert.Equal(t, int64(10), invoice.StorageAmount)
+	assert.Equal(t, int64(450), invoice.EgressAmount)
+	assert.Equal(t, int64(1), invoice.ObjectAmount)
+	assert.Equal(t, int64(4), invoice.SegmentAmount)
+	assert.Equal(t, int64(465), invoice.Total)
+	assert.True(t, invoice.PeriodStart.Equal(periodStart))
+	assert.True(t, invoice.PeriodEnd.Equal(periodEnd))
+}
+
+func TestPeriodStart(t *testing.T) {
+	start := billing.PeriodStart(time.Date(2019, 4, 17, 13, 5, 0, 0, time.UTC))
+	assert.Equal(t, time.Date(2019, 4, 1, 0, 0, 0, 0, time.UTC), start)
+}
+
+func TestGenerateInvoices(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		project, err := db.Console().Projects().Insert(ctx, &console.Project{Name: "billed"})
+		require.NoError(t, err)
+
+		service := billing.NewService(zaptest.NewLogger(t), billing.Config{
+			Interval:     time.Hour,
+			StoragePrice: 1,
+			EgressPrice:  4.5,
+			ObjectPrice:  0.01,
+			SegmentPrice: 0.01,
+		}, db.Console())
+
+		_, err = service.GenerateInvoices(ctx, time.Now())
+		assert.Error(t, err, "current month has not ended yet")
+
+		period := time.Date(2019, 4, 1, 0, 0, 0, 0, time.UTC)
+		bucketID := []byte(project.ID.String() + "/bucket")
+		for _, settle := range []struct {
+			action        pb.PieceAction
+			intervalStart time.Time
+		}{
+			{pb.PieceAction_GET, period},
+			// audit and repair traffic is not charged to the customer
+			{pb.PieceAction_GET_AUDIT, period},
+			{pb.PieceAction_GET_REPAIR, period},
+			// the first hour of the next month belongs to the next invoice
+			{pb.PieceAction_GET, period.AddDate(0, 1, 0)},
+		} {
+			err := db.Orders().UpdateBucketBandwidthSettle(ctx, bucketID, settle.action, 100*memory.GB.Int64(), settle.intervalStart)
+			require.NoError(t, err)
+		}
+
+		invoices, err := service.GenerateInvoices(ctx, period)
+		require.NoError(t, err)
+		require.Len(t, invoices, 1)
+		assert.Equal(t, project.ID, invoices[0].ProjectID)
+		assert.Equal(t, 100.0, invoices[0].Egress)
+		assert.Equal(t, int64(450), invoices[0].EgressAmount)
+		assert.Equal(t, int64(450), invoices[0].Total)
+
+		// generating again must not create duplicates
+		invoices, err = service.GenerateInvoices(ctx, period)
+		require.NoError(t, err)
+		require.Len(t, invoices, 1)
+
+		var csvOutput bytes.Buffer
+		require.NoError(t, billing.WriteCSV(&csvOutput, invoices))
+		lines := strings.Split(strings.TrimSpace(csvOutput.String()), "\n")
+		require.Len(t, lines, 2)
+		assert.True(t, strings.HasPrefix(lines[1], invoices[0].ID.String()))
+
+		var jsonOutput bytes.Buffer
+		require.NoError(t, billing.WriteJSON(&jsonOutput, invoices))
+		var decoded []console.Invoice
+		require.NoError(t, json.Unmarshal(jsonOutput.Bytes(), &decoded))
+		require.Len(t, decoded, 1)
+		assert.Equal(t, invoices[0].ID, decoded[0].ID)
+	})
+}
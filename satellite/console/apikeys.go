@@ -5,13 +5,13 @@ package console
 
 import (
 	"context"
-	"crypto/rand"
+	"crypto/md5"
 	"encoding/base32"
-	"io"
 	"time"
 
 	"github.com/skyrings/skyring-common/tools/uuid"
-	"github.com/zeebo/errs"
+
+	"storj.io/storj/pkg/macaroon"
 )
 
 // APIKeys is interface for working with api keys store
@@ -20,14 +20,18 @@ type APIKeys interface {
 	GetByProjectID(ctx context.Context, projectID uuid.UUID) ([]APIKeyInfo, error)
 	// Get retrieves APIKeyInfo with given ID
 	Get(ctx context.Context, id uuid.UUID) (*APIKeyInfo, error)
-	//GetByKey retrieves APIKeyInfo for given key
-	GetByKey(ctx context.Context, key APIKey) (*APIKeyInfo, error)
+	// GetByHead retrieves APIKeyInfo for given macaroon head
+	GetByHead(ctx context.Context, head []byte) (*APIKeyInfo, error)
 	// Create creates and stores new APIKeyInfo
-	Create(ctx context.Context, key APIKey, info APIKeyInfo) (*APIKeyInfo, error)
+	Create(ctx context.Context, head []byte, info APIKeyInfo) (*APIKeyInfo, error)
 	// Update updates APIKeyInfo in store
 	Update(ctx context.Context, key APIKeyInfo) error
 	// Delete deletes APIKeyInfo from store
 	Delete(ctx context.Context, id uuid.UUID) error
+	// Revoke adds tail of a key derived from api key with given ID to the revocation list
+	Revoke(ctx context.Context, id uuid.UUID, tail []byte) error
+	// GetRevocations retrieves revocation list of api key with given ID
+	GetRevocations(ctx context.Context, id uuid.UUID) ([][]byte, error)
 }
 
 // APIKeyInfo describing api key model in the database
@@ -37,12 +41,103 @@ type APIKeyInfo struct {
 	// Fk on project
 	ProjectID uuid.UUID `json:"projectId"`
 
-	Name string `json:"name"`
+	Name   string `json:"name"`
+	Secret []byte `json:"-"`
+
+	Restrictions APIKeyRestrictions `json:"restrictions"`
 
 	CreatedAt time.Time `json:"createdAt"`
 }
 
-// APIKey is an api key type
+// APIKeyRestrictions describes the permissions, paths and validity window an api key is restricted to
+type APIKeyRestrictions struct {
+	DisallowReads   bool `json:"disallowReads"`
+	DisallowWrites  bool `json:"disallowWrites"`
+	DisallowLists   bool `json:"disallowLists"`
+	DisallowDeletes bool `json:"disallowDeletes"`
+
+	AllowedPaths []APIKeyPath `json:"allowedPaths"`
+
+	NotBefore *time.Time `json:"notBefore"`
+	NotAfter  *time.Time `json:"notAfter"`
+}
+
+// APIKeyPath is a bucket and encrypted path prefix an api key is allowed to access
+type APIKeyPath struct {
+	Bucket              string `json:"bucket"`
+	EncryptedPathPrefix string `json:"encryptedPathPrefix"`
+}
+
+// IsZero returns true when the restrictions don't restrict anything
+func (restrictions APIKeyRestrictions) IsZero() bool {
+	return !restrictions.DisallowReads && !restrictions.DisallowWrites &&
+		!restrictions.DisallowLists && !restrictions.DisallowDeletes &&
+		len(restrictions.AllowedPaths) == 0 &&
+		restrictions.NotBefore == nil && restrictions.NotAfter == nil
+}
+
+// Caveat converts restrictions to a macaroon caveat
+func (restrictions APIKeyRestrictions) Caveat() macaroon.Caveat {
+	caveat := macaroon.Caveat{
+		DisallowReads:   restrictions.DisallowReads,
+		DisallowWrites:  restrictions.DisallowWrites,
+		DisallowLists:   restrictions.DisallowLists,
+		DisallowDeletes: restrictions.DisallowDeletes,
+		NotBefore:       restrictions.NotBefore,
+		NotAfter:        restrictions.NotAfter,
+	}
+	for _, path := range restrictions.AllowedPaths {
+		caveat.AllowedPaths = append(caveat.AllowedPaths, &macaroon.Caveat_Path{
+			Bucket:              []byte(path.Bucket),
+			EncryptedPathPrefix: []byte(path.EncryptedPathPrefix),
+		})
+	}
+	return caveat
+}
+
+// APIKeyRestrictionsFromCaveat converts a macaroon caveat to restrictions
+func APIKeyRestrictionsFromCaveat(caveat *macaroon.Caveat) APIKeyRestrictions {
+	restrictions := APIKeyRestrictions{
+		DisallowReads:   caveat.DisallowReads,
+		DisallowWrites:  caveat.DisallowWrites,
+		DisallowLists:   caveat.DisallowLists,
+		DisallowDeletes: caveat.DisallowDeletes,
+		NotBefore:       caveat.NotBefore,
+		NotAfter:        caveat.NotAfter,
+	}
+	for _, path := range caveat.AllowedPaths {
+		restrictions.AllowedPaths = append(restrictions.AllowedPaths, APIKeyPath{
+			Bucket:              string(path.Bucket),
+			EncryptedPathPrefix: string(path.EncryptedPathPrefix),
+		})
+	}
+	return restrictions
+}
+
+// validate checks that the restrictions are consistent
+func (restrictions APIKeyRestrictions) validate() error {
+	var errs validationErrors
+
+	if restrictions.DisallowReads && restrictions.DisallowWrites &&
+		restrictions.DisallowLists && restrictions.DisallowDeletes {
+		errs.Add("api key must allow at least one permission")
+	}
+
+	for _, path := range restrictions.AllowedPaths {
+		if path.Bucket == "" {
+			errs.Add("allowed path must specify a bucket")
+		}
+	}
+
+	if restrictions.NotBefore != nil && restrictions.NotAfter != nil &&
+		!restrictions.NotBefore.Before(*restrictions.NotAfter) {
+		errs.Add("api key not before time must be before its not after time")
+	}
+
+	return errs.Combine()
+}
+
+// APIKey is a legacy api key type, issued before api keys became macaroons
 type APIKey [24]byte
 
 // String implements Stringer
@@ -68,14 +163,8 @@ func APIKeyFromBase32(s string) (*APIKey, error) {
 	return key, nil
 }
 
-// CreateAPIKey creates new api key
-func CreateAPIKey() (*APIKey, error) {
-	key := new(APIKey)
-
-	n, err := io.ReadFull(rand.Reader, key[:])
-	if err != nil || n != 24 {
-		return nil, errs.New(internalErrMsg)
-	}
-
-	return key, nil
+// Head returns macaroon head the legacy key was migrated to
+func (key APIKey) Head() []byte {
+	head := md5.Sum(key[:])
+	return head[:]
 }
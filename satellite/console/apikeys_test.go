@@ -6,10 +6,12 @@ package console_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
 	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/pkg/macaroon"
 	"storj.io/storj/satellite"
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/satellitedb/satellitedbtest"
@@ -30,22 +32,55 @@ func TestApiKeysRepository(t *testing.T) {
 		assert.NotNil(t, project)
 		assert.NoError(t, err)
 
+		var heads [][]byte
 		t.Run("Creation success", func(t *testing.T) {
 			for i := 0; i < 10; i++ {
-				key, err := console.CreateAPIKey()
+				secret, err := macaroon.NewSecret()
+				assert.NoError(t, err)
+
+				key, err := macaroon.NewAPIKey(secret)
 				assert.NoError(t, err)
 
 				keyInfo := console.APIKeyInfo{
 					Name:      fmt.Sprintf("key %d", i),
 					ProjectID: project.ID,
+					Secret:    secret,
 				}
 
-				createdKey, err := apikeys.Create(ctx, *key, keyInfo)
+				createdKey, err := apikeys.Create(ctx, key.Head(), keyInfo)
 				assert.NotNil(t, createdKey)
 				assert.NoError(t, err)
+
+				heads = append(heads, key.Head())
 			}
 		})
 
+		t.Run("GetByHead success", func(t *testing.T) {
+			key, err := apikeys.GetByHead(ctx, heads[0])
+			assert.NoError(t, err)
+			assert.NotNil(t, key)
+			assert.Equal(t, "key 0", key.Name)
+			assert.Len(t, key.Secret, 32)
+		})
+
+		t.Run("Revoke success", func(t *testing.T) {
+			key, err := apikeys.GetByHead(ctx, heads[0])
+			assert.NoError(t, err)
+
+			revoked, err := apikeys.GetRevocations(ctx, key.ID)
+			assert.NoError(t, err)
+			assert.Len(t, revoked, 0)
+
+			err = apikeys.Revoke(ctx, key.ID, []byte("first tail"))
+			assert.NoError(t, err)
+			err = apikeys.Revoke(ctx, key.ID, []byte("second tail"))
+			assert.NoError(t, err)
+
+			revoked, err = apikeys.GetRevocations(ctx, key.ID)
+			assert.NoError(t, err)
+			assert.ElementsMatch(t, [][]byte{[]byte("first tail"), []byte("second tail")}, revoked)
+		})
+
 		t.Run("GetByProjectID success", func(t *testing.T) {
 			keys, err := apikeys.GetByProjectID(ctx, project.ID)
 			assert.NotNil(t, keys)
@@ -108,5 +143,38 @@ func TestApiKeysRepository(t *testing.T) {
 			assert.Equal(t, len(keys), 9)
 			assert.NoError(t, err)
 		})
+
+		t.Run("Restrictions are stored", func(t *testing.T) {
+			secret, err := macaroon.NewSecret()
+			assert.NoError(t, err)
+
+			key, err := macaroon.NewAPIKey(secret)
+			assert.NoError(t, err)
+
+			notBefore := time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)
+			notAfter := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+			restrictions := console.APIKeyRestrictions{
+				DisallowWrites:  true,
+				DisallowDeletes: true,
+				AllowedPaths: []console.APIKeyPath{
+					{Bucket: "bucket1"},
+					{Bucket: "bucket2", EncryptedPathPrefix: "prefix"},
+				},
+				NotBefore: &notBefore,
+				NotAfter:  &notAfter,
+			}
+
+			createdKey, err := apikeys.Create(ctx, key.Head(), console.APIKeyInfo{
+				Name:         "restricted key",
+				ProjectID:    project.ID,
+				Secret:       secret,
+				Restrictions: restrictions,
+			})
+			assert.NoError(t, err)
+
+			storedKey, err := apikeys.Get(ctx, createdKey.ID)
+			assert.NoError(t, err)
+			assert.Equal(t, restrictions, storedKey.Restrictions)
+		})
 	})
 }
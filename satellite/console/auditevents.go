@@ -0,0 +1,112 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console
+
+import (
+	"context"
+	"time"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"go.uber.org/zap"
+
+	"storj.io/storj/internal/sync2"
+)
+
+// AuditEvents exposes methods to manage the append-only audit log of console actions.
+type AuditEvents interface {
+	// Insert is a method for appending audit event to the log.
+	Insert(ctx context.Context, event AuditEvent) (*AuditEvent, error)
+	// GetByProjectID is a method for querying audit events of a project by offset and limit, newest first.
+	GetByProjectID(ctx context.Context, projectID uuid.UUID, limit int, offset int64) ([]AuditEvent, error)
+	// DeleteBefore is a method for deleting all audit events created before the given time.
+	DeleteBefore(ctx context.Context, before time.Time) (int64, error)
+}
+
+// AuditAction describes the kind of action recorded in the audit log
+type AuditAction string
+
+// Actions recorded in the audit log
+const (
+	AuditProjectCreate          AuditAction = "project_create"
+	AuditProjectUpdate          AuditAction = "project_update"
+	AuditProjectDelete          AuditAction = "project_delete"
+	AuditProjectMemberAdd       AuditAction = "project_member_add"
+	AuditProjectMemberDelete    AuditAction = "project_member_delete"
+	AuditProjectMemberRole      AuditAction = "project_member_role"
+	AuditProjectOwnerTransfer   AuditAction = "project_owner_transfer"
+	AuditAPIKeyCreate           AuditAction = "api_key_create"
+	AuditAPIKeyDelete           AuditAction = "api_key_delete"
+	AuditAPIKeyRevoke           AuditAction = "api_key_revoke"
+	AuditAccountUpdate          AuditAction = "account_update"
+	AuditAccountPasswordChange  AuditAction = "account_password_change"
+	AuditAccountDelete          AuditAction = "account_delete"
+	AuditAccountMFAEnable       AuditAction = "account_mfa_enable"
+	AuditAccountMFADisable      AuditAction = "account_mfa_disable"
+	AuditAccountMFARecoveryCode AuditAction = "account_mfa_recovery_code"
+)
+
+// AuditEvent describes an action performed by a console user
+type AuditEvent struct {
+	ID uuid.UUID `json:"id"`
+	// ProjectID is nil for actions on the account of the user
+	ProjectID *uuid.UUID `json:"projectId"`
+	ActorID   uuid.UUID  `json:"actorId"`
+
+	Action AuditAction `json:"action"`
+	// Target describes the subject of the action, e.g. the name of an api key or the email of a member
+	Target   string `json:"target"`
+	SourceIP string `json:"sourceIp"`
+
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// AuditLogConfig contains configurable values for the audit log
+type AuditLogConfig struct {
+	Retention time.Duration `help:"how long audit events of console actions are kept" default:"2160h"`
+	Interval  time.Duration `help:"how frequently expired audit events are deleted" default:"24h"`
+}
+
+// AuditLogChore periodically deletes audit events older than the retention period
+type AuditLogChore struct {
+	log       *zap.Logger
+	events    AuditEvents
+	retention time.Duration
+
+	Loop sync2.Cycle
+}
+
+// NewAuditLogChore creates a new audit log retention chore
+func NewAuditLogChore(log *zap.Logger, config AuditLogConfig, events AuditEvents) *AuditLogChore {
+	return &AuditLogChore{
+		log:       log,
+		events:    events,
+		retention: config.Retention,
+
+		Loop: *sync2.NewCycle(config.Interval),
+	}
+}
+
+// Run periodically deletes the expired audit events
+func (chore *AuditLogChore) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return chore.Loop.Run(ctx, func(ctx context.Context) error {
+		deleted, err := chore.events.DeleteBefore(ctx, time.Now().Add(-chore.retention))
+		if err != nil {
+			chore.log.Error("deleting expired audit events failed", zap.Error(err))
+			return nil
+		}
+
+		if deleted > 0 {
+			chore.log.Debug("deleted expired audit events", zap.Int64("count", deleted))
+		}
+		return nil
+	})
+}
+
+// Close halts the audit log retention chore
+func (chore *AuditLogChore) Close() error {
+	chore.Loop.Close()
+	return nil
+}
@@ -0,0 +1,91 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestAuditEventsRepository(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		events := db.Console().AuditEvents()
+
+		projectID, err := uuid.New()
+		require.NoError(t, err)
+		actorID, err := uuid.New()
+		require.NoError(t, err)
+
+		targets := []string{"key 1", "key 2", "key 3"}
+
+		t.Run("Insert", func(t *testing.T) {
+			for _, target := range targets {
+				event, err := events.Insert(ctx, console.AuditEvent{
+					ProjectID: projectID,
+					ActorID:   *actorID,
+					Action:    console.AuditAPIKeyCreate,
+					Target:    target,
+					SourceIP:  "127.0.0.1",
+				})
+				require.NoError(t, err)
+				require.NotNil(t, event)
+				assert.Equal(t, projectID, event.ProjectID)
+				assert.Equal(t, target, event.Target)
+			}
+
+			// account actions don't belong to any project
+			event, err := events.Insert(ctx, console.AuditEvent{
+				ActorID: *actorID,
+				Action:  console.AuditAccountPasswordChange,
+				Target:  "user@mail.test",
+			})
+			require.NoError(t, err)
+			assert.Nil(t, event.ProjectID)
+		})
+
+		t.Run("Get paged", func(t *testing.T) {
+			page, err := events.GetByProjectID(ctx, *projectID, 2, 0)
+			require.NoError(t, err)
+			require.Len(t, page, 2)
+			assert.Equal(t, "key 3", page[0].Target)
+			assert.Equal(t, "key 2", page[1].Target)
+			assert.Equal(t, console.AuditAPIKeyCreate, page[0].Action)
+			assert.Equal(t, "127.0.0.1", page[0].SourceIP)
+
+			page, err = events.GetByProjectID(ctx, *projectID, 2, 2)
+			require.NoError(t, err)
+			require.Len(t, page, 1)
+			assert.Equal(t, "key 1", page[0].Target)
+
+			_, err = events.GetByProjectID(ctx, *projectID, -1, 0)
+			assert.Error(t, err)
+		})
+
+		t.Run("Delete before", func(t *testing.T) {
+			deleted, err := events.DeleteBefore(ctx, time.Now().Add(-time.Hour))
+			require.NoError(t, err)
+			assert.Equal(t, int64(0), deleted)
+
+			deleted, err = events.DeleteBefore(ctx, time.Now().Add(time.Hour))
+			require.NoError(t, err)
+			assert.Equal(t, int64(len(targets)+1), deleted)
+
+			page, err := events.GetByProjectID(ctx, *projectID, 10, 0)
+			require.NoError(t, err)
+			assert.Len(t, page, 0)
+		})
+	})
+}
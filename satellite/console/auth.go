@@ -38,6 +38,9 @@ type key int
 // authKey is context key for Authorization
 const authKey key = 0
 
+// sourceIPKey is context key for the address of the client, which made the request
+const sourceIPKey key = 1
+
 // ErrUnauthorized is error class for authorization related errors
 var ErrUnauthorized = errs.Class("unauthorized error")
 
@@ -71,3 +74,14 @@ func GetAuth(ctx context.Context) (Authorization, error) {
 
 	return Authorization{}, errs.New(unauthorizedErrMsg)
 }
+
+// WithSourceIP creates new context with the address of the client, which made the request
+func WithSourceIP(ctx context.Context, ip string) context.Context {
+	return context.WithValue(ctx, sourceIPKey, ip)
+}
+
+// GetSourceIP gets the address of the client, which made the request, from context
+func GetSourceIP(ctx context.Context) string {
+	ip, _ := ctx.Value(sourceIPKey).(string)
+	return ip
+}
@@ -0,0 +1,137 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleauth
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1" // #nosec G505 TOTP is defined over HMAC-SHA1 by RFC 6238
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+)
+
+const (
+	// TOTPPeriod is the time step of time-based one-time passcodes
+	TOTPPeriod = 30 * time.Second
+	// TOTPDigits is the number of digits of time-based one-time passcodes
+	TOTPDigits = 6
+	// TOTPSkew is the number of time steps a passcode is accepted before or after its time step
+	TOTPSkew = 1
+
+	// totpSecretSize is the number of random bytes in a secret key, as recommended by RFC 4226
+	totpSecretSize = 20
+)
+
+// ErrTOTP is error class for time-based one-time passcode errors
+var ErrTOTP = errs.Class("totp error")
+
+// totpEncoding is the base32 encoding used by authenticator apps
+var totpEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// NewTOTPSecret creates a new random base32 encoded secret key for time-based one-time passcodes
+func NewTOTPSecret() (string, error) {
+	secret := make([]byte, totpSecretSize)
+
+	_, err := rand.Read(secret)
+	if err != nil {
+		return "", ErrTOTP.Wrap(err)
+	}
+
+	return totpEncoding.EncodeToString(secret), nil
+}
+
+// TOTPCode returns the time-based one-time passcode for secret at time t, as defined by RFC 6238
+func TOTPCode(secret string, t time.Time) (string, error) {
+	key, err := decodeTOTPSecret(secret)
+	if err != nil {
+		return "", err
+	}
+
+	return totpCode(key, totpCounter(t)), nil
+}
+
+// ValidateTOTP checks whether code is a valid time-based one-time passcode for secret at time t
+func ValidateTOTP(secret, code string, t time.Time) (bool, error) {
+	key, err := decodeTOTPSecret(secret)
+	if err != nil {
+		return false, err
+	}
+
+	code = strings.TrimSpace(code)
+	if len(code) != TOTPDigits {
+		return false, nil
+	}
+
+	counter := totpCounter(t)
+	for skew := -TOTPSkew; skew <= TOTPSkew; skew++ {
+		expected := totpCode(key, uint64(int64(counter)+int64(skew)))
+		if subtle.ConstantTimeCompare([]byte(expected), []byte(code)) == 1 {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// TOTPProvisioningURI returns the otpauth uri, which authenticator apps use for enrolling secret
+func TOTPProvisioningURI(secret, issuer, account string) string {
+	label := url.PathEscape(issuer + ":" + account)
+
+	query := url.Values{}
+	query.Set("secret", secret)
+	query.Set("issuer", issuer)
+	query.Set("algorithm", "SHA1")
+	query.Set("digits", fmt.Sprint(TOTPDigits))
+	query.Set("period", fmt.Sprint(int(TOTPPeriod/time.Second)))
+
+	return "otpauth://totp/" + label + "?" + query.Encode()
+}
+
+// decodeTOTPSecret decodes base32 encoded secret key
+func decodeTOTPSecret(secret string) ([]byte, error) {
+	secret = strings.ToUpper(strings.Replace(secret, " ", "", -1))
+	secret = strings.TrimRight(secret, "=")
+
+	key, err := totpEncoding.DecodeString(secret)
+	if err != nil {
+		return nil, ErrTOTP.Wrap(err)
+	}
+	if len(key) == 0 {
+		return nil, ErrTOTP.New("empty secret key")
+	}
+
+	return key, nil
+}
+
+// totpCounter returns the number of time steps since unix epoch
+func totpCounter(t time.Time) uint64 {
+	return uint64(t.Unix() / int64(TOTPPeriod/time.Second))
+}
+
+// totpCode calculates HOTP value for counter, as defined by RFC 4226
+func totpCode(key []byte, counter uint64) string {
+	var message [8]byte
+	binary.BigEndian.PutUint64(message[:], counter)
+
+	mac := hmac.New(sha1.New, key)
+	_, _ = mac.Write(message[:])
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0xf
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	modulo := uint32(1)
+	for i := 0; i < TOTPDigits; i++ {
+		modulo *= 10
+	}
+
+	return fmt.Sprintf("%0*d", TOTPDigits, value%modulo)
+}
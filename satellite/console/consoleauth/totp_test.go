@@ -0,0 +1,82 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleauth
+
+import (
+	"encoding/base32"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTOTPCode(t *testing.T) {
+	// test vectors from RFC 6238, truncated to 6 digits
+	secret := base32.StdEncoding.EncodeToString([]byte("12345678901234567890"))
+
+	for _, test := range []struct {
+		unix int64
+		code string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+	} {
+		code, err := TOTPCode(secret, time.Unix(test.unix, 0))
+		require.NoError(t, err)
+		assert.Equal(t, test.code, code, test.unix)
+	}
+}
+
+func TestValidateTOTP(t *testing.T) {
+	secret, err := NewTOTPSecret()
+	require.NoError(t, err)
+
+	now := time.Unix(1560000000, 0)
+	code, err := TOTPCode(secret, now)
+	require.NoError(t, err)
+
+	for _, test := range []struct {
+		at    time.Time
+		valid bool
+	}{
+		{now, true},
+		{now.Add(-TOTPPeriod), true},
+		{now.Add(TOTPPeriod), true},
+		{now.Add(-2 * TOTPPeriod), false},
+		{now.Add(2 * TOTPPeriod), false},
+	} {
+		valid, err := ValidateTOTP(secret, code, test.at)
+		require.NoError(t, err)
+		assert.Equal(t, test.valid, valid, test.at.Sub(now))
+	}
+
+	valid, err := ValidateTOTP(secret, "12345", now)
+	require.NoError(t, err)
+	assert.False(t, valid)
+
+	_, err = ValidateTOTP("not base32!", code, now)
+	assert.True(t, ErrTOTP.Has(err))
+}
+
+func TestTOTPProvisioningURI(t *testing.T) {
+	uri := TOTPProvisioningURI("JBSWY3DPEHPK3PXP", "Storj Satellite", "user@mail.test")
+
+	parsed, err := url.Parse(uri)
+	require.NoError(t, err)
+	assert.Equal(t, "otpauth", parsed.Scheme)
+	assert.Equal(t, "totp", parsed.Host)
+	assert.True(t, strings.HasPrefix(parsed.Path, "/Storj Satellite:user@mail.test"))
+
+	query := parsed.Query()
+	assert.Equal(t, "JBSWY3DPEHPK3PXP", query.Get("secret"))
+	assert.Equal(t, "Storj Satellite", query.Get("issuer"))
+	assert.Equal(t, "6", query.Get("digits"))
+	assert.Equal(t, "30", query.Get("period"))
+}
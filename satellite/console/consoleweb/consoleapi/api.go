@@ -0,0 +1,331 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package consoleapi implements the versioned JSON REST API of the satellite console.
+package consoleapi
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+
+	"storj.io/storj/satellite/console"
+)
+
+const (
+	// Prefix is the path prefix of all endpoints of this version of the api
+	Prefix = "/api/v0"
+
+	contentType     = "Content-Type"
+	applicationJSON = "application/json"
+
+	// maxRequestBodySize is the largest request body accepted by the api
+	maxRequestBodySize = 1 << 20
+)
+
+var (
+	// Error is console api error type
+	Error = errs.Class("console api error")
+	// ErrBadRequest is error class for malformed requests
+	ErrBadRequest = errs.Class("bad request")
+	// ErrUnauthenticated is error class for invalid credentials
+	ErrUnauthenticated = errs.Class("unauthenticated")
+
+	mon = monkit.Package()
+)
+
+// Param describes a path or query parameter of an endpoint
+type Param struct {
+	Name        string
+	In          string
+	Description string
+	Type        string
+	Format      string
+	Required    bool
+}
+
+// PathParam creates a required path parameter of string type
+func PathParam(name, format, description string) Param {
+	return Param{Name: name, In: "path", Description: description, Type: "string", Format: format, Required: true}
+}
+
+// QueryParam creates an optional query parameter
+func QueryParam(name, typ, format, description string) Param {
+	return Param{Name: name, In: "query", Description: description, Type: typ, Format: format}
+}
+
+// HandlerFunc handles an api request, the returned value is encoded as the JSON response body
+type HandlerFunc func(ctx context.Context, req *Request) (interface{}, error)
+
+// Endpoint describes a single operation of the api
+type Endpoint struct {
+	Method string
+	// Path is relative to Prefix, parameters are written as {name}
+	Path    string
+	Summary string
+	Tag     string
+	// Public endpoints don't require authorization
+	Public bool
+	Params []Param
+	// Request is a value of the request body type, nil when the endpoint doesn't have a body
+	Request interface{}
+	// Response is a value of the response body type, nil when the endpoint doesn't return content
+	Response interface{}
+	Handler  HandlerFunc
+}
+
+// match checks whether path matches the path of the endpoint and returns the values of the path parameters
+func (endpoint *Endpoint) match(path string) (map[string]string, bool) {
+	pattern := strings.Split(strings.Trim(endpoint.Path, "/"), "/")
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	if len(pattern) != len(segments) {
+		return nil, false
+	}
+
+	params := make(map[string]string)
+	for i, part := range pattern {
+		if strings.HasPrefix(part, "{") && strings.HasSuffix(part, "}") {
+			value, err := url.PathUnescape(segments[i])
+			if err != nil || value == "" {
+				return nil, false
+			}
+			params[part[1:len(part)-1]] = value
+			continue
+		}
+
+		if part != segments[i] {
+			return nil, false
+		}
+	}
+
+	return params, true
+}
+
+// Request contains the parsed parts of an api request
+type Request struct {
+	params map[string]string
+	query  url.Values
+	body   io.Reader
+}
+
+// Param returns the value of path parameter with given name
+func (req *Request) Param(name string) string {
+	return req.params[name]
+}
+
+// UUIDParam parses path parameter with given name as uuid
+func (req *Request) UUIDParam(name string) (uuid.UUID, error) {
+	id, err := uuid.Parse(req.params[name])
+	if err != nil {
+		return uuid.UUID{}, ErrBadRequest.New("invalid %s", name)
+	}
+	return *id, nil
+}
+
+// Query returns the value of query parameter with given name
+func (req *Request) Query(name string) string {
+	return req.query.Get(name)
+}
+
+// QueryValues returns all values of query parameter with given name
+func (req *Request) QueryValues(name string) []string {
+	return req.query[name]
+}
+
+// QueryInt parses query parameter with given name as integer, def is returned when it's missing
+func (req *Request) QueryInt(name string, def int) (int, error) {
+	value := req.query.Get(name)
+	if value == "" {
+		return def, nil
+	}
+
+	parsed, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, ErrBadRequest.New("invalid %s", name)
+	}
+	return parsed, nil
+}
+
+// QueryTime parses required query parameter with given name as RFC 3339 time
+func (req *Request) QueryTime(name string) (time.Time, error) {
+	parsed, err := time.Parse(time.RFC3339, req.query.Get(name))
+	if err != nil {
+		return time.Time{}, ErrBadRequest.New("invalid %s", name)
+	}
+	return parsed, nil
+}
+
+// Decode decodes the JSON request body into v
+func (req *Request) Decode(v interface{}) error {
+	if err := json.NewDecoder(req.body).Decode(v); err != nil {
+		return ErrBadRequest.New("invalid request body: %v", err)
+	}
+	return nil
+}
+
+// ErrorResponse is the body of all failed requests
+type ErrorResponse struct {
+	Error string `json:"error"`
+}
+
+// API serves the REST endpoints of the console, the console token
+// and the address of the client are taken from the request context
+type API struct {
+	log       *zap.Logger
+	service   *console.Service
+	endpoints []Endpoint
+}
+
+// NewAPI creates the console REST api backed by service
+func NewAPI(log *zap.Logger, service *console.Service) *API {
+	api := &API{
+		log:     log,
+		service: service,
+	}
+
+	api.endpoints = append(api.endpoints, api.tokenEndpoints()...)
+	api.endpoints = append(api.endpoints, api.accountEndpoints()...)
+	api.endpoints = append(api.endpoints, api.projectEndpoints()...)
+	api.endpoints = append(api.endpoints, api.memberEndpoints()...)
+	api.endpoints = append(api.endpoints, api.apiKeyEndpoints()...)
+	api.endpoints = append(api.endpoints, api.usageEndpoints()...)
+	api.endpoints = append(api.endpoints, Endpoint{
+		Method:   http.MethodGet,
+		Path:     "/openapi.json",
+		Summary:  "OpenAPI description of this api",
+		Tag:      "meta",
+		Public:   true,
+		Response: map[string]interface{}{},
+		Handler: func(ctx context.Context, req *Request) (interface{}, error) {
+			return api.OpenAPI(), nil
+		},
+	})
+
+	return api
+}
+
+// Endpoints returns the descriptions of all endpoints of the api
+func (api *API) Endpoints() []Endpoint {
+	return api.endpoints
+}
+
+// ServeHTTP dispatches the request to the matching endpoint
+func (api *API) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var err error
+	ctx := r.Context()
+	defer mon.Task()(&ctx)(&err)
+
+	if !strings.HasPrefix(r.URL.Path, Prefix+"/") {
+		api.writeError(w, http.StatusNotFound, errs.New("not found"))
+		return
+	}
+	path := strings.TrimPrefix(r.URL.Path, Prefix)
+
+	var endpoint *Endpoint
+	var params map[string]string
+	methodAllowed := true
+	for i := range api.endpoints {
+		matched, ok := api.endpoints[i].match(path)
+		if !ok {
+			continue
+		}
+		if api.endpoints[i].Method != r.Method {
+			methodAllowed = false
+			continue
+		}
+		endpoint, params = &api.endpoints[i], matched
+		break
+	}
+
+	if endpoint == nil {
+		if !methodAllowed {
+			api.writeError(w, http.StatusMethodNotAllowed, errs.New("method not allowed"))
+			return
+		}
+		api.writeError(w, http.StatusNotFound, errs.New("not found"))
+		return
+	}
+
+	if !endpoint.Public {
+		authorization, err := api.service.Authorize(ctx)
+		if err != nil {
+			api.writeError(w, http.StatusUnauthorized, err)
+			return
+		}
+		ctx = console.WithAuth(ctx, authorization)
+	}
+
+	response, err := endpoint.Handler(ctx, &Request{
+		params: params,
+		query:  r.URL.Query(),
+		body:   http.MaxBytesReader(w, r.Body, maxRequestBodySize),
+	})
+	if err != nil {
+		api.writeError(w, statusCode(err), err)
+		return
+	}
+
+	if endpoint.Response == nil {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	w.Header().Set(contentType, applicationJSON)
+	if err = json.NewEncoder(w).Encode(response); err != nil {
+		api.log.Error("encoding response failed", zap.Error(err))
+	}
+}
+
+// writeError writes err as the JSON body of a response with the given status
+func (api *API) writeError(w http.ResponseWriter, status int, err error) {
+	if status == http.StatusInternalServerError {
+		api.log.Error("request failed", zap.Error(err))
+	}
+
+	w.Header().Set(contentType, applicationJSON)
+	w.WriteHeader(status)
+
+	err = json.NewEncoder(w).Encode(ErrorResponse{Error: errorMessage(err)})
+	if err != nil {
+		api.log.Error("encoding error response failed", zap.Error(err))
+	}
+}
+
+// statusCode maps errors returned by the handlers to http status codes
+func statusCode(err error) int {
+	switch {
+	case ErrBadRequest.Has(err), console.ErrValidation.Has(err):
+		return http.StatusBadRequest
+	case ErrUnauthenticated.Has(err):
+		return http.StatusUnauthorized
+	case console.ErrUnauthorized.Has(err), console.ErrProjectRole.Has(err), console.ErrNoMembership.Has(err):
+		return http.StatusForbidden
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// errorMessage strips the error classes, which are meaningless to clients, from the message of err
+func errorMessage(err error) string {
+	message := err.Error()
+	for stripped := true; stripped; {
+		stripped = false
+		for _, class := range []errs.Class{ErrBadRequest, ErrUnauthenticated, console.ErrValidation, console.ErrUnauthorized, console.ErrProjectRole, console.ErrNoMembership} {
+			if strings.HasPrefix(message, string(class)+": ") {
+				message = strings.TrimPrefix(message, string(class)+": ")
+				stripped = true
+			}
+		}
+	}
+	return message
+}
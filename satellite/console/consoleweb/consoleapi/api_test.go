@@ -0,0 +1,267 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleapi_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/pkg/accounting"
+	"storj.io/storj/pkg/accounting/live"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/console/consoleauth"
+	"storj.io/storj/satellite/console/consoleweb"
+	"storj.io/storj/satellite/console/consoleweb/consoleapi"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestAPI(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		log := zaptest.NewLogger(t)
+
+		liveAccounting, err := live.New(log, live.Config{StorageBackend: "plainmemory:"})
+		require.NoError(t, err)
+
+		service, err := console.NewService(
+			log,
+			&consoleauth.Hmac{Secret: []byte("my-suppa-secret-key")},
+			db.Console(),
+			accounting.NewProjectUsage(db.ProjectAccounting(), liveAccounting, 25*memory.GB, 25*memory.GB),
+			console.TestPasswordCost,
+		)
+		require.NoError(t, err)
+
+		emails := []string{"owner@mail.test", "member@mail.test"}
+		for _, email := range emails {
+			regToken, err := service.CreateRegToken(ctx, 1)
+			require.NoError(t, err)
+
+			user, err := service.CreateUser(ctx, console.CreateUser{
+				UserInfo: console.UserInfo{FullName: email, Email: email},
+				Password: "123a123",
+			}, regToken.Secret)
+			require.NoError(t, err)
+
+			activationToken, err := service.GenerateActivationToken(ctx, user.ID, user.Email)
+			require.NoError(t, err)
+
+			err = service.ActivateAccount(ctx, activationToken)
+			require.NoError(t, err)
+		}
+
+		server := httptest.NewServer(consoleweb.WithCredentials(consoleapi.NewAPI(log, service)))
+		defer server.Close()
+
+		var token string
+
+		// call sends a request with JSON encoded body and decodes the JSON response into response
+		call := func(t *testing.T, method, path string, body, response interface{}) int {
+			var reader bytes.Buffer
+			if body != nil {
+				require.NoError(t, json.NewEncoder(&reader).Encode(body))
+			}
+
+			req, err := http.NewRequest(method, server.URL+consoleapi.Prefix+path, &reader)
+			require.NoError(t, err)
+			if token != "" {
+				req.Header.Set("Authorization", "Bearer "+token)
+			}
+
+			resp, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer func() { require.NoError(t, resp.Body.Close()) }()
+
+			if response != nil && resp.StatusCode < 300 {
+				require.NoError(t, json.NewDecoder(resp.Body).Decode(response))
+			}
+			return resp.StatusCode
+		}
+
+		t.Run("Authorization is required", func(t *testing.T) {
+			var errResponse consoleapi.ErrorResponse
+			status := call(t, http.MethodGet, "/projects", nil, &errResponse)
+			assert.Equal(t, http.StatusUnauthorized, status)
+
+			status = call(t, http.MethodPost, "/token", consoleapi.TokenInput{Email: emails[0], Password: "wrong"}, nil)
+			assert.Equal(t, http.StatusUnauthorized, status)
+
+			var tokenResponse consoleapi.Token
+			status = call(t, http.MethodPost, "/token", consoleapi.TokenInput{Email: emails[0], Password: "123a123"}, &tokenResponse)
+			require.Equal(t, http.StatusOK, status)
+			require.NotEmpty(t, tokenResponse.Token)
+
+			token = tokenResponse.Token
+		})
+
+		t.Run("Request body is limited", func(t *testing.T) {
+			input := consoleapi.TokenInput{Email: strings.Repeat("a", 2<<20), Password: "123a123"}
+			status := call(t, http.MethodPost, "/token", input, nil)
+			assert.Equal(t, http.StatusBadRequest, status)
+		})
+
+		t.Run("Notification preferences", func(t *testing.T) {
+			var preferences console.NotificationPreferences
+			status := call(t, http.MethodGet, "/account/notifications", nil, &preferences)
+			require.Equal(t, http.StatusOK, status)
+			assert.Equal(t, console.DefaultNotificationPreferences(), preferences)
+
+			status = call(t, http.MethodPatch, "/account/notifications", map[string]bool{"apiKeyCreated": false}, &preferences)
+			require.Equal(t, http.StatusOK, status)
+			assert.False(t, preferences.APIKeyCreated)
+			assert.True(t, preferences.UsageThresholds)
+
+			status = call(t, http.MethodGet, "/account/notifications", nil, &preferences)
+			require.Equal(t, http.StatusOK, status)
+			assert.False(t, preferences.APIKeyCreated)
+			assert.True(t, preferences.ProjectMemberAdded)
+		})
+
+		var project consoleapi.Project
+
+		t.Run("Projects", func(t *testing.T) {
+			status := call(t, http.MethodPost, "/projects", consoleapi.ProjectInput{Name: "rest", Description: "first"}, &project)
+			require.Equal(t, http.StatusOK, status)
+			assert.Equal(t, "rest", project.Name)
+
+			var projects []consoleapi.Project
+			status = call(t, http.MethodGet, "/projects", nil, &projects)
+			require.Equal(t, http.StatusOK, status)
+			require.Len(t, projects, 1)
+			assert.Equal(t, project.ID, projects[0].ID)
+
+			var updated consoleapi.Project
+			status = call(t, http.MethodPatch, "/projects/"+project.ID.String(), consoleapi.ProjectUpdate{Description: "second"}, &updated)
+			require.Equal(t, http.StatusOK, status)
+			assert.Equal(t, "second", updated.Description)
+
+			status = call(t, http.MethodGet, "/projects/not-a-uuid", nil, nil)
+			assert.Equal(t, http.StatusBadRequest, status)
+
+			status = call(t, http.MethodPut, "/projects/"+project.ID.String(), nil, nil)
+			assert.Equal(t, http.StatusMethodNotAllowed, status)
+		})
+
+		t.Run("Members", func(t *testing.T) {
+			path := "/projects/" + project.ID.String() + "/members"
+
+			status := call(t, http.MethodPost, path, consoleapi.MembersInput{Emails: emails[1:], Role: "viewer"}, nil)
+			require.Equal(t, http.StatusNoContent, status)
+
+			var members []consoleapi.Member
+			status = call(t, http.MethodGet, path+"?order=2", nil, &members)
+			require.Equal(t, http.StatusOK, status)
+			require.Len(t, members, 2)
+			assert.Equal(t, emails[1], members[0].Email)
+			assert.Equal(t, "viewer", members[0].Role)
+			assert.Equal(t, "owner", members[1].Role)
+
+			status = call(t, http.MethodPatch, path, consoleapi.MemberRoleInput{Email: emails[1], Role: "owner"}, nil)
+			assert.Equal(t, http.StatusForbidden, status)
+
+			status = call(t, http.MethodDelete, path+"?email="+emails[1], nil, nil)
+			require.Equal(t, http.StatusNoContent, status)
+
+			status = call(t, http.MethodGet, path, nil, &members)
+			require.Equal(t, http.StatusOK, status)
+			assert.Len(t, members, 1)
+		})
+
+		t.Run("API keys", func(t *testing.T) {
+			path := "/projects/" + project.ID.String() + "/apikeys"
+
+			var created consoleapi.CreatedAPIKey
+			status := call(t, http.MethodPost, path, consoleapi.APIKeyInput{
+				Name:         "automation",
+				Restrictions: console.APIKeyRestrictions{DisallowDeletes: true},
+			}, &created)
+			require.Equal(t, http.StatusOK, status)
+			assert.NotEmpty(t, created.Key)
+			assert.Equal(t, "automation", created.KeyInfo.Name)
+			assert.True(t, created.KeyInfo.Restrictions.DisallowDeletes)
+
+			var keys []consoleapi.APIKey
+			status = call(t, http.MethodGet, path, nil, &keys)
+			require.Equal(t, http.StatusOK, status)
+			require.Len(t, keys, 1)
+
+			status = call(t, http.MethodDelete, "/apikeys/"+created.KeyInfo.ID.String(), nil, nil)
+			require.Equal(t, http.StatusNoContent, status)
+
+			status = call(t, http.MethodGet, path, nil, &keys)
+			require.Equal(t, http.StatusOK, status)
+			assert.Len(t, keys, 0)
+		})
+
+		t.Run("Usage", func(t *testing.T) {
+			now := time.Now().UTC()
+			query := "?since=" + now.Add(-time.Hour).Format(time.RFC3339) + "&before=" + now.Format(time.RFC3339)
+
+			var usage consoleapi.Usage
+			status := call(t, http.MethodGet, "/projects/"+project.ID.String()+"/usage"+query, nil, &usage)
+			require.Equal(t, http.StatusOK, status)
+			assert.Equal(t, float64(0), usage.Storage)
+
+			var rollups []consoleapi.BucketRollup
+			status = call(t, http.MethodGet, "/projects/"+project.ID.String()+"/buckets/rollups"+query, nil, &rollups)
+			require.Equal(t, http.StatusOK, status)
+			assert.Len(t, rollups, 0)
+
+			var totals consoleapi.BucketTotalsPage
+			status = call(t, http.MethodGet, "/projects/"+project.ID.String()+"/buckets/totals?limit=10&page=1&before="+now.Format(time.RFC3339), nil, &totals)
+			require.Equal(t, http.StatusOK, status)
+			assert.Equal(t, uint64(0), totals.TotalCount)
+
+			status = call(t, http.MethodGet, "/projects/"+project.ID.String()+"/usage", nil, nil)
+			assert.Equal(t, http.StatusBadRequest, status)
+		})
+
+		t.Run("OpenAPI", func(t *testing.T) {
+			var spec struct {
+				OpenAPI string                                       `json:"openapi"`
+				Paths   map[string]map[string]map[string]interface{} `json:"paths"`
+			}
+			status := call(t, http.MethodGet, "/openapi.json", nil, &spec)
+			require.Equal(t, http.StatusOK, status)
+			assert.Equal(t, "3.0.0", spec.OpenAPI)
+
+			api := consoleapi.NewAPI(log, service)
+			for _, endpoint := range api.Endpoints() {
+				operations, ok := spec.Paths[consoleapi.Prefix+endpoint.Path]
+				require.True(t, ok, endpoint.Path)
+
+				operation, ok := operations[map[string]string{
+					http.MethodGet:    "get",
+					http.MethodPost:   "post",
+					http.MethodPatch:  "patch",
+					http.MethodDelete: "delete",
+				}[endpoint.Method]]
+				require.True(t, ok, endpoint.Method+" "+endpoint.Path)
+				assert.Equal(t, endpoint.Summary, operation["summary"])
+			}
+		})
+
+		t.Run("Delete project", func(t *testing.T) {
+			status := call(t, http.MethodDelete, "/projects/"+project.ID.String(), nil, nil)
+			require.Equal(t, http.StatusNoContent, status)
+
+			status = call(t, http.MethodGet, "/projects/"+project.ID.String(), nil, nil)
+			assert.Equal(t, http.StatusForbidden, status)
+		})
+	})
+}
@@ -0,0 +1,571 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleapi
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+
+	"storj.io/storj/satellite/console"
+)
+
+var (
+	projectIDParam = PathParam("projectID", "uuid", "id of the project")
+	sinceParam     = Param{Name: "since", In: "query", Description: "start of the period", Type: "string", Format: "date-time", Required: true}
+	beforeParam    = Param{Name: "before", In: "query", Description: "end of the period", Type: "string", Format: "date-time", Required: true}
+)
+
+// tokenEndpoints returns the endpoints for authentication
+func (api *API) tokenEndpoints() []Endpoint {
+	return []Endpoint{{
+		Method:   http.MethodPost,
+		Path:     "/token",
+		Summary:  "Request a console token",
+		Tag:      "auth",
+		Public:   true,
+		Request:  TokenInput{},
+		Response: Token{},
+		Handler: func(ctx context.Context, req *Request) (interface{}, error) {
+			var input TokenInput
+			if err := req.Decode(&input); err != nil {
+				return nil, err
+			}
+
+			token, err := api.service.TokenWithMFA(ctx, input.Email, input.Password, input.Passcode, input.RecoveryCode)
+			if err != nil {
+				return nil, ErrUnauthenticated.Wrap(err)
+			}
+
+			return Token{Token: token}, nil
+		},
+	}}
+}
+
+// accountEndpoints returns the endpoints for managing the account of the authorized user
+func (api *API) accountEndpoints() []Endpoint {
+	return []Endpoint{{
+		Method:   http.MethodGet,
+		Path:     "/account/notifications",
+		Summary:  "Get the notification preferences of the authorized user",
+		Tag:      "account",
+		Response: console.NotificationPreferences{},
+		Handler: func(ctx context.Context, req *Request) (interface{}, error) {
+			return api.service.GetNotificationPreferences(ctx)
+		},
+	}, {
+		Method:   http.MethodPatch,
+		Path:     "/account/notifications",
+		Summary:  "Update the notification preferences of the authorized user, omitted fields are kept",
+		Tag:      "account",
+		Request:  console.NotificationPreferences{},
+		Response: console.NotificationPreferences{},
+		Handler: func(ctx context.Context, req *Request) (interface{}, error) {
+			preferences, err := api.service.GetNotificationPreferences(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			if err := req.Decode(preferences); err != nil {
+				return nil, err
+			}
+
+			err = api.service.UpdateNotificationPreferences(ctx, *preferences)
+			if err != nil {
+				return nil, err
+			}
+
+			return preferences, nil
+		},
+	}}
+}
+
+// projectEndpoints returns the endpoints for managing projects
+func (api *API) projectEndpoints() []Endpoint {
+	return []Endpoint{{
+		Method:   http.MethodGet,
+		Path:     "/projects",
+		Summary:  "List the projects of the authorized user",
+		Tag:      "projects",
+		Response: []Project{},
+		Handler: func(ctx context.Context, req *Request) (interface{}, error) {
+			projects, err := api.service.GetUsersProjects(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			result := []Project{}
+			for i := range projects {
+				result = append(result, projectFromConsole(&projects[i]))
+			}
+			return result, nil
+		},
+	}, {
+		Method:   http.MethodPost,
+		Path:     "/projects",
+		Summary:  "Create a project, owned by the authorized user",
+		Tag:      "projects",
+		Request:  ProjectInput{},
+		Response: Project{},
+		Handler: func(ctx context.Context, req *Request) (interface{}, error) {
+			var input ProjectInput
+			if err := req.Decode(&input); err != nil {
+				return nil, err
+			}
+
+			project, err := api.service.CreateProject(ctx, console.ProjectInfo{
+				Name:        input.Name,
+				Description: input.Description,
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			return projectFromConsole(project), nil
+		},
+	}, {
+		Method:   http.MethodGet,
+		Path:     "/projects/{projectID}",
+		Summary:  "Get a project",
+		Tag:      "projects",
+		Params:   []Param{projectIDParam},
+		Response: Project{},
+		Handler: func(ctx context.Context, req *Request) (interface{}, error) {
+			projectID, err := req.UUIDParam(projectIDParam.Name)
+			if err != nil {
+				return nil, err
+			}
+
+			project, err := api.service.GetProject(ctx, projectID)
+			if err != nil {
+				return nil, err
+			}
+
+			return projectFromConsole(project), nil
+		},
+	}, {
+		Method:   http.MethodPatch,
+		Path:     "/projects/{projectID}",
+		Summary:  "Update the description of a project",
+		Tag:      "projects",
+		Params:   []Param{projectIDParam},
+		Request:  ProjectUpdate{},
+		Response: Project{},
+		Handler: func(ctx context.Context, req *Request) (interface{}, error) {
+			projectID, err := req.UUIDParam(projectIDParam.Name)
+			if err != nil {
+				return nil, err
+			}
+
+			var input ProjectUpdate
+			if err := req.Decode(&input); err != nil {
+				return nil, err
+			}
+
+			project, err := api.service.UpdateProject(ctx, projectID, input.Description)
+			if err != nil {
+				return nil, err
+			}
+
+			return projectFromConsole(project), nil
+		},
+	}, {
+		Method:  http.MethodDelete,
+		Path:    "/projects/{projectID}",
+		Summary: "Delete a project",
+		Tag:     "projects",
+		Params:  []Param{projectIDParam},
+		Handler: func(ctx context.Context, req *Request) (interface{}, error) {
+			projectID, err := req.UUIDParam(projectIDParam.Name)
+			if err != nil {
+				return nil, err
+			}
+
+			return nil, api.service.DeleteProject(ctx, projectID)
+		},
+	}}
+}
+
+// memberEndpoints returns the endpoints for managing project members
+func (api *API) memberEndpoints() []Endpoint {
+	return []Endpoint{{
+		Method:  http.MethodGet,
+		Path:    "/projects/{projectID}/members",
+		Summary: "List the members of a project",
+		Tag:     "members",
+		Params: []Param{
+			projectIDParam,
+			QueryParam("limit", "integer", "", "maximum number of members returned"),
+			QueryParam("offset", "integer", "", "number of members skipped"),
+			QueryParam("search", "string", "", "filter by name or email"),
+			QueryParam("order", "integer", "", "1 orders by name, 2 by email, 3 by creation date"),
+		},
+		Response: []Member{},
+		Handler: func(ctx context.Context, req *Request) (interface{}, error) {
+			projectID, err := req.UUIDParam(projectIDParam.Name)
+			if err != nil {
+				return nil, err
+			}
+
+			limit, err := req.QueryInt("limit", 50)
+			if err != nil {
+				return nil, err
+			}
+			offset, err := req.QueryInt("offset", 0)
+			if err != nil {
+				return nil, err
+			}
+			order, err := req.QueryInt("order", int(console.Name))
+			if err != nil {
+				return nil, err
+			}
+
+			members, err := api.service.GetProjectMembers(ctx, projectID, console.Pagination{
+				Limit:  limit,
+				Offset: int64(offset),
+				Search: req.Query("search"),
+				Order:  console.ProjectMemberOrder(order),
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			result := []Member{}
+			for _, member := range members {
+				user, err := api.service.GetUser(ctx, member.MemberID)
+				if err != nil {
+					return nil, err
+				}
+
+				result = append(result, Member{
+					ID:       user.ID,
+					Email:    user.Email,
+					FullName: user.FullName,
+					Role:     member.Role.String(),
+					JoinedAt: member.CreatedAt,
+				})
+			}
+			return result, nil
+		},
+	}, {
+		Method:  http.MethodPost,
+		Path:    "/projects/{projectID}/members",
+		Summary: "Add users to a project",
+		Tag:     "members",
+		Params:  []Param{projectIDParam},
+		Request: MembersInput{},
+		Handler: func(ctx context.Context, req *Request) (interface{}, error) {
+			projectID, err := req.UUIDParam(projectIDParam.Name)
+			if err != nil {
+				return nil, err
+			}
+
+			var input MembersInput
+			if err := req.Decode(&input); err != nil {
+				return nil, err
+			}
+
+			role := console.RoleDeveloper
+			if input.Role != "" {
+				role, err = console.ProjectMemberRoleFromString(input.Role)
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			_, err = api.service.AddProjectMembers(ctx, projectID, input.Emails, role)
+			return nil, err
+		},
+	}, {
+		Method:  http.MethodPatch,
+		Path:    "/projects/{projectID}/members",
+		Summary: "Change the role of a project member",
+		Tag:     "members",
+		Params:  []Param{projectIDParam},
+		Request: MemberRoleInput{},
+		Handler: func(ctx context.Context, req *Request) (interface{}, error) {
+			projectID, err := req.UUIDParam(projectIDParam.Name)
+			if err != nil {
+				return nil, err
+			}
+
+			var input MemberRoleInput
+			if err := req.Decode(&input); err != nil {
+				return nil, err
+			}
+
+			role, err := console.ProjectMemberRoleFromString(input.Role)
+			if err != nil {
+				return nil, err
+			}
+
+			return nil, api.service.UpdateProjectMemberRole(ctx, projectID, input.Email, role)
+		},
+	}, {
+		Method:  http.MethodDelete,
+		Path:    "/projects/{projectID}/members",
+		Summary: "Remove users from a project",
+		Tag:     "members",
+		Params: []Param{
+			projectIDParam,
+			{Name: "email", In: "query", Description: "email of the removed user, can be repeated", Type: "string", Required: true},
+		},
+		Handler: func(ctx context.Context, req *Request) (interface{}, error) {
+			projectID, err := req.UUIDParam(projectIDParam.Name)
+			if err != nil {
+				return nil, err
+			}
+
+			emails := req.QueryValues("email")
+			if len(emails) == 0 {
+				return nil, ErrBadRequest.New("missing email")
+			}
+
+			return nil, api.service.DeleteProjectMembers(ctx, projectID, emails)
+		},
+	}}
+}
+
+// apiKeyEndpoints returns the endpoints for managing api keys
+func (api *API) apiKeyEndpoints() []Endpoint {
+	apiKeyIDParam := PathParam("apiKeyID", "uuid", "id of the api key")
+
+	return []Endpoint{{
+		Method:   http.MethodGet,
+		Path:     "/projects/{projectID}/apikeys",
+		Summary:  "List the api keys of a project",
+		Tag:      "apikeys",
+		Params:   []Param{projectIDParam},
+		Response: []APIKey{},
+		Handler: func(ctx context.Context, req *Request) (interface{}, error) {
+			projectID, err := req.UUIDParam(projectIDParam.Name)
+			if err != nil {
+				return nil, err
+			}
+
+			keys, err := api.service.GetAPIKeysInfoByProjectID(ctx, projectID)
+			if err != nil {
+				return nil, err
+			}
+
+			result := []APIKey{}
+			for i := range keys {
+				result = append(result, apiKeyFromConsole(&keys[i]))
+			}
+			return result, nil
+		},
+	}, {
+		Method:   http.MethodPost,
+		Path:     "/projects/{projectID}/apikeys",
+		Summary:  "Create an api key, the serialized key is returned only once",
+		Tag:      "apikeys",
+		Params:   []Param{projectIDParam},
+		Request:  APIKeyInput{},
+		Response: CreatedAPIKey{},
+		Handler: func(ctx context.Context, req *Request) (interface{}, error) {
+			projectID, err := req.UUIDParam(projectIDParam.Name)
+			if err != nil {
+				return nil, err
+			}
+
+			var input APIKeyInput
+			if err := req.Decode(&input); err != nil {
+				return nil, err
+			}
+
+			info, key, err := api.service.CreateAPIKey(ctx, projectID, input.Name, input.Restrictions)
+			if err != nil {
+				return nil, err
+			}
+
+			return CreatedAPIKey{
+				Key:     key.Serialize(),
+				KeyInfo: apiKeyFromConsole(info),
+			}, nil
+		},
+	}, {
+		Method:   http.MethodGet,
+		Path:     "/apikeys/{apiKeyID}",
+		Summary:  "Get an api key",
+		Tag:      "apikeys",
+		Params:   []Param{apiKeyIDParam},
+		Response: APIKey{},
+		Handler: func(ctx context.Context, req *Request) (interface{}, error) {
+			id, err := req.UUIDParam(apiKeyIDParam.Name)
+			if err != nil {
+				return nil, err
+			}
+
+			info, err := api.service.GetAPIKeyInfo(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+
+			return apiKeyFromConsole(info), nil
+		},
+	}, {
+		Method:  http.MethodDelete,
+		Path:    "/apikeys/{apiKeyID}",
+		Summary: "Delete an api key",
+		Tag:     "apikeys",
+		Params:  []Param{apiKeyIDParam},
+		Handler: func(ctx context.Context, req *Request) (interface{}, error) {
+			id, err := req.UUIDParam(apiKeyIDParam.Name)
+			if err != nil {
+				return nil, err
+			}
+
+			return nil, api.service.DeleteAPIKeys(ctx, []uuid.UUID{id})
+		},
+	}}
+}
+
+// usageEndpoints returns the endpoints for querying project usage
+func (api *API) usageEndpoints() []Endpoint {
+	return []Endpoint{{
+		Method:   http.MethodGet,
+		Path:     "/projects/{projectID}/usage",
+		Summary:  "Get the total usage of a project during a period",
+		Tag:      "usage",
+		Params:   []Param{projectIDParam, sinceParam, beforeParam},
+		Response: Usage{},
+		Handler: func(ctx context.Context, req *Request) (interface{}, error) {
+			projectID, err := req.UUIDParam(projectIDParam.Name)
+			if err != nil {
+				return nil, err
+			}
+			since, err := req.QueryTime(sinceParam.Name)
+			if err != nil {
+				return nil, err
+			}
+			before, err := req.QueryTime(beforeParam.Name)
+			if err != nil {
+				return nil, err
+			}
+
+			usage, err := api.service.GetProjectUsage(ctx, projectID, since, before)
+			if err != nil {
+				return nil, err
+			}
+
+			return Usage{
+				Storage:     usage.Storage,
+				Egress:      usage.Egress,
+				ObjectCount: usage.ObjectCount,
+				Since:       usage.Since,
+				Before:      usage.Before,
+			}, nil
+		},
+	}, {
+		Method:   http.MethodGet,
+		Path:     "/projects/{projectID}/buckets/rollups",
+		Summary:  "Get the summed usage of every bucket of a project during a period",
+		Tag:      "usage",
+		Params:   []Param{projectIDParam, sinceParam, beforeParam},
+		Response: []BucketRollup{},
+		Handler: func(ctx context.Context, req *Request) (interface{}, error) {
+			projectID, err := req.UUIDParam(projectIDParam.Name)
+			if err != nil {
+				return nil, err
+			}
+			since, err := req.QueryTime(sinceParam.Name)
+			if err != nil {
+				return nil, err
+			}
+			before, err := req.QueryTime(beforeParam.Name)
+			if err != nil {
+				return nil, err
+			}
+
+			rollups, err := api.service.GetBucketUsageRollups(ctx, projectID, since, before)
+			if err != nil {
+				return nil, err
+			}
+
+			result := []BucketRollup{}
+			for _, rollup := range rollups {
+				result = append(result, BucketRollup{
+					BucketName:       string(rollup.BucketName),
+					RemoteStoredData: rollup.RemoteStoredData,
+					InlineStoredData: rollup.InlineStoredData,
+					RemoteSegments:   rollup.RemoteSegments,
+					InlineSegments:   rollup.InlineSegments,
+					ObjectCount:      rollup.ObjectCount,
+					MetadataSize:     rollup.MetadataSize,
+					RepairEgress:     rollup.RepairEgress,
+					GetEgress:        rollup.GetEgress,
+					AuditEgress:      rollup.AuditEgress,
+					Since:            rollup.Since,
+					Before:           rollup.Before,
+				})
+			}
+			return result, nil
+		},
+	}, {
+		Method:  http.MethodGet,
+		Path:    "/projects/{projectID}/buckets/totals",
+		Summary: "Get a page of the total usage of the buckets of a project since its creation",
+		Tag:     "usage",
+		Params: []Param{
+			projectIDParam,
+			beforeParam,
+			QueryParam("search", "string", "", "filter by bucket name"),
+			QueryParam("limit", "integer", "", "number of buckets in a page"),
+			QueryParam("page", "integer", "", "page number, starting at 1"),
+		},
+		Response: BucketTotalsPage{},
+		Handler: func(ctx context.Context, req *Request) (interface{}, error) {
+			projectID, err := req.UUIDParam(projectIDParam.Name)
+			if err != nil {
+				return nil, err
+			}
+			before, err := req.QueryTime(beforeParam.Name)
+			if err != nil {
+				return nil, err
+			}
+			limit, err := req.QueryInt("limit", 50)
+			if err != nil {
+				return nil, err
+			}
+			page, err := req.QueryInt("page", 1)
+			if err != nil {
+				return nil, err
+			}
+			if limit < 0 || page < 0 {
+				return nil, ErrBadRequest.New("invalid pagination")
+			}
+
+			totals, err := api.service.GetBucketTotals(ctx, projectID, console.BucketUsageCursor{
+				Search: req.Query("search"),
+				Limit:  uint(limit),
+				Page:   uint(page),
+			}, before)
+			if err != nil {
+				return nil, err
+			}
+
+			result := BucketTotalsPage{
+				BucketTotals: []BucketTotal{},
+				Search:       totals.Search,
+				Limit:        totals.Limit,
+				Offset:       totals.Offset,
+				PageCount:    totals.PageCount,
+				CurrentPage:  totals.CurrentPage,
+				TotalCount:   totals.TotalCount,
+			}
+			for _, usage := range totals.BucketUsages {
+				result.BucketTotals = append(result.BucketTotals, BucketTotal{
+					BucketName:  usage.BucketName,
+					Storage:     usage.Storage,
+					Egress:      usage.Egress,
+					ObjectCount: usage.ObjectCount,
+					Since:       usage.Since,
+					Before:      usage.Before,
+				})
+			}
+			return result, nil
+		},
+	}}
+}
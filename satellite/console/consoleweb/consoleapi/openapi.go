@@ -0,0 +1,215 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleapi
+
+import (
+	"net/http"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+)
+
+// OpenAPI returns the OpenAPI 3 description of the api, generated from the descriptions of its endpoints
+func (api *API) OpenAPI() map[string]interface{} {
+	schemas := &schemaGenerator{schemas: map[string]interface{}{}}
+	errorSchema := schemas.schema(reflect.TypeOf(ErrorResponse{}))
+
+	paths := map[string]interface{}{}
+	for _, endpoint := range api.endpoints {
+		path := Prefix + endpoint.Path
+
+		item, ok := paths[path].(map[string]interface{})
+		if !ok {
+			item = map[string]interface{}{}
+			paths[path] = item
+		}
+
+		operation := map[string]interface{}{
+			"summary": endpoint.Summary,
+			"tags":    []string{endpoint.Tag},
+		}
+
+		if len(endpoint.Params) > 0 {
+			var params []interface{}
+			for _, param := range endpoint.Params {
+				schema := map[string]interface{}{"type": param.Type}
+				if param.Format != "" {
+					schema["format"] = param.Format
+				}
+
+				params = append(params, map[string]interface{}{
+					"name":        param.Name,
+					"in":          param.In,
+					"description": param.Description,
+					"required":    param.Required,
+					"schema":      schema,
+				})
+			}
+			operation["parameters"] = params
+		}
+
+		if endpoint.Request != nil {
+			operation["requestBody"] = map[string]interface{}{
+				"required": true,
+				"content":  jsonContent(schemas.schema(reflect.TypeOf(endpoint.Request))),
+			}
+		}
+
+		responses := map[string]interface{}{
+			"default": map[string]interface{}{
+				"description": "error",
+				"content":     jsonContent(errorSchema),
+			},
+		}
+		if endpoint.Response != nil {
+			responses["200"] = map[string]interface{}{
+				"description": "success",
+				"content":     jsonContent(schemas.schema(reflect.TypeOf(endpoint.Response))),
+			}
+		} else {
+			responses["204"] = map[string]interface{}{
+				"description": "success",
+			}
+		}
+		operation["responses"] = responses
+
+		if !endpoint.Public {
+			operation["security"] = []interface{}{
+				map[string]interface{}{"bearerAuth": []string{}},
+			}
+		}
+
+		item[strings.ToLower(endpoint.Method)] = operation
+	}
+
+	return map[string]interface{}{
+		"openapi": "3.0.0",
+		"info": map[string]interface{}{
+			"title":   "Satellite Console API",
+			"version": strings.TrimPrefix(Prefix, "/api/"),
+		},
+		"paths": paths,
+		"components": map[string]interface{}{
+			"schemas": schemas.schemas,
+			"securitySchemes": map[string]interface{}{
+				"bearerAuth": map[string]interface{}{
+					"type":        "http",
+					"scheme":      "bearer",
+					"description": "console token, requested with " + http.MethodPost + " " + Prefix + "/token",
+				},
+			},
+		},
+	}
+}
+
+// jsonContent describes JSON encoded content with the given schema
+func jsonContent(schema map[string]interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		applicationJSON: map[string]interface{}{
+			"schema": schema,
+		},
+	}
+}
+
+var (
+	uuidType = reflect.TypeOf(uuid.UUID{})
+	timeType = reflect.TypeOf(time.Time{})
+)
+
+// schemaGenerator creates OpenAPI schemas of go types, named structs are collected as components
+type schemaGenerator struct {
+	schemas map[string]interface{}
+}
+
+// schema returns the schema of the JSON encoding of values of type t
+func (gen *schemaGenerator) schema(t reflect.Type) map[string]interface{} {
+	switch t {
+	case uuidType:
+		return map[string]interface{}{"type": "string", "format": "uuid"}
+	case timeType:
+		return map[string]interface{}{"type": "string", "format": "date-time"}
+	}
+
+	switch t.Kind() {
+	case reflect.Ptr:
+		schema := gen.schema(t.Elem())
+		if _, isRef := schema["$ref"]; !isRef {
+			schema["nullable"] = true
+		}
+		return schema
+	case reflect.Struct:
+		if t.Name() == "" {
+			return gen.object(t)
+		}
+		if _, ok := gen.schemas[t.Name()]; !ok {
+			// register the name first, so that recursive types terminate
+			gen.schemas[t.Name()] = nil
+			gen.schemas[t.Name()] = gen.object(t)
+		}
+		return map[string]interface{}{"$ref": "#/components/schemas/" + t.Name()}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return map[string]interface{}{"type": "string", "format": "byte"}
+		}
+		return map[string]interface{}{"type": "array", "items": gen.schema(t.Elem())}
+	case reflect.Map:
+		return map[string]interface{}{"type": "object", "additionalProperties": gen.schema(t.Elem())}
+	case reflect.String:
+		return map[string]interface{}{"type": "string"}
+	case reflect.Bool:
+		return map[string]interface{}{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return map[string]interface{}{"type": "integer", "format": "int64"}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]interface{}{"type": "integer", "format": "int64", "minimum": 0}
+	case reflect.Float32, reflect.Float64:
+		return map[string]interface{}{"type": "number", "format": "double"}
+	default:
+		return map[string]interface{}{}
+	}
+}
+
+// object returns the schema of a struct, using the json tags of its fields
+func (gen *schemaGenerator) object(t reflect.Type) map[string]interface{} {
+	properties := map[string]interface{}{}
+	var required []string
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath != "" {
+			continue
+		}
+
+		name := field.Name
+		omitempty := false
+		if tag, ok := field.Tag.Lookup("json"); ok {
+			options := strings.Split(tag, ",")
+			if options[0] == "-" {
+				continue
+			}
+			if options[0] != "" {
+				name = options[0]
+			}
+			for _, option := range options[1:] {
+				omitempty = omitempty || option == "omitempty"
+			}
+		}
+
+		properties[name] = gen.schema(field.Type)
+		if !omitempty && field.Type.Kind() != reflect.Ptr {
+			required = append(required, name)
+		}
+	}
+
+	schema := map[string]interface{}{
+		"type":       "object",
+		"properties": properties,
+	}
+	if len(required) > 0 {
+		schema["required"] = required
+	}
+	return schema
+}
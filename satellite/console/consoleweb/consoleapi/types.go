@@ -0,0 +1,157 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleapi
+
+import (
+	"time"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+
+	"storj.io/storj/satellite/console"
+)
+
+// TokenInput contains the credentials for requesting a console token
+type TokenInput struct {
+	Email        string `json:"email"`
+	Password     string `json:"password"`
+	Passcode     string `json:"passcode,omitempty"`
+	RecoveryCode string `json:"recoveryCode,omitempty"`
+}
+
+// Token is the console token, which has to be sent in the Authorization header as "Bearer <token>"
+type Token struct {
+	Token string `json:"token"`
+}
+
+// Project describes a project
+type Project struct {
+	ID          uuid.UUID `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	CreatedAt   time.Time `json:"createdAt"`
+}
+
+// ProjectInput contains the fields of a new project
+type ProjectInput struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// ProjectUpdate contains the updatable fields of a project
+type ProjectUpdate struct {
+	Description string `json:"description"`
+}
+
+// Member describes a project member
+type Member struct {
+	ID       uuid.UUID `json:"id"`
+	Email    string    `json:"email"`
+	FullName string    `json:"fullName"`
+	Role     string    `json:"role"`
+	JoinedAt time.Time `json:"joinedAt"`
+}
+
+// MembersInput contains the emails of users to add to a project and their role
+type MembersInput struct {
+	Emails []string `json:"emails"`
+	// Role is one of viewer, developer and admin, it defaults to developer
+	Role string `json:"role,omitempty"`
+}
+
+// MemberRoleInput contains the new role of a project member
+type MemberRoleInput struct {
+	Email string `json:"email"`
+	Role  string `json:"role"`
+}
+
+// APIKey describes an api key, without its secret
+type APIKey struct {
+	ID           uuid.UUID                  `json:"id"`
+	ProjectID    uuid.UUID                  `json:"projectId"`
+	Name         string                     `json:"name"`
+	Restrictions console.APIKeyRestrictions `json:"restrictions"`
+	CreatedAt    time.Time                  `json:"createdAt"`
+}
+
+// APIKeyInput contains the fields of a new api key
+type APIKeyInput struct {
+	Name         string                     `json:"name"`
+	Restrictions console.APIKeyRestrictions `json:"restrictions"`
+}
+
+// CreatedAPIKey contains a newly created api key, the serialized key is returned only once
+type CreatedAPIKey struct {
+	Key     string `json:"key"`
+	KeyInfo APIKey `json:"keyInfo"`
+}
+
+// Usage describes the usage of a project during a period
+type Usage struct {
+	// Storage is in GB-hours
+	Storage float64 `json:"storage"`
+	// Egress is in GB
+	Egress float64 `json:"egress"`
+	// ObjectCount is in object-hours
+	ObjectCount float64   `json:"objectCount"`
+	Since       time.Time `json:"since"`
+	Before      time.Time `json:"before"`
+}
+
+// BucketRollup describes the summed usage of a bucket during a period
+type BucketRollup struct {
+	BucketName       string    `json:"bucketName"`
+	RemoteStoredData float64   `json:"remoteStoredData"`
+	InlineStoredData float64   `json:"inlineStoredData"`
+	RemoteSegments   float64   `json:"remoteSegments"`
+	InlineSegments   float64   `json:"inlineSegments"`
+	ObjectCount      float64   `json:"objectCount"`
+	MetadataSize     float64   `json:"metadataSize"`
+	RepairEgress     float64   `json:"repairEgress"`
+	GetEgress        float64   `json:"getEgress"`
+	AuditEgress      float64   `json:"auditEgress"`
+	Since            time.Time `json:"since"`
+	Before           time.Time `json:"before"`
+}
+
+// BucketTotal describes the total usage of a bucket since the creation of the project
+type BucketTotal struct {
+	BucketName  string    `json:"bucketName"`
+	Storage     float64   `json:"storage"`
+	Egress      float64   `json:"egress"`
+	ObjectCount float64   `json:"objectCount"`
+	Since       time.Time `json:"since"`
+	Before      time.Time `json:"before"`
+}
+
+// BucketTotalsPage is a page of bucket totals
+type BucketTotalsPage struct {
+	BucketTotals []BucketTotal `json:"bucketTotals"`
+	Search       string        `json:"search"`
+	Limit        uint          `json:"limit"`
+	Offset       uint64        `json:"offset"`
+	PageCount    uint          `json:"pageCount"`
+	CurrentPage  uint          `json:"currentPage"`
+	TotalCount   uint64        `json:"totalCount"`
+}
+
+// projectFromConsole converts console.Project to its api representation
+func projectFromConsole(project *console.Project) Project {
+	return Project{
+		ID:          project.ID,
+		Name:        project.Name,
+		Description: project.Description,
+		CreatedAt:   project.CreatedAt,
+	}
+}
+
+// apiKeyFromConsole converts console.APIKeyInfo to its api representation
+func apiKeyFromConsole(info *console.APIKeyInfo) APIKey {
+	return APIKey{
+		ID:           info.ID,
+		ProjectID:    info.ProjectID,
+		Name:         info.Name,
+		Restrictions: info.Restrictions,
+		CreatedAt:    info.CreatedAt,
+	}
+}
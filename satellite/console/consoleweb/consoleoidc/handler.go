@@ -0,0 +1,407 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package consoleoidc implements single sign-on to the satellite console with an OpenID Connect provider,
+// using the authorization code flow with PKCE.
+package consoleoidc
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"html/template"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+
+	"storj.io/storj/satellite/console"
+)
+
+const (
+	// LoginPath starts the sign in with the identity provider
+	LoginPath = "/sso/login"
+	// CallbackPath is where the identity provider redirects back to, it has to be registered with the provider
+	CallbackPath = "/sso/callback"
+	// MFAPath receives the one-time passcode of users with two-factor authentication
+	MFAPath = "/sso/mfa"
+
+	// tokenCookie is the cookie, from which the console frontend reads the auth token
+	tokenCookie = "tokenKey"
+	// stateCookie binds a sign in to the browser, which started it
+	stateCookie = "ssoState"
+
+	// loginTimeout is the time the user has to sign in at the identity provider
+	loginTimeout = 10 * time.Minute
+	// maxPendingLogins limits the sign ins waiting for the identity provider, as anyone can start them
+	maxPendingLogins = 10000
+)
+
+var (
+	// Error is console oidc error type
+	Error = errs.Class("console oidc error")
+
+	mon = monkit.Package()
+)
+
+// Config contains configuration of the OpenID Connect single sign-on
+type Config struct {
+	Issuer       string `help:"url of the OpenID Connect provider used for single sign-on, empty disables it" default:""`
+	ClientID     string `help:"client id of the satellite registered at the OpenID Connect provider" default:""`
+	ClientSecret string `help:"client secret of the satellite registered at the OpenID Connect provider" default:""`
+	CreateUsers  bool   `help:"create accounts for users signing in with a verified email, who don't have one yet" default:"false"`
+}
+
+// pendingLogin is a sign in, which was redirected to the identity provider
+type pendingLogin struct {
+	verifier string
+	nonce    string
+	expires  time.Time
+}
+
+// pendingMFA is a sign in, which was verified by the identity provider and waits for
+// the second factor of the user
+type pendingMFA struct {
+	email    string
+	fullName string
+	expires  time.Time
+}
+
+// mfaForm asks for the second factor of users with two-factor authentication
+var mfaForm = template.Must(template.New("mfa").Parse(`<!DOCTYPE html>
+<html>
+<head><title>Two-factor authentication</title></head>
+<body>
+<form method="POST" action="{{.Action}}">
+<input type="hidden" name="state" value="{{.State}}">
+<p><label>Passcode <input type="text" name="passcode" autocomplete="one-time-code" autofocus></label></p>
+<p><label>or recovery code <input type="text" name="recovery_code"></label></p>
+<p><input type="submit" value="Sign in"></p>
+</form>
+</body>
+</html>
+`))
+
+// Handler serves the sign in and callback endpoints.
+//
+// Pending sign ins are kept in the memory of the handler, so all requests of a sign in have to
+// reach the same console instance. The flow doesn't work behind a load balancer, which spreads
+// the requests over several console instances without sticky sessions.
+//
+// The state of a sign in is also kept in a cookie of the browser, which started it, so that a callback
+// can't be completed in another browser.
+type Handler struct {
+	log      *zap.Logger
+	config   Config
+	service  *console.Service
+	provider *Provider
+
+	// externalAddress is the address of the console, ending with slash
+	externalAddress string
+
+	mu         sync.Mutex
+	pending    map[string]pendingLogin
+	pendingMFA map[string]pendingMFA
+
+	nowFn func() time.Time
+}
+
+// NewHandler creates a single sign-on handler, externalAddress is the address of the console ending with slash
+func NewHandler(log *zap.Logger, config Config, service *console.Service, externalAddress string) *Handler {
+	return &Handler{
+		log:             log,
+		config:          config,
+		service:         service,
+		provider:        NewProvider(config.Issuer, nil),
+		externalAddress: externalAddress,
+		pending:         make(map[string]pendingLogin),
+		pendingMFA:      make(map[string]pendingMFA),
+		nowFn:           time.Now,
+	}
+}
+
+// redirectURI is the callback url registered at the provider
+func (handler *Handler) redirectURI() string {
+	return handler.externalAddress + CallbackPath[1:]
+}
+
+// ServeHTTP dispatches to the login, callback and two-factor authentication endpoints
+func (handler *Handler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	method := http.MethodGet
+	if req.URL.Path == MFAPath {
+		method = http.MethodPost
+	}
+	if req.Method != method {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	switch req.URL.Path {
+	case LoginPath:
+		handler.login(w, req)
+	case CallbackPath:
+		handler.callback(w, req)
+	case MFAPath:
+		handler.mfa(w, req)
+	default:
+		http.NotFound(w, req)
+	}
+}
+
+// login redirects the user to the authorization endpoint of the provider
+func (handler *Handler) login(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	metadata, err := handler.provider.Metadata(ctx)
+	if err != nil {
+		handler.log.Error("identity provider unavailable", zap.Error(err))
+		http.Error(w, "identity provider unavailable", http.StatusBadGateway)
+		return
+	}
+
+	state, err := randomString()
+	if err != nil {
+		handler.serveError(w, err)
+		return
+	}
+	verifier, err := randomString()
+	if err != nil {
+		handler.serveError(w, err)
+		return
+	}
+	nonce, err := randomString()
+	if err != nil {
+		handler.serveError(w, err)
+		return
+	}
+
+	handler.mu.Lock()
+	now := handler.nowFn()
+	for key, login := range handler.pending {
+		if now.After(login.expires) {
+			delete(handler.pending, key)
+		}
+	}
+	full := len(handler.pending) >= maxPendingLogins
+	if !full {
+		handler.pending[state] = pendingLogin{
+			verifier: verifier,
+			nonce:    nonce,
+			expires:  now.Add(loginTimeout),
+		}
+	}
+	handler.mu.Unlock()
+
+	if full {
+		handler.log.Warn("too many pending sign ins")
+		http.Error(w, "too many sign ins in progress, please try again later", http.StatusServiceUnavailable)
+		return
+	}
+
+	// the callback only accepts the state from the browser, which started the sign in
+	http.SetCookie(w, &http.Cookie{
+		Name:     stateCookie,
+		Value:    state,
+		Path:     CallbackPath,
+		MaxAge:   int(loginTimeout / time.Second),
+		Secure:   true,
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	})
+
+	challenge := sha256.Sum256([]byte(verifier))
+
+	query := url.Values{
+		"response_type":         {"code"},
+		"client_id":             {handler.config.ClientID},
+		"redirect_uri":          {handler.redirectURI()},
+		"scope":                 {"openid email profile"},
+		"state":                 {state},
+		"nonce":                 {nonce},
+		"code_challenge":        {base64.RawURLEncoding.EncodeToString(challenge[:])},
+		"code_challenge_method": {"S256"},
+	}
+
+	http.Redirect(w, req, metadata.AuthorizationEndpoint+"?"+query.Encode(), http.StatusFound)
+}
+
+// callback finishes the sign in, after the user was authenticated by the provider
+func (handler *Handler) callback(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	query := req.URL.Query()
+	state := query.Get("state")
+
+	// a callback without the cookie was started in another browser, e.g. by an attacker signing in the user
+	// to the attacker's account, it leaves the pending sign in for the browser, which started it
+	cookie, err := req.Cookie(stateCookie)
+	if err != nil || subtle.ConstantTimeCompare([]byte(cookie.Value), []byte(state)) != 1 {
+		http.Error(w, "sign in expired, please try again", http.StatusBadRequest)
+		return
+	}
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     stateCookie,
+		Path:     CallbackPath,
+		MaxAge:   -1,
+		Secure:   true,
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	})
+
+	handler.mu.Lock()
+	login, ok := handler.pending[state]
+	delete(handler.pending, state)
+	handler.mu.Unlock()
+
+	if !ok || handler.nowFn().After(login.expires) {
+		http.Error(w, "sign in expired, please try again", http.StatusBadRequest)
+		return
+	}
+
+	if providerErr := query.Get("error"); providerErr != "" {
+		handler.log.Debug("sign in rejected by identity provider", zap.String("error", providerErr))
+		http.Error(w, "sign in was rejected by the identity provider", http.StatusUnauthorized)
+		return
+	}
+
+	idToken, err := handler.provider.Exchange(ctx, handler.config.ClientID, handler.config.ClientSecret, query.Get("code"), login.verifier, handler.redirectURI())
+	if err != nil {
+		handler.log.Error("exchanging authorization code failed", zap.Error(err))
+		http.Error(w, "sign in failed", http.StatusUnauthorized)
+		return
+	}
+
+	claims, err := handler.provider.Verify(ctx, idToken, handler.config.ClientID, handler.nowFn())
+	if err != nil {
+		handler.log.Error("invalid id token", zap.Error(err))
+		http.Error(w, "sign in failed", http.StatusUnauthorized)
+		return
+	}
+
+	switch {
+	case claims.Nonce != login.nonce:
+		http.Error(w, "sign in failed", http.StatusUnauthorized)
+		return
+	case claims.Email == "" || !claims.EmailVerified:
+		http.Error(w, "the identity provider didn't verify your email", http.StatusUnauthorized)
+		return
+	}
+
+	token, err := handler.service.TokenWithVerifiedEmail(ctx, claims.Email, claims.Name, handler.config.CreateUsers, "", "")
+	if err != nil {
+		if console.ErrMFAMissing.Has(err) {
+			handler.askMFA(w, claims.Email, claims.Name)
+			return
+		}
+		if console.ErrUnauthorized.Has(err) {
+			http.Error(w, errs.Unwrap(err).Error(), http.StatusUnauthorized)
+			return
+		}
+		handler.serveError(w, err)
+		return
+	}
+
+	handler.signIn(w, req, token)
+}
+
+// askMFA serves the form for the second factor of a user with two-factor authentication
+func (handler *Handler) askMFA(w http.ResponseWriter, email, fullName string) {
+	state, err := randomString()
+	if err != nil {
+		handler.serveError(w, err)
+		return
+	}
+
+	handler.mu.Lock()
+	now := handler.nowFn()
+	for key, login := range handler.pendingMFA {
+		if now.After(login.expires) {
+			delete(handler.pendingMFA, key)
+		}
+	}
+	handler.pendingMFA[state] = pendingMFA{
+		email:    email,
+		fullName: fullName,
+		expires:  now.Add(loginTimeout),
+	}
+	handler.mu.Unlock()
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	err = mfaForm.Execute(w, struct{ Action, State string }{
+		Action: handler.externalAddress + MFAPath[1:],
+		State:  state,
+	})
+	if err != nil {
+		handler.log.Error("serving two-factor authentication form failed", zap.Error(err))
+	}
+}
+
+// mfa finishes the sign in of a user with two-factor authentication
+func (handler *Handler) mfa(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	state := req.PostFormValue("state")
+
+	// every attempt needs a new sign in with the identity provider, which limits guessing passcodes
+	handler.mu.Lock()
+	login, ok := handler.pendingMFA[state]
+	delete(handler.pendingMFA, state)
+	handler.mu.Unlock()
+
+	if !ok || handler.nowFn().After(login.expires) {
+		http.Error(w, "sign in expired, please try again", http.StatusBadRequest)
+		return
+	}
+
+	token, err := handler.service.TokenWithVerifiedEmail(ctx, login.email, login.fullName, handler.config.CreateUsers,
+		req.PostFormValue("passcode"), req.PostFormValue("recovery_code"))
+	if err != nil {
+		if console.ErrMFAMissing.Has(err) || console.ErrMFAPasscode.Has(err) || console.ErrUnauthorized.Has(err) {
+			http.Error(w, errs.Unwrap(err).Error(), http.StatusUnauthorized)
+			return
+		}
+		handler.serveError(w, err)
+		return
+	}
+
+	handler.signIn(w, req, token)
+}
+
+// signIn stores the auth token for the console frontend and redirects to the console
+func (handler *Handler) signIn(w http.ResponseWriter, req *http.Request, token string) {
+	http.SetCookie(w, &http.Cookie{
+		Name:    tokenCookie,
+		Value:   token,
+		Path:    "/",
+		Expires: handler.nowFn().Add(24 * time.Hour),
+		Secure:  true,
+	})
+	http.Redirect(w, req, handler.externalAddress, http.StatusFound)
+}
+
+// serveError logs err and responds with internal server error
+func (handler *Handler) serveError(w http.ResponseWriter, err error) {
+	handler.log.Error("single sign-on failed", zap.Error(err))
+	http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+}
+
+// randomString returns a random url-safe string, used for state, nonce and code verifier
+func randomString() (string, error) {
+	var data [32]byte
+	if _, err := rand.Read(data[:]); err != nil {
+		return "", Error.Wrap(err)
+	}
+	return base64.RawURLEncoding.EncodeToString(data[:]), nil
+}
@@ -0,0 +1,458 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleoidc_test
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/pkg/accounting"
+	"storj.io/storj/pkg/accounting/live"
+	"storj.io/storj/pkg/auth"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/console/consoleauth"
+	"storj.io/storj/satellite/console/consoleweb/consoleoidc"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+const clientID = "storj-console"
+
+// identity is the user signed in at the stub identity provider
+type identity struct {
+	email    string
+	name     string
+	verified bool
+}
+
+// authorization is an issued authorization code waiting to be exchanged
+type authorization struct {
+	identity    identity
+	challenge   string
+	nonce       string
+	redirectURI string
+}
+
+// stubProvider is a minimal OpenID provider, which signs in whoever is set as the current identity
+type stubProvider struct {
+	t      *testing.T
+	server *httptest.Server
+	key    *rsa.PrivateKey
+
+	mu    sync.Mutex
+	user  identity
+	codes map[string]authorization
+	next  int
+}
+
+func newStubProvider(t *testing.T) *stubProvider {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	provider := &stubProvider{t: t, key: key, codes: make(map[string]authorization)}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", provider.discovery)
+	mux.HandleFunc("/authorize", provider.authorize)
+	mux.HandleFunc("/token", provider.token)
+	mux.HandleFunc("/jwks", provider.jwks)
+	provider.server = httptest.NewServer(mux)
+
+	return provider
+}
+
+func (provider *stubProvider) signIn(user identity) {
+	provider.mu.Lock()
+	defer provider.mu.Unlock()
+	provider.user = user
+}
+
+func (provider *stubProvider) writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	require.NoError(provider.t, json.NewEncoder(w).Encode(v))
+}
+
+func (provider *stubProvider) discovery(w http.ResponseWriter, req *http.Request) {
+	provider.writeJSON(w, map[string]string{
+		"issuer":                 provider.server.URL,
+		"authorization_endpoint": provider.server.URL + "/authorize",
+		"token_endpoint":         provider.server.URL + "/token",
+		"jwks_uri":               provider.server.URL + "/jwks",
+	})
+}
+
+func (provider *stubProvider) jwks(w http.ResponseWriter, req *http.Request) {
+	provider.writeJSON(w, map[string]interface{}{
+		"keys": []map[string]string{{
+			"kty": "RSA",
+			"kid": "test",
+			"use": "sig",
+			"n":   base64.RawURLEncoding.EncodeToString(provider.key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(provider.key.E)).Bytes()),
+		}},
+	})
+}
+
+func (provider *stubProvider) authorize(w http.ResponseWriter, req *http.Request) {
+	query := req.URL.Query()
+	if query.Get("client_id") != clientID || query.Get("code_challenge_method") != "S256" {
+		http.Error(w, "invalid request", http.StatusBadRequest)
+		return
+	}
+
+	provider.mu.Lock()
+	provider.next++
+	code := "code-" + strconv.Itoa(provider.next)
+	provider.codes[code] = authorization{
+		identity:    provider.user,
+		challenge:   query.Get("code_challenge"),
+		nonce:       query.Get("nonce"),
+		redirectURI: query.Get("redirect_uri"),
+	}
+	provider.mu.Unlock()
+
+	http.Redirect(w, req, query.Get("redirect_uri")+"?"+url.Values{
+		"code":  {code},
+		"state": {query.Get("state")},
+	}.Encode(), http.StatusFound)
+}
+
+func (provider *stubProvider) token(w http.ResponseWriter, req *http.Request) {
+	require.NoError(provider.t, req.ParseForm())
+
+	provider.mu.Lock()
+	code, ok := provider.codes[req.PostForm.Get("code")]
+	delete(provider.codes, req.PostForm.Get("code"))
+	provider.mu.Unlock()
+
+	challenge := sha256.Sum256([]byte(req.PostForm.Get("code_verifier")))
+	id, secret, _ := req.BasicAuth()
+
+	if !ok || id != clientID || secret != "secret" ||
+		code.challenge != base64.RawURLEncoding.EncodeToString(challenge[:]) ||
+		code.redirectURI != req.PostForm.Get("redirect_uri") {
+		w.WriteHeader(http.StatusBadRequest)
+		provider.writeJSON(w, map[string]string{"error": "invalid_grant"})
+		return
+	}
+
+	provider.writeJSON(w, map[string]string{
+		"access_token": "access",
+		"token_type":   "Bearer",
+		"id_token": provider.sign(map[string]interface{}{
+			"iss":            provider.server.URL,
+			"sub":            code.identity.email,
+			"aud":            clientID,
+			"exp":            time.Now().Add(time.Hour).Unix(),
+			"nonce":          code.nonce,
+			"email":          code.identity.email,
+			"email_verified": code.identity.verified,
+			"name":           code.identity.name,
+		}),
+	})
+}
+
+func (provider *stubProvider) sign(claims map[string]interface{}) string {
+	encode := func(v interface{}) string {
+		data, err := json.Marshal(v)
+		require.NoError(provider.t, err)
+		return base64.RawURLEncoding.EncodeToString(data)
+	}
+
+	signed := encode(map[string]string{"alg": "RS256", "kid": "test", "typ": "JWT"}) + "." + encode(claims)
+	digest := sha256.Sum256([]byte(signed))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, provider.key, crypto.SHA256, digest[:])
+	require.NoError(provider.t, err)
+
+	return signed + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func TestSingleSignOn(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		log := zaptest.NewLogger(t)
+
+		liveAccounting, err := live.New(log, live.Config{StorageBackend: "plainmemory:"})
+		require.NoError(t, err)
+
+		service, err := console.NewService(
+			log,
+			&consoleauth.Hmac{Secret: []byte("my-suppa-secret-key")},
+			db.Console(),
+			accounting.NewProjectUsage(db.ProjectAccounting(), liveAccounting, 25*memory.GB, 25*memory.GB),
+			console.TestPasswordCost,
+		)
+		require.NoError(t, err)
+
+		regToken, err := service.CreateRegToken(ctx, 1)
+		require.NoError(t, err)
+
+		existing, err := service.CreateUser(ctx, console.CreateUser{
+			UserInfo: console.UserInfo{FullName: "Existing", Email: "existing@mail.test"},
+			Password: "123a123",
+		}, regToken.Secret)
+		require.NoError(t, err)
+
+		activationToken, err := service.GenerateActivationToken(ctx, existing.ID, existing.Email)
+		require.NoError(t, err)
+		require.NoError(t, service.ActivateAccount(ctx, activationToken))
+
+		provider := newStubProvider(t)
+		defer provider.server.Close()
+
+		config := consoleoidc.Config{
+			Issuer:       provider.server.URL,
+			ClientID:     clientID,
+			ClientSecret: "secret",
+		}
+
+		var handler http.Handler
+		consoleServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			handler.ServeHTTP(w, req)
+		}))
+		defer consoleServer.Close()
+
+		setup := func(createUsers bool) {
+			config.CreateUsers = createUsers
+			handler = consoleoidc.NewHandler(log, config, service, consoleServer.URL+"/")
+		}
+
+		client := &http.Client{
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		}
+
+		// get requests the url with the cookies and returns the response status, redirect location and cookies
+		get := func(t *testing.T, url string, cookies ...*http.Cookie) (status int, location string, setCookies []*http.Cookie) {
+			req, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+			for _, cookie := range cookies {
+				req.AddCookie(cookie)
+			}
+
+			resp, err := client.Do(req)
+			require.NoError(t, err)
+			require.NoError(t, resp.Body.Close())
+			return resp.StatusCode, resp.Header.Get("Location"), resp.Cookies()
+		}
+
+		// cookieNamed returns the cookie with the name
+		cookieNamed := func(cookies []*http.Cookie, name string) *http.Cookie {
+			for _, cookie := range cookies {
+				if cookie.Name == name {
+					return cookie
+				}
+			}
+			return nil
+		}
+
+		// signIn goes through the whole flow and returns the status of the callback and the auth token
+		signIn := func(t *testing.T, user identity) (status int, token string) {
+			provider.signIn(user)
+
+			status, location, cookies := get(t, consoleServer.URL+consoleoidc.LoginPath)
+			require.Equal(t, http.StatusFound, status)
+			state := cookieNamed(cookies, "ssoState")
+			require.NotNil(t, state)
+			assert.True(t, state.HttpOnly)
+
+			status, location, _ = get(t, location)
+			require.Equal(t, http.StatusFound, status)
+
+			status, location, cookies = get(t, location, state)
+			if status != http.StatusFound {
+				return status, ""
+			}
+			assert.Equal(t, consoleServer.URL+"/", location)
+
+			tokenCookie := cookieNamed(cookies, "tokenKey")
+			require.NotNil(t, tokenCookie, "token cookie not set")
+			return status, tokenCookie.Value
+		}
+
+		// emailOf returns the email of the user authorized by token
+		emailOf := func(t *testing.T, token string) string {
+			authorization, err := service.Authorize(auth.WithAPIKey(ctx, []byte(token)))
+			require.NoError(t, err)
+			return authorization.User.Email
+		}
+
+		t.Run("Existing user is linked", func(t *testing.T) {
+			setup(false)
+
+			status, token := signIn(t, identity{email: "Existing@mail.test", verified: true})
+			require.Equal(t, http.StatusFound, status)
+			assert.Equal(t, existing.Email, emailOf(t, token))
+		})
+
+		t.Run("Unknown user is rejected", func(t *testing.T) {
+			setup(false)
+
+			status, _ := signIn(t, identity{email: "unknown@mail.test", verified: true})
+			assert.Equal(t, http.StatusUnauthorized, status)
+
+			_, err := db.Console().Users().GetByEmail(ctx, "unknown@mail.test")
+			assert.Error(t, err)
+		})
+
+		t.Run("Unverified email is rejected", func(t *testing.T) {
+			setup(true)
+
+			status, _ := signIn(t, identity{email: existing.Email, verified: false})
+			assert.Equal(t, http.StatusUnauthorized, status)
+		})
+
+		t.Run("Unknown user is created", func(t *testing.T) {
+			setup(true)
+
+			status, token := signIn(t, identity{email: "new@mail.test", name: "New User", verified: true})
+			require.Equal(t, http.StatusFound, status)
+			assert.Equal(t, "new@mail.test", emailOf(t, token))
+
+			user, err := db.Console().Users().GetByEmail(ctx, "new@mail.test")
+			require.NoError(t, err)
+			assert.Equal(t, "New User", user.FullName)
+			assert.Equal(t, console.Active, user.Status)
+
+			// the second sign in uses the created account
+			status, token = signIn(t, identity{email: "new@mail.test", verified: true})
+			require.Equal(t, http.StatusFound, status)
+			assert.Equal(t, "new@mail.test", emailOf(t, token))
+		})
+
+		t.Run("Second factor is required", func(t *testing.T) {
+			setup(false)
+
+			token, err := service.Token(ctx, existing.Email, "123a123")
+			require.NoError(t, err)
+			authorize := func(t *testing.T) context.Context {
+				authorization, err := service.Authorize(auth.WithAPIKey(ctx, []byte(token)))
+				require.NoError(t, err)
+				return console.WithAuth(ctx, authorization)
+			}
+
+			uri, err := service.EnrollMFA(authorize(t))
+			require.NoError(t, err)
+			parsed, err := url.Parse(uri)
+			require.NoError(t, err)
+			secret := parsed.Query().Get("secret")
+
+			passcode, err := consoleauth.TOTPCode(secret, time.Now())
+			require.NoError(t, err)
+			_, err = service.EnableMFA(authorize(t), passcode)
+			require.NoError(t, err)
+
+			// askMFA signs in at the provider and returns the state of the form asking for the passcode
+			stateField := regexp.MustCompile(`name="state" value="([^"]+)"`)
+			askMFA := func(t *testing.T) string {
+				provider.signIn(identity{email: existing.Email, verified: true})
+
+				status, location, cookies := get(t, consoleServer.URL+consoleoidc.LoginPath)
+				require.Equal(t, http.StatusFound, status)
+				status, location, _ = get(t, location)
+				require.Equal(t, http.StatusFound, status)
+
+				req, err := http.NewRequest(http.MethodGet, location, nil)
+				require.NoError(t, err)
+				req.AddCookie(cookieNamed(cookies, "ssoState"))
+				resp, err := client.Do(req)
+				require.NoError(t, err)
+				body, err := ioutil.ReadAll(resp.Body)
+				require.NoError(t, err)
+				require.NoError(t, resp.Body.Close())
+				require.Equal(t, http.StatusOK, resp.StatusCode)
+				assert.Nil(t, cookieNamed(resp.Cookies(), "tokenKey"))
+
+				match := stateField.FindSubmatch(body)
+				require.Len(t, match, 2)
+				return string(match[1])
+			}
+
+			submit := func(t *testing.T, state, passcode string) *http.Response {
+				resp, err := client.PostForm(consoleServer.URL+consoleoidc.MFAPath, url.Values{
+					"state":    {state},
+					"passcode": {passcode},
+				})
+				require.NoError(t, err)
+				require.NoError(t, resp.Body.Close())
+				return resp
+			}
+
+			state := askMFA(t)
+			resp := submit(t, state, "000000")
+			assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+			assert.Empty(t, resp.Cookies())
+
+			// the form can't be submitted again
+			passcode, err = consoleauth.TOTPCode(secret, time.Now())
+			require.NoError(t, err)
+			resp = submit(t, state, passcode)
+			assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+			resp = submit(t, askMFA(t), passcode)
+			require.Equal(t, http.StatusFound, resp.StatusCode)
+			require.Len(t, resp.Cookies(), 1)
+			assert.True(t, resp.Cookies()[0].Secure)
+			assert.Equal(t, existing.Email, emailOf(t, resp.Cookies()[0].Value))
+		})
+
+		t.Run("Invalid state is rejected", func(t *testing.T) {
+			setup(false)
+
+			status, _, _ := get(t, consoleServer.URL+consoleoidc.CallbackPath+"?code=code-1&state=forged")
+			assert.Equal(t, http.StatusBadRequest, status)
+		})
+
+		t.Run("Callback from another browser is rejected", func(t *testing.T) {
+			setup(false)
+			provider.signIn(identity{email: "new@mail.test", verified: true})
+
+			status, location, cookies := get(t, consoleServer.URL+consoleoidc.LoginPath)
+			require.Equal(t, http.StatusFound, status)
+			state := cookieNamed(cookies, "ssoState")
+			require.NotNil(t, state)
+
+			status, callback, _ := get(t, location)
+			require.Equal(t, http.StatusFound, status)
+
+			// the callback without the cookie of the browser, which started the sign in
+			status, _, _ = get(t, callback)
+			assert.Equal(t, http.StatusBadRequest, status)
+
+			// and with the cookie of another sign in
+			_, _, otherCookies := get(t, consoleServer.URL+consoleoidc.LoginPath)
+			status, _, _ = get(t, callback, cookieNamed(otherCookies, "ssoState"))
+			assert.Equal(t, http.StatusBadRequest, status)
+
+			// the sign in still succeeds in the browser, which started it
+			status, _, cookies = get(t, callback, state)
+			require.Equal(t, http.StatusFound, status)
+			assert.NotNil(t, cookieNamed(cookies, "tokenKey"))
+		})
+	})
+}
@@ -0,0 +1,317 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleoidc
+
+import (
+	"context"
+	"crypto"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/zeebo/errs"
+)
+
+// discoveryPath is the path of the OpenID provider configuration relative to the issuer
+const discoveryPath = "/.well-known/openid-configuration"
+
+// Metadata contains the endpoints of an OpenID provider
+type Metadata struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// Claims contains the claims of an ID token used by the console
+type Claims struct {
+	Issuer        string   `json:"iss"`
+	Subject       string   `json:"sub"`
+	Audience      Audience `json:"aud"`
+	Expiration    int64    `json:"exp"`
+	Nonce         string   `json:"nonce"`
+	Email         string   `json:"email"`
+	EmailVerified bool     `json:"email_verified"`
+	Name          string   `json:"name"`
+}
+
+// Audience is the aud claim, which is either a single string or an array of strings
+type Audience []string
+
+// UnmarshalJSON decodes both forms of the aud claim
+func (aud *Audience) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*aud = Audience{single}
+		return nil
+	}
+
+	var multiple []string
+	if err := json.Unmarshal(data, &multiple); err != nil {
+		return err
+	}
+	*aud = Audience(multiple)
+	return nil
+}
+
+// Contains checks whether clientID is one of the audiences
+func (aud Audience) Contains(clientID string) bool {
+	for _, value := range aud {
+		if value == clientID {
+			return true
+		}
+	}
+	return false
+}
+
+// Provider is an OpenID provider, whose metadata and signing keys are fetched lazily,
+// so that an unavailable provider doesn't prevent the satellite from starting
+type Provider struct {
+	issuer string
+	client *http.Client
+
+	mu       sync.Mutex
+	metadata *Metadata
+	keys     map[string]*rsa.PublicKey
+}
+
+// NewProvider creates an OpenID provider with the given issuer url
+func NewProvider(issuer string, client *http.Client) *Provider {
+	if client == nil {
+		client = &http.Client{Timeout: 30 * time.Second}
+	}
+	return &Provider{
+		issuer: strings.TrimSuffix(issuer, "/"),
+		client: client,
+	}
+}
+
+// Metadata returns the metadata of the provider, fetching it on the first call
+func (provider *Provider) Metadata(ctx context.Context) (_ *Metadata, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	provider.mu.Lock()
+	defer provider.mu.Unlock()
+
+	if provider.metadata != nil {
+		return provider.metadata, nil
+	}
+
+	var metadata Metadata
+	if err := provider.getJSON(ctx, provider.issuer+discoveryPath, &metadata); err != nil {
+		return nil, err
+	}
+
+	if metadata.Issuer != provider.issuer {
+		return nil, Error.New("issuer mismatch: expected %q, got %q", provider.issuer, metadata.Issuer)
+	}
+	if metadata.AuthorizationEndpoint == "" || metadata.TokenEndpoint == "" || metadata.JWKSURI == "" {
+		return nil, Error.New("incomplete provider metadata")
+	}
+
+	provider.metadata = &metadata
+	return provider.metadata, nil
+}
+
+// Verify checks the signature, issuer, audience and expiration of the ID token and returns its claims
+func (provider *Provider) Verify(ctx context.Context, idToken, clientID string, now time.Time) (_ *Claims, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	parts := strings.Split(idToken, ".")
+	if len(parts) != 3 {
+		return nil, Error.New("malformed id token")
+	}
+
+	var header struct {
+		Algorithm string `json:"alg"`
+		KeyID     string `json:"kid"`
+	}
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return nil, err
+	}
+	if header.Algorithm != "RS256" {
+		return nil, Error.New("unsupported id token algorithm %q", header.Algorithm)
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, Error.New("malformed id token signature")
+	}
+
+	key, err := provider.key(ctx, header.KeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	if err := rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], signature); err != nil {
+		return nil, Error.New("invalid id token signature")
+	}
+
+	var claims Claims
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case claims.Issuer != provider.issuer:
+		return nil, Error.New("id token issued by %q", claims.Issuer)
+	case !claims.Audience.Contains(clientID):
+		return nil, Error.New("id token issued for another client")
+	case now.Unix() >= claims.Expiration:
+		return nil, Error.New("id token expired")
+	}
+
+	return &claims, nil
+}
+
+// key returns the signing key with the given id, the keys are refetched when the id is unknown,
+// because providers rotate their keys
+func (provider *Provider) key(ctx context.Context, keyID string) (_ *rsa.PublicKey, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	metadata, err := provider.Metadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	provider.mu.Lock()
+	defer provider.mu.Unlock()
+
+	if key, ok := provider.keys[keyID]; ok {
+		return key, nil
+	}
+
+	var jwks struct {
+		Keys []struct {
+			KeyType string `json:"kty"`
+			KeyID   string `json:"kid"`
+			Use     string `json:"use"`
+			N       string `json:"n"`
+			E       string `json:"e"`
+		} `json:"keys"`
+	}
+	if err := provider.getJSON(ctx, metadata.JWKSURI, &jwks); err != nil {
+		return nil, err
+	}
+
+	keys := make(map[string]*rsa.PublicKey)
+	for _, jwk := range jwks.Keys {
+		if jwk.KeyType != "RSA" || (jwk.Use != "" && jwk.Use != "sig") {
+			continue
+		}
+
+		n, err := base64.RawURLEncoding.DecodeString(jwk.N)
+		if err != nil {
+			return nil, Error.New("malformed key %q", jwk.KeyID)
+		}
+		e, err := base64.RawURLEncoding.DecodeString(jwk.E)
+		if err != nil {
+			return nil, Error.New("malformed key %q", jwk.KeyID)
+		}
+
+		keys[jwk.KeyID] = &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}
+	}
+	provider.keys = keys
+
+	key, ok := keys[keyID]
+	if !ok {
+		return nil, Error.New("unknown signing key %q", keyID)
+	}
+	return key, nil
+}
+
+// Exchange redeems the authorization code for the ID token
+func (provider *Provider) Exchange(ctx context.Context, clientID, clientSecret, code, verifier, redirectURI string) (idToken string, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	metadata, err := provider.Metadata(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	values := url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"redirect_uri":  {redirectURI},
+		"client_id":     {clientID},
+		"code_verifier": {verifier},
+	}
+
+	req, err := http.NewRequest(http.MethodPost, metadata.TokenEndpoint, strings.NewReader(values.Encode()))
+	if err != nil {
+		return "", Error.Wrap(err)
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if clientSecret != "" {
+		req.SetBasicAuth(url.QueryEscape(clientID), url.QueryEscape(clientSecret))
+	}
+
+	resp, err := provider.client.Do(req)
+	if err != nil {
+		return "", Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, Error.Wrap(resp.Body.Close())) }()
+
+	var token struct {
+		IDToken          string `json:"id_token"`
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&token); err != nil {
+		return "", Error.New("invalid token response: %v", err)
+	}
+
+	if token.Error != "" {
+		return "", Error.New("token request failed: %s %s", token.Error, token.ErrorDescription)
+	}
+	if resp.StatusCode != http.StatusOK || token.IDToken == "" {
+		return "", Error.New("token request failed with status %d", resp.StatusCode)
+	}
+
+	return token.IDToken, nil
+}
+
+// getJSON fetches the JSON document at url into v
+func (provider *Provider) getJSON(ctx context.Context, url string, v interface{}) (err error) {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	resp, err := provider.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, Error.Wrap(resp.Body.Close())) }()
+
+	if resp.StatusCode != http.StatusOK {
+		return Error.New("fetching %s failed with status %d", url, resp.StatusCode)
+	}
+
+	return Error.Wrap(json.NewDecoder(resp.Body).Decode(v))
+}
+
+// decodeSegment decodes a base64url encoded JSON segment of a token
+func decodeSegment(segment string, v interface{}) error {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return Error.New("malformed id token")
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return Error.New("malformed id token")
+	}
+	return nil
+}
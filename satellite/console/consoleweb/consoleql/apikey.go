@@ -4,6 +4,8 @@
 package consoleql
 
 import (
+	"time"
+
 	"github.com/graphql-go/graphql"
 
 	"storj.io/storj/satellite/console"
@@ -17,10 +19,38 @@ const (
 	CreateAPIKeyType = "graphqlCreateAPIKey"
 	// FieldKey is field name for the actual key in createAPIKey
 	FieldKey = "key"
+	// APIKeyRestrictionsType is graphql type name for api key restrictions
+	APIKeyRestrictionsType = "apiKeyRestrictions"
+	// APIKeyRestrictionsInputType is graphql type name for api key restrictions input
+	APIKeyRestrictionsInputType = "apiKeyRestrictionsInput"
+	// APIKeyPathType is graphql type name for api key allowed path
+	APIKeyPathType = "apiKeyPath"
+	// APIKeyPathInputType is graphql type name for api key allowed path input
+	APIKeyPathInputType = "apiKeyPathInput"
+	// FieldRestrictions is field name for api key restrictions
+	FieldRestrictions = "restrictions"
+	// FieldDisallowReads is field name for disallowing reads
+	FieldDisallowReads = "disallowReads"
+	// FieldDisallowWrites is field name for disallowing writes
+	FieldDisallowWrites = "disallowWrites"
+	// FieldDisallowLists is field name for disallowing lists
+	FieldDisallowLists = "disallowLists"
+	// FieldDisallowDeletes is field name for disallowing deletes
+	FieldDisallowDeletes = "disallowDeletes"
+	// FieldAllowedPaths is field name for allowed paths
+	FieldAllowedPaths = "allowedPaths"
+	// FieldBucket is field name for bucket
+	FieldBucket = "bucket"
+	// FieldEncryptedPathPrefix is field name for encrypted path prefix
+	FieldEncryptedPathPrefix = "encryptedPathPrefix"
+	// FieldNotBefore is field name for the start of the validity window
+	FieldNotBefore = "notBefore"
+	// FieldNotAfter is field name for the end of the validity window
+	FieldNotAfter = "notAfter"
 )
 
 // graphqlAPIKeyInfo creates satellite.APIKeyInfo graphql object
-func graphqlAPIKeyInfo() *graphql.Object {
+func graphqlAPIKeyInfo(types *TypeCreator) *graphql.Object {
 	return graphql.NewObject(graphql.ObjectConfig{
 		Name: APIKeyInfoType,
 		Fields: graphql.Fields{
@@ -33,6 +63,9 @@ func graphqlAPIKeyInfo() *graphql.Object {
 			FieldName: &graphql.Field{
 				Type: graphql.String,
 			},
+			FieldRestrictions: &graphql.Field{
+				Type: types.apiKeyRestrictions,
+			},
 			FieldCreatedAt: &graphql.Field{
 				Type: graphql.DateTime,
 			},
@@ -40,6 +73,90 @@ func graphqlAPIKeyInfo() *graphql.Object {
 	})
 }
 
+// graphqlAPIKeyRestrictions creates console.APIKeyRestrictions graphql object
+func graphqlAPIKeyRestrictions() *graphql.Object {
+	path := graphql.NewObject(graphql.ObjectConfig{
+		Name: APIKeyPathType,
+		Fields: graphql.Fields{
+			FieldBucket: &graphql.Field{
+				Type: graphql.String,
+			},
+			FieldEncryptedPathPrefix: &graphql.Field{
+				Type: graphql.String,
+			},
+		},
+	})
+
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name: APIKeyRestrictionsType,
+		Fields: graphql.Fields{
+			FieldDisallowReads: &graphql.Field{
+				Type: graphql.Boolean,
+			},
+			FieldDisallowWrites: &graphql.Field{
+				Type: graphql.Boolean,
+			},
+			FieldDisallowLists: &graphql.Field{
+				Type: graphql.Boolean,
+			},
+			FieldDisallowDeletes: &graphql.Field{
+				Type: graphql.Boolean,
+			},
+			FieldAllowedPaths: &graphql.Field{
+				Type: graphql.NewList(path),
+			},
+			FieldNotBefore: &graphql.Field{
+				Type: graphql.DateTime,
+			},
+			FieldNotAfter: &graphql.Field{
+				Type: graphql.DateTime,
+			},
+		},
+	})
+}
+
+// graphqlAPIKeyRestrictionsInput creates graphql input type for console.APIKeyRestrictions
+func graphqlAPIKeyRestrictionsInput() *graphql.InputObject {
+	path := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: APIKeyPathInputType,
+		Fields: graphql.InputObjectConfigFieldMap{
+			FieldBucket: &graphql.InputObjectFieldConfig{
+				Type: graphql.NewNonNull(graphql.String),
+			},
+			FieldEncryptedPathPrefix: &graphql.InputObjectFieldConfig{
+				Type: graphql.String,
+			},
+		},
+	})
+
+	return graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: APIKeyRestrictionsInputType,
+		Fields: graphql.InputObjectConfigFieldMap{
+			FieldDisallowReads: &graphql.InputObjectFieldConfig{
+				Type: graphql.Boolean,
+			},
+			FieldDisallowWrites: &graphql.InputObjectFieldConfig{
+				Type: graphql.Boolean,
+			},
+			FieldDisallowLists: &graphql.InputObjectFieldConfig{
+				Type: graphql.Boolean,
+			},
+			FieldDisallowDeletes: &graphql.InputObjectFieldConfig{
+				Type: graphql.Boolean,
+			},
+			FieldAllowedPaths: &graphql.InputObjectFieldConfig{
+				Type: graphql.NewList(path),
+			},
+			FieldNotBefore: &graphql.InputObjectFieldConfig{
+				Type: graphql.DateTime,
+			},
+			FieldNotAfter: &graphql.InputObjectFieldConfig{
+				Type: graphql.DateTime,
+			},
+		},
+	})
+}
+
 // graphqlCreateAPIKey creates createAPIKey graphql object
 func graphqlCreateAPIKey(types *TypeCreator) *graphql.Object {
 	return graphql.NewObject(graphql.ObjectConfig{
@@ -55,8 +172,35 @@ func graphqlCreateAPIKey(types *TypeCreator) *graphql.Object {
 	})
 }
 
-// createAPIKey holds satellite.APIKey and satellite.APIKeyInfo
+// createAPIKey holds serialized macaroon api key and satellite.APIKeyInfo
 type createAPIKey struct {
-	Key     *console.APIKey
+	Key     string
 	KeyInfo *console.APIKeyInfo
 }
+
+// fromMapAPIKeyRestrictions creates console.APIKeyRestrictions from input args
+func fromMapAPIKeyRestrictions(args map[string]interface{}) (restrictions console.APIKeyRestrictions) {
+	restrictions.DisallowReads, _ = args[FieldDisallowReads].(bool)
+	restrictions.DisallowWrites, _ = args[FieldDisallowWrites].(bool)
+	restrictions.DisallowLists, _ = args[FieldDisallowLists].(bool)
+	restrictions.DisallowDeletes, _ = args[FieldDisallowDeletes].(bool)
+
+	paths, _ := args[FieldAllowedPaths].([]interface{})
+	for _, path := range paths {
+		pathArgs, _ := path.(map[string]interface{})
+
+		var allowed console.APIKeyPath
+		allowed.Bucket, _ = pathArgs[FieldBucket].(string)
+		allowed.EncryptedPathPrefix, _ = pathArgs[FieldEncryptedPathPrefix].(string)
+		restrictions.AllowedPaths = append(restrictions.AllowedPaths, allowed)
+	}
+
+	if notBefore, ok := args[FieldNotBefore].(time.Time); ok {
+		restrictions.NotBefore = &notBefore
+	}
+	if notAfter, ok := args[FieldNotAfter].(time.Time); ok {
+		restrictions.NotAfter = &notAfter
+	}
+
+	return restrictions
+}
@@ -0,0 +1,53 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleql
+
+import (
+	"github.com/graphql-go/graphql"
+)
+
+const (
+	// AuditEventType is a graphql type name for audit event
+	AuditEventType = "auditEvent"
+	// FieldAuditEvents is a field name for the audit log of a project
+	FieldAuditEvents = "auditEvents"
+	// FieldActorID is a field name for the id of the user, who performed the action
+	FieldActorID = "actorID"
+	// FieldAction is a field name for the kind of action
+	FieldAction = "action"
+	// FieldTarget is a field name for the subject of the action
+	FieldTarget = "target"
+	// FieldSourceIP is a field name for the address of the client, which performed the action
+	FieldSourceIP = "sourceIP"
+)
+
+// graphqlAuditEvent creates *graphql.Object type representation of console.AuditEvent
+func graphqlAuditEvent() *graphql.Object {
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name: AuditEventType,
+		Fields: graphql.Fields{
+			FieldID: &graphql.Field{
+				Type: graphql.String,
+			},
+			FieldProjectID: &graphql.Field{
+				Type: graphql.String,
+			},
+			FieldActorID: &graphql.Field{
+				Type: graphql.String,
+			},
+			FieldAction: &graphql.Field{
+				Type: graphql.String,
+			},
+			FieldTarget: &graphql.Field{
+				Type: graphql.String,
+			},
+			FieldSourceIP: &graphql.Field{
+				Type: graphql.String,
+			},
+			FieldCreatedAt: &graphql.Field{
+				Type: graphql.DateTime,
+			},
+		},
+	})
+}
@@ -0,0 +1,154 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleql_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/pkg/accounting"
+	"storj.io/storj/pkg/accounting/live"
+	"storj.io/storj/pkg/auth"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/console/consoleauth"
+	"storj.io/storj/satellite/console/consoleweb/consoleql"
+	"storj.io/storj/satellite/mailservice"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestGraphqlAuditEvents(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		log := zaptest.NewLogger(t)
+
+		liveAccounting, err := live.New(log, live.Config{StorageBackend: "plainmemory:"})
+		require.NoError(t, err)
+
+		service, err := console.NewService(
+			log,
+			&consoleauth.Hmac{Secret: []byte("my-suppa-secret-key")},
+			db.Console(),
+			accounting.NewProjectUsage(db.ProjectAccounting(), liveAccounting, 25*memory.GB, 25*memory.GB),
+			console.TestPasswordCost,
+		)
+		require.NoError(t, err)
+
+		mailService, err := mailservice.New(log, &discardSender{}, "testdata")
+		require.NoError(t, err)
+		defer ctx.Check(mailService.Close)
+
+		schema, err := consoleql.CreateSchema(log, service, mailService)
+		require.NoError(t, err)
+
+		// authorize returns a context, in which user with email is authorized
+		authorize := func(t *testing.T, email string) context.Context {
+			token, err := service.Token(ctx, email, "123a123")
+			require.NoError(t, err)
+
+			sauth, err := service.Authorize(auth.WithAPIKey(ctx, []byte(token)))
+			require.NoError(t, err)
+
+			return console.WithSourceIP(console.WithAuth(ctx, sauth), "10.0.0.1")
+		}
+
+		emails := []string{"owner@email.com", "admin@email.com"}
+		for _, email := range emails {
+			regToken, err := service.CreateRegToken(ctx, 1)
+			require.NoError(t, err)
+
+			user, err := service.CreateUser(ctx, console.CreateUser{
+				UserInfo: console.UserInfo{FullName: email, Email: email},
+				Password: "123a123",
+			}, regToken.Secret)
+			require.NoError(t, err)
+
+			activationToken, err := service.GenerateActivationToken(ctx, user.ID, user.Email)
+			require.NoError(t, err)
+
+			err = service.ActivateAccount(ctx, activationToken)
+			require.NoError(t, err)
+		}
+
+		ownerCtx := authorize(t, emails[0])
+		adminCtx := authorize(t, emails[1])
+
+		project, err := service.CreateProject(ownerCtx, console.ProjectInfo{Name: "audited"})
+		require.NoError(t, err)
+
+		_, err = service.AddProjectMembers(ownerCtx, project.ID, emails[1:], console.RoleAdmin)
+		require.NoError(t, err)
+
+		keyInfo, _, err := service.CreateAPIKey(adminCtx, project.ID, "audited key", console.APIKeyRestrictions{})
+		require.NoError(t, err)
+
+		err = service.DeleteAPIKeys(adminCtx, []uuid.UUID{keyInfo.ID})
+		require.NoError(t, err)
+
+		query := func(offset, limit int) string {
+			return fmt.Sprintf(
+				"query {project(id:\"%s\"){auditEvents(offset:%d, limit:%d){actorID, action, target, sourceIP, createdAt}}}",
+				project.ID.String(), offset, limit,
+			)
+		}
+
+		testQuery := func(t *testing.T, ctx context.Context, query string) *graphql.Result {
+			return graphql.Do(graphql.Params{
+				Schema:        schema,
+				Context:       ctx,
+				RequestString: query,
+				RootObject:    make(map[string]interface{}),
+			})
+		}
+
+		t.Run("Owner reads the audit log", func(t *testing.T) {
+			result := testQuery(t, ownerCtx, query(0, 10))
+			require.False(t, result.HasErrors(), result.Errors)
+
+			data := result.Data.(map[string]interface{})
+			events := data[consoleql.ProjectQuery].(map[string]interface{})[consoleql.FieldAuditEvents].([]interface{})
+
+			var actions []string
+			for _, event := range events {
+				event := event.(map[string]interface{})
+				actions = append(actions, event[consoleql.FieldAction].(string)+" "+event[consoleql.FieldTarget].(string))
+				assert.Equal(t, "10.0.0.1", event[consoleql.FieldSourceIP])
+			}
+
+			assert.Equal(t, []string{
+				"api_key_delete audited key",
+				"api_key_create audited key",
+				"project_member_add admin@email.com as admin",
+				"project_create audited",
+			}, actions)
+
+			result = testQuery(t, ownerCtx, query(1, 2))
+			require.False(t, result.HasErrors(), result.Errors)
+
+			data = result.Data.(map[string]interface{})
+			events = data[consoleql.ProjectQuery].(map[string]interface{})[consoleql.FieldAuditEvents].([]interface{})
+			require.Len(t, events, 2)
+			assert.Equal(t, "api_key_create", events[0].(map[string]interface{})[consoleql.FieldAction])
+		})
+
+		t.Run("Only the owner reads the audit log", func(t *testing.T) {
+			result := testQuery(t, adminCtx, query(0, 10))
+			require.True(t, result.HasErrors())
+
+			_, err := service.GetProjectAuditEvents(adminCtx, project.ID, 10, 0)
+			require.True(t, console.ErrProjectRole.Has(err))
+		})
+	})
+}
@@ -0,0 +1,82 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleql
+
+import (
+	"github.com/graphql-go/graphql"
+)
+
+const (
+	// InvoiceType is a graphql type name for project invoice
+	InvoiceType = "invoice"
+	// FieldInvoices is a field name for project invoices
+	FieldInvoices = "invoices"
+	// FieldPeriodStart is a field name for the start of the billing period
+	FieldPeriodStart = "periodStart"
+	// FieldPeriodEnd is a field name for the end of the billing period
+	FieldPeriodEnd = "periodEnd"
+	// FieldStorageAmount is a field name for storage charges in cents
+	FieldStorageAmount = "storageAmount"
+	// FieldEgressAmount is a field name for egress charges in cents
+	FieldEgressAmount = "egressAmount"
+	// FieldObjectAmount is a field name for object charges in cents
+	FieldObjectAmount = "objectAmount"
+	// FieldSegmentCount is a field name for the segments stored in segment-hours
+	FieldSegmentCount = "segmentCount"
+	// FieldSegmentAmount is a field name for segment charges in cents
+	FieldSegmentAmount = "segmentAmount"
+	// FieldTotal is a field name for total charges in cents
+	FieldTotal = "total"
+)
+
+// graphqlInvoice creates *graphql.Object type representation of console.Invoice
+func graphqlInvoice() *graphql.Object {
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name: InvoiceType,
+		Fields: graphql.Fields{
+			FieldID: &graphql.Field{
+				Type: graphql.String,
+			},
+			FieldProjectID: &graphql.Field{
+				Type: graphql.String,
+			},
+			FieldPeriodStart: &graphql.Field{
+				Type: graphql.DateTime,
+			},
+			FieldPeriodEnd: &graphql.Field{
+				Type: graphql.DateTime,
+			},
+			FieldStorage: &graphql.Field{
+				Type: graphql.Float,
+			},
+			FieldEgress: &graphql.Field{
+				Type: graphql.Float,
+			},
+			FieldObjectCount: &graphql.Field{
+				Type: graphql.Float,
+			},
+			FieldSegmentCount: &graphql.Field{
+				Type: graphql.Float,
+			},
+			FieldStorageAmount: &graphql.Field{
+				Type: graphql.Int,
+			},
+			FieldEgressAmount: &graphql.Field{
+				Type: graphql.Int,
+			},
+			FieldObjectAmount: &graphql.Field{
+				Type: graphql.Int,
+			},
+			FieldSegmentAmount: &graphql.Field{
+				Type: graphql.Int,
+			},
+			FieldTotal: &graphql.Field{
+				Type: graphql.Int,
+			},
+			FieldCreatedAt: &graphql.Field{
+				Type: graphql.DateTime,
+			},
+		},
+	})
+}
@@ -39,19 +39,3 @@ func (*ForgotPasswordEmail) Template() string { return "Forgot" }
 
 // Subject gets email subject
 func (*ForgotPasswordEmail) Subject() string { return "Password recovery request" }
-
-// ProjectInvitationEmail is mailservice template for project invitation email
-type ProjectInvitationEmail struct {
-	Origin      string
-	UserName    string
-	ProjectName string
-	SignInLink  string
-}
-
-// Template returns email template name
-func (*ProjectInvitationEmail) Template() string { return "Invite" }
-
-// Subject gets email subject
-func (email *ProjectInvitationEmail) Subject() string {
-	return "You were invited to join the Project " + email.ProjectName
-}
@@ -0,0 +1,227 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleql_test
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/graphql-go/graphql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/pkg/accounting"
+	"storj.io/storj/pkg/accounting/live"
+	"storj.io/storj/pkg/auth"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/console/consoleauth"
+	"storj.io/storj/satellite/console/consoleweb/consoleql"
+	"storj.io/storj/satellite/mailservice"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestGraphqlMFA(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		log := zaptest.NewLogger(t)
+
+		liveAccounting, err := live.New(log, live.Config{StorageBackend: "plainmemory:"})
+		require.NoError(t, err)
+
+		service, err := console.NewService(
+			log,
+			&consoleauth.Hmac{Secret: []byte("my-suppa-secret-key")},
+			db.Console(),
+			accounting.NewProjectUsage(db.ProjectAccounting(), liveAccounting, 25*memory.GB, 25*memory.GB),
+			console.TestPasswordCost,
+		)
+		require.NoError(t, err)
+
+		// passcodes are validated against a fixed clock
+		now := time.Unix(1560000000, 0)
+		service.SetNow(func() time.Time { return now })
+
+		mailService, err := mailservice.New(log, &discardSender{}, "testdata")
+		require.NoError(t, err)
+		defer ctx.Check(mailService.Close)
+
+		schema, err := consoleql.CreateSchema(log, service, mailService)
+		require.NoError(t, err)
+
+		createUser := console.CreateUser{
+			UserInfo: console.UserInfo{
+				FullName:  "John Roll",
+				ShortName: "Roll",
+				Email:     "mfa@email.com",
+			},
+			Password: "123a123",
+		}
+
+		regToken, err := service.CreateRegToken(ctx, 1)
+		require.NoError(t, err)
+
+		rootUser, err := service.CreateUser(ctx, createUser, regToken.Secret)
+		require.NoError(t, err)
+
+		activationToken, err := service.GenerateActivationToken(ctx, rootUser.ID, rootUser.Email)
+		require.NoError(t, err)
+
+		err = service.ActivateAccount(ctx, activationToken)
+		require.NoError(t, err)
+
+		authorize := func(t *testing.T, token string) context.Context {
+			sauth, err := service.Authorize(auth.WithAPIKey(ctx, []byte(token)))
+			require.NoError(t, err)
+
+			return console.WithAuth(ctx, sauth)
+		}
+
+		doQuery := func(ctx context.Context, query string) *graphql.Result {
+			return graphql.Do(graphql.Params{
+				Schema:        schema,
+				Context:       ctx,
+				RequestString: query,
+				RootObject:    make(map[string]interface{}),
+			})
+		}
+
+		testQuery := func(t *testing.T, ctx context.Context, query string) map[string]interface{} {
+			result := doQuery(ctx, query)
+			for _, err := range result.Errors {
+				assert.NoError(t, err)
+			}
+			require.False(t, result.HasErrors())
+
+			return result.Data.(map[string]interface{})
+		}
+
+		tokenQuery := func(extra string) string {
+			return fmt.Sprintf(
+				"query {token(email: \"%s\", password: \"%s\"%s) {token}}",
+				createUser.Email, createUser.Password, extra,
+			)
+		}
+
+		token, err := service.Token(ctx, createUser.Email, createUser.Password)
+		require.NoError(t, err)
+		authCtx := authorize(t, token)
+
+		var secret string
+		var recoveryCodes []string
+
+		t.Run("Enroll mutation", func(t *testing.T) {
+			data := testQuery(t, authCtx, "mutation {enrollMFA}")
+
+			uri, err := url.Parse(data[consoleql.EnrollMFAMutation].(string))
+			require.NoError(t, err)
+			assert.Equal(t, "otpauth", uri.Scheme)
+			assert.Equal(t, "totp", uri.Host)
+			assert.Equal(t, console.MFAIssuer, uri.Query().Get("issuer"))
+
+			secret = uri.Query().Get("secret")
+			require.NotEmpty(t, secret)
+
+			// enrolling doesn't require a passcode yet
+			_, err = service.Token(ctx, createUser.Email, createUser.Password)
+			require.NoError(t, err)
+		})
+
+		t.Run("Enable mutation with invalid passcode", func(t *testing.T) {
+			passcode, err := consoleauth.TOTPCode(secret, now.Add(-time.Hour))
+			require.NoError(t, err)
+
+			result := doQuery(authorize(t, token), fmt.Sprintf("mutation {enableMFA(passcode: \"%s\")}", passcode))
+			require.True(t, result.HasErrors())
+
+			user, err := service.GetUser(ctx, rootUser.ID)
+			require.NoError(t, err)
+			assert.False(t, user.MFAEnabled)
+		})
+
+		t.Run("Enable mutation", func(t *testing.T) {
+			passcode, err := consoleauth.TOTPCode(secret, now)
+			require.NoError(t, err)
+
+			data := testQuery(t, authorize(t, token), fmt.Sprintf("mutation {enableMFA(passcode: \"%s\")}", passcode))
+
+			for _, code := range data[consoleql.EnableMFAMutation].([]interface{}) {
+				recoveryCodes = append(recoveryCodes, code.(string))
+			}
+			require.Len(t, recoveryCodes, console.MFARecoveryCodeCount)
+
+			// only hashes of recovery codes are stored
+			user, err := service.GetUser(ctx, rootUser.ID)
+			require.NoError(t, err)
+			assert.True(t, user.MFAEnabled)
+			require.Len(t, user.MFARecoveryCodes, console.MFARecoveryCodeCount)
+			for _, code := range recoveryCodes {
+				assert.NotContains(t, user.MFARecoveryCodes, code)
+			}
+		})
+
+		t.Run("Token query requires second step", func(t *testing.T) {
+			_, err := service.Token(ctx, createUser.Email, createUser.Password)
+			assert.True(t, console.ErrMFAMissing.Has(err))
+
+			result := doQuery(ctx, tokenQuery(""))
+			require.True(t, result.HasErrors())
+
+			passcode, err := consoleauth.TOTPCode(secret, now.Add(-time.Hour))
+			require.NoError(t, err)
+
+			result = doQuery(ctx, tokenQuery(fmt.Sprintf(", passcode: \"%s\"", passcode)))
+			require.True(t, result.HasErrors())
+		})
+
+		t.Run("Token query with passcode", func(t *testing.T) {
+			passcode, err := consoleauth.TOTPCode(secret, now.Add(consoleauth.TOTPPeriod))
+			require.NoError(t, err)
+
+			data := testQuery(t, ctx, tokenQuery(fmt.Sprintf(", passcode: \"%s\"", passcode)))
+			token = data[consoleql.TokenQuery].(map[string]interface{})[consoleql.TokenType].(string)
+			require.NotEmpty(t, token)
+		})
+
+		t.Run("Token query with recovery code", func(t *testing.T) {
+			query := tokenQuery(fmt.Sprintf(", recoveryCode: \"%s\"", recoveryCodes[0]))
+
+			data := testQuery(t, ctx, query)
+			require.NotEmpty(t, data[consoleql.TokenQuery].(map[string]interface{})[consoleql.TokenType])
+
+			// recovery codes can be used only once
+			result := doQuery(ctx, query)
+			require.True(t, result.HasErrors())
+
+			user, err := service.GetUser(ctx, rootUser.ID)
+			require.NoError(t, err)
+			assert.Len(t, user.MFARecoveryCodes, console.MFARecoveryCodeCount-1)
+		})
+
+		t.Run("Disable mutation", func(t *testing.T) {
+			authCtx := authorize(t, token)
+
+			result := doQuery(authCtx, "mutation {disableMFA {mfaEnabled}}")
+			require.True(t, result.HasErrors())
+
+			passcode, err := consoleauth.TOTPCode(secret, now)
+			require.NoError(t, err)
+
+			data := testQuery(t, authCtx, fmt.Sprintf("mutation {disableMFA(passcode: \"%s\") {mfaEnabled}}", passcode))
+			user := data[consoleql.DisableMFAMutation].(map[string]interface{})
+			assert.Equal(t, false, user[consoleql.FieldMFAEnabled])
+
+			_, err = service.Token(ctx, createUser.Email, createUser.Password)
+			require.NoError(t, err)
+		})
+	})
+}
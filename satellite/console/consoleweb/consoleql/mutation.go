@@ -36,11 +36,27 @@ const (
 	AddProjectMembersMutation = "addProjectMembers"
 	// DeleteProjectMembersMutation is a mutation name for deleting project members
 	DeleteProjectMembersMutation = "deleteProjectMembers"
+	// UpdateProjectMemberRoleMutation is a mutation name for changing the role of project member
+	UpdateProjectMemberRoleMutation = "updateProjectMemberRole"
+	// TransferProjectOwnershipMutation is a mutation name for transferring the ownership of project
+	TransferProjectOwnershipMutation = "transferProjectOwnership"
 
 	// CreateAPIKeyMutation is a mutation name for api key creation
 	CreateAPIKeyMutation = "createAPIKey"
 	// DeleteAPIKeysMutation is a mutation name for api key deleting
 	DeleteAPIKeysMutation = "deleteAPIKeys"
+	// RevokeAPIKeyMutation is a mutation name for api key revocation
+	RevokeAPIKeyMutation = "revokeAPIKey"
+
+	// EnrollMFAMutation is a mutation name for starting the enrollment of two-factor authentication
+	EnrollMFAMutation = "enrollMFA"
+	// EnableMFAMutation is a mutation name for enabling two-factor authentication
+	EnableMFAMutation = "enableMFA"
+	// DisableMFAMutation is a mutation name for disabling two-factor authentication
+	DisableMFAMutation = "disableMFA"
+
+	// UpdateNotificationPreferencesMutation is a mutation name for changing notification preferences of account
+	UpdateNotificationPreferencesMutation = "updateNotificationPreferences"
 
 	// InputArg is argument name for all input types
 	InputArg = "input"
@@ -50,6 +66,10 @@ const (
 	FieldNewPassword = "newPassword"
 	// Secret is a field name for registration token for user creation during Vanguard release
 	Secret = "secret"
+	// FieldPasscode is a field name for two-factor authentication one-time passcode
+	FieldPasscode = "passcode"
+	// FieldRecoveryCode is a field name for two-factor authentication recovery code
+	FieldRecoveryCode = "recoveryCode"
 )
 
 // rootMutation creates mutation for graphql populated by AccountsClient
@@ -173,6 +193,82 @@ func rootMutation(log *zap.Logger, service *console.Service, mailService *mailse
 					return auth.User, nil
 				},
 			},
+			EnrollMFAMutation: &graphql.Field{
+				Type: graphql.String,
+				// generates a new secret key and returns the provisioning uri for authenticator apps
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return service.EnrollMFA(p.Context)
+				},
+			},
+			EnableMFAMutation: &graphql.Field{
+				Type: graphql.NewList(graphql.String),
+				Args: graphql.FieldConfigArgument{
+					FieldPasscode: &graphql.ArgumentConfig{
+						Type: graphql.NewNonNull(graphql.String),
+					},
+				},
+				// verifies passcode, enables two-factor authentication and returns recovery codes
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					passcode, _ := p.Args[FieldPasscode].(string)
+
+					return service.EnableMFA(p.Context, passcode)
+				},
+			},
+			DisableMFAMutation: &graphql.Field{
+				Type: types.user,
+				Args: graphql.FieldConfigArgument{
+					FieldPasscode: &graphql.ArgumentConfig{
+						Type: graphql.String,
+					},
+					FieldRecoveryCode: &graphql.ArgumentConfig{
+						Type: graphql.String,
+					},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					passcode, _ := p.Args[FieldPasscode].(string)
+					recoveryCode, _ := p.Args[FieldRecoveryCode].(string)
+
+					auth, err := console.GetAuth(p.Context)
+					if err != nil {
+						return nil, err
+					}
+
+					err = service.DisableMFA(p.Context, passcode, recoveryCode)
+					if err != nil {
+						return nil, err
+					}
+
+					user := auth.User
+					user.MFAEnabled = false
+					return user, nil
+				},
+			},
+			UpdateNotificationPreferencesMutation: &graphql.Field{
+				Type: types.notificationPrefs,
+				Args: graphql.FieldConfigArgument{
+					InputArg: &graphql.ArgumentConfig{
+						Type: graphql.NewNonNull(types.notificationPrefsInput),
+					},
+				},
+				// fields missing from the input keep their current value
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					input, _ := p.Args[InputArg].(map[string]interface{})
+
+					preferences, err := service.GetNotificationPreferences(p.Context)
+					if err != nil {
+						return nil, err
+					}
+
+					fillNotificationPreferences(preferences, input)
+
+					err = service.UpdateNotificationPreferences(p.Context, *preferences)
+					if err != nil {
+						return nil, err
+					}
+
+					return preferences, nil
+				},
+			},
 			DeleteAccountMutation: &graphql.Field{
 				Type: types.user,
 				Args: graphql.FieldConfigArgument{
@@ -270,16 +366,26 @@ func rootMutation(log *zap.Logger, service *console.Service, mailService *mailse
 					FieldEmail: &graphql.ArgumentConfig{
 						Type: graphql.NewNonNull(graphql.NewList(graphql.String)),
 					},
+					FieldRole: &graphql.ArgumentConfig{
+						Type:         graphql.String,
+						DefaultValue: console.RoleDeveloper.String(),
+					},
 				},
 				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
 					pID, _ := p.Args[FieldProjectID].(string)
 					emails, _ := p.Args[FieldEmail].([]interface{})
+					roleName, _ := p.Args[FieldRole].(string)
 
 					projectID, err := uuid.Parse(pID)
 					if err != nil {
 						return nil, err
 					}
 
+					role, err := console.ProjectMemberRoleFromString(roleName)
+					if err != nil {
+						return nil, err
+					}
+
 					var userEmails []string
 					for _, email := range emails {
 						userEmails = append(userEmails, email.(string))
@@ -290,33 +396,11 @@ func rootMutation(log *zap.Logger, service *console.Service, mailService *mailse
 						return nil, err
 					}
 
-					users, err := service.AddProjectMembers(p.Context, *projectID, userEmails)
+					_, err = service.AddProjectMembers(p.Context, *projectID, userEmails, role)
 					if err != nil {
 						return nil, err
 					}
 
-					rootObject := p.Info.RootValue.(map[string]interface{})
-					origin := rootObject["origin"].(string)
-					signIn := origin + rootObject[SignInPath].(string)
-
-					for _, user := range users {
-						userName := user.ShortName
-						if user.ShortName == "" {
-							userName = user.FullName
-						}
-
-						mailService.SendRenderedAsync(
-							p.Context,
-							[]post.Address{{Address: user.Email, Name: userName}},
-							&ProjectInvitationEmail{
-								Origin:      origin,
-								UserName:    userName,
-								ProjectName: project.Name,
-								SignInLink:  signIn,
-							},
-						)
-					}
-
 					return project, nil
 				},
 			},
@@ -353,6 +437,69 @@ func rootMutation(log *zap.Logger, service *console.Service, mailService *mailse
 					return service.GetProject(p.Context, *projectID)
 				},
 			},
+			UpdateProjectMemberRoleMutation: &graphql.Field{
+				Type: types.project,
+				Args: graphql.FieldConfigArgument{
+					FieldProjectID: &graphql.ArgumentConfig{
+						Type: graphql.NewNonNull(graphql.String),
+					},
+					FieldEmail: &graphql.ArgumentConfig{
+						Type: graphql.NewNonNull(graphql.String),
+					},
+					FieldRole: &graphql.ArgumentConfig{
+						Type: graphql.NewNonNull(graphql.String),
+					},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					pID, _ := p.Args[FieldProjectID].(string)
+					email, _ := p.Args[FieldEmail].(string)
+					roleName, _ := p.Args[FieldRole].(string)
+
+					projectID, err := uuid.Parse(pID)
+					if err != nil {
+						return nil, err
+					}
+
+					role, err := console.ProjectMemberRoleFromString(roleName)
+					if err != nil {
+						return nil, err
+					}
+
+					err = service.UpdateProjectMemberRole(p.Context, *projectID, email, role)
+					if err != nil {
+						return nil, err
+					}
+
+					return service.GetProject(p.Context, *projectID)
+				},
+			},
+			TransferProjectOwnershipMutation: &graphql.Field{
+				Type: types.project,
+				Args: graphql.FieldConfigArgument{
+					FieldProjectID: &graphql.ArgumentConfig{
+						Type: graphql.NewNonNull(graphql.String),
+					},
+					FieldEmail: &graphql.ArgumentConfig{
+						Type: graphql.NewNonNull(graphql.String),
+					},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					pID, _ := p.Args[FieldProjectID].(string)
+					email, _ := p.Args[FieldEmail].(string)
+
+					projectID, err := uuid.Parse(pID)
+					if err != nil {
+						return nil, err
+					}
+
+					err = service.TransferProjectOwnership(p.Context, *projectID, email)
+					if err != nil {
+						return nil, err
+					}
+
+					return service.GetProject(p.Context, *projectID)
+				},
+			},
 			// creates new api key
 			CreateAPIKeyMutation: &graphql.Field{
 				Type: types.createAPIKey,
@@ -363,27 +510,47 @@ func rootMutation(log *zap.Logger, service *console.Service, mailService *mailse
 					FieldName: &graphql.ArgumentConfig{
 						Type: graphql.NewNonNull(graphql.String),
 					},
+					FieldRestrictions: &graphql.ArgumentConfig{
+						Type: types.apiKeyRestrictionsInput,
+					},
 				},
 				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
 					projectID, _ := p.Args[FieldProjectID].(string)
 					name, _ := p.Args[FieldName].(string)
+					restrictionsArgs, _ := p.Args[FieldRestrictions].(map[string]interface{})
 
 					pID, err := uuid.Parse(projectID)
 					if err != nil {
 						return nil, err
 					}
 
-					info, key, err := service.CreateAPIKey(p.Context, *pID, name)
+					restrictions := fromMapAPIKeyRestrictions(restrictionsArgs)
+
+					info, key, err := service.CreateAPIKey(p.Context, *pID, name, restrictions)
 					if err != nil {
 						return nil, err
 					}
 
 					return createAPIKey{
-						Key:     key,
+						Key:     key.Serialize(),
 						KeyInfo: info,
 					}, nil
 				},
 			},
+			// revokes api key restricted from one of the project api keys
+			RevokeAPIKeyMutation: &graphql.Field{
+				Type: types.apiKeyInfo,
+				Args: graphql.FieldConfigArgument{
+					FieldKey: &graphql.ArgumentConfig{
+						Type: graphql.NewNonNull(graphql.String),
+					},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					key, _ := p.Args[FieldKey].(string)
+
+					return service.RevokeAPIKey(p.Context, key)
+				},
+			},
 			// deletes api key
 			DeleteAPIKeysMutation: &graphql.Field{
 				Type: graphql.NewList(types.apiKeyInfo),
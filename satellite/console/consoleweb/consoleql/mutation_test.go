@@ -15,9 +15,13 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap/zaptest"
 
+	"storj.io/storj/internal/memory"
 	"storj.io/storj/internal/post"
 	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/pkg/accounting"
+	"storj.io/storj/pkg/accounting/live"
 	"storj.io/storj/pkg/auth"
+	"storj.io/storj/pkg/macaroon"
 	"storj.io/storj/satellite"
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/console/consoleauth"
@@ -46,10 +50,14 @@ func TestGrapqhlMutation(t *testing.T) {
 
 		log := zaptest.NewLogger(t)
 
+		liveAccounting, err := live.New(log, live.Config{StorageBackend: "plainmemory:"})
+		require.NoError(t, err)
+
 		service, err := console.NewService(
 			log,
 			&consoleauth.Hmac{Secret: []byte("my-suppa-secret-key")},
 			db.Console(),
+			accounting.NewProjectUsage(db.ProjectAccounting(), liveAccounting, 25*memory.GB, 25*memory.GB),
 			console.TestPasswordCost,
 		)
 		require.NoError(t, err)
@@ -425,7 +433,7 @@ func TestGrapqhlMutation(t *testing.T) {
 			assert.Equal(t, rootUser.ID.String(), rootMember[consoleql.FieldID])
 		})
 
-		var keyID string
+		var keyID, serializedKey string
 		t.Run("Create api key mutation", func(t *testing.T) {
 			keyName := "key1"
 			query := fmt.Sprintf(
@@ -448,6 +456,78 @@ func TestGrapqhlMutation(t *testing.T) {
 			assert.Equal(t, project.ID.String(), keyInfo[consoleql.FieldProjectID])
 
 			keyID = keyInfo[consoleql.FieldID].(string)
+			serializedKey = key
+		})
+
+		t.Run("Revoke api key mutation", func(t *testing.T) {
+			key, err := macaroon.ParseAPIKey(serializedKey)
+			require.NoError(t, err)
+
+			restricted, err := key.Restrict(macaroon.Caveat{DisallowWrites: true})
+			require.NoError(t, err)
+
+			query := fmt.Sprintf(
+				"mutation {revokeAPIKey(key:\"%s\"){id,name}}",
+				restricted.Serialize(),
+			)
+
+			result := testQuery(t, query)
+			data := result.(map[string]interface{})
+			keyInfo := data[consoleql.RevokeAPIKeyMutation].(map[string]interface{})
+
+			assert.Equal(t, keyID, keyInfo[consoleql.FieldID])
+
+			id, err := uuid.Parse(keyID)
+			require.NoError(t, err)
+
+			revoked, err := db.Console().APIKeys().GetRevocations(ctx, *id)
+			require.NoError(t, err)
+			assert.Equal(t, [][]byte{restricted.Tail()}, revoked)
+		})
+
+		t.Run("Create restricted api key mutation", func(t *testing.T) {
+			query := fmt.Sprintf(
+				"mutation {createAPIKey(projectID:\"%s\",name:\"%s\",restrictions:{disallowWrites:true,allowedPaths:[{bucket:\"%s\",encryptedPathPrefix:\"%s\"}],notAfter:\"%s\"}){key,keyInfo{id,restrictions{disallowReads,disallowWrites,allowedPaths{bucket,encryptedPathPrefix},notAfter}}}}",
+				project.ID.String(),
+				"restricted key",
+				"bucket",
+				"prefix",
+				"2100-01-01T00:00:00Z",
+			)
+
+			result := testQuery(t, query)
+
+			data := result.(map[string]interface{})
+			createAPIKey := data[consoleql.CreateAPIKeyMutation].(map[string]interface{})
+			keyInfo := createAPIKey[consoleql.APIKeyInfoType].(map[string]interface{})
+			restrictions := keyInfo[consoleql.FieldRestrictions].(map[string]interface{})
+
+			assert.Equal(t, false, restrictions[consoleql.FieldDisallowReads])
+			assert.Equal(t, true, restrictions[consoleql.FieldDisallowWrites])
+			assert.Equal(t, "2100-01-01T00:00:00Z", restrictions[consoleql.FieldNotAfter])
+			assert.Equal(t, []interface{}{
+				map[string]interface{}{
+					consoleql.FieldBucket:              "bucket",
+					consoleql.FieldEncryptedPathPrefix: "prefix",
+				},
+			}, restrictions[consoleql.FieldAllowedPaths])
+
+			key, err := macaroon.ParseAPIKey(createAPIKey[consoleql.FieldKey].(string))
+			require.NoError(t, err)
+
+			id, err := uuid.Parse(keyInfo[consoleql.FieldID].(string))
+			require.NoError(t, err)
+
+			info, err := db.Console().APIKeys().Get(ctx, *id)
+			require.NoError(t, err)
+
+			now := time.Now()
+			err = key.Check(info.Secret, macaroon.Action{Op: macaroon.ActionRead, Bucket: []byte("bucket"), EncryptedPath: []byte("prefix/path"), Time: now}, nil)
+			assert.NoError(t, err)
+			err = key.Check(info.Secret, macaroon.Action{Op: macaroon.ActionWrite, Bucket: []byte("bucket"), EncryptedPath: []byte("prefix/path"), Time: now}, nil)
+			assert.True(t, macaroon.ErrUnauthorized.Has(err))
+			err = key.Check(info.Secret, macaroon.Action{Op: macaroon.ActionRead, Bucket: []byte("other"), Time: now}, nil)
+			assert.True(t, macaroon.ErrUnauthorized.Has(err))
 		})
 
 		t.Run("Delete api key mutation", func(t *testing.T) {
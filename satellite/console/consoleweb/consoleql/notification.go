@@ -0,0 +1,72 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleql
+
+import (
+	"github.com/graphql-go/graphql"
+
+	"storj.io/storj/satellite/console"
+)
+
+const (
+	// NotificationPreferencesType is a graphql type name for notification preferences
+	NotificationPreferencesType = "notificationPreferences"
+	// NotificationPreferencesInputType is a graphql type name for notification preferences input
+	NotificationPreferencesInputType = "notificationPreferencesInput"
+	// FieldUsageThresholds is a field name for enabling emails about projects reaching their usage limits
+	FieldUsageThresholds = "usageThresholds"
+	// FieldAPIKeyCreated is a field name for enabling emails about new api keys
+	FieldAPIKeyCreated = "apiKeyCreated"
+	// FieldProjectMemberAdded is a field name for enabling emails about being added to projects
+	FieldProjectMemberAdded = "projectMemberAdded"
+)
+
+// graphqlNotificationPreferences creates *graphql.Object type representation of console.NotificationPreferences
+func graphqlNotificationPreferences() *graphql.Object {
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name: NotificationPreferencesType,
+		Fields: graphql.Fields{
+			FieldUsageThresholds: &graphql.Field{
+				Type: graphql.Boolean,
+			},
+			FieldAPIKeyCreated: &graphql.Field{
+				Type: graphql.Boolean,
+			},
+			FieldProjectMemberAdded: &graphql.Field{
+				Type: graphql.Boolean,
+			},
+		},
+	})
+}
+
+// graphqlNotificationPreferencesInput creates graphql.InputObject type needed to update console.NotificationPreferences
+func graphqlNotificationPreferencesInput() *graphql.InputObject {
+	return graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: NotificationPreferencesInputType,
+		Fields: graphql.InputObjectConfigFieldMap{
+			FieldUsageThresholds: &graphql.InputObjectFieldConfig{
+				Type: graphql.Boolean,
+			},
+			FieldAPIKeyCreated: &graphql.InputObjectFieldConfig{
+				Type: graphql.Boolean,
+			},
+			FieldProjectMemberAdded: &graphql.InputObjectFieldConfig{
+				Type: graphql.Boolean,
+			},
+		},
+	})
+}
+
+// fillNotificationPreferences updates preferences with the fields present in input args
+func fillNotificationPreferences(preferences *console.NotificationPreferences, args map[string]interface{}) {
+	if value, ok := args[FieldUsageThresholds].(bool); ok {
+		preferences.UsageThresholds = value
+	}
+	if value, ok := args[FieldAPIKeyCreated].(bool); ok {
+		preferences.APIKeyCreated = value
+	}
+	if value, ok := args[FieldProjectMemberAdded].(bool); ok {
+		preferences.ProjectMemberAdded = value
+	}
+}
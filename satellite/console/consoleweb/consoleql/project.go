@@ -18,6 +18,8 @@ const (
 	ProjectInputType = "projectInput"
 	// ProjectUsageType is a graphql type name for project usage
 	ProjectUsageType = "projectUsage"
+	// ProjectUsageLimitsType is a graphql type name for project usage limits
+	ProjectUsageLimitsType = "projectUsageLimits"
 	// BucketUsageCursorInputType is a graphql input
 	// type name for bucket usage cursor
 	BucketUsageCursorInputType = "bucketUsageCursor"
@@ -37,6 +39,16 @@ const (
 	FieldAPIKeys = "apiKeys"
 	// FieldUsage is a field name for usage rollup
 	FieldUsage = "usage"
+	// FieldLimits is a field name for project usage limits
+	FieldLimits = "limits"
+	// FieldStorageLimit is a field name for storage limit
+	FieldStorageLimit = "storageLimit"
+	// FieldEgressLimit is a field name for egress limit
+	FieldEgressLimit = "egressLimit"
+	// FieldStorageUsed is a field name for storage used
+	FieldStorageUsed = "storageUsed"
+	// FieldEgressUsed is a field name for egress used
+	FieldEgressUsed = "egressUsed"
 	// FieldBucketUsages is a field name for bucket usages
 	FieldBucketUsages = "bucketUsages"
 	// FieldStorage is a field name for storage total
@@ -136,6 +148,7 @@ func graphqlProject(service *console.Service, types *TypeCreator) *graphql.Objec
 
 						users = append(users, projectMember{
 							User:     user,
+							Role:     member.Role,
 							JoinedAt: member.CreatedAt,
 						})
 					}
@@ -170,6 +183,41 @@ func graphqlProject(service *console.Service, types *TypeCreator) *graphql.Objec
 					return service.GetProjectUsage(p.Context, project.ID, since, before)
 				},
 			},
+			FieldLimits: &graphql.Field{
+				Type: types.projectLimits,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					project, _ := p.Source.(*console.Project)
+
+					return service.GetProjectUsageLimits(p.Context, project.ID)
+				},
+			},
+			FieldInvoices: &graphql.Field{
+				Type: graphql.NewList(types.invoice),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					project, _ := p.Source.(*console.Project)
+
+					return service.GetProjectInvoices(p.Context, project.ID)
+				},
+			},
+			FieldAuditEvents: &graphql.Field{
+				Type: graphql.NewList(types.auditEvent),
+				Args: graphql.FieldConfigArgument{
+					OffsetArg: &graphql.ArgumentConfig{
+						Type: graphql.NewNonNull(graphql.Int),
+					},
+					LimitArg: &graphql.ArgumentConfig{
+						Type: graphql.NewNonNull(graphql.Int),
+					},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					project, _ := p.Source.(*console.Project)
+
+					offs, _ := p.Args[OffsetArg].(int)
+					lim, _ := p.Args[LimitArg].(int)
+
+					return service.GetProjectAuditEvents(p.Context, project.ID, lim, int64(offs))
+				},
+			},
 			FieldBucketUsages: &graphql.Field{
 				Type: types.bucketUsagePage,
 				Args: graphql.FieldConfigArgument{
@@ -307,6 +355,43 @@ func graphqlProjectUsage() *graphql.Object {
 	})
 }
 
+// graphqlProjectUsageLimits creates project usage limits graphql type
+func graphqlProjectUsageLimits() *graphql.Object {
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name: ProjectUsageLimitsType,
+		Fields: graphql.Fields{
+			FieldStorageLimit: &graphql.Field{
+				Type: graphql.Float,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					limits, _ := p.Source.(*console.ProjectUsageLimits)
+					return limits.StorageLimit.Float64(), nil
+				},
+			},
+			FieldEgressLimit: &graphql.Field{
+				Type: graphql.Float,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					limits, _ := p.Source.(*console.ProjectUsageLimits)
+					return limits.EgressLimit.Float64(), nil
+				},
+			},
+			FieldStorageUsed: &graphql.Field{
+				Type: graphql.Float,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					limits, _ := p.Source.(*console.ProjectUsageLimits)
+					return limits.StorageUsed.Float64(), nil
+				},
+			},
+			FieldEgressUsed: &graphql.Field{
+				Type: graphql.Float,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					limits, _ := p.Source.(*console.ProjectUsageLimits)
+					return limits.EgressUsed.Float64(), nil
+				},
+			},
+		},
+	})
+}
+
 // fromMapProjectInfo creates console.ProjectInfo from input args
 func fromMapProjectInfo(args map[string]interface{}) (project console.ProjectInfo) {
 	project.Name, _ = args[FieldName].(string)
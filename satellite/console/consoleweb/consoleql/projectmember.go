@@ -16,6 +16,8 @@ const (
 	ProjectMemberType = "projectMember"
 	// FieldJoinedAt is a field name for joined at timestamp
 	FieldJoinedAt = "joinedAt"
+	// FieldRole is a field name for project member role
+	FieldRole = "role"
 )
 
 // graphqlProjectMember creates projectMember type
@@ -34,6 +36,13 @@ func graphqlProjectMember(service *console.Service, types *TypeCreator) *graphql
 			FieldJoinedAt: &graphql.Field{
 				Type: graphql.DateTime,
 			},
+			FieldRole: &graphql.Field{
+				Type: graphql.String,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					member, _ := p.Source.(projectMember)
+					return member.Role.String(), nil
+				},
+			},
 		},
 	})
 }
@@ -41,5 +50,6 @@ func graphqlProjectMember(service *console.Service, types *TypeCreator) *graphql
 // projectMember encapsulates User and joinedAt
 type projectMember struct {
 	User     *console.User
+	Role     console.ProjectMemberRole
 	JoinedAt time.Time
 }
@@ -0,0 +1,225 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleql_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/pkg/accounting"
+	"storj.io/storj/pkg/accounting/live"
+	"storj.io/storj/pkg/auth"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/console/consoleauth"
+	"storj.io/storj/satellite/console/consoleweb/consoleql"
+	"storj.io/storj/satellite/mailservice"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestGraphqlProjectMemberRoles(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		log := zaptest.NewLogger(t)
+
+		liveAccounting, err := live.New(log, live.Config{StorageBackend: "plainmemory:"})
+		require.NoError(t, err)
+
+		service, err := console.NewService(
+			log,
+			&consoleauth.Hmac{Secret: []byte("my-suppa-secret-key")},
+			db.Console(),
+			accounting.NewProjectUsage(db.ProjectAccounting(), liveAccounting, 25*memory.GB, 25*memory.GB),
+			console.TestPasswordCost,
+		)
+		require.NoError(t, err)
+
+		mailService, err := mailservice.New(log, &discardSender{}, "testdata")
+		require.NoError(t, err)
+		defer ctx.Check(mailService.Close)
+
+		schema, err := consoleql.CreateSchema(log, service, mailService)
+		require.NoError(t, err)
+
+		rootObject := make(map[string]interface{})
+		rootObject["origin"] = "http://doesntmatter.com/"
+		rootObject[consoleql.SignInPath] = "login"
+
+		// authorize returns a context, in which user with email is authorized
+		authorize := func(t *testing.T, email string) context.Context {
+			token, err := service.Token(ctx, email, "123a123")
+			require.NoError(t, err)
+
+			sauth, err := service.Authorize(auth.WithAPIKey(ctx, []byte(token)))
+			require.NoError(t, err)
+
+			return console.WithAuth(ctx, sauth)
+		}
+
+		emails := []string{"owner@email.com", "admin@email.com", "dev@email.com", "viewer@email.com"}
+		for _, email := range emails {
+			regToken, err := service.CreateRegToken(ctx, 1)
+			require.NoError(t, err)
+
+			user, err := service.CreateUser(ctx, console.CreateUser{
+				UserInfo: console.UserInfo{FullName: email, Email: email},
+				Password: "123a123",
+			}, regToken.Secret)
+			require.NoError(t, err)
+
+			activationToken, err := service.GenerateActivationToken(ctx, user.ID, user.Email)
+			require.NoError(t, err)
+
+			err = service.ActivateAccount(ctx, activationToken)
+			require.NoError(t, err)
+		}
+
+		ownerCtx := authorize(t, emails[0])
+		adminCtx := authorize(t, emails[1])
+		devCtx := authorize(t, emails[2])
+		viewerCtx := authorize(t, emails[3])
+
+		project, err := service.CreateProject(ownerCtx, console.ProjectInfo{Name: "roles"})
+		require.NoError(t, err)
+
+		_, err = service.AddProjectMembers(ownerCtx, project.ID, emails[1:2], console.RoleAdmin)
+		require.NoError(t, err)
+
+		// admins can't add other admins
+		_, err = service.AddProjectMembers(adminCtx, project.ID, emails[2:3], console.RoleAdmin)
+		require.True(t, console.ErrProjectRole.Has(err))
+
+		_, err = service.AddProjectMembers(adminCtx, project.ID, emails[2:3], console.RoleDeveloper)
+		require.NoError(t, err)
+		_, err = service.AddProjectMembers(adminCtx, project.ID, emails[3:4], console.RoleViewer)
+		require.NoError(t, err)
+
+		testQuery := func(t *testing.T, ctx context.Context, query string) *graphql.Result {
+			return graphql.Do(graphql.Params{
+				Schema:        schema,
+				Context:       ctx,
+				RequestString: query,
+				RootObject:    rootObject,
+			})
+		}
+
+		roles := func(t *testing.T) map[string]string {
+			result := testQuery(t, ownerCtx, fmt.Sprintf(
+				"query {project(id:\"%s\"){members(offset:0, limit:50){role, user{email}}}}",
+				project.ID.String(),
+			))
+			require.False(t, result.HasErrors(), result.Errors)
+
+			data := result.Data.(map[string]interface{})
+			members := data[consoleql.ProjectQuery].(map[string]interface{})[consoleql.FieldMembers].([]interface{})
+
+			roles := make(map[string]string)
+			for _, member := range members {
+				member := member.(map[string]interface{})
+				user := member[consoleql.UserType].(map[string]interface{})
+				roles[user[consoleql.FieldEmail].(string)] = member[consoleql.FieldRole].(string)
+			}
+			return roles
+		}
+
+		t.Run("Members query returns roles", func(t *testing.T) {
+			assert.Equal(t, map[string]string{
+				emails[0]: "owner",
+				emails[1]: "admin",
+				emails[2]: "developer",
+				emails[3]: "viewer",
+			}, roles(t))
+		})
+
+		t.Run("Roles are enforced", func(t *testing.T) {
+			_, err := service.GetProject(viewerCtx, project.ID)
+			require.NoError(t, err)
+
+			_, _, err = service.CreateAPIKey(viewerCtx, project.ID, "viewer key", console.APIKeyRestrictions{})
+			require.True(t, console.ErrProjectRole.Has(err))
+
+			_, _, err = service.CreateAPIKey(devCtx, project.ID, "dev key", console.APIKeyRestrictions{})
+			require.NoError(t, err)
+
+			_, err = service.UpdateProject(devCtx, project.ID, "description")
+			require.True(t, console.ErrProjectRole.Has(err))
+
+			_, err = service.UpdateProject(adminCtx, project.ID, "description")
+			require.NoError(t, err)
+
+			err = service.DeleteProjectMembers(devCtx, project.ID, emails[3:4])
+			require.True(t, console.ErrProjectRole.Has(err))
+
+			err = service.DeleteProjectMembers(adminCtx, project.ID, emails[0:1])
+			require.True(t, console.ErrProjectRole.Has(err))
+
+			err = service.DeleteProject(adminCtx, project.ID)
+			require.True(t, console.ErrProjectRole.Has(err))
+		})
+
+		t.Run("Update role mutation", func(t *testing.T) {
+			query := fmt.Sprintf(
+				"mutation {updateProjectMemberRole(projectID:\"%s\", email:\"%s\", role:\"%s\"){id}}",
+				project.ID.String(), emails[3], "developer",
+			)
+
+			result := testQuery(t, devCtx, query)
+			require.True(t, result.HasErrors())
+
+			result = testQuery(t, adminCtx, query)
+			require.False(t, result.HasErrors(), result.Errors)
+			assert.Equal(t, "developer", roles(t)[emails[3]])
+
+			// only the owner can manage admins
+			result = testQuery(t, adminCtx, fmt.Sprintf(
+				"mutation {updateProjectMemberRole(projectID:\"%s\", email:\"%s\", role:\"%s\"){id}}",
+				project.ID.String(), emails[3], "admin",
+			))
+			require.True(t, result.HasErrors())
+
+			// the owner can only be changed by transferring ownership
+			result = testQuery(t, ownerCtx, fmt.Sprintf(
+				"mutation {updateProjectMemberRole(projectID:\"%s\", email:\"%s\", role:\"%s\"){id}}",
+				project.ID.String(), emails[3], "owner",
+			))
+			require.True(t, result.HasErrors())
+		})
+
+		t.Run("Transfer ownership mutation", func(t *testing.T) {
+			query := fmt.Sprintf(
+				"mutation {transferProjectOwnership(projectID:\"%s\", email:\"%s\"){id}}",
+				project.ID.String(), emails[1],
+			)
+
+			result := testQuery(t, adminCtx, query)
+			require.True(t, result.HasErrors())
+
+			result = testQuery(t, ownerCtx, query)
+			require.False(t, result.HasErrors(), result.Errors)
+
+			assert.Equal(t, map[string]string{
+				emails[0]: "admin",
+				emails[1]: "owner",
+				emails[2]: "developer",
+				emails[3]: "developer",
+			}, roles(t))
+
+			err = service.DeleteProject(ownerCtx, project.ID)
+			require.True(t, console.ErrProjectRole.Has(err))
+
+			err = service.DeleteProject(adminCtx, project.ID)
+			require.NoError(t, err)
+		})
+	})
+}
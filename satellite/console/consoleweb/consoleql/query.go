@@ -30,6 +30,8 @@ const (
 	ForgotPasswordQuery = "forgotPassword"
 	// ResendAccountActivationEmailQuery is a query name for password recovery request
 	ResendAccountActivationEmailQuery = "resendAccountActivationEmail"
+	// NotificationPreferencesQuery is a query name for notification preferences of account
+	NotificationPreferencesQuery = "notificationPreferences"
 )
 
 // rootQuery creates query for graphql populated by AccountsClient
@@ -90,12 +92,20 @@ func rootQuery(service *console.Service, mailService *mailservice.Service, types
 					FieldPassword: &graphql.ArgumentConfig{
 						Type: graphql.NewNonNull(graphql.String),
 					},
+					FieldPasscode: &graphql.ArgumentConfig{
+						Type: graphql.String,
+					},
+					FieldRecoveryCode: &graphql.ArgumentConfig{
+						Type: graphql.String,
+					},
 				},
 				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
 					email, _ := p.Args[FieldEmail].(string)
 					pass, _ := p.Args[FieldPassword].(string)
+					passcode, _ := p.Args[FieldPasscode].(string)
+					recoveryCode, _ := p.Args[FieldRecoveryCode].(string)
 
-					token, err := service.Token(p.Context, email, pass)
+					token, err := service.TokenWithMFA(p.Context, email, pass, passcode, recoveryCode)
 					if err != nil {
 						return nil, err
 					}
@@ -192,6 +202,12 @@ func rootQuery(service *console.Service, mailService *mailservice.Service, types
 					return true, nil
 				},
 			},
+			NotificationPreferencesQuery: &graphql.Field{
+				Type: types.notificationPrefs,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return service.GetNotificationPreferences(p.Context)
+				},
+			},
 		},
 	})
 }
@@ -13,7 +13,10 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap/zaptest"
 
+	"storj.io/storj/internal/memory"
 	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/pkg/accounting"
+	"storj.io/storj/pkg/accounting/live"
 	"storj.io/storj/pkg/auth"
 	"storj.io/storj/satellite"
 	"storj.io/storj/satellite/console"
@@ -30,10 +33,14 @@ func TestGraphqlQuery(t *testing.T) {
 
 		log := zaptest.NewLogger(t)
 
+		liveAccounting, err := live.New(log, live.Config{StorageBackend: "plainmemory:"})
+		require.NoError(t, err)
+
 		service, err := console.NewService(
 			log,
 			&consoleauth.Hmac{Secret: []byte("my-suppa-secret-key")},
 			db.Console(),
+			accounting.NewProjectUsage(db.ProjectAccounting(), liveAccounting, 25*memory.GB, 25*memory.GB),
 			console.TestPasswordCost,
 		)
 		require.NoError(t, err)
@@ -229,7 +236,7 @@ func TestGraphqlQuery(t *testing.T) {
 		users, err := service.AddProjectMembers(authCtx, createdProject.ID, []string{
 			user1.Email,
 			user2.Email,
-		})
+		}, console.RoleDeveloper)
 		require.NoError(t, err)
 		assert.Equal(t, 2, len(users))
 
@@ -284,10 +291,10 @@ func TestGraphqlQuery(t *testing.T) {
 			assert.True(t, foundU2)
 		})
 
-		keyInfo1, _, err := service.CreateAPIKey(authCtx, createdProject.ID, "key1")
+		keyInfo1, _, err := service.CreateAPIKey(authCtx, createdProject.ID, "key1", console.APIKeyRestrictions{})
 		require.NoError(t, err)
 
-		keyInfo2, _, err := service.CreateAPIKey(authCtx, createdProject.ID, "key2")
+		keyInfo2, _, err := service.CreateAPIKey(authCtx, createdProject.ID, "key2", console.APIKeyRestrictions{})
 		require.NoError(t, err)
 
 		t.Run("Project query api keys", func(t *testing.T) {
@@ -18,18 +18,25 @@ type TypeCreator struct {
 
 	token *graphql.Object
 
-	user            *graphql.Object
-	project         *graphql.Object
-	projectUsage    *graphql.Object
-	bucketUsage     *graphql.Object
-	bucketUsagePage *graphql.Object
-	projectMember   *graphql.Object
-	apiKeyInfo      *graphql.Object
-	createAPIKey    *graphql.Object
-
-	userInput         *graphql.InputObject
-	projectInput      *graphql.InputObject
-	bucketUsageCursor *graphql.InputObject
+	user               *graphql.Object
+	project            *graphql.Object
+	projectUsage       *graphql.Object
+	projectLimits      *graphql.Object
+	invoice            *graphql.Object
+	auditEvent         *graphql.Object
+	notificationPrefs  *graphql.Object
+	bucketUsage        *graphql.Object
+	bucketUsagePage    *graphql.Object
+	projectMember      *graphql.Object
+	apiKeyInfo         *graphql.Object
+	apiKeyRestrictions *graphql.Object
+	createAPIKey       *graphql.Object
+
+	userInput               *graphql.InputObject
+	projectInput            *graphql.InputObject
+	bucketUsageCursor       *graphql.InputObject
+	apiKeyRestrictionsInput *graphql.InputObject
+	notificationPrefsInput  *graphql.InputObject
 }
 
 // Create create types and check for error
@@ -50,6 +57,16 @@ func (c *TypeCreator) Create(log *zap.Logger, service *console.Service, mailServ
 		return err
 	}
 
+	c.apiKeyRestrictionsInput = graphqlAPIKeyRestrictionsInput()
+	if err := c.apiKeyRestrictionsInput.Error(); err != nil {
+		return err
+	}
+
+	c.notificationPrefsInput = graphqlNotificationPreferencesInput()
+	if err := c.notificationPrefsInput.Error(); err != nil {
+		return err
+	}
+
 	// entities
 	c.user = graphqlUser()
 	if err := c.user.Error(); err != nil {
@@ -61,6 +78,26 @@ func (c *TypeCreator) Create(log *zap.Logger, service *console.Service, mailServ
 		return err
 	}
 
+	c.projectLimits = graphqlProjectUsageLimits()
+	if err := c.projectLimits.Error(); err != nil {
+		return err
+	}
+
+	c.invoice = graphqlInvoice()
+	if err := c.invoice.Error(); err != nil {
+		return err
+	}
+
+	c.auditEvent = graphqlAuditEvent()
+	if err := c.auditEvent.Error(); err != nil {
+		return err
+	}
+
+	c.notificationPrefs = graphqlNotificationPreferences()
+	if err := c.notificationPrefs.Error(); err != nil {
+		return err
+	}
+
 	c.bucketUsage = graphqlBucketUsage()
 	if err := c.bucketUsage.Error(); err != nil {
 		return err
@@ -71,7 +108,12 @@ func (c *TypeCreator) Create(log *zap.Logger, service *console.Service, mailServ
 		return err
 	}
 
-	c.apiKeyInfo = graphqlAPIKeyInfo()
+	c.apiKeyRestrictions = graphqlAPIKeyRestrictions()
+	if err := c.apiKeyRestrictions.Error(); err != nil {
+		return err
+	}
+
+	c.apiKeyInfo = graphqlAPIKeyInfo(c)
 	if err := c.apiKeyInfo.Error(); err != nil {
 		return err
 	}
@@ -26,6 +26,8 @@ const (
 	FieldShortName = "shortName"
 	// FieldCreatedAt is a field name for created at timestamp
 	FieldCreatedAt = "createdAt"
+	// FieldMFAEnabled is a field name for whether two-factor authentication is enabled
+	FieldMFAEnabled = "mfaEnabled"
 )
 
 // base graphql config for user
@@ -48,6 +50,9 @@ func baseUserConfig() graphql.ObjectConfig {
 			FieldCreatedAt: &graphql.Field{
 				Type: graphql.DateTime,
 			},
+			FieldMFAEnabled: &graphql.Field{
+				Type: graphql.Boolean,
+			},
 		},
 	}
 }
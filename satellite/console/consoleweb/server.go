@@ -23,6 +23,8 @@ import (
 
 	"storj.io/storj/pkg/auth"
 	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/console/consoleweb/consoleapi"
+	"storj.io/storj/satellite/console/consoleweb/consoleoidc"
 	"storj.io/storj/satellite/console/consoleweb/consoleql"
 	"storj.io/storj/satellite/mailservice"
 )
@@ -35,6 +37,8 @@ const (
 
 	applicationJSON    = "application/json"
 	applicationGraphql = "application/graphql"
+
+	signInPath = "login"
 )
 
 // Error is satellite console error type
@@ -50,6 +54,9 @@ type Config struct {
 	AuthToken string `help:"auth token needed for access to registration token creation endpoint" default:""`
 
 	PasswordCost int `internal:"true" help:"password hashing cost (0=automatic)" default:"0"`
+
+	AuditLog console.AuditLogConfig
+	OIDC     consoleoidc.Config
 }
 
 // Server represents console web server
@@ -67,7 +74,12 @@ type Server struct {
 }
 
 // NewServer creates new instance of console server
-func NewServer(logger *zap.Logger, config Config, service *console.Service, mailService *mailservice.Service, listener net.Listener) *Server {
+func NewServer(logger *zap.Logger, config Config, service *console.Service, mailService *mailservice.Service, listener net.Listener) (*Server, error) {
+	// the identity provider has to redirect the user back to the address, which the user can reach
+	if config.OIDC.Issuer != "" && config.ExternalAddress == "" {
+		return nil, Error.New("single sign-on requires the external address of the console")
+	}
+
 	server := Server{
 		log:         logger,
 		config:      config,
@@ -90,6 +102,11 @@ func NewServer(logger *zap.Logger, config Config, service *console.Service, mail
 	fs := http.FileServer(http.Dir(server.config.StaticDir))
 
 	mux.Handle("/api/graphql/v0", http.HandlerFunc(server.grapqlHandler))
+	mux.Handle(consoleapi.Prefix+"/", WithCredentials(consoleapi.NewAPI(logger.Named("api"), service)))
+
+	if server.config.OIDC.Issuer != "" {
+		mux.Handle("/sso/", consoleoidc.NewHandler(logger.Named("sso"), server.config.OIDC, service, server.config.ExternalAddress))
+	}
 
 	if server.config.StaticDir != "" {
 		mux.Handle("/activation/", http.HandlerFunc(server.accountActivationHandler))
@@ -105,7 +122,7 @@ func NewServer(logger *zap.Logger, config Config, service *console.Service, mail
 		Handler: mux,
 	}
 
-	return &server
+	return &server, nil
 }
 
 // appHandler is web app http handler function
@@ -307,6 +324,7 @@ func (s *Server) grapqlHandler(w http.ResponseWriter, req *http.Request) {
 	}
 
 	ctx := auth.WithAPIKey(context.Background(), []byte(token))
+	ctx = console.WithSourceIP(ctx, getSourceIP(req))
 	auth, err := s.service.Authorize(ctx)
 	if err != nil {
 		ctx = console.WithAuthFailure(ctx, err)
@@ -320,7 +338,7 @@ func (s *Server) grapqlHandler(w http.ResponseWriter, req *http.Request) {
 	rootObject[consoleql.ActivationPath] = "activation/?token="
 	rootObject[consoleql.PasswordRecoveryPath] = "password-recovery/?token="
 	rootObject[consoleql.CancelPasswordRecoveryPath] = "cancel-password-recovery/?token="
-	rootObject[consoleql.SignInPath] = "login"
+	rootObject[consoleql.SignInPath] = signInPath
 
 	result := graphql.Do(graphql.Params{
 		Schema:         s.schema,
@@ -368,3 +386,8 @@ func (s *Server) Run(ctx context.Context) error {
 func (s *Server) Close() error {
 	return s.server.Close()
 }
+
+// SignInLink returns the address of the sign in page of the console
+func (s *Server) SignInLink() string {
+	return s.config.ExternalAddress + signInPath
+}
@@ -6,11 +6,14 @@ package consoleweb
 import (
 	"encoding/json"
 	"io/ioutil"
+	"net"
 	"net/http"
 	"strings"
 
 	"github.com/zeebo/errs"
 
+	"storj.io/storj/pkg/auth"
+	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/console/consoleweb/consoleql"
 )
 
@@ -35,6 +38,25 @@ func getToken(req *http.Request) string {
 	return value[len(authorizationBearer):]
 }
 
+// getSourceIP retrieves the address of the client from request
+func getSourceIP(req *http.Request) string {
+	host, _, err := net.SplitHostPort(req.RemoteAddr)
+	if err != nil {
+		return req.RemoteAddr
+	}
+
+	return host
+}
+
+// WithCredentials adds the token and the address of the client to the context of requests served by handler
+func WithCredentials(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		ctx := auth.WithAPIKey(req.Context(), []byte(getToken(req)))
+		ctx = console.WithSourceIP(ctx, getSourceIP(req))
+		handler.ServeHTTP(w, req.WithContext(ctx))
+	})
+}
+
 // getQuery retrieves graphql query from request
 func getQuery(req *http.Request) (query graphqlJSON, err error) {
 	switch req.Method {
@@ -27,6 +27,12 @@ type DB interface {
 	ResetPasswordTokens() ResetPasswordTokens
 	// UsageRollups is a getter for UsageRollups repository
 	UsageRollups() UsageRollups
+	// Invoices is a getter for Invoices repository
+	Invoices() Invoices
+	// AuditEvents is a getter for AuditEvents repository
+	AuditEvents() AuditEvents
+	// Notifications is a getter for Notifications repository
+	Notifications() Notifications
 
 	// BeginTransaction is a method for opening transaction
 	BeginTx(ctx context.Context) (DBTx, error)
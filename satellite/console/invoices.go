@@ -0,0 +1,53 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console
+
+import (
+	"context"
+	"time"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+)
+
+// Invoices exposes methods to manage project invoices in the database.
+type Invoices interface {
+	// Create is a method for inserting invoice into the database.
+	Create(ctx context.Context, invoice Invoice) (*Invoice, error)
+	// Get is a method for querying invoice from the database by id.
+	Get(ctx context.Context, id uuid.UUID) (*Invoice, error)
+	// GetByProjectID is a method for querying all invoices of a project, newest first.
+	GetByProjectID(ctx context.Context, projectID uuid.UUID) ([]Invoice, error)
+	// GetByProjectIDAndPeriod is a method for querying the invoice of a project for the billing period
+	// starting at periodStart, it returns nil when the invoice does not exist.
+	GetByProjectIDAndPeriod(ctx context.Context, projectID uuid.UUID, periodStart time.Time) (*Invoice, error)
+	// GetByPeriod is a method for querying the invoices of all projects for the billing period starting at periodStart.
+	GetByPeriod(ctx context.Context, periodStart time.Time) ([]Invoice, error)
+}
+
+// Invoice describes the charges for the usage of a project during a billing period.
+type Invoice struct {
+	ID        uuid.UUID `json:"id"`
+	ProjectID uuid.UUID `json:"projectId"`
+
+	PeriodStart time.Time `json:"periodStart"`
+	PeriodEnd   time.Time `json:"periodEnd"`
+
+	// Storage is the data stored during the period in GB-hours
+	Storage float64 `json:"storage"`
+	// Egress is the data downloaded during the period in GB
+	Egress float64 `json:"egress"`
+	// ObjectCount is the number of objects stored during the period in object-hours
+	ObjectCount float64 `json:"objectCount"`
+	// SegmentCount is the number of segments stored during the period in segment-hours
+	SegmentCount float64 `json:"segmentCount"`
+
+	// charges in cents
+	StorageAmount int64 `json:"storageAmount"`
+	EgressAmount  int64 `json:"egressAmount"`
+	ObjectAmount  int64 `json:"objectAmount"`
+	SegmentAmount int64 `json:"segmentAmount"`
+	Total         int64 `json:"total"`
+
+	CreatedAt time.Time `json:"createdAt"`
+}
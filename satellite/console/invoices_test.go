@@ -0,0 +1,105 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestInvoicesRepository(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		projects := db.Console().Projects()
+		invoices := db.Console().Invoices()
+
+		project, err := projects.Insert(ctx, &console.Project{Name: "invoiced"})
+		require.NoError(t, err)
+
+		april := time.Date(2019, 4, 1, 0, 0, 0, 0, time.UTC)
+		may := time.Date(2019, 5, 1, 0, 0, 0, 0, time.UTC)
+		june := time.Date(2019, 6, 1, 0, 0, 0, 0, time.UTC)
+
+		var aprilInvoice *console.Invoice
+
+		t.Run("Create invoices", func(t *testing.T) {
+			aprilInvoice, err = invoices.Create(ctx, console.Invoice{
+				ProjectID:     project.ID,
+				PeriodStart:   april,
+				PeriodEnd:     may,
+				Storage:       7200,
+				Egress:        100,
+				ObjectCount:   1440,
+				StorageAmount: 10,
+				EgressAmount:  450,
+				ObjectAmount:  1,
+				Total:         461,
+			})
+			require.NoError(t, err)
+			require.NotNil(t, aprilInvoice)
+			assert.Equal(t, project.ID, aprilInvoice.ProjectID)
+			assert.Equal(t, int64(461), aprilInvoice.Total)
+
+			_, err = invoices.Create(ctx, console.Invoice{
+				ProjectID:   project.ID,
+				PeriodStart: may,
+				PeriodEnd:   june,
+			})
+			require.NoError(t, err)
+		})
+
+		t.Run("Duplicate period fails", func(t *testing.T) {
+			_, err := invoices.Create(ctx, console.Invoice{
+				ProjectID:   project.ID,
+				PeriodStart: april,
+				PeriodEnd:   may,
+			})
+			assert.Error(t, err)
+		})
+
+		t.Run("Get invoice", func(t *testing.T) {
+			invoice, err := invoices.Get(ctx, aprilInvoice.ID)
+			require.NoError(t, err)
+			assert.Equal(t, aprilInvoice.ID, invoice.ID)
+			assert.Equal(t, 7200.0, invoice.Storage)
+			assert.Equal(t, int64(450), invoice.EgressAmount)
+		})
+
+		t.Run("Get by project and period", func(t *testing.T) {
+			invoice, err := invoices.GetByProjectIDAndPeriod(ctx, project.ID, april)
+			require.NoError(t, err)
+			require.NotNil(t, invoice)
+			assert.Equal(t, aprilInvoice.ID, invoice.ID)
+
+			invoice, err = invoices.GetByProjectIDAndPeriod(ctx, project.ID, june)
+			require.NoError(t, err)
+			assert.Nil(t, invoice)
+		})
+
+		t.Run("Get by project newest first", func(t *testing.T) {
+			list, err := invoices.GetByProjectID(ctx, project.ID)
+			require.NoError(t, err)
+			require.Len(t, list, 2)
+			assert.True(t, list[0].PeriodStart.Equal(may))
+			assert.True(t, list[1].PeriodStart.Equal(april))
+		})
+
+		t.Run("Get by period", func(t *testing.T) {
+			list, err := invoices.GetByPeriod(ctx, april)
+			require.NoError(t, err)
+			require.Len(t, list, 1)
+			assert.Equal(t, aprilInvoice.ID, list[0].ID)
+		})
+	})
+}
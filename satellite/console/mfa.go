@@ -0,0 +1,82 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/hex"
+	"strings"
+
+	"github.com/zeebo/errs"
+)
+
+const (
+	// MFAIssuer is the issuer shown by authenticator apps
+	MFAIssuer = "Storj Satellite"
+	// MFARecoveryCodeCount is the number of recovery codes generated when enabling two-factor authentication
+	MFARecoveryCodeCount = 10
+
+	// mfaRecoveryCodeSize is the number of random bytes in a recovery code
+	mfaRecoveryCodeSize = 5
+)
+
+var (
+	// ErrMFAMissing is error class for login attempts without a passcode or recovery code for an account with two-factor authentication
+	ErrMFAMissing = errs.Class("two-factor authentication credentials missing")
+	// ErrMFAPasscode is error class for invalid passcodes and recovery codes
+	ErrMFAPasscode = errs.Class("two-factor authentication passcode invalid")
+)
+
+// MFA error messages
+const (
+	mfaMissingErrMsg          = "Please enter the passcode from your authenticator app or a recovery code"
+	mfaPasscodeErrMsg         = "The passcode or recovery code is incorrect, please try again"
+	mfaAlreadyEnabledErrMsg   = "Two-factor authentication is already enabled"
+	mfaNotEnabledErrMsg       = "Two-factor authentication is not enabled"
+	mfaNotEnrolledErrMsg      = "Please start the enrollment of two-factor authentication first"
+	mfaConflictingCodesErrMsg = "Please enter either a passcode or a recovery code"
+)
+
+// newMFARecoveryCodes generates new recovery codes and returns them together with their hashes
+func newMFARecoveryCodes() (codes []string, hashes []string, err error) {
+	for i := 0; i < MFARecoveryCodeCount; i++ {
+		code := make([]byte, mfaRecoveryCodeSize)
+
+		_, err = rand.Read(code)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		encoded := base32.StdEncoding.EncodeToString(code)
+		codes = append(codes, encoded)
+		hashes = append(hashes, hashMFARecoveryCode(encoded))
+	}
+
+	return codes, hashes, nil
+}
+
+// hashMFARecoveryCode returns the hash of recovery code, under which it is stored
+func hashMFARecoveryCode(code string) string {
+	code = strings.ToUpper(strings.TrimSpace(code))
+
+	hash := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(hash[:])
+}
+
+// useMFARecoveryCode removes code from the user's recovery codes, it returns false when code doesn't match any of them
+func useMFARecoveryCode(user *User, code string) bool {
+	hash := hashMFARecoveryCode(code)
+
+	for i, stored := range user.MFARecoveryCodes {
+		if subtle.ConstantTimeCompare([]byte(stored), []byte(hash)) == 1 {
+			user.MFARecoveryCodes = append(user.MFARecoveryCodes[:i:i], user.MFARecoveryCodes[i+1:]...)
+			return true
+		}
+	}
+
+	return false
+}
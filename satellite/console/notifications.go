@@ -0,0 +1,69 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console
+
+import (
+	"context"
+	"time"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+)
+
+// Notifications exposes methods to manage notification preferences of users
+// and the usage notifications sent for projects.
+type Notifications interface {
+	// GetPreferences is a method for querying notification preferences of a user, defaults are returned when the user hasn't changed them.
+	GetPreferences(ctx context.Context, userID uuid.UUID) (*NotificationPreferences, error)
+	// UpdatePreferences is a method for storing notification preferences of a user.
+	UpdatePreferences(ctx context.Context, userID uuid.UUID, preferences NotificationPreferences) error
+	// HasUsageNotification is a method for checking whether the usage notification was already sent.
+	HasUsageNotification(ctx context.Context, notification UsageNotification) (bool, error)
+	// InsertUsageNotification is a method for recording that the usage notification was sent.
+	InsertUsageNotification(ctx context.Context, notification UsageNotification) error
+}
+
+// NotificationPreferences describes which notification emails a user wants to receive
+type NotificationPreferences struct {
+	// UsageThresholds enables emails about projects reaching their usage limits, they are sent to owners and admins
+	UsageThresholds bool `json:"usageThresholds"`
+	// APIKeyCreated enables emails about api keys created in projects, they are sent to owners and admins
+	APIKeyCreated bool `json:"apiKeyCreated"`
+	// ProjectMemberAdded enables emails about the user being added to a project
+	ProjectMemberAdded bool `json:"projectMemberAdded"`
+}
+
+// DefaultNotificationPreferences returns the preferences of users, who haven't changed them
+func DefaultNotificationPreferences() NotificationPreferences {
+	return NotificationPreferences{
+		UsageThresholds:    true,
+		APIKeyCreated:      true,
+		ProjectMemberAdded: true,
+	}
+}
+
+// UsageResource is the kind of project usage, which is limited
+type UsageResource string
+
+// Limited project usage resources
+const (
+	UsageStorage UsageResource = "storage"
+	UsageEgress  UsageResource = "egress"
+)
+
+// UsageNotification identifies a notification about a project reaching
+// the threshold percentage of its limit during a period
+type UsageNotification struct {
+	ProjectID   uuid.UUID
+	Resource    UsageResource
+	Threshold   int
+	PeriodStart time.Time
+}
+
+// Notifier is informed about console actions users may want to be notified about
+type Notifier interface {
+	// APIKeyCreated is called after actor created the api key in project
+	APIKeyCreated(ctx context.Context, project *Project, key *APIKeyInfo, actor *User)
+	// ProjectMembersAdded is called after actor added users to project
+	ProjectMembersAdded(ctx context.Context, project *Project, users []*User, actor *User)
+}
@@ -0,0 +1,90 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestNotificationsRepository(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		notifications := db.Console().Notifications()
+
+		user, err := db.Console().Users().Insert(ctx, &console.User{
+			FullName:     "Notified",
+			Email:        "notified@mail.test",
+			PasswordHash: []byte("123a123"),
+		})
+		require.NoError(t, err)
+
+		project, err := db.Console().Projects().Insert(ctx, &console.Project{Name: "notified"})
+		require.NoError(t, err)
+
+		t.Run("Preferences", func(t *testing.T) {
+			preferences, err := notifications.GetPreferences(ctx, user.ID)
+			require.NoError(t, err)
+			assert.Equal(t, console.DefaultNotificationPreferences(), *preferences)
+
+			updated := console.NotificationPreferences{UsageThresholds: false, APIKeyCreated: true, ProjectMemberAdded: false}
+			require.NoError(t, notifications.UpdatePreferences(ctx, user.ID, updated))
+
+			preferences, err = notifications.GetPreferences(ctx, user.ID)
+			require.NoError(t, err)
+			assert.Equal(t, updated, *preferences)
+
+			updated.UsageThresholds = true
+			require.NoError(t, notifications.UpdatePreferences(ctx, user.ID, updated))
+
+			preferences, err = notifications.GetPreferences(ctx, user.ID)
+			require.NoError(t, err)
+			assert.Equal(t, updated, *preferences)
+		})
+
+		t.Run("Usage notifications", func(t *testing.T) {
+			notification := console.UsageNotification{
+				ProjectID:   project.ID,
+				Resource:    console.UsageStorage,
+				Threshold:   80,
+				PeriodStart: time.Date(2019, 5, 1, 0, 0, 0, 0, time.UTC),
+			}
+
+			sent, err := notifications.HasUsageNotification(ctx, notification)
+			require.NoError(t, err)
+			assert.False(t, sent)
+
+			require.NoError(t, notifications.InsertUsageNotification(ctx, notification))
+
+			sent, err = notifications.HasUsageNotification(ctx, notification)
+			require.NoError(t, err)
+			assert.True(t, sent)
+
+			// the same threshold is notified again in the next period and for other resources
+			next := notification
+			next.PeriodStart = notification.PeriodStart.AddDate(0, 1, 0)
+			sent, err = notifications.HasUsageNotification(ctx, next)
+			require.NoError(t, err)
+			assert.False(t, sent)
+
+			egress := notification
+			egress.Resource = console.UsageEgress
+			sent, err = notifications.HasUsageNotification(ctx, egress)
+			require.NoError(t, err)
+			assert.False(t, sent)
+
+			require.Error(t, notifications.InsertUsageNotification(ctx, notification))
+		})
+	})
+}
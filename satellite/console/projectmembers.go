@@ -16,8 +16,10 @@ type ProjectMembers interface {
 	GetByMemberID(ctx context.Context, memberID uuid.UUID) ([]ProjectMember, error)
 	// GetByProjectID is a method for querying project members from the database by projectID, offset and limit.
 	GetByProjectID(ctx context.Context, projectID uuid.UUID, pagination Pagination) ([]ProjectMember, error)
-	// Insert is a method for inserting project member into the database.
-	Insert(ctx context.Context, memberID, projectID uuid.UUID) (*ProjectMember, error)
+	// Insert is a method for inserting project member with the given role into the database.
+	Insert(ctx context.Context, memberID, projectID uuid.UUID, role ProjectMemberRole) (*ProjectMember, error)
+	// UpdateRole is a method for changing the role of project member.
+	UpdateRole(ctx context.Context, memberID, projectID uuid.UUID, role ProjectMemberRole) error
 	// Delete is a method for deleting project member by memberID and projectID from the database.
 	Delete(ctx context.Context, memberID, projectID uuid.UUID) error
 }
@@ -29,9 +31,62 @@ type ProjectMember struct {
 	// FK on Projects table.
 	ProjectID uuid.UUID
 
+	Role ProjectMemberRole
+
 	CreatedAt time.Time
 }
 
+// ProjectMemberRole defines what project member is allowed to do, every role includes the permissions of lower roles
+type ProjectMemberRole int
+
+const (
+	// RoleViewer is allowed to view the project, its members, api keys and usage
+	RoleViewer ProjectMemberRole = 1
+	// RoleDeveloper is additionally allowed to create, delete and revoke api keys
+	RoleDeveloper ProjectMemberRole = 2
+	// RoleAdmin is additionally allowed to update the project and manage members, which aren't admins
+	RoleAdmin ProjectMemberRole = 3
+	// RoleOwner is additionally allowed to manage admins, delete the project and transfer its ownership
+	RoleOwner ProjectMemberRole = 4
+)
+
+// ProjectMemberRoleFromString parses project member role name
+func ProjectMemberRoleFromString(name string) (ProjectMemberRole, error) {
+	for _, role := range []ProjectMemberRole{RoleViewer, RoleDeveloper, RoleAdmin, RoleOwner} {
+		if role.String() == name {
+			return role, nil
+		}
+	}
+
+	return 0, ErrValidation.New("invalid project member role %q", name)
+}
+
+// String returns the name of the role
+func (role ProjectMemberRole) String() string {
+	switch role {
+	case RoleViewer:
+		return "viewer"
+	case RoleDeveloper:
+		return "developer"
+	case RoleAdmin:
+		return "admin"
+	case RoleOwner:
+		return "owner"
+	default:
+		return "unknown"
+	}
+}
+
+// IsValid checks whether role is one of the known roles
+func (role ProjectMemberRole) IsValid() bool {
+	return role >= RoleViewer && role <= RoleOwner
+}
+
+// Includes checks whether role has the permissions of other role
+func (role ProjectMemberRole) Includes(other ProjectMemberRole) bool {
+	return role >= other
+}
+
 // Pagination defines pagination, filtering and sorting rules
 type Pagination struct {
 	Limit  int
@@ -8,6 +8,8 @@ import (
 	"time"
 
 	"github.com/skyrings/skyring-common/tools/uuid"
+
+	"storj.io/storj/internal/memory"
 )
 
 // Projects exposes methods to manage Project table in database.
@@ -24,6 +26,8 @@ type Projects interface {
 	Delete(ctx context.Context, id uuid.UUID) error
 	// Update is a method for updating project entity.
 	Update(ctx context.Context, project *Project) error
+	// UpdateUsageLimits is a method for updating project storage and egress limits.
+	UpdateUsageLimits(ctx context.Context, id uuid.UUID, storageLimit, egressLimit memory.Size) error
 }
 
 // Project is a database object that describes Project entity
@@ -33,6 +37,10 @@ type Project struct {
 	Name        string `json:"name"`
 	Description string `json:"description"`
 
+	// StorageLimit and EgressLimit are zero when the satellite defaults apply
+	StorageLimit memory.Size `json:"storageLimit"`
+	EgressLimit  memory.Size `json:"egressLimit"`
+
 	CreatedAt time.Time `json:"createdAt"`
 }
 
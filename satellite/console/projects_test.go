@@ -6,8 +6,10 @@ package console_test
 import (
 	"testing"
 
+	"github.com/skyrings/skyring-common/tools/uuid"
 	"github.com/stretchr/testify/assert"
 
+	"storj.io/storj/internal/memory"
 	"storj.io/storj/internal/testcontext"
 	"storj.io/storj/satellite"
 	"storj.io/storj/satellite/console"
@@ -29,6 +31,10 @@ func TestProjectsRepository(t *testing.T) {
 
 		// updated project values
 		newDescription = "some new description"
+
+		// project usage limits
+		storageLimit = 50 * memory.GB
+		egressLimit  = 100 * memory.GB
 	)
 
 	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
@@ -99,6 +105,31 @@ func TestProjectsRepository(t *testing.T) {
 			assert.Equal(t, newProject.Description, newDescription)
 		})
 
+		t.Run("Update usage limits success", func(t *testing.T) {
+			oldProject, err := projects.Get(ctx, project.ID)
+			assert.NoError(t, err)
+			assert.Equal(t, memory.Size(0), oldProject.StorageLimit)
+			assert.Equal(t, memory.Size(0), oldProject.EgressLimit)
+
+			err = projects.UpdateUsageLimits(ctx, oldProject.ID, storageLimit, egressLimit)
+			assert.NoError(t, err)
+
+			// fetching updated project from db
+			newProject, err := projects.Get(ctx, oldProject.ID)
+			assert.NoError(t, err)
+			assert.Equal(t, storageLimit, newProject.StorageLimit)
+			assert.Equal(t, egressLimit, newProject.EgressLimit)
+			assert.Equal(t, oldProject.Description, newProject.Description)
+		})
+
+		t.Run("Update usage limits of missing project", func(t *testing.T) {
+			missingID, err := uuid.New()
+			assert.NoError(t, err)
+
+			err = projects.UpdateUsageLimits(ctx, *missingID, storageLimit, egressLimit)
+			assert.Error(t, err)
+		})
+
 		t.Run("Delete project success", func(t *testing.T) {
 			oldProject, err := projects.Get(ctx, project.ID)
 			assert.NoError(t, err)
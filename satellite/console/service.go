@@ -5,7 +5,10 @@ package console
 
 import (
 	"context"
+	"crypto/rand"
 	"crypto/subtle"
+	"database/sql"
+	"encoding/hex"
 	"time"
 
 	"github.com/skyrings/skyring-common/tools/uuid"
@@ -14,7 +17,10 @@ import (
 	"golang.org/x/crypto/bcrypt"
 	"gopkg.in/spacemonkeygo/monkit.v2"
 
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/pkg/accounting"
 	"storj.io/storj/pkg/auth"
+	"storj.io/storj/pkg/macaroon"
 	"storj.io/storj/satellite/console/consoleauth"
 )
 
@@ -44,6 +50,10 @@ const (
 	credentialsErrMsg                    = "Your email or password was incorrect, please try again"
 	oldPassIncorrectErrMsg               = "Old password is incorrect, please try again"
 	passwordIncorrectErrMsg              = "Your password needs at least %d characters long"
+	projectRoleErrMsg                    = "Your role in this project doesn't allow this action"
+	projectOwnerErrMsg                   = "Only the project owner is allowed to manage admins and the ownership of the project"
+	ownerRoleErrMsg                      = "The project owner can only be changed by transferring the ownership of the project"
+	unknownSSOUserErrMsg                 = "There is no account on this Satellite for your email, please sign up first"
 	teamMemberDoesNotExistErrMsg         = `There is no account on this Satellite for the user(s) you have entered. 
 									     Please add team members with active accounts`
 
@@ -56,14 +66,21 @@ const (
 type Service struct {
 	Signer
 
-	store DB
-	log   *zap.Logger
+	store        DB
+	projectUsage *accounting.ProjectUsage
+	log          *zap.Logger
 
 	passwordCost int
+
+	// notifier is informed about actions users may want to receive emails about, it's optional
+	notifier Notifier
+
+	// now returns the current time, it's used for validating one-time passcodes
+	now func() time.Time
 }
 
 // NewService returns new instance of Service
-func NewService(log *zap.Logger, signer Signer, store DB, passwordCost int) (*Service, error) {
+func NewService(log *zap.Logger, signer Signer, store DB, projectUsage *accounting.ProjectUsage, passwordCost int) (*Service, error) {
 	if signer == nil {
 		return nil, errs.New("signer can't be nil")
 	}
@@ -72,6 +89,10 @@ func NewService(log *zap.Logger, signer Signer, store DB, passwordCost int) (*Se
 		return nil, errs.New("store can't be nil")
 	}
 
+	if projectUsage == nil {
+		return nil, errs.New("project usage can't be nil")
+	}
+
 	if log == nil {
 		return nil, errs.New("log can't be nil")
 	}
@@ -83,11 +104,23 @@ func NewService(log *zap.Logger, signer Signer, store DB, passwordCost int) (*Se
 	return &Service{
 		Signer:       signer,
 		store:        store,
+		projectUsage: projectUsage,
 		log:          log,
 		passwordCost: passwordCost,
+		now:          time.Now,
 	}, nil
 }
 
+// SetNow replaces the clock used for validating one-time passcodes, it's intended for tests
+func (s *Service) SetNow(now func() time.Time) {
+	s.now = now
+}
+
+// SetNotifier sets the notifier, which is informed about created api keys and added project members
+func (s *Service) SetNotifier(notifier Notifier) {
+	s.notifier = notifier
+}
+
 // CreateUser gets password hash value and creates new inactive User
 func (s *Service) CreateUser(ctx context.Context, user CreateUser, tokenSecret RegistrationSecret) (u *User, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -268,9 +301,20 @@ func (s *Service) RevokeResetPasswordToken(ctx context.Context, resetPasswordTok
 }
 
 // Token authenticates User by credentials and returns auth token
+//
+// It fails with ErrMFAMissing, when the user has enabled two-factor authentication,
+// in which case TokenWithMFA should be used instead.
 func (s *Service) Token(ctx context.Context, email, password string) (token string, err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	return s.TokenWithMFA(ctx, email, password, "", "")
+}
+
+// TokenWithMFA authenticates User by credentials and, when the user has enabled two-factor authentication,
+// either a one-time passcode or a recovery code and returns auth token.
+func (s *Service) TokenWithMFA(ctx context.Context, email, password, passcode, recoveryCode string) (token string, err error) {
+	defer mon.Task()(&ctx)(&err)
+
 	email = normalizeEmail(email)
 
 	user, err := s.store.Users().GetByEmail(ctx, email)
@@ -283,6 +327,13 @@ func (s *Service) Token(ctx context.Context, email, password string) (token stri
 		return "", ErrUnauthorized.New(credentialsErrMsg)
 	}
 
+	if user.MFAEnabled {
+		err = s.verifyMFA(ctx, user, passcode, recoveryCode)
+		if err != nil {
+			return "", err
+		}
+	}
+
 	claims := consoleauth.Claims{
 		ID:         user.ID,
 		Expiration: time.Now().Add(tokenExpirationTime),
@@ -296,6 +347,222 @@ func (s *Service) Token(ctx context.Context, email, password string) (token stri
 	return token, nil
 }
 
+// TokenWithVerifiedEmail returns auth token of the user, whose email was verified by a trusted identity provider.
+// When create is set, users without an account get an active account with an unusable password.
+// Users with two-factor authentication need either a one-time passcode or a recovery code,
+// otherwise it fails with ErrMFAMissing.
+func (s *Service) TokenWithVerifiedEmail(ctx context.Context, email, fullName string, create bool, passcode, recoveryCode string) (token string, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	email = normalizeEmail(email)
+
+	user, err := s.store.Users().GetByEmail(ctx, email)
+	switch {
+	case err == sql.ErrNoRows:
+		if !create {
+			return "", ErrUnauthorized.New(unknownSSOUserErrMsg)
+		}
+
+		user, err = s.createVerifiedUser(ctx, email, fullName)
+		if err != nil {
+			return "", err
+		}
+	case err != nil:
+		return "", errs.New(internalErrMsg)
+	}
+
+	if user.MFAEnabled {
+		err = s.verifyMFA(ctx, user, passcode, recoveryCode)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	claims := consoleauth.Claims{
+		ID:         user.ID,
+		Expiration: time.Now().Add(tokenExpirationTime),
+	}
+
+	return s.createToken(&claims)
+}
+
+// createVerifiedUser creates an active user, who signs in through an identity provider,
+// the random password can be replaced using password recovery
+func (s *Service) createVerifiedUser(ctx context.Context, email, fullName string) (u *User, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if fullName == "" {
+		fullName = email
+	}
+
+	var password [32]byte
+	if _, err = rand.Read(password[:]); err != nil {
+		return nil, errs.New(internalErrMsg)
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(hex.EncodeToString(password[:])), s.passwordCost)
+	if err != nil {
+		return nil, errs.New(internalErrMsg)
+	}
+
+	u, err = s.store.Users().Insert(ctx, &User{
+		Email:        email,
+		FullName:     fullName,
+		PasswordHash: hash,
+	})
+	if err != nil {
+		return nil, errs.New(internalErrMsg)
+	}
+
+	u.Status = Active
+	err = s.store.Users().Update(ctx, u)
+	if err != nil {
+		return nil, errs.New(internalErrMsg)
+	}
+
+	return u, nil
+}
+
+// EnrollMFA generates a new secret key for two-factor authentication of the authorized user
+// and returns the provisioning uri, which is used for adding it to an authenticator app.
+// Two-factor authentication is not required until it's enabled with EnableMFA.
+func (s *Service) EnrollMFA(ctx context.Context) (provisioningURI string, err error) {
+	defer mon.Task()(&ctx)(&err)
+	auth, err := GetAuth(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	if auth.User.MFAEnabled {
+		return "", errs.New(mfaAlreadyEnabledErrMsg)
+	}
+
+	secret, err := consoleauth.NewTOTPSecret()
+	if err != nil {
+		return "", errs.New(internalErrMsg)
+	}
+
+	auth.User.MFASecretKey = secret
+	err = s.store.Users().Update(ctx, &auth.User)
+	if err != nil {
+		return "", errs.New(internalErrMsg)
+	}
+
+	return consoleauth.TOTPProvisioningURI(secret, MFAIssuer, auth.User.Email), nil
+}
+
+// EnableMFA enables two-factor authentication for the authorized user, after verifying that passcode
+// has been generated with the secret key from EnrollMFA. It returns recovery codes, which can be used
+// once each instead of a passcode. Only hashes of the recovery codes are stored.
+func (s *Service) EnableMFA(ctx context.Context, passcode string) (recoveryCodes []string, err error) {
+	defer mon.Task()(&ctx)(&err)
+	auth, err := GetAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if auth.User.MFAEnabled {
+		return nil, errs.New(mfaAlreadyEnabledErrMsg)
+	}
+
+	if auth.User.MFASecretKey == "" {
+		return nil, errs.New(mfaNotEnrolledErrMsg)
+	}
+
+	valid, err := consoleauth.ValidateTOTP(auth.User.MFASecretKey, passcode, s.now())
+	if err != nil {
+		return nil, errs.New(internalErrMsg)
+	}
+	if !valid {
+		return nil, ErrMFAPasscode.New(mfaPasscodeErrMsg)
+	}
+
+	recoveryCodes, hashes, err := newMFARecoveryCodes()
+	if err != nil {
+		return nil, errs.New(internalErrMsg)
+	}
+
+	auth.User.MFAEnabled = true
+	auth.User.MFARecoveryCodes = hashes
+	err = s.withTx(ctx, func(tx DBTx) error {
+		err := tx.Users().Update(ctx, &auth.User)
+		if err != nil {
+			return errs.New(internalErrMsg)
+		}
+
+		return s.audit(ctx, tx, auth.User.ID, nil, AuditAccountMFAEnable, auth.User.Email)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return recoveryCodes, nil
+}
+
+// DisableMFA disables two-factor authentication for the authorized user,
+// either a valid passcode or a recovery code is required.
+func (s *Service) DisableMFA(ctx context.Context, passcode, recoveryCode string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	auth, err := GetAuth(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !auth.User.MFAEnabled {
+		return errs.New(mfaNotEnabledErrMsg)
+	}
+
+	err = s.verifyMFA(ctx, &auth.User, passcode, recoveryCode)
+	if err != nil {
+		return err
+	}
+
+	auth.User.MFAEnabled = false
+	auth.User.MFASecretKey = ""
+	auth.User.MFARecoveryCodes = nil
+	return s.withTx(ctx, func(tx DBTx) error {
+		err := tx.Users().Update(ctx, &auth.User)
+		if err != nil {
+			return errs.New(internalErrMsg)
+		}
+
+		return s.audit(ctx, tx, auth.User.ID, nil, AuditAccountMFADisable, auth.User.Email)
+	})
+}
+
+// verifyMFA checks either passcode or recovery code of the user, used recovery codes are removed
+func (s *Service) verifyMFA(ctx context.Context, user *User, passcode, recoveryCode string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	switch {
+	case passcode == "" && recoveryCode == "":
+		return ErrMFAMissing.New(mfaMissingErrMsg)
+	case passcode != "" && recoveryCode != "":
+		return ErrMFAPasscode.New(mfaConflictingCodesErrMsg)
+	case passcode != "":
+		valid, err := consoleauth.ValidateTOTP(user.MFASecretKey, passcode, s.now())
+		if err != nil {
+			return errs.New(internalErrMsg)
+		}
+		if !valid {
+			return ErrMFAPasscode.New(mfaPasscodeErrMsg)
+		}
+		return nil
+	default:
+		if !useMFARecoveryCode(user, recoveryCode) {
+			return ErrMFAPasscode.New(mfaPasscodeErrMsg)
+		}
+
+		return s.withTx(ctx, func(tx DBTx) error {
+			err := tx.Users().Update(ctx, user)
+			if err != nil {
+				return errs.New(internalErrMsg)
+			}
+			return s.audit(ctx, tx, user.ID, nil, AuditAccountMFARecoveryCode, user.Email)
+		})
+	}
+}
+
 // GetUser returns User by id
 func (s *Service) GetUser(ctx context.Context, id uuid.UUID) (u *User, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -327,14 +594,52 @@ func (s *Service) UpdateAccount(ctx context.Context, info UserInfo) (err error)
 		return err
 	}
 
-	err = s.store.Users().Update(ctx, &User{
-		ID:           auth.User.ID,
-		FullName:     info.FullName,
-		ShortName:    info.ShortName,
-		Email:        auth.User.Email,
-		PasswordHash: nil,
-		Status:       auth.User.Status,
+	return s.withTx(ctx, func(tx DBTx) error {
+		err := tx.Users().Update(ctx, &User{
+			ID:           auth.User.ID,
+			FullName:     info.FullName,
+			ShortName:    info.ShortName,
+			Email:        auth.User.Email,
+			PasswordHash: nil,
+			Status:       auth.User.Status,
+
+			MFAEnabled:       auth.User.MFAEnabled,
+			MFASecretKey:     auth.User.MFASecretKey,
+			MFARecoveryCodes: auth.User.MFARecoveryCodes,
+		})
+		if err != nil {
+			return errs.New(internalErrMsg)
+		}
+
+		return s.audit(ctx, tx, auth.User.ID, nil, AuditAccountUpdate, auth.User.Email)
 	})
+}
+
+// GetNotificationPreferences returns the notification preferences of the authorized user
+func (s *Service) GetNotificationPreferences(ctx context.Context) (_ *NotificationPreferences, err error) {
+	defer mon.Task()(&ctx)(&err)
+	auth, err := GetAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	preferences, err := s.store.Notifications().GetPreferences(ctx, auth.User.ID)
+	if err != nil {
+		return nil, errs.New(internalErrMsg)
+	}
+
+	return preferences, nil
+}
+
+// UpdateNotificationPreferences updates the notification preferences of the authorized user
+func (s *Service) UpdateNotificationPreferences(ctx context.Context, preferences NotificationPreferences) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	auth, err := GetAuth(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = s.store.Notifications().UpdatePreferences(ctx, auth.User.ID, preferences)
 	if err != nil {
 		return errs.New(internalErrMsg)
 	}
@@ -365,12 +670,14 @@ func (s *Service) ChangePassword(ctx context.Context, pass, newPass string) (err
 	}
 
 	auth.User.PasswordHash = hash
-	err = s.store.Users().Update(ctx, &auth.User)
-	if err != nil {
-		return errs.New(internalErrMsg)
-	}
+	return s.withTx(ctx, func(tx DBTx) error {
+		err := tx.Users().Update(ctx, &auth.User)
+		if err != nil {
+			return errs.New(internalErrMsg)
+		}
 
-	return nil
+		return s.audit(ctx, tx, auth.User.ID, nil, AuditAccountPasswordChange, auth.User.Email)
+	})
 }
 
 // DeleteAccount deletes User
@@ -386,28 +693,30 @@ func (s *Service) DeleteAccount(ctx context.Context, password string) (err error
 		return ErrUnauthorized.New(oldPassIncorrectErrMsg)
 	}
 
-	err = s.store.Users().Delete(ctx, auth.User.ID)
-	if err != nil {
-		return errs.New(internalErrMsg)
-	}
+	return s.withTx(ctx, func(tx DBTx) error {
+		err := tx.Users().Delete(ctx, auth.User.ID)
+		if err != nil {
+			return errs.New(internalErrMsg)
+		}
 
-	return nil
+		return s.audit(ctx, tx, auth.User.ID, nil, AuditAccountDelete, auth.User.Email)
+	})
 }
 
 // GetProject is a method for querying project by id
 func (s *Service) GetProject(ctx context.Context, projectID uuid.UUID) (p *Project, err error) {
 	defer mon.Task()(&ctx)(&err)
-	_, err = GetAuth(ctx)
+	auth, err := GetAuth(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	p, err = s.store.Projects().Get(ctx, projectID)
+	isMember, err := s.isProjectMember(ctx, auth.User.ID, projectID, RoleViewer)
 	if err != nil {
-		return nil, errs.New(internalErrMsg)
+		return nil, ErrUnauthorized.Wrap(err)
 	}
 
-	return
+	return isMember.project, nil
 }
 
 // GetUsersProjects is a method for querying all projects
@@ -467,11 +776,16 @@ func (s *Service) CreateProject(ctx context.Context, projectInfo ProjectInfo) (p
 		return nil, errs.New(internalErrMsg)
 	}
 
-	_, err = transaction.ProjectMembers().Insert(ctx, auth.User.ID, prj.ID)
+	_, err = transaction.ProjectMembers().Insert(ctx, auth.User.ID, prj.ID, RoleOwner)
 	if err != nil {
 		return nil, errs.New(internalErrMsg)
 	}
 
+	err = s.audit(ctx, transaction, auth.User.ID, &prj.ID, AuditProjectCreate, prj.Name)
+	if err != nil {
+		return nil, err
+	}
+
 	return prj, nil
 }
 
@@ -483,16 +797,19 @@ func (s *Service) DeleteProject(ctx context.Context, projectID uuid.UUID) (err e
 		return err
 	}
 
-	if _, err = s.isProjectMember(ctx, auth.User.ID, projectID); err != nil {
+	isMember, err := s.isProjectMember(ctx, auth.User.ID, projectID, RoleOwner)
+	if err != nil {
 		return ErrUnauthorized.Wrap(err)
 	}
 
-	err = s.store.Projects().Delete(ctx, projectID)
-	if err != nil {
-		return errs.New(internalErrMsg)
-	}
+	return s.withTx(ctx, func(tx DBTx) error {
+		err := tx.Projects().Delete(ctx, projectID)
+		if err != nil {
+			return errs.New(internalErrMsg)
+		}
 
-	return nil
+		return s.audit(ctx, tx, auth.User.ID, &projectID, AuditProjectDelete, isMember.project.Name)
+	})
 }
 
 // UpdateProject is a method for updating project description by id
@@ -503,7 +820,7 @@ func (s *Service) UpdateProject(ctx context.Context, projectID uuid.UUID, descri
 		return nil, err
 	}
 
-	isMember, err := s.isProjectMember(ctx, auth.User.ID, projectID)
+	isMember, err := s.isProjectMember(ctx, auth.User.ID, projectID, RoleAdmin)
 	if err != nil {
 		return nil, ErrUnauthorized.Wrap(err)
 	}
@@ -511,26 +828,38 @@ func (s *Service) UpdateProject(ctx context.Context, projectID uuid.UUID, descri
 	project := isMember.project
 	project.Description = description
 
-	err = s.store.Projects().Update(ctx, project)
+	err = s.withTx(ctx, func(tx DBTx) error {
+		err := tx.Projects().Update(ctx, project)
+		if err != nil {
+			return errs.New(internalErrMsg)
+		}
+
+		return s.audit(ctx, tx, auth.User.ID, &projectID, AuditProjectUpdate, project.Name)
+	})
 	if err != nil {
-		return nil, errs.New(internalErrMsg)
+		return nil, err
 	}
 
 	return project, nil
 }
 
-// AddProjectMembers adds users by email to given project
-func (s *Service) AddProjectMembers(ctx context.Context, projectID uuid.UUID, emails []string) (users []*User, err error) {
+// AddProjectMembers adds users by email to given project with the given role
+func (s *Service) AddProjectMembers(ctx context.Context, projectID uuid.UUID, emails []string, role ProjectMemberRole) (users []*User, err error) {
 	defer mon.Task()(&ctx)(&err)
 	auth, err := GetAuth(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	if _, err = s.isProjectMember(ctx, auth.User.ID, projectID); err != nil {
+	isMember, err := s.isProjectMember(ctx, auth.User.ID, projectID, RoleAdmin)
+	if err != nil {
 		return nil, ErrUnauthorized.Wrap(err)
 	}
 
+	if err = canAssignRole(isMember.membership.Role, role); err != nil {
+		return nil, err
+	}
+
 	var userErr errs.Group
 
 	// collect user querying errors
@@ -561,14 +890,22 @@ func (s *Service) AddProjectMembers(ctx context.Context, projectID uuid.UUID, em
 		}
 
 		err = tx.Commit()
+		if err == nil && s.notifier != nil {
+			s.notifier.ProjectMembersAdded(ctx, isMember.project, users, &auth.User)
+		}
 	}()
 
 	for _, user := range users {
-		_, err = tx.ProjectMembers().Insert(ctx, user.ID, projectID)
+		_, err = tx.ProjectMembers().Insert(ctx, user.ID, projectID, role)
 
 		if err != nil {
 			return nil, errs.New(internalErrMsg)
 		}
+
+		err = s.audit(ctx, tx, auth.User.ID, &projectID, AuditProjectMemberAdd, memberAuditTarget(user.Email, role))
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	return users, nil
@@ -582,11 +919,12 @@ func (s *Service) DeleteProjectMembers(ctx context.Context, projectID uuid.UUID,
 		return err
 	}
 
-	if _, err = s.isProjectMember(ctx, auth.User.ID, projectID); err != nil {
+	isMember, err := s.isProjectMember(ctx, auth.User.ID, projectID, RoleAdmin)
+	if err != nil {
 		return ErrUnauthorized.Wrap(err)
 	}
 
-	var userIDs []uuid.UUID
+	var users []*User
 	var userErr errs.Group
 
 	// collect user querying errors
@@ -598,13 +936,25 @@ func (s *Service) DeleteProjectMembers(ctx context.Context, projectID uuid.UUID,
 			continue
 		}
 
-		userIDs = append(userIDs, user.ID)
+		users = append(users, user)
 	}
 
 	if err = userErr.Err(); err != nil {
 		return errs.New(teamMemberDoesNotExistErrMsg)
 	}
 
+	for _, user := range users {
+		member, err := s.isProjectMember(ctx, user.ID, projectID, RoleViewer)
+		if err != nil {
+			// deleting users, which aren't members, is a no-op
+			continue
+		}
+
+		if err = canManageMember(isMember.membership.Role, member.membership.Role); err != nil {
+			return err
+		}
+	}
+
 	// delete project members in transaction scope
 	tx, err := s.store.BeginTx(ctx)
 	if err != nil {
@@ -620,17 +970,277 @@ func (s *Service) DeleteProjectMembers(ctx context.Context, projectID uuid.UUID,
 		err = tx.Commit()
 	}()
 
-	for _, uID := range userIDs {
-		err = tx.ProjectMembers().Delete(ctx, uID, projectID)
+	for _, user := range users {
+		err = tx.ProjectMembers().Delete(ctx, user.ID, projectID)
 
 		if err != nil {
 			return errs.New(internalErrMsg)
 		}
+
+		err = s.audit(ctx, tx, auth.User.ID, &projectID, AuditProjectMemberDelete, user.Email)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// UpdateProjectMemberRole changes the role of project member with given email
+func (s *Service) UpdateProjectMemberRole(ctx context.Context, projectID uuid.UUID, email string, role ProjectMemberRole) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	auth, err := GetAuth(ctx)
+	if err != nil {
+		return err
+	}
+
+	isMember, err := s.isProjectMember(ctx, auth.User.ID, projectID, RoleAdmin)
+	if err != nil {
+		return ErrUnauthorized.Wrap(err)
+	}
+
+	if err = canAssignRole(isMember.membership.Role, role); err != nil {
+		return err
+	}
+
+	user, err := s.store.Users().GetByEmail(ctx, normalizeEmail(email))
+	if err != nil {
+		return errs.New(teamMemberDoesNotExistErrMsg)
+	}
+
+	member, err := s.isProjectMember(ctx, user.ID, projectID, RoleViewer)
+	if err != nil {
+		return err
+	}
+
+	if err = canManageMember(isMember.membership.Role, member.membership.Role); err != nil {
+		return err
+	}
+
+	return s.withTx(ctx, func(tx DBTx) error {
+		err := tx.ProjectMembers().UpdateRole(ctx, user.ID, projectID, role)
+		if err != nil {
+			return errs.New(internalErrMsg)
+		}
+
+		return s.audit(ctx, tx, auth.User.ID, &projectID, AuditProjectMemberRole, memberAuditTarget(user.Email, role))
+	})
+}
+
+// TransferProjectOwnership makes project member with given email the owner of the project,
+// the previous owner becomes an admin
+func (s *Service) TransferProjectOwnership(ctx context.Context, projectID uuid.UUID, email string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	auth, err := GetAuth(ctx)
+	if err != nil {
+		return err
+	}
+
+	if _, err = s.isProjectMember(ctx, auth.User.ID, projectID, RoleOwner); err != nil {
+		return ErrUnauthorized.Wrap(err)
+	}
+
+	user, err := s.store.Users().GetByEmail(ctx, normalizeEmail(email))
+	if err != nil {
+		return errs.New(teamMemberDoesNotExistErrMsg)
+	}
+
+	if user.ID == auth.User.ID {
+		return nil
+	}
+
+	if _, err = s.isProjectMember(ctx, user.ID, projectID, RoleViewer); err != nil {
+		return err
+	}
+
+	tx, err := s.store.BeginTx(ctx)
+	if err != nil {
+		return errs.New(internalErrMsg)
+	}
+
+	defer func() {
+		if err != nil {
+			err = errs.Combine(err, tx.Rollback())
+			return
+		}
+
+		err = tx.Commit()
+	}()
+
+	err = tx.ProjectMembers().UpdateRole(ctx, user.ID, projectID, RoleOwner)
+	if err != nil {
+		return errs.New(internalErrMsg)
+	}
+
+	err = tx.ProjectMembers().UpdateRole(ctx, auth.User.ID, projectID, RoleAdmin)
+	if err != nil {
+		return errs.New(internalErrMsg)
+	}
+
+	return s.audit(ctx, tx, auth.User.ID, &projectID, AuditProjectOwnerTransfer, user.Email)
+}
+
+// memberAuditTarget describes the member with given email and role in the audit log
+func memberAuditTarget(email string, role ProjectMemberRole) string {
+	return email + " as " + role.String()
+}
+
+// canAssignRole checks whether member with actor role is allowed to give role to other members
+func canAssignRole(actor, role ProjectMemberRole) error {
+	switch {
+	case !role.IsValid():
+		return ErrValidation.New("invalid project member role")
+	case role == RoleOwner:
+		return ErrProjectRole.New(ownerRoleErrMsg)
+	case role == RoleAdmin && actor != RoleOwner:
+		return ErrProjectRole.New(projectOwnerErrMsg)
+	}
+
+	return nil
+}
+
+// canManageMember checks whether member with actor role is allowed to change or remove member with target role
+func canManageMember(actor, target ProjectMemberRole) error {
+	switch {
+	case target == RoleOwner:
+		return ErrProjectRole.New(ownerRoleErrMsg)
+	case target == RoleAdmin && actor != RoleOwner:
+		return ErrProjectRole.New(projectOwnerErrMsg)
 	}
 
 	return nil
 }
 
+// ProjectUsageLimits holds the usage limits enforced for a project and its current usage
+type ProjectUsageLimits struct {
+	StorageLimit memory.Size `json:"storageLimit"`
+	EgressLimit  memory.Size `json:"egressLimit"`
+	StorageUsed  memory.Size `json:"storageUsed"`
+	EgressUsed   memory.Size `json:"egressUsed"`
+}
+
+// GetProjectUsageLimits returns the usage limits enforced for the project and its current usage
+func (s *Service) GetProjectUsageLimits(ctx context.Context, projectID uuid.UUID) (_ *ProjectUsageLimits, err error) {
+	defer mon.Task()(&ctx)(&err)
+	auth, err := GetAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.isProjectMember(ctx, auth.User.ID, projectID, RoleViewer)
+	if err != nil {
+		return nil, ErrUnauthorized.Wrap(err)
+	}
+
+	storageLimit, egressLimit, err := s.projectUsage.GetProjectUsageLimits(ctx, projectID)
+	if err != nil {
+		return nil, errs.New(internalErrMsg)
+	}
+
+	storageUsed, err := s.projectUsage.GetProjectStorageTotals(ctx, projectID)
+	if err != nil {
+		return nil, errs.New(internalErrMsg)
+	}
+
+	egressUsed, err := s.projectUsage.GetProjectBandwidthTotals(ctx, projectID)
+	if err != nil {
+		return nil, errs.New(internalErrMsg)
+	}
+
+	return &ProjectUsageLimits{
+		StorageLimit: storageLimit,
+		EgressLimit:  egressLimit,
+		StorageUsed:  storageUsed,
+		EgressUsed:   egressUsed,
+	}, nil
+}
+
+// GetProjectInvoices returns the invoices of a project, newest first
+func (s *Service) GetProjectInvoices(ctx context.Context, projectID uuid.UUID) (_ []Invoice, err error) {
+	defer mon.Task()(&ctx)(&err)
+	auth, err := GetAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.isProjectMember(ctx, auth.User.ID, projectID, RoleViewer)
+	if err != nil {
+		return nil, ErrUnauthorized.Wrap(err)
+	}
+
+	invoices, err := s.store.Invoices().GetByProjectID(ctx, projectID)
+	if err != nil {
+		return nil, errs.New(internalErrMsg)
+	}
+
+	return invoices, nil
+}
+
+// GetProjectAuditEvents returns a page of the audit log of a project, newest first,
+// only the project owner is allowed to read it
+func (s *Service) GetProjectAuditEvents(ctx context.Context, projectID uuid.UUID, limit int, offset int64) (_ []AuditEvent, err error) {
+	defer mon.Task()(&ctx)(&err)
+	auth, err := GetAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.isProjectMember(ctx, auth.User.ID, projectID, RoleOwner)
+	if err != nil {
+		return nil, ErrUnauthorized.Wrap(err)
+	}
+
+	if limit > maxLimit {
+		limit = maxLimit
+	}
+
+	events, err := s.store.AuditEvents().GetByProjectID(ctx, projectID, limit, offset)
+	if err != nil {
+		return nil, errs.New(internalErrMsg)
+	}
+
+	return events, nil
+}
+
+// audit appends the action of actor to the audit log, together with the address of the client taken from ctx
+func (s *Service) audit(ctx context.Context, store DB, actorID uuid.UUID, projectID *uuid.UUID, action AuditAction, target string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = store.AuditEvents().Insert(ctx, AuditEvent{
+		ProjectID: projectID,
+		ActorID:   actorID,
+		Action:    action,
+		Target:    target,
+		SourceIP:  GetSourceIP(ctx),
+	})
+	if err != nil {
+		s.log.Error("writing audit event failed", zap.String("action", string(action)), zap.Error(err))
+		return errs.New(internalErrMsg)
+	}
+
+	return nil
+}
+
+// withTx runs fn in a transaction, which is committed when fn succeeds and rolled back otherwise,
+// changes are made together with their audit events through it
+func (s *Service) withTx(ctx context.Context, fn func(tx DBTx) error) (err error) {
+	tx, err := s.store.BeginTx(ctx)
+	if err != nil {
+		return errs.New(internalErrMsg)
+	}
+
+	defer func() {
+		if err != nil {
+			err = errs.Combine(err, tx.Rollback())
+			return
+		}
+
+		err = tx.Commit()
+	}()
+
+	return fn(tx)
+}
+
 // GetProjectMembers returns ProjectMembers for given Project
 func (s *Service) GetProjectMembers(ctx context.Context, projectID uuid.UUID, pagination Pagination) (pm []ProjectMember, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -639,7 +1249,7 @@ func (s *Service) GetProjectMembers(ctx context.Context, projectID uuid.UUID, pa
 		return nil, err
 	}
 
-	_, err = s.isProjectMember(ctx, auth.User.ID, projectID)
+	_, err = s.isProjectMember(ctx, auth.User.ID, projectID, RoleViewer)
 	if err != nil {
 		return nil, ErrUnauthorized.Wrap(err)
 	}
@@ -656,8 +1266,8 @@ func (s *Service) GetProjectMembers(ctx context.Context, projectID uuid.UUID, pa
 	return
 }
 
-// CreateAPIKey creates new api key
-func (s *Service) CreateAPIKey(ctx context.Context, projectID uuid.UUID, name string) (*APIKeyInfo, *APIKey, error) {
+// CreateAPIKey creates new api key, restricted by the given restrictions
+func (s *Service) CreateAPIKey(ctx context.Context, projectID uuid.UUID, name string, restrictions APIKeyRestrictions) (*APIKeyInfo, *macaroon.APIKey, error) {
 	var err error
 	defer mon.Task()(&ctx)(&err)
 
@@ -666,24 +1276,54 @@ func (s *Service) CreateAPIKey(ctx context.Context, projectID uuid.UUID, name st
 		return nil, nil, err
 	}
 
-	_, err = s.isProjectMember(ctx, auth.User.ID, projectID)
+	if err := restrictions.validate(); err != nil {
+		return nil, nil, err
+	}
+
+	isMember, err := s.isProjectMember(ctx, auth.User.ID, projectID, RoleDeveloper)
 	if err != nil {
 		return nil, nil, ErrUnauthorized.Wrap(err)
 	}
 
-	key, err := CreateAPIKey()
+	secret, err := macaroon.NewSecret()
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, errs.New(internalErrMsg)
 	}
 
-	info, err := s.store.APIKeys().Create(ctx, *key, APIKeyInfo{
-		Name:      name,
-		ProjectID: projectID,
-	})
+	key, err := macaroon.NewAPIKey(secret)
 	if err != nil {
 		return nil, nil, errs.New(internalErrMsg)
 	}
 
+	if !restrictions.IsZero() {
+		key, err = key.Restrict(restrictions.Caveat())
+		if err != nil {
+			return nil, nil, errs.New(internalErrMsg)
+		}
+	}
+
+	var info *APIKeyInfo
+	err = s.withTx(ctx, func(tx DBTx) (err error) {
+		info, err = tx.APIKeys().Create(ctx, key.Head(), APIKeyInfo{
+			Name:         name,
+			ProjectID:    projectID,
+			Secret:       secret,
+			Restrictions: restrictions,
+		})
+		if err != nil {
+			return errs.New(internalErrMsg)
+		}
+
+		return s.audit(ctx, tx, auth.User.ID, &projectID, AuditAPIKeyCreate, name)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if s.notifier != nil {
+		s.notifier.APIKeyCreated(ctx, isMember.project, info, &auth.User)
+	}
+
 	return info, key, nil
 }
 
@@ -702,7 +1342,7 @@ func (s *Service) GetAPIKeyInfo(ctx context.Context, id uuid.UUID) (*APIKeyInfo,
 		return nil, errs.New(internalErrMsg)
 	}
 
-	_, err = s.isProjectMember(ctx, auth.User.ID, key.ProjectID)
+	_, err = s.isProjectMember(ctx, auth.User.ID, key.ProjectID, RoleViewer)
 	if err != nil {
 		return nil, ErrUnauthorized.Wrap(err)
 	}
@@ -718,6 +1358,7 @@ func (s *Service) DeleteAPIKeys(ctx context.Context, ids []uuid.UUID) (err error
 		return err
 	}
 
+	var keys []*APIKeyInfo
 	var keysErr errs.Group
 
 	for _, keyID := range ids {
@@ -727,11 +1368,13 @@ func (s *Service) DeleteAPIKeys(ctx context.Context, ids []uuid.UUID) (err error
 			continue
 		}
 
-		_, err = s.isProjectMember(ctx, auth.User.ID, key.ProjectID)
+		_, err = s.isProjectMember(ctx, auth.User.ID, key.ProjectID, RoleDeveloper)
 		if err != nil {
 			keysErr.Add(ErrUnauthorized.Wrap(err))
 			continue
 		}
+
+		keys = append(keys, key)
 	}
 
 	if err = keysErr.Err(); err != nil {
@@ -752,16 +1395,60 @@ func (s *Service) DeleteAPIKeys(ctx context.Context, ids []uuid.UUID) (err error
 		err = tx.Commit()
 	}()
 
-	for _, keyToDeleteID := range ids {
-		err = tx.APIKeys().Delete(ctx, keyToDeleteID)
+	for _, key := range keys {
+		err = tx.APIKeys().Delete(ctx, key.ID)
 		if err != nil {
 			return errs.New(internalErrMsg)
 		}
+
+		err = s.audit(ctx, tx, auth.User.ID, &key.ProjectID, AuditAPIKeyDelete, key.Name)
+		if err != nil {
+			return err
+		}
 	}
 
 	return nil
 }
 
+// RevokeAPIKey adds serialized api key, restricted from one of the project api keys,
+// to the revocation list, which also revokes all keys restricted from it
+func (s *Service) RevokeAPIKey(ctx context.Context, serialized string) (info *APIKeyInfo, err error) {
+	defer mon.Task()(&ctx)(&err)
+	auth, err := GetAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := macaroon.ParseAPIKey(serialized)
+	if err != nil {
+		return nil, errs.New("api key is invalid")
+	}
+
+	info, err = s.store.APIKeys().GetByHead(ctx, key.Head())
+	if err != nil {
+		return nil, errs.New("api key is invalid")
+	}
+
+	_, err = s.isProjectMember(ctx, auth.User.ID, info.ProjectID, RoleDeveloper)
+	if err != nil {
+		return nil, ErrUnauthorized.Wrap(err)
+	}
+
+	err = s.withTx(ctx, func(tx DBTx) error {
+		err := tx.APIKeys().Revoke(ctx, info.ID, key.Tail())
+		if err != nil {
+			return errs.New(internalErrMsg)
+		}
+
+		return s.audit(ctx, tx, auth.User.ID, &info.ProjectID, AuditAPIKeyRevoke, info.Name)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return info, nil
+}
+
 // GetAPIKeysInfoByProjectID retrieves all api keys for a given project
 func (s *Service) GetAPIKeysInfoByProjectID(ctx context.Context, projectID uuid.UUID) (info []APIKeyInfo, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -770,7 +1457,7 @@ func (s *Service) GetAPIKeysInfoByProjectID(ctx context.Context, projectID uuid.
 		return nil, err
 	}
 
-	_, err = s.isProjectMember(ctx, auth.User.ID, projectID)
+	_, err = s.isProjectMember(ctx, auth.User.ID, projectID, RoleViewer)
 	if err != nil {
 		return nil, ErrUnauthorized.Wrap(err)
 	}
@@ -793,7 +1480,7 @@ func (s *Service) GetProjectUsage(ctx context.Context, projectID uuid.UUID, sinc
 		return nil, err
 	}
 
-	_, err = s.isProjectMember(ctx, auth.User.ID, projectID)
+	_, err = s.isProjectMember(ctx, auth.User.ID, projectID, RoleViewer)
 	if err != nil {
 		return nil, err
 	}
@@ -816,7 +1503,7 @@ func (s *Service) GetBucketTotals(ctx context.Context, projectID uuid.UUID, curs
 		return nil, err
 	}
 
-	isMember, err := s.isProjectMember(ctx, auth.User.ID, projectID)
+	isMember, err := s.isProjectMember(ctx, auth.User.ID, projectID, RoleViewer)
 	if err != nil {
 		return nil, err
 	}
@@ -834,7 +1521,7 @@ func (s *Service) GetBucketUsageRollups(ctx context.Context, projectID uuid.UUID
 		return nil, err
 	}
 
-	_, err = s.isProjectMember(ctx, auth.User.ID, projectID)
+	_, err = s.isProjectMember(ctx, auth.User.ID, projectID, RoleViewer)
 	if err != nil {
 		return nil, err
 	}
@@ -956,8 +1643,11 @@ type isProjectMember struct {
 // ErrNoMembership is error type of not belonging to a specific project
 var ErrNoMembership = errs.Class("no membership error")
 
-// isProjectMember checks if the user is a member of given project
-func (s *Service) isProjectMember(ctx context.Context, userID uuid.UUID, projectID uuid.UUID) (result isProjectMember, err error) {
+// ErrProjectRole is error type of project member role not allowing an action
+var ErrProjectRole = errs.Class("project role error")
+
+// isProjectMember checks that the user is a member of the project with at least the given role
+func (s *Service) isProjectMember(ctx context.Context, userID uuid.UUID, projectID uuid.UUID, role ProjectMemberRole) (result isProjectMember, err error) {
 	project, err := s.store.Projects().Get(ctx, projectID)
 	if err != nil {
 		return result, errs.New(internalErrMsg)
@@ -970,6 +1660,10 @@ func (s *Service) isProjectMember(ctx context.Context, userID uuid.UUID, project
 
 	for _, membership := range memberships {
 		if membership.ProjectID == projectID {
+			if !membership.Role.Includes(role) {
+				return isProjectMember{}, ErrProjectRole.New(projectRoleErrMsg)
+			}
+
 			result.membership = &membership
 			result.project = project
 			return
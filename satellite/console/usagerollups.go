@@ -13,16 +13,18 @@ import (
 // UsageRollups defines how console works with usage rollups
 type UsageRollups interface {
 	GetProjectTotal(ctx context.Context, projectID uuid.UUID, since, before time.Time) (*ProjectUsage, error)
+	GetProjectBillableUsage(ctx context.Context, projectID uuid.UUID, since, before time.Time) (*ProjectUsage, error)
 	GetBucketUsageRollups(ctx context.Context, projectID uuid.UUID, since, before time.Time) ([]BucketUsageRollup, error)
 	GetBucketTotals(ctx context.Context, projectID uuid.UUID, cursor BucketUsageCursor, since, before time.Time) (*BucketUsagePage, error)
 }
 
 // ProjectUsage consist of period total storage, egress
-// and objects count per hour for certain Project
+// and objects and segments count per hour for certain Project
 type ProjectUsage struct {
-	Storage     float64
-	Egress      float64
-	ObjectCount float64
+	Storage      float64
+	Egress       float64
+	ObjectCount  float64
+	SegmentCount float64
 
 	Since  time.Time
 	Before time.Time
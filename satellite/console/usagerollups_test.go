@@ -12,6 +12,7 @@ import (
 	"github.com/skyrings/skyring-common/tools/uuid"
 	"github.com/stretchr/testify/assert"
 
+	"storj.io/storj/internal/memory"
 	"storj.io/storj/internal/testcontext"
 	"storj.io/storj/pkg/accounting"
 	"storj.io/storj/pkg/pb"
@@ -226,3 +227,53 @@ func TestUsageRollups(t *testing.T) {
 		})
 	})
 }
+
+func TestGetProjectBillableUsage(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		projectID, err := uuid.New()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		since := time.Date(2019, 4, 1, 0, 0, 0, 0, time.UTC)
+		before := since.AddDate(0, 1, 0)
+		bucketID := projectID.String() + "/bucket"
+
+		// the tallies straddle the boundaries of the period
+		for _, tally := range []struct {
+			intervalStart time.Time
+			remoteBytes   int64
+		}{
+			{since.Add(-12 * time.Hour), 1 * memory.GB.Int64()},
+			{since.Add(6 * time.Hour), 2 * memory.GB.Int64()},
+			{before.Add(-6 * time.Hour), 4 * memory.GB.Int64()},
+			{before, 8 * memory.GB.Int64()},
+		} {
+			_, err := db.ProjectAccounting().SaveTallies(ctx, tally.intervalStart, map[string]*accounting.BucketTally{
+				bucketID: {
+					RemoteSegments: 1,
+					Files:          1,
+					RemoteBytes:    tally.remoteBytes,
+				},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		usage, err := db.Console().UsageRollups().GetProjectBillableUsage(ctx, *projectID, since, before)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// the tally before the period is carried to the first tally in it, the newest one is kept until the end,
+		// the tally at the end of the period is billed in the next one
+		hours := before.Sub(since).Hours()
+		assert.InDelta(t, 1*6+2*(hours-12)+4*6, usage.Storage, 1e-6)
+		assert.InDelta(t, hours, usage.ObjectCount, 1e-6)
+		assert.InDelta(t, hours, usage.SegmentCount, 1e-6)
+	})
+}
@@ -88,5 +88,11 @@ type User struct {
 
 	Status UserStatus `json:"status"`
 
+	MFAEnabled bool `json:"mfaEnabled"`
+	// MFASecretKey is the base32 encoded secret key of time-based one-time passcodes
+	MFASecretKey string `json:"-"`
+	// MFARecoveryCodes contains hashes of unused recovery codes
+	MFARecoveryCodes []string `json:"-"`
+
 	CreatedAt time.Time `json:"createdAt"`
 }
@@ -38,7 +38,7 @@ func TestInspectorStats(t *testing.T) {
 		healthEndpoint := planet.Satellites[0].Inspector.Endpoint
 
 		// Get path of random segment we just uploaded and check the health
-		_ = planet.Satellites[0].Metainfo.Database.Iterate(storage.IterateOptions{Recurse: true},
+		_ = planet.Satellites[0].Metainfo.Database.Iterate(ctx, storage.IterateOptions{Recurse: true},
 			func(it storage.Iterator) error {
 				var item storage.ListItem
 				for it.Next(&item) {
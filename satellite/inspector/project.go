@@ -0,0 +1,98 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package inspector
+
+import (
+	"context"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"go.uber.org/zap"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/pkg/accounting"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/satellite/console"
+)
+
+// ProjectEndpoint for inspecting and changing project usage limits
+type ProjectEndpoint struct {
+	log      *zap.Logger
+	projects console.Projects
+	usage    *accounting.ProjectUsage
+}
+
+// NewProjectEndpoint will initialize a ProjectEndpoint struct
+func NewProjectEndpoint(log *zap.Logger, projects console.Projects, usage *accounting.ProjectUsage) *ProjectEndpoint {
+	return &ProjectEndpoint{
+		log:      log,
+		projects: projects,
+		usage:    usage,
+	}
+}
+
+// GetProjectUsageLimits returns the custom and enforced usage limits of a project together with its current usage
+func (endpoint *ProjectEndpoint) GetProjectUsageLimits(ctx context.Context, in *pb.GetProjectUsageLimitsRequest) (resp *pb.GetProjectUsageLimitsResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	projectID, err := uuid.Parse(string(in.GetProjectId()))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	project, err := endpoint.projects.Get(ctx, *projectID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	storageLimit, egressLimit, err := endpoint.usage.GetProjectUsageLimits(ctx, *projectID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	storageUsed, err := endpoint.usage.GetProjectStorageTotals(ctx, *projectID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	egressUsed, err := endpoint.usage.GetProjectBandwidthTotals(ctx, *projectID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return &pb.GetProjectUsageLimitsResponse{
+		StorageLimit:          project.StorageLimit.Int64(),
+		EgressLimit:           project.EgressLimit.Int64(),
+		EffectiveStorageLimit: storageLimit.Int64(),
+		EffectiveEgressLimit:  egressLimit.Int64(),
+		StorageUsed:           storageUsed.Int64(),
+		EgressUsed:            egressUsed.Int64(),
+	}, nil
+}
+
+// SetProjectUsageLimits changes the usage limits of a project, zero limits reset the project to the satellite defaults
+func (endpoint *ProjectEndpoint) SetProjectUsageLimits(ctx context.Context, in *pb.SetProjectUsageLimitsRequest) (resp *pb.SetProjectUsageLimitsResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	projectID, err := uuid.Parse(string(in.GetProjectId()))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	if in.GetStorageLimit() < 0 || in.GetEgressLimit() < 0 {
+		return nil, Error.New("usage limits cannot be negative")
+	}
+
+	err = endpoint.projects.UpdateUsageLimits(ctx, *projectID, memory.Size(in.GetStorageLimit()), memory.Size(in.GetEgressLimit()))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	endpoint.log.Info("project usage limits updated",
+		zap.String("Project ID", projectID.String()),
+		zap.String("Storage Limit", memory.Size(in.GetStorageLimit()).String()),
+		zap.String("Egress Limit", memory.Size(in.GetEgressLimit()).String()),
+	)
+
+	return &pb.SetProjectUsageLimitsResponse{}, nil
+}
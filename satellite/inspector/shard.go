@@ -0,0 +1,61 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package inspector
+
+import (
+	"context"
+	"time"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"go.uber.org/zap"
+
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/storage/shardkv"
+)
+
+// ShardEndpoint for moving projects between pointer database shards
+type ShardEndpoint struct {
+	log   *zap.Logger
+	store *shardkv.Store
+}
+
+// NewShardEndpoint will initialize a ShardEndpoint struct
+func NewShardEndpoint(log *zap.Logger, store *shardkv.Store) *ShardEndpoint {
+	return &ShardEndpoint{
+		log:   log,
+		store: store,
+	}
+}
+
+// MoveProject moves the pointers of a project to another shard, while the satellite keeps serving them
+func (endpoint *ShardEndpoint) MoveProject(ctx context.Context, in *pb.MoveProjectRequest) (resp *pb.MoveProjectResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	projectID, err := uuid.Parse(string(in.GetProjectId()))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	source := endpoint.store.ShardMap().Lookup(projectID.String())
+	endpoint.log.Info("moving project",
+		zap.String("Project ID", projectID.String()),
+		zap.String("Source", source),
+		zap.String("Target", in.GetShard()),
+	)
+
+	start := time.Now()
+	err = endpoint.store.MoveProject(ctx, projectID.String(), in.GetShard())
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	endpoint.log.Info("project moved",
+		zap.String("Project ID", projectID.String()),
+		zap.String("Source", source),
+		zap.String("Target", in.GetShard()),
+		zap.Duration("Duration", time.Since(start)),
+	)
+
+	return &pb.MoveProjectResponse{}, nil
+}
@@ -0,0 +1,82 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package inspector_test
+
+import (
+	"crypto/rand"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/satellite"
+	"storj.io/storj/storage/shardkv"
+)
+
+func TestShardInspectorMoveProject(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				// split the pointers into two bolt databases next to the default one
+				dir := filepath.Dir(strings.TrimPrefix(config.Metainfo.DatabaseURL, "bolt://"))
+				shardMap := &shardkv.ShardMap{
+					Shards: map[string]string{
+						"first":  "bolt://" + filepath.Join(dir, "pointers-first.db"),
+						"second": "bolt://" + filepath.Join(dir, "pointers-second.db"),
+					},
+					Default: "first",
+				}
+
+				path := filepath.Join(dir, "shards.json")
+				if err := shardMap.Save(path); err != nil {
+					panic(err)
+				}
+				config.Metainfo.DatabaseURL = "shards://" + path
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		uplink := planet.Uplinks[0]
+
+		expected := make([]byte, 10*memory.KiB)
+		_, err := rand.Read(expected)
+		require.NoError(t, err)
+		require.NoError(t, uplink.Upload(ctx, satellite, "testbucket", "test/path", expected))
+
+		projects, err := satellite.DB.Console().Projects().GetAll(ctx)
+		require.NoError(t, err)
+		require.Len(t, projects, 1)
+		projectID := projects[0].ID.String()
+
+		endpoint := satellite.Inspector.ShardEndpoint
+		require.NotNil(t, endpoint)
+
+		_, err = endpoint.MoveProject(ctx, &pb.MoveProjectRequest{
+			ProjectId: []byte(projectID),
+			Shard:     "second",
+		})
+		require.NoError(t, err)
+
+		store := satellite.Metainfo.Database.(*shardkv.Store)
+		assert.Equal(t, "second", store.ShardMap().Lookup(projectID))
+
+		data, err := uplink.Download(ctx, satellite, "testbucket", "test/path")
+		require.NoError(t, err)
+		assert.Equal(t, expected, data)
+
+		_, err = endpoint.MoveProject(ctx, &pb.MoveProjectRequest{
+			ProjectId: []byte(projectID),
+			Shard:     "missing",
+		})
+		assert.Error(t, err)
+	})
+}
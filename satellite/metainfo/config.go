@@ -10,7 +10,9 @@ import (
 	"storj.io/storj/internal/memory"
 	"storj.io/storj/storage"
 	"storj.io/storj/storage/boltdb"
+	"storj.io/storj/storage/cockroachkv"
 	"storj.io/storj/storage/postgreskv"
+	"storj.io/storj/storage/shardkv"
 )
 
 const (
@@ -25,6 +27,7 @@ type Config struct {
 	MaxInlineSegmentSize memory.Size `default:"8000" help:"maximum inline segment size"`
 	Overlay              bool        `default:"true" help:"toggle flag if overlay is enabled"`
 	BwExpiration         int         `default:"45"   help:"lifespan of bandwidth agreements in days"`
+	Reaper               ReaperConfig
 }
 
 // NewStore returns database for storing pointer data
@@ -37,6 +40,13 @@ func NewStore(logger *zap.Logger, dbURLString string) (db storage.KeyValueStore,
 		db, err = boltdb.New(source, BoltPointerBucket)
 	} else if driver == "postgresql" || driver == "postgres" {
 		db, err = postgreskv.New(source)
+	} else if driver == "cockroach" {
+		db, err = cockroachkv.New(source)
+	} else if driver == "shards" {
+		// source is the path of the shard map, which lists the database urls of the shards
+		db, err = shardkv.Open(source, func(url string) (storage.KeyValueStore, error) {
+			return NewStore(logger, url)
+		})
 	} else {
 		err = Error.New("unsupported db scheme: %s", driver)
 	}
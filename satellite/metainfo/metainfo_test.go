@@ -13,12 +13,14 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/skyrings/skyring-common/tools/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/zeebo/errs"
 
 	"storj.io/storj/internal/testcontext"
 	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/pkg/macaroon"
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/storj"
 	"storj.io/storj/satellite/console"
@@ -30,11 +32,16 @@ type mockAPIKeys struct {
 	err  error
 }
 
-// GetByKey return api key info for given key
-func (keys *mockAPIKeys) GetByKey(ctx context.Context, key console.APIKey) (*console.APIKeyInfo, error) {
+// GetByHead return api key info for given head
+func (keys *mockAPIKeys) GetByHead(ctx context.Context, head []byte) (*console.APIKeyInfo, error) {
 	return &keys.info, keys.err
 }
 
+// GetRevocations returns revocation list of the api key
+func (keys *mockAPIKeys) GetRevocations(ctx context.Context, id uuid.UUID) ([][]byte, error) {
+	return nil, keys.err
+}
+
 func TestInvalidAPIKey(t *testing.T) {
 	ctx := testcontext.New(t)
 	defer ctx.Cleanup()
@@ -66,14 +73,129 @@ func TestInvalidAPIKey(t *testing.T) {
 
 		_, _, err = client.ListSegments(ctx, "testbucket", "", "", "", true, 1, 0)
 		assertUnauthenticated(t, err)
+
+		_, err = client.MoveSegment(ctx, "testbucket", "testpath", 0, "newpath", 0, nil)
+		assertUnauthenticated(t, err)
 	}
 }
 
+func TestRestrictedAPIKey(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+
+		key, err := macaroon.ParseAPIKey(planet.Uplinks[0].APIKey[satellite.ID()])
+		require.NoError(t, err)
+
+		readOnly, err := key.Restrict(macaroon.Caveat{
+			DisallowWrites:  true,
+			DisallowDeletes: true,
+		})
+		require.NoError(t, err)
+
+		pathRestricted, err := key.Restrict(macaroon.Caveat{
+			AllowedPaths: []*macaroon.Caveat_Path{{
+				Bucket:              []byte("testbucket"),
+				EncryptedPathPrefix: []byte("allowed"),
+			}},
+		})
+		require.NoError(t, err)
+
+		hourAgo := time.Now().Add(-time.Hour)
+		expired, err := key.Restrict(macaroon.Caveat{
+			NotAfter: &hourAgo,
+		})
+		require.NoError(t, err)
+
+		client, err := planet.Uplinks[0].DialMetainfo(ctx, satellite, readOnly.Serialize())
+		require.NoError(t, err)
+
+		_, err = client.SegmentInfo(ctx, "testbucket", "testpath", 0)
+		assertStatus(t, err, codes.NotFound)
+		_, err = client.DeleteSegment(ctx, "testbucket", "testpath", 0)
+		assertStatus(t, err, codes.PermissionDenied)
+		_, _, err = client.CreateSegment(ctx, "testbucket", "testpath", 0, &pb.RedundancyScheme{}, 123, time.Now())
+		assertStatus(t, err, codes.PermissionDenied)
+
+		client, err = planet.Uplinks[0].DialMetainfo(ctx, satellite, pathRestricted.Serialize())
+		require.NoError(t, err)
+
+		_, err = client.SegmentInfo(ctx, "testbucket", "allowed/path", 0)
+		assertStatus(t, err, codes.NotFound)
+		_, err = client.SegmentInfo(ctx, "testbucket", "denied/path", 0)
+		assertStatus(t, err, codes.PermissionDenied)
+		_, err = client.SegmentInfo(ctx, "otherbucket", "allowed/path", 0)
+		assertStatus(t, err, codes.PermissionDenied)
+		_, _, err = client.ListSegments(ctx, "", "", "", "", true, 1, 0)
+		assertStatus(t, err, codes.PermissionDenied)
+
+		client, err = planet.Uplinks[0].DialMetainfo(ctx, satellite, expired.Serialize())
+		require.NoError(t, err)
+
+		_, err = client.SegmentInfo(ctx, "testbucket", "testpath", 0)
+		assertStatus(t, err, codes.PermissionDenied)
+
+		// revoking the read only key doesn't affect the key it was restricted from
+		keyInfo, err := satellite.DB.Console().APIKeys().GetByHead(ctx, key.Head())
+		require.NoError(t, err)
+		require.NoError(t, satellite.DB.Console().APIKeys().Revoke(ctx, keyInfo.ID, readOnly.Tail()))
+
+		client, err = planet.Uplinks[0].DialMetainfo(ctx, satellite, readOnly.Serialize())
+		require.NoError(t, err)
+		_, err = client.SegmentInfo(ctx, "testbucket", "testpath", 0)
+		assertStatus(t, err, codes.PermissionDenied)
+
+		client, err = planet.Uplinks[0].DialMetainfo(ctx, satellite, key.Serialize())
+		require.NoError(t, err)
+		_, err = client.SegmentInfo(ctx, "testbucket", "testpath", 0)
+		assertStatus(t, err, codes.NotFound)
+	})
+}
+
+func TestLegacyAPIKey(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+
+		projects, err := satellite.DB.Console().Projects().GetAll(ctx)
+		require.NoError(t, err)
+
+		// legacy keys are migrated to use the key as the secret
+		legacy := console.APIKeyFromBytes([]byte("legacy api key"))
+		_, err = satellite.DB.Console().APIKeys().Create(ctx, legacy.Head(), console.APIKeyInfo{
+			Name:      "legacy",
+			ProjectID: projects[0].ID,
+			Secret:    legacy[:],
+		})
+		require.NoError(t, err)
+
+		client, err := planet.Uplinks[0].DialMetainfo(ctx, satellite, legacy.String())
+		require.NoError(t, err)
+		_, err = client.SegmentInfo(ctx, "testbucket", "testpath", 0)
+		assertStatus(t, err, codes.NotFound)
+
+		forged := console.APIKeyFromBytes([]byte("forged api key"))
+		client, err = planet.Uplinks[0].DialMetainfo(ctx, satellite, forged.String())
+		require.NoError(t, err)
+		_, err = client.SegmentInfo(ctx, "testbucket", "testpath", 0)
+		assertUnauthenticated(t, err)
+	})
+}
+
 func assertUnauthenticated(t *testing.T, err error) {
 	t.Helper()
 
+	assertStatus(t, err, codes.Unauthenticated)
+}
+
+func assertStatus(t *testing.T, err error, code codes.Code) {
+	t.Helper()
+
+	require.Error(t, err)
 	if err, ok := status.FromError(errs.Unwrap(err)); ok {
-		assert.Equal(t, codes.Unauthenticated, err.Code())
+		assert.Equal(t, code, err.Code())
 	} else {
 		assert.Fail(t, "got unexpected error", "%T", err)
 	}
@@ -163,9 +285,7 @@ func TestCommitSegment(t *testing.T) {
 	testplanet.Run(t, testplanet.Config{
 		SatelliteCount: 1, StorageNodeCount: 6, UplinkCount: 1,
 	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
-		projects, err := planet.Satellites[0].DB.Console().Projects().GetAll(ctx)
-		require.NoError(t, err)
-		apiKey := console.APIKeyFromBytes([]byte(projects[0].Name)).String()
+		apiKey := planet.Uplinks[0].APIKey[planet.Satellites[0].ID()]
 
 		metainfo, err := planet.Uplinks[0].DialMetainfo(ctx, planet.Satellites[0], apiKey)
 		require.NoError(t, err)
@@ -220,3 +340,56 @@ func TestCommitSegment(t *testing.T) {
 		}
 	})
 }
+
+func TestMoveSegment(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		apiKey := planet.Uplinks[0].APIKey[planet.Satellites[0].ID()]
+
+		for _, path := range []string{"first", "second"} {
+			err := planet.Uplinks[0].Upload(ctx, planet.Satellites[0], "testbucket", path, []byte("inline data"))
+			require.NoError(t, err)
+		}
+
+		client, err := planet.Uplinks[0].DialMetainfo(ctx, planet.Satellites[0], apiKey)
+		require.NoError(t, err)
+
+		items, _, err := client.ListSegments(ctx, "testbucket", "", "", "", true, 10, 0)
+		require.NoError(t, err)
+		require.Len(t, items, 2)
+		first, second := items[0].Path, items[1].Path
+
+		original, err := client.SegmentInfo(ctx, "testbucket", first, -1)
+		require.NoError(t, err)
+
+		// moving over an existing segment would lose its pieces
+		_, err = client.MoveSegment(ctx, "testbucket", first, -1, second, -1, nil)
+		require.Error(t, err)
+		assert.Equal(t, codes.AlreadyExists, status.Code(errs.Unwrap(err)))
+
+		_, err = client.MoveSegment(ctx, "testbucket", "missing", -1, "moved", 0, nil)
+		require.Error(t, err)
+
+		moved, err := client.MoveSegment(ctx, "testbucket", first, -1, "moved", 0, []byte("new metadata"))
+		require.NoError(t, err)
+		assert.Equal(t, original.InlineSegment, moved.InlineSegment)
+		assert.Equal(t, []byte("new metadata"), moved.Metadata)
+
+		pointer, err := client.SegmentInfo(ctx, "testbucket", "moved", 0)
+		require.NoError(t, err)
+		assert.Equal(t, original.InlineSegment, pointer.InlineSegment)
+		assert.Equal(t, []byte("new metadata"), pointer.Metadata)
+
+		_, err = client.SegmentInfo(ctx, "testbucket", first, -1)
+		require.Error(t, err)
+
+		// moving a segment to its own path replaces its metadata
+		_, err = client.MoveSegment(ctx, "testbucket", second, -1, second, -1, []byte("updated"))
+		require.NoError(t, err)
+
+		pointer, err = client.SegmentInfo(ctx, "testbucket", second, -1)
+		require.NoError(t, err)
+		assert.Equal(t, []byte("updated"), pointer.Metadata)
+	})
+}
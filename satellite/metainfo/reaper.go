@@ -0,0 +1,147 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"context"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"go.uber.org/zap"
+
+	"storj.io/storj/internal/sync2"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/storage"
+)
+
+// ReaperConfig contains configurable values for deleting expired objects
+type ReaperConfig struct {
+	Interval time.Duration `help:"how frequently the pointers of expired objects are deleted" releaseDefault:"1h" devDefault:"1m"`
+}
+
+// IsExpired returns whether the pointer has an expiration date, which is not after now
+func IsExpired(pointer *pb.Pointer, now time.Time) bool {
+	expiration := pointer.GetExpirationDate()
+	if expiration == nil {
+		return false
+	}
+	expiresAt, err := ptypes.Timestamp(expiration)
+	if err != nil {
+		return false
+	}
+	return !expiresAt.After(now)
+}
+
+// Reaper periodically deletes the pointers of expired objects.
+//
+// The storage nodes delete the pieces of expired segments on their own,
+// so only the pointers need to be deleted.
+type Reaper struct {
+	log      *zap.Logger
+	metainfo *Service
+
+	Loop sync2.Cycle
+}
+
+// NewReaper creates a new expired object reaper
+func NewReaper(log *zap.Logger, config ReaperConfig, metainfo *Service) *Reaper {
+	return &Reaper{
+		log:      log,
+		metainfo: metainfo,
+
+		Loop: *sync2.NewCycle(config.Interval),
+	}
+}
+
+// Run periodically deletes the pointers of expired objects
+func (reaper *Reaper) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return reaper.Loop.Run(ctx, func(ctx context.Context) error {
+		deleted, err := reaper.DeleteExpired(ctx, time.Now())
+		if err != nil {
+			reaper.log.Error("deleting expired objects failed", zap.Error(err))
+		}
+
+		if deleted > 0 {
+			reaper.log.Debug("deleted expired segments", zap.Int64("count", deleted))
+		}
+		return nil
+	})
+}
+
+// Close halts the reaper loop
+func (reaper *Reaper) Close() error {
+	reaper.Loop.Close()
+	return nil
+}
+
+// expiredPointer is a pointer, which is deleted unless it has been modified since it was read.
+type expiredPointer struct {
+	path  string
+	value []byte
+}
+
+// DeleteExpired deletes the pointers of all segments, which have expired at now.
+func (reaper *Reaper) DeleteExpired(ctx context.Context, now time.Time) (deleted int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var first string
+	for {
+		expired, next, err := reaper.findExpired(ctx, first, now)
+		if err != nil {
+			return deleted, err
+		}
+
+		for _, pointer := range expired {
+			// the object may have been uploaded again since it was read
+			err := reaper.metainfo.CompareAndSwap(ctx, pointer.path, pointer.value, nil)
+			if err != nil {
+				if storage.ErrValueChanged.Has(err) || storage.ErrKeyNotFound.Has(err) {
+					continue
+				}
+				return deleted, err
+			}
+			deleted++
+		}
+		mon.Meter("expired_segments_deleted").Mark(len(expired))
+
+		if next == "" {
+			return deleted, nil
+		}
+		first = next
+	}
+}
+
+// findExpired finds up to storage.LookupLimit expired pointers starting at first.
+// It returns the path where the search should continue, which is empty after all
+// pointers have been searched.
+//
+// The pointers are deleted only after iteration, because some databases don't
+// support modifications during iteration.
+func (reaper *Reaper) findExpired(ctx context.Context, first string, now time.Time) (expired []expiredPointer, next string, err error) {
+	err = reaper.metainfo.Iterate(ctx, "", first, true, false, func(it storage.Iterator) error {
+		var item storage.ListItem
+		for it.Next(&item) {
+			if len(expired) >= storage.LookupLimit {
+				next = item.Key.String()
+				return nil
+			}
+
+			pointer := &pb.Pointer{}
+			if err := proto.Unmarshal(item.Value, pointer); err != nil {
+				return Error.New("error unmarshalling pointer %q: %v", item.Key.String(), err)
+			}
+			if IsExpired(pointer, now) {
+				expired = append(expired, expiredPointer{
+					path:  item.Key.String(),
+					value: storage.CloneValue(item.Value),
+				})
+			}
+		}
+		return nil
+	})
+	return expired, next, err
+}
@@ -0,0 +1,76 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storage/meta"
+	"storj.io/storj/satellite/metainfo"
+	"storj.io/storj/storage"
+	"storj.io/storj/storage/teststore"
+)
+
+func TestReaper(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	log := zaptest.NewLogger(t)
+	service := metainfo.NewService(log, teststore.New(), nil)
+	reaper := metainfo.NewReaper(log, metainfo.ReaperConfig{Interval: time.Hour}, service)
+
+	now := time.Now()
+	pointer := func(expiration time.Duration) *pb.Pointer {
+		pointer := &pb.Pointer{Type: pb.Pointer_INLINE, InlineSegment: []byte("data")}
+		if expiration != 0 {
+			expirationDate, err := ptypes.TimestampProto(now.Add(expiration))
+			require.NoError(t, err)
+			pointer.ExpirationDate = expirationDate
+		}
+		return pointer
+	}
+
+	require.NoError(t, service.Put(ctx, "project/l/bucket/expired", pointer(-time.Hour)))
+	require.NoError(t, service.Put(ctx, "project/l/bucket/expiring", pointer(time.Hour)))
+	require.NoError(t, service.Put(ctx, "project/l/bucket/permanent", pointer(0)))
+	require.NoError(t, service.Put(ctx, "project/s0/bucket/expired", pointer(-time.Minute)))
+
+	items, more, err := service.List(ctx, "project/l/bucket", "", "", true, 0, meta.None)
+	require.NoError(t, err)
+	assert.False(t, more)
+	require.Len(t, items, 2)
+	assert.Equal(t, "expiring", items[0].Path)
+	assert.Equal(t, "permanent", items[1].Path)
+
+	// listing continues past expired objects to fill the limit
+	items, _, err = service.List(ctx, "project/l/bucket", "", "permanent", true, 1, meta.None)
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	assert.Equal(t, "expiring", items[0].Path)
+
+	deleted, err := reaper.DeleteExpired(ctx, now)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), deleted)
+
+	_, err = service.Get(ctx, "project/l/bucket/expired")
+	assert.True(t, storage.ErrKeyNotFound.Has(err))
+	_, err = service.Get(ctx, "project/s0/bucket/expired")
+	assert.True(t, storage.ErrKeyNotFound.Has(err))
+
+	// the remaining objects expire later
+	deleted, err = reaper.DeleteExpired(ctx, now.Add(2*time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), deleted)
+
+	_, err = service.Get(ctx, "project/l/bucket/permanent")
+	assert.NoError(t, err)
+}
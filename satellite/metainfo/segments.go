@@ -0,0 +1,188 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"go.uber.org/zap"
+
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storage"
+)
+
+// SegmentsDB stores the metadata of segment pointers in a form, which can be queried
+// without deserializing the whole pointer database
+type SegmentsDB interface {
+	// Put stores the metadata of the pointer of the segment, replacing the previous metadata
+	Put(ctx context.Context, location SegmentLocation, pointer *pb.Pointer) error
+	// Delete deletes the metadata of the segment, the object is deleted together with its last segment
+	Delete(ctx context.Context, location SegmentLocation) error
+	// List lists up to limit segments, which come after the segment with the given id
+	List(ctx context.Context, afterID int64, limit int) ([]Segment, error)
+	// ListByNode lists up to limit segments with a piece on the node, which come after the segment with the given id
+	ListByNode(ctx context.Context, nodeID storj.NodeID, afterID int64, limit int) ([]NodeSegment, error)
+	// Expiring returns the number of segments and their total size, which expire in [from, to)
+	Expiring(ctx context.Context, from, to time.Time) (segments int64, size int64, err error)
+	// BucketSize returns the amount of data stored in a bucket
+	BucketSize(ctx context.Context, projectID uuid.UUID, bucketName []byte) (BucketSize, error)
+}
+
+// SegmentLocation identifies a segment by the parts of its pointer path
+type SegmentLocation struct {
+	ProjectID    uuid.UUID
+	SegmentIndex int64 // -1 for the last segment
+	BucketName   []byte
+	ObjectKey    []byte
+}
+
+// Segment is a segment stored in the SegmentsDB
+type Segment struct {
+	// ID identifies the segment in the SegmentsDB, it can be used to continue listing
+	ID       int64
+	Location SegmentLocation
+}
+
+// NodeSegment is a segment, which has a piece stored on a node
+type NodeSegment struct {
+	// ID identifies the segment in the SegmentsDB, it can be used to continue listing
+	ID       int64
+	Location SegmentLocation
+	PieceNum int32
+}
+
+// BucketSize is the amount of data stored in a bucket
+type BucketSize struct {
+	Objects        int64
+	InlineSegments int64
+	RemoteSegments int64
+	InlineBytes    int64
+	RemoteBytes    int64
+}
+
+// ParseSegmentPath parses a pointer path created by CreatePath. It returns an error,
+// when the path doesn't belong to a segment of an object.
+func ParseSegmentPath(path storj.Path) (location SegmentLocation, err error) {
+	elements := strings.SplitN(path, "/", 4)
+	if len(elements) < 4 || elements[2] == "" || elements[3] == "" {
+		return location, Error.New("not a segment path: %q", path)
+	}
+
+	projectID, err := uuid.Parse(elements[0])
+	if err != nil {
+		return location, Error.New("invalid project id in path %q: %v", path, err)
+	}
+	location.ProjectID = *projectID
+
+	switch segment := elements[1]; {
+	case segment == "l":
+		location.SegmentIndex = -1
+	case strings.HasPrefix(segment, "s"):
+		location.SegmentIndex, err = strconv.ParseInt(segment[1:], 10, 64)
+		if err != nil || location.SegmentIndex < 0 {
+			return location, Error.New("invalid segment index in path %q", path)
+		}
+	default:
+		return location, Error.New("invalid segment index in path %q", path)
+	}
+
+	location.BucketName = []byte(elements[2])
+	location.ObjectKey = []byte(elements[3])
+	return location, nil
+}
+
+// Path returns the pointer path of the segment
+func (location SegmentLocation) Path() storj.Path {
+	path, _ := CreatePath(location.ProjectID, location.SegmentIndex, location.BucketName, location.ObjectKey)
+	return path
+}
+
+// MigrateSegments stores the metadata of all segment pointers in the segments database
+// and removes the metadata of segments without a pointer. Existing metadata is replaced,
+// so the migration can be run again after it was interrupted or to repair the metadata,
+// when updating it together with the pointers failed.
+func MigrateSegments(ctx context.Context, log *zap.Logger, pointers storage.KeyValueStore, segments SegmentsDB) (count int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = pointers.Iterate(ctx, storage.IterateOptions{Recurse: true}, func(it storage.Iterator) error {
+		var item storage.ListItem
+		for it.Next(&item) {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+
+			location, err := ParseSegmentPath(item.Key.String())
+			if err != nil {
+				continue
+			}
+
+			pointer := &pb.Pointer{}
+			if err := proto.Unmarshal(item.Value, pointer); err != nil {
+				log.Warn("skipping invalid pointer", zap.String("Path", item.Key.String()), zap.Error(err))
+				continue
+			}
+
+			if err := segments.Put(ctx, location, pointer); err != nil {
+				return Error.New("storing metadata of %q failed: %v", item.Key.String(), err)
+			}
+
+			count++
+			if count%10000 == 0 {
+				log.Info("migrating segments", zap.Int64("Segments", count))
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return count, err
+	}
+
+	removed, err := removeStaleSegments(ctx, pointers, segments)
+	if removed > 0 {
+		log.Info("removed metadata of segments without pointer", zap.Int64("Segments", removed))
+	}
+	return count, err
+}
+
+// removeStaleSegments deletes the metadata of the segments, which don't have a pointer
+func removeStaleSegments(ctx context.Context, pointers storage.KeyValueStore, segments SegmentsDB) (removed int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	const batchSize = 1000
+
+	var afterID int64
+	for {
+		list, err := segments.List(ctx, afterID, batchSize)
+		if err != nil {
+			return removed, Error.Wrap(err)
+		}
+
+		for _, segment := range list {
+			afterID = segment.ID
+
+			_, err := pointers.Get(ctx, storage.Key(segment.Location.Path()))
+			if err == nil {
+				continue
+			}
+			if !storage.ErrKeyNotFound.Has(err) {
+				return removed, Error.Wrap(err)
+			}
+
+			if err := segments.Delete(ctx, segment.Location); err != nil {
+				return removed, Error.New("removing metadata of %q failed: %v", segment.Location.Path(), err)
+			}
+			removed++
+		}
+
+		if len(list) < batchSize {
+			return removed, nil
+		}
+	}
+}
@@ -0,0 +1,179 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/teststorj"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/metainfo"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+	"storj.io/storj/storage/teststore"
+)
+
+func TestParseSegmentPath(t *testing.T) {
+	projectID, err := uuid.New()
+	require.NoError(t, err)
+
+	for _, segmentIndex := range []int64{-1, 0, 15} {
+		location := metainfo.SegmentLocation{
+			ProjectID:    *projectID,
+			SegmentIndex: segmentIndex,
+			BucketName:   []byte("bucket"),
+			ObjectKey:    []byte("encrypted/object/key"),
+		}
+		parsed, err := metainfo.ParseSegmentPath(location.Path())
+		require.NoError(t, err)
+		assert.Equal(t, location, parsed)
+	}
+
+	for _, path := range []storj.Path{
+		"",
+		projectID.String() + "/l/bucket",
+		projectID.String() + "/x/bucket/object",
+		projectID.String() + "/s-1/bucket/object",
+		"project/l/bucket/object",
+	} {
+		_, err := metainfo.ParseSegmentPath(path)
+		assert.Error(t, err, path)
+	}
+}
+
+func TestSegmentsDB(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		segments := db.Segments()
+		service := metainfo.NewService(zaptest.NewLogger(t), teststore.New(), segments)
+
+		projectID, err := uuid.New()
+		require.NoError(t, err)
+		node1, node2 := teststorj.NodeIDFromString("node1"), teststorj.NodeIDFromString("node2")
+
+		now := time.Now()
+		expiration, err := ptypes.TimestampProto(now.Add(24 * time.Hour))
+		require.NoError(t, err)
+
+		remote := func(size int64, nodes ...storj.NodeID) *pb.Pointer {
+			pointer := &pb.Pointer{
+				Type:           pb.Pointer_REMOTE,
+				SegmentSize:    size,
+				ExpirationDate: expiration,
+				Remote:         &pb.RemoteSegment{RootPieceId: storj.NewPieceID()},
+			}
+			for i, node := range nodes {
+				pointer.Remote.RemotePieces = append(pointer.Remote.RemotePieces, &pb.RemotePiece{
+					PieceNum: int32(i),
+					NodeId:   node,
+				})
+			}
+			return pointer
+		}
+
+		path := func(segmentIndex int64, object string) storj.Path {
+			path, err := metainfo.CreatePath(*projectID, segmentIndex, []byte("bucket"), []byte(object))
+			require.NoError(t, err)
+			return path
+		}
+
+		require.NoError(t, service.Put(ctx, path(0, "a"), remote(1000, node1, node2)))
+		require.NoError(t, service.Put(ctx, path(-1, "a"), &pb.Pointer{Type: pb.Pointer_INLINE, InlineSegment: []byte("last")}))
+		require.NoError(t, service.Put(ctx, path(-1, "b"), remote(500, node2)))
+		// bucket pointers are not segments
+		require.NoError(t, service.Put(ctx, projectID.String()+"/l/bucket", &pb.Pointer{}))
+
+		size, err := segments.BucketSize(ctx, *projectID, []byte("bucket"))
+		require.NoError(t, err)
+		assert.Equal(t, metainfo.BucketSize{
+			Objects:        2,
+			InlineSegments: 1,
+			RemoteSegments: 2,
+			InlineBytes:    4,
+			RemoteBytes:    1500,
+		}, size)
+
+		count, bytes, err := segments.Expiring(ctx, now, now.Add(48*time.Hour))
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), count)
+		assert.Equal(t, int64(1500), bytes)
+
+		count, _, err = segments.Expiring(ctx, now.Add(48*time.Hour), now.Add(72*time.Hour))
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), count)
+
+		listed, err := segments.ListByNode(ctx, node2, 0, 10)
+		require.NoError(t, err)
+		require.Len(t, listed, 2)
+		assert.Equal(t, path(0, "a"), listed[0].Location.Path())
+		assert.Equal(t, int32(1), listed[0].PieceNum)
+		assert.Equal(t, path(-1, "b"), listed[1].Location.Path())
+
+		// listing continues after the last returned segment
+		listed, err = segments.ListByNode(ctx, node2, listed[0].ID, 10)
+		require.NoError(t, err)
+		require.Len(t, listed, 1)
+		assert.Equal(t, path(-1, "b"), listed[0].Location.Path())
+
+		// replacing a segment replaces its pieces
+		require.NoError(t, service.Put(ctx, path(0, "a"), remote(1000, node2)))
+		listed, err = segments.ListByNode(ctx, node1, 0, 10)
+		require.NoError(t, err)
+		assert.Empty(t, listed)
+
+		// the object is deleted with its last segment
+		require.NoError(t, service.Delete(ctx, path(0, "a")))
+		require.NoError(t, service.Delete(ctx, path(-1, "a")))
+
+		size, err = segments.BucketSize(ctx, *projectID, []byte("bucket"))
+		require.NoError(t, err)
+		assert.Equal(t, metainfo.BucketSize{Objects: 1, RemoteSegments: 1, RemoteBytes: 500}, size)
+	})
+}
+
+func TestMigrateSegments(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		log := zaptest.NewLogger(t)
+		pointers := teststore.New()
+
+		// pointers stored before the segment metadata was maintained
+		service := metainfo.NewService(log, pointers, nil)
+
+		projectID, err := uuid.New()
+		require.NoError(t, err)
+		for _, object := range []string{"a", "b", "c"} {
+			path, err := metainfo.CreatePath(*projectID, -1, []byte("bucket"), []byte(object))
+			require.NoError(t, err)
+			require.NoError(t, service.Put(ctx, path, &pb.Pointer{Type: pb.Pointer_INLINE, InlineSegment: []byte(object)}))
+		}
+
+		// metadata left behind by a failed delete
+		stale := metainfo.SegmentLocation{ProjectID: *projectID, SegmentIndex: -1, BucketName: []byte("bucket"), ObjectKey: []byte("deleted")}
+		require.NoError(t, db.Segments().Put(ctx, stale, &pb.Pointer{Type: pb.Pointer_INLINE, InlineSegment: []byte("deleted")}))
+
+		for i := 0; i < 2; i++ {
+			count, err := metainfo.MigrateSegments(ctx, log, pointers, db.Segments())
+			require.NoError(t, err)
+			assert.Equal(t, int64(3), count)
+		}
+
+		size, err := db.Segments().BucketSize(ctx, *projectID, []byte("bucket"))
+		require.NoError(t, err)
+		assert.Equal(t, metainfo.BucketSize{Objects: 3, InlineSegments: 3, InlineBytes: 3}, size)
+	})
+}
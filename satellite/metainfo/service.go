@@ -4,6 +4,9 @@
 package metainfo
 
 import (
+	"context"
+	"time"
+
 	"github.com/gogo/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/zeebo/errs"
@@ -16,17 +19,20 @@ import (
 
 // Service structure
 type Service struct {
-	logger *zap.Logger
-	DB     storage.KeyValueStore
+	logger   *zap.Logger
+	DB       storage.KeyValueStore
+	segments SegmentsDB
 }
 
-// NewService creates new metainfo service
-func NewService(logger *zap.Logger, db storage.KeyValueStore) *Service {
-	return &Service{logger: logger, DB: db}
+// NewService creates new metainfo service, segments may be nil
+func NewService(logger *zap.Logger, db storage.KeyValueStore, segments SegmentsDB) *Service {
+	return &Service{logger: logger, DB: db, segments: segments}
 }
 
 // Put puts pointer to db under specific path
-func (s *Service) Put(path string, pointer *pb.Pointer) (err error) {
+func (s *Service) Put(ctx context.Context, path string, pointer *pb.Pointer) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
 	// Update the pointer with the creation date
 	pointer.CreationDate = ptypes.TimestampNow()
 
@@ -38,32 +44,72 @@ func (s *Service) Put(path string, pointer *pb.Pointer) (err error) {
 	// TODO(kaloyan): make sure that we know we are overwriting the pointer!
 	// In such case we should delete the pieces of the old segment if it was
 	// a remote one.
-	if err = s.DB.Put([]byte(path), pointerBytes); err != nil {
+	if err = s.DB.Put(ctx, []byte(path), pointerBytes); err != nil {
 		return err
 	}
 
+	s.putSegment(ctx, path, pointer)
 	return nil
 }
 
 // Get gets pointer from db
-func (s *Service) Get(path string) (pointer *pb.Pointer, err error) {
-	pointerBytes, err := s.DB.Get([]byte(path))
+func (s *Service) Get(ctx context.Context, path string) (pointer *pb.Pointer, err error) {
+	defer mon.Task()(&ctx)(&err)
+	_, pointer, err = s.GetWithBytes(ctx, path)
+	return pointer, err
+}
+
+// GetWithBytes gets pointer from db together with its serialized form,
+// which can be used later with CompareAndSwap
+func (s *Service) GetWithBytes(ctx context.Context, path string) (pointerBytes []byte, pointer *pb.Pointer, err error) {
+	defer mon.Task()(&ctx)(&err)
+	pointerBytes, err = s.DB.Get(ctx, []byte(path))
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	pointer = &pb.Pointer{}
 	err = proto.Unmarshal(pointerBytes, pointer)
 	if err != nil {
-		return nil, errs.New("error unmarshaling pointer: %v", err)
+		return nil, nil, errs.New("error unmarshaling pointer: %v", err)
+	}
+
+	return pointerBytes, pointer, nil
+}
+
+// CompareAndSwap replaces the pointer under path with newPointer, only when the
+// stored pointer still matches oldPointerBytes. A nil newPointer deletes the pointer.
+//
+// It returns storage.ErrValueChanged when the pointer was modified and
+// storage.ErrKeyNotFound when the pointer was deleted in the meantime.
+func (s *Service) CompareAndSwap(ctx context.Context, path string, oldPointerBytes []byte, newPointer *pb.Pointer) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var newPointerBytes []byte
+	if newPointer != nil {
+		newPointerBytes, err = proto.Marshal(newPointer)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = s.DB.CompareAndSwap(ctx, []byte(path), oldPointerBytes, newPointerBytes)
+	if err != nil {
+		return err
 	}
 
-	return pointer, nil
+	if newPointer != nil {
+		s.putSegment(ctx, path, newPointer)
+	} else {
+		s.deleteSegment(ctx, path)
+	}
+	return nil
 }
 
 // List returns all Path keys in the pointers bucket
-func (s *Service) List(prefix string, startAfter string, endBefore string, recursive bool, limit int32,
+func (s *Service) List(ctx context.Context, prefix string, startAfter string, endBefore string, recursive bool, limit int32,
 	metaFlags uint32) (items []*pb.ListResponse_Item, more bool, err error) {
+	defer mon.Task()(&ctx)(&err)
 
 	var prefixKey storage.Key
 	if prefix != "" {
@@ -73,53 +119,87 @@ func (s *Service) List(prefix string, startAfter string, endBefore string, recur
 		}
 	}
 
-	rawItems, more, err := storage.ListV2(s.DB, storage.ListOptions{
-		Prefix:       prefixKey,
-		StartAfter:   storage.Key(startAfter),
-		EndBefore:    storage.Key(endBefore),
-		Recursive:    recursive,
-		Limit:        int(limit),
-		IncludeValue: metaFlags != meta.None,
-	})
-	if err != nil {
-		return nil, false, err
+	if limit <= 0 || limit > storage.LookupLimit {
+		limit = storage.LookupLimit
 	}
 
-	for _, rawItem := range rawItems {
-		items = append(items, s.createListItem(rawItem, metaFlags))
+	opts := storage.ListOptions{
+		Prefix:     prefixKey,
+		StartAfter: storage.Key(startAfter),
+		EndBefore:  storage.Key(endBefore),
+		Recursive:  recursive,
+		// the values are needed to hide expired objects
+		IncludeValue: true,
+	}
+	reverse := !opts.EndBefore.IsZero()
+
+	now := time.Now()
+	for {
+		opts.Limit = int(limit) - len(items)
+
+		var rawItems storage.Items
+		rawItems, more, err = storage.ListV2(ctx, s.DB, opts)
+		if err != nil {
+			return nil, false, err
+		}
+
+		var listed []*pb.ListResponse_Item
+		for _, rawItem := range rawItems {
+			if item := s.createListItem(rawItem, metaFlags, now); item != nil {
+				listed = append(listed, item)
+			}
+		}
+
+		// ListV2 returns the items in ascending order also when listing backwards
+		if reverse {
+			items = append(listed, items...)
+		} else {
+			items = append(items, listed...)
+		}
+
+		// expired objects were skipped, continue listing to fill the limit
+		if !more || len(items) >= int(limit) || len(rawItems) == 0 {
+			return items, more, nil
+		}
+		if reverse {
+			opts.EndBefore = rawItems[0].Key
+		} else {
+			opts.StartAfter = rawItems[len(rawItems)-1].Key
+		}
 	}
-	return items, more, nil
 }
 
 // createListItem creates a new list item with the given path. It also adds
-// the metadata according to the given metaFlags.
-func (s *Service) createListItem(rawItem storage.ListItem, metaFlags uint32) *pb.ListResponse_Item {
+// the metadata according to the given metaFlags. It returns nil, when the
+// object has expired at now.
+func (s *Service) createListItem(rawItem storage.ListItem, metaFlags uint32, now time.Time) *pb.ListResponse_Item {
 	item := &pb.ListResponse_Item{
 		Path:     rawItem.Key.String(),
 		IsPrefix: rawItem.IsPrefix,
 	}
-	if item.IsPrefix {
+	if item.IsPrefix || len(rawItem.Value) == 0 {
 		return item
 	}
 
-	err := s.setMetadata(item, rawItem.Value, metaFlags)
+	pr := &pb.Pointer{}
+	err := proto.Unmarshal(rawItem.Value, pr)
 	if err != nil {
 		s.logger.Warn("err retrieving metadata", zap.Error(err))
+		return item
+	}
+	if IsExpired(pr, now) {
+		return nil
 	}
+
+	s.setMetadata(item, pr, metaFlags)
 	return item
 }
 
-// getMetadata adds the metadata to the given item pointer according to the
+// setMetadata adds the metadata to the given item pointer according to the
 // given metaFlags
-func (s *Service) setMetadata(item *pb.ListResponse_Item, data []byte, metaFlags uint32) (err error) {
-	if metaFlags == meta.None || len(data) == 0 {
-		return nil
-	}
-
-	pr := &pb.Pointer{}
-	err = proto.Unmarshal(data, pr)
-	if err != nil {
-		return err
+func (s *Service) setMetadata(item *pb.ListResponse_Item, pr *pb.Pointer, metaFlags uint32) {
+	if metaFlags == meta.None {
+		return
 	}
 
 	// Start with an empty pointer to and add only what's requested in
@@ -137,22 +217,61 @@ func (s *Service) setMetadata(item *pb.ListResponse_Item, data []byte, metaFlags
 	if metaFlags&meta.UserDefined != 0 {
 		item.Pointer.Metadata = pr.GetMetadata()
 	}
+}
+
+// Delete deletes from item from db
+func (s *Service) Delete(ctx context.Context, path string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	err = s.DB.Delete(ctx, []byte(path))
+	if err != nil {
+		return err
+	}
 
+	s.deleteSegment(ctx, path)
 	return nil
 }
 
-// Delete deletes from item from db
-func (s *Service) Delete(path string) (err error) {
-	return s.DB.Delete([]byte(path))
+// putSegment updates the segment metadata after the pointer has been stored.
+//
+// The pointer database remains the source of truth, so failures are only logged;
+// MigrateSegments repairs the metadata, including removing the segments without a pointer.
+func (s *Service) putSegment(ctx context.Context, path string, pointer *pb.Pointer) {
+	if s.segments == nil {
+		return
+	}
+	location, err := ParseSegmentPath(path)
+	if err != nil {
+		return
+	}
+	if err := s.segments.Put(ctx, location, pointer); err != nil {
+		mon.Meter("segment_metadata_errors").Mark(1)
+		s.logger.Warn("failed to update segment metadata", zap.String("Path", path), zap.Error(err))
+	}
+}
+
+// deleteSegment deletes the segment metadata after the pointer has been deleted.
+func (s *Service) deleteSegment(ctx context.Context, path string) {
+	if s.segments == nil {
+		return
+	}
+	location, err := ParseSegmentPath(path)
+	if err != nil {
+		return
+	}
+	if err := s.segments.Delete(ctx, location); err != nil {
+		mon.Meter("segment_metadata_errors").Mark(1)
+		s.logger.Warn("failed to delete segment metadata", zap.String("Path", path), zap.Error(err))
+	}
 }
 
 // Iterate iterates over items in db
-func (s *Service) Iterate(prefix string, first string, recurse bool, reverse bool, f func(it storage.Iterator) error) (err error) {
+func (s *Service) Iterate(ctx context.Context, prefix string, first string, recurse bool, reverse bool, f func(it storage.Iterator) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
 	opts := storage.IterateOptions{
 		Prefix:  storage.Key(prefix),
 		First:   storage.Key(first),
 		Recurse: recurse,
 		Reverse: reverse,
 	}
-	return s.DB.Iterate(opts, f)
+	return s.DB.Iterate(ctx, opts, f)
 }
@@ -0,0 +1,62 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/satellite/metainfo"
+	"storj.io/storj/storage"
+	"storj.io/storj/storage/teststore"
+)
+
+func TestServiceCompareAndSwap(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	service := metainfo.NewService(zaptest.NewLogger(t), teststore.New(), nil)
+
+	const path = "project/l/bucket/object"
+	require.NoError(t, service.Put(ctx, path, &pb.Pointer{Type: pb.Pointer_INLINE, InlineSegment: []byte("original")}))
+
+	originalBytes, original, err := service.GetWithBytes(ctx, path)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("original"), original.InlineSegment)
+
+	// overwrite the pointer, as an uplink would during a repair
+	require.NoError(t, service.Put(ctx, path, &pb.Pointer{Type: pb.Pointer_INLINE, InlineSegment: []byte("overwritten")}))
+
+	err = service.CompareAndSwap(ctx, path, originalBytes, &pb.Pointer{Type: pb.Pointer_INLINE, InlineSegment: []byte("repaired")})
+	assert.True(t, storage.ErrValueChanged.Has(err), err)
+
+	pointer, err := service.Get(ctx, path)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("overwritten"), pointer.InlineSegment)
+
+	// swapping against the current pointer succeeds
+	currentBytes, _, err := service.GetWithBytes(ctx, path)
+	require.NoError(t, err)
+
+	err = service.CompareAndSwap(ctx, path, currentBytes, &pb.Pointer{Type: pb.Pointer_INLINE, InlineSegment: []byte("repaired")})
+	require.NoError(t, err)
+
+	pointer, err = service.Get(ctx, path)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("repaired"), pointer.InlineSegment)
+
+	// swapping a deleted pointer must not resurrect it
+	require.NoError(t, service.Delete(ctx, path))
+
+	err = service.CompareAndSwap(ctx, path, currentBytes, &pb.Pointer{Type: pb.Pointer_INLINE, InlineSegment: []byte("resurrected")})
+	assert.True(t, storage.ErrKeyNotFound.Has(err), err)
+
+	_, err = service.Get(ctx, path)
+	assert.True(t, storage.ErrKeyNotFound.Has(err), err)
+}
@@ -0,0 +1,57 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package notifications
+
+import (
+	"fmt"
+)
+
+// UsageThresholdEmail is mailservice template for the email about a project reaching a threshold of its usage limit
+type UsageThresholdEmail struct {
+	UserName    string
+	ProjectName string
+	Resource    string
+	Percent     int
+	Used        string
+	Limit       string
+}
+
+// Template returns email template name
+func (*UsageThresholdEmail) Template() string { return "UsageThreshold" }
+
+// Subject gets email subject
+func (email *UsageThresholdEmail) Subject() string {
+	return fmt.Sprintf("The Project %s has used %d%% of its %s limit", email.ProjectName, email.Percent, email.Resource)
+}
+
+// APIKeyCreatedEmail is mailservice template for the email about a new api key
+type APIKeyCreatedEmail struct {
+	UserName     string
+	ProjectName  string
+	KeyName      string
+	CreatorEmail string
+}
+
+// Template returns email template name
+func (*APIKeyCreatedEmail) Template() string { return "APIKeyCreated" }
+
+// Subject gets email subject
+func (email *APIKeyCreatedEmail) Subject() string {
+	return "A new API key was created in the Project " + email.ProjectName
+}
+
+// ProjectInvitationEmail is mailservice template for project invitation email
+type ProjectInvitationEmail struct {
+	UserName    string
+	ProjectName string
+	SignInLink  string
+}
+
+// Template returns email template name
+func (*ProjectInvitationEmail) Template() string { return "Invite" }
+
+// Subject gets email subject
+func (email *ProjectInvitationEmail) Subject() string {
+	return "You were invited to join the Project " + email.ProjectName
+}
@@ -0,0 +1,323 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package notifications
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/post"
+	"storj.io/storj/internal/sync2"
+	"storj.io/storj/pkg/accounting"
+	"storj.io/storj/satellite/billing"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/mailservice"
+)
+
+var (
+	// Error is the default notifications errs class
+	Error = errs.Class("notifications error")
+	mon   = monkit.Package()
+)
+
+// membersPageSize is the number of project members queried at once
+const membersPageSize = 100
+
+// Config contains configurable values for notifications
+type Config struct {
+	Interval        time.Duration `help:"how frequently project usage is compared with the notification thresholds" default:"1h"`
+	UsageThresholds string        `help:"comma separated percentages of the project usage limits, which trigger notification emails" default:"80,100"`
+}
+
+// ParseThresholds parses the comma separated threshold percentages, the result is sorted in ascending order
+func ParseThresholds(thresholds string) ([]int, error) {
+	var parsed []int
+	for _, value := range strings.Split(thresholds, ",") {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			continue
+		}
+
+		threshold, err := strconv.Atoi(value)
+		if err != nil || threshold <= 0 {
+			return nil, Error.New("invalid usage threshold %q", value)
+		}
+		parsed = append(parsed, threshold)
+	}
+
+	sort.Ints(parsed)
+	return parsed, nil
+}
+
+// Service sends notification emails about console actions and about projects
+// reaching the configured percentages of their usage limits
+type Service struct {
+	log        *zap.Logger
+	db         console.DB
+	usage      *accounting.ProjectUsage
+	mail       *mailservice.Service
+	signInLink string
+	thresholds []int
+
+	Loop sync2.Cycle
+}
+
+// NewService creates a new notifications service
+func NewService(log *zap.Logger, config Config, db console.DB, usage *accounting.ProjectUsage, mail *mailservice.Service, signInLink string) (*Service, error) {
+	thresholds, err := ParseThresholds(config.UsageThresholds)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Service{
+		log:        log,
+		db:         db,
+		usage:      usage,
+		mail:       mail,
+		signInLink: signInLink,
+		thresholds: thresholds,
+
+		Loop: *sync2.NewCycle(config.Interval),
+	}, nil
+}
+
+// Run periodically compares project usage with the thresholds
+func (service *Service) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return service.Loop.Run(ctx, func(ctx context.Context) error {
+		err := service.CheckUsage(ctx)
+		if err != nil {
+			service.log.Error("checking project usage failed", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// Close halts the usage checking loop
+func (service *Service) Close() error {
+	service.Loop.Close()
+	return nil
+}
+
+// CheckUsage sends emails about all projects, whose usage has reached a threshold,
+// which wasn't notified about during the current month yet
+func (service *Service) CheckUsage(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(service.thresholds) == 0 {
+		return nil
+	}
+
+	projects, err := service.db.Projects().GetAll(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	periodStart := billing.PeriodStart(time.Now())
+
+	var errlist errs.Group
+	for i := range projects {
+		project := &projects[i]
+
+		storageLimit, egressLimit, err := service.usage.GetProjectUsageLimits(ctx, project.ID)
+		if err != nil {
+			errlist.Add(err)
+			continue
+		}
+
+		storage, err := service.usage.GetProjectStorageTotals(ctx, project.ID)
+		if err != nil {
+			errlist.Add(err)
+			continue
+		}
+		errlist.Add(service.checkResource(ctx, project, console.UsageStorage, storage, storageLimit, periodStart))
+
+		egress, err := service.usage.GetProjectBandwidthTotals(ctx, project.ID)
+		if err != nil {
+			errlist.Add(err)
+			continue
+		}
+		errlist.Add(service.checkResource(ctx, project, console.UsageEgress, egress, egressLimit, periodStart))
+	}
+
+	return Error.Wrap(errlist.Err())
+}
+
+// checkResource notifies the owner and admins of project about the highest reached threshold of resource,
+// lower thresholds are skipped, so that a sudden increase of usage results in a single email
+func (service *Service) checkResource(ctx context.Context, project *console.Project, resource console.UsageResource, used, limit memory.Size, periodStart time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if limit <= 0 {
+		return nil
+	}
+
+	percent := int(int64(used) * 100 / int64(limit))
+
+	reached := 0
+	for _, threshold := range service.thresholds {
+		if percent >= threshold {
+			reached = threshold
+		}
+	}
+	if reached == 0 {
+		return nil
+	}
+
+	notification := console.UsageNotification{
+		ProjectID:   project.ID,
+		Resource:    resource,
+		Threshold:   reached,
+		PeriodStart: periodStart,
+	}
+
+	sent, err := service.db.Notifications().HasUsageNotification(ctx, notification)
+	if err != nil || sent {
+		return err
+	}
+
+	recipients, err := service.recipients(ctx, project.ID, console.RoleAdmin, func(preferences *console.NotificationPreferences) bool {
+		return preferences.UsageThresholds
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, user := range recipients {
+		service.mail.SendRenderedAsync(ctx,
+			[]post.Address{{Address: user.Email, Name: userName(user)}},
+			&UsageThresholdEmail{
+				UserName:    userName(user),
+				ProjectName: project.Name,
+				Resource:    string(resource),
+				Percent:     reached,
+				Used:        used.String(),
+				Limit:       limit.String(),
+			},
+		)
+	}
+
+	service.log.Debug("usage threshold reached",
+		zap.String("Project ID", project.ID.String()),
+		zap.String("Resource", string(resource)),
+		zap.Int("Threshold", reached),
+		zap.Int("Recipients", len(recipients)),
+	)
+
+	return service.db.Notifications().InsertUsageNotification(ctx, notification)
+}
+
+// APIKeyCreated notifies the owner and admins of project, except actor, about the new api key
+func (service *Service) APIKeyCreated(ctx context.Context, project *console.Project, key *console.APIKeyInfo, actor *console.User) {
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	recipients, err := service.recipients(ctx, project.ID, console.RoleAdmin, func(preferences *console.NotificationPreferences) bool {
+		return preferences.APIKeyCreated
+	})
+	if err != nil {
+		service.log.Error("querying api key notification recipients failed", zap.Error(err))
+		return
+	}
+
+	for _, user := range recipients {
+		if user.ID == actor.ID {
+			continue
+		}
+
+		service.mail.SendRenderedAsync(ctx,
+			[]post.Address{{Address: user.Email, Name: userName(user)}},
+			&APIKeyCreatedEmail{
+				UserName:     userName(user),
+				ProjectName:  project.Name,
+				KeyName:      key.Name,
+				CreatorEmail: actor.Email,
+			},
+		)
+	}
+}
+
+// ProjectMembersAdded notifies the users about being added to project
+func (service *Service) ProjectMembersAdded(ctx context.Context, project *console.Project, users []*console.User, actor *console.User) {
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	for _, user := range users {
+		preferences, err := service.db.Notifications().GetPreferences(ctx, user.ID)
+		if err != nil {
+			service.log.Error("querying notification preferences failed", zap.Error(err))
+			continue
+		}
+		if !preferences.ProjectMemberAdded {
+			continue
+		}
+
+		service.mail.SendRenderedAsync(ctx,
+			[]post.Address{{Address: user.Email, Name: userName(user)}},
+			&ProjectInvitationEmail{
+				UserName:    userName(user),
+				ProjectName: project.Name,
+				SignInLink:  service.signInLink,
+			},
+		)
+	}
+}
+
+// recipients returns the members of project with at least the given role, whose preferences are accepted by enabled
+func (service *Service) recipients(ctx context.Context, projectID uuid.UUID, role console.ProjectMemberRole, enabled func(*console.NotificationPreferences) bool) (_ []*console.User, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var recipients []*console.User
+	for offset := int64(0); ; offset += membersPageSize {
+		members, err := service.db.ProjectMembers().GetByProjectID(ctx, projectID, console.Pagination{
+			Limit:  membersPageSize,
+			Offset: offset,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, member := range members {
+			if !member.Role.Includes(role) {
+				continue
+			}
+
+			preferences, err := service.db.Notifications().GetPreferences(ctx, member.MemberID)
+			if err != nil {
+				return nil, err
+			}
+			if !enabled(preferences) {
+				continue
+			}
+
+			user, err := service.db.Users().Get(ctx, member.MemberID)
+			if err != nil {
+				return nil, err
+			}
+			recipients = append(recipients, user)
+		}
+
+		if len(members) < membersPageSize {
+			return recipients, nil
+		}
+	}
+}
+
+// userName returns the name used for addressing user in emails
+func userName(user *console.User) string {
+	if user.ShortName != "" {
+		return user.ShortName
+	}
+	return user.FullName
+}
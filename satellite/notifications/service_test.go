@@ -0,0 +1,209 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package notifications_test
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/post"
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/pkg/accounting"
+	"storj.io/storj/pkg/accounting/live"
+	"storj.io/storj/pkg/auth"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/console/consoleauth"
+	"storj.io/storj/satellite/mailservice"
+	"storj.io/storj/satellite/notifications"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+// recordingSender remembers the sent emails instead of sending them
+type recordingSender struct {
+	mu   sync.Mutex
+	sent []*post.Message
+}
+
+// FromAddress returns empty post.Address
+func (*recordingSender) FromAddress() post.Address { return post.Address{} }
+
+// SendEmail records msg
+func (sender *recordingSender) SendEmail(msg *post.Message) error {
+	sender.mu.Lock()
+	defer sender.mu.Unlock()
+	sender.sent = append(sender.sent, msg)
+	return nil
+}
+
+func TestParseThresholds(t *testing.T) {
+	thresholds, err := notifications.ParseThresholds("100, 80,,90")
+	require.NoError(t, err)
+	assert.Equal(t, []int{80, 90, 100}, thresholds)
+
+	thresholds, err = notifications.ParseThresholds("")
+	require.NoError(t, err)
+	assert.Empty(t, thresholds)
+
+	_, err = notifications.ParseThresholds("80,abc")
+	assert.Error(t, err)
+
+	_, err = notifications.ParseThresholds("-10")
+	assert.Error(t, err)
+}
+
+func TestNotifications(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		log := zaptest.NewLogger(t)
+
+		liveAccounting, err := live.New(log, live.Config{StorageBackend: "plainmemory:"})
+		require.NoError(t, err)
+
+		const storageLimit = 1000 * memory.B
+		projectUsage := accounting.NewProjectUsage(db.ProjectAccounting(), liveAccounting, storageLimit, 25*memory.GB)
+
+		service, err := console.NewService(
+			log,
+			&consoleauth.Hmac{Secret: []byte("my-suppa-secret-key")},
+			db.Console(),
+			projectUsage,
+			console.TestPasswordCost,
+		)
+		require.NoError(t, err)
+
+		sender := &recordingSender{}
+		mailService, err := mailservice.New(log, sender, "../../web/satellite/static/emails")
+		require.NoError(t, err)
+
+		notifier, err := notifications.NewService(log, notifications.Config{
+			Interval:        time.Hour,
+			UsageThresholds: "80,100",
+		}, db.Console(), projectUsage, mailService, "http://satellite.test/login")
+		require.NoError(t, err)
+		defer ctx.Check(notifier.Close)
+
+		service.SetNotifier(notifier)
+
+		// sent waits for the emails sent so far and returns the sorted recipients and subjects
+		sent := func() (recipients []string, subjects []string) {
+			require.NoError(t, mailService.Close())
+
+			sender.mu.Lock()
+			defer sender.mu.Unlock()
+			for _, msg := range sender.sent {
+				recipients = append(recipients, msg.To[0].Address)
+				subjects = append(subjects, msg.Subject)
+			}
+			sender.sent = nil
+
+			sort.Strings(recipients)
+			sort.Strings(subjects)
+			return recipients, subjects
+		}
+
+		emails := []string{"owner@mail.test", "admin@mail.test", "viewer@mail.test"}
+		users := map[string]*console.User{}
+		for _, email := range emails {
+			regToken, err := service.CreateRegToken(ctx, 1)
+			require.NoError(t, err)
+
+			user, err := service.CreateUser(ctx, console.CreateUser{
+				UserInfo: console.UserInfo{FullName: email, Email: email},
+				Password: "123a123",
+			}, regToken.Secret)
+			require.NoError(t, err)
+
+			activationToken, err := service.GenerateActivationToken(ctx, user.ID, user.Email)
+			require.NoError(t, err)
+			require.NoError(t, service.ActivateAccount(ctx, activationToken))
+
+			users[email] = user
+		}
+
+		authorize := func(email string) context.Context {
+			token, err := service.Token(ctx, email, "123a123")
+			require.NoError(t, err)
+
+			authorization, err := service.Authorize(auth.WithAPIKey(ctx, []byte(token)))
+			require.NoError(t, err)
+			return console.WithAuth(ctx, authorization)
+		}
+		ownerCtx := authorize(emails[0])
+
+		project, err := service.CreateProject(ownerCtx, console.ProjectInfo{Name: "notified"})
+		require.NoError(t, err)
+
+		t.Run("Project members added", func(t *testing.T) {
+			err := service.UpdateNotificationPreferences(authorize(emails[2]), console.NotificationPreferences{
+				UsageThresholds: true,
+				APIKeyCreated:   true,
+			})
+			require.NoError(t, err)
+
+			_, err = service.AddProjectMembers(ownerCtx, project.ID, emails[1:2], console.RoleAdmin)
+			require.NoError(t, err)
+			_, err = service.AddProjectMembers(ownerCtx, project.ID, emails[2:], console.RoleViewer)
+			require.NoError(t, err)
+
+			// the viewer disabled emails about being added to projects
+			recipients, subjects := sent()
+			assert.Equal(t, []string{emails[1]}, recipients)
+			assert.Equal(t, []string{"You were invited to join the Project notified"}, subjects)
+		})
+
+		t.Run("API key created", func(t *testing.T) {
+			_, _, err := service.CreateAPIKey(ownerCtx, project.ID, "automation", console.APIKeyRestrictions{})
+			require.NoError(t, err)
+
+			// the creator and viewers aren't notified
+			recipients, subjects := sent()
+			assert.Equal(t, []string{emails[1]}, recipients)
+			assert.Equal(t, []string{"A new API key was created in the Project notified"}, subjects)
+		})
+
+		t.Run("Usage thresholds", func(t *testing.T) {
+			addStorage := func(used memory.Size) {
+				err := liveAccounting.AddProjectStorageUsage(ctx, project.ID, 0, int64(used)*accounting.ExpansionFactor)
+				require.NoError(t, err)
+			}
+
+			// below all thresholds
+			addStorage(500 * memory.B)
+			require.NoError(t, notifier.CheckUsage(ctx))
+			recipients, _ := sent()
+			assert.Empty(t, recipients)
+
+			addStorage(350 * memory.B)
+			require.NoError(t, notifier.CheckUsage(ctx))
+			recipients, subjects := sent()
+			assert.Equal(t, []string{emails[1], emails[0]}, recipients)
+			assert.Equal(t, "The Project notified has used 80% of its storage limit", subjects[0])
+
+			// the threshold is notified only once per period
+			require.NoError(t, notifier.CheckUsage(ctx))
+			recipients, _ = sent()
+			assert.Empty(t, recipients)
+
+			err := service.UpdateNotificationPreferences(authorize(emails[1]), console.NotificationPreferences{})
+			require.NoError(t, err)
+
+			addStorage(200 * memory.B)
+			require.NoError(t, notifier.CheckUsage(ctx))
+			recipients, subjects = sent()
+			assert.Equal(t, []string{emails[0]}, recipients)
+			assert.Equal(t, []string{"The Project notified has used 100% of its storage limit"}, subjects)
+		})
+	})
+}
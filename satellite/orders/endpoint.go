@@ -18,6 +18,7 @@ import (
 	"storj.io/storj/pkg/auth/signing"
 	"storj.io/storj/pkg/certdb"
 	"storj.io/storj/pkg/identity"
+	"storj.io/storj/pkg/overlay"
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/storj"
 )
@@ -64,15 +65,17 @@ type Endpoint struct {
 	satelliteSignee signing.Signee
 	DB              DB
 	certdb          certdb.DB
+	cache           *overlay.Cache
 }
 
 // NewEndpoint new orders receiving endpoint
-func NewEndpoint(log *zap.Logger, satelliteSignee signing.Signee, db DB, certdb certdb.DB) *Endpoint {
+func NewEndpoint(log *zap.Logger, satelliteSignee signing.Signee, db DB, certdb certdb.DB, cache *overlay.Cache) *Endpoint {
 	return &Endpoint{
 		log:             log,
 		satelliteSignee: satelliteSignee,
 		DB:              db,
 		certdb:          certdb,
+		cache:           cache,
 	}
 }
 
@@ -95,6 +98,15 @@ func (endpoint *Endpoint) Settlement(stream pb.Orders_SettlementServer) (err err
 
 	log := endpoint.log.Named(peer.ID.String())
 	log.Debug("Settlement")
+
+	// disqualified nodes are not paid for bandwidth anymore
+	disqualified, err := endpoint.cache.IsDisqualified(ctx, peer.ID)
+	if err != nil && !overlay.ErrNodeNotFound.Has(err) {
+		return status.Error(codes.Internal, err.Error())
+	}
+	if disqualified {
+		return status.Error(codes.PermissionDenied, "storage node is disqualified")
+	}
 	for {
 		request, err := stream.Recv()
 		if err != nil {
@@ -23,6 +23,7 @@ import (
 	"storj.io/storj/internal/version"
 	"storj.io/storj/pkg/accounting"
 	"storj.io/storj/pkg/accounting/live"
+	"storj.io/storj/pkg/accounting/payout"
 	"storj.io/storj/pkg/accounting/rollup"
 	"storj.io/storj/pkg/accounting/tally"
 	"storj.io/storj/pkg/audit"
@@ -43,6 +44,7 @@ import (
 	"storj.io/storj/pkg/server"
 	"storj.io/storj/pkg/storj"
 	"storj.io/storj/pkg/transport"
+	"storj.io/storj/satellite/billing"
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/console/consoleauth"
 	"storj.io/storj/satellite/console/consoleweb"
@@ -50,9 +52,11 @@ import (
 	"storj.io/storj/satellite/mailservice"
 	"storj.io/storj/satellite/mailservice/simulate"
 	"storj.io/storj/satellite/metainfo"
+	"storj.io/storj/satellite/notifications"
 	"storj.io/storj/satellite/orders"
 	"storj.io/storj/storage"
 	"storj.io/storj/storage/boltdb"
+	"storj.io/storj/storage/shardkv"
 )
 
 // DB is the master database for the satellite
@@ -87,6 +91,10 @@ type DB interface {
 	Orders() orders.DB
 	// Containment returns database for containment
 	Containment() audit.Containment
+	// Payouts returns database for storage node payout statements
+	Payouts() payout.DB
+	// Segments returns database for queryable segment metadata
+	Segments() metainfo.SegmentsDB
 }
 
 // Config is the global config satellite
@@ -110,9 +118,12 @@ type Config struct {
 	Tally          tally.Config
 	Rollup         rollup.Config
 	LiveAccounting live.Config
+	Billing        billing.Config
+	Payouts        payout.Config
 
-	Mail    mailservice.Config
-	Console consoleweb.Config
+	Mail          mailservice.Config
+	Console       consoleweb.Config
+	Notifications notifications.Config
 
 	Version version.Config
 }
@@ -153,10 +164,13 @@ type Peer struct {
 		Database  storage.KeyValueStore // TODO: move into pointerDB
 		Service   *metainfo.Service
 		Endpoint2 *metainfo.Endpoint
+		Reaper    *metainfo.Reaper
 	}
 
 	Inspector struct {
-		Endpoint *inspector.Endpoint
+		Endpoint        *inspector.Endpoint
+		ProjectEndpoint *inspector.ProjectEndpoint
+		ShardEndpoint   *inspector.ShardEndpoint
 	}
 
 	Agreements struct {
@@ -178,14 +192,24 @@ type Peer struct {
 	}
 
 	Accounting struct {
-		Tally  *tally.Service
-		Rollup *rollup.Service
+		Tally        *tally.Service
+		Rollup       *rollup.Service
+		ProjectUsage *accounting.ProjectUsage
 	}
 
 	LiveAccounting struct {
 		Service live.Service
 	}
 
+	Billing struct {
+		Service *billing.Service
+	}
+
+	Payouts struct {
+		Service  *payout.Service
+		Endpoint *payout.Endpoint
+	}
+
 	Mail struct {
 		Service *mailservice.Service
 	}
@@ -194,6 +218,11 @@ type Peer struct {
 		Listener net.Listener
 		Service  *console.Service
 		Endpoint *consoleweb.Server
+		AuditLog *console.AuditLogChore
+	}
+
+	Notifications struct {
+		Service *notifications.Service
 	}
 }
 
@@ -322,6 +351,16 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config *Config, ve
 		peer.LiveAccounting.Service = liveAccountingService
 	}
 
+	{ // setup project usage
+		log.Debug("Setting up project usage")
+		peer.Accounting.ProjectUsage = accounting.NewProjectUsage(
+			peer.DB.ProjectAccounting(),
+			peer.LiveAccounting.Service,
+			config.Rollup.DefaultStorageLimit,
+			config.Rollup.DefaultEgressLimit,
+		)
+	}
+
 	{ // setup orders
 		log.Debug("Setting up orders")
 		satelliteSignee := signing.SigneeFromPeerIdentity(peer.Identity.PeerIdentity())
@@ -330,6 +369,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config *Config, ve
 			satelliteSignee,
 			peer.DB.Orders(),
 			peer.DB.CertDB(),
+			peer.Overlay.Service,
 		)
 		peer.Orders.Service = orders.NewService(
 			peer.Log.Named("orders:service"),
@@ -350,7 +390,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config *Config, ve
 		}
 
 		peer.Metainfo.Database = db // for logging: storelogger.New(peer.Log.Named("pdb"), db)
-		peer.Metainfo.Service = metainfo.NewService(peer.Log.Named("metainfo:service"), peer.Metainfo.Database)
+		peer.Metainfo.Service = metainfo.NewService(peer.Log.Named("metainfo:service"), peer.Metainfo.Database, peer.DB.Segments())
 
 		peer.Metainfo.Endpoint2 = metainfo.NewEndpoint(
 			peer.Log.Named("metainfo:endpoint"),
@@ -359,12 +399,13 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config *Config, ve
 			peer.Overlay.Service,
 			peer.DB.Console().APIKeys(),
 			peer.DB.StoragenodeAccounting(),
-			peer.DB.ProjectAccounting(),
+			peer.Accounting.ProjectUsage,
 			peer.LiveAccounting.Service,
-			config.Rollup.MaxAlphaUsage,
 		)
 
 		pb.RegisterMetainfoServer(peer.Server.GRPC(), peer.Metainfo.Endpoint2)
+
+		peer.Metainfo.Reaper = metainfo.NewReaper(peer.Log.Named("metainfo:reaper"), config.Metainfo.Reaper, peer.Metainfo.Service)
 	}
 
 	{ // setup agreements
@@ -423,6 +464,18 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config *Config, ve
 		peer.Accounting.Rollup = rollup.New(peer.Log.Named("rollup"), peer.DB.StoragenodeAccounting(), config.Rollup.Interval, config.Rollup.DeleteTallies)
 	}
 
+	{ // setup billing
+		log.Debug("Setting up billing")
+		peer.Billing.Service = billing.NewService(peer.Log.Named("billing"), config.Billing, peer.DB.Console())
+	}
+
+	{ // setup payouts
+		log.Debug("Setting up payouts")
+		peer.Payouts.Service = payout.NewService(peer.Log.Named("payouts"), config.Payouts, peer.DB.StoragenodeAccounting(), peer.DB.OverlayCache(), peer.DB.Payouts())
+		peer.Payouts.Endpoint = payout.NewEndpoint(peer.Log.Named("payouts:endpoint"), peer.Payouts.Service)
+		pb.RegisterPayoutsServer(peer.Server.GRPC(), peer.Payouts.Endpoint)
+	}
+
 	{ // setup inspector
 		log.Debug("Setting up inspector")
 		peer.Inspector.Endpoint = inspector.NewEndpoint(
@@ -432,6 +485,24 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config *Config, ve
 		)
 
 		pb.RegisterHealthInspectorServer(peer.Server.PrivateGRPC(), peer.Inspector.Endpoint)
+
+		peer.Inspector.ProjectEndpoint = inspector.NewProjectEndpoint(
+			peer.Log.Named("inspector:project"),
+			peer.DB.Console().Projects(),
+			peer.Accounting.ProjectUsage,
+		)
+
+		pb.RegisterProjectInspectorServer(peer.Server.PrivateGRPC(), peer.Inspector.ProjectEndpoint)
+
+		// projects can only be moved, when the pointers are sharded
+		if store, ok := peer.Metainfo.Database.(*shardkv.Store); ok {
+			peer.Inspector.ShardEndpoint = inspector.NewShardEndpoint(
+				peer.Log.Named("inspector:shard"),
+				store,
+			)
+
+			pb.RegisterShardInspectorServer(peer.Server.PrivateGRPC(), peer.Inspector.ShardEndpoint)
+		}
 	}
 
 	{ // setup mailservice
@@ -516,6 +587,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config *Config, ve
 			// TODO(yar): use satellite key
 			&consoleauth.Hmac{Secret: []byte("my-suppa-secret-key")},
 			peer.DB.Console(),
+			peer.Accounting.ProjectUsage,
 			consoleConfig.PasswordCost,
 		)
 
@@ -523,13 +595,39 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config *Config, ve
 			return nil, errs.Combine(err, peer.Close())
 		}
 
-		peer.Console.Endpoint = consoleweb.NewServer(
+		peer.Console.Endpoint, err = consoleweb.NewServer(
 			peer.Log.Named("console:endpoint"),
 			consoleConfig,
 			peer.Console.Service,
 			peer.Mail.Service,
 			peer.Console.Listener,
 		)
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+
+		peer.Console.AuditLog = console.NewAuditLogChore(
+			peer.Log.Named("console:auditlog"),
+			consoleConfig.AuditLog,
+			peer.DB.Console().AuditEvents(),
+		)
+	}
+
+	{ // setup notifications
+		log.Debug("Setting up notifications")
+		peer.Notifications.Service, err = notifications.NewService(
+			peer.Log.Named("notifications"),
+			config.Notifications,
+			peer.DB.Console(),
+			peer.Accounting.ProjectUsage,
+			peer.Mail.Service,
+			peer.Console.Endpoint.SignInLink(),
+		)
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+
+		peer.Console.Service.SetNotifier(peer.Notifications.Service)
 	}
 
 	return peer, nil
@@ -551,6 +649,9 @@ func (peer *Peer) Run(ctx context.Context) error {
 	group.Go(func() error {
 		return errs2.IgnoreCanceled(peer.Discovery.Service.Run(ctx))
 	})
+	group.Go(func() error {
+		return errs2.IgnoreCanceled(peer.Metainfo.Reaper.Run(ctx))
+	})
 	group.Go(func() error {
 		return errs2.IgnoreCanceled(peer.Repair.Checker.Run(ctx))
 	})
@@ -563,6 +664,9 @@ func (peer *Peer) Run(ctx context.Context) error {
 	group.Go(func() error {
 		return errs2.IgnoreCanceled(peer.Accounting.Rollup.Run(ctx))
 	})
+	group.Go(func() error {
+		return errs2.IgnoreCanceled(peer.Billing.Service.Run(ctx))
+	})
 	group.Go(func() error {
 		return errs2.IgnoreCanceled(peer.Audit.Service.Run(ctx))
 	})
@@ -577,6 +681,12 @@ func (peer *Peer) Run(ctx context.Context) error {
 	group.Go(func() error {
 		return errs2.IgnoreCanceled(peer.Console.Endpoint.Run(ctx))
 	})
+	group.Go(func() error {
+		return errs2.IgnoreCanceled(peer.Console.AuditLog.Run(ctx))
+	})
+	group.Go(func() error {
+		return errs2.IgnoreCanceled(peer.Notifications.Service.Run(ctx))
+	})
 
 	return group.Wait()
 }
@@ -605,6 +715,15 @@ func (peer *Peer) Close() error {
 	}
 
 	// close services in reverse initialization order
+	if peer.Notifications.Service != nil {
+		errlist.Add(peer.Notifications.Service.Close())
+	}
+	if peer.Console.AuditLog != nil {
+		errlist.Add(peer.Console.AuditLog.Close())
+	}
+	if peer.Billing.Service != nil {
+		errlist.Add(peer.Billing.Service.Close())
+	}
 	if peer.Repair.Repairer != nil {
 		errlist.Add(peer.Repair.Repairer.Close())
 	}
@@ -616,6 +735,9 @@ func (peer *Peer) Close() error {
 		errlist.Add(peer.Agreements.Endpoint.Close())
 	}
 
+	if peer.Metainfo.Reaper != nil {
+		errlist.Add(peer.Metainfo.Reaper.Close())
+	}
 	if peer.Metainfo.Database != nil {
 		errlist.Add(peer.Metainfo.Database.Close())
 	}
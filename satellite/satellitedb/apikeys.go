@@ -6,9 +6,11 @@ package satellitedb
 import (
 	"context"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/skyrings/skyring-common/tools/uuid"
 	"github.com/zeebo/errs"
 
+	"storj.io/storj/pkg/macaroon"
 	"storj.io/storj/satellite/console"
 	dbx "storj.io/storj/satellite/satellitedb/dbx"
 )
@@ -55,9 +57,9 @@ func (keys *apikeys) Get(ctx context.Context, id uuid.UUID) (*console.APIKeyInfo
 	return fromDBXAPIKey(dbKey)
 }
 
-// GetByKey implements satellite.APIKeys
-func (keys *apikeys) GetByKey(ctx context.Context, key console.APIKey) (*console.APIKeyInfo, error) {
-	dbKey, err := keys.db.Get_ApiKey_By_Key(ctx, dbx.ApiKey_Key(key[:]))
+// GetByHead implements satellite.APIKeys
+func (keys *apikeys) GetByHead(ctx context.Context, head []byte) (*console.APIKeyInfo, error) {
+	dbKey, err := keys.db.Get_ApiKey_By_Head(ctx, dbx.ApiKey_Head(head))
 	if err != nil {
 		return nil, err
 	}
@@ -66,18 +68,30 @@ func (keys *apikeys) GetByKey(ctx context.Context, key console.APIKey) (*console
 }
 
 // Create implements satellite.APIKeys
-func (keys *apikeys) Create(ctx context.Context, key console.APIKey, info console.APIKeyInfo) (*console.APIKeyInfo, error) {
+func (keys *apikeys) Create(ctx context.Context, head []byte, info console.APIKeyInfo) (*console.APIKeyInfo, error) {
 	id, err := uuid.New()
 	if err != nil {
 		return nil, err
 	}
 
+	caveat := dbx.ApiKey_Caveat_Null()
+	if !info.Restrictions.IsZero() {
+		restrictions := info.Restrictions.Caveat()
+		caveatBytes, err := proto.Marshal(&restrictions)
+		if err != nil {
+			return nil, err
+		}
+		caveat = dbx.ApiKey_Caveat(caveatBytes)
+	}
+
 	dbKey, err := keys.db.Create_ApiKey(
 		ctx,
 		dbx.ApiKey_Id(id[:]),
 		dbx.ApiKey_ProjectId(info.ProjectID[:]),
-		dbx.ApiKey_Key(key[:]),
+		dbx.ApiKey_Head(head),
 		dbx.ApiKey_Name(info.Name),
+		dbx.ApiKey_Secret(info.Secret),
+		caveat,
 	)
 
 	if err != nil {
@@ -106,6 +120,31 @@ func (keys *apikeys) Delete(ctx context.Context, id uuid.UUID) error {
 	return err
 }
 
+// Revoke implements satellite.APIKeys
+func (keys *apikeys) Revoke(ctx context.Context, id uuid.UUID, tail []byte) error {
+	_, err := keys.db.Create_ApiKeyRevocation(
+		ctx,
+		dbx.ApiKeyRevocation_ApiKeyId(id[:]),
+		dbx.ApiKeyRevocation_Tail(tail),
+	)
+	return err
+}
+
+// GetRevocations implements satellite.APIKeys
+func (keys *apikeys) GetRevocations(ctx context.Context, id uuid.UUID) ([][]byte, error) {
+	dbRevocations, err := keys.db.All_ApiKeyRevocation_By_ApiKeyId(ctx, dbx.ApiKeyRevocation_ApiKeyId(id[:]))
+	if err != nil {
+		return nil, err
+	}
+
+	var revoked [][]byte
+	for _, revocation := range dbRevocations {
+		revoked = append(revoked, revocation.Tail)
+	}
+
+	return revoked, nil
+}
+
 // fromDBXAPIKey converts dbx.ApiKey to satellite.APIKeyInfo
 func fromDBXAPIKey(key *dbx.ApiKey) (*console.APIKeyInfo, error) {
 	id, err := bytesToUUID(key.Id)
@@ -118,10 +157,21 @@ func fromDBXAPIKey(key *dbx.ApiKey) (*console.APIKeyInfo, error) {
 		return nil, err
 	}
 
+	var restrictions console.APIKeyRestrictions
+	if len(key.Caveat) > 0 {
+		var caveat macaroon.Caveat
+		if err := proto.Unmarshal(key.Caveat, &caveat); err != nil {
+			return nil, err
+		}
+		restrictions = console.APIKeyRestrictionsFromCaveat(&caveat)
+	}
+
 	return &console.APIKeyInfo{
-		ID:        id,
-		ProjectID: projectID,
-		Name:      key.Name,
-		CreatedAt: key.CreatedAt,
+		ID:           id,
+		ProjectID:    projectID,
+		Name:         key.Name,
+		Secret:       key.Secret,
+		Restrictions: restrictions,
+		CreatedAt:    key.CreatedAt,
 	}, nil
 }
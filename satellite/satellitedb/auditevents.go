@@ -0,0 +1,122 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"time"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/satellite/console"
+	dbx "storj.io/storj/satellite/satellitedb/dbx"
+)
+
+// auditEvents is an implementation of console.AuditEvents
+type auditEvents struct {
+	db dbx.Methods
+}
+
+// Insert is a method for appending audit event to the log.
+func (events *auditEvents) Insert(ctx context.Context, event console.AuditEvent) (*console.AuditEvent, error) {
+	id, err := uuid.New()
+	if err != nil {
+		return nil, err
+	}
+
+	var projectID []byte
+	if event.ProjectID != nil {
+		projectID = event.ProjectID[:]
+	}
+
+	created, err := events.db.Create_AuditEvent(ctx,
+		dbx.AuditEvent_Id(id[:]),
+		dbx.AuditEvent_ProjectId_Raw(projectID),
+		dbx.AuditEvent_ActorId(event.ActorID[:]),
+		dbx.AuditEvent_Action(string(event.Action)),
+		dbx.AuditEvent_Target(event.Target),
+		dbx.AuditEvent_SourceIp(event.SourceIP),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return auditEventFromDBX(created)
+}
+
+// GetByProjectID is a method for querying audit events of a project by offset and limit, newest first.
+func (events *auditEvents) GetByProjectID(ctx context.Context, projectID uuid.UUID, limit int, offset int64) ([]console.AuditEvent, error) {
+	if limit < 0 || offset < 0 {
+		return nil, errs.New("invalid pagination argument")
+	}
+
+	eventsDbx, err := events.db.Limited_AuditEvent_By_ProjectId_OrderBy_Desc_CreatedAt(ctx,
+		dbx.AuditEvent_ProjectId(projectID[:]),
+		limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	return auditEventsFromDbxSlice(eventsDbx)
+}
+
+// DeleteBefore is a method for deleting all audit events created before the given time.
+func (events *auditEvents) DeleteBefore(ctx context.Context, before time.Time) (int64, error) {
+	return events.db.Delete_AuditEvent_By_CreatedAt_Less(ctx, dbx.AuditEvent_CreatedAt(before))
+}
+
+// auditEventFromDBX is used for creating AuditEvent entity from autogenerated dbx.AuditEvent struct
+func auditEventFromDBX(event *dbx.AuditEvent) (*console.AuditEvent, error) {
+	if event == nil {
+		return nil, errs.New("audit event parameter is nil")
+	}
+
+	id, err := bytesToUUID(event.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	actorID, err := bytesToUUID(event.ActorId)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &console.AuditEvent{
+		ID:        id,
+		ActorID:   actorID,
+		Action:    console.AuditAction(event.Action),
+		Target:    event.Target,
+		SourceIP:  event.SourceIp,
+		CreatedAt: event.CreatedAt,
+	}
+
+	if event.ProjectId != nil {
+		projectID, err := bytesToUUID(event.ProjectId)
+		if err != nil {
+			return nil, err
+		}
+		result.ProjectID = &projectID
+	}
+
+	return result, nil
+}
+
+// auditEventsFromDbxSlice is used for creating []AuditEvent entities from autogenerated []*dbx.AuditEvent struct
+func auditEventsFromDbxSlice(eventsDbx []*dbx.AuditEvent) ([]console.AuditEvent, error) {
+	var events []console.AuditEvent
+	var errors []error
+
+	for _, eventDbx := range eventsDbx {
+		event, err := auditEventFromDBX(eventDbx)
+		if err != nil {
+			errors = append(errors, err)
+			continue
+		}
+
+		events = append(events, *event)
+	}
+
+	return events, errs.Combine(errors...)
+}
@@ -61,6 +61,21 @@ func (db *ConsoleDB) UsageRollups() console.UsageRollups {
 	return &usagerollups{db.db}
 }
 
+// Invoices is a getter for Invoices repository
+func (db *ConsoleDB) Invoices() console.Invoices {
+	return &invoices{db.methods}
+}
+
+// AuditEvents is a getter for AuditEvents repository
+func (db *ConsoleDB) AuditEvents() console.AuditEvents {
+	return &auditEvents{db.methods}
+}
+
+// Notifications is a getter for Notifications repository
+func (db *ConsoleDB) Notifications() console.Notifications {
+	return &notifications{db.methods}
+}
+
 // BeginTx is a method for opening transaction
 func (db *ConsoleDB) BeginTx(ctx context.Context) (console.DBTx, error) {
 	if db.db == nil {
@@ -47,11 +47,12 @@ func (containment *containment) IncrementPending(ctx context.Context, pendingAud
 	existingAudit, err := tx.Get_PendingAudits_By_NodeId(ctx, dbx.PendingAudits_NodeId(pendingAudit.NodeID.Bytes()))
 	if err == sql.ErrNoRows {
 		statement := containment.db.Rebind(
-			`INSERT INTO pending_audits (node_id, piece_id, stripe_index, share_size, expected_share_hash, reverify_count)
-			VALUES (?, ?, ?, ?, ?, ?)`,
+			`INSERT INTO pending_audits (node_id, piece_id, stripe_index, share_size, expected_share_hash, reverify_count, piece_num, path)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
 		)
 		_, err = tx.Tx.ExecContext(ctx, statement,
 			pendingAudit.NodeID.Bytes(), pendingAudit.PieceID.Bytes(), pendingAudit.StripeIndex, pendingAudit.ShareSize, pendingAudit.ExpectedShareHash, pendingAudit.ReverifyCount,
+			pendingAudit.PieceNum, []byte(pendingAudit.Path),
 		)
 		if err != nil {
 			return audit.ContainError.Wrap(errs.Combine(err, tx.Rollback()))
@@ -132,6 +133,8 @@ func convertDBPending(info *dbx.PendingAudits) (*audit.PendingAudit, error) {
 		ShareSize:         int32(info.ShareSize),
 		ExpectedShareHash: info.ExpectedShareHash,
 		ReverifyCount:     int32(info.ReverifyCount),
+		PieceNum:          int32(info.PieceNum),
+		Path:              storj.Path(info.Path),
 	}
 	return pending, nil
 }
@@ -10,6 +10,7 @@ import (
 	"storj.io/storj/internal/dbutil"
 	"storj.io/storj/internal/dbutil/pgutil"
 	"storj.io/storj/pkg/accounting"
+	"storj.io/storj/pkg/accounting/payout"
 	"storj.io/storj/pkg/audit"
 	"storj.io/storj/pkg/bwagreement"
 	"storj.io/storj/pkg/certdb"
@@ -18,6 +19,7 @@ import (
 	"storj.io/storj/pkg/overlay"
 	"storj.io/storj/satellite"
 	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/metainfo"
 	"storj.io/storj/satellite/orders"
 	dbx "storj.io/storj/satellite/satellitedb/dbx"
 )
@@ -37,16 +39,24 @@ type DB struct {
 	source string
 }
 
-// New creates instance of database (supports: postgres, sqlite3)
+// New creates instance of database (supports: postgres, cockroach, sqlite3)
 func New(log *zap.Logger, databaseURL string) (satellite.DB, error) {
 	driver, source, err := dbutil.SplitConnstr(databaseURL)
 	if err != nil {
 		return nil, err
 	}
-	if driver == "postgres" {
+
+	dbxDriver := driver
+	switch driver {
+	case "postgres":
+		source = pgutil.CheckApplicationName(source)
+	case "cockroach":
+		// cockroach is compatible with the postgres dialect of dbx
+		dbxDriver = "postgres"
 		source = pgutil.CheckApplicationName(source)
 	}
-	db, err := dbx.Open(driver, source)
+
+	db, err := dbx.Open(dbxDriver, source)
 	if err != nil {
 		return nil, Error.New("failed opening database %q, %q: %v",
 			driver, source, err)
@@ -75,7 +85,7 @@ func (db *DB) Close() error {
 // CreateSchema creates a schema if it doesn't exist.
 func (db *DB) CreateSchema(schema string) error {
 	switch db.driver {
-	case "postgres":
+	case "postgres", "cockroach":
 		return pgutil.CreateSchema(db.db, schema)
 	}
 	return nil
@@ -88,7 +98,7 @@ func (db *DB) TestDBAccess() *dbx.DB { return db.db }
 // DropSchema drops the named schema
 func (db *DB) DropSchema(schema string) error {
 	switch db.driver {
-	case "postgres":
+	case "postgres", "cockroach":
 		return pgutil.DropSchema(db.db, schema)
 	}
 	return nil
@@ -151,3 +161,13 @@ func (db *DB) Orders() orders.DB {
 func (db *DB) Containment() audit.Containment {
 	return &containment{db: db.db}
 }
+
+// Payouts returns database for storage node payout statements
+func (db *DB) Payouts() payout.DB {
+	return &payouts{db: db.db}
+}
+
+// Segments returns database for queryable segment metadata
+func (db *DB) Segments() metainfo.SegmentsDB {
+	return &segments{db: db.db}
+}
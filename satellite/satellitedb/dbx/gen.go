@@ -8,6 +8,8 @@ import (
 	"fmt"
 
 	"github.com/zeebo/errs"
+
+	"storj.io/storj/internal/dbutil/txutil"
 )
 
 //go:generate dbx.v1 schema -d postgres -d sqlite3 satellitedb.dbx .
@@ -49,18 +51,20 @@ func (err *constraintError) Error() string {
 	return fmt.Sprintf("violates constraint %q: %v", err.constraint, err.err)
 }
 
-// WithTx wraps DB code in a transaction
+// WithTx wraps DB code in a transaction, which is retried on serialization failures
 func (db *DB) WithTx(ctx context.Context, fn func(context.Context, *Tx) error) (err error) {
-	tx, err := db.Open(ctx)
-	if err != nil {
-		return err
-	}
-	defer func() {
-		if err == nil {
-			err = tx.Commit()
-		} else {
-			err = errs.Combine(err, tx.Rollback())
+	return txutil.Retry(ctx, func() (err error) {
+		tx, err := db.Open(ctx)
+		if err != nil {
+			return err
 		}
-	}()
-	return fn(ctx, tx)
+		defer func() {
+			if err == nil {
+				err = tx.Commit()
+			} else {
+				err = errs.Combine(err, tx.Rollback())
+			}
+		}()
+		return fn(ctx, tx)
+	})
 }
@@ -350,6 +350,24 @@ CREATE TABLE injuredsegments (
 	attempted timestamp,
 	PRIMARY KEY ( path )
 );
+CREATE TABLE invoices (
+	id bytea NOT NULL,
+	project_id bytea NOT NULL,
+	period_start timestamp with time zone NOT NULL,
+	period_end timestamp with time zone NOT NULL,
+	storage double precision NOT NULL,
+	egress double precision NOT NULL,
+	object_count double precision NOT NULL,
+	segment_count double precision NOT NULL,
+	storage_amount bigint NOT NULL,
+	egress_amount bigint NOT NULL,
+	object_amount bigint NOT NULL,
+	segment_amount bigint NOT NULL,
+	total bigint NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id ),
+	UNIQUE ( project_id, period_start )
+);
 CREATE TABLE irreparabledbs (
 	segmentpath bytea NOT NULL,
 	segmentdetail bytea NOT NULL,
@@ -381,13 +399,28 @@ CREATE TABLE nodes (
 	uptime_success_count bigint NOT NULL,
 	total_uptime_count bigint NOT NULL,
 	uptime_ratio double precision NOT NULL,
+	audit_reputation_alpha double precision NOT NULL,
+	audit_reputation_beta double precision NOT NULL,
+	uptime_reputation_alpha double precision NOT NULL,
+	uptime_reputation_beta double precision NOT NULL,
 	created_at timestamp with time zone NOT NULL,
 	updated_at timestamp with time zone NOT NULL,
 	last_contact_success timestamp with time zone NOT NULL,
 	last_contact_failure timestamp with time zone NOT NULL,
 	contained boolean NOT NULL,
+	disqualified timestamp with time zone,
+	last_audited timestamp with time zone,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE objects (
+	id bigserial NOT NULL,
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	object_key bytea NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id ),
+	UNIQUE ( project_id, bucket_name, object_key )
+);
 CREATE TABLE offers (
 	id serial NOT NULL,
 	name text NOT NULL,
@@ -403,6 +436,30 @@ CREATE TABLE offers (
 	status integer NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE payout_statements (
+	node_id bytea NOT NULL,
+	period_start timestamp with time zone NOT NULL,
+	period_end timestamp with time zone NOT NULL,
+	node_created_at timestamp with time zone NOT NULL,
+	wallet text NOT NULL,
+	at_rest_total double precision NOT NULL,
+	get_total bigint NOT NULL,
+	get_repair_total bigint NOT NULL,
+	get_audit_total bigint NOT NULL,
+	put_total bigint NOT NULL,
+	put_repair_total bigint NOT NULL,
+	at_rest_amount bigint NOT NULL,
+	get_amount bigint NOT NULL,
+	repair_amount bigint NOT NULL,
+	audit_amount bigint NOT NULL,
+	earned bigint NOT NULL,
+	held_percent integer NOT NULL,
+	held bigint NOT NULL,
+	disqualified boolean NOT NULL,
+	paid bigint NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( node_id, period_start )
+);
 CREATE TABLE pending_audits (
 	node_id bytea NOT NULL,
 	piece_id bytea NOT NULL,
@@ -410,12 +467,16 @@ CREATE TABLE pending_audits (
 	share_size bigint NOT NULL,
 	expected_share_hash bytea NOT NULL,
 	reverify_count bigint NOT NULL,
+	piece_num bigint NOT NULL,
+	path bytea NOT NULL,
 	PRIMARY KEY ( node_id )
 );
 CREATE TABLE projects (
 	id bytea NOT NULL,
 	name text NOT NULL,
 	description text NOT NULL,
+	storage_limit bigint NOT NULL,
+	egress_limit bigint NOT NULL,
 	created_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( id )
 );
@@ -464,33 +525,92 @@ CREATE TABLE users (
 	email text NOT NULL,
 	password_hash bytea NOT NULL,
 	status integer NOT NULL,
+	mfa_enabled boolean NOT NULL,
+	mfa_secret_key text,
+	mfa_recovery_codes text,
 	created_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( id )
 );
 CREATE TABLE api_keys (
 	id bytea NOT NULL,
 	project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
-	key bytea NOT NULL,
+	head bytea NOT NULL,
 	name text NOT NULL,
+	secret bytea NOT NULL,
+	caveat bytea,
 	created_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( id ),
-	UNIQUE ( key ),
+	UNIQUE ( head ),
 	UNIQUE ( name, project_id )
 );
 CREATE TABLE project_members (
 	member_id bytea NOT NULL REFERENCES users( id ) ON DELETE CASCADE,
 	project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+	role integer NOT NULL,
 	created_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( member_id, project_id )
 );
+CREATE TABLE segments (
+	id bigserial NOT NULL,
+	object_id bigint NOT NULL REFERENCES objects( id ) ON DELETE CASCADE,
+	segment_index bigint NOT NULL,
+	remote boolean NOT NULL,
+	segment_size bigint NOT NULL,
+	root_piece_id bytea,
+	expires_at timestamp with time zone,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id ),
+	UNIQUE ( object_id, segment_index )
+);
 CREATE TABLE used_serials (
 	serial_number_id integer NOT NULL REFERENCES serial_numbers( id ) ON DELETE CASCADE,
 	storage_node_id bytea NOT NULL,
 	PRIMARY KEY ( serial_number_id, storage_node_id )
 );
+CREATE TABLE api_key_revocations (
+	api_key_id bytea NOT NULL REFERENCES api_keys( id ) ON DELETE CASCADE,
+	tail bytea NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( api_key_id, tail )
+);
+CREATE TABLE segment_pieces (
+	segment_id bigint NOT NULL REFERENCES segments( id ) ON DELETE CASCADE,
+	piece_num integer NOT NULL,
+	node_id bytea NOT NULL,
+	PRIMARY KEY ( segment_id, piece_num )
+);
+CREATE TABLE audit_events (
+	id bytea NOT NULL,
+	project_id bytea,
+	actor_id bytea NOT NULL,
+	action text NOT NULL,
+	target text NOT NULL,
+	source_ip text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE notification_preferences (
+	user_id bytea NOT NULL REFERENCES users( id ) ON DELETE CASCADE,
+	usage_thresholds boolean NOT NULL,
+	api_key_created boolean NOT NULL,
+	project_member_added boolean NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( user_id )
+);
+CREATE TABLE usage_notifications (
+	project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+	resource text NOT NULL,
+	threshold integer NOT NULL,
+	period_start timestamp with time zone NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, resource, threshold, period_start )
+);
+CREATE INDEX audit_events_project_id_created_at ON audit_events ( project_id, created_at );
 CREATE INDEX bucket_name_project_id_interval_start_interval_seconds ON bucket_bandwidth_rollups ( bucket_name, project_id, interval_start, interval_seconds );
 CREATE UNIQUE INDEX bucket_id_rollup ON bucket_usages ( bucket_id, rollup_end_time );
 CREATE INDEX node_last_ip ON nodes ( last_ip );
+CREATE INDEX segment_pieces_node_id_segment_id ON segment_pieces ( node_id, segment_id );
+CREATE INDEX segments_expires_at ON segments ( expires_at );
 CREATE UNIQUE INDEX serial_number ON serial_numbers ( serial_number );
 CREATE INDEX serial_numbers_expires_at_index ON serial_numbers ( expires_at );
 CREATE INDEX storagenode_id_interval_start_interval_seconds ON storagenode_bandwidth_rollups ( storagenode_id, interval_start, interval_seconds );`
@@ -634,6 +754,24 @@ CREATE TABLE injuredsegments (
 	attempted TIMESTAMP,
 	PRIMARY KEY ( path )
 );
+CREATE TABLE invoices (
+	id BLOB NOT NULL,
+	project_id BLOB NOT NULL,
+	period_start TIMESTAMP NOT NULL,
+	period_end TIMESTAMP NOT NULL,
+	storage REAL NOT NULL,
+	egress REAL NOT NULL,
+	object_count REAL NOT NULL,
+	segment_count REAL NOT NULL,
+	storage_amount INTEGER NOT NULL,
+	egress_amount INTEGER NOT NULL,
+	object_amount INTEGER NOT NULL,
+	segment_amount INTEGER NOT NULL,
+	total INTEGER NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( id ),
+	UNIQUE ( project_id, period_start )
+);
 CREATE TABLE irreparabledbs (
 	segmentpath BLOB NOT NULL,
 	segmentdetail BLOB NOT NULL,
@@ -665,13 +803,28 @@ CREATE TABLE nodes (
 	uptime_success_count INTEGER NOT NULL,
 	total_uptime_count INTEGER NOT NULL,
 	uptime_ratio REAL NOT NULL,
+	audit_reputation_alpha REAL NOT NULL,
+	audit_reputation_beta REAL NOT NULL,
+	uptime_reputation_alpha REAL NOT NULL,
+	uptime_reputation_beta REAL NOT NULL,
 	created_at TIMESTAMP NOT NULL,
 	updated_at TIMESTAMP NOT NULL,
 	last_contact_success TIMESTAMP NOT NULL,
 	last_contact_failure TIMESTAMP NOT NULL,
 	contained INTEGER NOT NULL,
+	disqualified TIMESTAMP,
+	last_audited TIMESTAMP,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE objects (
+	id INTEGER NOT NULL,
+	project_id BLOB NOT NULL,
+	bucket_name BLOB NOT NULL,
+	object_key BLOB NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( id ),
+	UNIQUE ( project_id, bucket_name, object_key )
+);
 CREATE TABLE offers (
 	id INTEGER NOT NULL,
 	name TEXT NOT NULL,
@@ -687,6 +840,30 @@ CREATE TABLE offers (
 	status INTEGER NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE payout_statements (
+	node_id BLOB NOT NULL,
+	period_start TIMESTAMP NOT NULL,
+	period_end TIMESTAMP NOT NULL,
+	node_created_at TIMESTAMP NOT NULL,
+	wallet TEXT NOT NULL,
+	at_rest_total REAL NOT NULL,
+	get_total INTEGER NOT NULL,
+	get_repair_total INTEGER NOT NULL,
+	get_audit_total INTEGER NOT NULL,
+	put_total INTEGER NOT NULL,
+	put_repair_total INTEGER NOT NULL,
+	at_rest_amount INTEGER NOT NULL,
+	get_amount INTEGER NOT NULL,
+	repair_amount INTEGER NOT NULL,
+	audit_amount INTEGER NOT NULL,
+	earned INTEGER NOT NULL,
+	held_percent INTEGER NOT NULL,
+	held INTEGER NOT NULL,
+	disqualified INTEGER NOT NULL,
+	paid INTEGER NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( node_id, period_start )
+);
 CREATE TABLE pending_audits (
 	node_id BLOB NOT NULL,
 	piece_id BLOB NOT NULL,
@@ -694,12 +871,16 @@ CREATE TABLE pending_audits (
 	share_size INTEGER NOT NULL,
 	expected_share_hash BLOB NOT NULL,
 	reverify_count INTEGER NOT NULL,
+	piece_num INTEGER NOT NULL,
+	path BLOB NOT NULL,
 	PRIMARY KEY ( node_id )
 );
 CREATE TABLE projects (
 	id BLOB NOT NULL,
 	name TEXT NOT NULL,
 	description TEXT NOT NULL,
+	storage_limit INTEGER NOT NULL,
+	egress_limit INTEGER NOT NULL,
 	created_at TIMESTAMP NOT NULL,
 	PRIMARY KEY ( id )
 );
@@ -748,33 +929,92 @@ CREATE TABLE users (
 	email TEXT NOT NULL,
 	password_hash BLOB NOT NULL,
 	status INTEGER NOT NULL,
+	mfa_enabled INTEGER NOT NULL,
+	mfa_secret_key TEXT,
+	mfa_recovery_codes TEXT,
 	created_at TIMESTAMP NOT NULL,
 	PRIMARY KEY ( id )
 );
 CREATE TABLE api_keys (
 	id BLOB NOT NULL,
 	project_id BLOB NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
-	key BLOB NOT NULL,
+	head BLOB NOT NULL,
 	name TEXT NOT NULL,
+	secret BLOB NOT NULL,
+	caveat BLOB,
 	created_at TIMESTAMP NOT NULL,
 	PRIMARY KEY ( id ),
-	UNIQUE ( key ),
+	UNIQUE ( head ),
 	UNIQUE ( name, project_id )
 );
 CREATE TABLE project_members (
 	member_id BLOB NOT NULL REFERENCES users( id ) ON DELETE CASCADE,
 	project_id BLOB NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+	role INTEGER NOT NULL,
 	created_at TIMESTAMP NOT NULL,
 	PRIMARY KEY ( member_id, project_id )
 );
+CREATE TABLE segments (
+	id INTEGER NOT NULL,
+	object_id INTEGER NOT NULL REFERENCES objects( id ) ON DELETE CASCADE,
+	segment_index INTEGER NOT NULL,
+	remote INTEGER NOT NULL,
+	segment_size INTEGER NOT NULL,
+	root_piece_id BLOB,
+	expires_at TIMESTAMP,
+	created_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( id ),
+	UNIQUE ( object_id, segment_index )
+);
 CREATE TABLE used_serials (
 	serial_number_id INTEGER NOT NULL REFERENCES serial_numbers( id ) ON DELETE CASCADE,
 	storage_node_id BLOB NOT NULL,
 	PRIMARY KEY ( serial_number_id, storage_node_id )
 );
+CREATE TABLE api_key_revocations (
+	api_key_id BLOB NOT NULL REFERENCES api_keys( id ) ON DELETE CASCADE,
+	tail BLOB NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( api_key_id, tail )
+);
+CREATE TABLE segment_pieces (
+	segment_id INTEGER NOT NULL REFERENCES segments( id ) ON DELETE CASCADE,
+	piece_num INTEGER NOT NULL,
+	node_id BLOB NOT NULL,
+	PRIMARY KEY ( segment_id, piece_num )
+);
+CREATE TABLE audit_events (
+	id BLOB NOT NULL,
+	project_id BLOB,
+	actor_id BLOB NOT NULL,
+	action TEXT NOT NULL,
+	target TEXT NOT NULL,
+	source_ip TEXT NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE notification_preferences (
+	user_id BLOB NOT NULL REFERENCES users( id ) ON DELETE CASCADE,
+	usage_thresholds INTEGER NOT NULL,
+	api_key_created INTEGER NOT NULL,
+	project_member_added INTEGER NOT NULL,
+	updated_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( user_id )
+);
+CREATE TABLE usage_notifications (
+	project_id BLOB NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+	resource TEXT NOT NULL,
+	threshold INTEGER NOT NULL,
+	period_start TIMESTAMP NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( project_id, resource, threshold, period_start )
+);
+CREATE INDEX audit_events_project_id_created_at ON audit_events ( project_id, created_at );
 CREATE INDEX bucket_name_project_id_interval_start_interval_seconds ON bucket_bandwidth_rollups ( bucket_name, project_id, interval_start, interval_seconds );
 CREATE UNIQUE INDEX bucket_id_rollup ON bucket_usages ( bucket_id, rollup_end_time );
 CREATE INDEX node_last_ip ON nodes ( last_ip );
+CREATE INDEX segment_pieces_node_id_segment_id ON segment_pieces ( node_id, segment_id );
+CREATE INDEX segments_expires_at ON segments ( expires_at );
 CREATE UNIQUE INDEX serial_number ON serial_numbers ( serial_number );
 CREATE INDEX serial_numbers_expires_at_index ON serial_numbers ( expires_at );
 CREATE INDEX storagenode_id_interval_start_interval_seconds ON storagenode_bandwidth_rollups ( storagenode_id, interval_start, interval_seconds );`
@@ -1989,788 +2229,1343 @@ func (f Injuredsegment_Attempted_Field) value() interface{} {
 
 func (Injuredsegment_Attempted_Field) _Column() string { return "attempted" }
 
-type Irreparabledb struct {
-	Segmentpath        []byte
-	Segmentdetail      []byte
-	PiecesLostCount    int64
-	SegDamagedUnixSec  int64
-	RepairAttemptCount int64
+type Invoice struct {
+	Id            []byte
+	ProjectId     []byte
+	PeriodStart   time.Time
+	PeriodEnd     time.Time
+	Storage       float64
+	Egress        float64
+	ObjectCount   float64
+	SegmentCount  float64
+	StorageAmount int64
+	EgressAmount  int64
+	ObjectAmount  int64
+	SegmentAmount int64
+	Total         int64
+	CreatedAt     time.Time
 }
 
-func (Irreparabledb) _Table() string { return "irreparabledbs" }
+func (Invoice) _Table() string { return "invoices" }
 
-type Irreparabledb_Update_Fields struct {
-	Segmentdetail      Irreparabledb_Segmentdetail_Field
-	PiecesLostCount    Irreparabledb_PiecesLostCount_Field
-	SegDamagedUnixSec  Irreparabledb_SegDamagedUnixSec_Field
-	RepairAttemptCount Irreparabledb_RepairAttemptCount_Field
+type Invoice_Update_Fields struct {
 }
 
-type Irreparabledb_Segmentpath_Field struct {
+type Invoice_Id_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func Irreparabledb_Segmentpath(v []byte) Irreparabledb_Segmentpath_Field {
-	return Irreparabledb_Segmentpath_Field{_set: true, _value: v}
+func Invoice_Id(v []byte) Invoice_Id_Field {
+	return Invoice_Id_Field{_set: true, _value: v}
 }
 
-func (f Irreparabledb_Segmentpath_Field) value() interface{} {
+func (f Invoice_Id_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Irreparabledb_Segmentpath_Field) _Column() string { return "segmentpath" }
+func (Invoice_Id_Field) _Column() string { return "id" }
 
-type Irreparabledb_Segmentdetail_Field struct {
+type Invoice_ProjectId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func Irreparabledb_Segmentdetail(v []byte) Irreparabledb_Segmentdetail_Field {
-	return Irreparabledb_Segmentdetail_Field{_set: true, _value: v}
+func Invoice_ProjectId(v []byte) Invoice_ProjectId_Field {
+	return Invoice_ProjectId_Field{_set: true, _value: v}
 }
 
-func (f Irreparabledb_Segmentdetail_Field) value() interface{} {
+func (f Invoice_ProjectId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Irreparabledb_Segmentdetail_Field) _Column() string { return "segmentdetail" }
+func (Invoice_ProjectId_Field) _Column() string { return "project_id" }
 
-type Irreparabledb_PiecesLostCount_Field struct {
+type Invoice_PeriodStart_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value time.Time
 }
 
-func Irreparabledb_PiecesLostCount(v int64) Irreparabledb_PiecesLostCount_Field {
-	return Irreparabledb_PiecesLostCount_Field{_set: true, _value: v}
+func Invoice_PeriodStart(v time.Time) Invoice_PeriodStart_Field {
+	return Invoice_PeriodStart_Field{_set: true, _value: v}
 }
 
-func (f Irreparabledb_PiecesLostCount_Field) value() interface{} {
+func (f Invoice_PeriodStart_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Irreparabledb_PiecesLostCount_Field) _Column() string { return "pieces_lost_count" }
+func (Invoice_PeriodStart_Field) _Column() string { return "period_start" }
 
-type Irreparabledb_SegDamagedUnixSec_Field struct {
+type Invoice_PeriodEnd_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value time.Time
 }
 
-func Irreparabledb_SegDamagedUnixSec(v int64) Irreparabledb_SegDamagedUnixSec_Field {
-	return Irreparabledb_SegDamagedUnixSec_Field{_set: true, _value: v}
+func Invoice_PeriodEnd(v time.Time) Invoice_PeriodEnd_Field {
+	return Invoice_PeriodEnd_Field{_set: true, _value: v}
 }
 
-func (f Irreparabledb_SegDamagedUnixSec_Field) value() interface{} {
+func (f Invoice_PeriodEnd_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Irreparabledb_SegDamagedUnixSec_Field) _Column() string { return "seg_damaged_unix_sec" }
+func (Invoice_PeriodEnd_Field) _Column() string { return "period_end" }
 
-type Irreparabledb_RepairAttemptCount_Field struct {
+type Invoice_Storage_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value float64
 }
 
-func Irreparabledb_RepairAttemptCount(v int64) Irreparabledb_RepairAttemptCount_Field {
-	return Irreparabledb_RepairAttemptCount_Field{_set: true, _value: v}
+func Invoice_Storage(v float64) Invoice_Storage_Field {
+	return Invoice_Storage_Field{_set: true, _value: v}
 }
 
-func (f Irreparabledb_RepairAttemptCount_Field) value() interface{} {
+func (f Invoice_Storage_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Irreparabledb_RepairAttemptCount_Field) _Column() string { return "repair_attempt_count" }
-
-type Node struct {
-	Id                 []byte
-	Address            string
-	LastIp             string
-	Protocol           int
-	Type               int
-	Email              string
-	Wallet             string
-	FreeBandwidth      int64
-	FreeDisk           int64
-	Major              int64
-	Minor              int64
-	Patch              int64
-	Hash               string
-	Timestamp          time.Time
-	Release            bool
-	Latency90          int64
-	AuditSuccessCount  int64
-	TotalAuditCount    int64
-	AuditSuccessRatio  float64
-	UptimeSuccessCount int64
-	TotalUptimeCount   int64
-	UptimeRatio        float64
-	CreatedAt          time.Time
-	UpdatedAt          time.Time
-	LastContactSuccess time.Time
-	LastContactFailure time.Time
-	Contained          bool
-}
-
-func (Node) _Table() string { return "nodes" }
-
-type Node_Update_Fields struct {
-	Address            Node_Address_Field
-	LastIp             Node_LastIp_Field
-	Protocol           Node_Protocol_Field
-	Type               Node_Type_Field
-	Email              Node_Email_Field
-	Wallet             Node_Wallet_Field
-	FreeBandwidth      Node_FreeBandwidth_Field
-	FreeDisk           Node_FreeDisk_Field
-	Major              Node_Major_Field
-	Minor              Node_Minor_Field
-	Patch              Node_Patch_Field
-	Hash               Node_Hash_Field
-	Timestamp          Node_Timestamp_Field
-	Release            Node_Release_Field
-	Latency90          Node_Latency90_Field
-	AuditSuccessCount  Node_AuditSuccessCount_Field
-	TotalAuditCount    Node_TotalAuditCount_Field
-	AuditSuccessRatio  Node_AuditSuccessRatio_Field
-	UptimeSuccessCount Node_UptimeSuccessCount_Field
-	TotalUptimeCount   Node_TotalUptimeCount_Field
-	UptimeRatio        Node_UptimeRatio_Field
-	LastContactSuccess Node_LastContactSuccess_Field
-	LastContactFailure Node_LastContactFailure_Field
-	Contained          Node_Contained_Field
-}
+func (Invoice_Storage_Field) _Column() string { return "storage" }
 
-type Node_Id_Field struct {
+type Invoice_Egress_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value float64
 }
 
-func Node_Id(v []byte) Node_Id_Field {
-	return Node_Id_Field{_set: true, _value: v}
+func Invoice_Egress(v float64) Invoice_Egress_Field {
+	return Invoice_Egress_Field{_set: true, _value: v}
 }
 
-func (f Node_Id_Field) value() interface{} {
+func (f Invoice_Egress_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Id_Field) _Column() string { return "id" }
+func (Invoice_Egress_Field) _Column() string { return "egress" }
 
-type Node_Address_Field struct {
+type Invoice_ObjectCount_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value float64
 }
 
-func Node_Address(v string) Node_Address_Field {
-	return Node_Address_Field{_set: true, _value: v}
+func Invoice_ObjectCount(v float64) Invoice_ObjectCount_Field {
+	return Invoice_ObjectCount_Field{_set: true, _value: v}
 }
 
-func (f Node_Address_Field) value() interface{} {
+func (f Invoice_ObjectCount_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Address_Field) _Column() string { return "address" }
+func (Invoice_ObjectCount_Field) _Column() string { return "object_count" }
 
-type Node_LastIp_Field struct {
+type Invoice_SegmentCount_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value float64
 }
 
-func Node_LastIp(v string) Node_LastIp_Field {
-	return Node_LastIp_Field{_set: true, _value: v}
+func Invoice_SegmentCount(v float64) Invoice_SegmentCount_Field {
+	return Invoice_SegmentCount_Field{_set: true, _value: v}
 }
 
-func (f Node_LastIp_Field) value() interface{} {
+func (f Invoice_SegmentCount_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_LastIp_Field) _Column() string { return "last_ip" }
+func (Invoice_SegmentCount_Field) _Column() string { return "segment_count" }
 
-type Node_Protocol_Field struct {
+type Invoice_StorageAmount_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value int64
 }
 
-func Node_Protocol(v int) Node_Protocol_Field {
-	return Node_Protocol_Field{_set: true, _value: v}
+func Invoice_StorageAmount(v int64) Invoice_StorageAmount_Field {
+	return Invoice_StorageAmount_Field{_set: true, _value: v}
 }
 
-func (f Node_Protocol_Field) value() interface{} {
+func (f Invoice_StorageAmount_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Protocol_Field) _Column() string { return "protocol" }
+func (Invoice_StorageAmount_Field) _Column() string { return "storage_amount" }
 
-type Node_Type_Field struct {
+type Invoice_EgressAmount_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value int64
 }
 
-func Node_Type(v int) Node_Type_Field {
-	return Node_Type_Field{_set: true, _value: v}
+func Invoice_EgressAmount(v int64) Invoice_EgressAmount_Field {
+	return Invoice_EgressAmount_Field{_set: true, _value: v}
 }
 
-func (f Node_Type_Field) value() interface{} {
+func (f Invoice_EgressAmount_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Type_Field) _Column() string { return "type" }
+func (Invoice_EgressAmount_Field) _Column() string { return "egress_amount" }
 
-type Node_Email_Field struct {
+type Invoice_ObjectAmount_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value int64
 }
 
-func Node_Email(v string) Node_Email_Field {
-	return Node_Email_Field{_set: true, _value: v}
+func Invoice_ObjectAmount(v int64) Invoice_ObjectAmount_Field {
+	return Invoice_ObjectAmount_Field{_set: true, _value: v}
 }
 
-func (f Node_Email_Field) value() interface{} {
+func (f Invoice_ObjectAmount_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Email_Field) _Column() string { return "email" }
+func (Invoice_ObjectAmount_Field) _Column() string { return "object_amount" }
 
-type Node_Wallet_Field struct {
+type Invoice_SegmentAmount_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value int64
 }
 
-func Node_Wallet(v string) Node_Wallet_Field {
-	return Node_Wallet_Field{_set: true, _value: v}
+func Invoice_SegmentAmount(v int64) Invoice_SegmentAmount_Field {
+	return Invoice_SegmentAmount_Field{_set: true, _value: v}
 }
 
-func (f Node_Wallet_Field) value() interface{} {
+func (f Invoice_SegmentAmount_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Wallet_Field) _Column() string { return "wallet" }
+func (Invoice_SegmentAmount_Field) _Column() string { return "segment_amount" }
 
-type Node_FreeBandwidth_Field struct {
+type Invoice_Total_Field struct {
 	_set   bool
 	_null  bool
 	_value int64
 }
 
-func Node_FreeBandwidth(v int64) Node_FreeBandwidth_Field {
-	return Node_FreeBandwidth_Field{_set: true, _value: v}
+func Invoice_Total(v int64) Invoice_Total_Field {
+	return Invoice_Total_Field{_set: true, _value: v}
 }
 
-func (f Node_FreeBandwidth_Field) value() interface{} {
+func (f Invoice_Total_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_FreeBandwidth_Field) _Column() string { return "free_bandwidth" }
+func (Invoice_Total_Field) _Column() string { return "total" }
 
-type Node_FreeDisk_Field struct {
+type Invoice_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value time.Time
 }
 
-func Node_FreeDisk(v int64) Node_FreeDisk_Field {
-	return Node_FreeDisk_Field{_set: true, _value: v}
+func Invoice_CreatedAt(v time.Time) Invoice_CreatedAt_Field {
+	return Invoice_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f Node_FreeDisk_Field) value() interface{} {
+func (f Invoice_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_FreeDisk_Field) _Column() string { return "free_disk" }
+func (Invoice_CreatedAt_Field) _Column() string { return "created_at" }
 
-type Node_Major_Field struct {
+type Irreparabledb struct {
+	Segmentpath        []byte
+	Segmentdetail      []byte
+	PiecesLostCount    int64
+	SegDamagedUnixSec  int64
+	RepairAttemptCount int64
+}
+
+func (Irreparabledb) _Table() string { return "irreparabledbs" }
+
+type Irreparabledb_Update_Fields struct {
+	Segmentdetail      Irreparabledb_Segmentdetail_Field
+	PiecesLostCount    Irreparabledb_PiecesLostCount_Field
+	SegDamagedUnixSec  Irreparabledb_SegDamagedUnixSec_Field
+	RepairAttemptCount Irreparabledb_RepairAttemptCount_Field
+}
+
+type Irreparabledb_Segmentpath_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value []byte
 }
 
-func Node_Major(v int64) Node_Major_Field {
-	return Node_Major_Field{_set: true, _value: v}
+func Irreparabledb_Segmentpath(v []byte) Irreparabledb_Segmentpath_Field {
+	return Irreparabledb_Segmentpath_Field{_set: true, _value: v}
 }
 
-func (f Node_Major_Field) value() interface{} {
+func (f Irreparabledb_Segmentpath_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Major_Field) _Column() string { return "major" }
+func (Irreparabledb_Segmentpath_Field) _Column() string { return "segmentpath" }
 
-type Node_Minor_Field struct {
+type Irreparabledb_Segmentdetail_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value []byte
 }
 
-func Node_Minor(v int64) Node_Minor_Field {
-	return Node_Minor_Field{_set: true, _value: v}
+func Irreparabledb_Segmentdetail(v []byte) Irreparabledb_Segmentdetail_Field {
+	return Irreparabledb_Segmentdetail_Field{_set: true, _value: v}
 }
 
-func (f Node_Minor_Field) value() interface{} {
+func (f Irreparabledb_Segmentdetail_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Minor_Field) _Column() string { return "minor" }
+func (Irreparabledb_Segmentdetail_Field) _Column() string { return "segmentdetail" }
 
-type Node_Patch_Field struct {
+type Irreparabledb_PiecesLostCount_Field struct {
 	_set   bool
 	_null  bool
 	_value int64
 }
 
-func Node_Patch(v int64) Node_Patch_Field {
-	return Node_Patch_Field{_set: true, _value: v}
+func Irreparabledb_PiecesLostCount(v int64) Irreparabledb_PiecesLostCount_Field {
+	return Irreparabledb_PiecesLostCount_Field{_set: true, _value: v}
 }
 
-func (f Node_Patch_Field) value() interface{} {
+func (f Irreparabledb_PiecesLostCount_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Patch_Field) _Column() string { return "patch" }
+func (Irreparabledb_PiecesLostCount_Field) _Column() string { return "pieces_lost_count" }
 
-type Node_Hash_Field struct {
+type Irreparabledb_SegDamagedUnixSec_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value int64
 }
 
-func Node_Hash(v string) Node_Hash_Field {
-	return Node_Hash_Field{_set: true, _value: v}
-}
+func Irreparabledb_SegDamagedUnixSec(v int64) Irreparabledb_SegDamagedUnixSec_Field {
+	return Irreparabledb_SegDamagedUnixSec_Field{_set: true, _value: v}
+}
 
-func (f Node_Hash_Field) value() interface{} {
+func (f Irreparabledb_SegDamagedUnixSec_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Hash_Field) _Column() string { return "hash" }
+func (Irreparabledb_SegDamagedUnixSec_Field) _Column() string { return "seg_damaged_unix_sec" }
 
-type Node_Timestamp_Field struct {
+type Irreparabledb_RepairAttemptCount_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value int64
 }
 
-func Node_Timestamp(v time.Time) Node_Timestamp_Field {
-	return Node_Timestamp_Field{_set: true, _value: v}
+func Irreparabledb_RepairAttemptCount(v int64) Irreparabledb_RepairAttemptCount_Field {
+	return Irreparabledb_RepairAttemptCount_Field{_set: true, _value: v}
 }
 
-func (f Node_Timestamp_Field) value() interface{} {
+func (f Irreparabledb_RepairAttemptCount_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Timestamp_Field) _Column() string { return "timestamp" }
+func (Irreparabledb_RepairAttemptCount_Field) _Column() string { return "repair_attempt_count" }
 
-type Node_Release_Field struct {
-	_set   bool
-	_null  bool
-	_value bool
+type Node struct {
+	Id                    []byte
+	Address               string
+	LastIp                string
+	Protocol              int
+	Type                  int
+	Email                 string
+	Wallet                string
+	FreeBandwidth         int64
+	FreeDisk              int64
+	Major                 int64
+	Minor                 int64
+	Patch                 int64
+	Hash                  string
+	Timestamp             time.Time
+	Release               bool
+	Latency90             int64
+	AuditSuccessCount     int64
+	TotalAuditCount       int64
+	AuditSuccessRatio     float64
+	UptimeSuccessCount    int64
+	TotalUptimeCount      int64
+	UptimeRatio           float64
+	AuditReputationAlpha  float64
+	AuditReputationBeta   float64
+	UptimeReputationAlpha float64
+	UptimeReputationBeta  float64
+	CreatedAt             time.Time
+	UpdatedAt             time.Time
+	LastContactSuccess    time.Time
+	LastContactFailure    time.Time
+	Contained             bool
+	Disqualified          *time.Time
+	LastAudited           *time.Time
 }
 
-func Node_Release(v bool) Node_Release_Field {
-	return Node_Release_Field{_set: true, _value: v}
-}
+func (Node) _Table() string { return "nodes" }
 
-func (f Node_Release_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
-	}
-	return f._value
+type Node_Create_Fields struct {
+	Disqualified Node_Disqualified_Field
+	LastAudited  Node_LastAudited_Field
 }
 
-func (Node_Release_Field) _Column() string { return "release" }
+type Node_Update_Fields struct {
+	Address               Node_Address_Field
+	LastIp                Node_LastIp_Field
+	Protocol              Node_Protocol_Field
+	Type                  Node_Type_Field
+	Email                 Node_Email_Field
+	Wallet                Node_Wallet_Field
+	FreeBandwidth         Node_FreeBandwidth_Field
+	FreeDisk              Node_FreeDisk_Field
+	Major                 Node_Major_Field
+	Minor                 Node_Minor_Field
+	Patch                 Node_Patch_Field
+	Hash                  Node_Hash_Field
+	Timestamp             Node_Timestamp_Field
+	Release               Node_Release_Field
+	Latency90             Node_Latency90_Field
+	AuditSuccessCount     Node_AuditSuccessCount_Field
+	TotalAuditCount       Node_TotalAuditCount_Field
+	AuditSuccessRatio     Node_AuditSuccessRatio_Field
+	UptimeSuccessCount    Node_UptimeSuccessCount_Field
+	TotalUptimeCount      Node_TotalUptimeCount_Field
+	UptimeRatio           Node_UptimeRatio_Field
+	AuditReputationAlpha  Node_AuditReputationAlpha_Field
+	AuditReputationBeta   Node_AuditReputationBeta_Field
+	UptimeReputationAlpha Node_UptimeReputationAlpha_Field
+	UptimeReputationBeta  Node_UptimeReputationBeta_Field
+	LastContactSuccess    Node_LastContactSuccess_Field
+	LastContactFailure    Node_LastContactFailure_Field
+	Contained             Node_Contained_Field
+	Disqualified          Node_Disqualified_Field
+	LastAudited           Node_LastAudited_Field
+}
 
-type Node_Latency90_Field struct {
+type Node_Id_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value []byte
 }
 
-func Node_Latency90(v int64) Node_Latency90_Field {
-	return Node_Latency90_Field{_set: true, _value: v}
+func Node_Id(v []byte) Node_Id_Field {
+	return Node_Id_Field{_set: true, _value: v}
 }
 
-func (f Node_Latency90_Field) value() interface{} {
+func (f Node_Id_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Latency90_Field) _Column() string { return "latency_90" }
+func (Node_Id_Field) _Column() string { return "id" }
 
-type Node_AuditSuccessCount_Field struct {
+type Node_Address_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value string
 }
 
-func Node_AuditSuccessCount(v int64) Node_AuditSuccessCount_Field {
-	return Node_AuditSuccessCount_Field{_set: true, _value: v}
+func Node_Address(v string) Node_Address_Field {
+	return Node_Address_Field{_set: true, _value: v}
 }
 
-func (f Node_AuditSuccessCount_Field) value() interface{} {
+func (f Node_Address_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_AuditSuccessCount_Field) _Column() string { return "audit_success_count" }
+func (Node_Address_Field) _Column() string { return "address" }
 
-type Node_TotalAuditCount_Field struct {
+type Node_LastIp_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value string
 }
 
-func Node_TotalAuditCount(v int64) Node_TotalAuditCount_Field {
-	return Node_TotalAuditCount_Field{_set: true, _value: v}
+func Node_LastIp(v string) Node_LastIp_Field {
+	return Node_LastIp_Field{_set: true, _value: v}
 }
 
-func (f Node_TotalAuditCount_Field) value() interface{} {
+func (f Node_LastIp_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_TotalAuditCount_Field) _Column() string { return "total_audit_count" }
+func (Node_LastIp_Field) _Column() string { return "last_ip" }
 
-type Node_AuditSuccessRatio_Field struct {
+type Node_Protocol_Field struct {
 	_set   bool
 	_null  bool
-	_value float64
+	_value int
 }
 
-func Node_AuditSuccessRatio(v float64) Node_AuditSuccessRatio_Field {
-	return Node_AuditSuccessRatio_Field{_set: true, _value: v}
+func Node_Protocol(v int) Node_Protocol_Field {
+	return Node_Protocol_Field{_set: true, _value: v}
 }
 
-func (f Node_AuditSuccessRatio_Field) value() interface{} {
+func (f Node_Protocol_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_AuditSuccessRatio_Field) _Column() string { return "audit_success_ratio" }
+func (Node_Protocol_Field) _Column() string { return "protocol" }
 
-type Node_UptimeSuccessCount_Field struct {
+type Node_Type_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value int
 }
 
-func Node_UptimeSuccessCount(v int64) Node_UptimeSuccessCount_Field {
-	return Node_UptimeSuccessCount_Field{_set: true, _value: v}
+func Node_Type(v int) Node_Type_Field {
+	return Node_Type_Field{_set: true, _value: v}
 }
 
-func (f Node_UptimeSuccessCount_Field) value() interface{} {
+func (f Node_Type_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_UptimeSuccessCount_Field) _Column() string { return "uptime_success_count" }
+func (Node_Type_Field) _Column() string { return "type" }
 
-type Node_TotalUptimeCount_Field struct {
+type Node_Email_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value string
 }
 
-func Node_TotalUptimeCount(v int64) Node_TotalUptimeCount_Field {
-	return Node_TotalUptimeCount_Field{_set: true, _value: v}
+func Node_Email(v string) Node_Email_Field {
+	return Node_Email_Field{_set: true, _value: v}
 }
 
-func (f Node_TotalUptimeCount_Field) value() interface{} {
+func (f Node_Email_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_TotalUptimeCount_Field) _Column() string { return "total_uptime_count" }
+func (Node_Email_Field) _Column() string { return "email" }
 
-type Node_UptimeRatio_Field struct {
+type Node_Wallet_Field struct {
 	_set   bool
 	_null  bool
-	_value float64
+	_value string
 }
 
-func Node_UptimeRatio(v float64) Node_UptimeRatio_Field {
-	return Node_UptimeRatio_Field{_set: true, _value: v}
+func Node_Wallet(v string) Node_Wallet_Field {
+	return Node_Wallet_Field{_set: true, _value: v}
 }
 
-func (f Node_UptimeRatio_Field) value() interface{} {
+func (f Node_Wallet_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_UptimeRatio_Field) _Column() string { return "uptime_ratio" }
+func (Node_Wallet_Field) _Column() string { return "wallet" }
 
-type Node_CreatedAt_Field struct {
+type Node_FreeBandwidth_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value int64
 }
 
-func Node_CreatedAt(v time.Time) Node_CreatedAt_Field {
-	return Node_CreatedAt_Field{_set: true, _value: v}
+func Node_FreeBandwidth(v int64) Node_FreeBandwidth_Field {
+	return Node_FreeBandwidth_Field{_set: true, _value: v}
 }
 
-func (f Node_CreatedAt_Field) value() interface{} {
+func (f Node_FreeBandwidth_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_CreatedAt_Field) _Column() string { return "created_at" }
+func (Node_FreeBandwidth_Field) _Column() string { return "free_bandwidth" }
 
-type Node_UpdatedAt_Field struct {
+type Node_FreeDisk_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value int64
 }
 
-func Node_UpdatedAt(v time.Time) Node_UpdatedAt_Field {
-	return Node_UpdatedAt_Field{_set: true, _value: v}
+func Node_FreeDisk(v int64) Node_FreeDisk_Field {
+	return Node_FreeDisk_Field{_set: true, _value: v}
 }
 
-func (f Node_UpdatedAt_Field) value() interface{} {
+func (f Node_FreeDisk_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_UpdatedAt_Field) _Column() string { return "updated_at" }
+func (Node_FreeDisk_Field) _Column() string { return "free_disk" }
 
-type Node_LastContactSuccess_Field struct {
+type Node_Major_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value int64
 }
 
-func Node_LastContactSuccess(v time.Time) Node_LastContactSuccess_Field {
-	return Node_LastContactSuccess_Field{_set: true, _value: v}
+func Node_Major(v int64) Node_Major_Field {
+	return Node_Major_Field{_set: true, _value: v}
 }
 
-func (f Node_LastContactSuccess_Field) value() interface{} {
+func (f Node_Major_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_LastContactSuccess_Field) _Column() string { return "last_contact_success" }
+func (Node_Major_Field) _Column() string { return "major" }
 
-type Node_LastContactFailure_Field struct {
+type Node_Minor_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value int64
 }
 
-func Node_LastContactFailure(v time.Time) Node_LastContactFailure_Field {
-	return Node_LastContactFailure_Field{_set: true, _value: v}
+func Node_Minor(v int64) Node_Minor_Field {
+	return Node_Minor_Field{_set: true, _value: v}
 }
 
-func (f Node_LastContactFailure_Field) value() interface{} {
+func (f Node_Minor_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_LastContactFailure_Field) _Column() string { return "last_contact_failure" }
+func (Node_Minor_Field) _Column() string { return "minor" }
 
-type Node_Contained_Field struct {
+type Node_Patch_Field struct {
 	_set   bool
 	_null  bool
-	_value bool
+	_value int64
 }
 
-func Node_Contained(v bool) Node_Contained_Field {
-	return Node_Contained_Field{_set: true, _value: v}
+func Node_Patch(v int64) Node_Patch_Field {
+	return Node_Patch_Field{_set: true, _value: v}
 }
 
-func (f Node_Contained_Field) value() interface{} {
+func (f Node_Patch_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Contained_Field) _Column() string { return "contained" }
-
-type Offer struct {
-	Id                        int
-	Name                      string
-	Description               string
-	Type                      int
-	CreditInCents             int
-	AwardCreditDurationDays   int
-	InviteeCreditDurationDays int
-	RedeemableCap             int
-	NumRedeemed               int
-	ExpiresAt                 *time.Time
-	CreatedAt                 time.Time
-	Status                    int
-}
-
-func (Offer) _Table() string { return "offers" }
-
-type Offer_Update_Fields struct {
-	Name                      Offer_Name_Field
-	Description               Offer_Description_Field
-	Type                      Offer_Type_Field
-	CreditInCents             Offer_CreditInCents_Field
-	AwardCreditDurationDays   Offer_AwardCreditDurationDays_Field
-	InviteeCreditDurationDays Offer_InviteeCreditDurationDays_Field
-	RedeemableCap             Offer_RedeemableCap_Field
-	NumRedeemed               Offer_NumRedeemed_Field
-	ExpiresAt                 Offer_ExpiresAt_Field
-	Status                    Offer_Status_Field
-}
+func (Node_Patch_Field) _Column() string { return "patch" }
 
-type Offer_Id_Field struct {
+type Node_Hash_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value string
 }
 
-func Offer_Id(v int) Offer_Id_Field {
-	return Offer_Id_Field{_set: true, _value: v}
+func Node_Hash(v string) Node_Hash_Field {
+	return Node_Hash_Field{_set: true, _value: v}
 }
 
-func (f Offer_Id_Field) value() interface{} {
+func (f Node_Hash_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Offer_Id_Field) _Column() string { return "id" }
+func (Node_Hash_Field) _Column() string { return "hash" }
 
-type Offer_Name_Field struct {
+type Node_Timestamp_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value time.Time
 }
 
-func Offer_Name(v string) Offer_Name_Field {
-	return Offer_Name_Field{_set: true, _value: v}
+func Node_Timestamp(v time.Time) Node_Timestamp_Field {
+	return Node_Timestamp_Field{_set: true, _value: v}
 }
 
-func (f Offer_Name_Field) value() interface{} {
+func (f Node_Timestamp_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Offer_Name_Field) _Column() string { return "name" }
+func (Node_Timestamp_Field) _Column() string { return "timestamp" }
 
-type Offer_Description_Field struct {
+type Node_Release_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value bool
 }
 
-func Offer_Description(v string) Offer_Description_Field {
-	return Offer_Description_Field{_set: true, _value: v}
+func Node_Release(v bool) Node_Release_Field {
+	return Node_Release_Field{_set: true, _value: v}
 }
 
-func (f Offer_Description_Field) value() interface{} {
+func (f Node_Release_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Offer_Description_Field) _Column() string { return "description" }
+func (Node_Release_Field) _Column() string { return "release" }
 
-type Offer_Type_Field struct {
+type Node_Latency90_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value int64
 }
 
-func Offer_Type(v int) Offer_Type_Field {
-	return Offer_Type_Field{_set: true, _value: v}
+func Node_Latency90(v int64) Node_Latency90_Field {
+	return Node_Latency90_Field{_set: true, _value: v}
 }
 
-func (f Offer_Type_Field) value() interface{} {
+func (f Node_Latency90_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_Latency90_Field) _Column() string { return "latency_90" }
+
+type Node_AuditSuccessCount_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func Node_AuditSuccessCount(v int64) Node_AuditSuccessCount_Field {
+	return Node_AuditSuccessCount_Field{_set: true, _value: v}
+}
+
+func (f Node_AuditSuccessCount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_AuditSuccessCount_Field) _Column() string { return "audit_success_count" }
+
+type Node_TotalAuditCount_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func Node_TotalAuditCount(v int64) Node_TotalAuditCount_Field {
+	return Node_TotalAuditCount_Field{_set: true, _value: v}
+}
+
+func (f Node_TotalAuditCount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_TotalAuditCount_Field) _Column() string { return "total_audit_count" }
+
+type Node_AuditSuccessRatio_Field struct {
+	_set   bool
+	_null  bool
+	_value float64
+}
+
+func Node_AuditSuccessRatio(v float64) Node_AuditSuccessRatio_Field {
+	return Node_AuditSuccessRatio_Field{_set: true, _value: v}
+}
+
+func (f Node_AuditSuccessRatio_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_AuditSuccessRatio_Field) _Column() string { return "audit_success_ratio" }
+
+type Node_UptimeSuccessCount_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func Node_UptimeSuccessCount(v int64) Node_UptimeSuccessCount_Field {
+	return Node_UptimeSuccessCount_Field{_set: true, _value: v}
+}
+
+func (f Node_UptimeSuccessCount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_UptimeSuccessCount_Field) _Column() string { return "uptime_success_count" }
+
+type Node_TotalUptimeCount_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func Node_TotalUptimeCount(v int64) Node_TotalUptimeCount_Field {
+	return Node_TotalUptimeCount_Field{_set: true, _value: v}
+}
+
+func (f Node_TotalUptimeCount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_TotalUptimeCount_Field) _Column() string { return "total_uptime_count" }
+
+type Node_UptimeRatio_Field struct {
+	_set   bool
+	_null  bool
+	_value float64
+}
+
+func Node_UptimeRatio(v float64) Node_UptimeRatio_Field {
+	return Node_UptimeRatio_Field{_set: true, _value: v}
+}
+
+func (f Node_UptimeRatio_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_UptimeRatio_Field) _Column() string { return "uptime_ratio" }
+
+type Node_AuditReputationAlpha_Field struct {
+	_set   bool
+	_null  bool
+	_value float64
+}
+
+func Node_AuditReputationAlpha(v float64) Node_AuditReputationAlpha_Field {
+	return Node_AuditReputationAlpha_Field{_set: true, _value: v}
+}
+
+func (f Node_AuditReputationAlpha_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_AuditReputationAlpha_Field) _Column() string { return "audit_reputation_alpha" }
+
+type Node_AuditReputationBeta_Field struct {
+	_set   bool
+	_null  bool
+	_value float64
+}
+
+func Node_AuditReputationBeta(v float64) Node_AuditReputationBeta_Field {
+	return Node_AuditReputationBeta_Field{_set: true, _value: v}
+}
+
+func (f Node_AuditReputationBeta_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_AuditReputationBeta_Field) _Column() string { return "audit_reputation_beta" }
+
+type Node_UptimeReputationAlpha_Field struct {
+	_set   bool
+	_null  bool
+	_value float64
+}
+
+func Node_UptimeReputationAlpha(v float64) Node_UptimeReputationAlpha_Field {
+	return Node_UptimeReputationAlpha_Field{_set: true, _value: v}
+}
+
+func (f Node_UptimeReputationAlpha_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_UptimeReputationAlpha_Field) _Column() string { return "uptime_reputation_alpha" }
+
+type Node_UptimeReputationBeta_Field struct {
+	_set   bool
+	_null  bool
+	_value float64
+}
+
+func Node_UptimeReputationBeta(v float64) Node_UptimeReputationBeta_Field {
+	return Node_UptimeReputationBeta_Field{_set: true, _value: v}
+}
+
+func (f Node_UptimeReputationBeta_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_UptimeReputationBeta_Field) _Column() string { return "uptime_reputation_beta" }
+
+type Node_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func Node_CreatedAt(v time.Time) Node_CreatedAt_Field {
+	return Node_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f Node_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_CreatedAt_Field) _Column() string { return "created_at" }
+
+type Node_UpdatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func Node_UpdatedAt(v time.Time) Node_UpdatedAt_Field {
+	return Node_UpdatedAt_Field{_set: true, _value: v}
+}
+
+func (f Node_UpdatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_UpdatedAt_Field) _Column() string { return "updated_at" }
+
+type Node_LastContactSuccess_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func Node_LastContactSuccess(v time.Time) Node_LastContactSuccess_Field {
+	return Node_LastContactSuccess_Field{_set: true, _value: v}
+}
+
+func (f Node_LastContactSuccess_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_LastContactSuccess_Field) _Column() string { return "last_contact_success" }
+
+type Node_LastContactFailure_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func Node_LastContactFailure(v time.Time) Node_LastContactFailure_Field {
+	return Node_LastContactFailure_Field{_set: true, _value: v}
+}
+
+func (f Node_LastContactFailure_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_LastContactFailure_Field) _Column() string { return "last_contact_failure" }
+
+type Node_Contained_Field struct {
+	_set   bool
+	_null  bool
+	_value bool
+}
+
+func Node_Contained(v bool) Node_Contained_Field {
+	return Node_Contained_Field{_set: true, _value: v}
+}
+
+func (f Node_Contained_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_Contained_Field) _Column() string { return "contained" }
+
+type Node_Disqualified_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func Node_Disqualified(v time.Time) Node_Disqualified_Field {
+	v = toUTC(v)
+	return Node_Disqualified_Field{_set: true, _value: &v}
+}
+
+func Node_Disqualified_Raw(v *time.Time) Node_Disqualified_Field {
+	if v == nil {
+		return Node_Disqualified_Null()
+	}
+	return Node_Disqualified(*v)
+}
+
+func Node_Disqualified_Null() Node_Disqualified_Field {
+	return Node_Disqualified_Field{_set: true, _null: true}
+}
+
+func (f Node_Disqualified_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Node_Disqualified_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_Disqualified_Field) _Column() string { return "disqualified" }
+
+type Node_LastAudited_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func Node_LastAudited(v time.Time) Node_LastAudited_Field {
+	v = toUTC(v)
+	return Node_LastAudited_Field{_set: true, _value: &v}
+}
+
+func Node_LastAudited_Raw(v *time.Time) Node_LastAudited_Field {
+	if v == nil {
+		return Node_LastAudited_Null()
+	}
+	return Node_LastAudited(*v)
+}
+
+func Node_LastAudited_Null() Node_LastAudited_Field {
+	return Node_LastAudited_Field{_set: true, _null: true}
+}
+
+func (f Node_LastAudited_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Node_LastAudited_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_LastAudited_Field) _Column() string { return "last_audited" }
+
+type Object struct {
+	Id         int64
+	ProjectId  []byte
+	BucketName []byte
+	ObjectKey  []byte
+	CreatedAt  time.Time
+}
+
+func (Object) _Table() string { return "objects" }
+
+type Object_Update_Fields struct {
+}
+
+type Object_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func Object_Id(v int64) Object_Id_Field {
+	return Object_Id_Field{_set: true, _value: v}
+}
+
+func (f Object_Id_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Object_Id_Field) _Column() string { return "id" }
+
+type Object_ProjectId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func Object_ProjectId(v []byte) Object_ProjectId_Field {
+	return Object_ProjectId_Field{_set: true, _value: v}
+}
+
+func (f Object_ProjectId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Object_ProjectId_Field) _Column() string { return "project_id" }
+
+type Object_BucketName_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func Object_BucketName(v []byte) Object_BucketName_Field {
+	return Object_BucketName_Field{_set: true, _value: v}
+}
+
+func (f Object_BucketName_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Object_BucketName_Field) _Column() string { return "bucket_name" }
+
+type Object_ObjectKey_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func Object_ObjectKey(v []byte) Object_ObjectKey_Field {
+	return Object_ObjectKey_Field{_set: true, _value: v}
+}
+
+func (f Object_ObjectKey_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Object_ObjectKey_Field) _Column() string { return "object_key" }
+
+type Object_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func Object_CreatedAt(v time.Time) Object_CreatedAt_Field {
+	return Object_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f Object_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Object_CreatedAt_Field) _Column() string { return "created_at" }
+
+type Offer struct {
+	Id                        int
+	Name                      string
+	Description               string
+	Type                      int
+	CreditInCents             int
+	AwardCreditDurationDays   int
+	InviteeCreditDurationDays int
+	RedeemableCap             int
+	NumRedeemed               int
+	ExpiresAt                 *time.Time
+	CreatedAt                 time.Time
+	Status                    int
+}
+
+func (Offer) _Table() string { return "offers" }
+
+type Offer_Update_Fields struct {
+	Name                      Offer_Name_Field
+	Description               Offer_Description_Field
+	Type                      Offer_Type_Field
+	CreditInCents             Offer_CreditInCents_Field
+	AwardCreditDurationDays   Offer_AwardCreditDurationDays_Field
+	InviteeCreditDurationDays Offer_InviteeCreditDurationDays_Field
+	RedeemableCap             Offer_RedeemableCap_Field
+	NumRedeemed               Offer_NumRedeemed_Field
+	ExpiresAt                 Offer_ExpiresAt_Field
+	Status                    Offer_Status_Field
+}
+
+type Offer_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func Offer_Id(v int) Offer_Id_Field {
+	return Offer_Id_Field{_set: true, _value: v}
+}
+
+func (f Offer_Id_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Offer_Id_Field) _Column() string { return "id" }
+
+type Offer_Name_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func Offer_Name(v string) Offer_Name_Field {
+	return Offer_Name_Field{_set: true, _value: v}
+}
+
+func (f Offer_Name_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Offer_Name_Field) _Column() string { return "name" }
+
+type Offer_Description_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func Offer_Description(v string) Offer_Description_Field {
+	return Offer_Description_Field{_set: true, _value: v}
+}
+
+func (f Offer_Description_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Offer_Description_Field) _Column() string { return "description" }
+
+type Offer_Type_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func Offer_Type(v int) Offer_Type_Field {
+	return Offer_Type_Field{_set: true, _value: v}
+}
+
+func (f Offer_Type_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
@@ -2779,1266 +3574,2653 @@ func (f Offer_Type_Field) value() interface{} {
 
 func (Offer_Type_Field) _Column() string { return "type" }
 
-type Offer_CreditInCents_Field struct {
+type Offer_CreditInCents_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func Offer_CreditInCents(v int) Offer_CreditInCents_Field {
+	return Offer_CreditInCents_Field{_set: true, _value: v}
+}
+
+func (f Offer_CreditInCents_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Offer_CreditInCents_Field) _Column() string { return "credit_in_cents" }
+
+type Offer_AwardCreditDurationDays_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func Offer_AwardCreditDurationDays(v int) Offer_AwardCreditDurationDays_Field {
+	return Offer_AwardCreditDurationDays_Field{_set: true, _value: v}
+}
+
+func (f Offer_AwardCreditDurationDays_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Offer_AwardCreditDurationDays_Field) _Column() string { return "award_credit_duration_days" }
+
+type Offer_InviteeCreditDurationDays_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func Offer_InviteeCreditDurationDays(v int) Offer_InviteeCreditDurationDays_Field {
+	return Offer_InviteeCreditDurationDays_Field{_set: true, _value: v}
+}
+
+func (f Offer_InviteeCreditDurationDays_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Offer_InviteeCreditDurationDays_Field) _Column() string { return "invitee_credit_duration_days" }
+
+type Offer_RedeemableCap_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func Offer_RedeemableCap(v int) Offer_RedeemableCap_Field {
+	return Offer_RedeemableCap_Field{_set: true, _value: v}
+}
+
+func (f Offer_RedeemableCap_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Offer_RedeemableCap_Field) _Column() string { return "redeemable_cap" }
+
+type Offer_NumRedeemed_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func Offer_NumRedeemed(v int) Offer_NumRedeemed_Field {
+	return Offer_NumRedeemed_Field{_set: true, _value: v}
+}
+
+func (f Offer_NumRedeemed_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Offer_NumRedeemed_Field) _Column() string { return "num_redeemed" }
+
+type Offer_ExpiresAt_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func Offer_ExpiresAt(v time.Time) Offer_ExpiresAt_Field {
+	return Offer_ExpiresAt_Field{_set: true, _value: &v}
+}
+
+func Offer_ExpiresAt_Raw(v *time.Time) Offer_ExpiresAt_Field {
+	if v == nil {
+		return Offer_ExpiresAt_Null()
+	}
+	return Offer_ExpiresAt(*v)
+}
+
+func Offer_ExpiresAt_Null() Offer_ExpiresAt_Field {
+	return Offer_ExpiresAt_Field{_set: true, _null: true}
+}
+
+func (f Offer_ExpiresAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Offer_ExpiresAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Offer_ExpiresAt_Field) _Column() string { return "expires_at" }
+
+type Offer_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func Offer_CreatedAt(v time.Time) Offer_CreatedAt_Field {
+	return Offer_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f Offer_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Offer_CreatedAt_Field) _Column() string { return "created_at" }
+
+type Offer_Status_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func Offer_Status(v int) Offer_Status_Field {
+	return Offer_Status_Field{_set: true, _value: v}
+}
+
+func (f Offer_Status_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Offer_Status_Field) _Column() string { return "status" }
+
+type PayoutStatement struct {
+	NodeId         []byte
+	PeriodStart    time.Time
+	PeriodEnd      time.Time
+	NodeCreatedAt  time.Time
+	Wallet         string
+	AtRestTotal    float64
+	GetTotal       int64
+	GetRepairTotal int64
+	GetAuditTotal  int64
+	PutTotal       int64
+	PutRepairTotal int64
+	AtRestAmount   int64
+	GetAmount      int64
+	RepairAmount   int64
+	AuditAmount    int64
+	Earned         int64
+	HeldPercent    int
+	Held           int64
+	Disqualified   bool
+	Paid           int64
+	CreatedAt      time.Time
+}
+
+func (PayoutStatement) _Table() string { return "payout_statements" }
+
+type PayoutStatement_Update_Fields struct {
+}
+
+type PayoutStatement_NodeId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func PayoutStatement_NodeId(v []byte) PayoutStatement_NodeId_Field {
+	return PayoutStatement_NodeId_Field{_set: true, _value: v}
+}
+
+func (f PayoutStatement_NodeId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PayoutStatement_NodeId_Field) _Column() string { return "node_id" }
+
+type PayoutStatement_PeriodStart_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func PayoutStatement_PeriodStart(v time.Time) PayoutStatement_PeriodStart_Field {
+	return PayoutStatement_PeriodStart_Field{_set: true, _value: v}
+}
+
+func (f PayoutStatement_PeriodStart_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PayoutStatement_PeriodStart_Field) _Column() string { return "period_start" }
+
+type PayoutStatement_PeriodEnd_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func PayoutStatement_PeriodEnd(v time.Time) PayoutStatement_PeriodEnd_Field {
+	return PayoutStatement_PeriodEnd_Field{_set: true, _value: v}
+}
+
+func (f PayoutStatement_PeriodEnd_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PayoutStatement_PeriodEnd_Field) _Column() string { return "period_end" }
+
+type PayoutStatement_NodeCreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func PayoutStatement_NodeCreatedAt(v time.Time) PayoutStatement_NodeCreatedAt_Field {
+	return PayoutStatement_NodeCreatedAt_Field{_set: true, _value: v}
+}
+
+func (f PayoutStatement_NodeCreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PayoutStatement_NodeCreatedAt_Field) _Column() string { return "node_created_at" }
+
+type PayoutStatement_Wallet_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func PayoutStatement_Wallet(v string) PayoutStatement_Wallet_Field {
+	return PayoutStatement_Wallet_Field{_set: true, _value: v}
+}
+
+func (f PayoutStatement_Wallet_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PayoutStatement_Wallet_Field) _Column() string { return "wallet" }
+
+type PayoutStatement_AtRestTotal_Field struct {
+	_set   bool
+	_null  bool
+	_value float64
+}
+
+func PayoutStatement_AtRestTotal(v float64) PayoutStatement_AtRestTotal_Field {
+	return PayoutStatement_AtRestTotal_Field{_set: true, _value: v}
+}
+
+func (f PayoutStatement_AtRestTotal_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PayoutStatement_AtRestTotal_Field) _Column() string { return "at_rest_total" }
+
+type PayoutStatement_GetTotal_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func PayoutStatement_GetTotal(v int64) PayoutStatement_GetTotal_Field {
+	return PayoutStatement_GetTotal_Field{_set: true, _value: v}
+}
+
+func (f PayoutStatement_GetTotal_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PayoutStatement_GetTotal_Field) _Column() string { return "get_total" }
+
+type PayoutStatement_GetRepairTotal_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func PayoutStatement_GetRepairTotal(v int64) PayoutStatement_GetRepairTotal_Field {
+	return PayoutStatement_GetRepairTotal_Field{_set: true, _value: v}
+}
+
+func (f PayoutStatement_GetRepairTotal_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PayoutStatement_GetRepairTotal_Field) _Column() string { return "get_repair_total" }
+
+type PayoutStatement_GetAuditTotal_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func PayoutStatement_GetAuditTotal(v int64) PayoutStatement_GetAuditTotal_Field {
+	return PayoutStatement_GetAuditTotal_Field{_set: true, _value: v}
+}
+
+func (f PayoutStatement_GetAuditTotal_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PayoutStatement_GetAuditTotal_Field) _Column() string { return "get_audit_total" }
+
+type PayoutStatement_PutTotal_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func PayoutStatement_PutTotal(v int64) PayoutStatement_PutTotal_Field {
+	return PayoutStatement_PutTotal_Field{_set: true, _value: v}
+}
+
+func (f PayoutStatement_PutTotal_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PayoutStatement_PutTotal_Field) _Column() string { return "put_total" }
+
+type PayoutStatement_PutRepairTotal_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func PayoutStatement_PutRepairTotal(v int64) PayoutStatement_PutRepairTotal_Field {
+	return PayoutStatement_PutRepairTotal_Field{_set: true, _value: v}
+}
+
+func (f PayoutStatement_PutRepairTotal_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PayoutStatement_PutRepairTotal_Field) _Column() string { return "put_repair_total" }
+
+type PayoutStatement_AtRestAmount_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func PayoutStatement_AtRestAmount(v int64) PayoutStatement_AtRestAmount_Field {
+	return PayoutStatement_AtRestAmount_Field{_set: true, _value: v}
+}
+
+func (f PayoutStatement_AtRestAmount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PayoutStatement_AtRestAmount_Field) _Column() string { return "at_rest_amount" }
+
+type PayoutStatement_GetAmount_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func PayoutStatement_GetAmount(v int64) PayoutStatement_GetAmount_Field {
+	return PayoutStatement_GetAmount_Field{_set: true, _value: v}
+}
+
+func (f PayoutStatement_GetAmount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PayoutStatement_GetAmount_Field) _Column() string { return "get_amount" }
+
+type PayoutStatement_RepairAmount_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func PayoutStatement_RepairAmount(v int64) PayoutStatement_RepairAmount_Field {
+	return PayoutStatement_RepairAmount_Field{_set: true, _value: v}
+}
+
+func (f PayoutStatement_RepairAmount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PayoutStatement_RepairAmount_Field) _Column() string { return "repair_amount" }
+
+type PayoutStatement_AuditAmount_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func PayoutStatement_AuditAmount(v int64) PayoutStatement_AuditAmount_Field {
+	return PayoutStatement_AuditAmount_Field{_set: true, _value: v}
+}
+
+func (f PayoutStatement_AuditAmount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PayoutStatement_AuditAmount_Field) _Column() string { return "audit_amount" }
+
+type PayoutStatement_Earned_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func PayoutStatement_Earned(v int64) PayoutStatement_Earned_Field {
+	return PayoutStatement_Earned_Field{_set: true, _value: v}
+}
+
+func (f PayoutStatement_Earned_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PayoutStatement_Earned_Field) _Column() string { return "earned" }
+
+type PayoutStatement_HeldPercent_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func PayoutStatement_HeldPercent(v int) PayoutStatement_HeldPercent_Field {
+	return PayoutStatement_HeldPercent_Field{_set: true, _value: v}
+}
+
+func (f PayoutStatement_HeldPercent_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PayoutStatement_HeldPercent_Field) _Column() string { return "held_percent" }
+
+type PayoutStatement_Held_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func PayoutStatement_Held(v int64) PayoutStatement_Held_Field {
+	return PayoutStatement_Held_Field{_set: true, _value: v}
+}
+
+func (f PayoutStatement_Held_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PayoutStatement_Held_Field) _Column() string { return "held" }
+
+type PayoutStatement_Disqualified_Field struct {
+	_set   bool
+	_null  bool
+	_value bool
+}
+
+func PayoutStatement_Disqualified(v bool) PayoutStatement_Disqualified_Field {
+	return PayoutStatement_Disqualified_Field{_set: true, _value: v}
+}
+
+func (f PayoutStatement_Disqualified_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PayoutStatement_Disqualified_Field) _Column() string { return "disqualified" }
+
+type PayoutStatement_Paid_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func PayoutStatement_Paid(v int64) PayoutStatement_Paid_Field {
+	return PayoutStatement_Paid_Field{_set: true, _value: v}
+}
+
+func (f PayoutStatement_Paid_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PayoutStatement_Paid_Field) _Column() string { return "paid" }
+
+type PayoutStatement_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func PayoutStatement_CreatedAt(v time.Time) PayoutStatement_CreatedAt_Field {
+	return PayoutStatement_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f PayoutStatement_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PayoutStatement_CreatedAt_Field) _Column() string { return "created_at" }
+
+type PendingAudits struct {
+	NodeId            []byte
+	PieceId           []byte
+	StripeIndex       int64
+	ShareSize         int64
+	ExpectedShareHash []byte
+	ReverifyCount     int64
+	PieceNum          int64
+	Path              []byte
+}
+
+func (PendingAudits) _Table() string { return "pending_audits" }
+
+type PendingAudits_Update_Fields struct {
+	ReverifyCount PendingAudits_ReverifyCount_Field
+}
+
+type PendingAudits_NodeId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func PendingAudits_NodeId(v []byte) PendingAudits_NodeId_Field {
+	return PendingAudits_NodeId_Field{_set: true, _value: v}
+}
+
+func (f PendingAudits_NodeId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PendingAudits_NodeId_Field) _Column() string { return "node_id" }
+
+type PendingAudits_PieceId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func PendingAudits_PieceId(v []byte) PendingAudits_PieceId_Field {
+	return PendingAudits_PieceId_Field{_set: true, _value: v}
+}
+
+func (f PendingAudits_PieceId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PendingAudits_PieceId_Field) _Column() string { return "piece_id" }
+
+type PendingAudits_StripeIndex_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func PendingAudits_StripeIndex(v int64) PendingAudits_StripeIndex_Field {
+	return PendingAudits_StripeIndex_Field{_set: true, _value: v}
+}
+
+func (f PendingAudits_StripeIndex_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PendingAudits_StripeIndex_Field) _Column() string { return "stripe_index" }
+
+type PendingAudits_ShareSize_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func PendingAudits_ShareSize(v int64) PendingAudits_ShareSize_Field {
+	return PendingAudits_ShareSize_Field{_set: true, _value: v}
+}
+
+func (f PendingAudits_ShareSize_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PendingAudits_ShareSize_Field) _Column() string { return "share_size" }
+
+type PendingAudits_ExpectedShareHash_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func PendingAudits_ExpectedShareHash(v []byte) PendingAudits_ExpectedShareHash_Field {
+	return PendingAudits_ExpectedShareHash_Field{_set: true, _value: v}
+}
+
+func (f PendingAudits_ExpectedShareHash_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PendingAudits_ExpectedShareHash_Field) _Column() string { return "expected_share_hash" }
+
+type PendingAudits_ReverifyCount_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func PendingAudits_ReverifyCount(v int64) PendingAudits_ReverifyCount_Field {
+	return PendingAudits_ReverifyCount_Field{_set: true, _value: v}
+}
+
+func (f PendingAudits_ReverifyCount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PendingAudits_ReverifyCount_Field) _Column() string { return "reverify_count" }
+
+type PendingAudits_PieceNum_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func PendingAudits_PieceNum(v int64) PendingAudits_PieceNum_Field {
+	return PendingAudits_PieceNum_Field{_set: true, _value: v}
+}
+
+func (f PendingAudits_PieceNum_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PendingAudits_PieceNum_Field) _Column() string { return "piece_num" }
+
+type PendingAudits_Path_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func PendingAudits_Path(v []byte) PendingAudits_Path_Field {
+	return PendingAudits_Path_Field{_set: true, _value: v}
+}
+
+func (f PendingAudits_Path_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PendingAudits_Path_Field) _Column() string { return "path" }
+
+type Project struct {
+	Id           []byte
+	Name         string
+	Description  string
+	StorageLimit int64
+	EgressLimit  int64
+	CreatedAt    time.Time
+}
+
+func (Project) _Table() string { return "projects" }
+
+type Project_Update_Fields struct {
+	Description  Project_Description_Field
+	StorageLimit Project_StorageLimit_Field
+	EgressLimit  Project_EgressLimit_Field
+}
+
+type Project_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func Project_Id(v []byte) Project_Id_Field {
+	return Project_Id_Field{_set: true, _value: v}
+}
+
+func (f Project_Id_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Project_Id_Field) _Column() string { return "id" }
+
+type Project_Name_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func Project_Name(v string) Project_Name_Field {
+	return Project_Name_Field{_set: true, _value: v}
+}
+
+func (f Project_Name_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Project_Name_Field) _Column() string { return "name" }
+
+type Project_Description_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func Project_Description(v string) Project_Description_Field {
+	return Project_Description_Field{_set: true, _value: v}
+}
+
+func (f Project_Description_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Project_Description_Field) _Column() string { return "description" }
+
+type Project_StorageLimit_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func Project_StorageLimit(v int64) Project_StorageLimit_Field {
+	return Project_StorageLimit_Field{_set: true, _value: v}
+}
+
+func (f Project_StorageLimit_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Project_StorageLimit_Field) _Column() string { return "storage_limit" }
+
+type Project_EgressLimit_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func Project_EgressLimit(v int64) Project_EgressLimit_Field {
+	return Project_EgressLimit_Field{_set: true, _value: v}
+}
+
+func (f Project_EgressLimit_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Project_EgressLimit_Field) _Column() string { return "egress_limit" }
+
+type Project_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func Project_CreatedAt(v time.Time) Project_CreatedAt_Field {
+	return Project_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f Project_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Project_CreatedAt_Field) _Column() string { return "created_at" }
+
+type RegistrationToken struct {
+	Secret       []byte
+	OwnerId      []byte
+	ProjectLimit int
+	CreatedAt    time.Time
+}
+
+func (RegistrationToken) _Table() string { return "registration_tokens" }
+
+type RegistrationToken_Create_Fields struct {
+	OwnerId RegistrationToken_OwnerId_Field
+}
+
+type RegistrationToken_Update_Fields struct {
+	OwnerId RegistrationToken_OwnerId_Field
+}
+
+type RegistrationToken_Secret_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func RegistrationToken_Secret(v []byte) RegistrationToken_Secret_Field {
+	return RegistrationToken_Secret_Field{_set: true, _value: v}
+}
+
+func (f RegistrationToken_Secret_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (RegistrationToken_Secret_Field) _Column() string { return "secret" }
+
+type RegistrationToken_OwnerId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func RegistrationToken_OwnerId(v []byte) RegistrationToken_OwnerId_Field {
+	return RegistrationToken_OwnerId_Field{_set: true, _value: v}
+}
+
+func RegistrationToken_OwnerId_Raw(v []byte) RegistrationToken_OwnerId_Field {
+	if v == nil {
+		return RegistrationToken_OwnerId_Null()
+	}
+	return RegistrationToken_OwnerId(v)
+}
+
+func RegistrationToken_OwnerId_Null() RegistrationToken_OwnerId_Field {
+	return RegistrationToken_OwnerId_Field{_set: true, _null: true}
+}
+
+func (f RegistrationToken_OwnerId_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f RegistrationToken_OwnerId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (RegistrationToken_OwnerId_Field) _Column() string { return "owner_id" }
+
+type RegistrationToken_ProjectLimit_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func RegistrationToken_ProjectLimit(v int) RegistrationToken_ProjectLimit_Field {
+	return RegistrationToken_ProjectLimit_Field{_set: true, _value: v}
+}
+
+func (f RegistrationToken_ProjectLimit_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (RegistrationToken_ProjectLimit_Field) _Column() string { return "project_limit" }
+
+type RegistrationToken_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func RegistrationToken_CreatedAt(v time.Time) RegistrationToken_CreatedAt_Field {
+	return RegistrationToken_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f RegistrationToken_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (RegistrationToken_CreatedAt_Field) _Column() string { return "created_at" }
+
+type ResetPasswordToken struct {
+	Secret    []byte
+	OwnerId   []byte
+	CreatedAt time.Time
+}
+
+func (ResetPasswordToken) _Table() string { return "reset_password_tokens" }
+
+type ResetPasswordToken_Update_Fields struct {
+	OwnerId ResetPasswordToken_OwnerId_Field
+}
+
+type ResetPasswordToken_Secret_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func ResetPasswordToken_Secret(v []byte) ResetPasswordToken_Secret_Field {
+	return ResetPasswordToken_Secret_Field{_set: true, _value: v}
+}
+
+func (f ResetPasswordToken_Secret_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ResetPasswordToken_Secret_Field) _Column() string { return "secret" }
+
+type ResetPasswordToken_OwnerId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func ResetPasswordToken_OwnerId(v []byte) ResetPasswordToken_OwnerId_Field {
+	return ResetPasswordToken_OwnerId_Field{_set: true, _value: v}
+}
+
+func (f ResetPasswordToken_OwnerId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ResetPasswordToken_OwnerId_Field) _Column() string { return "owner_id" }
+
+type ResetPasswordToken_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func ResetPasswordToken_CreatedAt(v time.Time) ResetPasswordToken_CreatedAt_Field {
+	return ResetPasswordToken_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f ResetPasswordToken_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ResetPasswordToken_CreatedAt_Field) _Column() string { return "created_at" }
+
+type SerialNumber struct {
+	Id           int
+	SerialNumber []byte
+	BucketId     []byte
+	ExpiresAt    time.Time
+}
+
+func (SerialNumber) _Table() string { return "serial_numbers" }
+
+type SerialNumber_Update_Fields struct {
+}
+
+type SerialNumber_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func SerialNumber_Id(v int) SerialNumber_Id_Field {
+	return SerialNumber_Id_Field{_set: true, _value: v}
+}
+
+func (f SerialNumber_Id_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (SerialNumber_Id_Field) _Column() string { return "id" }
+
+type SerialNumber_SerialNumber_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func SerialNumber_SerialNumber(v []byte) SerialNumber_SerialNumber_Field {
+	return SerialNumber_SerialNumber_Field{_set: true, _value: v}
+}
+
+func (f SerialNumber_SerialNumber_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (SerialNumber_SerialNumber_Field) _Column() string { return "serial_number" }
+
+type SerialNumber_BucketId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func SerialNumber_BucketId(v []byte) SerialNumber_BucketId_Field {
+	return SerialNumber_BucketId_Field{_set: true, _value: v}
+}
+
+func (f SerialNumber_BucketId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (SerialNumber_BucketId_Field) _Column() string { return "bucket_id" }
+
+type SerialNumber_ExpiresAt_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value time.Time
 }
 
-func Offer_CreditInCents(v int) Offer_CreditInCents_Field {
-	return Offer_CreditInCents_Field{_set: true, _value: v}
+func SerialNumber_ExpiresAt(v time.Time) SerialNumber_ExpiresAt_Field {
+	v = toUTC(v)
+	return SerialNumber_ExpiresAt_Field{_set: true, _value: v}
 }
 
-func (f Offer_CreditInCents_Field) value() interface{} {
+func (f SerialNumber_ExpiresAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Offer_CreditInCents_Field) _Column() string { return "credit_in_cents" }
+func (SerialNumber_ExpiresAt_Field) _Column() string { return "expires_at" }
 
-type Offer_AwardCreditDurationDays_Field struct {
+type StoragenodeBandwidthRollup struct {
+	StoragenodeId   []byte
+	IntervalStart   time.Time
+	IntervalSeconds uint
+	Action          uint
+	Allocated       uint64
+	Settled         uint64
+}
+
+func (StoragenodeBandwidthRollup) _Table() string { return "storagenode_bandwidth_rollups" }
+
+type StoragenodeBandwidthRollup_Update_Fields struct {
+	Allocated StoragenodeBandwidthRollup_Allocated_Field
+	Settled   StoragenodeBandwidthRollup_Settled_Field
+}
+
+type StoragenodeBandwidthRollup_StoragenodeId_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value []byte
 }
 
-func Offer_AwardCreditDurationDays(v int) Offer_AwardCreditDurationDays_Field {
-	return Offer_AwardCreditDurationDays_Field{_set: true, _value: v}
+func StoragenodeBandwidthRollup_StoragenodeId(v []byte) StoragenodeBandwidthRollup_StoragenodeId_Field {
+	return StoragenodeBandwidthRollup_StoragenodeId_Field{_set: true, _value: v}
 }
 
-func (f Offer_AwardCreditDurationDays_Field) value() interface{} {
+func (f StoragenodeBandwidthRollup_StoragenodeId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Offer_AwardCreditDurationDays_Field) _Column() string { return "award_credit_duration_days" }
+func (StoragenodeBandwidthRollup_StoragenodeId_Field) _Column() string { return "storagenode_id" }
 
-type Offer_InviteeCreditDurationDays_Field struct {
+type StoragenodeBandwidthRollup_IntervalStart_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value time.Time
 }
 
-func Offer_InviteeCreditDurationDays(v int) Offer_InviteeCreditDurationDays_Field {
-	return Offer_InviteeCreditDurationDays_Field{_set: true, _value: v}
+func StoragenodeBandwidthRollup_IntervalStart(v time.Time) StoragenodeBandwidthRollup_IntervalStart_Field {
+	v = toUTC(v)
+	return StoragenodeBandwidthRollup_IntervalStart_Field{_set: true, _value: v}
 }
 
-func (f Offer_InviteeCreditDurationDays_Field) value() interface{} {
+func (f StoragenodeBandwidthRollup_IntervalStart_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Offer_InviteeCreditDurationDays_Field) _Column() string { return "invitee_credit_duration_days" }
+func (StoragenodeBandwidthRollup_IntervalStart_Field) _Column() string { return "interval_start" }
 
-type Offer_RedeemableCap_Field struct {
+type StoragenodeBandwidthRollup_IntervalSeconds_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value uint
 }
 
-func Offer_RedeemableCap(v int) Offer_RedeemableCap_Field {
-	return Offer_RedeemableCap_Field{_set: true, _value: v}
+func StoragenodeBandwidthRollup_IntervalSeconds(v uint) StoragenodeBandwidthRollup_IntervalSeconds_Field {
+	return StoragenodeBandwidthRollup_IntervalSeconds_Field{_set: true, _value: v}
 }
 
-func (f Offer_RedeemableCap_Field) value() interface{} {
+func (f StoragenodeBandwidthRollup_IntervalSeconds_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Offer_RedeemableCap_Field) _Column() string { return "redeemable_cap" }
+func (StoragenodeBandwidthRollup_IntervalSeconds_Field) _Column() string { return "interval_seconds" }
 
-type Offer_NumRedeemed_Field struct {
+type StoragenodeBandwidthRollup_Action_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value uint
 }
 
-func Offer_NumRedeemed(v int) Offer_NumRedeemed_Field {
-	return Offer_NumRedeemed_Field{_set: true, _value: v}
+func StoragenodeBandwidthRollup_Action(v uint) StoragenodeBandwidthRollup_Action_Field {
+	return StoragenodeBandwidthRollup_Action_Field{_set: true, _value: v}
+}
+
+func (f StoragenodeBandwidthRollup_Action_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StoragenodeBandwidthRollup_Action_Field) _Column() string { return "action" }
+
+type StoragenodeBandwidthRollup_Allocated_Field struct {
+	_set   bool
+	_null  bool
+	_value uint64
+}
+
+func StoragenodeBandwidthRollup_Allocated(v uint64) StoragenodeBandwidthRollup_Allocated_Field {
+	return StoragenodeBandwidthRollup_Allocated_Field{_set: true, _value: v}
+}
+
+func (f StoragenodeBandwidthRollup_Allocated_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StoragenodeBandwidthRollup_Allocated_Field) _Column() string { return "allocated" }
+
+type StoragenodeBandwidthRollup_Settled_Field struct {
+	_set   bool
+	_null  bool
+	_value uint64
+}
+
+func StoragenodeBandwidthRollup_Settled(v uint64) StoragenodeBandwidthRollup_Settled_Field {
+	return StoragenodeBandwidthRollup_Settled_Field{_set: true, _value: v}
+}
+
+func (f StoragenodeBandwidthRollup_Settled_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StoragenodeBandwidthRollup_Settled_Field) _Column() string { return "settled" }
+
+type StoragenodeStorageTally struct {
+	Id              int64
+	NodeId          []byte
+	IntervalEndTime time.Time
+	DataTotal       float64
+}
+
+func (StoragenodeStorageTally) _Table() string { return "storagenode_storage_tallies" }
+
+type StoragenodeStorageTally_Update_Fields struct {
+}
+
+type StoragenodeStorageTally_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func StoragenodeStorageTally_Id(v int64) StoragenodeStorageTally_Id_Field {
+	return StoragenodeStorageTally_Id_Field{_set: true, _value: v}
+}
+
+func (f StoragenodeStorageTally_Id_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StoragenodeStorageTally_Id_Field) _Column() string { return "id" }
+
+type StoragenodeStorageTally_NodeId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func StoragenodeStorageTally_NodeId(v []byte) StoragenodeStorageTally_NodeId_Field {
+	return StoragenodeStorageTally_NodeId_Field{_set: true, _value: v}
+}
+
+func (f StoragenodeStorageTally_NodeId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StoragenodeStorageTally_NodeId_Field) _Column() string { return "node_id" }
+
+type StoragenodeStorageTally_IntervalEndTime_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func StoragenodeStorageTally_IntervalEndTime(v time.Time) StoragenodeStorageTally_IntervalEndTime_Field {
+	return StoragenodeStorageTally_IntervalEndTime_Field{_set: true, _value: v}
+}
+
+func (f StoragenodeStorageTally_IntervalEndTime_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StoragenodeStorageTally_IntervalEndTime_Field) _Column() string { return "interval_end_time" }
+
+type StoragenodeStorageTally_DataTotal_Field struct {
+	_set   bool
+	_null  bool
+	_value float64
+}
+
+func StoragenodeStorageTally_DataTotal(v float64) StoragenodeStorageTally_DataTotal_Field {
+	return StoragenodeStorageTally_DataTotal_Field{_set: true, _value: v}
+}
+
+func (f StoragenodeStorageTally_DataTotal_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StoragenodeStorageTally_DataTotal_Field) _Column() string { return "data_total" }
+
+type User struct {
+	Id               []byte
+	FullName         string
+	ShortName        *string
+	Email            string
+	PasswordHash     []byte
+	Status           int
+	MfaEnabled       bool
+	MfaSecretKey     *string
+	MfaRecoveryCodes *string
+	CreatedAt        time.Time
+}
+
+func (User) _Table() string { return "users" }
+
+type User_Create_Fields struct {
+	ShortName        User_ShortName_Field
+	MfaSecretKey     User_MfaSecretKey_Field
+	MfaRecoveryCodes User_MfaRecoveryCodes_Field
+}
+
+type User_Update_Fields struct {
+	FullName         User_FullName_Field
+	ShortName        User_ShortName_Field
+	Email            User_Email_Field
+	PasswordHash     User_PasswordHash_Field
+	Status           User_Status_Field
+	MfaEnabled       User_MfaEnabled_Field
+	MfaSecretKey     User_MfaSecretKey_Field
+	MfaRecoveryCodes User_MfaRecoveryCodes_Field
+}
+
+type User_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func User_Id(v []byte) User_Id_Field {
+	return User_Id_Field{_set: true, _value: v}
+}
+
+func (f User_Id_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (User_Id_Field) _Column() string { return "id" }
+
+type User_FullName_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func User_FullName(v string) User_FullName_Field {
+	return User_FullName_Field{_set: true, _value: v}
 }
 
-func (f Offer_NumRedeemed_Field) value() interface{} {
+func (f User_FullName_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Offer_NumRedeemed_Field) _Column() string { return "num_redeemed" }
+func (User_FullName_Field) _Column() string { return "full_name" }
 
-type Offer_ExpiresAt_Field struct {
+type User_ShortName_Field struct {
 	_set   bool
 	_null  bool
-	_value *time.Time
+	_value *string
 }
 
-func Offer_ExpiresAt(v time.Time) Offer_ExpiresAt_Field {
-	return Offer_ExpiresAt_Field{_set: true, _value: &v}
+func User_ShortName(v string) User_ShortName_Field {
+	return User_ShortName_Field{_set: true, _value: &v}
 }
 
-func Offer_ExpiresAt_Raw(v *time.Time) Offer_ExpiresAt_Field {
+func User_ShortName_Raw(v *string) User_ShortName_Field {
 	if v == nil {
-		return Offer_ExpiresAt_Null()
+		return User_ShortName_Null()
 	}
-	return Offer_ExpiresAt(*v)
+	return User_ShortName(*v)
 }
 
-func Offer_ExpiresAt_Null() Offer_ExpiresAt_Field {
-	return Offer_ExpiresAt_Field{_set: true, _null: true}
+func User_ShortName_Null() User_ShortName_Field {
+	return User_ShortName_Field{_set: true, _null: true}
 }
 
-func (f Offer_ExpiresAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (f User_ShortName_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f Offer_ExpiresAt_Field) value() interface{} {
+func (f User_ShortName_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Offer_ExpiresAt_Field) _Column() string { return "expires_at" }
+func (User_ShortName_Field) _Column() string { return "short_name" }
 
-type Offer_CreatedAt_Field struct {
+type User_Email_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value string
 }
 
-func Offer_CreatedAt(v time.Time) Offer_CreatedAt_Field {
-	return Offer_CreatedAt_Field{_set: true, _value: v}
+func User_Email(v string) User_Email_Field {
+	return User_Email_Field{_set: true, _value: v}
 }
 
-func (f Offer_CreatedAt_Field) value() interface{} {
+func (f User_Email_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Offer_CreatedAt_Field) _Column() string { return "created_at" }
+func (User_Email_Field) _Column() string { return "email" }
 
-type Offer_Status_Field struct {
+type User_PasswordHash_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value []byte
 }
 
-func Offer_Status(v int) Offer_Status_Field {
-	return Offer_Status_Field{_set: true, _value: v}
+func User_PasswordHash(v []byte) User_PasswordHash_Field {
+	return User_PasswordHash_Field{_set: true, _value: v}
 }
 
-func (f Offer_Status_Field) value() interface{} {
+func (f User_PasswordHash_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Offer_Status_Field) _Column() string { return "status" }
-
-type PendingAudits struct {
-	NodeId            []byte
-	PieceId           []byte
-	StripeIndex       int64
-	ShareSize         int64
-	ExpectedShareHash []byte
-	ReverifyCount     int64
-}
-
-func (PendingAudits) _Table() string { return "pending_audits" }
-
-type PendingAudits_Update_Fields struct {
-	ReverifyCount PendingAudits_ReverifyCount_Field
-}
+func (User_PasswordHash_Field) _Column() string { return "password_hash" }
 
-type PendingAudits_NodeId_Field struct {
+type User_Status_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value int
 }
 
-func PendingAudits_NodeId(v []byte) PendingAudits_NodeId_Field {
-	return PendingAudits_NodeId_Field{_set: true, _value: v}
+func User_Status(v int) User_Status_Field {
+	return User_Status_Field{_set: true, _value: v}
 }
 
-func (f PendingAudits_NodeId_Field) value() interface{} {
+func (f User_Status_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (PendingAudits_NodeId_Field) _Column() string { return "node_id" }
+func (User_Status_Field) _Column() string { return "status" }
 
-type PendingAudits_PieceId_Field struct {
+type User_MfaEnabled_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value bool
 }
 
-func PendingAudits_PieceId(v []byte) PendingAudits_PieceId_Field {
-	return PendingAudits_PieceId_Field{_set: true, _value: v}
+func User_MfaEnabled(v bool) User_MfaEnabled_Field {
+	return User_MfaEnabled_Field{_set: true, _value: v}
 }
 
-func (f PendingAudits_PieceId_Field) value() interface{} {
+func (f User_MfaEnabled_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (PendingAudits_PieceId_Field) _Column() string { return "piece_id" }
+func (User_MfaEnabled_Field) _Column() string { return "mfa_enabled" }
 
-type PendingAudits_StripeIndex_Field struct {
+type User_MfaSecretKey_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value *string
 }
 
-func PendingAudits_StripeIndex(v int64) PendingAudits_StripeIndex_Field {
-	return PendingAudits_StripeIndex_Field{_set: true, _value: v}
+func User_MfaSecretKey(v string) User_MfaSecretKey_Field {
+	return User_MfaSecretKey_Field{_set: true, _value: &v}
 }
 
-func (f PendingAudits_StripeIndex_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
+func User_MfaSecretKey_Raw(v *string) User_MfaSecretKey_Field {
+	if v == nil {
+		return User_MfaSecretKey_Null()
 	}
-	return f._value
+	return User_MfaSecretKey(*v)
 }
 
-func (PendingAudits_StripeIndex_Field) _Column() string { return "stripe_index" }
-
-type PendingAudits_ShareSize_Field struct {
-	_set   bool
-	_null  bool
-	_value int64
+func User_MfaSecretKey_Null() User_MfaSecretKey_Field {
+	return User_MfaSecretKey_Field{_set: true, _null: true}
 }
 
-func PendingAudits_ShareSize(v int64) PendingAudits_ShareSize_Field {
-	return PendingAudits_ShareSize_Field{_set: true, _value: v}
-}
+func (f User_MfaSecretKey_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f PendingAudits_ShareSize_Field) value() interface{} {
+func (f User_MfaSecretKey_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (PendingAudits_ShareSize_Field) _Column() string { return "share_size" }
+func (User_MfaSecretKey_Field) _Column() string { return "mfa_secret_key" }
 
-type PendingAudits_ExpectedShareHash_Field struct {
+type User_MfaRecoveryCodes_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value *string
 }
 
-func PendingAudits_ExpectedShareHash(v []byte) PendingAudits_ExpectedShareHash_Field {
-	return PendingAudits_ExpectedShareHash_Field{_set: true, _value: v}
+func User_MfaRecoveryCodes(v string) User_MfaRecoveryCodes_Field {
+	return User_MfaRecoveryCodes_Field{_set: true, _value: &v}
 }
 
-func (f PendingAudits_ExpectedShareHash_Field) value() interface{} {
+func User_MfaRecoveryCodes_Raw(v *string) User_MfaRecoveryCodes_Field {
+	if v == nil {
+		return User_MfaRecoveryCodes_Null()
+	}
+	return User_MfaRecoveryCodes(*v)
+}
+
+func User_MfaRecoveryCodes_Null() User_MfaRecoveryCodes_Field {
+	return User_MfaRecoveryCodes_Field{_set: true, _null: true}
+}
+
+func (f User_MfaRecoveryCodes_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f User_MfaRecoveryCodes_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (PendingAudits_ExpectedShareHash_Field) _Column() string { return "expected_share_hash" }
+func (User_MfaRecoveryCodes_Field) _Column() string { return "mfa_recovery_codes" }
 
-type PendingAudits_ReverifyCount_Field struct {
+type User_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value time.Time
 }
 
-func PendingAudits_ReverifyCount(v int64) PendingAudits_ReverifyCount_Field {
-	return PendingAudits_ReverifyCount_Field{_set: true, _value: v}
+func User_CreatedAt(v time.Time) User_CreatedAt_Field {
+	return User_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f PendingAudits_ReverifyCount_Field) value() interface{} {
+func (f User_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (PendingAudits_ReverifyCount_Field) _Column() string { return "reverify_count" }
+func (User_CreatedAt_Field) _Column() string { return "created_at" }
 
-type Project struct {
-	Id          []byte
-	Name        string
-	Description string
-	CreatedAt   time.Time
+type ApiKey struct {
+	Id        []byte
+	ProjectId []byte
+	Head      []byte
+	Name      string
+	Secret    []byte
+	Caveat    []byte
+	CreatedAt time.Time
 }
 
-func (Project) _Table() string { return "projects" }
+func (ApiKey) _Table() string { return "api_keys" }
 
-type Project_Update_Fields struct {
-	Description Project_Description_Field
+type ApiKey_Update_Fields struct {
+	Name ApiKey_Name_Field
 }
 
-type Project_Id_Field struct {
+type ApiKey_Id_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func Project_Id(v []byte) Project_Id_Field {
-	return Project_Id_Field{_set: true, _value: v}
+func ApiKey_Id(v []byte) ApiKey_Id_Field {
+	return ApiKey_Id_Field{_set: true, _value: v}
 }
 
-func (f Project_Id_Field) value() interface{} {
+func (f ApiKey_Id_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_Id_Field) _Column() string { return "id" }
+func (ApiKey_Id_Field) _Column() string { return "id" }
 
-type Project_Name_Field struct {
+type ApiKey_ProjectId_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value []byte
 }
 
-func Project_Name(v string) Project_Name_Field {
-	return Project_Name_Field{_set: true, _value: v}
+func ApiKey_ProjectId(v []byte) ApiKey_ProjectId_Field {
+	return ApiKey_ProjectId_Field{_set: true, _value: v}
 }
 
-func (f Project_Name_Field) value() interface{} {
+func (f ApiKey_ProjectId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_Name_Field) _Column() string { return "name" }
+func (ApiKey_ProjectId_Field) _Column() string { return "project_id" }
 
-type Project_Description_Field struct {
+type ApiKey_Head_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value []byte
 }
 
-func Project_Description(v string) Project_Description_Field {
-	return Project_Description_Field{_set: true, _value: v}
+func ApiKey_Head(v []byte) ApiKey_Head_Field {
+	return ApiKey_Head_Field{_set: true, _value: v}
 }
 
-func (f Project_Description_Field) value() interface{} {
+func (f ApiKey_Head_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_Description_Field) _Column() string { return "description" }
+func (ApiKey_Head_Field) _Column() string { return "head" }
 
-type Project_CreatedAt_Field struct {
+type ApiKey_Name_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value string
 }
 
-func Project_CreatedAt(v time.Time) Project_CreatedAt_Field {
-	return Project_CreatedAt_Field{_set: true, _value: v}
+func ApiKey_Name(v string) ApiKey_Name_Field {
+	return ApiKey_Name_Field{_set: true, _value: v}
 }
 
-func (f Project_CreatedAt_Field) value() interface{} {
+func (f ApiKey_Name_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_CreatedAt_Field) _Column() string { return "created_at" }
-
-type RegistrationToken struct {
-	Secret       []byte
-	OwnerId      []byte
-	ProjectLimit int
-	CreatedAt    time.Time
-}
-
-func (RegistrationToken) _Table() string { return "registration_tokens" }
-
-type RegistrationToken_Create_Fields struct {
-	OwnerId RegistrationToken_OwnerId_Field
-}
-
-type RegistrationToken_Update_Fields struct {
-	OwnerId RegistrationToken_OwnerId_Field
-}
+func (ApiKey_Name_Field) _Column() string { return "name" }
 
-type RegistrationToken_Secret_Field struct {
+type ApiKey_Secret_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func RegistrationToken_Secret(v []byte) RegistrationToken_Secret_Field {
-	return RegistrationToken_Secret_Field{_set: true, _value: v}
+func ApiKey_Secret(v []byte) ApiKey_Secret_Field {
+	return ApiKey_Secret_Field{_set: true, _value: v}
 }
 
-func (f RegistrationToken_Secret_Field) value() interface{} {
+func (f ApiKey_Secret_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (RegistrationToken_Secret_Field) _Column() string { return "secret" }
+func (ApiKey_Secret_Field) _Column() string { return "secret" }
 
-type RegistrationToken_OwnerId_Field struct {
+type ApiKey_Caveat_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func RegistrationToken_OwnerId(v []byte) RegistrationToken_OwnerId_Field {
-	return RegistrationToken_OwnerId_Field{_set: true, _value: v}
+func ApiKey_Caveat(v []byte) ApiKey_Caveat_Field {
+	return ApiKey_Caveat_Field{_set: true, _value: v}
 }
 
-func RegistrationToken_OwnerId_Raw(v []byte) RegistrationToken_OwnerId_Field {
+func ApiKey_Caveat_Raw(v []byte) ApiKey_Caveat_Field {
 	if v == nil {
-		return RegistrationToken_OwnerId_Null()
+		return ApiKey_Caveat_Null()
 	}
-	return RegistrationToken_OwnerId(v)
+	return ApiKey_Caveat(v)
 }
 
-func RegistrationToken_OwnerId_Null() RegistrationToken_OwnerId_Field {
-	return RegistrationToken_OwnerId_Field{_set: true, _null: true}
+func ApiKey_Caveat_Null() ApiKey_Caveat_Field {
+	return ApiKey_Caveat_Field{_set: true, _null: true}
 }
 
-func (f RegistrationToken_OwnerId_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (f ApiKey_Caveat_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f RegistrationToken_OwnerId_Field) value() interface{} {
+func (f ApiKey_Caveat_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (RegistrationToken_OwnerId_Field) _Column() string { return "owner_id" }
+func (ApiKey_Caveat_Field) _Column() string { return "caveat" }
 
-type RegistrationToken_ProjectLimit_Field struct {
+type ApiKey_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value time.Time
 }
 
-func RegistrationToken_ProjectLimit(v int) RegistrationToken_ProjectLimit_Field {
-	return RegistrationToken_ProjectLimit_Field{_set: true, _value: v}
+func ApiKey_CreatedAt(v time.Time) ApiKey_CreatedAt_Field {
+	return ApiKey_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f RegistrationToken_ProjectLimit_Field) value() interface{} {
+func (f ApiKey_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (RegistrationToken_ProjectLimit_Field) _Column() string { return "project_limit" }
+func (ApiKey_CreatedAt_Field) _Column() string { return "created_at" }
 
-type RegistrationToken_CreatedAt_Field struct {
+type ProjectMember struct {
+	MemberId  []byte
+	ProjectId []byte
+	Role      int
+	CreatedAt time.Time
+}
+
+func (ProjectMember) _Table() string { return "project_members" }
+
+type ProjectMember_Update_Fields struct {
+	Role ProjectMember_Role_Field
+}
+
+type ProjectMember_MemberId_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value []byte
 }
 
-func RegistrationToken_CreatedAt(v time.Time) RegistrationToken_CreatedAt_Field {
-	return RegistrationToken_CreatedAt_Field{_set: true, _value: v}
+func ProjectMember_MemberId(v []byte) ProjectMember_MemberId_Field {
+	return ProjectMember_MemberId_Field{_set: true, _value: v}
 }
 
-func (f RegistrationToken_CreatedAt_Field) value() interface{} {
+func (f ProjectMember_MemberId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (RegistrationToken_CreatedAt_Field) _Column() string { return "created_at" }
-
-type ResetPasswordToken struct {
-	Secret    []byte
-	OwnerId   []byte
-	CreatedAt time.Time
-}
-
-func (ResetPasswordToken) _Table() string { return "reset_password_tokens" }
-
-type ResetPasswordToken_Update_Fields struct {
-	OwnerId ResetPasswordToken_OwnerId_Field
-}
+func (ProjectMember_MemberId_Field) _Column() string { return "member_id" }
 
-type ResetPasswordToken_Secret_Field struct {
+type ProjectMember_ProjectId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func ResetPasswordToken_Secret(v []byte) ResetPasswordToken_Secret_Field {
-	return ResetPasswordToken_Secret_Field{_set: true, _value: v}
+func ProjectMember_ProjectId(v []byte) ProjectMember_ProjectId_Field {
+	return ProjectMember_ProjectId_Field{_set: true, _value: v}
 }
 
-func (f ResetPasswordToken_Secret_Field) value() interface{} {
+func (f ProjectMember_ProjectId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ResetPasswordToken_Secret_Field) _Column() string { return "secret" }
+func (ProjectMember_ProjectId_Field) _Column() string { return "project_id" }
 
-type ResetPasswordToken_OwnerId_Field struct {
+type ProjectMember_Role_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value int
 }
 
-func ResetPasswordToken_OwnerId(v []byte) ResetPasswordToken_OwnerId_Field {
-	return ResetPasswordToken_OwnerId_Field{_set: true, _value: v}
+func ProjectMember_Role(v int) ProjectMember_Role_Field {
+	return ProjectMember_Role_Field{_set: true, _value: v}
 }
 
-func (f ResetPasswordToken_OwnerId_Field) value() interface{} {
+func (f ProjectMember_Role_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ResetPasswordToken_OwnerId_Field) _Column() string { return "owner_id" }
+func (ProjectMember_Role_Field) _Column() string { return "role" }
 
-type ResetPasswordToken_CreatedAt_Field struct {
+type ProjectMember_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
 	_value time.Time
 }
 
-func ResetPasswordToken_CreatedAt(v time.Time) ResetPasswordToken_CreatedAt_Field {
-	return ResetPasswordToken_CreatedAt_Field{_set: true, _value: v}
+func ProjectMember_CreatedAt(v time.Time) ProjectMember_CreatedAt_Field {
+	return ProjectMember_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f ResetPasswordToken_CreatedAt_Field) value() interface{} {
+func (f ProjectMember_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ResetPasswordToken_CreatedAt_Field) _Column() string { return "created_at" }
+func (ProjectMember_CreatedAt_Field) _Column() string { return "created_at" }
 
-type SerialNumber struct {
-	Id           int
-	SerialNumber []byte
-	BucketId     []byte
-	ExpiresAt    time.Time
+type Segment struct {
+	Id           int64
+	ObjectId     int64
+	SegmentIndex int64
+	Remote       bool
+	SegmentSize  int64
+	RootPieceId  []byte
+	ExpiresAt    *time.Time
+	CreatedAt    time.Time
 }
 
-func (SerialNumber) _Table() string { return "serial_numbers" }
+func (Segment) _Table() string { return "segments" }
 
-type SerialNumber_Update_Fields struct {
+type Segment_Update_Fields struct {
 }
 
-type SerialNumber_Id_Field struct {
+type Segment_Id_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value int64
 }
 
-func SerialNumber_Id(v int) SerialNumber_Id_Field {
-	return SerialNumber_Id_Field{_set: true, _value: v}
+func Segment_Id(v int64) Segment_Id_Field {
+	return Segment_Id_Field{_set: true, _value: v}
 }
 
-func (f SerialNumber_Id_Field) value() interface{} {
+func (f Segment_Id_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (SerialNumber_Id_Field) _Column() string { return "id" }
+func (Segment_Id_Field) _Column() string { return "id" }
 
-type SerialNumber_SerialNumber_Field struct {
+type Segment_ObjectId_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value int64
 }
 
-func SerialNumber_SerialNumber(v []byte) SerialNumber_SerialNumber_Field {
-	return SerialNumber_SerialNumber_Field{_set: true, _value: v}
+func Segment_ObjectId(v int64) Segment_ObjectId_Field {
+	return Segment_ObjectId_Field{_set: true, _value: v}
 }
 
-func (f SerialNumber_SerialNumber_Field) value() interface{} {
+func (f Segment_ObjectId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (SerialNumber_SerialNumber_Field) _Column() string { return "serial_number" }
+func (Segment_ObjectId_Field) _Column() string { return "object_id" }
 
-type SerialNumber_BucketId_Field struct {
+type Segment_SegmentIndex_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value int64
 }
 
-func SerialNumber_BucketId(v []byte) SerialNumber_BucketId_Field {
-	return SerialNumber_BucketId_Field{_set: true, _value: v}
+func Segment_SegmentIndex(v int64) Segment_SegmentIndex_Field {
+	return Segment_SegmentIndex_Field{_set: true, _value: v}
 }
 
-func (f SerialNumber_BucketId_Field) value() interface{} {
+func (f Segment_SegmentIndex_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (SerialNumber_BucketId_Field) _Column() string { return "bucket_id" }
+func (Segment_SegmentIndex_Field) _Column() string { return "segment_index" }
 
-type SerialNumber_ExpiresAt_Field struct {
+type Segment_Remote_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value bool
 }
 
-func SerialNumber_ExpiresAt(v time.Time) SerialNumber_ExpiresAt_Field {
-	v = toUTC(v)
-	return SerialNumber_ExpiresAt_Field{_set: true, _value: v}
+func Segment_Remote(v bool) Segment_Remote_Field {
+	return Segment_Remote_Field{_set: true, _value: v}
 }
 
-func (f SerialNumber_ExpiresAt_Field) value() interface{} {
+func (f Segment_Remote_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (SerialNumber_ExpiresAt_Field) _Column() string { return "expires_at" }
-
-type StoragenodeBandwidthRollup struct {
-	StoragenodeId   []byte
-	IntervalStart   time.Time
-	IntervalSeconds uint
-	Action          uint
-	Allocated       uint64
-	Settled         uint64
-}
-
-func (StoragenodeBandwidthRollup) _Table() string { return "storagenode_bandwidth_rollups" }
-
-type StoragenodeBandwidthRollup_Update_Fields struct {
-	Allocated StoragenodeBandwidthRollup_Allocated_Field
-	Settled   StoragenodeBandwidthRollup_Settled_Field
-}
+func (Segment_Remote_Field) _Column() string { return "remote" }
 
-type StoragenodeBandwidthRollup_StoragenodeId_Field struct {
+type Segment_SegmentSize_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value int64
 }
 
-func StoragenodeBandwidthRollup_StoragenodeId(v []byte) StoragenodeBandwidthRollup_StoragenodeId_Field {
-	return StoragenodeBandwidthRollup_StoragenodeId_Field{_set: true, _value: v}
+func Segment_SegmentSize(v int64) Segment_SegmentSize_Field {
+	return Segment_SegmentSize_Field{_set: true, _value: v}
 }
 
-func (f StoragenodeBandwidthRollup_StoragenodeId_Field) value() interface{} {
+func (f Segment_SegmentSize_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeBandwidthRollup_StoragenodeId_Field) _Column() string { return "storagenode_id" }
+func (Segment_SegmentSize_Field) _Column() string { return "segment_size" }
 
-type StoragenodeBandwidthRollup_IntervalStart_Field struct {
+type Segment_RootPieceId_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value []byte
 }
 
-func StoragenodeBandwidthRollup_IntervalStart(v time.Time) StoragenodeBandwidthRollup_IntervalStart_Field {
-	v = toUTC(v)
-	return StoragenodeBandwidthRollup_IntervalStart_Field{_set: true, _value: v}
+func Segment_RootPieceId(v []byte) Segment_RootPieceId_Field {
+	return Segment_RootPieceId_Field{_set: true, _value: v}
 }
 
-func (f StoragenodeBandwidthRollup_IntervalStart_Field) value() interface{} {
+func Segment_RootPieceId_Raw(v []byte) Segment_RootPieceId_Field {
+	if v == nil {
+		return Segment_RootPieceId_Null()
+	}
+	return Segment_RootPieceId(v)
+}
+
+func Segment_RootPieceId_Null() Segment_RootPieceId_Field {
+	return Segment_RootPieceId_Field{_set: true, _null: true}
+}
+
+func (f Segment_RootPieceId_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Segment_RootPieceId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeBandwidthRollup_IntervalStart_Field) _Column() string { return "interval_start" }
+func (Segment_RootPieceId_Field) _Column() string { return "root_piece_id" }
 
-type StoragenodeBandwidthRollup_IntervalSeconds_Field struct {
+type Segment_ExpiresAt_Field struct {
 	_set   bool
 	_null  bool
-	_value uint
+	_value *time.Time
 }
 
-func StoragenodeBandwidthRollup_IntervalSeconds(v uint) StoragenodeBandwidthRollup_IntervalSeconds_Field {
-	return StoragenodeBandwidthRollup_IntervalSeconds_Field{_set: true, _value: v}
+func Segment_ExpiresAt(v time.Time) Segment_ExpiresAt_Field {
+	return Segment_ExpiresAt_Field{_set: true, _value: &v}
 }
 
-func (f StoragenodeBandwidthRollup_IntervalSeconds_Field) value() interface{} {
+func Segment_ExpiresAt_Raw(v *time.Time) Segment_ExpiresAt_Field {
+	if v == nil {
+		return Segment_ExpiresAt_Null()
+	}
+	return Segment_ExpiresAt(*v)
+}
+
+func Segment_ExpiresAt_Null() Segment_ExpiresAt_Field {
+	return Segment_ExpiresAt_Field{_set: true, _null: true}
+}
+
+func (f Segment_ExpiresAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Segment_ExpiresAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeBandwidthRollup_IntervalSeconds_Field) _Column() string { return "interval_seconds" }
+func (Segment_ExpiresAt_Field) _Column() string { return "expires_at" }
 
-type StoragenodeBandwidthRollup_Action_Field struct {
+type Segment_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value uint
+	_value time.Time
 }
 
-func StoragenodeBandwidthRollup_Action(v uint) StoragenodeBandwidthRollup_Action_Field {
-	return StoragenodeBandwidthRollup_Action_Field{_set: true, _value: v}
+func Segment_CreatedAt(v time.Time) Segment_CreatedAt_Field {
+	return Segment_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f StoragenodeBandwidthRollup_Action_Field) value() interface{} {
+func (f Segment_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeBandwidthRollup_Action_Field) _Column() string { return "action" }
+func (Segment_CreatedAt_Field) _Column() string { return "created_at" }
 
-type StoragenodeBandwidthRollup_Allocated_Field struct {
+type UsedSerial struct {
+	SerialNumberId int
+	StorageNodeId  []byte
+}
+
+func (UsedSerial) _Table() string { return "used_serials" }
+
+type UsedSerial_Update_Fields struct {
+}
+
+type UsedSerial_SerialNumberId_Field struct {
 	_set   bool
 	_null  bool
-	_value uint64
+	_value int
 }
 
-func StoragenodeBandwidthRollup_Allocated(v uint64) StoragenodeBandwidthRollup_Allocated_Field {
-	return StoragenodeBandwidthRollup_Allocated_Field{_set: true, _value: v}
+func UsedSerial_SerialNumberId(v int) UsedSerial_SerialNumberId_Field {
+	return UsedSerial_SerialNumberId_Field{_set: true, _value: v}
 }
 
-func (f StoragenodeBandwidthRollup_Allocated_Field) value() interface{} {
+func (f UsedSerial_SerialNumberId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeBandwidthRollup_Allocated_Field) _Column() string { return "allocated" }
+func (UsedSerial_SerialNumberId_Field) _Column() string { return "serial_number_id" }
 
-type StoragenodeBandwidthRollup_Settled_Field struct {
+type UsedSerial_StorageNodeId_Field struct {
 	_set   bool
 	_null  bool
-	_value uint64
+	_value []byte
 }
 
-func StoragenodeBandwidthRollup_Settled(v uint64) StoragenodeBandwidthRollup_Settled_Field {
-	return StoragenodeBandwidthRollup_Settled_Field{_set: true, _value: v}
+func UsedSerial_StorageNodeId(v []byte) UsedSerial_StorageNodeId_Field {
+	return UsedSerial_StorageNodeId_Field{_set: true, _value: v}
 }
 
-func (f StoragenodeBandwidthRollup_Settled_Field) value() interface{} {
+func (f UsedSerial_StorageNodeId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeBandwidthRollup_Settled_Field) _Column() string { return "settled" }
+func (UsedSerial_StorageNodeId_Field) _Column() string { return "storage_node_id" }
 
-type StoragenodeStorageTally struct {
-	Id              int64
-	NodeId          []byte
-	IntervalEndTime time.Time
-	DataTotal       float64
+type ApiKeyRevocation struct {
+	ApiKeyId  []byte
+	Tail      []byte
+	CreatedAt time.Time
 }
 
-func (StoragenodeStorageTally) _Table() string { return "storagenode_storage_tallies" }
+func (ApiKeyRevocation) _Table() string { return "api_key_revocations" }
 
-type StoragenodeStorageTally_Update_Fields struct {
+type ApiKeyRevocation_Update_Fields struct {
 }
 
-type StoragenodeStorageTally_Id_Field struct {
+type ApiKeyRevocation_ApiKeyId_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value []byte
 }
 
-func StoragenodeStorageTally_Id(v int64) StoragenodeStorageTally_Id_Field {
-	return StoragenodeStorageTally_Id_Field{_set: true, _value: v}
+func ApiKeyRevocation_ApiKeyId(v []byte) ApiKeyRevocation_ApiKeyId_Field {
+	return ApiKeyRevocation_ApiKeyId_Field{_set: true, _value: v}
 }
 
-func (f StoragenodeStorageTally_Id_Field) value() interface{} {
+func (f ApiKeyRevocation_ApiKeyId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeStorageTally_Id_Field) _Column() string { return "id" }
+func (ApiKeyRevocation_ApiKeyId_Field) _Column() string { return "api_key_id" }
 
-type StoragenodeStorageTally_NodeId_Field struct {
+type ApiKeyRevocation_Tail_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func StoragenodeStorageTally_NodeId(v []byte) StoragenodeStorageTally_NodeId_Field {
-	return StoragenodeStorageTally_NodeId_Field{_set: true, _value: v}
+func ApiKeyRevocation_Tail(v []byte) ApiKeyRevocation_Tail_Field {
+	return ApiKeyRevocation_Tail_Field{_set: true, _value: v}
 }
 
-func (f StoragenodeStorageTally_NodeId_Field) value() interface{} {
+func (f ApiKeyRevocation_Tail_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeStorageTally_NodeId_Field) _Column() string { return "node_id" }
+func (ApiKeyRevocation_Tail_Field) _Column() string { return "tail" }
 
-type StoragenodeStorageTally_IntervalEndTime_Field struct {
+type ApiKeyRevocation_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
 	_value time.Time
 }
 
-func StoragenodeStorageTally_IntervalEndTime(v time.Time) StoragenodeStorageTally_IntervalEndTime_Field {
-	return StoragenodeStorageTally_IntervalEndTime_Field{_set: true, _value: v}
+func ApiKeyRevocation_CreatedAt(v time.Time) ApiKeyRevocation_CreatedAt_Field {
+	return ApiKeyRevocation_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f StoragenodeStorageTally_IntervalEndTime_Field) value() interface{} {
+func (f ApiKeyRevocation_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeStorageTally_IntervalEndTime_Field) _Column() string { return "interval_end_time" }
+func (ApiKeyRevocation_CreatedAt_Field) _Column() string { return "created_at" }
 
-type StoragenodeStorageTally_DataTotal_Field struct {
+type SegmentPiece struct {
+	SegmentId int64
+	PieceNum  int
+	NodeId    []byte
+}
+
+func (SegmentPiece) _Table() string { return "segment_pieces" }
+
+type SegmentPiece_Update_Fields struct {
+}
+
+type SegmentPiece_SegmentId_Field struct {
 	_set   bool
 	_null  bool
-	_value float64
+	_value int64
 }
 
-func StoragenodeStorageTally_DataTotal(v float64) StoragenodeStorageTally_DataTotal_Field {
-	return StoragenodeStorageTally_DataTotal_Field{_set: true, _value: v}
+func SegmentPiece_SegmentId(v int64) SegmentPiece_SegmentId_Field {
+	return SegmentPiece_SegmentId_Field{_set: true, _value: v}
 }
 
-func (f StoragenodeStorageTally_DataTotal_Field) value() interface{} {
+func (f SegmentPiece_SegmentId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeStorageTally_DataTotal_Field) _Column() string { return "data_total" }
+func (SegmentPiece_SegmentId_Field) _Column() string { return "segment_id" }
 
-type User struct {
-	Id           []byte
-	FullName     string
-	ShortName    *string
-	Email        string
-	PasswordHash []byte
-	Status       int
-	CreatedAt    time.Time
+type SegmentPiece_PieceNum_Field struct {
+	_set   bool
+	_null  bool
+	_value int
 }
 
-func (User) _Table() string { return "users" }
-
-type User_Create_Fields struct {
-	ShortName User_ShortName_Field
+func SegmentPiece_PieceNum(v int) SegmentPiece_PieceNum_Field {
+	return SegmentPiece_PieceNum_Field{_set: true, _value: v}
 }
 
-type User_Update_Fields struct {
-	FullName     User_FullName_Field
-	ShortName    User_ShortName_Field
-	Email        User_Email_Field
-	PasswordHash User_PasswordHash_Field
-	Status       User_Status_Field
+func (f SegmentPiece_PieceNum_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
 }
 
-type User_Id_Field struct {
+func (SegmentPiece_PieceNum_Field) _Column() string { return "piece_num" }
+
+type SegmentPiece_NodeId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func User_Id(v []byte) User_Id_Field {
-	return User_Id_Field{_set: true, _value: v}
+func SegmentPiece_NodeId(v []byte) SegmentPiece_NodeId_Field {
+	return SegmentPiece_NodeId_Field{_set: true, _value: v}
 }
 
-func (f User_Id_Field) value() interface{} {
+func (f SegmentPiece_NodeId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_Id_Field) _Column() string { return "id" }
+func (SegmentPiece_NodeId_Field) _Column() string { return "node_id" }
 
-type User_FullName_Field struct {
+type AuditEvent struct {
+	Id        []byte
+	ProjectId []byte
+	ActorId   []byte
+	Action    string
+	Target    string
+	SourceIp  string
+	CreatedAt time.Time
+}
+
+func (AuditEvent) _Table() string { return "audit_events" }
+
+type AuditEvent_Update_Fields struct {
+}
+
+type AuditEvent_Id_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value []byte
 }
 
-func User_FullName(v string) User_FullName_Field {
-	return User_FullName_Field{_set: true, _value: v}
+func AuditEvent_Id(v []byte) AuditEvent_Id_Field {
+	return AuditEvent_Id_Field{_set: true, _value: v}
 }
 
-func (f User_FullName_Field) value() interface{} {
+func (f AuditEvent_Id_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_FullName_Field) _Column() string { return "full_name" }
+func (AuditEvent_Id_Field) _Column() string { return "id" }
 
-type User_ShortName_Field struct {
+type AuditEvent_ProjectId_Field struct {
 	_set   bool
 	_null  bool
-	_value *string
+	_value []byte
 }
 
-func User_ShortName(v string) User_ShortName_Field {
-	return User_ShortName_Field{_set: true, _value: &v}
+func AuditEvent_ProjectId(v []byte) AuditEvent_ProjectId_Field {
+	return AuditEvent_ProjectId_Field{_set: true, _value: v}
 }
 
-func User_ShortName_Raw(v *string) User_ShortName_Field {
+func AuditEvent_ProjectId_Raw(v []byte) AuditEvent_ProjectId_Field {
 	if v == nil {
-		return User_ShortName_Null()
+		return AuditEvent_ProjectId_Null()
+	}
+	return AuditEvent_ProjectId(v)
+}
+
+func AuditEvent_ProjectId_Null() AuditEvent_ProjectId_Field {
+	return AuditEvent_ProjectId_Field{_set: true, _null: true}
+}
+
+func (f AuditEvent_ProjectId_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f AuditEvent_ProjectId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return User_ShortName(*v)
+	return f._value
 }
 
-func User_ShortName_Null() User_ShortName_Field {
-	return User_ShortName_Field{_set: true, _null: true}
+func (AuditEvent_ProjectId_Field) _Column() string { return "project_id" }
+
+type AuditEvent_ActorId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
 }
 
-func (f User_ShortName_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func AuditEvent_ActorId(v []byte) AuditEvent_ActorId_Field {
+	return AuditEvent_ActorId_Field{_set: true, _value: v}
+}
 
-func (f User_ShortName_Field) value() interface{} {
+func (f AuditEvent_ActorId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_ShortName_Field) _Column() string { return "short_name" }
+func (AuditEvent_ActorId_Field) _Column() string { return "actor_id" }
 
-type User_Email_Field struct {
+type AuditEvent_Action_Field struct {
 	_set   bool
 	_null  bool
 	_value string
 }
 
-func User_Email(v string) User_Email_Field {
-	return User_Email_Field{_set: true, _value: v}
+func AuditEvent_Action(v string) AuditEvent_Action_Field {
+	return AuditEvent_Action_Field{_set: true, _value: v}
 }
 
-func (f User_Email_Field) value() interface{} {
+func (f AuditEvent_Action_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_Email_Field) _Column() string { return "email" }
+func (AuditEvent_Action_Field) _Column() string { return "action" }
 
-type User_PasswordHash_Field struct {
+type AuditEvent_Target_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value string
 }
 
-func User_PasswordHash(v []byte) User_PasswordHash_Field {
-	return User_PasswordHash_Field{_set: true, _value: v}
+func AuditEvent_Target(v string) AuditEvent_Target_Field {
+	return AuditEvent_Target_Field{_set: true, _value: v}
 }
 
-func (f User_PasswordHash_Field) value() interface{} {
+func (f AuditEvent_Target_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_PasswordHash_Field) _Column() string { return "password_hash" }
+func (AuditEvent_Target_Field) _Column() string { return "target" }
 
-type User_Status_Field struct {
+type AuditEvent_SourceIp_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value string
 }
 
-func User_Status(v int) User_Status_Field {
-	return User_Status_Field{_set: true, _value: v}
+func AuditEvent_SourceIp(v string) AuditEvent_SourceIp_Field {
+	return AuditEvent_SourceIp_Field{_set: true, _value: v}
 }
 
-func (f User_Status_Field) value() interface{} {
+func (f AuditEvent_SourceIp_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_Status_Field) _Column() string { return "status" }
+func (AuditEvent_SourceIp_Field) _Column() string { return "source_ip" }
 
-type User_CreatedAt_Field struct {
+type AuditEvent_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
 	_value time.Time
 }
 
-func User_CreatedAt(v time.Time) User_CreatedAt_Field {
-	return User_CreatedAt_Field{_set: true, _value: v}
+func AuditEvent_CreatedAt(v time.Time) AuditEvent_CreatedAt_Field {
+	return AuditEvent_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f User_CreatedAt_Field) value() interface{} {
+func (f AuditEvent_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_CreatedAt_Field) _Column() string { return "created_at" }
+func (AuditEvent_CreatedAt_Field) _Column() string { return "created_at" }
 
-type ApiKey struct {
-	Id        []byte
-	ProjectId []byte
-	Key       []byte
-	Name      string
-	CreatedAt time.Time
+type NotificationPreference struct {
+	UserId             []byte
+	UsageThresholds    bool
+	ApiKeyCreated      bool
+	ProjectMemberAdded bool
+	UpdatedAt          time.Time
 }
 
-func (ApiKey) _Table() string { return "api_keys" }
+func (NotificationPreference) _Table() string { return "notification_preferences" }
 
-type ApiKey_Update_Fields struct {
-	Name ApiKey_Name_Field
+type NotificationPreference_Update_Fields struct {
+	UsageThresholds    NotificationPreference_UsageThresholds_Field
+	ApiKeyCreated      NotificationPreference_ApiKeyCreated_Field
+	ProjectMemberAdded NotificationPreference_ProjectMemberAdded_Field
 }
 
-type ApiKey_Id_Field struct {
+type NotificationPreference_UserId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func ApiKey_Id(v []byte) ApiKey_Id_Field {
-	return ApiKey_Id_Field{_set: true, _value: v}
+func NotificationPreference_UserId(v []byte) NotificationPreference_UserId_Field {
+	return NotificationPreference_UserId_Field{_set: true, _value: v}
 }
 
-func (f ApiKey_Id_Field) value() interface{} {
+func (f NotificationPreference_UserId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ApiKey_Id_Field) _Column() string { return "id" }
+func (NotificationPreference_UserId_Field) _Column() string { return "user_id" }
 
-type ApiKey_ProjectId_Field struct {
+type NotificationPreference_UsageThresholds_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value bool
 }
 
-func ApiKey_ProjectId(v []byte) ApiKey_ProjectId_Field {
-	return ApiKey_ProjectId_Field{_set: true, _value: v}
+func NotificationPreference_UsageThresholds(v bool) NotificationPreference_UsageThresholds_Field {
+	return NotificationPreference_UsageThresholds_Field{_set: true, _value: v}
 }
 
-func (f ApiKey_ProjectId_Field) value() interface{} {
+func (f NotificationPreference_UsageThresholds_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ApiKey_ProjectId_Field) _Column() string { return "project_id" }
+func (NotificationPreference_UsageThresholds_Field) _Column() string { return "usage_thresholds" }
 
-type ApiKey_Key_Field struct {
+type NotificationPreference_ApiKeyCreated_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value bool
 }
 
-func ApiKey_Key(v []byte) ApiKey_Key_Field {
-	return ApiKey_Key_Field{_set: true, _value: v}
+func NotificationPreference_ApiKeyCreated(v bool) NotificationPreference_ApiKeyCreated_Field {
+	return NotificationPreference_ApiKeyCreated_Field{_set: true, _value: v}
 }
 
-func (f ApiKey_Key_Field) value() interface{} {
+func (f NotificationPreference_ApiKeyCreated_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ApiKey_Key_Field) _Column() string { return "key" }
+func (NotificationPreference_ApiKeyCreated_Field) _Column() string { return "api_key_created" }
 
-type ApiKey_Name_Field struct {
+type NotificationPreference_ProjectMemberAdded_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value bool
 }
 
-func ApiKey_Name(v string) ApiKey_Name_Field {
-	return ApiKey_Name_Field{_set: true, _value: v}
+func NotificationPreference_ProjectMemberAdded(v bool) NotificationPreference_ProjectMemberAdded_Field {
+	return NotificationPreference_ProjectMemberAdded_Field{_set: true, _value: v}
 }
 
-func (f ApiKey_Name_Field) value() interface{} {
+func (f NotificationPreference_ProjectMemberAdded_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ApiKey_Name_Field) _Column() string { return "name" }
+func (NotificationPreference_ProjectMemberAdded_Field) _Column() string {
+	return "project_member_added"
+}
 
-type ApiKey_CreatedAt_Field struct {
+type NotificationPreference_UpdatedAt_Field struct {
 	_set   bool
 	_null  bool
 	_value time.Time
 }
 
-func ApiKey_CreatedAt(v time.Time) ApiKey_CreatedAt_Field {
-	return ApiKey_CreatedAt_Field{_set: true, _value: v}
+func NotificationPreference_UpdatedAt(v time.Time) NotificationPreference_UpdatedAt_Field {
+	return NotificationPreference_UpdatedAt_Field{_set: true, _value: v}
 }
 
-func (f ApiKey_CreatedAt_Field) value() interface{} {
+func (f NotificationPreference_UpdatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ApiKey_CreatedAt_Field) _Column() string { return "created_at" }
+func (NotificationPreference_UpdatedAt_Field) _Column() string { return "updated_at" }
 
-type ProjectMember struct {
-	MemberId  []byte
-	ProjectId []byte
-	CreatedAt time.Time
+type UsageNotification struct {
+	ProjectId   []byte
+	Resource    string
+	Threshold   int
+	PeriodStart time.Time
+	CreatedAt   time.Time
 }
 
-func (ProjectMember) _Table() string { return "project_members" }
+func (UsageNotification) _Table() string { return "usage_notifications" }
 
-type ProjectMember_Update_Fields struct {
+type UsageNotification_Update_Fields struct {
 }
 
-type ProjectMember_MemberId_Field struct {
+type UsageNotification_ProjectId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func ProjectMember_MemberId(v []byte) ProjectMember_MemberId_Field {
-	return ProjectMember_MemberId_Field{_set: true, _value: v}
+func UsageNotification_ProjectId(v []byte) UsageNotification_ProjectId_Field {
+	return UsageNotification_ProjectId_Field{_set: true, _value: v}
 }
 
-func (f ProjectMember_MemberId_Field) value() interface{} {
+func (f UsageNotification_ProjectId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ProjectMember_MemberId_Field) _Column() string { return "member_id" }
+func (UsageNotification_ProjectId_Field) _Column() string { return "project_id" }
 
-type ProjectMember_ProjectId_Field struct {
+type UsageNotification_Resource_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value string
 }
 
-func ProjectMember_ProjectId(v []byte) ProjectMember_ProjectId_Field {
-	return ProjectMember_ProjectId_Field{_set: true, _value: v}
+func UsageNotification_Resource(v string) UsageNotification_Resource_Field {
+	return UsageNotification_Resource_Field{_set: true, _value: v}
 }
 
-func (f ProjectMember_ProjectId_Field) value() interface{} {
+func (f UsageNotification_Resource_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ProjectMember_ProjectId_Field) _Column() string { return "project_id" }
+func (UsageNotification_Resource_Field) _Column() string { return "resource" }
 
-type ProjectMember_CreatedAt_Field struct {
+type UsageNotification_Threshold_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value int
 }
 
-func ProjectMember_CreatedAt(v time.Time) ProjectMember_CreatedAt_Field {
-	return ProjectMember_CreatedAt_Field{_set: true, _value: v}
+func UsageNotification_Threshold(v int) UsageNotification_Threshold_Field {
+	return UsageNotification_Threshold_Field{_set: true, _value: v}
 }
 
-func (f ProjectMember_CreatedAt_Field) value() interface{} {
+func (f UsageNotification_Threshold_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ProjectMember_CreatedAt_Field) _Column() string { return "created_at" }
-
-type UsedSerial struct {
-	SerialNumberId int
-	StorageNodeId  []byte
-}
-
-func (UsedSerial) _Table() string { return "used_serials" }
-
-type UsedSerial_Update_Fields struct {
-}
+func (UsageNotification_Threshold_Field) _Column() string { return "threshold" }
 
-type UsedSerial_SerialNumberId_Field struct {
+type UsageNotification_PeriodStart_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value time.Time
 }
 
-func UsedSerial_SerialNumberId(v int) UsedSerial_SerialNumberId_Field {
-	return UsedSerial_SerialNumberId_Field{_set: true, _value: v}
+func UsageNotification_PeriodStart(v time.Time) UsageNotification_PeriodStart_Field {
+	return UsageNotification_PeriodStart_Field{_set: true, _value: v}
 }
 
-func (f UsedSerial_SerialNumberId_Field) value() interface{} {
+func (f UsageNotification_PeriodStart_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (UsedSerial_SerialNumberId_Field) _Column() string { return "serial_number_id" }
+func (UsageNotification_PeriodStart_Field) _Column() string { return "period_start" }
 
-type UsedSerial_StorageNodeId_Field struct {
+type UsageNotification_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value time.Time
 }
 
-func UsedSerial_StorageNodeId(v []byte) UsedSerial_StorageNodeId_Field {
-	return UsedSerial_StorageNodeId_Field{_set: true, _value: v}
+func UsageNotification_CreatedAt(v time.Time) UsageNotification_CreatedAt_Field {
+	return UsageNotification_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f UsedSerial_StorageNodeId_Field) value() interface{} {
+func (f UsageNotification_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (UsedSerial_StorageNodeId_Field) _Column() string { return "storage_node_id" }
+func (UsageNotification_CreatedAt_Field) _Column() string { return "created_at" }
 
 func toUTC(t time.Time) time.Time {
 	return t.UTC()
@@ -4268,7 +6450,9 @@ func (obj *postgresImpl) Create_PendingAudits(ctx context.Context,
 	pending_audits_stripe_index PendingAudits_StripeIndex_Field,
 	pending_audits_share_size PendingAudits_ShareSize_Field,
 	pending_audits_expected_share_hash PendingAudits_ExpectedShareHash_Field,
-	pending_audits_reverify_count PendingAudits_ReverifyCount_Field) (
+	pending_audits_reverify_count PendingAudits_ReverifyCount_Field,
+	pending_audits_piece_num PendingAudits_PieceNum_Field,
+	pending_audits_path PendingAudits_Path_Field) (
 	pending_audits *PendingAudits, err error) {
 	__node_id_val := pending_audits_node_id.value()
 	__piece_id_val := pending_audits_piece_id.value()
@@ -4276,14 +6460,16 @@ func (obj *postgresImpl) Create_PendingAudits(ctx context.Context,
 	__share_size_val := pending_audits_share_size.value()
 	__expected_share_hash_val := pending_audits_expected_share_hash.value()
 	__reverify_count_val := pending_audits_reverify_count.value()
+	__piece_num_val := pending_audits_piece_num.value()
+	__path_val := pending_audits_path.value()
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO pending_audits ( node_id, piece_id, stripe_index, share_size, expected_share_hash, reverify_count ) VALUES ( ?, ?, ?, ?, ?, ? ) RETURNING pending_audits.node_id, pending_audits.piece_id, pending_audits.stripe_index, pending_audits.share_size, pending_audits.expected_share_hash, pending_audits.reverify_count")
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO pending_audits ( node_id, piece_id, stripe_index, share_size, expected_share_hash, reverify_count, piece_num, path ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ? ) RETURNING pending_audits.node_id, pending_audits.piece_id, pending_audits.stripe_index, pending_audits.share_size, pending_audits.expected_share_hash, pending_audits.reverify_count, pending_audits.piece_num, pending_audits.path")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __node_id_val, __piece_id_val, __stripe_index_val, __share_size_val, __expected_share_hash_val, __reverify_count_val)
+	obj.logStmt(__stmt, __node_id_val, __piece_id_val, __stripe_index_val, __share_size_val, __expected_share_hash_val, __reverify_count_val, __piece_num_val, __path_val)
 
 	pending_audits = &PendingAudits{}
-	err = obj.driver.QueryRow(__stmt, __node_id_val, __piece_id_val, __stripe_index_val, __share_size_val, __expected_share_hash_val, __reverify_count_val).Scan(&pending_audits.NodeId, &pending_audits.PieceId, &pending_audits.StripeIndex, &pending_audits.ShareSize, &pending_audits.ExpectedShareHash, &pending_audits.ReverifyCount)
+	err = obj.driver.QueryRow(__stmt, __node_id_val, __piece_id_val, __stripe_index_val, __share_size_val, __expected_share_hash_val, __reverify_count_val, __piece_num_val, __path_val).Scan(&pending_audits.NodeId, &pending_audits.PieceId, &pending_audits.StripeIndex, &pending_audits.ShareSize, &pending_audits.ExpectedShareHash, &pending_audits.ReverifyCount, &pending_audits.PieceNum, &pending_audits.Path)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -4395,9 +6581,14 @@ func (obj *postgresImpl) Create_Node(ctx context.Context,
 	node_uptime_success_count Node_UptimeSuccessCount_Field,
 	node_total_uptime_count Node_TotalUptimeCount_Field,
 	node_uptime_ratio Node_UptimeRatio_Field,
+	node_audit_reputation_alpha Node_AuditReputationAlpha_Field,
+	node_audit_reputation_beta Node_AuditReputationBeta_Field,
+	node_uptime_reputation_alpha Node_UptimeReputationAlpha_Field,
+	node_uptime_reputation_beta Node_UptimeReputationBeta_Field,
 	node_last_contact_success Node_LastContactSuccess_Field,
 	node_last_contact_failure Node_LastContactFailure_Field,
-	node_contained Node_Contained_Field) (
+	node_contained Node_Contained_Field,
+	optional Node_Create_Fields) (
 	node *Node, err error) {
 
 	__now := obj.db.Hooks.Now().UTC()
@@ -4423,19 +6614,25 @@ func (obj *postgresImpl) Create_Node(ctx context.Context,
 	__uptime_success_count_val := node_uptime_success_count.value()
 	__total_uptime_count_val := node_total_uptime_count.value()
 	__uptime_ratio_val := node_uptime_ratio.value()
+	__audit_reputation_alpha_val := node_audit_reputation_alpha.value()
+	__audit_reputation_beta_val := node_audit_reputation_beta.value()
+	__uptime_reputation_alpha_val := node_uptime_reputation_alpha.value()
+	__uptime_reputation_beta_val := node_uptime_reputation_beta.value()
 	__created_at_val := __now
 	__updated_at_val := __now
 	__last_contact_success_val := node_last_contact_success.value()
 	__last_contact_failure_val := node_last_contact_failure.value()
 	__contained_val := node_contained.value()
+	__disqualified_val := optional.Disqualified.value()
+	__last_audited_val := optional.LastAudited.value()
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO nodes ( id, address, last_ip, protocol, type, email, wallet, free_bandwidth, free_disk, major, minor, patch, hash, timestamp, release, latency_90, audit_success_count, total_audit_count, audit_success_ratio, uptime_success_count, total_uptime_count, uptime_ratio, created_at, updated_at, last_contact_success, last_contact_failure, contained ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? ) RETURNING nodes.id, nodes.address, nodes.last_ip, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.free_bandwidth, nodes.free_disk, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.audit_success_count, nodes.total_audit_count, nodes.audit_success_ratio, nodes.uptime_success_count, nodes.total_uptime_count, nodes.uptime_ratio, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.contained")
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO nodes ( id, address, last_ip, protocol, type, email, wallet, free_bandwidth, free_disk, major, minor, patch, hash, timestamp, release, latency_90, audit_success_count, total_audit_count, audit_success_ratio, uptime_success_count, total_uptime_count, uptime_ratio, audit_reputation_alpha, audit_reputation_beta, uptime_reputation_alpha, uptime_reputation_beta, created_at, updated_at, last_contact_success, last_contact_failure, contained, disqualified, last_audited ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? ) RETURNING nodes.id, nodes.address, nodes.last_ip, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.free_bandwidth, nodes.free_disk, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.audit_success_count, nodes.total_audit_count, nodes.audit_success_ratio, nodes.uptime_success_count, nodes.total_uptime_count, nodes.uptime_ratio, nodes.audit_reputation_alpha, nodes.audit_reputation_beta, nodes.uptime_reputation_alpha, nodes.uptime_reputation_beta, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.contained, nodes.disqualified, nodes.last_audited")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __id_val, __address_val, __last_ip_val, __protocol_val, __type_val, __email_val, __wallet_val, __free_bandwidth_val, __free_disk_val, __major_val, __minor_val, __patch_val, __hash_val, __timestamp_val, __release_val, __latency_90_val, __audit_success_count_val, __total_audit_count_val, __audit_success_ratio_val, __uptime_success_count_val, __total_uptime_count_val, __uptime_ratio_val, __created_at_val, __updated_at_val, __last_contact_success_val, __last_contact_failure_val, __contained_val)
+	obj.logStmt(__stmt, __id_val, __address_val, __last_ip_val, __protocol_val, __type_val, __email_val, __wallet_val, __free_bandwidth_val, __free_disk_val, __major_val, __minor_val, __patch_val, __hash_val, __timestamp_val, __release_val, __latency_90_val, __audit_success_count_val, __total_audit_count_val, __audit_success_ratio_val, __uptime_success_count_val, __total_uptime_count_val, __uptime_ratio_val, __audit_reputation_alpha_val, __audit_reputation_beta_val, __uptime_reputation_alpha_val, __uptime_reputation_beta_val, __created_at_val, __updated_at_val, __last_contact_success_val, __last_contact_failure_val, __contained_val, __disqualified_val, __last_audited_val)
 
 	node = &Node{}
-	err = obj.driver.QueryRow(__stmt, __id_val, __address_val, __last_ip_val, __protocol_val, __type_val, __email_val, __wallet_val, __free_bandwidth_val, __free_disk_val, __major_val, __minor_val, __patch_val, __hash_val, __timestamp_val, __release_val, __latency_90_val, __audit_success_count_val, __total_audit_count_val, __audit_success_ratio_val, __uptime_success_count_val, __total_uptime_count_val, __uptime_ratio_val, __created_at_val, __updated_at_val, __last_contact_success_val, __last_contact_failure_val, __contained_val).Scan(&node.Id, &node.Address, &node.LastIp, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.FreeBandwidth, &node.FreeDisk, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.AuditSuccessCount, &node.TotalAuditCount, &node.AuditSuccessRatio, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.UptimeRatio, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Contained)
+	err = obj.driver.QueryRow(__stmt, __id_val, __address_val, __last_ip_val, __protocol_val, __type_val, __email_val, __wallet_val, __free_bandwidth_val, __free_disk_val, __major_val, __minor_val, __patch_val, __hash_val, __timestamp_val, __release_val, __latency_90_val, __audit_success_count_val, __total_audit_count_val, __audit_success_ratio_val, __uptime_success_count_val, __total_uptime_count_val, __uptime_ratio_val, __audit_reputation_alpha_val, __audit_reputation_beta_val, __uptime_reputation_alpha_val, __uptime_reputation_beta_val, __created_at_val, __updated_at_val, __last_contact_success_val, __last_contact_failure_val, __contained_val, __disqualified_val, __last_audited_val).Scan(&node.Id, &node.Address, &node.LastIp, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.FreeBandwidth, &node.FreeDisk, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.AuditSuccessCount, &node.TotalAuditCount, &node.AuditSuccessRatio, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.UptimeRatio, &node.AuditReputationAlpha, &node.AuditReputationBeta, &node.UptimeReputationAlpha, &node.UptimeReputationBeta, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Contained, &node.Disqualified, &node.LastAudited)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -4458,15 +6655,18 @@ func (obj *postgresImpl) Create_User(ctx context.Context,
 	__email_val := user_email.value()
 	__password_hash_val := user_password_hash.value()
 	__status_val := int(0)
+	__mfa_enabled_val := false
+	__mfa_secret_key_val := optional.MfaSecretKey.value()
+	__mfa_recovery_codes_val := optional.MfaRecoveryCodes.value()
 	__created_at_val := __now
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO users ( id, full_name, short_name, email, password_hash, status, created_at ) VALUES ( ?, ?, ?, ?, ?, ?, ? ) RETURNING users.id, users.full_name, users.short_name, users.email, users.password_hash, users.status, users.created_at")
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO users ( id, full_name, short_name, email, password_hash, status, mfa_enabled, mfa_secret_key, mfa_recovery_codes, created_at ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ? ) RETURNING users.id, users.full_name, users.short_name, users.email, users.password_hash, users.status, users.mfa_enabled, users.mfa_secret_key, users.mfa_recovery_codes, users.created_at")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __id_val, __full_name_val, __short_name_val, __email_val, __password_hash_val, __status_val, __created_at_val)
+	obj.logStmt(__stmt, __id_val, __full_name_val, __short_name_val, __email_val, __password_hash_val, __status_val, __mfa_enabled_val, __mfa_secret_key_val, __mfa_recovery_codes_val, __created_at_val)
 
 	user = &User{}
-	err = obj.driver.QueryRow(__stmt, __id_val, __full_name_val, __short_name_val, __email_val, __password_hash_val, __status_val, __created_at_val).Scan(&user.Id, &user.FullName, &user.ShortName, &user.Email, &user.PasswordHash, &user.Status, &user.CreatedAt)
+	err = obj.driver.QueryRow(__stmt, __id_val, __full_name_val, __short_name_val, __email_val, __password_hash_val, __status_val, __mfa_enabled_val, __mfa_secret_key_val, __mfa_recovery_codes_val, __created_at_val).Scan(&user.Id, &user.FullName, &user.ShortName, &user.Email, &user.PasswordHash, &user.Status, &user.MfaEnabled, &user.MfaSecretKey, &user.MfaRecoveryCodes, &user.CreatedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -4477,22 +6677,26 @@ func (obj *postgresImpl) Create_User(ctx context.Context,
 func (obj *postgresImpl) Create_Project(ctx context.Context,
 	project_id Project_Id_Field,
 	project_name Project_Name_Field,
-	project_description Project_Description_Field) (
+	project_description Project_Description_Field,
+	project_storage_limit Project_StorageLimit_Field,
+	project_egress_limit Project_EgressLimit_Field) (
 	project *Project, err error) {
 
 	__now := obj.db.Hooks.Now().UTC()
 	__id_val := project_id.value()
 	__name_val := project_name.value()
 	__description_val := project_description.value()
+	__storage_limit_val := project_storage_limit.value()
+	__egress_limit_val := project_egress_limit.value()
 	__created_at_val := __now
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO projects ( id, name, description, created_at ) VALUES ( ?, ?, ?, ? ) RETURNING projects.id, projects.name, projects.description, projects.created_at")
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO projects ( id, name, description, storage_limit, egress_limit, created_at ) VALUES ( ?, ?, ?, ?, ?, ? ) RETURNING projects.id, projects.name, projects.description, projects.storage_limit, projects.egress_limit, projects.created_at")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __id_val, __name_val, __description_val, __created_at_val)
+	obj.logStmt(__stmt, __id_val, __name_val, __description_val, __storage_limit_val, __egress_limit_val, __created_at_val)
 
 	project = &Project{}
-	err = obj.driver.QueryRow(__stmt, __id_val, __name_val, __description_val, __created_at_val).Scan(&project.Id, &project.Name, &project.Description, &project.CreatedAt)
+	err = obj.driver.QueryRow(__stmt, __id_val, __name_val, __description_val, __storage_limit_val, __egress_limit_val, __created_at_val).Scan(&project.Id, &project.Name, &project.Description, &project.StorageLimit, &project.EgressLimit, &project.CreatedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -4502,21 +6706,23 @@ func (obj *postgresImpl) Create_Project(ctx context.Context,
 
 func (obj *postgresImpl) Create_ProjectMember(ctx context.Context,
 	project_member_member_id ProjectMember_MemberId_Field,
-	project_member_project_id ProjectMember_ProjectId_Field) (
+	project_member_project_id ProjectMember_ProjectId_Field,
+	project_member_role ProjectMember_Role_Field) (
 	project_member *ProjectMember, err error) {
 
 	__now := obj.db.Hooks.Now().UTC()
 	__member_id_val := project_member_member_id.value()
 	__project_id_val := project_member_project_id.value()
+	__role_val := project_member_role.value()
 	__created_at_val := __now
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO project_members ( member_id, project_id, created_at ) VALUES ( ?, ?, ? ) RETURNING project_members.member_id, project_members.project_id, project_members.created_at")
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO project_members ( member_id, project_id, role, created_at ) VALUES ( ?, ?, ?, ? ) RETURNING project_members.member_id, project_members.project_id, project_members.role, project_members.created_at")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __member_id_val, __project_id_val, __created_at_val)
+	obj.logStmt(__stmt, __member_id_val, __project_id_val, __role_val, __created_at_val)
 
 	project_member = &ProjectMember{}
-	err = obj.driver.QueryRow(__stmt, __member_id_val, __project_id_val, __created_at_val).Scan(&project_member.MemberId, &project_member.ProjectId, &project_member.CreatedAt)
+	err = obj.driver.QueryRow(__stmt, __member_id_val, __project_id_val, __role_val, __created_at_val).Scan(&project_member.MemberId, &project_member.ProjectId, &project_member.Role, &project_member.CreatedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -4527,24 +6733,28 @@ func (obj *postgresImpl) Create_ProjectMember(ctx context.Context,
 func (obj *postgresImpl) Create_ApiKey(ctx context.Context,
 	api_key_id ApiKey_Id_Field,
 	api_key_project_id ApiKey_ProjectId_Field,
-	api_key_key ApiKey_Key_Field,
-	api_key_name ApiKey_Name_Field) (
+	api_key_head ApiKey_Head_Field,
+	api_key_name ApiKey_Name_Field,
+	api_key_secret ApiKey_Secret_Field,
+	api_key_caveat ApiKey_Caveat_Field) (
 	api_key *ApiKey, err error) {
 
 	__now := obj.db.Hooks.Now().UTC()
 	__id_val := api_key_id.value()
 	__project_id_val := api_key_project_id.value()
-	__key_val := api_key_key.value()
+	__head_val := api_key_head.value()
 	__name_val := api_key_name.value()
+	__secret_val := api_key_secret.value()
+	__caveat_val := api_key_caveat.value()
 	__created_at_val := __now
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO api_keys ( id, project_id, key, name, created_at ) VALUES ( ?, ?, ?, ?, ? ) RETURNING api_keys.id, api_keys.project_id, api_keys.key, api_keys.name, api_keys.created_at")
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO api_keys ( id, project_id, head, name, secret, caveat, created_at ) VALUES ( ?, ?, ?, ?, ?, ?, ? ) RETURNING api_keys.id, api_keys.project_id, api_keys.head, api_keys.name, api_keys.secret, api_keys.caveat, api_keys.created_at")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __id_val, __project_id_val, __key_val, __name_val, __created_at_val)
+	obj.logStmt(__stmt, __id_val, __project_id_val, __head_val, __name_val, __secret_val, __caveat_val, __created_at_val)
 
 	api_key = &ApiKey{}
-	err = obj.driver.QueryRow(__stmt, __id_val, __project_id_val, __key_val, __name_val, __created_at_val).Scan(&api_key.Id, &api_key.ProjectId, &api_key.Key, &api_key.Name, &api_key.CreatedAt)
+	err = obj.driver.QueryRow(__stmt, __id_val, __project_id_val, __head_val, __name_val, __secret_val, __caveat_val, __created_at_val).Scan(&api_key.Id, &api_key.ProjectId, &api_key.Head, &api_key.Name, &api_key.Secret, &api_key.Caveat, &api_key.CreatedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -4708,101 +6918,319 @@ func (obj *postgresImpl) Create_CertRecord(ctx context.Context,
 	var __embed_stmt = __sqlbundle_Literal("INSERT INTO certRecords ( publickey, id, update_at ) VALUES ( ?, ?, ? ) RETURNING certRecords.publickey, certRecords.id, certRecords.update_at")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __publickey_val, __id_val, __update_at_val)
+	obj.logStmt(__stmt, __publickey_val, __id_val, __update_at_val)
+
+	certRecord = &CertRecord{}
+	err = obj.driver.QueryRow(__stmt, __publickey_val, __id_val, __update_at_val).Scan(&certRecord.Publickey, &certRecord.Id, &certRecord.UpdateAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return certRecord, nil
+
+}
+
+func (obj *postgresImpl) Create_RegistrationToken(ctx context.Context,
+	registration_token_secret RegistrationToken_Secret_Field,
+	registration_token_project_limit RegistrationToken_ProjectLimit_Field,
+	optional RegistrationToken_Create_Fields) (
+	registration_token *RegistrationToken, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__secret_val := registration_token_secret.value()
+	__owner_id_val := optional.OwnerId.value()
+	__project_limit_val := registration_token_project_limit.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO registration_tokens ( secret, owner_id, project_limit, created_at ) VALUES ( ?, ?, ?, ? ) RETURNING registration_tokens.secret, registration_tokens.owner_id, registration_tokens.project_limit, registration_tokens.created_at")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __secret_val, __owner_id_val, __project_limit_val, __created_at_val)
+
+	registration_token = &RegistrationToken{}
+	err = obj.driver.QueryRow(__stmt, __secret_val, __owner_id_val, __project_limit_val, __created_at_val).Scan(&registration_token.Secret, &registration_token.OwnerId, &registration_token.ProjectLimit, &registration_token.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return registration_token, nil
+
+}
+
+func (obj *postgresImpl) Create_ResetPasswordToken(ctx context.Context,
+	reset_password_token_secret ResetPasswordToken_Secret_Field,
+	reset_password_token_owner_id ResetPasswordToken_OwnerId_Field) (
+	reset_password_token *ResetPasswordToken, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__secret_val := reset_password_token_secret.value()
+	__owner_id_val := reset_password_token_owner_id.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO reset_password_tokens ( secret, owner_id, created_at ) VALUES ( ?, ?, ? ) RETURNING reset_password_tokens.secret, reset_password_tokens.owner_id, reset_password_tokens.created_at")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __secret_val, __owner_id_val, __created_at_val)
+
+	reset_password_token = &ResetPasswordToken{}
+	err = obj.driver.QueryRow(__stmt, __secret_val, __owner_id_val, __created_at_val).Scan(&reset_password_token.Secret, &reset_password_token.OwnerId, &reset_password_token.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return reset_password_token, nil
+
+}
+
+func (obj *postgresImpl) Create_Offer(ctx context.Context,
+	offer_name Offer_Name_Field,
+	offer_description Offer_Description_Field,
+	offer_type Offer_Type_Field,
+	offer_credit_in_cents Offer_CreditInCents_Field,
+	offer_award_credit_duration_days Offer_AwardCreditDurationDays_Field,
+	offer_invitee_credit_duration_days Offer_InviteeCreditDurationDays_Field,
+	offer_redeemable_cap Offer_RedeemableCap_Field) (
+	offer *Offer, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__name_val := offer_name.value()
+	__description_val := offer_description.value()
+	__type_val := offer_type.value()
+	__credit_in_cents_val := offer_credit_in_cents.value()
+	__award_credit_duration_days_val := offer_award_credit_duration_days.value()
+	__invitee_credit_duration_days_val := offer_invitee_credit_duration_days.value()
+	__redeemable_cap_val := offer_redeemable_cap.value()
+	__num_redeemed_val := int(0)
+	__expires_at_val := (*time.Time)(nil)
+	__created_at_val := __now
+	__status_val := int(0)
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO offers ( name, description, type, credit_in_cents, award_credit_duration_days, invitee_credit_duration_days, redeemable_cap, num_redeemed, expires_at, created_at, status ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? ) RETURNING offers.id, offers.name, offers.description, offers.type, offers.credit_in_cents, offers.award_credit_duration_days, offers.invitee_credit_duration_days, offers.redeemable_cap, offers.num_redeemed, offers.expires_at, offers.created_at, offers.status")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __name_val, __description_val, __type_val, __credit_in_cents_val, __award_credit_duration_days_val, __invitee_credit_duration_days_val, __redeemable_cap_val, __num_redeemed_val, __expires_at_val, __created_at_val, __status_val)
+
+	offer = &Offer{}
+	err = obj.driver.QueryRow(__stmt, __name_val, __description_val, __type_val, __credit_in_cents_val, __award_credit_duration_days_val, __invitee_credit_duration_days_val, __redeemable_cap_val, __num_redeemed_val, __expires_at_val, __created_at_val, __status_val).Scan(&offer.Id, &offer.Name, &offer.Description, &offer.Type, &offer.CreditInCents, &offer.AwardCreditDurationDays, &offer.InviteeCreditDurationDays, &offer.RedeemableCap, &offer.NumRedeemed, &offer.ExpiresAt, &offer.CreatedAt, &offer.Status)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return offer, nil
+
+}
+
+func (obj *postgresImpl) Create_Invoice(ctx context.Context,
+	invoice_id Invoice_Id_Field,
+	invoice_project_id Invoice_ProjectId_Field,
+	invoice_period_start Invoice_PeriodStart_Field,
+	invoice_period_end Invoice_PeriodEnd_Field,
+	invoice_storage Invoice_Storage_Field,
+	invoice_egress Invoice_Egress_Field,
+	invoice_object_count Invoice_ObjectCount_Field,
+	invoice_segment_count Invoice_SegmentCount_Field,
+	invoice_storage_amount Invoice_StorageAmount_Field,
+	invoice_egress_amount Invoice_EgressAmount_Field,
+	invoice_object_amount Invoice_ObjectAmount_Field,
+	invoice_segment_amount Invoice_SegmentAmount_Field,
+	invoice_total Invoice_Total_Field) (
+	invoice *Invoice, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__id_val := invoice_id.value()
+	__project_id_val := invoice_project_id.value()
+	__period_start_val := invoice_period_start.value()
+	__period_end_val := invoice_period_end.value()
+	__storage_val := invoice_storage.value()
+	__egress_val := invoice_egress.value()
+	__object_count_val := invoice_object_count.value()
+	__segment_count_val := invoice_segment_count.value()
+	__storage_amount_val := invoice_storage_amount.value()
+	__egress_amount_val := invoice_egress_amount.value()
+	__object_amount_val := invoice_object_amount.value()
+	__segment_amount_val := invoice_segment_amount.value()
+	__total_val := invoice_total.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO invoices ( id, project_id, period_start, period_end, storage, egress, object_count, segment_count, storage_amount, egress_amount, object_amount, segment_amount, total, created_at ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? ) RETURNING invoices.id, invoices.project_id, invoices.period_start, invoices.period_end, invoices.storage, invoices.egress, invoices.object_count, invoices.segment_count, invoices.storage_amount, invoices.egress_amount, invoices.object_amount, invoices.segment_amount, invoices.total, invoices.created_at")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __id_val, __project_id_val, __period_start_val, __period_end_val, __storage_val, __egress_val, __object_count_val, __segment_count_val, __storage_amount_val, __egress_amount_val, __object_amount_val, __segment_amount_val, __total_val, __created_at_val)
+
+	invoice = &Invoice{}
+	err = obj.driver.QueryRow(__stmt, __id_val, __project_id_val, __period_start_val, __period_end_val, __storage_val, __egress_val, __object_count_val, __segment_count_val, __storage_amount_val, __egress_amount_val, __object_amount_val, __segment_amount_val, __total_val, __created_at_val).Scan(&invoice.Id, &invoice.ProjectId, &invoice.PeriodStart, &invoice.PeriodEnd, &invoice.Storage, &invoice.Egress, &invoice.ObjectCount, &invoice.SegmentCount, &invoice.StorageAmount, &invoice.EgressAmount, &invoice.ObjectAmount, &invoice.SegmentAmount, &invoice.Total, &invoice.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return invoice, nil
+
+}
+
+func (obj *postgresImpl) Create_PayoutStatement(ctx context.Context,
+	payout_statement_node_id PayoutStatement_NodeId_Field,
+	payout_statement_period_start PayoutStatement_PeriodStart_Field,
+	payout_statement_period_end PayoutStatement_PeriodEnd_Field,
+	payout_statement_node_created_at PayoutStatement_NodeCreatedAt_Field,
+	payout_statement_wallet PayoutStatement_Wallet_Field,
+	payout_statement_at_rest_total PayoutStatement_AtRestTotal_Field,
+	payout_statement_get_total PayoutStatement_GetTotal_Field,
+	payout_statement_get_repair_total PayoutStatement_GetRepairTotal_Field,
+	payout_statement_get_audit_total PayoutStatement_GetAuditTotal_Field,
+	payout_statement_put_total PayoutStatement_PutTotal_Field,
+	payout_statement_put_repair_total PayoutStatement_PutRepairTotal_Field,
+	payout_statement_at_rest_amount PayoutStatement_AtRestAmount_Field,
+	payout_statement_get_amount PayoutStatement_GetAmount_Field,
+	payout_statement_repair_amount PayoutStatement_RepairAmount_Field,
+	payout_statement_audit_amount PayoutStatement_AuditAmount_Field,
+	payout_statement_earned PayoutStatement_Earned_Field,
+	payout_statement_held_percent PayoutStatement_HeldPercent_Field,
+	payout_statement_held PayoutStatement_Held_Field,
+	payout_statement_disqualified PayoutStatement_Disqualified_Field,
+	payout_statement_paid PayoutStatement_Paid_Field) (
+	payout_statement *PayoutStatement, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__node_id_val := payout_statement_node_id.value()
+	__period_start_val := payout_statement_period_start.value()
+	__period_end_val := payout_statement_period_end.value()
+	__node_created_at_val := payout_statement_node_created_at.value()
+	__wallet_val := payout_statement_wallet.value()
+	__at_rest_total_val := payout_statement_at_rest_total.value()
+	__get_total_val := payout_statement_get_total.value()
+	__get_repair_total_val := payout_statement_get_repair_total.value()
+	__get_audit_total_val := payout_statement_get_audit_total.value()
+	__put_total_val := payout_statement_put_total.value()
+	__put_repair_total_val := payout_statement_put_repair_total.value()
+	__at_rest_amount_val := payout_statement_at_rest_amount.value()
+	__get_amount_val := payout_statement_get_amount.value()
+	__repair_amount_val := payout_statement_repair_amount.value()
+	__audit_amount_val := payout_statement_audit_amount.value()
+	__earned_val := payout_statement_earned.value()
+	__held_percent_val := payout_statement_held_percent.value()
+	__held_val := payout_statement_held.value()
+	__disqualified_val := payout_statement_disqualified.value()
+	__paid_val := payout_statement_paid.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO payout_statements ( node_id, period_start, period_end, node_created_at, wallet, at_rest_total, get_total, get_repair_total, get_audit_total, put_total, put_repair_total, at_rest_amount, get_amount, repair_amount, audit_amount, earned, held_percent, held, disqualified, paid, created_at ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? ) RETURNING payout_statements.node_id, payout_statements.period_start, payout_statements.period_end, payout_statements.node_created_at, payout_statements.wallet, payout_statements.at_rest_total, payout_statements.get_total, payout_statements.get_repair_total, payout_statements.get_audit_total, payout_statements.put_total, payout_statements.put_repair_total, payout_statements.at_rest_amount, payout_statements.get_amount, payout_statements.repair_amount, payout_statements.audit_amount, payout_statements.earned, payout_statements.held_percent, payout_statements.held, payout_statements.disqualified, payout_statements.paid, payout_statements.created_at")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __node_id_val, __period_start_val, __period_end_val, __node_created_at_val, __wallet_val, __at_rest_total_val, __get_total_val, __get_repair_total_val, __get_audit_total_val, __put_total_val, __put_repair_total_val, __at_rest_amount_val, __get_amount_val, __repair_amount_val, __audit_amount_val, __earned_val, __held_percent_val, __held_val, __disqualified_val, __paid_val, __created_at_val)
+
+	payout_statement = &PayoutStatement{}
+	err = obj.driver.QueryRow(__stmt, __node_id_val, __period_start_val, __period_end_val, __node_created_at_val, __wallet_val, __at_rest_total_val, __get_total_val, __get_repair_total_val, __get_audit_total_val, __put_total_val, __put_repair_total_val, __at_rest_amount_val, __get_amount_val, __repair_amount_val, __audit_amount_val, __earned_val, __held_percent_val, __held_val, __disqualified_val, __paid_val, __created_at_val).Scan(&payout_statement.NodeId, &payout_statement.PeriodStart, &payout_statement.PeriodEnd, &payout_statement.NodeCreatedAt, &payout_statement.Wallet, &payout_statement.AtRestTotal, &payout_statement.GetTotal, &payout_statement.GetRepairTotal, &payout_statement.GetAuditTotal, &payout_statement.PutTotal, &payout_statement.PutRepairTotal, &payout_statement.AtRestAmount, &payout_statement.GetAmount, &payout_statement.RepairAmount, &payout_statement.AuditAmount, &payout_statement.Earned, &payout_statement.HeldPercent, &payout_statement.Held, &payout_statement.Disqualified, &payout_statement.Paid, &payout_statement.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return payout_statement, nil
+
+}
+
+func (obj *postgresImpl) Create_ApiKeyRevocation(ctx context.Context,
+	api_key_revocation_api_key_id ApiKeyRevocation_ApiKeyId_Field,
+	api_key_revocation_tail ApiKeyRevocation_Tail_Field) (
+	api_key_revocation *ApiKeyRevocation, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__api_key_id_val := api_key_revocation_api_key_id.value()
+	__tail_val := api_key_revocation_tail.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO api_key_revocations ( api_key_id, tail, created_at ) VALUES ( ?, ?, ? ) RETURNING api_key_revocations.api_key_id, api_key_revocations.tail, api_key_revocations.created_at")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __api_key_id_val, __tail_val, __created_at_val)
 
-	certRecord = &CertRecord{}
-	err = obj.driver.QueryRow(__stmt, __publickey_val, __id_val, __update_at_val).Scan(&certRecord.Publickey, &certRecord.Id, &certRecord.UpdateAt)
+	api_key_revocation = &ApiKeyRevocation{}
+	err = obj.driver.QueryRow(__stmt, __api_key_id_val, __tail_val, __created_at_val).Scan(&api_key_revocation.ApiKeyId, &api_key_revocation.Tail, &api_key_revocation.CreatedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	return certRecord, nil
+	return api_key_revocation, nil
 
 }
 
-func (obj *postgresImpl) Create_RegistrationToken(ctx context.Context,
-	registration_token_secret RegistrationToken_Secret_Field,
-	registration_token_project_limit RegistrationToken_ProjectLimit_Field,
-	optional RegistrationToken_Create_Fields) (
-	registration_token *RegistrationToken, err error) {
+func (obj *postgresImpl) Create_AuditEvent(ctx context.Context,
+	audit_event_id AuditEvent_Id_Field,
+	audit_event_project_id AuditEvent_ProjectId_Field,
+	audit_event_actor_id AuditEvent_ActorId_Field,
+	audit_event_action AuditEvent_Action_Field,
+	audit_event_target AuditEvent_Target_Field,
+	audit_event_source_ip AuditEvent_SourceIp_Field) (
+	audit_event *AuditEvent, err error) {
 
 	__now := obj.db.Hooks.Now().UTC()
-	__secret_val := registration_token_secret.value()
-	__owner_id_val := optional.OwnerId.value()
-	__project_limit_val := registration_token_project_limit.value()
+	__id_val := audit_event_id.value()
+	__project_id_val := audit_event_project_id.value()
+	__actor_id_val := audit_event_actor_id.value()
+	__action_val := audit_event_action.value()
+	__target_val := audit_event_target.value()
+	__source_ip_val := audit_event_source_ip.value()
 	__created_at_val := __now
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO registration_tokens ( secret, owner_id, project_limit, created_at ) VALUES ( ?, ?, ?, ? ) RETURNING registration_tokens.secret, registration_tokens.owner_id, registration_tokens.project_limit, registration_tokens.created_at")
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO audit_events ( id, project_id, actor_id, action, target, source_ip, created_at ) VALUES ( ?, ?, ?, ?, ?, ?, ? ) RETURNING audit_events.id, audit_events.project_id, audit_events.actor_id, audit_events.action, audit_events.target, audit_events.source_ip, audit_events.created_at")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __secret_val, __owner_id_val, __project_limit_val, __created_at_val)
+	obj.logStmt(__stmt, __id_val, __project_id_val, __actor_id_val, __action_val, __target_val, __source_ip_val, __created_at_val)
 
-	registration_token = &RegistrationToken{}
-	err = obj.driver.QueryRow(__stmt, __secret_val, __owner_id_val, __project_limit_val, __created_at_val).Scan(&registration_token.Secret, &registration_token.OwnerId, &registration_token.ProjectLimit, &registration_token.CreatedAt)
+	audit_event = &AuditEvent{}
+	err = obj.driver.QueryRow(__stmt, __id_val, __project_id_val, __actor_id_val, __action_val, __target_val, __source_ip_val, __created_at_val).Scan(&audit_event.Id, &audit_event.ProjectId, &audit_event.ActorId, &audit_event.Action, &audit_event.Target, &audit_event.SourceIp, &audit_event.CreatedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	return registration_token, nil
+	return audit_event, nil
 
 }
 
-func (obj *postgresImpl) Create_ResetPasswordToken(ctx context.Context,
-	reset_password_token_secret ResetPasswordToken_Secret_Field,
-	reset_password_token_owner_id ResetPasswordToken_OwnerId_Field) (
-	reset_password_token *ResetPasswordToken, err error) {
+func (obj *postgresImpl) Create_NotificationPreference(ctx context.Context,
+	notification_preference_user_id NotificationPreference_UserId_Field,
+	notification_preference_usage_thresholds NotificationPreference_UsageThresholds_Field,
+	notification_preference_api_key_created NotificationPreference_ApiKeyCreated_Field,
+	notification_preference_project_member_added NotificationPreference_ProjectMemberAdded_Field) (
+	notification_preference *NotificationPreference, err error) {
 
 	__now := obj.db.Hooks.Now().UTC()
-	__secret_val := reset_password_token_secret.value()
-	__owner_id_val := reset_password_token_owner_id.value()
-	__created_at_val := __now
+	__user_id_val := notification_preference_user_id.value()
+	__usage_thresholds_val := notification_preference_usage_thresholds.value()
+	__api_key_created_val := notification_preference_api_key_created.value()
+	__project_member_added_val := notification_preference_project_member_added.value()
+	__updated_at_val := __now
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO reset_password_tokens ( secret, owner_id, created_at ) VALUES ( ?, ?, ? ) RETURNING reset_password_tokens.secret, reset_password_tokens.owner_id, reset_password_tokens.created_at")
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO notification_preferences ( user_id, usage_thresholds, api_key_created, project_member_added, updated_at ) VALUES ( ?, ?, ?, ?, ? ) RETURNING notification_preferences.user_id, notification_preferences.usage_thresholds, notification_preferences.api_key_created, notification_preferences.project_member_added, notification_preferences.updated_at")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __secret_val, __owner_id_val, __created_at_val)
+	obj.logStmt(__stmt, __user_id_val, __usage_thresholds_val, __api_key_created_val, __project_member_added_val, __updated_at_val)
 
-	reset_password_token = &ResetPasswordToken{}
-	err = obj.driver.QueryRow(__stmt, __secret_val, __owner_id_val, __created_at_val).Scan(&reset_password_token.Secret, &reset_password_token.OwnerId, &reset_password_token.CreatedAt)
+	notification_preference = &NotificationPreference{}
+	err = obj.driver.QueryRow(__stmt, __user_id_val, __usage_thresholds_val, __api_key_created_val, __project_member_added_val, __updated_at_val).Scan(&notification_preference.UserId, &notification_preference.UsageThresholds, &notification_preference.ApiKeyCreated, &notification_preference.ProjectMemberAdded, &notification_preference.UpdatedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	return reset_password_token, nil
+	return notification_preference, nil
 
 }
 
-func (obj *postgresImpl) Create_Offer(ctx context.Context,
-	offer_name Offer_Name_Field,
-	offer_description Offer_Description_Field,
-	offer_type Offer_Type_Field,
-	offer_credit_in_cents Offer_CreditInCents_Field,
-	offer_award_credit_duration_days Offer_AwardCreditDurationDays_Field,
-	offer_invitee_credit_duration_days Offer_InviteeCreditDurationDays_Field,
-	offer_redeemable_cap Offer_RedeemableCap_Field) (
-	offer *Offer, err error) {
+func (obj *postgresImpl) Create_UsageNotification(ctx context.Context,
+	usage_notification_project_id UsageNotification_ProjectId_Field,
+	usage_notification_resource UsageNotification_Resource_Field,
+	usage_notification_threshold UsageNotification_Threshold_Field,
+	usage_notification_period_start UsageNotification_PeriodStart_Field) (
+	usage_notification *UsageNotification, err error) {
 
 	__now := obj.db.Hooks.Now().UTC()
-	__name_val := offer_name.value()
-	__description_val := offer_description.value()
-	__type_val := offer_type.value()
-	__credit_in_cents_val := offer_credit_in_cents.value()
-	__award_credit_duration_days_val := offer_award_credit_duration_days.value()
-	__invitee_credit_duration_days_val := offer_invitee_credit_duration_days.value()
-	__redeemable_cap_val := offer_redeemable_cap.value()
-	__num_redeemed_val := int(0)
-	__expires_at_val := (*time.Time)(nil)
+	__project_id_val := usage_notification_project_id.value()
+	__resource_val := usage_notification_resource.value()
+	__threshold_val := usage_notification_threshold.value()
+	__period_start_val := usage_notification_period_start.value()
 	__created_at_val := __now
-	__status_val := int(0)
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO offers ( name, description, type, credit_in_cents, award_credit_duration_days, invitee_credit_duration_days, redeemable_cap, num_redeemed, expires_at, created_at, status ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? ) RETURNING offers.id, offers.name, offers.description, offers.type, offers.credit_in_cents, offers.award_credit_duration_days, offers.invitee_credit_duration_days, offers.redeemable_cap, offers.num_redeemed, offers.expires_at, offers.created_at, offers.status")
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO usage_notifications ( project_id, resource, threshold, period_start, created_at ) VALUES ( ?, ?, ?, ?, ? ) RETURNING usage_notifications.project_id, usage_notifications.resource, usage_notifications.threshold, usage_notifications.period_start, usage_notifications.created_at")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __name_val, __description_val, __type_val, __credit_in_cents_val, __award_credit_duration_days_val, __invitee_credit_duration_days_val, __redeemable_cap_val, __num_redeemed_val, __expires_at_val, __created_at_val, __status_val)
+	obj.logStmt(__stmt, __project_id_val, __resource_val, __threshold_val, __period_start_val, __created_at_val)
 
-	offer = &Offer{}
-	err = obj.driver.QueryRow(__stmt, __name_val, __description_val, __type_val, __credit_in_cents_val, __award_credit_duration_days_val, __invitee_credit_duration_days_val, __redeemable_cap_val, __num_redeemed_val, __expires_at_val, __created_at_val, __status_val).Scan(&offer.Id, &offer.Name, &offer.Description, &offer.Type, &offer.CreditInCents, &offer.AwardCreditDurationDays, &offer.InviteeCreditDurationDays, &offer.RedeemableCap, &offer.NumRedeemed, &offer.ExpiresAt, &offer.CreatedAt, &offer.Status)
+	usage_notification = &UsageNotification{}
+	err = obj.driver.QueryRow(__stmt, __project_id_val, __resource_val, __threshold_val, __period_start_val, __created_at_val).Scan(&usage_notification.ProjectId, &usage_notification.Resource, &usage_notification.Threshold, &usage_notification.PeriodStart, &usage_notification.CreatedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	return offer, nil
+	return usage_notification, nil
 
 }
 
@@ -4810,7 +7238,7 @@ func (obj *postgresImpl) Get_PendingAudits_By_NodeId(ctx context.Context,
 	pending_audits_node_id PendingAudits_NodeId_Field) (
 	pending_audits *PendingAudits, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT pending_audits.node_id, pending_audits.piece_id, pending_audits.stripe_index, pending_audits.share_size, pending_audits.expected_share_hash, pending_audits.reverify_count FROM pending_audits WHERE pending_audits.node_id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT pending_audits.node_id, pending_audits.piece_id, pending_audits.stripe_index, pending_audits.share_size, pending_audits.expected_share_hash, pending_audits.reverify_count, pending_audits.piece_num, pending_audits.path FROM pending_audits WHERE pending_audits.node_id = ?")
 
 	var __values []interface{}
 	__values = append(__values, pending_audits_node_id.value())
@@ -4819,7 +7247,7 @@ func (obj *postgresImpl) Get_PendingAudits_By_NodeId(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	pending_audits = &PendingAudits{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&pending_audits.NodeId, &pending_audits.PieceId, &pending_audits.StripeIndex, &pending_audits.ShareSize, &pending_audits.ExpectedShareHash, &pending_audits.ReverifyCount)
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&pending_audits.NodeId, &pending_audits.PieceId, &pending_audits.StripeIndex, &pending_audits.ShareSize, &pending_audits.ExpectedShareHash, &pending_audits.ReverifyCount, &pending_audits.PieceNum, &pending_audits.Path)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -4965,7 +7393,7 @@ func (obj *postgresImpl) Get_Node_By_Id(ctx context.Context,
 	node_id Node_Id_Field) (
 	node *Node, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_ip, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.free_bandwidth, nodes.free_disk, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.audit_success_count, nodes.total_audit_count, nodes.audit_success_ratio, nodes.uptime_success_count, nodes.total_uptime_count, nodes.uptime_ratio, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.contained FROM nodes WHERE nodes.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_ip, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.free_bandwidth, nodes.free_disk, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.audit_success_count, nodes.total_audit_count, nodes.audit_success_ratio, nodes.uptime_success_count, nodes.total_uptime_count, nodes.uptime_ratio, nodes.audit_reputation_alpha, nodes.audit_reputation_beta, nodes.uptime_reputation_alpha, nodes.uptime_reputation_beta, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.contained, nodes.disqualified, nodes.last_audited FROM nodes WHERE nodes.id = ?")
 
 	var __values []interface{}
 	__values = append(__values, node_id.value())
@@ -4974,7 +7402,7 @@ func (obj *postgresImpl) Get_Node_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	node = &Node{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&node.Id, &node.Address, &node.LastIp, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.FreeBandwidth, &node.FreeDisk, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.AuditSuccessCount, &node.TotalAuditCount, &node.AuditSuccessRatio, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.UptimeRatio, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Contained)
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&node.Id, &node.Address, &node.LastIp, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.FreeBandwidth, &node.FreeDisk, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.AuditSuccessCount, &node.TotalAuditCount, &node.AuditSuccessRatio, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.UptimeRatio, &node.AuditReputationAlpha, &node.AuditReputationBeta, &node.UptimeReputationAlpha, &node.UptimeReputationBeta, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Contained, &node.Disqualified, &node.LastAudited)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -5019,7 +7447,7 @@ func (obj *postgresImpl) Limited_Node_By_Id_GreaterOrEqual_OrderBy_Asc_Id(ctx co
 	limit int, offset int64) (
 	rows []*Node, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_ip, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.free_bandwidth, nodes.free_disk, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.audit_success_count, nodes.total_audit_count, nodes.audit_success_ratio, nodes.uptime_success_count, nodes.total_uptime_count, nodes.uptime_ratio, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.contained FROM nodes WHERE nodes.id >= ? ORDER BY nodes.id LIMIT ? OFFSET ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_ip, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.free_bandwidth, nodes.free_disk, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.audit_success_count, nodes.total_audit_count, nodes.audit_success_ratio, nodes.uptime_success_count, nodes.total_uptime_count, nodes.uptime_ratio, nodes.audit_reputation_alpha, nodes.audit_reputation_beta, nodes.uptime_reputation_alpha, nodes.uptime_reputation_beta, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.contained, nodes.disqualified, nodes.last_audited FROM nodes WHERE nodes.id >= ? ORDER BY nodes.id LIMIT ? OFFSET ?")
 
 	var __values []interface{}
 	__values = append(__values, node_id_greater_or_equal.value())
@@ -5037,7 +7465,7 @@ func (obj *postgresImpl) Limited_Node_By_Id_GreaterOrEqual_OrderBy_Asc_Id(ctx co
 
 	for __rows.Next() {
 		node := &Node{}
-		err = __rows.Scan(&node.Id, &node.Address, &node.LastIp, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.FreeBandwidth, &node.FreeDisk, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.AuditSuccessCount, &node.TotalAuditCount, &node.AuditSuccessRatio, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.UptimeRatio, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Contained)
+		err = __rows.Scan(&node.Id, &node.Address, &node.LastIp, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.FreeBandwidth, &node.FreeDisk, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.AuditSuccessCount, &node.TotalAuditCount, &node.AuditSuccessRatio, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.UptimeRatio, &node.AuditReputationAlpha, &node.AuditReputationBeta, &node.UptimeReputationAlpha, &node.UptimeReputationBeta, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Contained, &node.Disqualified, &node.LastAudited)
 		if err != nil {
 			return nil, obj.makeErr(err)
 		}
@@ -5054,7 +7482,7 @@ func (obj *postgresImpl) Get_User_By_Email_And_Status_Not_Number(ctx context.Con
 	user_email User_Email_Field) (
 	user *User, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT users.id, users.full_name, users.short_name, users.email, users.password_hash, users.status, users.created_at FROM users WHERE users.email = ? AND users.status != 0 LIMIT 2")
+	var __embed_stmt = __sqlbundle_Literal("SELECT users.id, users.full_name, users.short_name, users.email, users.password_hash, users.status, users.mfa_enabled, users.mfa_secret_key, users.mfa_recovery_codes, users.created_at FROM users WHERE users.email = ? AND users.status != 0 LIMIT 2")
 
 	var __values []interface{}
 	__values = append(__values, user_email.value())
@@ -5076,7 +7504,7 @@ func (obj *postgresImpl) Get_User_By_Email_And_Status_Not_Number(ctx context.Con
 	}
 
 	user = &User{}
-	err = __rows.Scan(&user.Id, &user.FullName, &user.ShortName, &user.Email, &user.PasswordHash, &user.Status, &user.CreatedAt)
+	err = __rows.Scan(&user.Id, &user.FullName, &user.ShortName, &user.Email, &user.PasswordHash, &user.Status, &user.MfaEnabled, &user.MfaSecretKey, &user.MfaRecoveryCodes, &user.CreatedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -5097,7 +7525,7 @@ func (obj *postgresImpl) Get_User_By_Id(ctx context.Context,
 	user_id User_Id_Field) (
 	user *User, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT users.id, users.full_name, users.short_name, users.email, users.password_hash, users.status, users.created_at FROM users WHERE users.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT users.id, users.full_name, users.short_name, users.email, users.password_hash, users.status, users.mfa_enabled, users.mfa_secret_key, users.mfa_recovery_codes, users.created_at FROM users WHERE users.id = ?")
 
 	var __values []interface{}
 	__values = append(__values, user_id.value())
@@ -5106,7 +7534,7 @@ func (obj *postgresImpl) Get_User_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	user = &User{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&user.Id, &user.FullName, &user.ShortName, &user.Email, &user.PasswordHash, &user.Status, &user.CreatedAt)
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&user.Id, &user.FullName, &user.ShortName, &user.Email, &user.PasswordHash, &user.Status, &user.MfaEnabled, &user.MfaSecretKey, &user.MfaRecoveryCodes, &user.CreatedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -5117,7 +7545,7 @@ func (obj *postgresImpl) Get_User_By_Id(ctx context.Context,
 func (obj *postgresImpl) All_Project(ctx context.Context) (
 	rows []*Project, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.created_at FROM projects")
+	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.storage_limit, projects.egress_limit, projects.created_at FROM projects")
 
 	var __values []interface{}
 	__values = append(__values)
@@ -5133,7 +7561,7 @@ func (obj *postgresImpl) All_Project(ctx context.Context) (
 
 	for __rows.Next() {
 		project := &Project{}
-		err = __rows.Scan(&project.Id, &project.Name, &project.Description, &project.CreatedAt)
+		err = __rows.Scan(&project.Id, &project.Name, &project.Description, &project.StorageLimit, &project.EgressLimit, &project.CreatedAt)
 		if err != nil {
 			return nil, obj.makeErr(err)
 		}
@@ -5150,7 +7578,7 @@ func (obj *postgresImpl) Get_Project_By_Id(ctx context.Context,
 	project_id Project_Id_Field) (
 	project *Project, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.created_at FROM projects WHERE projects.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.storage_limit, projects.egress_limit, projects.created_at FROM projects WHERE projects.id = ?")
 
 	var __values []interface{}
 	__values = append(__values, project_id.value())
@@ -5159,7 +7587,7 @@ func (obj *postgresImpl) Get_Project_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	project = &Project{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&project.Id, &project.Name, &project.Description, &project.CreatedAt)
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&project.Id, &project.Name, &project.Description, &project.StorageLimit, &project.EgressLimit, &project.CreatedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -5171,7 +7599,7 @@ func (obj *postgresImpl) All_Project_By_ProjectMember_MemberId_OrderBy_Asc_Proje
 	project_member_member_id ProjectMember_MemberId_Field) (
 	rows []*Project, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.created_at FROM projects  JOIN project_members ON projects.id = project_members.project_id WHERE project_members.member_id = ? ORDER BY projects.name")
+	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.storage_limit, projects.egress_limit, projects.created_at FROM projects  JOIN project_members ON projects.id = project_members.project_id WHERE project_members.member_id = ? ORDER BY projects.name")
 
 	var __values []interface{}
 	__values = append(__values, project_member_member_id.value())
@@ -5187,7 +7615,7 @@ func (obj *postgresImpl) All_Project_By_ProjectMember_MemberId_OrderBy_Asc_Proje
 
 	for __rows.Next() {
 		project := &Project{}
-		err = __rows.Scan(&project.Id, &project.Name, &project.Description, &project.CreatedAt)
+		err = __rows.Scan(&project.Id, &project.Name, &project.Description, &project.StorageLimit, &project.EgressLimit, &project.CreatedAt)
 		if err != nil {
 			return nil, obj.makeErr(err)
 		}
@@ -5204,7 +7632,7 @@ func (obj *postgresImpl) All_ProjectMember_By_MemberId(ctx context.Context,
 	project_member_member_id ProjectMember_MemberId_Field) (
 	rows []*ProjectMember, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT project_members.member_id, project_members.project_id, project_members.created_at FROM project_members WHERE project_members.member_id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_members.member_id, project_members.project_id, project_members.role, project_members.created_at FROM project_members WHERE project_members.member_id = ?")
 
 	var __values []interface{}
 	__values = append(__values, project_member_member_id.value())
@@ -5220,7 +7648,7 @@ func (obj *postgresImpl) All_ProjectMember_By_MemberId(ctx context.Context,
 
 	for __rows.Next() {
 		project_member := &ProjectMember{}
-		err = __rows.Scan(&project_member.MemberId, &project_member.ProjectId, &project_member.CreatedAt)
+		err = __rows.Scan(&project_member.MemberId, &project_member.ProjectId, &project_member.Role, &project_member.CreatedAt)
 		if err != nil {
 			return nil, obj.makeErr(err)
 		}
@@ -5238,7 +7666,7 @@ func (obj *postgresImpl) Limited_ProjectMember_By_ProjectId(ctx context.Context,
 	limit int, offset int64) (
 	rows []*ProjectMember, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT project_members.member_id, project_members.project_id, project_members.created_at FROM project_members WHERE project_members.project_id = ? LIMIT ? OFFSET ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_members.member_id, project_members.project_id, project_members.role, project_members.created_at FROM project_members WHERE project_members.project_id = ? LIMIT ? OFFSET ?")
 
 	var __values []interface{}
 	__values = append(__values, project_member_project_id.value())
@@ -5256,7 +7684,7 @@ func (obj *postgresImpl) Limited_ProjectMember_By_ProjectId(ctx context.Context,
 
 	for __rows.Next() {
 		project_member := &ProjectMember{}
-		err = __rows.Scan(&project_member.MemberId, &project_member.ProjectId, &project_member.CreatedAt)
+		err = __rows.Scan(&project_member.MemberId, &project_member.ProjectId, &project_member.Role, &project_member.CreatedAt)
 		if err != nil {
 			return nil, obj.makeErr(err)
 		}
@@ -5273,7 +7701,7 @@ func (obj *postgresImpl) Get_ApiKey_By_Id(ctx context.Context,
 	api_key_id ApiKey_Id_Field) (
 	api_key *ApiKey, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT api_keys.id, api_keys.project_id, api_keys.key, api_keys.name, api_keys.created_at FROM api_keys WHERE api_keys.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT api_keys.id, api_keys.project_id, api_keys.head, api_keys.name, api_keys.secret, api_keys.caveat, api_keys.created_at FROM api_keys WHERE api_keys.id = ?")
 
 	var __values []interface{}
 	__values = append(__values, api_key_id.value())
@@ -5282,7 +7710,7 @@ func (obj *postgresImpl) Get_ApiKey_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	api_key = &ApiKey{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&api_key.Id, &api_key.ProjectId, &api_key.Key, &api_key.Name, &api_key.CreatedAt)
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&api_key.Id, &api_key.ProjectId, &api_key.Head, &api_key.Name, &api_key.Secret, &api_key.Caveat, &api_key.CreatedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -5290,20 +7718,20 @@ func (obj *postgresImpl) Get_ApiKey_By_Id(ctx context.Context,
 
 }
 
-func (obj *postgresImpl) Get_ApiKey_By_Key(ctx context.Context,
-	api_key_key ApiKey_Key_Field) (
+func (obj *postgresImpl) Get_ApiKey_By_Head(ctx context.Context,
+	api_key_head ApiKey_Head_Field) (
 	api_key *ApiKey, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT api_keys.id, api_keys.project_id, api_keys.key, api_keys.name, api_keys.created_at FROM api_keys WHERE api_keys.key = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT api_keys.id, api_keys.project_id, api_keys.head, api_keys.name, api_keys.secret, api_keys.caveat, api_keys.created_at FROM api_keys WHERE api_keys.head = ?")
 
 	var __values []interface{}
-	__values = append(__values, api_key_key.value())
+	__values = append(__values, api_key_head.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
 	api_key = &ApiKey{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&api_key.Id, &api_key.ProjectId, &api_key.Key, &api_key.Name, &api_key.CreatedAt)
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&api_key.Id, &api_key.ProjectId, &api_key.Head, &api_key.Name, &api_key.Secret, &api_key.Caveat, &api_key.CreatedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -5315,7 +7743,7 @@ func (obj *postgresImpl) All_ApiKey_By_ProjectId_OrderBy_Asc_Name(ctx context.Co
 	api_key_project_id ApiKey_ProjectId_Field) (
 	rows []*ApiKey, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT api_keys.id, api_keys.project_id, api_keys.key, api_keys.name, api_keys.created_at FROM api_keys WHERE api_keys.project_id = ? ORDER BY api_keys.name")
+	var __embed_stmt = __sqlbundle_Literal("SELECT api_keys.id, api_keys.project_id, api_keys.head, api_keys.name, api_keys.secret, api_keys.caveat, api_keys.created_at FROM api_keys WHERE api_keys.project_id = ? ORDER BY api_keys.name")
 
 	var __values []interface{}
 	__values = append(__values, api_key_project_id.value())
@@ -5331,7 +7759,7 @@ func (obj *postgresImpl) All_ApiKey_By_ProjectId_OrderBy_Asc_Name(ctx context.Co
 
 	for __rows.Next() {
 		api_key := &ApiKey{}
-		err = __rows.Scan(&api_key.Id, &api_key.ProjectId, &api_key.Key, &api_key.Name, &api_key.CreatedAt)
+		err = __rows.Scan(&api_key.Id, &api_key.ProjectId, &api_key.Head, &api_key.Name, &api_key.Secret, &api_key.Caveat, &api_key.CreatedAt)
 		if err != nil {
 			return nil, obj.makeErr(err)
 		}
@@ -5748,128 +8176,375 @@ func (obj *postgresImpl) Get_CertRecord_By_Id(ctx context.Context,
 
 }
 
-func (obj *postgresImpl) Get_RegistrationToken_By_Secret(ctx context.Context,
-	registration_token_secret RegistrationToken_Secret_Field) (
-	registration_token *RegistrationToken, err error) {
+func (obj *postgresImpl) Get_RegistrationToken_By_Secret(ctx context.Context,
+	registration_token_secret RegistrationToken_Secret_Field) (
+	registration_token *RegistrationToken, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT registration_tokens.secret, registration_tokens.owner_id, registration_tokens.project_limit, registration_tokens.created_at FROM registration_tokens WHERE registration_tokens.secret = ?")
+
+	var __values []interface{}
+	__values = append(__values, registration_token_secret.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	registration_token = &RegistrationToken{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&registration_token.Secret, &registration_token.OwnerId, &registration_token.ProjectLimit, &registration_token.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return registration_token, nil
+
+}
+
+func (obj *postgresImpl) Get_RegistrationToken_By_OwnerId(ctx context.Context,
+	registration_token_owner_id RegistrationToken_OwnerId_Field) (
+	registration_token *RegistrationToken, err error) {
+
+	var __cond_0 = &__sqlbundle_Condition{Left: "registration_tokens.owner_id", Equal: true, Right: "?", Null: true}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("SELECT registration_tokens.secret, registration_tokens.owner_id, registration_tokens.project_limit, registration_tokens.created_at FROM registration_tokens WHERE "), __cond_0}}
+
+	var __values []interface{}
+	__values = append(__values)
+
+	if !registration_token_owner_id.isnull() {
+		__cond_0.Null = false
+		__values = append(__values, registration_token_owner_id.value())
+	}
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	registration_token = &RegistrationToken{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&registration_token.Secret, &registration_token.OwnerId, &registration_token.ProjectLimit, &registration_token.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return registration_token, nil
+
+}
+
+func (obj *postgresImpl) Get_ResetPasswordToken_By_Secret(ctx context.Context,
+	reset_password_token_secret ResetPasswordToken_Secret_Field) (
+	reset_password_token *ResetPasswordToken, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT reset_password_tokens.secret, reset_password_tokens.owner_id, reset_password_tokens.created_at FROM reset_password_tokens WHERE reset_password_tokens.secret = ?")
+
+	var __values []interface{}
+	__values = append(__values, reset_password_token_secret.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	reset_password_token = &ResetPasswordToken{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&reset_password_token.Secret, &reset_password_token.OwnerId, &reset_password_token.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return reset_password_token, nil
+
+}
+
+func (obj *postgresImpl) Get_ResetPasswordToken_By_OwnerId(ctx context.Context,
+	reset_password_token_owner_id ResetPasswordToken_OwnerId_Field) (
+	reset_password_token *ResetPasswordToken, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT reset_password_tokens.secret, reset_password_tokens.owner_id, reset_password_tokens.created_at FROM reset_password_tokens WHERE reset_password_tokens.owner_id = ?")
+
+	var __values []interface{}
+	__values = append(__values, reset_password_token_owner_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	reset_password_token = &ResetPasswordToken{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&reset_password_token.Secret, &reset_password_token.OwnerId, &reset_password_token.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return reset_password_token, nil
+
+}
+
+func (obj *postgresImpl) Get_Offer_By_Id(ctx context.Context,
+	offer_id Offer_Id_Field) (
+	offer *Offer, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT offers.id, offers.name, offers.description, offers.type, offers.credit_in_cents, offers.award_credit_duration_days, offers.invitee_credit_duration_days, offers.redeemable_cap, offers.num_redeemed, offers.expires_at, offers.created_at, offers.status FROM offers WHERE offers.id = ?")
+
+	var __values []interface{}
+	__values = append(__values, offer_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	offer = &Offer{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&offer.Id, &offer.Name, &offer.Description, &offer.Type, &offer.CreditInCents, &offer.AwardCreditDurationDays, &offer.InviteeCreditDurationDays, &offer.RedeemableCap, &offer.NumRedeemed, &offer.ExpiresAt, &offer.CreatedAt, &offer.Status)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return offer, nil
+
+}
+
+func (obj *postgresImpl) Get_Offer_By_Status_And_Type_And_ExpiresAt_GreaterOrEqual(ctx context.Context,
+	offer_status Offer_Status_Field,
+	offer_type Offer_Type_Field,
+	offer_expires_at_greater_or_equal Offer_ExpiresAt_Field) (
+	offer *Offer, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT offers.id, offers.name, offers.description, offers.type, offers.credit_in_cents, offers.award_credit_duration_days, offers.invitee_credit_duration_days, offers.redeemable_cap, offers.num_redeemed, offers.expires_at, offers.created_at, offers.status FROM offers WHERE offers.status = ? AND offers.type = ? AND offers.expires_at >= ? LIMIT 2")
+
+	var __values []interface{}
+	__values = append(__values, offer_status.value(), offer_type.value(), offer_expires_at_greater_or_equal.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	if !__rows.Next() {
+		if err := __rows.Err(); err != nil {
+			return nil, obj.makeErr(err)
+		}
+		return nil, makeErr(sql.ErrNoRows)
+	}
+
+	offer = &Offer{}
+	err = __rows.Scan(&offer.Id, &offer.Name, &offer.Description, &offer.Type, &offer.CreditInCents, &offer.AwardCreditDurationDays, &offer.InviteeCreditDurationDays, &offer.RedeemableCap, &offer.NumRedeemed, &offer.ExpiresAt, &offer.CreatedAt, &offer.Status)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+
+	if __rows.Next() {
+		return nil, tooManyRows("Offer_By_Status_And_Type_And_ExpiresAt_GreaterOrEqual")
+	}
+
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+
+	return offer, nil
+
+}
+
+func (obj *postgresImpl) All_Offer(ctx context.Context) (
+	rows []*Offer, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT offers.id, offers.name, offers.description, offers.type, offers.credit_in_cents, offers.award_credit_duration_days, offers.invitee_credit_duration_days, offers.redeemable_cap, offers.num_redeemed, offers.expires_at, offers.created_at, offers.status FROM offers")
+
+	var __values []interface{}
+	__values = append(__values)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		offer := &Offer{}
+		err = __rows.Scan(&offer.Id, &offer.Name, &offer.Description, &offer.Type, &offer.CreditInCents, &offer.AwardCreditDurationDays, &offer.InviteeCreditDurationDays, &offer.RedeemableCap, &offer.NumRedeemed, &offer.ExpiresAt, &offer.CreatedAt, &offer.Status)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, offer)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *postgresImpl) Get_Invoice_By_Id(ctx context.Context,
+	invoice_id Invoice_Id_Field) (
+	invoice *Invoice, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT invoices.id, invoices.project_id, invoices.period_start, invoices.period_end, invoices.storage, invoices.egress, invoices.object_count, invoices.segment_count, invoices.storage_amount, invoices.egress_amount, invoices.object_amount, invoices.segment_amount, invoices.total, invoices.created_at FROM invoices WHERE invoices.id = ?")
+
+	var __values []interface{}
+	__values = append(__values, invoice_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	invoice = &Invoice{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&invoice.Id, &invoice.ProjectId, &invoice.PeriodStart, &invoice.PeriodEnd, &invoice.Storage, &invoice.Egress, &invoice.ObjectCount, &invoice.SegmentCount, &invoice.StorageAmount, &invoice.EgressAmount, &invoice.ObjectAmount, &invoice.SegmentAmount, &invoice.Total, &invoice.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return invoice, nil
+
+}
+
+func (obj *postgresImpl) Find_Invoice_By_ProjectId_And_PeriodStart(ctx context.Context,
+	invoice_project_id Invoice_ProjectId_Field,
+	invoice_period_start Invoice_PeriodStart_Field) (
+	invoice *Invoice, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT registration_tokens.secret, registration_tokens.owner_id, registration_tokens.project_limit, registration_tokens.created_at FROM registration_tokens WHERE registration_tokens.secret = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT invoices.id, invoices.project_id, invoices.period_start, invoices.period_end, invoices.storage, invoices.egress, invoices.object_count, invoices.segment_count, invoices.storage_amount, invoices.egress_amount, invoices.object_amount, invoices.segment_amount, invoices.total, invoices.created_at FROM invoices WHERE invoices.project_id = ? AND invoices.period_start = ?")
 
 	var __values []interface{}
-	__values = append(__values, registration_token_secret.value())
+	__values = append(__values, invoice_project_id.value())
+	__values = append(__values, invoice_period_start.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	registration_token = &RegistrationToken{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&registration_token.Secret, &registration_token.OwnerId, &registration_token.ProjectLimit, &registration_token.CreatedAt)
+	invoice = &Invoice{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&invoice.Id, &invoice.ProjectId, &invoice.PeriodStart, &invoice.PeriodEnd, &invoice.Storage, &invoice.Egress, &invoice.ObjectCount, &invoice.SegmentCount, &invoice.StorageAmount, &invoice.EgressAmount, &invoice.ObjectAmount, &invoice.SegmentAmount, &invoice.Total, &invoice.CreatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	return registration_token, nil
+	return invoice, nil
 
 }
 
-func (obj *postgresImpl) Get_RegistrationToken_By_OwnerId(ctx context.Context,
-	registration_token_owner_id RegistrationToken_OwnerId_Field) (
-	registration_token *RegistrationToken, err error) {
-
-	var __cond_0 = &__sqlbundle_Condition{Left: "registration_tokens.owner_id", Equal: true, Right: "?", Null: true}
+func (obj *postgresImpl) All_Invoice_By_ProjectId_OrderBy_Desc_PeriodStart(ctx context.Context,
+	invoice_project_id Invoice_ProjectId_Field) (
+	rows []*Invoice, err error) {
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("SELECT registration_tokens.secret, registration_tokens.owner_id, registration_tokens.project_limit, registration_tokens.created_at FROM registration_tokens WHERE "), __cond_0}}
+	var __embed_stmt = __sqlbundle_Literal("SELECT invoices.id, invoices.project_id, invoices.period_start, invoices.period_end, invoices.storage, invoices.egress, invoices.object_count, invoices.segment_count, invoices.storage_amount, invoices.egress_amount, invoices.object_amount, invoices.segment_amount, invoices.total, invoices.created_at FROM invoices WHERE invoices.project_id = ? ORDER BY invoices.period_start DESC")
 
 	var __values []interface{}
-	__values = append(__values)
-
-	if !registration_token_owner_id.isnull() {
-		__cond_0.Null = false
-		__values = append(__values, registration_token_owner_id.value())
-	}
+	__values = append(__values, invoice_project_id.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	registration_token = &RegistrationToken{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&registration_token.Secret, &registration_token.OwnerId, &registration_token.ProjectLimit, &registration_token.CreatedAt)
+	__rows, err := obj.driver.Query(__stmt, __values...)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	return registration_token, nil
+	defer __rows.Close()
+
+	for __rows.Next() {
+		invoice := &Invoice{}
+		err = __rows.Scan(&invoice.Id, &invoice.ProjectId, &invoice.PeriodStart, &invoice.PeriodEnd, &invoice.Storage, &invoice.Egress, &invoice.ObjectCount, &invoice.SegmentCount, &invoice.StorageAmount, &invoice.EgressAmount, &invoice.ObjectAmount, &invoice.SegmentAmount, &invoice.Total, &invoice.CreatedAt)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, invoice)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
 
 }
 
-func (obj *postgresImpl) Get_ResetPasswordToken_By_Secret(ctx context.Context,
-	reset_password_token_secret ResetPasswordToken_Secret_Field) (
-	reset_password_token *ResetPasswordToken, err error) {
+func (obj *postgresImpl) All_Invoice_By_PeriodStart(ctx context.Context,
+	invoice_period_start Invoice_PeriodStart_Field) (
+	rows []*Invoice, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT reset_password_tokens.secret, reset_password_tokens.owner_id, reset_password_tokens.created_at FROM reset_password_tokens WHERE reset_password_tokens.secret = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT invoices.id, invoices.project_id, invoices.period_start, invoices.period_end, invoices.storage, invoices.egress, invoices.object_count, invoices.segment_count, invoices.storage_amount, invoices.egress_amount, invoices.object_amount, invoices.segment_amount, invoices.total, invoices.created_at FROM invoices WHERE invoices.period_start = ?")
 
 	var __values []interface{}
-	__values = append(__values, reset_password_token_secret.value())
+	__values = append(__values, invoice_period_start.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	reset_password_token = &ResetPasswordToken{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&reset_password_token.Secret, &reset_password_token.OwnerId, &reset_password_token.CreatedAt)
+	__rows, err := obj.driver.Query(__stmt, __values...)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	return reset_password_token, nil
+	defer __rows.Close()
+
+	for __rows.Next() {
+		invoice := &Invoice{}
+		err = __rows.Scan(&invoice.Id, &invoice.ProjectId, &invoice.PeriodStart, &invoice.PeriodEnd, &invoice.Storage, &invoice.Egress, &invoice.ObjectCount, &invoice.SegmentCount, &invoice.StorageAmount, &invoice.EgressAmount, &invoice.ObjectAmount, &invoice.SegmentAmount, &invoice.Total, &invoice.CreatedAt)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, invoice)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
 
 }
 
-func (obj *postgresImpl) Get_ResetPasswordToken_By_OwnerId(ctx context.Context,
-	reset_password_token_owner_id ResetPasswordToken_OwnerId_Field) (
-	reset_password_token *ResetPasswordToken, err error) {
+func (obj *postgresImpl) Find_PayoutStatement_By_NodeId_And_PeriodStart(ctx context.Context,
+	payout_statement_node_id PayoutStatement_NodeId_Field,
+	payout_statement_period_start PayoutStatement_PeriodStart_Field) (
+	payout_statement *PayoutStatement, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT reset_password_tokens.secret, reset_password_tokens.owner_id, reset_password_tokens.created_at FROM reset_password_tokens WHERE reset_password_tokens.owner_id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT payout_statements.node_id, payout_statements.period_start, payout_statements.period_end, payout_statements.node_created_at, payout_statements.wallet, payout_statements.at_rest_total, payout_statements.get_total, payout_statements.get_repair_total, payout_statements.get_audit_total, payout_statements.put_total, payout_statements.put_repair_total, payout_statements.at_rest_amount, payout_statements.get_amount, payout_statements.repair_amount, payout_statements.audit_amount, payout_statements.earned, payout_statements.held_percent, payout_statements.held, payout_statements.disqualified, payout_statements.paid, payout_statements.created_at FROM payout_statements WHERE payout_statements.node_id = ? AND payout_statements.period_start = ?")
 
 	var __values []interface{}
-	__values = append(__values, reset_password_token_owner_id.value())
+	__values = append(__values, payout_statement_node_id.value())
+	__values = append(__values, payout_statement_period_start.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	reset_password_token = &ResetPasswordToken{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&reset_password_token.Secret, &reset_password_token.OwnerId, &reset_password_token.CreatedAt)
+	payout_statement = &PayoutStatement{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&payout_statement.NodeId, &payout_statement.PeriodStart, &payout_statement.PeriodEnd, &payout_statement.NodeCreatedAt, &payout_statement.Wallet, &payout_statement.AtRestTotal, &payout_statement.GetTotal, &payout_statement.GetRepairTotal, &payout_statement.GetAuditTotal, &payout_statement.PutTotal, &payout_statement.PutRepairTotal, &payout_statement.AtRestAmount, &payout_statement.GetAmount, &payout_statement.RepairAmount, &payout_statement.AuditAmount, &payout_statement.Earned, &payout_statement.HeldPercent, &payout_statement.Held, &payout_statement.Disqualified, &payout_statement.Paid, &payout_statement.CreatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	return reset_password_token, nil
+	return payout_statement, nil
 
 }
 
-func (obj *postgresImpl) Get_Offer_By_Id(ctx context.Context,
-	offer_id Offer_Id_Field) (
-	offer *Offer, err error) {
+func (obj *postgresImpl) All_PayoutStatement_By_NodeId_OrderBy_Desc_PeriodStart(ctx context.Context,
+	payout_statement_node_id PayoutStatement_NodeId_Field) (
+	rows []*PayoutStatement, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT offers.id, offers.name, offers.description, offers.type, offers.credit_in_cents, offers.award_credit_duration_days, offers.invitee_credit_duration_days, offers.redeemable_cap, offers.num_redeemed, offers.expires_at, offers.created_at, offers.status FROM offers WHERE offers.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT payout_statements.node_id, payout_statements.period_start, payout_statements.period_end, payout_statements.node_created_at, payout_statements.wallet, payout_statements.at_rest_total, payout_statements.get_total, payout_statements.get_repair_total, payout_statements.get_audit_total, payout_statements.put_total, payout_statements.put_repair_total, payout_statements.at_rest_amount, payout_statements.get_amount, payout_statements.repair_amount, payout_statements.audit_amount, payout_statements.earned, payout_statements.held_percent, payout_statements.held, payout_statements.disqualified, payout_statements.paid, payout_statements.created_at FROM payout_statements WHERE payout_statements.node_id = ? ORDER BY payout_statements.period_start DESC")
 
 	var __values []interface{}
-	__values = append(__values, offer_id.value())
+	__values = append(__values, payout_statement_node_id.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	offer = &Offer{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&offer.Id, &offer.Name, &offer.Description, &offer.Type, &offer.CreditInCents, &offer.AwardCreditDurationDays, &offer.InviteeCreditDurationDays, &offer.RedeemableCap, &offer.NumRedeemed, &offer.ExpiresAt, &offer.CreatedAt, &offer.Status)
+	__rows, err := obj.driver.Query(__stmt, __values...)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	return offer, nil
+	defer __rows.Close()
+
+	for __rows.Next() {
+		payout_statement := &PayoutStatement{}
+		err = __rows.Scan(&payout_statement.NodeId, &payout_statement.PeriodStart, &payout_statement.PeriodEnd, &payout_statement.NodeCreatedAt, &payout_statement.Wallet, &payout_statement.AtRestTotal, &payout_statement.GetTotal, &payout_statement.GetRepairTotal, &payout_statement.GetAuditTotal, &payout_statement.PutTotal, &payout_statement.PutRepairTotal, &payout_statement.AtRestAmount, &payout_statement.GetAmount, &payout_statement.RepairAmount, &payout_statement.AuditAmount, &payout_statement.Earned, &payout_statement.HeldPercent, &payout_statement.Held, &payout_statement.Disqualified, &payout_statement.Paid, &payout_statement.CreatedAt)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, payout_statement)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
 
 }
 
-func (obj *postgresImpl) Get_Offer_By_Status_And_Type_And_ExpiresAt_GreaterOrEqual(ctx context.Context,
-	offer_status Offer_Status_Field,
-	offer_type Offer_Type_Field,
-	offer_expires_at_greater_or_equal Offer_ExpiresAt_Field) (
-	offer *Offer, err error) {
+func (obj *postgresImpl) All_PayoutStatement_By_PeriodStart_OrderBy_Asc_NodeId(ctx context.Context,
+	payout_statement_period_start PayoutStatement_PeriodStart_Field) (
+	rows []*PayoutStatement, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT offers.id, offers.name, offers.description, offers.type, offers.credit_in_cents, offers.award_credit_duration_days, offers.invitee_credit_duration_days, offers.redeemable_cap, offers.num_redeemed, offers.expires_at, offers.created_at, offers.status FROM offers WHERE offers.status = ? AND offers.type = ? AND offers.expires_at >= ? LIMIT 2")
+	var __embed_stmt = __sqlbundle_Literal("SELECT payout_statements.node_id, payout_statements.period_start, payout_statements.period_end, payout_statements.node_created_at, payout_statements.wallet, payout_statements.at_rest_total, payout_statements.get_total, payout_statements.get_repair_total, payout_statements.get_audit_total, payout_statements.put_total, payout_statements.put_repair_total, payout_statements.at_rest_amount, payout_statements.get_amount, payout_statements.repair_amount, payout_statements.audit_amount, payout_statements.earned, payout_statements.held_percent, payout_statements.held, payout_statements.disqualified, payout_statements.paid, payout_statements.created_at FROM payout_statements WHERE payout_statements.period_start = ? ORDER BY payout_statements.node_id")
 
 	var __values []interface{}
-	__values = append(__values, offer_status.value(), offer_type.value(), offer_expires_at_greater_or_equal.value())
+	__values = append(__values, payout_statement_period_start.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
@@ -5880,38 +8555,65 @@ func (obj *postgresImpl) Get_Offer_By_Status_And_Type_And_ExpiresAt_GreaterOrEqu
 	}
 	defer __rows.Close()
 
-	if !__rows.Next() {
-		if err := __rows.Err(); err != nil {
+	for __rows.Next() {
+		payout_statement := &PayoutStatement{}
+		err = __rows.Scan(&payout_statement.NodeId, &payout_statement.PeriodStart, &payout_statement.PeriodEnd, &payout_statement.NodeCreatedAt, &payout_statement.Wallet, &payout_statement.AtRestTotal, &payout_statement.GetTotal, &payout_statement.GetRepairTotal, &payout_statement.GetAuditTotal, &payout_statement.PutTotal, &payout_statement.PutRepairTotal, &payout_statement.AtRestAmount, &payout_statement.GetAmount, &payout_statement.RepairAmount, &payout_statement.AuditAmount, &payout_statement.Earned, &payout_statement.HeldPercent, &payout_statement.Held, &payout_statement.Disqualified, &payout_statement.Paid, &payout_statement.CreatedAt)
+		if err != nil {
 			return nil, obj.makeErr(err)
 		}
-		return nil, makeErr(sql.ErrNoRows)
+		rows = append(rows, payout_statement)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
 	}
+	return rows, nil
 
-	offer = &Offer{}
-	err = __rows.Scan(&offer.Id, &offer.Name, &offer.Description, &offer.Type, &offer.CreditInCents, &offer.AwardCreditDurationDays, &offer.InviteeCreditDurationDays, &offer.RedeemableCap, &offer.NumRedeemed, &offer.ExpiresAt, &offer.CreatedAt, &offer.Status)
+}
+
+func (obj *postgresImpl) All_ApiKeyRevocation_By_ApiKeyId(ctx context.Context,
+	api_key_revocation_api_key_id ApiKeyRevocation_ApiKeyId_Field) (
+	rows []*ApiKeyRevocation, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT api_key_revocations.api_key_id, api_key_revocations.tail, api_key_revocations.created_at FROM api_key_revocations WHERE api_key_revocations.api_key_id = ?")
+
+	var __values []interface{}
+	__values = append(__values, api_key_revocation_api_key_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
+	defer __rows.Close()
 
-	if __rows.Next() {
-		return nil, tooManyRows("Offer_By_Status_And_Type_And_ExpiresAt_GreaterOrEqual")
+	for __rows.Next() {
+		api_key_revocation := &ApiKeyRevocation{}
+		err = __rows.Scan(&api_key_revocation.ApiKeyId, &api_key_revocation.Tail, &api_key_revocation.CreatedAt)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, api_key_revocation)
 	}
-
 	if err := __rows.Err(); err != nil {
 		return nil, obj.makeErr(err)
 	}
-
-	return offer, nil
+	return rows, nil
 
 }
 
-func (obj *postgresImpl) All_Offer(ctx context.Context) (
-	rows []*Offer, err error) {
+func (obj *postgresImpl) Limited_AuditEvent_By_ProjectId_OrderBy_Desc_CreatedAt(ctx context.Context,
+	audit_event_project_id AuditEvent_ProjectId_Field,
+	limit int, offset int64) (
+	rows []*AuditEvent, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT offers.id, offers.name, offers.description, offers.type, offers.credit_in_cents, offers.award_credit_duration_days, offers.invitee_credit_duration_days, offers.redeemable_cap, offers.num_redeemed, offers.expires_at, offers.created_at, offers.status FROM offers")
+	var __embed_stmt = __sqlbundle_Literal("SELECT audit_events.id, audit_events.project_id, audit_events.actor_id, audit_events.action, audit_events.target, audit_events.source_ip, audit_events.created_at FROM audit_events WHERE audit_events.project_id = ? ORDER BY audit_events.created_at DESC LIMIT ? OFFSET ?")
 
 	var __values []interface{}
-	__values = append(__values)
+	__values = append(__values, audit_event_project_id.value())
+
+	__values = append(__values, limit, offset)
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
@@ -5923,12 +8625,12 @@ func (obj *postgresImpl) All_Offer(ctx context.Context) (
 	defer __rows.Close()
 
 	for __rows.Next() {
-		offer := &Offer{}
-		err = __rows.Scan(&offer.Id, &offer.Name, &offer.Description, &offer.Type, &offer.CreditInCents, &offer.AwardCreditDurationDays, &offer.InviteeCreditDurationDays, &offer.RedeemableCap, &offer.NumRedeemed, &offer.ExpiresAt, &offer.CreatedAt, &offer.Status)
+		audit_event := &AuditEvent{}
+		err = __rows.Scan(&audit_event.Id, &audit_event.ProjectId, &audit_event.ActorId, &audit_event.Action, &audit_event.Target, &audit_event.SourceIp, &audit_event.CreatedAt)
 		if err != nil {
 			return nil, obj.makeErr(err)
 		}
-		rows = append(rows, offer)
+		rows = append(rows, audit_event)
 	}
 	if err := __rows.Err(); err != nil {
 		return nil, obj.makeErr(err)
@@ -5937,13 +8639,63 @@ func (obj *postgresImpl) All_Offer(ctx context.Context) (
 
 }
 
+func (obj *postgresImpl) Find_NotificationPreference_By_UserId(ctx context.Context,
+	notification_preference_user_id NotificationPreference_UserId_Field) (
+	notification_preference *NotificationPreference, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT notification_preferences.user_id, notification_preferences.usage_thresholds, notification_preferences.api_key_created, notification_preferences.project_member_added, notification_preferences.updated_at FROM notification_preferences WHERE notification_preferences.user_id = ?")
+
+	var __values []interface{}
+	__values = append(__values, notification_preference_user_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	notification_preference = &NotificationPreference{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&notification_preference.UserId, &notification_preference.UsageThresholds, &notification_preference.ApiKeyCreated, &notification_preference.ProjectMemberAdded, &notification_preference.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return notification_preference, nil
+
+}
+
+func (obj *postgresImpl) Has_UsageNotification_By_ProjectId_And_Resource_And_Threshold_And_PeriodStart(ctx context.Context,
+	usage_notification_project_id UsageNotification_ProjectId_Field,
+	usage_notification_resource UsageNotification_Resource_Field,
+	usage_notification_threshold UsageNotification_Threshold_Field,
+	usage_notification_period_start UsageNotification_PeriodStart_Field) (
+	has bool, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT EXISTS( SELECT 1 FROM usage_notifications WHERE usage_notifications.project_id = ? AND usage_notifications.resource = ? AND usage_notifications.threshold = ? AND usage_notifications.period_start = ? )")
+
+	var __values []interface{}
+	__values = append(__values, usage_notification_project_id.value())
+	__values = append(__values, usage_notification_resource.value())
+	__values = append(__values, usage_notification_threshold.value())
+	__values = append(__values, usage_notification_period_start.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&has)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+	return has, nil
+
+}
+
 func (obj *postgresImpl) Update_PendingAudits_By_NodeId(ctx context.Context,
 	pending_audits_node_id PendingAudits_NodeId_Field,
 	update PendingAudits_Update_Fields) (
 	pending_audits *PendingAudits, err error) {
 	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE pending_audits SET "), __sets, __sqlbundle_Literal(" WHERE pending_audits.node_id = ? RETURNING pending_audits.node_id, pending_audits.piece_id, pending_audits.stripe_index, pending_audits.share_size, pending_audits.expected_share_hash, pending_audits.reverify_count")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE pending_audits SET "), __sets, __sqlbundle_Literal(" WHERE pending_audits.node_id = ? RETURNING pending_audits.node_id, pending_audits.piece_id, pending_audits.stripe_index, pending_audits.share_size, pending_audits.expected_share_hash, pending_audits.reverify_count, pending_audits.piece_num, pending_audits.path")}}
 
 	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []interface{}
@@ -5967,7 +8719,7 @@ func (obj *postgresImpl) Update_PendingAudits_By_NodeId(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	pending_audits = &PendingAudits{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&pending_audits.NodeId, &pending_audits.PieceId, &pending_audits.StripeIndex, &pending_audits.ShareSize, &pending_audits.ExpectedShareHash, &pending_audits.ReverifyCount)
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&pending_audits.NodeId, &pending_audits.PieceId, &pending_audits.StripeIndex, &pending_audits.ShareSize, &pending_audits.ExpectedShareHash, &pending_audits.ReverifyCount, &pending_audits.PieceNum, &pending_audits.Path)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -6078,7 +8830,7 @@ func (obj *postgresImpl) Update_Node_By_Id(ctx context.Context,
 	node *Node, err error) {
 	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE nodes SET "), __sets, __sqlbundle_Literal(" WHERE nodes.id = ? RETURNING nodes.id, nodes.address, nodes.last_ip, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.free_bandwidth, nodes.free_disk, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.audit_success_count, nodes.total_audit_count, nodes.audit_success_ratio, nodes.uptime_success_count, nodes.total_uptime_count, nodes.uptime_ratio, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.contained")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE nodes SET "), __sets, __sqlbundle_Literal(" WHERE nodes.id = ? RETURNING nodes.id, nodes.address, nodes.last_ip, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.free_bandwidth, nodes.free_disk, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.audit_success_count, nodes.total_audit_count, nodes.audit_success_ratio, nodes.uptime_success_count, nodes.total_uptime_count, nodes.uptime_ratio, nodes.audit_reputation_alpha, nodes.audit_reputation_beta, nodes.uptime_reputation_alpha, nodes.uptime_reputation_beta, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.contained, nodes.disqualified, nodes.last_audited")}}
 
 	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []interface{}
@@ -6189,6 +8941,26 @@ func (obj *postgresImpl) Update_Node_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("uptime_ratio = ?"))
 	}
 
+	if update.AuditReputationAlpha._set {
+		__values = append(__values, update.AuditReputationAlpha.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_reputation_alpha = ?"))
+	}
+
+	if update.AuditReputationBeta._set {
+		__values = append(__values, update.AuditReputationBeta.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_reputation_beta = ?"))
+	}
+
+	if update.UptimeReputationAlpha._set {
+		__values = append(__values, update.UptimeReputationAlpha.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("uptime_reputation_alpha = ?"))
+	}
+
+	if update.UptimeReputationBeta._set {
+		__values = append(__values, update.UptimeReputationBeta.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("uptime_reputation_beta = ?"))
+	}
+
 	if update.LastContactSuccess._set {
 		__values = append(__values, update.LastContactSuccess.value())
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_contact_success = ?"))
@@ -6204,6 +8976,16 @@ func (obj *postgresImpl) Update_Node_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("contained = ?"))
 	}
 
+	if update.Disqualified._set {
+		__values = append(__values, update.Disqualified.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("disqualified = ?"))
+	}
+
+	if update.LastAudited._set {
+		__values = append(__values, update.LastAudited.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_audited = ?"))
+	}
+
 	__now := obj.db.Hooks.Now().UTC()
 
 	__values = append(__values, __now)
@@ -6218,7 +9000,7 @@ func (obj *postgresImpl) Update_Node_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	node = &Node{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&node.Id, &node.Address, &node.LastIp, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.FreeBandwidth, &node.FreeDisk, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.AuditSuccessCount, &node.TotalAuditCount, &node.AuditSuccessRatio, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.UptimeRatio, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Contained)
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&node.Id, &node.Address, &node.LastIp, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.FreeBandwidth, &node.FreeDisk, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.AuditSuccessCount, &node.TotalAuditCount, &node.AuditSuccessRatio, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.UptimeRatio, &node.AuditReputationAlpha, &node.AuditReputationBeta, &node.UptimeReputationAlpha, &node.UptimeReputationBeta, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Contained, &node.Disqualified, &node.LastAudited)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -6234,7 +9016,7 @@ func (obj *postgresImpl) Update_User_By_Id(ctx context.Context,
 	user *User, err error) {
 	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE users SET "), __sets, __sqlbundle_Literal(" WHERE users.id = ? RETURNING users.id, users.full_name, users.short_name, users.email, users.password_hash, users.status, users.created_at")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE users SET "), __sets, __sqlbundle_Literal(" WHERE users.id = ? RETURNING users.id, users.full_name, users.short_name, users.email, users.password_hash, users.status, users.mfa_enabled, users.mfa_secret_key, users.mfa_recovery_codes, users.created_at")}}
 
 	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []interface{}
@@ -6265,6 +9047,21 @@ func (obj *postgresImpl) Update_User_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("status = ?"))
 	}
 
+	if update.MfaEnabled._set {
+		__values = append(__values, update.MfaEnabled.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("mfa_enabled = ?"))
+	}
+
+	if update.MfaSecretKey._set {
+		__values = append(__values, update.MfaSecretKey.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("mfa_secret_key = ?"))
+	}
+
+	if update.MfaRecoveryCodes._set {
+		__values = append(__values, update.MfaRecoveryCodes.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("mfa_recovery_codes = ?"))
+	}
+
 	if len(__sets_sql.SQLs) == 0 {
 		return nil, emptyUpdate()
 	}
@@ -6278,7 +9075,7 @@ func (obj *postgresImpl) Update_User_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	user = &User{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&user.Id, &user.FullName, &user.ShortName, &user.Email, &user.PasswordHash, &user.Status, &user.CreatedAt)
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&user.Id, &user.FullName, &user.ShortName, &user.Email, &user.PasswordHash, &user.Status, &user.MfaEnabled, &user.MfaSecretKey, &user.MfaRecoveryCodes, &user.CreatedAt)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -6294,7 +9091,7 @@ func (obj *postgresImpl) Update_Project_By_Id(ctx context.Context,
 	project *Project, err error) {
 	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE projects SET "), __sets, __sqlbundle_Literal(" WHERE projects.id = ? RETURNING projects.id, projects.name, projects.description, projects.created_at")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE projects SET "), __sets, __sqlbundle_Literal(" WHERE projects.id = ? RETURNING projects.id, projects.name, projects.description, projects.storage_limit, projects.egress_limit, projects.created_at")}}
 
 	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []interface{}
@@ -6305,6 +9102,16 @@ func (obj *postgresImpl) Update_Project_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("description = ?"))
 	}
 
+	if update.StorageLimit._set {
+		__values = append(__values, update.StorageLimit.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("storage_limit = ?"))
+	}
+
+	if update.EgressLimit._set {
+		__values = append(__values, update.EgressLimit.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("egress_limit = ?"))
+	}
+
 	if len(__sets_sql.SQLs) == 0 {
 		return nil, emptyUpdate()
 	}
@@ -6318,7 +9125,7 @@ func (obj *postgresImpl) Update_Project_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	project = &Project{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&project.Id, &project.Name, &project.Description, &project.CreatedAt)
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&project.Id, &project.Name, &project.Description, &project.StorageLimit, &project.EgressLimit, &project.CreatedAt)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -6328,13 +9135,54 @@ func (obj *postgresImpl) Update_Project_By_Id(ctx context.Context,
 	return project, nil
 }
 
+func (obj *postgresImpl) Update_ProjectMember_By_MemberId_And_ProjectId(ctx context.Context,
+	project_member_member_id ProjectMember_MemberId_Field,
+	project_member_project_id ProjectMember_ProjectId_Field,
+	update ProjectMember_Update_Fields) (
+	project_member *ProjectMember, err error) {
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE project_members SET "), __sets, __sqlbundle_Literal(" WHERE project_members.member_id = ? AND project_members.project_id = ? RETURNING project_members.member_id, project_members.project_id, project_members.role, project_members.created_at")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.Role._set {
+		__values = append(__values, update.Role.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("role = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
+
+	__args = append(__args, project_member_member_id.value(), project_member_project_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	project_member = &ProjectMember{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&project_member.MemberId, &project_member.ProjectId, &project_member.Role, &project_member.CreatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return project_member, nil
+}
+
 func (obj *postgresImpl) Update_ApiKey_By_Id(ctx context.Context,
 	api_key_id ApiKey_Id_Field,
 	update ApiKey_Update_Fields) (
 	api_key *ApiKey, err error) {
 	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE api_keys SET "), __sets, __sqlbundle_Literal(" WHERE api_keys.id = ? RETURNING api_keys.id, api_keys.project_id, api_keys.key, api_keys.name, api_keys.created_at")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE api_keys SET "), __sets, __sqlbundle_Literal(" WHERE api_keys.id = ? RETURNING api_keys.id, api_keys.project_id, api_keys.head, api_keys.name, api_keys.secret, api_keys.caveat, api_keys.created_at")}}
 
 	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []interface{}
@@ -6358,7 +9206,7 @@ func (obj *postgresImpl) Update_ApiKey_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	api_key = &ApiKey{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&api_key.Id, &api_key.ProjectId, &api_key.Key, &api_key.Name, &api_key.CreatedAt)
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&api_key.Id, &api_key.ProjectId, &api_key.Head, &api_key.Name, &api_key.Secret, &api_key.Caveat, &api_key.CreatedAt)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -6526,7 +9374,58 @@ func (obj *postgresImpl) Update_Offer_By_Id_And_Status_Equal_Number_And_ExpiresA
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	return offer, nil
+	return offer, nil
+}
+
+func (obj *postgresImpl) Update_NotificationPreference_By_UserId(ctx context.Context,
+	notification_preference_user_id NotificationPreference_UserId_Field,
+	update NotificationPreference_Update_Fields) (
+	notification_preference *NotificationPreference, err error) {
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE notification_preferences SET "), __sets, __sqlbundle_Literal(" WHERE notification_preferences.user_id = ? RETURNING notification_preferences.user_id, notification_preferences.usage_thresholds, notification_preferences.api_key_created, notification_preferences.project_member_added, notification_preferences.updated_at")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.UsageThresholds._set {
+		__values = append(__values, update.UsageThresholds.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("usage_thresholds = ?"))
+	}
+
+	if update.ApiKeyCreated._set {
+		__values = append(__values, update.ApiKeyCreated.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("api_key_created = ?"))
+	}
+
+	if update.ProjectMemberAdded._set {
+		__values = append(__values, update.ProjectMemberAdded.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("project_member_added = ?"))
+	}
+
+	__now := obj.db.Hooks.Now().UTC()
+
+	__values = append(__values, __now)
+	__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("updated_at = ?"))
+
+	__args = append(__args, notification_preference_user_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	notification_preference = &NotificationPreference{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&notification_preference.UserId, &notification_preference.UsageThresholds, &notification_preference.ApiKeyCreated, &notification_preference.ProjectMemberAdded, &notification_preference.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return notification_preference, nil
 }
 
 func (obj *postgresImpl) Delete_PendingAudits_By_NodeId(ctx context.Context,
@@ -6894,6 +9793,32 @@ func (obj *postgresImpl) Delete_Offer_By_Id(ctx context.Context,
 
 }
 
+func (obj *postgresImpl) Delete_AuditEvent_By_CreatedAt_Less(ctx context.Context,
+	audit_event_created_at_less AuditEvent_CreatedAt_Field) (
+	count int64, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM audit_events WHERE audit_events.created_at < ?")
+
+	var __values []interface{}
+	__values = append(__values, audit_event_created_at_less.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	return count, nil
+
+}
+
 func (impl postgresImpl) isConstraintError(err error) (
 	constraint string, ok bool) {
 	if e, ok := err.(*pq.Error); ok {
@@ -6907,11 +9832,71 @@ func (impl postgresImpl) isConstraintError(err error) (
 func (obj *postgresImpl) deleteAll(ctx context.Context) (count int64, err error) {
 	var __res sql.Result
 	var __count int64
+	__res, err = obj.driver.Exec("DELETE FROM usage_notifications;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM notification_preferences;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM audit_events;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM segment_pieces;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM api_key_revocations;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
 	__res, err = obj.driver.Exec("DELETE FROM used_serials;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM segments;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -7012,6 +9997,16 @@ func (obj *postgresImpl) deleteAll(ctx context.Context) (count int64, err error)
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM payout_statements;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -7022,6 +10017,16 @@ func (obj *postgresImpl) deleteAll(ctx context.Context) (count int64, err error)
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM objects;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -7042,6 +10047,16 @@ func (obj *postgresImpl) deleteAll(ctx context.Context) (count int64, err error)
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM invoices;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -7138,7 +10153,9 @@ func (obj *sqlite3Impl) Create_PendingAudits(ctx context.Context,
 	pending_audits_stripe_index PendingAudits_StripeIndex_Field,
 	pending_audits_share_size PendingAudits_ShareSize_Field,
 	pending_audits_expected_share_hash PendingAudits_ExpectedShareHash_Field,
-	pending_audits_reverify_count PendingAudits_ReverifyCount_Field) (
+	pending_audits_reverify_count PendingAudits_ReverifyCount_Field,
+	pending_audits_piece_num PendingAudits_PieceNum_Field,
+	pending_audits_path PendingAudits_Path_Field) (
 	pending_audits *PendingAudits, err error) {
 	__node_id_val := pending_audits_node_id.value()
 	__piece_id_val := pending_audits_piece_id.value()
@@ -7146,13 +10163,15 @@ func (obj *sqlite3Impl) Create_PendingAudits(ctx context.Context,
 	__share_size_val := pending_audits_share_size.value()
 	__expected_share_hash_val := pending_audits_expected_share_hash.value()
 	__reverify_count_val := pending_audits_reverify_count.value()
+	__piece_num_val := pending_audits_piece_num.value()
+	__path_val := pending_audits_path.value()
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO pending_audits ( node_id, piece_id, stripe_index, share_size, expected_share_hash, reverify_count ) VALUES ( ?, ?, ?, ?, ?, ? )")
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO pending_audits ( node_id, piece_id, stripe_index, share_size, expected_share_hash, reverify_count, piece_num, path ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ? )")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __node_id_val, __piece_id_val, __stripe_index_val, __share_size_val, __expected_share_hash_val, __reverify_count_val)
+	obj.logStmt(__stmt, __node_id_val, __piece_id_val, __stripe_index_val, __share_size_val, __expected_share_hash_val, __reverify_count_val, __piece_num_val, __path_val)
 
-	__res, err := obj.driver.Exec(__stmt, __node_id_val, __piece_id_val, __stripe_index_val, __share_size_val, __expected_share_hash_val, __reverify_count_val)
+	__res, err := obj.driver.Exec(__stmt, __node_id_val, __piece_id_val, __stripe_index_val, __share_size_val, __expected_share_hash_val, __reverify_count_val, __piece_num_val, __path_val)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -7277,9 +10296,14 @@ func (obj *sqlite3Impl) Create_Node(ctx context.Context,
 	node_uptime_success_count Node_UptimeSuccessCount_Field,
 	node_total_uptime_count Node_TotalUptimeCount_Field,
 	node_uptime_ratio Node_UptimeRatio_Field,
+	node_audit_reputation_alpha Node_AuditReputationAlpha_Field,
+	node_audit_reputation_beta Node_AuditReputationBeta_Field,
+	node_uptime_reputation_alpha Node_UptimeReputationAlpha_Field,
+	node_uptime_reputation_beta Node_UptimeReputationBeta_Field,
 	node_last_contact_success Node_LastContactSuccess_Field,
 	node_last_contact_failure Node_LastContactFailure_Field,
-	node_contained Node_Contained_Field) (
+	node_contained Node_Contained_Field,
+	optional Node_Create_Fields) (
 	node *Node, err error) {
 
 	__now := obj.db.Hooks.Now().UTC()
@@ -7305,18 +10329,24 @@ func (obj *sqlite3Impl) Create_Node(ctx context.Context,
 	__uptime_success_count_val := node_uptime_success_count.value()
 	__total_uptime_count_val := node_total_uptime_count.value()
 	__uptime_ratio_val := node_uptime_ratio.value()
+	__audit_reputation_alpha_val := node_audit_reputation_alpha.value()
+	__audit_reputation_beta_val := node_audit_reputation_beta.value()
+	__uptime_reputation_alpha_val := node_uptime_reputation_alpha.value()
+	__uptime_reputation_beta_val := node_uptime_reputation_beta.value()
 	__created_at_val := __now
 	__updated_at_val := __now
 	__last_contact_success_val := node_last_contact_success.value()
 	__last_contact_failure_val := node_last_contact_failure.value()
 	__contained_val := node_contained.value()
+	__disqualified_val := optional.Disqualified.value()
+	__last_audited_val := optional.LastAudited.value()
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO nodes ( id, address, last_ip, protocol, type, email, wallet, free_bandwidth, free_disk, major, minor, patch, hash, timestamp, release, latency_90, audit_success_count, total_audit_count, audit_success_ratio, uptime_success_count, total_uptime_count, uptime_ratio, created_at, updated_at, last_contact_success, last_contact_failure, contained ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? )")
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO nodes ( id, address, last_ip, protocol, type, email, wallet, free_bandwidth, free_disk, major, minor, patch, hash, timestamp, release, latency_90, audit_success_count, total_audit_count, audit_success_ratio, uptime_success_count, total_uptime_count, uptime_ratio, audit_reputation_alpha, audit_reputation_beta, uptime_reputation_alpha, uptime_reputation_beta, created_at, updated_at, last_contact_success, last_contact_failure, contained, disqualified, last_audited ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? )")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __id_val, __address_val, __last_ip_val, __protocol_val, __type_val, __email_val, __wallet_val, __free_bandwidth_val, __free_disk_val, __major_val, __minor_val, __patch_val, __hash_val, __timestamp_val, __release_val, __latency_90_val, __audit_success_count_val, __total_audit_count_val, __audit_success_ratio_val, __uptime_success_count_val, __total_uptime_count_val, __uptime_ratio_val, __created_at_val, __updated_at_val, __last_contact_success_val, __last_contact_failure_val, __contained_val)
+	obj.logStmt(__stmt, __id_val, __address_val, __last_ip_val, __protocol_val, __type_val, __email_val, __wallet_val, __free_bandwidth_val, __free_disk_val, __major_val, __minor_val, __patch_val, __hash_val, __timestamp_val, __release_val, __latency_90_val, __audit_success_count_val, __total_audit_count_val, __audit_success_ratio_val, __uptime_success_count_val, __total_uptime_count_val, __uptime_ratio_val, __audit_reputation_alpha_val, __audit_reputation_beta_val, __uptime_reputation_alpha_val, __uptime_reputation_beta_val, __created_at_val, __updated_at_val, __last_contact_success_val, __last_contact_failure_val, __contained_val, __disqualified_val, __last_audited_val)
 
-	__res, err := obj.driver.Exec(__stmt, __id_val, __address_val, __last_ip_val, __protocol_val, __type_val, __email_val, __wallet_val, __free_bandwidth_val, __free_disk_val, __major_val, __minor_val, __patch_val, __hash_val, __timestamp_val, __release_val, __latency_90_val, __audit_success_count_val, __total_audit_count_val, __audit_success_ratio_val, __uptime_success_count_val, __total_uptime_count_val, __uptime_ratio_val, __created_at_val, __updated_at_val, __last_contact_success_val, __last_contact_failure_val, __contained_val)
+	__res, err := obj.driver.Exec(__stmt, __id_val, __address_val, __last_ip_val, __protocol_val, __type_val, __email_val, __wallet_val, __free_bandwidth_val, __free_disk_val, __major_val, __minor_val, __patch_val, __hash_val, __timestamp_val, __release_val, __latency_90_val, __audit_success_count_val, __total_audit_count_val, __audit_success_ratio_val, __uptime_success_count_val, __total_uptime_count_val, __uptime_ratio_val, __audit_reputation_alpha_val, __audit_reputation_beta_val, __uptime_reputation_alpha_val, __uptime_reputation_beta_val, __created_at_val, __updated_at_val, __last_contact_success_val, __last_contact_failure_val, __contained_val, __disqualified_val, __last_audited_val)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -7343,14 +10373,17 @@ func (obj *sqlite3Impl) Create_User(ctx context.Context,
 	__email_val := user_email.value()
 	__password_hash_val := user_password_hash.value()
 	__status_val := int(0)
+	__mfa_enabled_val := false
+	__mfa_secret_key_val := optional.MfaSecretKey.value()
+	__mfa_recovery_codes_val := optional.MfaRecoveryCodes.value()
 	__created_at_val := __now
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO users ( id, full_name, short_name, email, password_hash, status, created_at ) VALUES ( ?, ?, ?, ?, ?, ?, ? )")
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO users ( id, full_name, short_name, email, password_hash, status, mfa_enabled, mfa_secret_key, mfa_recovery_codes, created_at ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ? )")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __id_val, __full_name_val, __short_name_val, __email_val, __password_hash_val, __status_val, __created_at_val)
+	obj.logStmt(__stmt, __id_val, __full_name_val, __short_name_val, __email_val, __password_hash_val, __status_val, __mfa_enabled_val, __mfa_secret_key_val, __mfa_recovery_codes_val, __created_at_val)
 
-	__res, err := obj.driver.Exec(__stmt, __id_val, __full_name_val, __short_name_val, __email_val, __password_hash_val, __status_val, __created_at_val)
+	__res, err := obj.driver.Exec(__stmt, __id_val, __full_name_val, __short_name_val, __email_val, __password_hash_val, __status_val, __mfa_enabled_val, __mfa_secret_key_val, __mfa_recovery_codes_val, __created_at_val)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -7365,21 +10398,25 @@ func (obj *sqlite3Impl) Create_User(ctx context.Context,
 func (obj *sqlite3Impl) Create_Project(ctx context.Context,
 	project_id Project_Id_Field,
 	project_name Project_Name_Field,
-	project_description Project_Description_Field) (
+	project_description Project_Description_Field,
+	project_storage_limit Project_StorageLimit_Field,
+	project_egress_limit Project_EgressLimit_Field) (
 	project *Project, err error) {
 
 	__now := obj.db.Hooks.Now().UTC()
 	__id_val := project_id.value()
 	__name_val := project_name.value()
 	__description_val := project_description.value()
+	__storage_limit_val := project_storage_limit.value()
+	__egress_limit_val := project_egress_limit.value()
 	__created_at_val := __now
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO projects ( id, name, description, created_at ) VALUES ( ?, ?, ?, ? )")
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO projects ( id, name, description, storage_limit, egress_limit, created_at ) VALUES ( ?, ?, ?, ?, ?, ? )")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __id_val, __name_val, __description_val, __created_at_val)
+	obj.logStmt(__stmt, __id_val, __name_val, __description_val, __storage_limit_val, __egress_limit_val, __created_at_val)
 
-	__res, err := obj.driver.Exec(__stmt, __id_val, __name_val, __description_val, __created_at_val)
+	__res, err := obj.driver.Exec(__stmt, __id_val, __name_val, __description_val, __storage_limit_val, __egress_limit_val, __created_at_val)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -7393,20 +10430,22 @@ func (obj *sqlite3Impl) Create_Project(ctx context.Context,
 
 func (obj *sqlite3Impl) Create_ProjectMember(ctx context.Context,
 	project_member_member_id ProjectMember_MemberId_Field,
-	project_member_project_id ProjectMember_ProjectId_Field) (
+	project_member_project_id ProjectMember_ProjectId_Field,
+	project_member_role ProjectMember_Role_Field) (
 	project_member *ProjectMember, err error) {
 
 	__now := obj.db.Hooks.Now().UTC()
 	__member_id_val := project_member_member_id.value()
 	__project_id_val := project_member_project_id.value()
+	__role_val := project_member_role.value()
 	__created_at_val := __now
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO project_members ( member_id, project_id, created_at ) VALUES ( ?, ?, ? )")
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO project_members ( member_id, project_id, role, created_at ) VALUES ( ?, ?, ?, ? )")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __member_id_val, __project_id_val, __created_at_val)
+	obj.logStmt(__stmt, __member_id_val, __project_id_val, __role_val, __created_at_val)
 
-	__res, err := obj.driver.Exec(__stmt, __member_id_val, __project_id_val, __created_at_val)
+	__res, err := obj.driver.Exec(__stmt, __member_id_val, __project_id_val, __role_val, __created_at_val)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -7421,23 +10460,27 @@ func (obj *sqlite3Impl) Create_ProjectMember(ctx context.Context,
 func (obj *sqlite3Impl) Create_ApiKey(ctx context.Context,
 	api_key_id ApiKey_Id_Field,
 	api_key_project_id ApiKey_ProjectId_Field,
-	api_key_key ApiKey_Key_Field,
-	api_key_name ApiKey_Name_Field) (
+	api_key_head ApiKey_Head_Field,
+	api_key_name ApiKey_Name_Field,
+	api_key_secret ApiKey_Secret_Field,
+	api_key_caveat ApiKey_Caveat_Field) (
 	api_key *ApiKey, err error) {
 
 	__now := obj.db.Hooks.Now().UTC()
 	__id_val := api_key_id.value()
 	__project_id_val := api_key_project_id.value()
-	__key_val := api_key_key.value()
+	__head_val := api_key_head.value()
 	__name_val := api_key_name.value()
+	__secret_val := api_key_secret.value()
+	__caveat_val := api_key_caveat.value()
 	__created_at_val := __now
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO api_keys ( id, project_id, key, name, created_at ) VALUES ( ?, ?, ?, ?, ? )")
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO api_keys ( id, project_id, head, name, secret, caveat, created_at ) VALUES ( ?, ?, ?, ?, ?, ?, ? )")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __id_val, __project_id_val, __key_val, __name_val, __created_at_val)
+	obj.logStmt(__stmt, __id_val, __project_id_val, __head_val, __name_val, __secret_val, __caveat_val, __created_at_val)
 
-	__res, err := obj.driver.Exec(__stmt, __id_val, __project_id_val, __key_val, __name_val, __created_at_val)
+	__res, err := obj.driver.Exec(__stmt, __id_val, __project_id_val, __head_val, __name_val, __secret_val, __caveat_val, __created_at_val)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -7613,16 +10656,251 @@ func (obj *sqlite3Impl) Create_CertRecord(ctx context.Context,
 	certRecord *CertRecord, err error) {
 
 	__now := obj.db.Hooks.Now().UTC()
-	__publickey_val := certRecord_publickey.value()
-	__id_val := certRecord_id.value()
-	__update_at_val := __now
+	__publickey_val := certRecord_publickey.value()
+	__id_val := certRecord_id.value()
+	__update_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO certRecords ( publickey, id, update_at ) VALUES ( ?, ?, ? )")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __publickey_val, __id_val, __update_at_val)
+
+	__res, err := obj.driver.Exec(__stmt, __publickey_val, __id_val, __update_at_val)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	__pk, err := __res.LastInsertId()
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return obj.getLastCertRecord(ctx, __pk)
+
+}
+
+func (obj *sqlite3Impl) Create_RegistrationToken(ctx context.Context,
+	registration_token_secret RegistrationToken_Secret_Field,
+	registration_token_project_limit RegistrationToken_ProjectLimit_Field,
+	optional RegistrationToken_Create_Fields) (
+	registration_token *RegistrationToken, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__secret_val := registration_token_secret.value()
+	__owner_id_val := optional.OwnerId.value()
+	__project_limit_val := registration_token_project_limit.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO registration_tokens ( secret, owner_id, project_limit, created_at ) VALUES ( ?, ?, ?, ? )")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __secret_val, __owner_id_val, __project_limit_val, __created_at_val)
+
+	__res, err := obj.driver.Exec(__stmt, __secret_val, __owner_id_val, __project_limit_val, __created_at_val)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	__pk, err := __res.LastInsertId()
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return obj.getLastRegistrationToken(ctx, __pk)
+
+}
+
+func (obj *sqlite3Impl) Create_ResetPasswordToken(ctx context.Context,
+	reset_password_token_secret ResetPasswordToken_Secret_Field,
+	reset_password_token_owner_id ResetPasswordToken_OwnerId_Field) (
+	reset_password_token *ResetPasswordToken, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__secret_val := reset_password_token_secret.value()
+	__owner_id_val := reset_password_token_owner_id.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO reset_password_tokens ( secret, owner_id, created_at ) VALUES ( ?, ?, ? )")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __secret_val, __owner_id_val, __created_at_val)
+
+	__res, err := obj.driver.Exec(__stmt, __secret_val, __owner_id_val, __created_at_val)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	__pk, err := __res.LastInsertId()
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return obj.getLastResetPasswordToken(ctx, __pk)
+
+}
+
+func (obj *sqlite3Impl) Create_Offer(ctx context.Context,
+	offer_name Offer_Name_Field,
+	offer_description Offer_Description_Field,
+	offer_type Offer_Type_Field,
+	offer_credit_in_cents Offer_CreditInCents_Field,
+	offer_award_credit_duration_days Offer_AwardCreditDurationDays_Field,
+	offer_invitee_credit_duration_days Offer_InviteeCreditDurationDays_Field,
+	offer_redeemable_cap Offer_RedeemableCap_Field) (
+	offer *Offer, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__name_val := offer_name.value()
+	__description_val := offer_description.value()
+	__type_val := offer_type.value()
+	__credit_in_cents_val := offer_credit_in_cents.value()
+	__award_credit_duration_days_val := offer_award_credit_duration_days.value()
+	__invitee_credit_duration_days_val := offer_invitee_credit_duration_days.value()
+	__redeemable_cap_val := offer_redeemable_cap.value()
+	__num_redeemed_val := int(0)
+	__expires_at_val := (*time.Time)(nil)
+	__created_at_val := __now
+	__status_val := int(0)
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO offers ( name, description, type, credit_in_cents, award_credit_duration_days, invitee_credit_duration_days, redeemable_cap, num_redeemed, expires_at, created_at, status ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? )")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __name_val, __description_val, __type_val, __credit_in_cents_val, __award_credit_duration_days_val, __invitee_credit_duration_days_val, __redeemable_cap_val, __num_redeemed_val, __expires_at_val, __created_at_val, __status_val)
+
+	__res, err := obj.driver.Exec(__stmt, __name_val, __description_val, __type_val, __credit_in_cents_val, __award_credit_duration_days_val, __invitee_credit_duration_days_val, __redeemable_cap_val, __num_redeemed_val, __expires_at_val, __created_at_val, __status_val)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	__pk, err := __res.LastInsertId()
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return obj.getLastOffer(ctx, __pk)
+
+}
+
+func (obj *sqlite3Impl) Create_Invoice(ctx context.Context,
+	invoice_id Invoice_Id_Field,
+	invoice_project_id Invoice_ProjectId_Field,
+	invoice_period_start Invoice_PeriodStart_Field,
+	invoice_period_end Invoice_PeriodEnd_Field,
+	invoice_storage Invoice_Storage_Field,
+	invoice_egress Invoice_Egress_Field,
+	invoice_object_count Invoice_ObjectCount_Field,
+	invoice_segment_count Invoice_SegmentCount_Field,
+	invoice_storage_amount Invoice_StorageAmount_Field,
+	invoice_egress_amount Invoice_EgressAmount_Field,
+	invoice_object_amount Invoice_ObjectAmount_Field,
+	invoice_segment_amount Invoice_SegmentAmount_Field,
+	invoice_total Invoice_Total_Field) (
+	invoice *Invoice, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__id_val := invoice_id.value()
+	__project_id_val := invoice_project_id.value()
+	__period_start_val := invoice_period_start.value()
+	__period_end_val := invoice_period_end.value()
+	__storage_val := invoice_storage.value()
+	__egress_val := invoice_egress.value()
+	__object_count_val := invoice_object_count.value()
+	__segment_count_val := invoice_segment_count.value()
+	__storage_amount_val := invoice_storage_amount.value()
+	__egress_amount_val := invoice_egress_amount.value()
+	__object_amount_val := invoice_object_amount.value()
+	__segment_amount_val := invoice_segment_amount.value()
+	__total_val := invoice_total.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO invoices ( id, project_id, period_start, period_end, storage, egress, object_count, segment_count, storage_amount, egress_amount, object_amount, segment_amount, total, created_at ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? )")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __id_val, __project_id_val, __period_start_val, __period_end_val, __storage_val, __egress_val, __object_count_val, __segment_count_val, __storage_amount_val, __egress_amount_val, __object_amount_val, __segment_amount_val, __total_val, __created_at_val)
+
+	__res, err := obj.driver.Exec(__stmt, __id_val, __project_id_val, __period_start_val, __period_end_val, __storage_val, __egress_val, __object_count_val, __segment_count_val, __storage_amount_val, __egress_amount_val, __object_amount_val, __segment_amount_val, __total_val, __created_at_val)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	__pk, err := __res.LastInsertId()
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return obj.getLastInvoice(ctx, __pk)
+
+}
+
+func (obj *sqlite3Impl) Create_PayoutStatement(ctx context.Context,
+	payout_statement_node_id PayoutStatement_NodeId_Field,
+	payout_statement_period_start PayoutStatement_PeriodStart_Field,
+	payout_statement_period_end PayoutStatement_PeriodEnd_Field,
+	payout_statement_node_created_at PayoutStatement_NodeCreatedAt_Field,
+	payout_statement_wallet PayoutStatement_Wallet_Field,
+	payout_statement_at_rest_total PayoutStatement_AtRestTotal_Field,
+	payout_statement_get_total PayoutStatement_GetTotal_Field,
+	payout_statement_get_repair_total PayoutStatement_GetRepairTotal_Field,
+	payout_statement_get_audit_total PayoutStatement_GetAuditTotal_Field,
+	payout_statement_put_total PayoutStatement_PutTotal_Field,
+	payout_statement_put_repair_total PayoutStatement_PutRepairTotal_Field,
+	payout_statement_at_rest_amount PayoutStatement_AtRestAmount_Field,
+	payout_statement_get_amount PayoutStatement_GetAmount_Field,
+	payout_statement_repair_amount PayoutStatement_RepairAmount_Field,
+	payout_statement_audit_amount PayoutStatement_AuditAmount_Field,
+	payout_statement_earned PayoutStatement_Earned_Field,
+	payout_statement_held_percent PayoutStatement_HeldPercent_Field,
+	payout_statement_held PayoutStatement_Held_Field,
+	payout_statement_disqualified PayoutStatement_Disqualified_Field,
+	payout_statement_paid PayoutStatement_Paid_Field) (
+	payout_statement *PayoutStatement, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__node_id_val := payout_statement_node_id.value()
+	__period_start_val := payout_statement_period_start.value()
+	__period_end_val := payout_statement_period_end.value()
+	__node_created_at_val := payout_statement_node_created_at.value()
+	__wallet_val := payout_statement_wallet.value()
+	__at_rest_total_val := payout_statement_at_rest_total.value()
+	__get_total_val := payout_statement_get_total.value()
+	__get_repair_total_val := payout_statement_get_repair_total.value()
+	__get_audit_total_val := payout_statement_get_audit_total.value()
+	__put_total_val := payout_statement_put_total.value()
+	__put_repair_total_val := payout_statement_put_repair_total.value()
+	__at_rest_amount_val := payout_statement_at_rest_amount.value()
+	__get_amount_val := payout_statement_get_amount.value()
+	__repair_amount_val := payout_statement_repair_amount.value()
+	__audit_amount_val := payout_statement_audit_amount.value()
+	__earned_val := payout_statement_earned.value()
+	__held_percent_val := payout_statement_held_percent.value()
+	__held_val := payout_statement_held.value()
+	__disqualified_val := payout_statement_disqualified.value()
+	__paid_val := payout_statement_paid.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO payout_statements ( node_id, period_start, period_end, node_created_at, wallet, at_rest_total, get_total, get_repair_total, get_audit_total, put_total, put_repair_total, at_rest_amount, get_amount, repair_amount, audit_amount, earned, held_percent, held, disqualified, paid, created_at ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? )")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __node_id_val, __period_start_val, __period_end_val, __node_created_at_val, __wallet_val, __at_rest_total_val, __get_total_val, __get_repair_total_val, __get_audit_total_val, __put_total_val, __put_repair_total_val, __at_rest_amount_val, __get_amount_val, __repair_amount_val, __audit_amount_val, __earned_val, __held_percent_val, __held_val, __disqualified_val, __paid_val, __created_at_val)
+
+	__res, err := obj.driver.Exec(__stmt, __node_id_val, __period_start_val, __period_end_val, __node_created_at_val, __wallet_val, __at_rest_total_val, __get_total_val, __get_repair_total_val, __get_audit_total_val, __put_total_val, __put_repair_total_val, __at_rest_amount_val, __get_amount_val, __repair_amount_val, __audit_amount_val, __earned_val, __held_percent_val, __held_val, __disqualified_val, __paid_val, __created_at_val)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	__pk, err := __res.LastInsertId()
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return obj.getLastPayoutStatement(ctx, __pk)
+
+}
+
+func (obj *sqlite3Impl) Create_ApiKeyRevocation(ctx context.Context,
+	api_key_revocation_api_key_id ApiKeyRevocation_ApiKeyId_Field,
+	api_key_revocation_tail ApiKeyRevocation_Tail_Field) (
+	api_key_revocation *ApiKeyRevocation, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__api_key_id_val := api_key_revocation_api_key_id.value()
+	__tail_val := api_key_revocation_tail.value()
+	__created_at_val := __now
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO certRecords ( publickey, id, update_at ) VALUES ( ?, ?, ? )")
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO api_key_revocations ( api_key_id, tail, created_at ) VALUES ( ?, ?, ? )")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __publickey_val, __id_val, __update_at_val)
+	obj.logStmt(__stmt, __api_key_id_val, __tail_val, __created_at_val)
 
-	__res, err := obj.driver.Exec(__stmt, __publickey_val, __id_val, __update_at_val)
+	__res, err := obj.driver.Exec(__stmt, __api_key_id_val, __tail_val, __created_at_val)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -7630,28 +10908,34 @@ func (obj *sqlite3Impl) Create_CertRecord(ctx context.Context,
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	return obj.getLastCertRecord(ctx, __pk)
+	return obj.getLastApiKeyRevocation(ctx, __pk)
 
 }
 
-func (obj *sqlite3Impl) Create_RegistrationToken(ctx context.Context,
-	registration_token_secret RegistrationToken_Secret_Field,
-	registration_token_project_limit RegistrationToken_ProjectLimit_Field,
-	optional RegistrationToken_Create_Fields) (
-	registration_token *RegistrationToken, err error) {
+func (obj *sqlite3Impl) Create_AuditEvent(ctx context.Context,
+	audit_event_id AuditEvent_Id_Field,
+	audit_event_project_id AuditEvent_ProjectId_Field,
+	audit_event_actor_id AuditEvent_ActorId_Field,
+	audit_event_action AuditEvent_Action_Field,
+	audit_event_target AuditEvent_Target_Field,
+	audit_event_source_ip AuditEvent_SourceIp_Field) (
+	audit_event *AuditEvent, err error) {
 
 	__now := obj.db.Hooks.Now().UTC()
-	__secret_val := registration_token_secret.value()
-	__owner_id_val := optional.OwnerId.value()
-	__project_limit_val := registration_token_project_limit.value()
+	__id_val := audit_event_id.value()
+	__project_id_val := audit_event_project_id.value()
+	__actor_id_val := audit_event_actor_id.value()
+	__action_val := audit_event_action.value()
+	__target_val := audit_event_target.value()
+	__source_ip_val := audit_event_source_ip.value()
 	__created_at_val := __now
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO registration_tokens ( secret, owner_id, project_limit, created_at ) VALUES ( ?, ?, ?, ? )")
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO audit_events ( id, project_id, actor_id, action, target, source_ip, created_at ) VALUES ( ?, ?, ?, ?, ?, ?, ? )")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __secret_val, __owner_id_val, __project_limit_val, __created_at_val)
+	obj.logStmt(__stmt, __id_val, __project_id_val, __actor_id_val, __action_val, __target_val, __source_ip_val, __created_at_val)
 
-	__res, err := obj.driver.Exec(__stmt, __secret_val, __owner_id_val, __project_limit_val, __created_at_val)
+	__res, err := obj.driver.Exec(__stmt, __id_val, __project_id_val, __actor_id_val, __action_val, __target_val, __source_ip_val, __created_at_val)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -7659,26 +10943,30 @@ func (obj *sqlite3Impl) Create_RegistrationToken(ctx context.Context,
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	return obj.getLastRegistrationToken(ctx, __pk)
+	return obj.getLastAuditEvent(ctx, __pk)
 
 }
 
-func (obj *sqlite3Impl) Create_ResetPasswordToken(ctx context.Context,
-	reset_password_token_secret ResetPasswordToken_Secret_Field,
-	reset_password_token_owner_id ResetPasswordToken_OwnerId_Field) (
-	reset_password_token *ResetPasswordToken, err error) {
+func (obj *sqlite3Impl) Create_NotificationPreference(ctx context.Context,
+	notification_preference_user_id NotificationPreference_UserId_Field,
+	notification_preference_usage_thresholds NotificationPreference_UsageThresholds_Field,
+	notification_preference_api_key_created NotificationPreference_ApiKeyCreated_Field,
+	notification_preference_project_member_added NotificationPreference_ProjectMemberAdded_Field) (
+	notification_preference *NotificationPreference, err error) {
 
 	__now := obj.db.Hooks.Now().UTC()
-	__secret_val := reset_password_token_secret.value()
-	__owner_id_val := reset_password_token_owner_id.value()
-	__created_at_val := __now
+	__user_id_val := notification_preference_user_id.value()
+	__usage_thresholds_val := notification_preference_usage_thresholds.value()
+	__api_key_created_val := notification_preference_api_key_created.value()
+	__project_member_added_val := notification_preference_project_member_added.value()
+	__updated_at_val := __now
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO reset_password_tokens ( secret, owner_id, created_at ) VALUES ( ?, ?, ? )")
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO notification_preferences ( user_id, usage_thresholds, api_key_created, project_member_added, updated_at ) VALUES ( ?, ?, ?, ?, ? )")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __secret_val, __owner_id_val, __created_at_val)
+	obj.logStmt(__stmt, __user_id_val, __usage_thresholds_val, __api_key_created_val, __project_member_added_val, __updated_at_val)
 
-	__res, err := obj.driver.Exec(__stmt, __secret_val, __owner_id_val, __created_at_val)
+	__res, err := obj.driver.Exec(__stmt, __user_id_val, __usage_thresholds_val, __api_key_created_val, __project_member_added_val, __updated_at_val)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -7686,39 +10974,30 @@ func (obj *sqlite3Impl) Create_ResetPasswordToken(ctx context.Context,
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	return obj.getLastResetPasswordToken(ctx, __pk)
+	return obj.getLastNotificationPreference(ctx, __pk)
 
 }
 
-func (obj *sqlite3Impl) Create_Offer(ctx context.Context,
-	offer_name Offer_Name_Field,
-	offer_description Offer_Description_Field,
-	offer_type Offer_Type_Field,
-	offer_credit_in_cents Offer_CreditInCents_Field,
-	offer_award_credit_duration_days Offer_AwardCreditDurationDays_Field,
-	offer_invitee_credit_duration_days Offer_InviteeCreditDurationDays_Field,
-	offer_redeemable_cap Offer_RedeemableCap_Field) (
-	offer *Offer, err error) {
+func (obj *sqlite3Impl) Create_UsageNotification(ctx context.Context,
+	usage_notification_project_id UsageNotification_ProjectId_Field,
+	usage_notification_resource UsageNotification_Resource_Field,
+	usage_notification_threshold UsageNotification_Threshold_Field,
+	usage_notification_period_start UsageNotification_PeriodStart_Field) (
+	usage_notification *UsageNotification, err error) {
 
 	__now := obj.db.Hooks.Now().UTC()
-	__name_val := offer_name.value()
-	__description_val := offer_description.value()
-	__type_val := offer_type.value()
-	__credit_in_cents_val := offer_credit_in_cents.value()
-	__award_credit_duration_days_val := offer_award_credit_duration_days.value()
-	__invitee_credit_duration_days_val := offer_invitee_credit_duration_days.value()
-	__redeemable_cap_val := offer_redeemable_cap.value()
-	__num_redeemed_val := int(0)
-	__expires_at_val := (*time.Time)(nil)
+	__project_id_val := usage_notification_project_id.value()
+	__resource_val := usage_notification_resource.value()
+	__threshold_val := usage_notification_threshold.value()
+	__period_start_val := usage_notification_period_start.value()
 	__created_at_val := __now
-	__status_val := int(0)
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO offers ( name, description, type, credit_in_cents, award_credit_duration_days, invitee_credit_duration_days, redeemable_cap, num_redeemed, expires_at, created_at, status ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? )")
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO usage_notifications ( project_id, resource, threshold, period_start, created_at ) VALUES ( ?, ?, ?, ?, ? )")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __name_val, __description_val, __type_val, __credit_in_cents_val, __award_credit_duration_days_val, __invitee_credit_duration_days_val, __redeemable_cap_val, __num_redeemed_val, __expires_at_val, __created_at_val, __status_val)
+	obj.logStmt(__stmt, __project_id_val, __resource_val, __threshold_val, __period_start_val, __created_at_val)
 
-	__res, err := obj.driver.Exec(__stmt, __name_val, __description_val, __type_val, __credit_in_cents_val, __award_credit_duration_days_val, __invitee_credit_duration_days_val, __redeemable_cap_val, __num_redeemed_val, __expires_at_val, __created_at_val, __status_val)
+	__res, err := obj.driver.Exec(__stmt, __project_id_val, __resource_val, __threshold_val, __period_start_val, __created_at_val)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -7726,7 +11005,7 @@ func (obj *sqlite3Impl) Create_Offer(ctx context.Context,
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	return obj.getLastOffer(ctx, __pk)
+	return obj.getLastUsageNotification(ctx, __pk)
 
 }
 
@@ -7734,7 +11013,7 @@ func (obj *sqlite3Impl) Get_PendingAudits_By_NodeId(ctx context.Context,
 	pending_audits_node_id PendingAudits_NodeId_Field) (
 	pending_audits *PendingAudits, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT pending_audits.node_id, pending_audits.piece_id, pending_audits.stripe_index, pending_audits.share_size, pending_audits.expected_share_hash, pending_audits.reverify_count FROM pending_audits WHERE pending_audits.node_id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT pending_audits.node_id, pending_audits.piece_id, pending_audits.stripe_index, pending_audits.share_size, pending_audits.expected_share_hash, pending_audits.reverify_count, pending_audits.piece_num, pending_audits.path FROM pending_audits WHERE pending_audits.node_id = ?")
 
 	var __values []interface{}
 	__values = append(__values, pending_audits_node_id.value())
@@ -7743,7 +11022,7 @@ func (obj *sqlite3Impl) Get_PendingAudits_By_NodeId(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	pending_audits = &PendingAudits{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&pending_audits.NodeId, &pending_audits.PieceId, &pending_audits.StripeIndex, &pending_audits.ShareSize, &pending_audits.ExpectedShareHash, &pending_audits.ReverifyCount)
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&pending_audits.NodeId, &pending_audits.PieceId, &pending_audits.StripeIndex, &pending_audits.ShareSize, &pending_audits.ExpectedShareHash, &pending_audits.ReverifyCount, &pending_audits.PieceNum, &pending_audits.Path)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -7889,7 +11168,7 @@ func (obj *sqlite3Impl) Get_Node_By_Id(ctx context.Context,
 	node_id Node_Id_Field) (
 	node *Node, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_ip, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.free_bandwidth, nodes.free_disk, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.audit_success_count, nodes.total_audit_count, nodes.audit_success_ratio, nodes.uptime_success_count, nodes.total_uptime_count, nodes.uptime_ratio, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.contained FROM nodes WHERE nodes.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_ip, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.free_bandwidth, nodes.free_disk, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.audit_success_count, nodes.total_audit_count, nodes.audit_success_ratio, nodes.uptime_success_count, nodes.total_uptime_count, nodes.uptime_ratio, nodes.audit_reputation_alpha, nodes.audit_reputation_beta, nodes.uptime_reputation_alpha, nodes.uptime_reputation_beta, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.contained, nodes.disqualified, nodes.last_audited FROM nodes WHERE nodes.id = ?")
 
 	var __values []interface{}
 	__values = append(__values, node_id.value())
@@ -7898,7 +11177,7 @@ func (obj *sqlite3Impl) Get_Node_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	node = &Node{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&node.Id, &node.Address, &node.LastIp, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.FreeBandwidth, &node.FreeDisk, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.AuditSuccessCount, &node.TotalAuditCount, &node.AuditSuccessRatio, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.UptimeRatio, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Contained)
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&node.Id, &node.Address, &node.LastIp, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.FreeBandwidth, &node.FreeDisk, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.AuditSuccessCount, &node.TotalAuditCount, &node.AuditSuccessRatio, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.UptimeRatio, &node.AuditReputationAlpha, &node.AuditReputationBeta, &node.UptimeReputationAlpha, &node.UptimeReputationBeta, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Contained, &node.Disqualified, &node.LastAudited)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -7943,7 +11222,7 @@ func (obj *sqlite3Impl) Limited_Node_By_Id_GreaterOrEqual_OrderBy_Asc_Id(ctx con
 	limit int, offset int64) (
 	rows []*Node, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_ip, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.free_bandwidth, nodes.free_disk, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.audit_success_count, nodes.total_audit_count, nodes.audit_success_ratio, nodes.uptime_success_count, nodes.total_uptime_count, nodes.uptime_ratio, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.contained FROM nodes WHERE nodes.id >= ? ORDER BY nodes.id LIMIT ? OFFSET ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_ip, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.free_bandwidth, nodes.free_disk, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.audit_success_count, nodes.total_audit_count, nodes.audit_success_ratio, nodes.uptime_success_count, nodes.total_uptime_count, nodes.uptime_ratio, nodes.audit_reputation_alpha, nodes.audit_reputation_beta, nodes.uptime_reputation_alpha, nodes.uptime_reputation_beta, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.contained, nodes.disqualified, nodes.last_audited FROM nodes WHERE nodes.id >= ? ORDER BY nodes.id LIMIT ? OFFSET ?")
 
 	var __values []interface{}
 	__values = append(__values, node_id_greater_or_equal.value())
@@ -7961,7 +11240,7 @@ func (obj *sqlite3Impl) Limited_Node_By_Id_GreaterOrEqual_OrderBy_Asc_Id(ctx con
 
 	for __rows.Next() {
 		node := &Node{}
-		err = __rows.Scan(&node.Id, &node.Address, &node.LastIp, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.FreeBandwidth, &node.FreeDisk, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.AuditSuccessCount, &node.TotalAuditCount, &node.AuditSuccessRatio, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.UptimeRatio, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Contained)
+		err = __rows.Scan(&node.Id, &node.Address, &node.LastIp, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.FreeBandwidth, &node.FreeDisk, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.AuditSuccessCount, &node.TotalAuditCount, &node.AuditSuccessRatio, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.UptimeRatio, &node.AuditReputationAlpha, &node.AuditReputationBeta, &node.UptimeReputationAlpha, &node.UptimeReputationBeta, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Contained, &node.Disqualified, &node.LastAudited)
 		if err != nil {
 			return nil, obj.makeErr(err)
 		}
@@ -7978,7 +11257,7 @@ func (obj *sqlite3Impl) Get_User_By_Email_And_Status_Not_Number(ctx context.Cont
 	user_email User_Email_Field) (
 	user *User, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT users.id, users.full_name, users.short_name, users.email, users.password_hash, users.status, users.created_at FROM users WHERE users.email = ? AND users.status != 0 LIMIT 2")
+	var __embed_stmt = __sqlbundle_Literal("SELECT users.id, users.full_name, users.short_name, users.email, users.password_hash, users.status, users.mfa_enabled, users.mfa_secret_key, users.mfa_recovery_codes, users.created_at FROM users WHERE users.email = ? AND users.status != 0 LIMIT 2")
 
 	var __values []interface{}
 	__values = append(__values, user_email.value())
@@ -8000,7 +11279,7 @@ func (obj *sqlite3Impl) Get_User_By_Email_And_Status_Not_Number(ctx context.Cont
 	}
 
 	user = &User{}
-	err = __rows.Scan(&user.Id, &user.FullName, &user.ShortName, &user.Email, &user.PasswordHash, &user.Status, &user.CreatedAt)
+	err = __rows.Scan(&user.Id, &user.FullName, &user.ShortName, &user.Email, &user.PasswordHash, &user.Status, &user.MfaEnabled, &user.MfaSecretKey, &user.MfaRecoveryCodes, &user.CreatedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -8021,7 +11300,7 @@ func (obj *sqlite3Impl) Get_User_By_Id(ctx context.Context,
 	user_id User_Id_Field) (
 	user *User, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT users.id, users.full_name, users.short_name, users.email, users.password_hash, users.status, users.created_at FROM users WHERE users.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT users.id, users.full_name, users.short_name, users.email, users.password_hash, users.status, users.mfa_enabled, users.mfa_secret_key, users.mfa_recovery_codes, users.created_at FROM users WHERE users.id = ?")
 
 	var __values []interface{}
 	__values = append(__values, user_id.value())
@@ -8030,7 +11309,7 @@ func (obj *sqlite3Impl) Get_User_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	user = &User{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&user.Id, &user.FullName, &user.ShortName, &user.Email, &user.PasswordHash, &user.Status, &user.CreatedAt)
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&user.Id, &user.FullName, &user.ShortName, &user.Email, &user.PasswordHash, &user.Status, &user.MfaEnabled, &user.MfaSecretKey, &user.MfaRecoveryCodes, &user.CreatedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -8041,7 +11320,7 @@ func (obj *sqlite3Impl) Get_User_By_Id(ctx context.Context,
 func (obj *sqlite3Impl) All_Project(ctx context.Context) (
 	rows []*Project, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.created_at FROM projects")
+	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.storage_limit, projects.egress_limit, projects.created_at FROM projects")
 
 	var __values []interface{}
 	__values = append(__values)
@@ -8057,7 +11336,7 @@ func (obj *sqlite3Impl) All_Project(ctx context.Context) (
 
 	for __rows.Next() {
 		project := &Project{}
-		err = __rows.Scan(&project.Id, &project.Name, &project.Description, &project.CreatedAt)
+		err = __rows.Scan(&project.Id, &project.Name, &project.Description, &project.StorageLimit, &project.EgressLimit, &project.CreatedAt)
 		if err != nil {
 			return nil, obj.makeErr(err)
 		}
@@ -8074,7 +11353,7 @@ func (obj *sqlite3Impl) Get_Project_By_Id(ctx context.Context,
 	project_id Project_Id_Field) (
 	project *Project, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.created_at FROM projects WHERE projects.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.storage_limit, projects.egress_limit, projects.created_at FROM projects WHERE projects.id = ?")
 
 	var __values []interface{}
 	__values = append(__values, project_id.value())
@@ -8083,7 +11362,7 @@ func (obj *sqlite3Impl) Get_Project_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	project = &Project{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&project.Id, &project.Name, &project.Description, &project.CreatedAt)
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&project.Id, &project.Name, &project.Description, &project.StorageLimit, &project.EgressLimit, &project.CreatedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -8095,7 +11374,7 @@ func (obj *sqlite3Impl) All_Project_By_ProjectMember_MemberId_OrderBy_Asc_Projec
 	project_member_member_id ProjectMember_MemberId_Field) (
 	rows []*Project, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.created_at FROM projects  JOIN project_members ON projects.id = project_members.project_id WHERE project_members.member_id = ? ORDER BY projects.name")
+	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.storage_limit, projects.egress_limit, projects.created_at FROM projects  JOIN project_members ON projects.id = project_members.project_id WHERE project_members.member_id = ? ORDER BY projects.name")
 
 	var __values []interface{}
 	__values = append(__values, project_member_member_id.value())
@@ -8111,7 +11390,7 @@ func (obj *sqlite3Impl) All_Project_By_ProjectMember_MemberId_OrderBy_Asc_Projec
 
 	for __rows.Next() {
 		project := &Project{}
-		err = __rows.Scan(&project.Id, &project.Name, &project.Description, &project.CreatedAt)
+		err = __rows.Scan(&project.Id, &project.Name, &project.Description, &project.StorageLimit, &project.EgressLimit, &project.CreatedAt)
 		if err != nil {
 			return nil, obj.makeErr(err)
 		}
@@ -8128,7 +11407,7 @@ func (obj *sqlite3Impl) All_ProjectMember_By_MemberId(ctx context.Context,
 	project_member_member_id ProjectMember_MemberId_Field) (
 	rows []*ProjectMember, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT project_members.member_id, project_members.project_id, project_members.created_at FROM project_members WHERE project_members.member_id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_members.member_id, project_members.project_id, project_members.role, project_members.created_at FROM project_members WHERE project_members.member_id = ?")
 
 	var __values []interface{}
 	__values = append(__values, project_member_member_id.value())
@@ -8144,7 +11423,7 @@ func (obj *sqlite3Impl) All_ProjectMember_By_MemberId(ctx context.Context,
 
 	for __rows.Next() {
 		project_member := &ProjectMember{}
-		err = __rows.Scan(&project_member.MemberId, &project_member.ProjectId, &project_member.CreatedAt)
+		err = __rows.Scan(&project_member.MemberId, &project_member.ProjectId, &project_member.Role, &project_member.CreatedAt)
 		if err != nil {
 			return nil, obj.makeErr(err)
 		}
@@ -8162,7 +11441,7 @@ func (obj *sqlite3Impl) Limited_ProjectMember_By_ProjectId(ctx context.Context,
 	limit int, offset int64) (
 	rows []*ProjectMember, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT project_members.member_id, project_members.project_id, project_members.created_at FROM project_members WHERE project_members.project_id = ? LIMIT ? OFFSET ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_members.member_id, project_members.project_id, project_members.role, project_members.created_at FROM project_members WHERE project_members.project_id = ? LIMIT ? OFFSET ?")
 
 	var __values []interface{}
 	__values = append(__values, project_member_project_id.value())
@@ -8180,7 +11459,7 @@ func (obj *sqlite3Impl) Limited_ProjectMember_By_ProjectId(ctx context.Context,
 
 	for __rows.Next() {
 		project_member := &ProjectMember{}
-		err = __rows.Scan(&project_member.MemberId, &project_member.ProjectId, &project_member.CreatedAt)
+		err = __rows.Scan(&project_member.MemberId, &project_member.ProjectId, &project_member.Role, &project_member.CreatedAt)
 		if err != nil {
 			return nil, obj.makeErr(err)
 		}
@@ -8197,7 +11476,7 @@ func (obj *sqlite3Impl) Get_ApiKey_By_Id(ctx context.Context,
 	api_key_id ApiKey_Id_Field) (
 	api_key *ApiKey, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT api_keys.id, api_keys.project_id, api_keys.key, api_keys.name, api_keys.created_at FROM api_keys WHERE api_keys.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT api_keys.id, api_keys.project_id, api_keys.head, api_keys.name, api_keys.secret, api_keys.caveat, api_keys.created_at FROM api_keys WHERE api_keys.id = ?")
 
 	var __values []interface{}
 	__values = append(__values, api_key_id.value())
@@ -8206,7 +11485,7 @@ func (obj *sqlite3Impl) Get_ApiKey_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	api_key = &ApiKey{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&api_key.Id, &api_key.ProjectId, &api_key.Key, &api_key.Name, &api_key.CreatedAt)
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&api_key.Id, &api_key.ProjectId, &api_key.Head, &api_key.Name, &api_key.Secret, &api_key.Caveat, &api_key.CreatedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -8214,20 +11493,20 @@ func (obj *sqlite3Impl) Get_ApiKey_By_Id(ctx context.Context,
 
 }
 
-func (obj *sqlite3Impl) Get_ApiKey_By_Key(ctx context.Context,
-	api_key_key ApiKey_Key_Field) (
+func (obj *sqlite3Impl) Get_ApiKey_By_Head(ctx context.Context,
+	api_key_head ApiKey_Head_Field) (
 	api_key *ApiKey, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT api_keys.id, api_keys.project_id, api_keys.key, api_keys.name, api_keys.created_at FROM api_keys WHERE api_keys.key = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT api_keys.id, api_keys.project_id, api_keys.head, api_keys.name, api_keys.secret, api_keys.caveat, api_keys.created_at FROM api_keys WHERE api_keys.head = ?")
 
 	var __values []interface{}
-	__values = append(__values, api_key_key.value())
+	__values = append(__values, api_key_head.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
 	api_key = &ApiKey{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&api_key.Id, &api_key.ProjectId, &api_key.Key, &api_key.Name, &api_key.CreatedAt)
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&api_key.Id, &api_key.ProjectId, &api_key.Head, &api_key.Name, &api_key.Secret, &api_key.Caveat, &api_key.CreatedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -8239,7 +11518,7 @@ func (obj *sqlite3Impl) All_ApiKey_By_ProjectId_OrderBy_Asc_Name(ctx context.Con
 	api_key_project_id ApiKey_ProjectId_Field) (
 	rows []*ApiKey, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT api_keys.id, api_keys.project_id, api_keys.key, api_keys.name, api_keys.created_at FROM api_keys WHERE api_keys.project_id = ? ORDER BY api_keys.name")
+	var __embed_stmt = __sqlbundle_Literal("SELECT api_keys.id, api_keys.project_id, api_keys.head, api_keys.name, api_keys.secret, api_keys.caveat, api_keys.created_at FROM api_keys WHERE api_keys.project_id = ? ORDER BY api_keys.name")
 
 	var __values []interface{}
 	__values = append(__values, api_key_project_id.value())
@@ -8255,7 +11534,7 @@ func (obj *sqlite3Impl) All_ApiKey_By_ProjectId_OrderBy_Asc_Name(ctx context.Con
 
 	for __rows.Next() {
 		api_key := &ApiKey{}
-		err = __rows.Scan(&api_key.Id, &api_key.ProjectId, &api_key.Key, &api_key.Name, &api_key.CreatedAt)
+		err = __rows.Scan(&api_key.Id, &api_key.ProjectId, &api_key.Head, &api_key.Name, &api_key.Secret, &api_key.Caveat, &api_key.CreatedAt)
 		if err != nil {
 			return nil, obj.makeErr(err)
 		}
@@ -8824,18 +12103,292 @@ func (obj *sqlite3Impl) Get_Offer_By_Status_And_Type_And_ExpiresAt_GreaterOrEqua
 	if err := __rows.Err(); err != nil {
 		return nil, obj.makeErr(err)
 	}
-
-	return offer, nil
+
+	return offer, nil
+
+}
+
+func (obj *sqlite3Impl) All_Offer(ctx context.Context) (
+	rows []*Offer, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT offers.id, offers.name, offers.description, offers.type, offers.credit_in_cents, offers.award_credit_duration_days, offers.invitee_credit_duration_days, offers.redeemable_cap, offers.num_redeemed, offers.expires_at, offers.created_at, offers.status FROM offers")
+
+	var __values []interface{}
+	__values = append(__values)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		offer := &Offer{}
+		err = __rows.Scan(&offer.Id, &offer.Name, &offer.Description, &offer.Type, &offer.CreditInCents, &offer.AwardCreditDurationDays, &offer.InviteeCreditDurationDays, &offer.RedeemableCap, &offer.NumRedeemed, &offer.ExpiresAt, &offer.CreatedAt, &offer.Status)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, offer)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *sqlite3Impl) Get_Invoice_By_Id(ctx context.Context,
+	invoice_id Invoice_Id_Field) (
+	invoice *Invoice, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT invoices.id, invoices.project_id, invoices.period_start, invoices.period_end, invoices.storage, invoices.egress, invoices.object_count, invoices.segment_count, invoices.storage_amount, invoices.egress_amount, invoices.object_amount, invoices.segment_amount, invoices.total, invoices.created_at FROM invoices WHERE invoices.id = ?")
+
+	var __values []interface{}
+	__values = append(__values, invoice_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	invoice = &Invoice{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&invoice.Id, &invoice.ProjectId, &invoice.PeriodStart, &invoice.PeriodEnd, &invoice.Storage, &invoice.Egress, &invoice.ObjectCount, &invoice.SegmentCount, &invoice.StorageAmount, &invoice.EgressAmount, &invoice.ObjectAmount, &invoice.SegmentAmount, &invoice.Total, &invoice.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return invoice, nil
+
+}
+
+func (obj *sqlite3Impl) Find_Invoice_By_ProjectId_And_PeriodStart(ctx context.Context,
+	invoice_project_id Invoice_ProjectId_Field,
+	invoice_period_start Invoice_PeriodStart_Field) (
+	invoice *Invoice, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT invoices.id, invoices.project_id, invoices.period_start, invoices.period_end, invoices.storage, invoices.egress, invoices.object_count, invoices.segment_count, invoices.storage_amount, invoices.egress_amount, invoices.object_amount, invoices.segment_amount, invoices.total, invoices.created_at FROM invoices WHERE invoices.project_id = ? AND invoices.period_start = ?")
+
+	var __values []interface{}
+	__values = append(__values, invoice_project_id.value())
+	__values = append(__values, invoice_period_start.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	invoice = &Invoice{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&invoice.Id, &invoice.ProjectId, &invoice.PeriodStart, &invoice.PeriodEnd, &invoice.Storage, &invoice.Egress, &invoice.ObjectCount, &invoice.SegmentCount, &invoice.StorageAmount, &invoice.EgressAmount, &invoice.ObjectAmount, &invoice.SegmentAmount, &invoice.Total, &invoice.CreatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return invoice, nil
+
+}
+
+func (obj *sqlite3Impl) All_Invoice_By_ProjectId_OrderBy_Desc_PeriodStart(ctx context.Context,
+	invoice_project_id Invoice_ProjectId_Field) (
+	rows []*Invoice, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT invoices.id, invoices.project_id, invoices.period_start, invoices.period_end, invoices.storage, invoices.egress, invoices.object_count, invoices.segment_count, invoices.storage_amount, invoices.egress_amount, invoices.object_amount, invoices.segment_amount, invoices.total, invoices.created_at FROM invoices WHERE invoices.project_id = ? ORDER BY invoices.period_start DESC")
+
+	var __values []interface{}
+	__values = append(__values, invoice_project_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		invoice := &Invoice{}
+		err = __rows.Scan(&invoice.Id, &invoice.ProjectId, &invoice.PeriodStart, &invoice.PeriodEnd, &invoice.Storage, &invoice.Egress, &invoice.ObjectCount, &invoice.SegmentCount, &invoice.StorageAmount, &invoice.EgressAmount, &invoice.ObjectAmount, &invoice.SegmentAmount, &invoice.Total, &invoice.CreatedAt)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, invoice)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *sqlite3Impl) All_Invoice_By_PeriodStart(ctx context.Context,
+	invoice_period_start Invoice_PeriodStart_Field) (
+	rows []*Invoice, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT invoices.id, invoices.project_id, invoices.period_start, invoices.period_end, invoices.storage, invoices.egress, invoices.object_count, invoices.segment_count, invoices.storage_amount, invoices.egress_amount, invoices.object_amount, invoices.segment_amount, invoices.total, invoices.created_at FROM invoices WHERE invoices.period_start = ?")
+
+	var __values []interface{}
+	__values = append(__values, invoice_period_start.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		invoice := &Invoice{}
+		err = __rows.Scan(&invoice.Id, &invoice.ProjectId, &invoice.PeriodStart, &invoice.PeriodEnd, &invoice.Storage, &invoice.Egress, &invoice.ObjectCount, &invoice.SegmentCount, &invoice.StorageAmount, &invoice.EgressAmount, &invoice.ObjectAmount, &invoice.SegmentAmount, &invoice.Total, &invoice.CreatedAt)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, invoice)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *sqlite3Impl) Find_PayoutStatement_By_NodeId_And_PeriodStart(ctx context.Context,
+	payout_statement_node_id PayoutStatement_NodeId_Field,
+	payout_statement_period_start PayoutStatement_PeriodStart_Field) (
+	payout_statement *PayoutStatement, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT payout_statements.node_id, payout_statements.period_start, payout_statements.period_end, payout_statements.node_created_at, payout_statements.wallet, payout_statements.at_rest_total, payout_statements.get_total, payout_statements.get_repair_total, payout_statements.get_audit_total, payout_statements.put_total, payout_statements.put_repair_total, payout_statements.at_rest_amount, payout_statements.get_amount, payout_statements.repair_amount, payout_statements.audit_amount, payout_statements.earned, payout_statements.held_percent, payout_statements.held, payout_statements.disqualified, payout_statements.paid, payout_statements.created_at FROM payout_statements WHERE payout_statements.node_id = ? AND payout_statements.period_start = ?")
+
+	var __values []interface{}
+	__values = append(__values, payout_statement_node_id.value())
+	__values = append(__values, payout_statement_period_start.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	payout_statement = &PayoutStatement{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&payout_statement.NodeId, &payout_statement.PeriodStart, &payout_statement.PeriodEnd, &payout_statement.NodeCreatedAt, &payout_statement.Wallet, &payout_statement.AtRestTotal, &payout_statement.GetTotal, &payout_statement.GetRepairTotal, &payout_statement.GetAuditTotal, &payout_statement.PutTotal, &payout_statement.PutRepairTotal, &payout_statement.AtRestAmount, &payout_statement.GetAmount, &payout_statement.RepairAmount, &payout_statement.AuditAmount, &payout_statement.Earned, &payout_statement.HeldPercent, &payout_statement.Held, &payout_statement.Disqualified, &payout_statement.Paid, &payout_statement.CreatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return payout_statement, nil
+
+}
+
+func (obj *sqlite3Impl) All_PayoutStatement_By_NodeId_OrderBy_Desc_PeriodStart(ctx context.Context,
+	payout_statement_node_id PayoutStatement_NodeId_Field) (
+	rows []*PayoutStatement, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT payout_statements.node_id, payout_statements.period_start, payout_statements.period_end, payout_statements.node_created_at, payout_statements.wallet, payout_statements.at_rest_total, payout_statements.get_total, payout_statements.get_repair_total, payout_statements.get_audit_total, payout_statements.put_total, payout_statements.put_repair_total, payout_statements.at_rest_amount, payout_statements.get_amount, payout_statements.repair_amount, payout_statements.audit_amount, payout_statements.earned, payout_statements.held_percent, payout_statements.held, payout_statements.disqualified, payout_statements.paid, payout_statements.created_at FROM payout_statements WHERE payout_statements.node_id = ? ORDER BY payout_statements.period_start DESC")
+
+	var __values []interface{}
+	__values = append(__values, payout_statement_node_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		payout_statement := &PayoutStatement{}
+		err = __rows.Scan(&payout_statement.NodeId, &payout_statement.PeriodStart, &payout_statement.PeriodEnd, &payout_statement.NodeCreatedAt, &payout_statement.Wallet, &payout_statement.AtRestTotal, &payout_statement.GetTotal, &payout_statement.GetRepairTotal, &payout_statement.GetAuditTotal, &payout_statement.PutTotal, &payout_statement.PutRepairTotal, &payout_statement.AtRestAmount, &payout_statement.GetAmount, &payout_statement.RepairAmount, &payout_statement.AuditAmount, &payout_statement.Earned, &payout_statement.HeldPercent, &payout_statement.Held, &payout_statement.Disqualified, &payout_statement.Paid, &payout_statement.CreatedAt)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, payout_statement)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *sqlite3Impl) All_PayoutStatement_By_PeriodStart_OrderBy_Asc_NodeId(ctx context.Context,
+	payout_statement_period_start PayoutStatement_PeriodStart_Field) (
+	rows []*PayoutStatement, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT payout_statements.node_id, payout_statements.period_start, payout_statements.period_end, payout_statements.node_created_at, payout_statements.wallet, payout_statements.at_rest_total, payout_statements.get_total, payout_statements.get_repair_total, payout_statements.get_audit_total, payout_statements.put_total, payout_statements.put_repair_total, payout_statements.at_rest_amount, payout_statements.get_amount, payout_statements.repair_amount, payout_statements.audit_amount, payout_statements.earned, payout_statements.held_percent, payout_statements.held, payout_statements.disqualified, payout_statements.paid, payout_statements.created_at FROM payout_statements WHERE payout_statements.period_start = ? ORDER BY payout_statements.node_id")
+
+	var __values []interface{}
+	__values = append(__values, payout_statement_period_start.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		payout_statement := &PayoutStatement{}
+		err = __rows.Scan(&payout_statement.NodeId, &payout_statement.PeriodStart, &payout_statement.PeriodEnd, &payout_statement.NodeCreatedAt, &payout_statement.Wallet, &payout_statement.AtRestTotal, &payout_statement.GetTotal, &payout_statement.GetRepairTotal, &payout_statement.GetAuditTotal, &payout_statement.PutTotal, &payout_statement.PutRepairTotal, &payout_statement.AtRestAmount, &payout_statement.GetAmount, &payout_statement.RepairAmount, &payout_statement.AuditAmount, &payout_statement.Earned, &payout_statement.HeldPercent, &payout_statement.Held, &payout_statement.Disqualified, &payout_statement.Paid, &payout_statement.CreatedAt)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, payout_statement)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *sqlite3Impl) All_ApiKeyRevocation_By_ApiKeyId(ctx context.Context,
+	api_key_revocation_api_key_id ApiKeyRevocation_ApiKeyId_Field) (
+	rows []*ApiKeyRevocation, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT api_key_revocations.api_key_id, api_key_revocations.tail, api_key_revocations.created_at FROM api_key_revocations WHERE api_key_revocations.api_key_id = ?")
+
+	var __values []interface{}
+	__values = append(__values, api_key_revocation_api_key_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		api_key_revocation := &ApiKeyRevocation{}
+		err = __rows.Scan(&api_key_revocation.ApiKeyId, &api_key_revocation.Tail, &api_key_revocation.CreatedAt)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, api_key_revocation)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
 
 }
 
-func (obj *sqlite3Impl) All_Offer(ctx context.Context) (
-	rows []*Offer, err error) {
+func (obj *sqlite3Impl) Limited_AuditEvent_By_ProjectId_OrderBy_Desc_CreatedAt(ctx context.Context,
+	audit_event_project_id AuditEvent_ProjectId_Field,
+	limit int, offset int64) (
+	rows []*AuditEvent, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT offers.id, offers.name, offers.description, offers.type, offers.credit_in_cents, offers.award_credit_duration_days, offers.invitee_credit_duration_days, offers.redeemable_cap, offers.num_redeemed, offers.expires_at, offers.created_at, offers.status FROM offers")
+	var __embed_stmt = __sqlbundle_Literal("SELECT audit_events.id, audit_events.project_id, audit_events.actor_id, audit_events.action, audit_events.target, audit_events.source_ip, audit_events.created_at FROM audit_events WHERE audit_events.project_id = ? ORDER BY audit_events.created_at DESC LIMIT ? OFFSET ?")
 
 	var __values []interface{}
-	__values = append(__values)
+	__values = append(__values, audit_event_project_id.value())
+
+	__values = append(__values, limit, offset)
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
@@ -8847,12 +12400,12 @@ func (obj *sqlite3Impl) All_Offer(ctx context.Context) (
 	defer __rows.Close()
 
 	for __rows.Next() {
-		offer := &Offer{}
-		err = __rows.Scan(&offer.Id, &offer.Name, &offer.Description, &offer.Type, &offer.CreditInCents, &offer.AwardCreditDurationDays, &offer.InviteeCreditDurationDays, &offer.RedeemableCap, &offer.NumRedeemed, &offer.ExpiresAt, &offer.CreatedAt, &offer.Status)
+		audit_event := &AuditEvent{}
+		err = __rows.Scan(&audit_event.Id, &audit_event.ProjectId, &audit_event.ActorId, &audit_event.Action, &audit_event.Target, &audit_event.SourceIp, &audit_event.CreatedAt)
 		if err != nil {
 			return nil, obj.makeErr(err)
 		}
-		rows = append(rows, offer)
+		rows = append(rows, audit_event)
 	}
 	if err := __rows.Err(); err != nil {
 		return nil, obj.makeErr(err)
@@ -8861,6 +12414,56 @@ func (obj *sqlite3Impl) All_Offer(ctx context.Context) (
 
 }
 
+func (obj *sqlite3Impl) Find_NotificationPreference_By_UserId(ctx context.Context,
+	notification_preference_user_id NotificationPreference_UserId_Field) (
+	notification_preference *NotificationPreference, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT notification_preferences.user_id, notification_preferences.usage_thresholds, notification_preferences.api_key_created, notification_preferences.project_member_added, notification_preferences.updated_at FROM notification_preferences WHERE notification_preferences.user_id = ?")
+
+	var __values []interface{}
+	__values = append(__values, notification_preference_user_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	notification_preference = &NotificationPreference{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&notification_preference.UserId, &notification_preference.UsageThresholds, &notification_preference.ApiKeyCreated, &notification_preference.ProjectMemberAdded, &notification_preference.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return notification_preference, nil
+
+}
+
+func (obj *sqlite3Impl) Has_UsageNotification_By_ProjectId_And_Resource_And_Threshold_And_PeriodStart(ctx context.Context,
+	usage_notification_project_id UsageNotification_ProjectId_Field,
+	usage_notification_resource UsageNotification_Resource_Field,
+	usage_notification_threshold UsageNotification_Threshold_Field,
+	usage_notification_period_start UsageNotification_PeriodStart_Field) (
+	has bool, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT EXISTS( SELECT 1 FROM usage_notifications WHERE usage_notifications.project_id = ? AND usage_notifications.resource = ? AND usage_notifications.threshold = ? AND usage_notifications.period_start = ? )")
+
+	var __values []interface{}
+	__values = append(__values, usage_notification_project_id.value())
+	__values = append(__values, usage_notification_resource.value())
+	__values = append(__values, usage_notification_threshold.value())
+	__values = append(__values, usage_notification_period_start.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&has)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+	return has, nil
+
+}
+
 func (obj *sqlite3Impl) Update_PendingAudits_By_NodeId(ctx context.Context,
 	pending_audits_node_id PendingAudits_NodeId_Field,
 	update PendingAudits_Update_Fields) (
@@ -8896,12 +12499,12 @@ func (obj *sqlite3Impl) Update_PendingAudits_By_NodeId(ctx context.Context,
 		return nil, obj.makeErr(err)
 	}
 
-	var __embed_stmt_get = __sqlbundle_Literal("SELECT pending_audits.node_id, pending_audits.piece_id, pending_audits.stripe_index, pending_audits.share_size, pending_audits.expected_share_hash, pending_audits.reverify_count FROM pending_audits WHERE pending_audits.node_id = ?")
+	var __embed_stmt_get = __sqlbundle_Literal("SELECT pending_audits.node_id, pending_audits.piece_id, pending_audits.stripe_index, pending_audits.share_size, pending_audits.expected_share_hash, pending_audits.reverify_count, pending_audits.piece_num, pending_audits.path FROM pending_audits WHERE pending_audits.node_id = ?")
 
 	var __stmt_get = __sqlbundle_Render(obj.dialect, __embed_stmt_get)
 	obj.logStmt("(IMPLIED) "+__stmt_get, __args...)
 
-	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&pending_audits.NodeId, &pending_audits.PieceId, &pending_audits.StripeIndex, &pending_audits.ShareSize, &pending_audits.ExpectedShareHash, &pending_audits.ReverifyCount)
+	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&pending_audits.NodeId, &pending_audits.PieceId, &pending_audits.StripeIndex, &pending_audits.ShareSize, &pending_audits.ExpectedShareHash, &pending_audits.ReverifyCount, &pending_audits.PieceNum, &pending_audits.Path)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -9143,6 +12746,26 @@ func (obj *sqlite3Impl) Update_Node_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("uptime_ratio = ?"))
 	}
 
+	if update.AuditReputationAlpha._set {
+		__values = append(__values, update.AuditReputationAlpha.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_reputation_alpha = ?"))
+	}
+
+	if update.AuditReputationBeta._set {
+		__values = append(__values, update.AuditReputationBeta.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_reputation_beta = ?"))
+	}
+
+	if update.UptimeReputationAlpha._set {
+		__values = append(__values, update.UptimeReputationAlpha.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("uptime_reputation_alpha = ?"))
+	}
+
+	if update.UptimeReputationBeta._set {
+		__values = append(__values, update.UptimeReputationBeta.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("uptime_reputation_beta = ?"))
+	}
+
 	if update.LastContactSuccess._set {
 		__values = append(__values, update.LastContactSuccess.value())
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_contact_success = ?"))
@@ -9158,6 +12781,16 @@ func (obj *sqlite3Impl) Update_Node_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("contained = ?"))
 	}
 
+	if update.Disqualified._set {
+		__values = append(__values, update.Disqualified.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("disqualified = ?"))
+	}
+
+	if update.LastAudited._set {
+		__values = append(__values, update.LastAudited.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_audited = ?"))
+	}
+
 	__now := obj.db.Hooks.Now().UTC()
 
 	__values = append(__values, __now)
@@ -9177,12 +12810,12 @@ func (obj *sqlite3Impl) Update_Node_By_Id(ctx context.Context,
 		return nil, obj.makeErr(err)
 	}
 
-	var __embed_stmt_get = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_ip, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.free_bandwidth, nodes.free_disk, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.audit_success_count, nodes.total_audit_count, nodes.audit_success_ratio, nodes.uptime_success_count, nodes.total_uptime_count, nodes.uptime_ratio, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.contained FROM nodes WHERE nodes.id = ?")
+	var __embed_stmt_get = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_ip, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.free_bandwidth, nodes.free_disk, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.audit_success_count, nodes.total_audit_count, nodes.audit_success_ratio, nodes.uptime_success_count, nodes.total_uptime_count, nodes.uptime_ratio, nodes.audit_reputation_alpha, nodes.audit_reputation_beta, nodes.uptime_reputation_alpha, nodes.uptime_reputation_beta, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.contained, nodes.disqualified, nodes.last_audited FROM nodes WHERE nodes.id = ?")
 
 	var __stmt_get = __sqlbundle_Render(obj.dialect, __embed_stmt_get)
 	obj.logStmt("(IMPLIED) "+__stmt_get, __args...)
 
-	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&node.Id, &node.Address, &node.LastIp, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.FreeBandwidth, &node.FreeDisk, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.AuditSuccessCount, &node.TotalAuditCount, &node.AuditSuccessRatio, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.UptimeRatio, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Contained)
+	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&node.Id, &node.Address, &node.LastIp, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.FreeBandwidth, &node.FreeDisk, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.AuditSuccessCount, &node.TotalAuditCount, &node.AuditSuccessRatio, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.UptimeRatio, &node.AuditReputationAlpha, &node.AuditReputationBeta, &node.UptimeReputationAlpha, &node.UptimeReputationBeta, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Contained, &node.Disqualified, &node.LastAudited)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -9229,6 +12862,21 @@ func (obj *sqlite3Impl) Update_User_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("status = ?"))
 	}
 
+	if update.MfaEnabled._set {
+		__values = append(__values, update.MfaEnabled.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("mfa_enabled = ?"))
+	}
+
+	if update.MfaSecretKey._set {
+		__values = append(__values, update.MfaSecretKey.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("mfa_secret_key = ?"))
+	}
+
+	if update.MfaRecoveryCodes._set {
+		__values = append(__values, update.MfaRecoveryCodes.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("mfa_recovery_codes = ?"))
+	}
+
 	if len(__sets_sql.SQLs) == 0 {
 		return nil, emptyUpdate()
 	}
@@ -9247,12 +12895,12 @@ func (obj *sqlite3Impl) Update_User_By_Id(ctx context.Context,
 		return nil, obj.makeErr(err)
 	}
 
-	var __embed_stmt_get = __sqlbundle_Literal("SELECT users.id, users.full_name, users.short_name, users.email, users.password_hash, users.status, users.created_at FROM users WHERE users.id = ?")
+	var __embed_stmt_get = __sqlbundle_Literal("SELECT users.id, users.full_name, users.short_name, users.email, users.password_hash, users.status, users.mfa_enabled, users.mfa_secret_key, users.mfa_recovery_codes, users.created_at FROM users WHERE users.id = ?")
 
 	var __stmt_get = __sqlbundle_Render(obj.dialect, __embed_stmt_get)
 	obj.logStmt("(IMPLIED) "+__stmt_get, __args...)
 
-	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&user.Id, &user.FullName, &user.ShortName, &user.Email, &user.PasswordHash, &user.Status, &user.CreatedAt)
+	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&user.Id, &user.FullName, &user.ShortName, &user.Email, &user.PasswordHash, &user.Status, &user.MfaEnabled, &user.MfaSecretKey, &user.MfaRecoveryCodes, &user.CreatedAt)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -9279,6 +12927,16 @@ func (obj *sqlite3Impl) Update_Project_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("description = ?"))
 	}
 
+	if update.StorageLimit._set {
+		__values = append(__values, update.StorageLimit.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("storage_limit = ?"))
+	}
+
+	if update.EgressLimit._set {
+		__values = append(__values, update.EgressLimit.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("egress_limit = ?"))
+	}
+
 	if len(__sets_sql.SQLs) == 0 {
 		return nil, emptyUpdate()
 	}
@@ -9297,12 +12955,12 @@ func (obj *sqlite3Impl) Update_Project_By_Id(ctx context.Context,
 		return nil, obj.makeErr(err)
 	}
 
-	var __embed_stmt_get = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.created_at FROM projects WHERE projects.id = ?")
+	var __embed_stmt_get = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.storage_limit, projects.egress_limit, projects.created_at FROM projects WHERE projects.id = ?")
 
 	var __stmt_get = __sqlbundle_Render(obj.dialect, __embed_stmt_get)
 	obj.logStmt("(IMPLIED) "+__stmt_get, __args...)
 
-	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&project.Id, &project.Name, &project.Description, &project.CreatedAt)
+	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&project.Id, &project.Name, &project.Description, &project.StorageLimit, &project.EgressLimit, &project.CreatedAt)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -9312,6 +12970,57 @@ func (obj *sqlite3Impl) Update_Project_By_Id(ctx context.Context,
 	return project, nil
 }
 
+func (obj *sqlite3Impl) Update_ProjectMember_By_MemberId_And_ProjectId(ctx context.Context,
+	project_member_member_id ProjectMember_MemberId_Field,
+	project_member_project_id ProjectMember_ProjectId_Field,
+	update ProjectMember_Update_Fields) (
+	project_member *ProjectMember, err error) {
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE project_members SET "), __sets, __sqlbundle_Literal(" WHERE project_members.member_id = ? AND project_members.project_id = ?")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.Role._set {
+		__values = append(__values, update.Role.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("role = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
+
+	__args = append(__args, project_member_member_id.value(), project_member_project_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	project_member = &ProjectMember{}
+	_, err = obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+
+	var __embed_stmt_get = __sqlbundle_Literal("SELECT project_members.member_id, project_members.project_id, project_members.role, project_members.created_at FROM project_members WHERE project_members.member_id = ? AND project_members.project_id = ?")
+
+	var __stmt_get = __sqlbundle_Render(obj.dialect, __embed_stmt_get)
+	obj.logStmt("(IMPLIED) "+__stmt_get, __args...)
+
+	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&project_member.MemberId, &project_member.ProjectId, &project_member.Role, &project_member.CreatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return project_member, nil
+}
+
 func (obj *sqlite3Impl) Update_ApiKey_By_Id(ctx context.Context,
 	api_key_id ApiKey_Id_Field,
 	update ApiKey_Update_Fields) (
@@ -9347,12 +13056,12 @@ func (obj *sqlite3Impl) Update_ApiKey_By_Id(ctx context.Context,
 		return nil, obj.makeErr(err)
 	}
 
-	var __embed_stmt_get = __sqlbundle_Literal("SELECT api_keys.id, api_keys.project_id, api_keys.key, api_keys.name, api_keys.created_at FROM api_keys WHERE api_keys.id = ?")
+	var __embed_stmt_get = __sqlbundle_Literal("SELECT api_keys.id, api_keys.project_id, api_keys.head, api_keys.name, api_keys.secret, api_keys.caveat, api_keys.created_at FROM api_keys WHERE api_keys.id = ?")
 
 	var __stmt_get = __sqlbundle_Render(obj.dialect, __embed_stmt_get)
 	obj.logStmt("(IMPLIED) "+__stmt_get, __args...)
 
-	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&api_key.Id, &api_key.ProjectId, &api_key.Key, &api_key.Name, &api_key.CreatedAt)
+	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&api_key.Id, &api_key.ProjectId, &api_key.Head, &api_key.Name, &api_key.Secret, &api_key.Caveat, &api_key.CreatedAt)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -9553,6 +13262,67 @@ func (obj *sqlite3Impl) Update_Offer_By_Id_And_Status_Equal_Number_And_ExpiresAt
 	return offer, nil
 }
 
+func (obj *sqlite3Impl) Update_NotificationPreference_By_UserId(ctx context.Context,
+	notification_preference_user_id NotificationPreference_UserId_Field,
+	update NotificationPreference_Update_Fields) (
+	notification_preference *NotificationPreference, err error) {
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE notification_preferences SET "), __sets, __sqlbundle_Literal(" WHERE notification_preferences.user_id = ?")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.UsageThresholds._set {
+		__values = append(__values, update.UsageThresholds.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("usage_thresholds = ?"))
+	}
+
+	if update.ApiKeyCreated._set {
+		__values = append(__values, update.ApiKeyCreated.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("api_key_created = ?"))
+	}
+
+	if update.ProjectMemberAdded._set {
+		__values = append(__values, update.ProjectMemberAdded.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("project_member_added = ?"))
+	}
+
+	__now := obj.db.Hooks.Now().UTC()
+
+	__values = append(__values, __now)
+	__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("updated_at = ?"))
+
+	__args = append(__args, notification_preference_user_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	notification_preference = &NotificationPreference{}
+	_, err = obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+
+	var __embed_stmt_get = __sqlbundle_Literal("SELECT notification_preferences.user_id, notification_preferences.usage_thresholds, notification_preferences.api_key_created, notification_preferences.project_member_added, notification_preferences.updated_at FROM notification_preferences WHERE notification_preferences.user_id = ?")
+
+	var __stmt_get = __sqlbundle_Render(obj.dialect, __embed_stmt_get)
+	obj.logStmt("(IMPLIED) "+__stmt_get, __args...)
+
+	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&notification_preference.UserId, &notification_preference.UsageThresholds, &notification_preference.ApiKeyCreated, &notification_preference.ProjectMemberAdded, &notification_preference.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return notification_preference, nil
+}
+
 func (obj *sqlite3Impl) Delete_PendingAudits_By_NodeId(ctx context.Context,
 	pending_audits_node_id PendingAudits_NodeId_Field) (
 	deleted bool, err error) {
@@ -9918,17 +13688,43 @@ func (obj *sqlite3Impl) Delete_Offer_By_Id(ctx context.Context,
 
 }
 
+func (obj *sqlite3Impl) Delete_AuditEvent_By_CreatedAt_Less(ctx context.Context,
+	audit_event_created_at_less AuditEvent_CreatedAt_Field) (
+	count int64, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM audit_events WHERE audit_events.created_at < ?")
+
+	var __values []interface{}
+	__values = append(__values, audit_event_created_at_less.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	return count, nil
+
+}
+
 func (obj *sqlite3Impl) getLastPendingAudits(ctx context.Context,
 	pk int64) (
 	pending_audits *PendingAudits, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT pending_audits.node_id, pending_audits.piece_id, pending_audits.stripe_index, pending_audits.share_size, pending_audits.expected_share_hash, pending_audits.reverify_count FROM pending_audits WHERE _rowid_ = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT pending_audits.node_id, pending_audits.piece_id, pending_audits.stripe_index, pending_audits.share_size, pending_audits.expected_share_hash, pending_audits.reverify_count, pending_audits.piece_num, pending_audits.path FROM pending_audits WHERE _rowid_ = ?")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, pk)
 
 	pending_audits = &PendingAudits{}
-	err = obj.driver.QueryRow(__stmt, pk).Scan(&pending_audits.NodeId, &pending_audits.PieceId, &pending_audits.StripeIndex, &pending_audits.ShareSize, &pending_audits.ExpectedShareHash, &pending_audits.ReverifyCount)
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&pending_audits.NodeId, &pending_audits.PieceId, &pending_audits.StripeIndex, &pending_audits.ShareSize, &pending_audits.ExpectedShareHash, &pending_audits.ReverifyCount, &pending_audits.PieceNum, &pending_audits.Path)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -9994,13 +13790,13 @@ func (obj *sqlite3Impl) getLastNode(ctx context.Context,
 	pk int64) (
 	node *Node, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_ip, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.free_bandwidth, nodes.free_disk, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.audit_success_count, nodes.total_audit_count, nodes.audit_success_ratio, nodes.uptime_success_count, nodes.total_uptime_count, nodes.uptime_ratio, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.contained FROM nodes WHERE _rowid_ = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_ip, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.free_bandwidth, nodes.free_disk, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.audit_success_count, nodes.total_audit_count, nodes.audit_success_ratio, nodes.uptime_success_count, nodes.total_uptime_count, nodes.uptime_ratio, nodes.audit_reputation_alpha, nodes.audit_reputation_beta, nodes.uptime_reputation_alpha, nodes.uptime_reputation_beta, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.contained, nodes.disqualified, nodes.last_audited FROM nodes WHERE _rowid_ = ?")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, pk)
 
 	node = &Node{}
-	err = obj.driver.QueryRow(__stmt, pk).Scan(&node.Id, &node.Address, &node.LastIp, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.FreeBandwidth, &node.FreeDisk, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.AuditSuccessCount, &node.TotalAuditCount, &node.AuditSuccessRatio, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.UptimeRatio, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Contained)
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&node.Id, &node.Address, &node.LastIp, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.FreeBandwidth, &node.FreeDisk, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.AuditSuccessCount, &node.TotalAuditCount, &node.AuditSuccessRatio, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.UptimeRatio, &node.AuditReputationAlpha, &node.AuditReputationBeta, &node.UptimeReputationAlpha, &node.UptimeReputationBeta, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Contained, &node.Disqualified, &node.LastAudited)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -10012,13 +13808,13 @@ func (obj *sqlite3Impl) getLastUser(ctx context.Context,
 	pk int64) (
 	user *User, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT users.id, users.full_name, users.short_name, users.email, users.password_hash, users.status, users.created_at FROM users WHERE _rowid_ = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT users.id, users.full_name, users.short_name, users.email, users.password_hash, users.status, users.mfa_enabled, users.mfa_secret_key, users.mfa_recovery_codes, users.created_at FROM users WHERE _rowid_ = ?")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, pk)
 
 	user = &User{}
-	err = obj.driver.QueryRow(__stmt, pk).Scan(&user.Id, &user.FullName, &user.ShortName, &user.Email, &user.PasswordHash, &user.Status, &user.CreatedAt)
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&user.Id, &user.FullName, &user.ShortName, &user.Email, &user.PasswordHash, &user.Status, &user.MfaEnabled, &user.MfaSecretKey, &user.MfaRecoveryCodes, &user.CreatedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -10030,13 +13826,13 @@ func (obj *sqlite3Impl) getLastProject(ctx context.Context,
 	pk int64) (
 	project *Project, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.created_at FROM projects WHERE _rowid_ = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.storage_limit, projects.egress_limit, projects.created_at FROM projects WHERE _rowid_ = ?")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, pk)
 
 	project = &Project{}
-	err = obj.driver.QueryRow(__stmt, pk).Scan(&project.Id, &project.Name, &project.Description, &project.CreatedAt)
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&project.Id, &project.Name, &project.Description, &project.StorageLimit, &project.EgressLimit, &project.CreatedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -10048,13 +13844,13 @@ func (obj *sqlite3Impl) getLastProjectMember(ctx context.Context,
 	pk int64) (
 	project_member *ProjectMember, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT project_members.member_id, project_members.project_id, project_members.created_at FROM project_members WHERE _rowid_ = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_members.member_id, project_members.project_id, project_members.role, project_members.created_at FROM project_members WHERE _rowid_ = ?")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, pk)
 
 	project_member = &ProjectMember{}
-	err = obj.driver.QueryRow(__stmt, pk).Scan(&project_member.MemberId, &project_member.ProjectId, &project_member.CreatedAt)
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&project_member.MemberId, &project_member.ProjectId, &project_member.Role, &project_member.CreatedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -10066,13 +13862,13 @@ func (obj *sqlite3Impl) getLastApiKey(ctx context.Context,
 	pk int64) (
 	api_key *ApiKey, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT api_keys.id, api_keys.project_id, api_keys.key, api_keys.name, api_keys.created_at FROM api_keys WHERE _rowid_ = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT api_keys.id, api_keys.project_id, api_keys.head, api_keys.name, api_keys.secret, api_keys.caveat, api_keys.created_at FROM api_keys WHERE _rowid_ = ?")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, pk)
 
 	api_key = &ApiKey{}
-	err = obj.driver.QueryRow(__stmt, pk).Scan(&api_key.Id, &api_key.ProjectId, &api_key.Key, &api_key.Name, &api_key.CreatedAt)
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&api_key.Id, &api_key.ProjectId, &api_key.Head, &api_key.Name, &api_key.Secret, &api_key.Caveat, &api_key.CreatedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -10215,30 +14011,138 @@ func (obj *sqlite3Impl) getLastResetPasswordToken(ctx context.Context,
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, pk)
 
-	reset_password_token = &ResetPasswordToken{}
-	err = obj.driver.QueryRow(__stmt, pk).Scan(&reset_password_token.Secret, &reset_password_token.OwnerId, &reset_password_token.CreatedAt)
+	reset_password_token = &ResetPasswordToken{}
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&reset_password_token.Secret, &reset_password_token.OwnerId, &reset_password_token.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return reset_password_token, nil
+
+}
+
+func (obj *sqlite3Impl) getLastOffer(ctx context.Context,
+	pk int64) (
+	offer *Offer, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT offers.id, offers.name, offers.description, offers.type, offers.credit_in_cents, offers.award_credit_duration_days, offers.invitee_credit_duration_days, offers.redeemable_cap, offers.num_redeemed, offers.expires_at, offers.created_at, offers.status FROM offers WHERE _rowid_ = ?")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, pk)
+
+	offer = &Offer{}
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&offer.Id, &offer.Name, &offer.Description, &offer.Type, &offer.CreditInCents, &offer.AwardCreditDurationDays, &offer.InviteeCreditDurationDays, &offer.RedeemableCap, &offer.NumRedeemed, &offer.ExpiresAt, &offer.CreatedAt, &offer.Status)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return offer, nil
+
+}
+
+func (obj *sqlite3Impl) getLastInvoice(ctx context.Context,
+	pk int64) (
+	invoice *Invoice, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT invoices.id, invoices.project_id, invoices.period_start, invoices.period_end, invoices.storage, invoices.egress, invoices.object_count, invoices.segment_count, invoices.storage_amount, invoices.egress_amount, invoices.object_amount, invoices.segment_amount, invoices.total, invoices.created_at FROM invoices WHERE _rowid_ = ?")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, pk)
+
+	invoice = &Invoice{}
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&invoice.Id, &invoice.ProjectId, &invoice.PeriodStart, &invoice.PeriodEnd, &invoice.Storage, &invoice.Egress, &invoice.ObjectCount, &invoice.SegmentCount, &invoice.StorageAmount, &invoice.EgressAmount, &invoice.ObjectAmount, &invoice.SegmentAmount, &invoice.Total, &invoice.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return invoice, nil
+
+}
+
+func (obj *sqlite3Impl) getLastPayoutStatement(ctx context.Context,
+	pk int64) (
+	payout_statement *PayoutStatement, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT payout_statements.node_id, payout_statements.period_start, payout_statements.period_end, payout_statements.node_created_at, payout_statements.wallet, payout_statements.at_rest_total, payout_statements.get_total, payout_statements.get_repair_total, payout_statements.get_audit_total, payout_statements.put_total, payout_statements.put_repair_total, payout_statements.at_rest_amount, payout_statements.get_amount, payout_statements.repair_amount, payout_statements.audit_amount, payout_statements.earned, payout_statements.held_percent, payout_statements.held, payout_statements.disqualified, payout_statements.paid, payout_statements.created_at FROM payout_statements WHERE _rowid_ = ?")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, pk)
+
+	payout_statement = &PayoutStatement{}
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&payout_statement.NodeId, &payout_statement.PeriodStart, &payout_statement.PeriodEnd, &payout_statement.NodeCreatedAt, &payout_statement.Wallet, &payout_statement.AtRestTotal, &payout_statement.GetTotal, &payout_statement.GetRepairTotal, &payout_statement.GetAuditTotal, &payout_statement.PutTotal, &payout_statement.PutRepairTotal, &payout_statement.AtRestAmount, &payout_statement.GetAmount, &payout_statement.RepairAmount, &payout_statement.AuditAmount, &payout_statement.Earned, &payout_statement.HeldPercent, &payout_statement.Held, &payout_statement.Disqualified, &payout_statement.Paid, &payout_statement.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return payout_statement, nil
+
+}
+
+func (obj *sqlite3Impl) getLastApiKeyRevocation(ctx context.Context,
+	pk int64) (
+	api_key_revocation *ApiKeyRevocation, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT api_key_revocations.api_key_id, api_key_revocations.tail, api_key_revocations.created_at FROM api_key_revocations WHERE _rowid_ = ?")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, pk)
+
+	api_key_revocation = &ApiKeyRevocation{}
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&api_key_revocation.ApiKeyId, &api_key_revocation.Tail, &api_key_revocation.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return api_key_revocation, nil
+
+}
+
+func (obj *sqlite3Impl) getLastAuditEvent(ctx context.Context,
+	pk int64) (
+	audit_event *AuditEvent, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT audit_events.id, audit_events.project_id, audit_events.actor_id, audit_events.action, audit_events.target, audit_events.source_ip, audit_events.created_at FROM audit_events WHERE _rowid_ = ?")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, pk)
+
+	audit_event = &AuditEvent{}
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&audit_event.Id, &audit_event.ProjectId, &audit_event.ActorId, &audit_event.Action, &audit_event.Target, &audit_event.SourceIp, &audit_event.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return audit_event, nil
+
+}
+
+func (obj *sqlite3Impl) getLastNotificationPreference(ctx context.Context,
+	pk int64) (
+	notification_preference *NotificationPreference, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT notification_preferences.user_id, notification_preferences.usage_thresholds, notification_preferences.api_key_created, notification_preferences.project_member_added, notification_preferences.updated_at FROM notification_preferences WHERE _rowid_ = ?")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, pk)
+
+	notification_preference = &NotificationPreference{}
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&notification_preference.UserId, &notification_preference.UsageThresholds, &notification_preference.ApiKeyCreated, &notification_preference.ProjectMemberAdded, &notification_preference.UpdatedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	return reset_password_token, nil
+	return notification_preference, nil
 
 }
 
-func (obj *sqlite3Impl) getLastOffer(ctx context.Context,
+func (obj *sqlite3Impl) getLastUsageNotification(ctx context.Context,
 	pk int64) (
-	offer *Offer, err error) {
+	usage_notification *UsageNotification, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT offers.id, offers.name, offers.description, offers.type, offers.credit_in_cents, offers.award_credit_duration_days, offers.invitee_credit_duration_days, offers.redeemable_cap, offers.num_redeemed, offers.expires_at, offers.created_at, offers.status FROM offers WHERE _rowid_ = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT usage_notifications.project_id, usage_notifications.resource, usage_notifications.threshold, usage_notifications.period_start, usage_notifications.created_at FROM usage_notifications WHERE _rowid_ = ?")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, pk)
 
-	offer = &Offer{}
-	err = obj.driver.QueryRow(__stmt, pk).Scan(&offer.Id, &offer.Name, &offer.Description, &offer.Type, &offer.CreditInCents, &offer.AwardCreditDurationDays, &offer.InviteeCreditDurationDays, &offer.RedeemableCap, &offer.NumRedeemed, &offer.ExpiresAt, &offer.CreatedAt, &offer.Status)
+	usage_notification = &UsageNotification{}
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&usage_notification.ProjectId, &usage_notification.Resource, &usage_notification.Threshold, &usage_notification.PeriodStart, &usage_notification.CreatedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	return offer, nil
+	return usage_notification, nil
 
 }
 
@@ -10260,11 +14164,71 @@ func (impl sqlite3Impl) isConstraintError(err error) (
 func (obj *sqlite3Impl) deleteAll(ctx context.Context) (count int64, err error) {
 	var __res sql.Result
 	var __count int64
+	__res, err = obj.driver.Exec("DELETE FROM usage_notifications;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM notification_preferences;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM audit_events;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM segment_pieces;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM api_key_revocations;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
 	__res, err = obj.driver.Exec("DELETE FROM used_serials;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM segments;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -10365,6 +14329,16 @@ func (obj *sqlite3Impl) deleteAll(ctx context.Context) (count int64, err error)
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM payout_statements;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -10375,6 +14349,16 @@ func (obj *sqlite3Impl) deleteAll(ctx context.Context) (count int64, err error)
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM objects;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -10395,6 +14379,16 @@ func (obj *sqlite3Impl) deleteAll(ctx context.Context) (count int64, err error)
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM invoices;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -10519,6 +14513,241 @@ func (rx *Rx) Commit() (err error) {
 	return err
 }
 
+func (rx *Rx) All_Invoice_By_PeriodStart(ctx context.Context,
+	invoice_period_start Invoice_PeriodStart_Field) (
+	rows []*Invoice, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.All_Invoice_By_PeriodStart(ctx, invoice_period_start)
+}
+
+func (rx *Rx) All_Invoice_By_ProjectId_OrderBy_Desc_PeriodStart(ctx context.Context,
+	invoice_project_id Invoice_ProjectId_Field) (
+	rows []*Invoice, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.All_Invoice_By_ProjectId_OrderBy_Desc_PeriodStart(ctx, invoice_project_id)
+}
+
+func (rx *Rx) Create_Invoice(ctx context.Context,
+	invoice_id Invoice_Id_Field,
+	invoice_project_id Invoice_ProjectId_Field,
+	invoice_period_start Invoice_PeriodStart_Field,
+	invoice_period_end Invoice_PeriodEnd_Field,
+	invoice_storage Invoice_Storage_Field,
+	invoice_egress Invoice_Egress_Field,
+	invoice_object_count Invoice_ObjectCount_Field,
+	invoice_segment_count Invoice_SegmentCount_Field,
+	invoice_storage_amount Invoice_StorageAmount_Field,
+	invoice_egress_amount Invoice_EgressAmount_Field,
+	invoice_object_amount Invoice_ObjectAmount_Field,
+	invoice_segment_amount Invoice_SegmentAmount_Field,
+	invoice_total Invoice_Total_Field) (
+	invoice *Invoice, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Create_Invoice(ctx, invoice_id, invoice_project_id, invoice_period_start, invoice_period_end, invoice_storage, invoice_egress, invoice_object_count, invoice_segment_count, invoice_storage_amount, invoice_egress_amount, invoice_object_amount, invoice_segment_amount, invoice_total)
+
+}
+
+func (rx *Rx) Find_Invoice_By_ProjectId_And_PeriodStart(ctx context.Context,
+	invoice_project_id Invoice_ProjectId_Field,
+	invoice_period_start Invoice_PeriodStart_Field) (
+	invoice *Invoice, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Find_Invoice_By_ProjectId_And_PeriodStart(ctx, invoice_project_id, invoice_period_start)
+}
+
+func (rx *Rx) Get_Invoice_By_Id(ctx context.Context,
+	invoice_id Invoice_Id_Field) (
+	invoice *Invoice, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Get_Invoice_By_Id(ctx, invoice_id)
+}
+
+func (rx *Rx) All_PayoutStatement_By_NodeId_OrderBy_Desc_PeriodStart(ctx context.Context,
+	payout_statement_node_id PayoutStatement_NodeId_Field) (
+	rows []*PayoutStatement, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.All_PayoutStatement_By_NodeId_OrderBy_Desc_PeriodStart(ctx, payout_statement_node_id)
+}
+
+func (rx *Rx) All_PayoutStatement_By_PeriodStart_OrderBy_Asc_NodeId(ctx context.Context,
+	payout_statement_period_start PayoutStatement_PeriodStart_Field) (
+	rows []*PayoutStatement, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.All_PayoutStatement_By_PeriodStart_OrderBy_Asc_NodeId(ctx, payout_statement_period_start)
+}
+
+func (rx *Rx) Create_PayoutStatement(ctx context.Context,
+	payout_statement_node_id PayoutStatement_NodeId_Field,
+	payout_statement_period_start PayoutStatement_PeriodStart_Field,
+	payout_statement_period_end PayoutStatement_PeriodEnd_Field,
+	payout_statement_node_created_at PayoutStatement_NodeCreatedAt_Field,
+	payout_statement_wallet PayoutStatement_Wallet_Field,
+	payout_statement_at_rest_total PayoutStatement_AtRestTotal_Field,
+	payout_statement_get_total PayoutStatement_GetTotal_Field,
+	payout_statement_get_repair_total PayoutStatement_GetRepairTotal_Field,
+	payout_statement_get_audit_total PayoutStatement_GetAuditTotal_Field,
+	payout_statement_put_total PayoutStatement_PutTotal_Field,
+	payout_statement_put_repair_total PayoutStatement_PutRepairTotal_Field,
+	payout_statement_at_rest_amount PayoutStatement_AtRestAmount_Field,
+	payout_statement_get_amount PayoutStatement_GetAmount_Field,
+	payout_statement_repair_amount PayoutStatement_RepairAmount_Field,
+	payout_statement_audit_amount PayoutStatement_AuditAmount_Field,
+	payout_statement_earned PayoutStatement_Earned_Field,
+	payout_statement_held_percent PayoutStatement_HeldPercent_Field,
+	payout_statement_held PayoutStatement_Held_Field,
+	payout_statement_disqualified PayoutStatement_Disqualified_Field,
+	payout_statement_paid PayoutStatement_Paid_Field) (
+	payout_statement *PayoutStatement, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Create_PayoutStatement(ctx, payout_statement_node_id, payout_statement_period_start, payout_statement_period_end, payout_statement_node_created_at, payout_statement_wallet, payout_statement_at_rest_total, payout_statement_get_total, payout_statement_get_repair_total, payout_statement_get_audit_total, payout_statement_put_total, payout_statement_put_repair_total, payout_statement_at_rest_amount, payout_statement_get_amount, payout_statement_repair_amount, payout_statement_audit_amount, payout_statement_earned, payout_statement_held_percent, payout_statement_held, payout_statement_disqualified, payout_statement_paid)
+
+}
+
+func (rx *Rx) Find_PayoutStatement_By_NodeId_And_PeriodStart(ctx context.Context,
+	payout_statement_node_id PayoutStatement_NodeId_Field,
+	payout_statement_period_start PayoutStatement_PeriodStart_Field) (
+	payout_statement *PayoutStatement, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Find_PayoutStatement_By_NodeId_And_PeriodStart(ctx, payout_statement_node_id, payout_statement_period_start)
+}
+
+func (rx *Rx) All_ApiKeyRevocation_By_ApiKeyId(ctx context.Context,
+	api_key_revocation_api_key_id ApiKeyRevocation_ApiKeyId_Field) (
+	rows []*ApiKeyRevocation, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.All_ApiKeyRevocation_By_ApiKeyId(ctx, api_key_revocation_api_key_id)
+}
+
+func (rx *Rx) Create_ApiKeyRevocation(ctx context.Context,
+	api_key_revocation_api_key_id ApiKeyRevocation_ApiKeyId_Field,
+	api_key_revocation_tail ApiKeyRevocation_Tail_Field) (
+	api_key_revocation *ApiKeyRevocation, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Create_ApiKeyRevocation(ctx, api_key_revocation_api_key_id, api_key_revocation_tail)
+
+}
+
+func (rx *Rx) Create_AuditEvent(ctx context.Context,
+	audit_event_id AuditEvent_Id_Field,
+	audit_event_project_id AuditEvent_ProjectId_Field,
+	audit_event_actor_id AuditEvent_ActorId_Field,
+	audit_event_action AuditEvent_Action_Field,
+	audit_event_target AuditEvent_Target_Field,
+	audit_event_source_ip AuditEvent_SourceIp_Field) (
+	audit_event *AuditEvent, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Create_AuditEvent(ctx, audit_event_id, audit_event_project_id, audit_event_actor_id, audit_event_action, audit_event_target, audit_event_source_ip)
+
+}
+
+func (rx *Rx) Delete_AuditEvent_By_CreatedAt_Less(ctx context.Context,
+	audit_event_created_at_less AuditEvent_CreatedAt_Field) (
+	count int64, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Delete_AuditEvent_By_CreatedAt_Less(ctx, audit_event_created_at_less)
+}
+
+func (rx *Rx) Limited_AuditEvent_By_ProjectId_OrderBy_Desc_CreatedAt(ctx context.Context,
+	audit_event_project_id AuditEvent_ProjectId_Field,
+	limit int, offset int64) (
+	rows []*AuditEvent, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Limited_AuditEvent_By_ProjectId_OrderBy_Desc_CreatedAt(ctx, audit_event_project_id, limit, offset)
+}
+
+func (rx *Rx) Create_NotificationPreference(ctx context.Context,
+	notification_preference_user_id NotificationPreference_UserId_Field,
+	notification_preference_usage_thresholds NotificationPreference_UsageThresholds_Field,
+	notification_preference_api_key_created NotificationPreference_ApiKeyCreated_Field,
+	notification_preference_project_member_added NotificationPreference_ProjectMemberAdded_Field) (
+	notification_preference *NotificationPreference, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Create_NotificationPreference(ctx, notification_preference_user_id, notification_preference_usage_thresholds, notification_preference_api_key_created, notification_preference_project_member_added)
+
+}
+
+func (rx *Rx) Find_NotificationPreference_By_UserId(ctx context.Context,
+	notification_preference_user_id NotificationPreference_UserId_Field) (
+	notification_preference *NotificationPreference, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Find_NotificationPreference_By_UserId(ctx, notification_preference_user_id)
+}
+
+func (rx *Rx) Create_UsageNotification(ctx context.Context,
+	usage_notification_project_id UsageNotification_ProjectId_Field,
+	usage_notification_resource UsageNotification_Resource_Field,
+	usage_notification_threshold UsageNotification_Threshold_Field,
+	usage_notification_period_start UsageNotification_PeriodStart_Field) (
+	usage_notification *UsageNotification, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Create_UsageNotification(ctx, usage_notification_project_id, usage_notification_resource, usage_notification_threshold, usage_notification_period_start)
+
+}
+
+func (rx *Rx) Has_UsageNotification_By_ProjectId_And_Resource_And_Threshold_And_PeriodStart(ctx context.Context,
+	usage_notification_project_id UsageNotification_ProjectId_Field,
+	usage_notification_resource UsageNotification_Resource_Field,
+	usage_notification_threshold UsageNotification_Threshold_Field,
+	usage_notification_period_start UsageNotification_PeriodStart_Field) (
+	has bool, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Has_UsageNotification_By_ProjectId_And_Resource_And_Threshold_And_PeriodStart(ctx, usage_notification_project_id, usage_notification_resource, usage_notification_threshold, usage_notification_period_start)
+}
+
 func (rx *Rx) Rollback() (err error) {
 	if rx.tx != nil {
 		err = rx.tx.Rollback()
@@ -10669,14 +14898,16 @@ func (rx *Rx) Create_AccountingTimestamps(ctx context.Context,
 func (rx *Rx) Create_ApiKey(ctx context.Context,
 	api_key_id ApiKey_Id_Field,
 	api_key_project_id ApiKey_ProjectId_Field,
-	api_key_key ApiKey_Key_Field,
-	api_key_name ApiKey_Name_Field) (
+	api_key_head ApiKey_Head_Field,
+	api_key_name ApiKey_Name_Field,
+	api_key_secret ApiKey_Secret_Field,
+	api_key_caveat ApiKey_Caveat_Field) (
 	api_key *ApiKey, err error) {
 	var tx *Tx
 	if tx, err = rx.getTx(ctx); err != nil {
 		return
 	}
-	return tx.Create_ApiKey(ctx, api_key_id, api_key_project_id, api_key_key, api_key_name)
+	return tx.Create_ApiKey(ctx, api_key_id, api_key_project_id, api_key_head, api_key_name, api_key_secret, api_key_caveat)
 
 }
 
@@ -10771,15 +15002,20 @@ func (rx *Rx) Create_Node(ctx context.Context,
 	node_uptime_success_count Node_UptimeSuccessCount_Field,
 	node_total_uptime_count Node_TotalUptimeCount_Field,
 	node_uptime_ratio Node_UptimeRatio_Field,
+	node_audit_reputation_alpha Node_AuditReputationAlpha_Field,
+	node_audit_reputation_beta Node_AuditReputationBeta_Field,
+	node_uptime_reputation_alpha Node_UptimeReputationAlpha_Field,
+	node_uptime_reputation_beta Node_UptimeReputationBeta_Field,
 	node_last_contact_success Node_LastContactSuccess_Field,
 	node_last_contact_failure Node_LastContactFailure_Field,
-	node_contained Node_Contained_Field) (
+	node_contained Node_Contained_Field,
+	optional Node_Create_Fields) (
 	node *Node, err error) {
 	var tx *Tx
 	if tx, err = rx.getTx(ctx); err != nil {
 		return
 	}
-	return tx.Create_Node(ctx, node_id, node_address, node_last_ip, node_protocol, node_type, node_email, node_wallet, node_free_bandwidth, node_free_disk, node_major, node_minor, node_patch, node_hash, node_timestamp, node_release, node_latency_90, node_audit_success_count, node_total_audit_count, node_audit_success_ratio, node_uptime_success_count, node_total_uptime_count, node_uptime_ratio, node_last_contact_success, node_last_contact_failure, node_contained)
+	return tx.Create_Node(ctx, node_id, node_address, node_last_ip, node_protocol, node_type, node_email, node_wallet, node_free_bandwidth, node_free_disk, node_major, node_minor, node_patch, node_hash, node_timestamp, node_release, node_latency_90, node_audit_success_count, node_total_audit_count, node_audit_success_ratio, node_uptime_success_count, node_total_uptime_count, node_uptime_ratio, node_audit_reputation_alpha, node_audit_reputation_beta, node_uptime_reputation_alpha, node_uptime_reputation_beta, node_last_contact_success, node_last_contact_failure, node_contained, optional)
 
 }
 
@@ -10806,38 +15042,43 @@ func (rx *Rx) Create_PendingAudits(ctx context.Context,
 	pending_audits_stripe_index PendingAudits_StripeIndex_Field,
 	pending_audits_share_size PendingAudits_ShareSize_Field,
 	pending_audits_expected_share_hash PendingAudits_ExpectedShareHash_Field,
-	pending_audits_reverify_count PendingAudits_ReverifyCount_Field) (
+	pending_audits_reverify_count PendingAudits_ReverifyCount_Field,
+	pending_audits_piece_num PendingAudits_PieceNum_Field,
+	pending_audits_path PendingAudits_Path_Field) (
 	pending_audits *PendingAudits, err error) {
 	var tx *Tx
 	if tx, err = rx.getTx(ctx); err != nil {
 		return
 	}
-	return tx.Create_PendingAudits(ctx, pending_audits_node_id, pending_audits_piece_id, pending_audits_stripe_index, pending_audits_share_size, pending_audits_expected_share_hash, pending_audits_reverify_count)
+	return tx.Create_PendingAudits(ctx, pending_audits_node_id, pending_audits_piece_id, pending_audits_stripe_index, pending_audits_share_size, pending_audits_expected_share_hash, pending_audits_reverify_count, pending_audits_piece_num, pending_audits_path)
 
 }
 
 func (rx *Rx) Create_Project(ctx context.Context,
 	project_id Project_Id_Field,
 	project_name Project_Name_Field,
-	project_description Project_Description_Field) (
+	project_description Project_Description_Field,
+	project_storage_limit Project_StorageLimit_Field,
+	project_egress_limit Project_EgressLimit_Field) (
 	project *Project, err error) {
 	var tx *Tx
 	if tx, err = rx.getTx(ctx); err != nil {
 		return
 	}
-	return tx.Create_Project(ctx, project_id, project_name, project_description)
+	return tx.Create_Project(ctx, project_id, project_name, project_description, project_storage_limit, project_egress_limit)
 
 }
 
 func (rx *Rx) Create_ProjectMember(ctx context.Context,
 	project_member_member_id ProjectMember_MemberId_Field,
-	project_member_project_id ProjectMember_ProjectId_Field) (
+	project_member_project_id ProjectMember_ProjectId_Field,
+	project_member_role ProjectMember_Role_Field) (
 	project_member *ProjectMember, err error) {
 	var tx *Tx
 	if tx, err = rx.getTx(ctx); err != nil {
 		return
 	}
-	return tx.Create_ProjectMember(ctx, project_member_member_id, project_member_project_id)
+	return tx.Create_ProjectMember(ctx, project_member_member_id, project_member_project_id, project_member_role)
 
 }
 
@@ -11126,24 +15367,24 @@ func (rx *Rx) Get_AccountingRollup_By_Id(ctx context.Context,
 	return tx.Get_AccountingRollup_By_Id(ctx, accounting_rollup_id)
 }
 
-func (rx *Rx) Get_ApiKey_By_Id(ctx context.Context,
-	api_key_id ApiKey_Id_Field) (
+func (rx *Rx) Get_ApiKey_By_Head(ctx context.Context,
+	api_key_head ApiKey_Head_Field) (
 	api_key *ApiKey, err error) {
 	var tx *Tx
 	if tx, err = rx.getTx(ctx); err != nil {
 		return
 	}
-	return tx.Get_ApiKey_By_Id(ctx, api_key_id)
+	return tx.Get_ApiKey_By_Head(ctx, api_key_head)
 }
 
-func (rx *Rx) Get_ApiKey_By_Key(ctx context.Context,
-	api_key_key ApiKey_Key_Field) (
+func (rx *Rx) Get_ApiKey_By_Id(ctx context.Context,
+	api_key_id ApiKey_Id_Field) (
 	api_key *ApiKey, err error) {
 	var tx *Tx
 	if tx, err = rx.getTx(ctx); err != nil {
 		return
 	}
-	return tx.Get_ApiKey_By_Key(ctx, api_key_key)
+	return tx.Get_ApiKey_By_Id(ctx, api_key_id)
 }
 
 func (rx *Rx) Get_BucketUsage_By_Id(ctx context.Context,
@@ -11411,6 +15652,17 @@ func (rx *Rx) Update_Node_By_Id(ctx context.Context,
 	return tx.Update_Node_By_Id(ctx, node_id, update)
 }
 
+func (rx *Rx) Update_NotificationPreference_By_UserId(ctx context.Context,
+	notification_preference_user_id NotificationPreference_UserId_Field,
+	update NotificationPreference_Update_Fields) (
+	notification_preference *NotificationPreference, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Update_NotificationPreference_By_UserId(ctx, notification_preference_user_id, update)
+}
+
 func (rx *Rx) Update_Offer_By_Id_And_Status_Equal_Number_And_ExpiresAt_GreaterOrEqual_CreatedAt(ctx context.Context,
 	offer_id Offer_Id_Field,
 	update Offer_Update_Fields) (
@@ -11433,6 +15685,18 @@ func (rx *Rx) Update_PendingAudits_By_NodeId(ctx context.Context,
 	return tx.Update_PendingAudits_By_NodeId(ctx, pending_audits_node_id, update)
 }
 
+func (rx *Rx) Update_ProjectMember_By_MemberId_And_ProjectId(ctx context.Context,
+	project_member_member_id ProjectMember_MemberId_Field,
+	project_member_project_id ProjectMember_ProjectId_Field,
+	update ProjectMember_Update_Fields) (
+	project_member *ProjectMember, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Update_ProjectMember_By_MemberId_And_ProjectId(ctx, project_member_member_id, project_member_project_id, update)
+}
+
 func (rx *Rx) Update_Project_By_Id(ctx context.Context,
 	project_id Project_Id_Field,
 	update Project_Update_Fields) (
@@ -11471,6 +15735,10 @@ type Methods interface {
 		accounting_rollup_start_time_greater_or_equal AccountingRollup_StartTime_Field) (
 		rows []*AccountingRollup, err error)
 
+	All_ApiKeyRevocation_By_ApiKeyId(ctx context.Context,
+		api_key_revocation_api_key_id ApiKeyRevocation_ApiKeyId_Field) (
+		rows []*ApiKeyRevocation, err error)
+
 	All_ApiKey_By_ProjectId_OrderBy_Asc_Name(ctx context.Context,
 		api_key_project_id ApiKey_ProjectId_Field) (
 		rows []*ApiKey, err error)
@@ -11482,12 +15750,28 @@ type Methods interface {
 		bucket_storage_tally_interval_start_less_or_equal BucketStorageTally_IntervalStart_Field) (
 		rows []*BucketStorageTally, err error)
 
+	All_Invoice_By_PeriodStart(ctx context.Context,
+		invoice_period_start Invoice_PeriodStart_Field) (
+		rows []*Invoice, err error)
+
+	All_Invoice_By_ProjectId_OrderBy_Desc_PeriodStart(ctx context.Context,
+		invoice_project_id Invoice_ProjectId_Field) (
+		rows []*Invoice, err error)
+
 	All_Node_Id(ctx context.Context) (
 		rows []*Id_Row, err error)
 
 	All_Offer(ctx context.Context) (
 		rows []*Offer, err error)
 
+	All_PayoutStatement_By_NodeId_OrderBy_Desc_PeriodStart(ctx context.Context,
+		payout_statement_node_id PayoutStatement_NodeId_Field) (
+		rows []*PayoutStatement, err error)
+
+	All_PayoutStatement_By_PeriodStart_OrderBy_Asc_NodeId(ctx context.Context,
+		payout_statement_period_start PayoutStatement_PeriodStart_Field) (
+		rows []*PayoutStatement, err error)
+
 	All_Project(ctx context.Context) (
 		rows []*Project, err error)
 
@@ -11529,10 +15813,26 @@ type Methods interface {
 	Create_ApiKey(ctx context.Context,
 		api_key_id ApiKey_Id_Field,
 		api_key_project_id ApiKey_ProjectId_Field,
-		api_key_key ApiKey_Key_Field,
-		api_key_name ApiKey_Name_Field) (
+		api_key_head ApiKey_Head_Field,
+		api_key_name ApiKey_Name_Field,
+		api_key_secret ApiKey_Secret_Field,
+		api_key_caveat ApiKey_Caveat_Field) (
 		api_key *ApiKey, err error)
 
+	Create_ApiKeyRevocation(ctx context.Context,
+		api_key_revocation_api_key_id ApiKeyRevocation_ApiKeyId_Field,
+		api_key_revocation_tail ApiKeyRevocation_Tail_Field) (
+		api_key_revocation *ApiKeyRevocation, err error)
+
+	Create_AuditEvent(ctx context.Context,
+		audit_event_id AuditEvent_Id_Field,
+		audit_event_project_id AuditEvent_ProjectId_Field,
+		audit_event_actor_id AuditEvent_ActorId_Field,
+		audit_event_action AuditEvent_Action_Field,
+		audit_event_target AuditEvent_Target_Field,
+		audit_event_source_ip AuditEvent_SourceIp_Field) (
+		audit_event *AuditEvent, err error)
+
 	Create_BucketStorageTally(ctx context.Context,
 		bucket_storage_tally_bucket_name BucketStorageTally_BucketName_Field,
 		bucket_storage_tally_project_id BucketStorageTally_ProjectId_Field,
@@ -11565,6 +15865,22 @@ type Methods interface {
 		certRecord_id CertRecord_Id_Field) (
 		certRecord *CertRecord, err error)
 
+	Create_Invoice(ctx context.Context,
+		invoice_id Invoice_Id_Field,
+		invoice_project_id Invoice_ProjectId_Field,
+		invoice_period_start Invoice_PeriodStart_Field,
+		invoice_period_end Invoice_PeriodEnd_Field,
+		invoice_storage Invoice_Storage_Field,
+		invoice_egress Invoice_Egress_Field,
+		invoice_object_count Invoice_ObjectCount_Field,
+		invoice_segment_count Invoice_SegmentCount_Field,
+		invoice_storage_amount Invoice_StorageAmount_Field,
+		invoice_egress_amount Invoice_EgressAmount_Field,
+		invoice_object_amount Invoice_ObjectAmount_Field,
+		invoice_segment_amount Invoice_SegmentAmount_Field,
+		invoice_total Invoice_Total_Field) (
+		invoice *Invoice, err error)
+
 	Create_Irreparabledb(ctx context.Context,
 		irreparabledb_segmentpath Irreparabledb_Segmentpath_Field,
 		irreparabledb_segmentdetail Irreparabledb_Segmentdetail_Field,
@@ -11596,11 +15912,23 @@ type Methods interface {
 		node_uptime_success_count Node_UptimeSuccessCount_Field,
 		node_total_uptime_count Node_TotalUptimeCount_Field,
 		node_uptime_ratio Node_UptimeRatio_Field,
+		node_audit_reputation_alpha Node_AuditReputationAlpha_Field,
+		node_audit_reputation_beta Node_AuditReputationBeta_Field,
+		node_uptime_reputation_alpha Node_UptimeReputationAlpha_Field,
+		node_uptime_reputation_beta Node_UptimeReputationBeta_Field,
 		node_last_contact_success Node_LastContactSuccess_Field,
 		node_last_contact_failure Node_LastContactFailure_Field,
-		node_contained Node_Contained_Field) (
+		node_contained Node_Contained_Field,
+		optional Node_Create_Fields) (
 		node *Node, err error)
 
+	Create_NotificationPreference(ctx context.Context,
+		notification_preference_user_id NotificationPreference_UserId_Field,
+		notification_preference_usage_thresholds NotificationPreference_UsageThresholds_Field,
+		notification_preference_api_key_created NotificationPreference_ApiKeyCreated_Field,
+		notification_preference_project_member_added NotificationPreference_ProjectMemberAdded_Field) (
+		notification_preference *NotificationPreference, err error)
+
 	Create_Offer(ctx context.Context,
 		offer_name Offer_Name_Field,
 		offer_description Offer_Description_Field,
@@ -11611,24 +15939,52 @@ type Methods interface {
 		offer_redeemable_cap Offer_RedeemableCap_Field) (
 		offer *Offer, err error)
 
+	Create_PayoutStatement(ctx context.Context,
+		payout_statement_node_id PayoutStatement_NodeId_Field,
+		payout_statement_period_start PayoutStatement_PeriodStart_Field,
+		payout_statement_period_end PayoutStatement_PeriodEnd_Field,
+		payout_statement_node_created_at PayoutStatement_NodeCreatedAt_Field,
+		payout_statement_wallet PayoutStatement_Wallet_Field,
+		payout_statement_at_rest_total PayoutStatement_AtRestTotal_Field,
+		payout_statement_get_total PayoutStatement_GetTotal_Field,
+		payout_statement_get_repair_total PayoutStatement_GetRepairTotal_Field,
+		payout_statement_get_audit_total PayoutStatement_GetAuditTotal_Field,
+		payout_statement_put_total PayoutStatement_PutTotal_Field,
+		payout_statement_put_repair_total PayoutStatement_PutRepairTotal_Field,
+		payout_statement_at_rest_amount PayoutStatement_AtRestAmount_Field,
+		payout_statement_get_amount PayoutStatement_GetAmount_Field,
+		payout_statement_repair_amount PayoutStatement_RepairAmount_Field,
+		payout_statement_audit_amount PayoutStatement_AuditAmount_Field,
+		payout_statement_earned PayoutStatement_Earned_Field,
+		payout_statement_held_percent PayoutStatement_HeldPercent_Field,
+		payout_statement_held PayoutStatement_Held_Field,
+		payout_statement_disqualified PayoutStatement_Disqualified_Field,
+		payout_statement_paid PayoutStatement_Paid_Field) (
+		payout_statement *PayoutStatement, err error)
+
 	Create_PendingAudits(ctx context.Context,
 		pending_audits_node_id PendingAudits_NodeId_Field,
 		pending_audits_piece_id PendingAudits_PieceId_Field,
 		pending_audits_stripe_index PendingAudits_StripeIndex_Field,
 		pending_audits_share_size PendingAudits_ShareSize_Field,
 		pending_audits_expected_share_hash PendingAudits_ExpectedShareHash_Field,
-		pending_audits_reverify_count PendingAudits_ReverifyCount_Field) (
+		pending_audits_reverify_count PendingAudits_ReverifyCount_Field,
+		pending_audits_piece_num PendingAudits_PieceNum_Field,
+		pending_audits_path PendingAudits_Path_Field) (
 		pending_audits *PendingAudits, err error)
 
 	Create_Project(ctx context.Context,
 		project_id Project_Id_Field,
 		project_name Project_Name_Field,
-		project_description Project_Description_Field) (
+		project_description Project_Description_Field,
+		project_storage_limit Project_StorageLimit_Field,
+		project_egress_limit Project_EgressLimit_Field) (
 		project *Project, err error)
 
 	Create_ProjectMember(ctx context.Context,
 		project_member_member_id ProjectMember_MemberId_Field,
-		project_member_project_id ProjectMember_ProjectId_Field) (
+		project_member_project_id ProjectMember_ProjectId_Field,
+		project_member_role ProjectMember_Role_Field) (
 		project_member *ProjectMember, err error)
 
 	Create_RegistrationToken(ctx context.Context,
@@ -11654,6 +16010,13 @@ type Methods interface {
 		storagenode_storage_tally_data_total StoragenodeStorageTally_DataTotal_Field) (
 		storagenode_storage_tally *StoragenodeStorageTally, err error)
 
+	Create_UsageNotification(ctx context.Context,
+		usage_notification_project_id UsageNotification_ProjectId_Field,
+		usage_notification_resource UsageNotification_Resource_Field,
+		usage_notification_threshold UsageNotification_Threshold_Field,
+		usage_notification_period_start UsageNotification_PeriodStart_Field) (
+		usage_notification *UsageNotification, err error)
+
 	Create_UsedSerial(ctx context.Context,
 		used_serial_serial_number_id UsedSerial_SerialNumberId_Field,
 		used_serial_storage_node_id UsedSerial_StorageNodeId_Field) (
@@ -11675,6 +16038,10 @@ type Methods interface {
 		api_key_id ApiKey_Id_Field) (
 		deleted bool, err error)
 
+	Delete_AuditEvent_By_CreatedAt_Less(ctx context.Context,
+		audit_event_created_at_less AuditEvent_CreatedAt_Field) (
+		count int64, err error)
+
 	Delete_BucketUsage_By_Id(ctx context.Context,
 		bucket_usage_id BucketUsage_Id_Field) (
 		deleted bool, err error)
@@ -11735,6 +16102,20 @@ type Methods interface {
 		bucket_bandwidth_rollup_action BucketBandwidthRollup_Action_Field) (
 		bucket_bandwidth_rollup *BucketBandwidthRollup, err error)
 
+	Find_Invoice_By_ProjectId_And_PeriodStart(ctx context.Context,
+		invoice_project_id Invoice_ProjectId_Field,
+		invoice_period_start Invoice_PeriodStart_Field) (
+		invoice *Invoice, err error)
+
+	Find_NotificationPreference_By_UserId(ctx context.Context,
+		notification_preference_user_id NotificationPreference_UserId_Field) (
+		notification_preference *NotificationPreference, err error)
+
+	Find_PayoutStatement_By_NodeId_And_PeriodStart(ctx context.Context,
+		payout_statement_node_id PayoutStatement_NodeId_Field,
+		payout_statement_period_start PayoutStatement_PeriodStart_Field) (
+		payout_statement *PayoutStatement, err error)
+
 	Find_SerialNumber_By_SerialNumber(ctx context.Context,
 		serial_number_serial_number SerialNumber_SerialNumber_Field) (
 		serial_number *SerialNumber, err error)
@@ -11753,12 +16134,12 @@ type Methods interface {
 		accounting_rollup_id AccountingRollup_Id_Field) (
 		accounting_rollup *AccountingRollup, err error)
 
-	Get_ApiKey_By_Id(ctx context.Context,
-		api_key_id ApiKey_Id_Field) (
+	Get_ApiKey_By_Head(ctx context.Context,
+		api_key_head ApiKey_Head_Field) (
 		api_key *ApiKey, err error)
 
-	Get_ApiKey_By_Key(ctx context.Context,
-		api_key_key ApiKey_Key_Field) (
+	Get_ApiKey_By_Id(ctx context.Context,
+		api_key_id ApiKey_Id_Field) (
 		api_key *ApiKey, err error)
 
 	Get_BucketUsage_By_Id(ctx context.Context,
@@ -11769,6 +16150,10 @@ type Methods interface {
 		certRecord_id CertRecord_Id_Field) (
 		certRecord *CertRecord, err error)
 
+	Get_Invoice_By_Id(ctx context.Context,
+		invoice_id Invoice_Id_Field) (
+		invoice *Invoice, err error)
+
 	Get_Irreparabledb_By_Segmentpath(ctx context.Context,
 		irreparabledb_segmentpath Irreparabledb_Segmentpath_Field) (
 		irreparabledb *Irreparabledb, err error)
@@ -11823,6 +16208,18 @@ type Methods interface {
 		user_id User_Id_Field) (
 		user *User, err error)
 
+	Has_UsageNotification_By_ProjectId_And_Resource_And_Threshold_And_PeriodStart(ctx context.Context,
+		usage_notification_project_id UsageNotification_ProjectId_Field,
+		usage_notification_resource UsageNotification_Resource_Field,
+		usage_notification_threshold UsageNotification_Threshold_Field,
+		usage_notification_period_start UsageNotification_PeriodStart_Field) (
+		has bool, err error)
+
+	Limited_AuditEvent_By_ProjectId_OrderBy_Desc_CreatedAt(ctx context.Context,
+		audit_event_project_id AuditEvent_ProjectId_Field,
+		limit int, offset int64) (
+		rows []*AuditEvent, err error)
+
 	Limited_BucketUsage_By_BucketId_And_RollupEndTime_Greater_And_RollupEndTime_LessOrEqual_OrderBy_Asc_RollupEndTime(ctx context.Context,
 		bucket_usage_bucket_id BucketUsage_BucketId_Field,
 		bucket_usage_rollup_end_time_greater BucketUsage_RollupEndTime_Field,
@@ -11876,6 +16273,11 @@ type Methods interface {
 		update Node_Update_Fields) (
 		node *Node, err error)
 
+	Update_NotificationPreference_By_UserId(ctx context.Context,
+		notification_preference_user_id NotificationPreference_UserId_Field,
+		update NotificationPreference_Update_Fields) (
+		notification_preference *NotificationPreference, err error)
+
 	Update_Offer_By_Id_And_Status_Equal_Number_And_ExpiresAt_GreaterOrEqual_CreatedAt(ctx context.Context,
 		offer_id Offer_Id_Field,
 		update Offer_Update_Fields) (
@@ -11886,6 +16288,12 @@ type Methods interface {
 		update PendingAudits_Update_Fields) (
 		pending_audits *PendingAudits, err error)
 
+	Update_ProjectMember_By_MemberId_And_ProjectId(ctx context.Context,
+		project_member_member_id ProjectMember_MemberId_Field,
+		project_member_project_id ProjectMember_ProjectId_Field,
+		update ProjectMember_Update_Fields) (
+		project_member *ProjectMember, err error)
+
 	Update_Project_By_Id(ctx context.Context,
 		project_id Project_Id_Field,
 		update Project_Update_Fields) (
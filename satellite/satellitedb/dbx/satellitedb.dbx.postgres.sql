@@ -77,6 +77,24 @@ CREATE TABLE injuredsegments (
 	attempted timestamp,
 	PRIMARY KEY ( path )
 );
+CREATE TABLE invoices (
+	id bytea NOT NULL,
+	project_id bytea NOT NULL,
+	period_start timestamp with time zone NOT NULL,
+	period_end timestamp with time zone NOT NULL,
+	storage double precision NOT NULL,
+	egress double precision NOT NULL,
+	object_count double precision NOT NULL,
+	segment_count double precision NOT NULL,
+	storage_amount bigint NOT NULL,
+	egress_amount bigint NOT NULL,
+	object_amount bigint NOT NULL,
+	segment_amount bigint NOT NULL,
+	total bigint NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id ),
+	UNIQUE ( project_id, period_start )
+);
 CREATE TABLE irreparabledbs (
 	segmentpath bytea NOT NULL,
 	segmentdetail bytea NOT NULL,
@@ -108,13 +126,28 @@ CREATE TABLE nodes (
 	uptime_success_count bigint NOT NULL,
 	total_uptime_count bigint NOT NULL,
 	uptime_ratio double precision NOT NULL,
+	audit_reputation_alpha double precision NOT NULL,
+	audit_reputation_beta double precision NOT NULL,
+	uptime_reputation_alpha double precision NOT NULL,
+	uptime_reputation_beta double precision NOT NULL,
 	created_at timestamp with time zone NOT NULL,
 	updated_at timestamp with time zone NOT NULL,
 	last_contact_success timestamp with time zone NOT NULL,
 	last_contact_failure timestamp with time zone NOT NULL,
 	contained boolean NOT NULL,
+	disqualified timestamp with time zone,
+	last_audited timestamp with time zone,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE objects (
+	id bigserial NOT NULL,
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	object_key bytea NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id ),
+	UNIQUE ( project_id, bucket_name, object_key )
+);
 CREATE TABLE offers (
 	id serial NOT NULL,
 	name text NOT NULL,
@@ -130,6 +163,30 @@ CREATE TABLE offers (
 	status integer NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE payout_statements (
+	node_id bytea NOT NULL,
+	period_start timestamp with time zone NOT NULL,
+	period_end timestamp with time zone NOT NULL,
+	node_created_at timestamp with time zone NOT NULL,
+	wallet text NOT NULL,
+	at_rest_total double precision NOT NULL,
+	get_total bigint NOT NULL,
+	get_repair_total bigint NOT NULL,
+	get_audit_total bigint NOT NULL,
+	put_total bigint NOT NULL,
+	put_repair_total bigint NOT NULL,
+	at_rest_amount bigint NOT NULL,
+	get_amount bigint NOT NULL,
+	repair_amount bigint NOT NULL,
+	audit_amount bigint NOT NULL,
+	earned bigint NOT NULL,
+	held_percent integer NOT NULL,
+	held bigint NOT NULL,
+	disqualified boolean NOT NULL,
+	paid bigint NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( node_id, period_start )
+);
 CREATE TABLE pending_audits (
 	node_id bytea NOT NULL,
 	piece_id bytea NOT NULL,
@@ -137,12 +194,16 @@ CREATE TABLE pending_audits (
 	share_size bigint NOT NULL,
 	expected_share_hash bytea NOT NULL,
 	reverify_count bigint NOT NULL,
+	piece_num bigint NOT NULL,
+	path bytea NOT NULL,
 	PRIMARY KEY ( node_id )
 );
 CREATE TABLE projects (
 	id bytea NOT NULL,
 	name text NOT NULL,
 	description text NOT NULL,
+	storage_limit bigint NOT NULL,
+	egress_limit bigint NOT NULL,
 	created_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( id )
 );
@@ -191,33 +252,92 @@ CREATE TABLE users (
 	email text NOT NULL,
 	password_hash bytea NOT NULL,
 	status integer NOT NULL,
+	mfa_enabled boolean NOT NULL,
+	mfa_secret_key text,
+	mfa_recovery_codes text,
 	created_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( id )
 );
 CREATE TABLE api_keys (
 	id bytea NOT NULL,
 	project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
-	key bytea NOT NULL,
+	head bytea NOT NULL,
 	name text NOT NULL,
+	secret bytea NOT NULL,
+	caveat bytea,
 	created_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( id ),
-	UNIQUE ( key ),
+	UNIQUE ( head ),
 	UNIQUE ( name, project_id )
 );
 CREATE TABLE project_members (
 	member_id bytea NOT NULL REFERENCES users( id ) ON DELETE CASCADE,
 	project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+	role integer NOT NULL,
 	created_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( member_id, project_id )
 );
+CREATE TABLE segments (
+	id bigserial NOT NULL,
+	object_id bigint NOT NULL REFERENCES objects( id ) ON DELETE CASCADE,
+	segment_index bigint NOT NULL,
+	remote boolean NOT NULL,
+	segment_size bigint NOT NULL,
+	root_piece_id bytea,
+	expires_at timestamp with time zone,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id ),
+	UNIQUE ( object_id, segment_index )
+);
 CREATE TABLE used_serials (
 	serial_number_id integer NOT NULL REFERENCES serial_numbers( id ) ON DELETE CASCADE,
 	storage_node_id bytea NOT NULL,
 	PRIMARY KEY ( serial_number_id, storage_node_id )
 );
+CREATE TABLE api_key_revocations (
+	api_key_id bytea NOT NULL REFERENCES api_keys( id ) ON DELETE CASCADE,
+	tail bytea NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( api_key_id, tail )
+);
+CREATE TABLE segment_pieces (
+	segment_id bigint NOT NULL REFERENCES segments( id ) ON DELETE CASCADE,
+	piece_num integer NOT NULL,
+	node_id bytea NOT NULL,
+	PRIMARY KEY ( segment_id, piece_num )
+);
+CREATE TABLE audit_events (
+	id bytea NOT NULL,
+	project_id bytea,
+	actor_id bytea NOT NULL,
+	action text NOT NULL,
+	target text NOT NULL,
+	source_ip text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE notification_preferences (
+	user_id bytea NOT NULL REFERENCES users( id ) ON DELETE CASCADE,
+	usage_thresholds boolean NOT NULL,
+	api_key_created boolean NOT NULL,
+	project_member_added boolean NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( user_id )
+);
+CREATE TABLE usage_notifications (
+	project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+	resource text NOT NULL,
+	threshold integer NOT NULL,
+	period_start timestamp with time zone NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, resource, threshold, period_start )
+);
+CREATE INDEX audit_events_project_id_created_at ON audit_events ( project_id, created_at );
 CREATE INDEX bucket_name_project_id_interval_start_interval_seconds ON bucket_bandwidth_rollups ( bucket_name, project_id, interval_start, interval_seconds );
 CREATE UNIQUE INDEX bucket_id_rollup ON bucket_usages ( bucket_id, rollup_end_time );
 CREATE INDEX node_last_ip ON nodes ( last_ip );
+CREATE INDEX segment_pieces_node_id_segment_id ON segment_pieces ( node_id, segment_id );
+CREATE INDEX segments_expires_at ON segments ( expires_at );
 CREATE UNIQUE INDEX serial_number ON serial_numbers ( serial_number );
 CREATE INDEX serial_numbers_expires_at_index ON serial_numbers ( expires_at );
 CREATE INDEX storagenode_id_interval_start_interval_seconds ON storagenode_bandwidth_rollups ( storagenode_id, interval_start, interval_seconds );
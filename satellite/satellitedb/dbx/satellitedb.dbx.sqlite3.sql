@@ -77,6 +77,24 @@ CREATE TABLE injuredsegments (
 	attempted TIMESTAMP,
 	PRIMARY KEY ( path )
 );
+CREATE TABLE invoices (
+	id BLOB NOT NULL,
+	project_id BLOB NOT NULL,
+	period_start TIMESTAMP NOT NULL,
+	period_end TIMESTAMP NOT NULL,
+	storage REAL NOT NULL,
+	egress REAL NOT NULL,
+	object_count REAL NOT NULL,
+	segment_count REAL NOT NULL,
+	storage_amount INTEGER NOT NULL,
+	egress_amount INTEGER NOT NULL,
+	object_amount INTEGER NOT NULL,
+	segment_amount INTEGER NOT NULL,
+	total INTEGER NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( id ),
+	UNIQUE ( project_id, period_start )
+);
 CREATE TABLE irreparabledbs (
 	segmentpath BLOB NOT NULL,
 	segmentdetail BLOB NOT NULL,
@@ -108,13 +126,28 @@ CREATE TABLE nodes (
 	uptime_success_count INTEGER NOT NULL,
 	total_uptime_count INTEGER NOT NULL,
 	uptime_ratio REAL NOT NULL,
+	audit_reputation_alpha REAL NOT NULL,
+	audit_reputation_beta REAL NOT NULL,
+	uptime_reputation_alpha REAL NOT NULL,
+	uptime_reputation_beta REAL NOT NULL,
 	created_at TIMESTAMP NOT NULL,
 	updated_at TIMESTAMP NOT NULL,
 	last_contact_success TIMESTAMP NOT NULL,
 	last_contact_failure TIMESTAMP NOT NULL,
 	contained INTEGER NOT NULL,
+	disqualified TIMESTAMP,
+	last_audited TIMESTAMP,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE objects (
+	id INTEGER NOT NULL,
+	project_id BLOB NOT NULL,
+	bucket_name BLOB NOT NULL,
+	object_key BLOB NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( id ),
+	UNIQUE ( project_id, bucket_name, object_key )
+);
 CREATE TABLE offers (
 	id INTEGER NOT NULL,
 	name TEXT NOT NULL,
@@ -130,6 +163,30 @@ CREATE TABLE offers (
 	status INTEGER NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE payout_statements (
+	node_id BLOB NOT NULL,
+	period_start TIMESTAMP NOT NULL,
+	period_end TIMESTAMP NOT NULL,
+	node_created_at TIMESTAMP NOT NULL,
+	wallet TEXT NOT NULL,
+	at_rest_total REAL NOT NULL,
+	get_total INTEGER NOT NULL,
+	get_repair_total INTEGER NOT NULL,
+	get_audit_total INTEGER NOT NULL,
+	put_total INTEGER NOT NULL,
+	put_repair_total INTEGER NOT NULL,
+	at_rest_amount INTEGER NOT NULL,
+	get_amount INTEGER NOT NULL,
+	repair_amount INTEGER NOT NULL,
+	audit_amount INTEGER NOT NULL,
+	earned INTEGER NOT NULL,
+	held_percent INTEGER NOT NULL,
+	held INTEGER NOT NULL,
+	disqualified INTEGER NOT NULL,
+	paid INTEGER NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( node_id, period_start )
+);
 CREATE TABLE pending_audits (
 	node_id BLOB NOT NULL,
 	piece_id BLOB NOT NULL,
@@ -137,12 +194,16 @@ CREATE TABLE pending_audits (
 	share_size INTEGER NOT NULL,
 	expected_share_hash BLOB NOT NULL,
 	reverify_count INTEGER NOT NULL,
+	piece_num INTEGER NOT NULL,
+	path BLOB NOT NULL,
 	PRIMARY KEY ( node_id )
 );
 CREATE TABLE projects (
 	id BLOB NOT NULL,
 	name TEXT NOT NULL,
 	description TEXT NOT NULL,
+	storage_limit INTEGER NOT NULL,
+	egress_limit INTEGER NOT NULL,
 	created_at TIMESTAMP NOT NULL,
 	PRIMARY KEY ( id )
 );
@@ -191,33 +252,92 @@ CREATE TABLE users (
 	email TEXT NOT NULL,
 	password_hash BLOB NOT NULL,
 	status INTEGER NOT NULL,
+	mfa_enabled INTEGER NOT NULL,
+	mfa_secret_key TEXT,
+	mfa_recovery_codes TEXT,
 	created_at TIMESTAMP NOT NULL,
 	PRIMARY KEY ( id )
 );
 CREATE TABLE api_keys (
 	id BLOB NOT NULL,
 	project_id BLOB NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
-	key BLOB NOT NULL,
+	head BLOB NOT NULL,
 	name TEXT NOT NULL,
+	secret BLOB NOT NULL,
+	caveat BLOB,
 	created_at TIMESTAMP NOT NULL,
 	PRIMARY KEY ( id ),
-	UNIQUE ( key ),
+	UNIQUE ( head ),
 	UNIQUE ( name, project_id )
 );
 CREATE TABLE project_members (
 	member_id BLOB NOT NULL REFERENCES users( id ) ON DELETE CASCADE,
 	project_id BLOB NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+	role INTEGER NOT NULL,
 	created_at TIMESTAMP NOT NULL,
 	PRIMARY KEY ( member_id, project_id )
 );
+CREATE TABLE segments (
+	id INTEGER NOT NULL,
+	object_id INTEGER NOT NULL REFERENCES objects( id ) ON DELETE CASCADE,
+	segment_index INTEGER NOT NULL,
+	remote INTEGER NOT NULL,
+	segment_size INTEGER NOT NULL,
+	root_piece_id BLOB,
+	expires_at TIMESTAMP,
+	created_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( id ),
+	UNIQUE ( object_id, segment_index )
+);
 CREATE TABLE used_serials (
 	serial_number_id INTEGER NOT NULL REFERENCES serial_numbers( id ) ON DELETE CASCADE,
 	storage_node_id BLOB NOT NULL,
 	PRIMARY KEY ( serial_number_id, storage_node_id )
 );
+CREATE TABLE api_key_revocations (
+	api_key_id BLOB NOT NULL REFERENCES api_keys( id ) ON DELETE CASCADE,
+	tail BLOB NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( api_key_id, tail )
+);
+CREATE TABLE segment_pieces (
+	segment_id INTEGER NOT NULL REFERENCES segments( id ) ON DELETE CASCADE,
+	piece_num INTEGER NOT NULL,
+	node_id BLOB NOT NULL,
+	PRIMARY KEY ( segment_id, piece_num )
+);
+CREATE TABLE audit_events (
+	id BLOB NOT NULL,
+	project_id BLOB,
+	actor_id BLOB NOT NULL,
+	action TEXT NOT NULL,
+	target TEXT NOT NULL,
+	source_ip TEXT NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE notification_preferences (
+	user_id BLOB NOT NULL REFERENCES users( id ) ON DELETE CASCADE,
+	usage_thresholds INTEGER NOT NULL,
+	api_key_created INTEGER NOT NULL,
+	project_member_added INTEGER NOT NULL,
+	updated_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( user_id )
+);
+CREATE TABLE usage_notifications (
+	project_id BLOB NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+	resource TEXT NOT NULL,
+	threshold INTEGER NOT NULL,
+	period_start TIMESTAMP NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( project_id, resource, threshold, period_start )
+);
+CREATE INDEX audit_events_project_id_created_at ON audit_events ( project_id, created_at );
 CREATE INDEX bucket_name_project_id_interval_start_interval_seconds ON bucket_bandwidth_rollups ( bucket_name, project_id, interval_start, interval_seconds );
 CREATE UNIQUE INDEX bucket_id_rollup ON bucket_usages ( bucket_id, rollup_end_time );
 CREATE INDEX node_last_ip ON nodes ( last_ip );
+CREATE INDEX segment_pieces_node_id_segment_id ON segment_pieces ( node_id, segment_id );
+CREATE INDEX segments_expires_at ON segments ( expires_at );
 CREATE UNIQUE INDEX serial_number ON serial_numbers ( serial_number );
 CREATE INDEX serial_numbers_expires_at_index ON serial_numbers ( expires_at );
 CREATE INDEX storagenode_id_interval_start_interval_seconds ON storagenode_bandwidth_rollups ( storagenode_id, interval_start, interval_seconds );
@@ -0,0 +1,147 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"time"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/satellite/console"
+	dbx "storj.io/storj/satellite/satellitedb/dbx"
+)
+
+// invoices is an implementation of console.Invoices
+type invoices struct {
+	db dbx.Methods
+}
+
+// Create is a method for inserting invoice into the database.
+func (invoices *invoices) Create(ctx context.Context, invoice console.Invoice) (*console.Invoice, error) {
+	id, err := uuid.New()
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := invoices.db.Create_Invoice(ctx,
+		dbx.Invoice_Id(id[:]),
+		dbx.Invoice_ProjectId(invoice.ProjectID[:]),
+		dbx.Invoice_PeriodStart(invoice.PeriodStart),
+		dbx.Invoice_PeriodEnd(invoice.PeriodEnd),
+		dbx.Invoice_Storage(invoice.Storage),
+		dbx.Invoice_Egress(invoice.Egress),
+		dbx.Invoice_ObjectCount(invoice.ObjectCount),
+		dbx.Invoice_SegmentCount(invoice.SegmentCount),
+		dbx.Invoice_StorageAmount(invoice.StorageAmount),
+		dbx.Invoice_EgressAmount(invoice.EgressAmount),
+		dbx.Invoice_ObjectAmount(invoice.ObjectAmount),
+		dbx.Invoice_SegmentAmount(invoice.SegmentAmount),
+		dbx.Invoice_Total(invoice.Total),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return invoiceFromDBX(created)
+}
+
+// Get is a method for querying invoice from the database by id.
+func (invoices *invoices) Get(ctx context.Context, id uuid.UUID) (*console.Invoice, error) {
+	invoice, err := invoices.db.Get_Invoice_By_Id(ctx, dbx.Invoice_Id(id[:]))
+	if err != nil {
+		return nil, err
+	}
+
+	return invoiceFromDBX(invoice)
+}
+
+// GetByProjectID is a method for querying all invoices of a project, newest first.
+func (invoices *invoices) GetByProjectID(ctx context.Context, projectID uuid.UUID) ([]console.Invoice, error) {
+	invoicesDbx, err := invoices.db.All_Invoice_By_ProjectId_OrderBy_Desc_PeriodStart(ctx, dbx.Invoice_ProjectId(projectID[:]))
+	if err != nil {
+		return nil, err
+	}
+
+	return invoicesFromDbxSlice(invoicesDbx)
+}
+
+// GetByProjectIDAndPeriod is a method for querying the invoice of a project for the billing period
+// starting at periodStart, it returns nil when the invoice does not exist.
+func (invoices *invoices) GetByProjectIDAndPeriod(ctx context.Context, projectID uuid.UUID, periodStart time.Time) (*console.Invoice, error) {
+	invoice, err := invoices.db.Find_Invoice_By_ProjectId_And_PeriodStart(ctx,
+		dbx.Invoice_ProjectId(projectID[:]),
+		dbx.Invoice_PeriodStart(periodStart))
+	if err != nil {
+		return nil, err
+	}
+	if invoice == nil {
+		return nil, nil
+	}
+
+	return invoiceFromDBX(invoice)
+}
+
+// GetByPeriod is a method for querying the invoices of all projects for the billing period starting at periodStart.
+func (invoices *invoices) GetByPeriod(ctx context.Context, periodStart time.Time) ([]console.Invoice, error) {
+	invoicesDbx, err := invoices.db.All_Invoice_By_PeriodStart(ctx, dbx.Invoice_PeriodStart(periodStart))
+	if err != nil {
+		return nil, err
+	}
+
+	return invoicesFromDbxSlice(invoicesDbx)
+}
+
+// invoiceFromDBX is used for creating Invoice entity from autogenerated dbx.Invoice struct
+func invoiceFromDBX(invoice *dbx.Invoice) (*console.Invoice, error) {
+	if invoice == nil {
+		return nil, errs.New("invoice parameter is nil")
+	}
+
+	id, err := bytesToUUID(invoice.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	projectID, err := bytesToUUID(invoice.ProjectId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &console.Invoice{
+		ID:            id,
+		ProjectID:     projectID,
+		PeriodStart:   invoice.PeriodStart,
+		PeriodEnd:     invoice.PeriodEnd,
+		Storage:       invoice.Storage,
+		Egress:        invoice.Egress,
+		ObjectCount:   invoice.ObjectCount,
+		SegmentCount:  invoice.SegmentCount,
+		StorageAmount: invoice.StorageAmount,
+		EgressAmount:  invoice.EgressAmount,
+		ObjectAmount:  invoice.ObjectAmount,
+		SegmentAmount: invoice.SegmentAmount,
+		Total:         invoice.Total,
+		CreatedAt:     invoice.CreatedAt,
+	}, nil
+}
+
+// invoicesFromDbxSlice is used for creating []Invoice entities from autogenerated []*dbx.Invoice struct
+func invoicesFromDbxSlice(invoicesDbx []*dbx.Invoice) ([]console.Invoice, error) {
+	var invoices []console.Invoice
+	var errors []error
+
+	for _, invoiceDbx := range invoicesDbx {
+		invoice, err := invoiceFromDBX(invoiceDbx)
+		if err != nil {
+			errors = append(errors, err)
+			continue
+		}
+
+		invoices = append(invoices, *invoice)
+	}
+
+	return invoices, errs.Combine(errors...)
+}
@@ -13,7 +13,9 @@ import (
 
 	"github.com/skyrings/skyring-common/tools/uuid"
 
+	"storj.io/storj/internal/memory"
 	"storj.io/storj/pkg/accounting"
+	"storj.io/storj/pkg/accounting/payout"
 	"storj.io/storj/pkg/audit"
 	"storj.io/storj/pkg/bwagreement"
 	"storj.io/storj/pkg/certdb"
@@ -24,6 +26,7 @@ import (
 	"storj.io/storj/pkg/storj"
 	"storj.io/storj/satellite"
 	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/metainfo"
 	"storj.io/storj/satellite/orders"
 )
 
@@ -147,10 +150,10 @@ type lockedAPIKeys struct {
 }
 
 // Create creates and stores new APIKeyInfo
-func (m *lockedAPIKeys) Create(ctx context.Context, key console.APIKey, info console.APIKeyInfo) (*console.APIKeyInfo, error) {
+func (m *lockedAPIKeys) Create(ctx context.Context, head []byte, info console.APIKeyInfo) (*console.APIKeyInfo, error) {
 	m.Lock()
 	defer m.Unlock()
-	return m.db.Create(ctx, key, info)
+	return m.db.Create(ctx, head, info)
 }
 
 // Delete deletes APIKeyInfo from store
@@ -167,11 +170,11 @@ func (m *lockedAPIKeys) Get(ctx context.Context, id uuid.UUID) (*console.APIKeyI
 	return m.db.Get(ctx, id)
 }
 
-// GetByKey retrieves APIKeyInfo for given key
-func (m *lockedAPIKeys) GetByKey(ctx context.Context, key console.APIKey) (*console.APIKeyInfo, error) {
+// GetByHead retrieves APIKeyInfo for given macaroon head
+func (m *lockedAPIKeys) GetByHead(ctx context.Context, head []byte) (*console.APIKeyInfo, error) {
 	m.Lock()
 	defer m.Unlock()
-	return m.db.GetByKey(ctx, key)
+	return m.db.GetByHead(ctx, head)
 }
 
 // GetByProjectID retrieves list of APIKeys for given projectID
@@ -181,6 +184,20 @@ func (m *lockedAPIKeys) GetByProjectID(ctx context.Context, projectID uuid.UUID)
 	return m.db.GetByProjectID(ctx, projectID)
 }
 
+// GetRevocations retrieves revocation list of api key with given ID
+func (m *lockedAPIKeys) GetRevocations(ctx context.Context, id uuid.UUID) ([][]byte, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.GetRevocations(ctx, id)
+}
+
+// Revoke adds tail of a key derived from api key with given ID to the revocation list
+func (m *lockedAPIKeys) Revoke(ctx context.Context, id uuid.UUID, tail []byte) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Revoke(ctx, id, tail)
+}
+
 // Update updates APIKeyInfo in store
 func (m *lockedAPIKeys) Update(ctx context.Context, key console.APIKeyInfo) error {
 	m.Lock()
@@ -188,6 +205,40 @@ func (m *lockedAPIKeys) Update(ctx context.Context, key console.APIKeyInfo) erro
 	return m.db.Update(ctx, key)
 }
 
+// AuditEvents is a getter for AuditEvents repository
+func (m *lockedConsole) AuditEvents() console.AuditEvents {
+	m.Lock()
+	defer m.Unlock()
+	return &lockedAuditEvents{m.Locker, m.db.AuditEvents()}
+}
+
+// lockedAuditEvents implements locking wrapper for console.AuditEvents
+type lockedAuditEvents struct {
+	sync.Locker
+	db console.AuditEvents
+}
+
+// DeleteBefore is a method for deleting all audit events created before the given time.
+func (m *lockedAuditEvents) DeleteBefore(ctx context.Context, before time.Time) (int64, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.DeleteBefore(ctx, before)
+}
+
+// GetByProjectID is a method for querying audit events of a project by offset and limit, newest first.
+func (m *lockedAuditEvents) GetByProjectID(ctx context.Context, projectID uuid.UUID, limit int, offset int64) ([]console.AuditEvent, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.GetByProjectID(ctx, projectID, limit, offset)
+}
+
+// Insert is a method for appending audit event to the log.
+func (m *lockedAuditEvents) Insert(ctx context.Context, event console.AuditEvent) (*console.AuditEvent, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Insert(ctx, event)
+}
+
 // BucketUsage is a getter for accounting.BucketUsage repository
 func (m *lockedConsole) BucketUsage() accounting.BucketUsage {
 	m.Lock()
@@ -225,6 +276,96 @@ func (m *lockedBucketUsage) GetPaged(ctx context.Context, cursor *accounting.Buc
 	return m.db.GetPaged(ctx, cursor)
 }
 
+// Invoices is a getter for Invoices repository
+func (m *lockedConsole) Invoices() console.Invoices {
+	m.Lock()
+	defer m.Unlock()
+	return &lockedInvoices{m.Locker, m.db.Invoices()}
+}
+
+// lockedInvoices implements locking wrapper for console.Invoices
+type lockedInvoices struct {
+	sync.Locker
+	db console.Invoices
+}
+
+// Create is a method for inserting invoice into the database.
+func (m *lockedInvoices) Create(ctx context.Context, invoice console.Invoice) (*console.Invoice, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Create(ctx, invoice)
+}
+
+// Get is a method for querying invoice from the database by id.
+func (m *lockedInvoices) Get(ctx context.Context, id uuid.UUID) (*console.Invoice, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Get(ctx, id)
+}
+
+// GetByPeriod is a method for querying the invoices of all projects for the billing period starting at periodStart.
+func (m *lockedInvoices) GetByPeriod(ctx context.Context, periodStart time.Time) ([]console.Invoice, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.GetByPeriod(ctx, periodStart)
+}
+
+// GetByProjectID is a method for querying all invoices of a project, newest first.
+func (m *lockedInvoices) GetByProjectID(ctx context.Context, projectID uuid.UUID) ([]console.Invoice, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.GetByProjectID(ctx, projectID)
+}
+
+// GetByProjectIDAndPeriod is a method for querying the invoice of a project for the billing period
+// starting at periodStart, it returns nil when the invoice does not exist.
+func (m *lockedInvoices) GetByProjectIDAndPeriod(ctx context.Context, projectID uuid.UUID, periodStart time.Time) (*console.Invoice, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.GetByProjectIDAndPeriod(ctx, projectID, periodStart)
+}
+
+// Notifications is a getter for Notifications repository
+func (m *lockedConsole) Notifications() console.Notifications {
+	m.Lock()
+	defer m.Unlock()
+	return &lockedNotifications{m.Locker, m.db.Notifications()}
+}
+
+// lockedNotifications implements locking wrapper for console.Notifications
+type lockedNotifications struct {
+	sync.Locker
+	db console.Notifications
+}
+
+// GetPreferences is a method for querying notification preferences of a user, defaults are returned when the user hasn't changed them.
+func (m *lockedNotifications) GetPreferences(ctx context.Context, userID uuid.UUID) (*console.NotificationPreferences, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.GetPreferences(ctx, userID)
+}
+
+// HasUsageNotification is a method for checking whether the usage notification was already sent.
+func (m *lockedNotifications) HasUsageNotification(ctx context.Context, notification console.UsageNotification) (bool, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.HasUsageNotification(ctx, notification)
+}
+
+// InsertUsageNotification is a method for recording that the usage notification was sent.
+func (m *lockedNotifications) InsertUsageNotification(ctx context.Context, notification console.UsageNotification) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.InsertUsageNotification(ctx, notification)
+}
+
+// UpdatePreferences is a method for storing notification preferences of a user.
+func (m *lockedNotifications) UpdatePreferences(ctx context.Context, userID uuid.UUID, preferences console.NotificationPreferences) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.UpdatePreferences(ctx, userID, preferences)
+}
+
 // ProjectMembers is a getter for ProjectMembers repository
 func (m *lockedConsole) ProjectMembers() console.ProjectMembers {
 	m.Lock()
@@ -259,11 +400,18 @@ func (m *lockedProjectMembers) GetByProjectID(ctx context.Context, projectID uui
 	return m.db.GetByProjectID(ctx, projectID, pagination)
 }
 
-// Insert is a method for inserting project member into the database.
-func (m *lockedProjectMembers) Insert(ctx context.Context, memberID uuid.UUID, projectID uuid.UUID) (*console.ProjectMember, error) {
+// Insert is a method for inserting project member with the given role into the database.
+func (m *lockedProjectMembers) Insert(ctx context.Context, memberID uuid.UUID, projectID uuid.UUID, role console.ProjectMemberRole) (*console.ProjectMember, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Insert(ctx, memberID, projectID, role)
+}
+
+// UpdateRole is a method for changing the role of project member.
+func (m *lockedProjectMembers) UpdateRole(ctx context.Context, memberID uuid.UUID, projectID uuid.UUID, role console.ProjectMemberRole) error {
 	m.Lock()
 	defer m.Unlock()
-	return m.db.Insert(ctx, memberID, projectID)
+	return m.db.UpdateRole(ctx, memberID, projectID, role)
 }
 
 // Projects is a getter for Projects repository
@@ -321,6 +469,13 @@ func (m *lockedProjects) Update(ctx context.Context, project *console.Project) e
 	return m.db.Update(ctx, project)
 }
 
+// UpdateUsageLimits is a method for updating project storage and egress limits.
+func (m *lockedProjects) UpdateUsageLimits(ctx context.Context, id uuid.UUID, storageLimit memory.Size, egressLimit memory.Size) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.UpdateUsageLimits(ctx, id, storageLimit, egressLimit)
+}
+
 // RegistrationTokens is a getter for RegistrationTokens repository
 func (m *lockedConsole) RegistrationTokens() console.RegistrationTokens {
 	m.Lock()
@@ -428,6 +583,12 @@ func (m *lockedUsageRollups) GetBucketUsageRollups(ctx context.Context, projectI
 	return m.db.GetBucketUsageRollups(ctx, projectID, since, before)
 }
 
+func (m *lockedUsageRollups) GetProjectBillableUsage(ctx context.Context, projectID uuid.UUID, since time.Time, before time.Time) (*console.ProjectUsage, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.GetProjectBillableUsage(ctx, projectID, since, before)
+}
+
 func (m *lockedUsageRollups) GetProjectTotal(ctx context.Context, projectID uuid.UUID, since time.Time, before time.Time) (*console.ProjectUsage, error) {
 	m.Lock()
 	defer m.Unlock()
@@ -671,11 +832,18 @@ type lockedOverlayCache struct {
 	db overlay.DB
 }
 
-// CreateStats initializes the stats for node.
-func (m *lockedOverlayCache) CreateStats(ctx context.Context, nodeID storj.NodeID, initial *overlay.NodeStats) (stats *overlay.NodeStats, err error) {
+// CreateStats initializes the stats for node, the reputation is seeded from the initial counts using the parameters from config.
+func (m *lockedOverlayCache) CreateStats(ctx context.Context, nodeID storj.NodeID, initial *overlay.NodeStats, config overlay.NodeSelectionConfig) (stats *overlay.NodeStats, err error) {
 	m.Lock()
 	defer m.Unlock()
-	return m.db.CreateStats(ctx, nodeID, initial)
+	return m.db.CreateStats(ctx, nodeID, initial, config)
+}
+
+// DisqualifyNode marks the node as disqualified at disqualifiedAt, unless it is already disqualified.
+func (m *lockedOverlayCache) DisqualifyNode(ctx context.Context, nodeID storj.NodeID, disqualifiedAt time.Time) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.DisqualifyNode(ctx, nodeID, disqualifiedAt)
 }
 
 // Get looks up the node by nodeID
@@ -699,6 +867,13 @@ func (m *lockedOverlayCache) Paginate(ctx context.Context, offset int64, limit i
 	return m.db.Paginate(ctx, offset, limit)
 }
 
+// ReinstateNode clears the disqualification of the node.
+func (m *lockedOverlayCache) ReinstateNode(ctx context.Context, nodeID storj.NodeID) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.ReinstateNode(ctx, nodeID)
+}
+
 // SelectNewStorageNodes looks up nodes based on new node criteria
 func (m *lockedOverlayCache) SelectNewStorageNodes(ctx context.Context, count int, criteria *overlay.NodeCriteria) ([]*pb.Node, error) {
 	m.Lock()
@@ -713,11 +888,11 @@ func (m *lockedOverlayCache) SelectStorageNodes(ctx context.Context, count int,
 	return m.db.SelectStorageNodes(ctx, count, criteria)
 }
 
-// Update updates node address
-func (m *lockedOverlayCache) UpdateAddress(ctx context.Context, value *pb.Node) error {
+// Update updates node address, new nodes start with the initial reputation from defaults
+func (m *lockedOverlayCache) UpdateAddress(ctx context.Context, value *pb.Node, defaults overlay.NodeSelectionConfig) error {
 	m.Lock()
 	defer m.Unlock()
-	return m.db.UpdateAddress(ctx, value)
+	return m.db.UpdateAddress(ctx, value, defaults)
 }
 
 // UpdateNodeInfo updates node dossier with info requested from the node itself like node type, email, wallet, capacity, and version.
@@ -735,10 +910,51 @@ func (m *lockedOverlayCache) UpdateStats(ctx context.Context, request *overlay.U
 }
 
 // UpdateUptime updates a single storagenode's uptime stats.
-func (m *lockedOverlayCache) UpdateUptime(ctx context.Context, nodeID storj.NodeID, isUp bool) (stats *overlay.NodeStats, err error) {
+func (m *lockedOverlayCache) UpdateUptime(ctx context.Context, nodeID storj.NodeID, isUp bool, lambda float64, weight float64) (stats *overlay.NodeStats, err error) {
 	m.Lock()
 	defer m.Unlock()
-	return m.db.UpdateUptime(ctx, nodeID, isUp)
+	return m.db.UpdateUptime(ctx, nodeID, isUp, lambda, weight)
+}
+
+// Payouts returns database for storage node payout statements
+func (m *locked) Payouts() payout.DB {
+	m.Lock()
+	defer m.Unlock()
+	return &lockedPayouts{m.Locker, m.db.Payouts()}
+}
+
+// lockedPayouts implements locking wrapper for payout.DB
+type lockedPayouts struct {
+	sync.Locker
+	db payout.DB
+}
+
+// Create inserts a payout statement
+func (m *lockedPayouts) Create(ctx context.Context, statement payout.Statement) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Create(ctx, statement)
+}
+
+// Get returns the statement of a node for the period starting at periodStart, it returns nil when the statement does not exist
+func (m *lockedPayouts) Get(ctx context.Context, nodeID storj.NodeID, periodStart time.Time) (*payout.Statement, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Get(ctx, nodeID, periodStart)
+}
+
+// GetByNodeID returns all statements of a node, newest first
+func (m *lockedPayouts) GetByNodeID(ctx context.Context, nodeID storj.NodeID) ([]payout.Statement, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.GetByNodeID(ctx, nodeID)
+}
+
+// GetByPeriod returns the statements of all nodes for the period starting at periodStart
+func (m *lockedPayouts) GetByPeriod(ctx context.Context, periodStart time.Time) ([]payout.Statement, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.GetByPeriod(ctx, periodStart)
 }
 
 // ProjectAccounting returns database for storing information about project data use
@@ -762,10 +978,17 @@ func (m *lockedProjectAccounting) CreateStorageTally(ctx context.Context, tally
 }
 
 // GetAllocatedBandwidthTotal returns the sum of GET bandwidth usage allocated for a projectID in the past time frame
-func (m *lockedProjectAccounting) GetAllocatedBandwidthTotal(ctx context.Context, bucketID []byte, from time.Time) (int64, error) {
+func (m *lockedProjectAccounting) GetAllocatedBandwidthTotal(ctx context.Context, projectID uuid.UUID, from time.Time) (int64, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.GetAllocatedBandwidthTotal(ctx, projectID, from)
+}
+
+// GetProjectUsageLimits returns the storage and egress limits of a projectID, zero means no custom limit is set
+func (m *lockedProjectAccounting) GetProjectUsageLimits(ctx context.Context, projectID uuid.UUID) (storageLimit memory.Size, egressLimit memory.Size, err error) {
 	m.Lock()
 	defer m.Unlock()
-	return m.db.GetAllocatedBandwidthTotal(ctx, bucketID, from)
+	return m.db.GetProjectUsageLimits(ctx, projectID)
 }
 
 // GetStorageTotals returns the current inline and remote storage usage for a projectID
@@ -823,6 +1046,61 @@ func (m *lockedRepairQueue) SelectN(ctx context.Context, limit int) ([]pb.Injure
 	return m.db.SelectN(ctx, limit)
 }
 
+// Segments returns database for queryable segment metadata
+func (m *locked) Segments() metainfo.SegmentsDB {
+	m.Lock()
+	defer m.Unlock()
+	return &lockedSegments{m.Locker, m.db.Segments()}
+}
+
+// lockedSegments implements locking wrapper for metainfo.SegmentsDB
+type lockedSegments struct {
+	sync.Locker
+	db metainfo.SegmentsDB
+}
+
+// BucketSize returns the amount of data stored in a bucket
+func (m *lockedSegments) BucketSize(ctx context.Context, projectID uuid.UUID, bucketName []byte) (metainfo.BucketSize, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.BucketSize(ctx, projectID, bucketName)
+}
+
+// Delete deletes the metadata of the segment, the object is deleted together with its last segment
+func (m *lockedSegments) Delete(ctx context.Context, location metainfo.SegmentLocation) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Delete(ctx, location)
+}
+
+// Expiring returns the number of segments and their total size, which expire in [from, to)
+func (m *lockedSegments) Expiring(ctx context.Context, from time.Time, to time.Time) (segments int64, size int64, err error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Expiring(ctx, from, to)
+}
+
+// List lists up to limit segments, which come after the segment with the given id
+func (m *lockedSegments) List(ctx context.Context, afterID int64, limit int) ([]metainfo.Segment, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.List(ctx, afterID, limit)
+}
+
+// ListByNode lists up to limit segments with a piece on the node, which come after the segment with the given id
+func (m *lockedSegments) ListByNode(ctx context.Context, nodeID storj.NodeID, afterID int64, limit int) ([]metainfo.NodeSegment, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.ListByNode(ctx, nodeID, afterID, limit)
+}
+
+// Put stores the metadata of the pointer of the segment, replacing the previous metadata
+func (m *lockedSegments) Put(ctx context.Context, location metainfo.SegmentLocation, pointer *pb.Pointer) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Put(ctx, location, pointer)
+}
+
 // StoragenodeAccounting returns database for storing information about storagenode use
 func (m *locked) StoragenodeAccounting() accounting.StoragenodeAccounting {
 	m.Lock()
@@ -871,6 +1149,13 @@ func (m *lockedStoragenodeAccounting) LastTimestamp(ctx context.Context, timesta
 	return m.db.LastTimestamp(ctx, timestampType)
 }
 
+// QueryNodePaymentInfo queries Nodes and Accounting_Rollup for a single node, it returns nil when the node has no rollups
+func (m *lockedStoragenodeAccounting) QueryNodePaymentInfo(ctx context.Context, nodeID storj.NodeID, start time.Time, end time.Time) (*accounting.CSVRow, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.QueryNodePaymentInfo(ctx, nodeID, start, end)
+}
+
 // QueryPaymentInfo queries Nodes and Accounting_Rollup on nodeID
 func (m *lockedStoragenodeAccounting) QueryPaymentInfo(ctx context.Context, start time.Time, end time.Time) ([]*accounting.CSVRow, error) {
 	m.Lock()
@@ -878,6 +1163,13 @@ func (m *lockedStoragenodeAccounting) QueryPaymentInfo(ctx context.Context, star
 	return m.db.QueryPaymentInfo(ctx, start, end)
 }
 
+// QueryPayoutInfo queries Nodes and Accounting_Rollup on nodeID, skipping rollups of nodes missing from Nodes
+func (m *lockedStoragenodeAccounting) QueryPayoutInfo(ctx context.Context, start time.Time, end time.Time) ([]*accounting.CSVRow, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.QueryPayoutInfo(ctx, start, end)
+}
+
 // SaveRollup records tally and bandwidth rollup aggregations to the database
 func (m *lockedStoragenodeAccounting) SaveRollup(ctx context.Context, latestTally time.Time, stats accounting.RollupStats) error {
 	m.Lock()
@@ -36,6 +36,21 @@ func (db *DB) CreateTables() error {
 		}
 		migration := db.PostgresMigration()
 		return migration.Run(db.log.Named("migrate"), db.db)
+	case "cockroach":
+		schema, err := pgutil.ParseSchemaFromConnstr(db.source)
+		if err != nil {
+			return errs.New("error parsing schema: %+v", err)
+		}
+		if schema != "" {
+			err = db.CreateSchema(schema)
+			if err != nil {
+				return errs.New("error creating schema: %+v", err)
+			}
+		}
+		// cockroach databases start from the current schema, because
+		// the historical postgres migration steps can't run on cockroach
+		migration := db.PostgresMigration()
+		return migration.RunOrCreate(db.log.Named("migrate"), db.db, migrate.SQL{db.db.Schema()})
 	default:
 		return migrate.Create("database", db.db)
 	}
@@ -694,6 +709,247 @@ func (db *DB) PostgresMigration() *migrate.Migration {
 					);`,
 				},
 			},
+			{
+				Description: "Add storage and egress usage limits to projects",
+				Version:     23,
+				Action: migrate.SQL{
+					`ALTER TABLE projects ADD storage_limit bigint;
+					UPDATE projects SET storage_limit = 0;
+					ALTER TABLE projects ALTER COLUMN storage_limit SET NOT NULL;`,
+
+					`ALTER TABLE projects ADD egress_limit bigint;
+					UPDATE projects SET egress_limit = 0;
+					ALTER TABLE projects ALTER COLUMN egress_limit SET NOT NULL;`,
+				},
+			},
+			{
+				Description: "Add invoices table",
+				Version:     24,
+				Action: migrate.SQL{`
+					CREATE TABLE invoices (
+						id bytea NOT NULL,
+						project_id bytea NOT NULL,
+						period_start timestamp with time zone NOT NULL,
+						period_end timestamp with time zone NOT NULL,
+						storage double precision NOT NULL,
+						egress double precision NOT NULL,
+						object_count double precision NOT NULL,
+						storage_amount bigint NOT NULL,
+						egress_amount bigint NOT NULL,
+						object_amount bigint NOT NULL,
+						total bigint NOT NULL,
+						created_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( id ),
+						UNIQUE ( project_id, period_start )
+					);`,
+				},
+			},
+			{
+				Description: "Add payout statements table",
+				Version:     25,
+				Action: migrate.SQL{`
+					CREATE TABLE payout_statements (
+						node_id bytea NOT NULL,
+						period_start timestamp with time zone NOT NULL,
+						period_end timestamp with time zone NOT NULL,
+						node_created_at timestamp with time zone NOT NULL,
+						wallet text NOT NULL,
+						at_rest_total double precision NOT NULL,
+						get_total bigint NOT NULL,
+						get_repair_total bigint NOT NULL,
+						get_audit_total bigint NOT NULL,
+						put_total bigint NOT NULL,
+						put_repair_total bigint NOT NULL,
+						at_rest_amount bigint NOT NULL,
+						get_amount bigint NOT NULL,
+						repair_amount bigint NOT NULL,
+						audit_amount bigint NOT NULL,
+						earned bigint NOT NULL,
+						held_percent integer NOT NULL,
+						held bigint NOT NULL,
+						disqualified boolean NOT NULL,
+						paid bigint NOT NULL,
+						created_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( node_id, period_start )
+					);`,
+				},
+			},
+			{
+				Description: "Add disqualified column to nodes table",
+				Version:     26,
+				Action: migrate.SQL{
+					`ALTER TABLE nodes ADD disqualified timestamp with time zone;`,
+				},
+			},
+			{
+				Description: "Add alpha/beta reputation columns to nodes table, seeded from the lifetime counts",
+				Version:     27,
+				Action: migrate.SQL{
+					`ALTER TABLE nodes ADD audit_reputation_alpha double precision;
+					ALTER TABLE nodes ADD audit_reputation_beta double precision;
+					ALTER TABLE nodes ADD uptime_reputation_alpha double precision;
+					ALTER TABLE nodes ADD uptime_reputation_beta double precision;`,
+					// the counts are not capped here, the next update caps them at what the configured lambdas accumulate
+					`UPDATE nodes SET
+						audit_reputation_alpha = CASE WHEN total_audit_count = 0 THEN 1 ELSE audit_success_ratio * total_audit_count END,
+						audit_reputation_beta = CASE WHEN total_audit_count = 0 THEN 0 ELSE (1 - audit_success_ratio) * total_audit_count END,
+						uptime_reputation_alpha = CASE WHEN total_uptime_count = 0 THEN 1 ELSE uptime_ratio * total_uptime_count END,
+						uptime_reputation_beta = CASE WHEN total_uptime_count = 0 THEN 0 ELSE (1 - uptime_ratio) * total_uptime_count END;`,
+					`ALTER TABLE nodes ALTER COLUMN audit_reputation_alpha SET NOT NULL;
+					ALTER TABLE nodes ALTER COLUMN audit_reputation_beta SET NOT NULL;
+					ALTER TABLE nodes ALTER COLUMN uptime_reputation_alpha SET NOT NULL;
+					ALTER TABLE nodes ALTER COLUMN uptime_reputation_beta SET NOT NULL;`,
+				},
+			},
+			{
+				Description: "Store macaroon head and secret for api keys and add api key revocations table",
+				Version:     28,
+				Action: migrate.SQL{
+					`ALTER TABLE api_keys ADD head bytea;
+					ALTER TABLE api_keys ADD secret bytea;`,
+					// existing keys become unrestricted macaroons with the key as the secret,
+					// the head is derived from the key, so it doesn't reveal it
+					`UPDATE api_keys SET head = decode(md5(key), 'hex'), secret = key;`,
+					`ALTER TABLE api_keys ALTER COLUMN head SET NOT NULL;
+					ALTER TABLE api_keys ALTER COLUMN secret SET NOT NULL;
+					ALTER TABLE api_keys DROP COLUMN key;
+					ALTER TABLE api_keys ADD UNIQUE ( head );`,
+					`CREATE TABLE api_key_revocations (
+						api_key_id bytea NOT NULL REFERENCES api_keys( id ) ON DELETE CASCADE,
+						tail bytea NOT NULL,
+						created_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( api_key_id, tail )
+					);`,
+				},
+			},
+			{
+				Description: "Add piece number and segment path to pending audits",
+				Version:     29,
+				Action: migrate.SQL{
+					// existing pending audits can't be reverified without the segment path
+					`DELETE FROM pending_audits;`,
+					`UPDATE nodes SET contained = false;`,
+					`ALTER TABLE pending_audits ADD piece_num bigint NOT NULL;
+					ALTER TABLE pending_audits ADD path bytea NOT NULL;`,
+				},
+			},
+			{
+				Description: "Add caveat restricting api keys",
+				Version:     30,
+				Action: migrate.SQL{
+					`ALTER TABLE api_keys ADD caveat bytea;`,
+				},
+			},
+			{
+				Description: "Add two-factor authentication to users",
+				Version:     31,
+				Action: migrate.SQL{
+					`ALTER TABLE users ADD mfa_enabled boolean NOT NULL DEFAULT false;
+					ALTER TABLE users ADD mfa_secret_key text;
+					ALTER TABLE users ADD mfa_recovery_codes text;`,
+				},
+			},
+			{
+				Description: "Add roles to project members, the earliest member of a project becomes its owner",
+				Version:     32,
+				Action: migrate.SQL{
+					`ALTER TABLE project_members ADD role integer NOT NULL DEFAULT 3;
+					UPDATE project_members SET role = 4
+						WHERE ( member_id, project_id ) IN (
+							SELECT DISTINCT ON ( project_id ) member_id, project_id FROM project_members
+								ORDER BY project_id, created_at, member_id
+						);`,
+				},
+			},
+			{
+				Description: "Add audit log of console actions",
+				Version:     33,
+				Action: migrate.SQL{
+					`CREATE TABLE audit_events (
+						id bytea NOT NULL,
+						project_id bytea,
+						actor_id bytea NOT NULL,
+						action text NOT NULL,
+						target text NOT NULL,
+						source_ip text NOT NULL,
+						created_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( id )
+					);`,
+					`CREATE INDEX audit_events_project_id_created_at ON audit_events ( project_id, created_at );`,
+				},
+			},
+			{
+				Description: "Add notification preferences and sent usage notifications",
+				Version:     34,
+				Action: migrate.SQL{
+					`CREATE TABLE notification_preferences (
+						user_id bytea NOT NULL REFERENCES users( id ) ON DELETE CASCADE,
+						usage_thresholds boolean NOT NULL,
+						api_key_created boolean NOT NULL,
+						project_member_added boolean NOT NULL,
+						updated_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( user_id )
+					);`,
+					`CREATE TABLE usage_notifications (
+						project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+						resource text NOT NULL,
+						threshold integer NOT NULL,
+						period_start timestamp with time zone NOT NULL,
+						created_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( project_id, resource, threshold, period_start )
+					);`,
+				},
+			},
+			{
+				Description: "Add queryable segment metadata",
+				Version:     35,
+				Action: migrate.SQL{
+					`CREATE TABLE objects (
+						id bigserial NOT NULL,
+						project_id bytea NOT NULL,
+						bucket_name bytea NOT NULL,
+						object_key bytea NOT NULL,
+						created_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( id ),
+						UNIQUE ( project_id, bucket_name, object_key )
+					);`,
+					`CREATE TABLE segments (
+						id bigserial NOT NULL,
+						object_id bigint NOT NULL REFERENCES objects( id ) ON DELETE CASCADE,
+						segment_index bigint NOT NULL,
+						remote boolean NOT NULL,
+						segment_size bigint NOT NULL,
+						root_piece_id bytea,
+						expires_at timestamp with time zone,
+						created_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( id ),
+						UNIQUE ( object_id, segment_index )
+					);`,
+					`CREATE TABLE segment_pieces (
+						segment_id bigint NOT NULL REFERENCES segments( id ) ON DELETE CASCADE,
+						piece_num integer NOT NULL,
+						node_id bytea NOT NULL,
+						PRIMARY KEY ( segment_id, piece_num )
+					);`,
+					`CREATE INDEX segment_pieces_node_id_segment_id ON segment_pieces ( node_id, segment_id );`,
+					`CREATE INDEX segments_expires_at ON segments ( expires_at );`,
+				},
+			},
+			{
+				Description: "Add segment charges to invoices",
+				Version:     36,
+				Action: migrate.SQL{
+					`ALTER TABLE invoices ADD segment_count double precision NOT NULL DEFAULT 0;
+					ALTER TABLE invoices ADD segment_amount bigint NOT NULL DEFAULT 0;`,
+				},
+			},
+			{
+				Description: "Add last audit time to nodes table",
+				Version:     37,
+				Action: migrate.SQL{
+					`ALTER TABLE nodes ADD last_audited timestamp with time zone;`,
+				},
+			},
 		},
 	}
 }
@@ -0,0 +1,87 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+
+	"storj.io/storj/satellite/console"
+	dbx "storj.io/storj/satellite/satellitedb/dbx"
+)
+
+// notifications is an implementation of console.Notifications
+type notifications struct {
+	db dbx.Methods
+}
+
+// GetPreferences is a method for querying notification preferences of a user, defaults are returned when the user hasn't changed them.
+func (notifications *notifications) GetPreferences(ctx context.Context, userID uuid.UUID) (*console.NotificationPreferences, error) {
+	dbxPreferences, err := notifications.db.Find_NotificationPreference_By_UserId(ctx,
+		dbx.NotificationPreference_UserId(userID[:]))
+	if err != nil {
+		return nil, err
+	}
+
+	preferences := console.DefaultNotificationPreferences()
+	if dbxPreferences != nil {
+		preferences = console.NotificationPreferences{
+			UsageThresholds:    dbxPreferences.UsageThresholds,
+			APIKeyCreated:      dbxPreferences.ApiKeyCreated,
+			ProjectMemberAdded: dbxPreferences.ProjectMemberAdded,
+		}
+	}
+
+	return &preferences, nil
+}
+
+// UpdatePreferences is a method for storing notification preferences of a user.
+func (notifications *notifications) UpdatePreferences(ctx context.Context, userID uuid.UUID, preferences console.NotificationPreferences) error {
+	existing, err := notifications.db.Find_NotificationPreference_By_UserId(ctx,
+		dbx.NotificationPreference_UserId(userID[:]))
+	if err != nil {
+		return err
+	}
+
+	if existing == nil {
+		_, err = notifications.db.Create_NotificationPreference(ctx,
+			dbx.NotificationPreference_UserId(userID[:]),
+			dbx.NotificationPreference_UsageThresholds(preferences.UsageThresholds),
+			dbx.NotificationPreference_ApiKeyCreated(preferences.APIKeyCreated),
+			dbx.NotificationPreference_ProjectMemberAdded(preferences.ProjectMemberAdded),
+		)
+		return err
+	}
+
+	_, err = notifications.db.Update_NotificationPreference_By_UserId(ctx,
+		dbx.NotificationPreference_UserId(userID[:]),
+		dbx.NotificationPreference_Update_Fields{
+			UsageThresholds:    dbx.NotificationPreference_UsageThresholds(preferences.UsageThresholds),
+			ApiKeyCreated:      dbx.NotificationPreference_ApiKeyCreated(preferences.APIKeyCreated),
+			ProjectMemberAdded: dbx.NotificationPreference_ProjectMemberAdded(preferences.ProjectMemberAdded),
+		})
+	return err
+}
+
+// HasUsageNotification is a method for checking whether the usage notification was already sent.
+func (notifications *notifications) HasUsageNotification(ctx context.Context, notification console.UsageNotification) (bool, error) {
+	return notifications.db.Has_UsageNotification_By_ProjectId_And_Resource_And_Threshold_And_PeriodStart(ctx,
+		dbx.UsageNotification_ProjectId(notification.ProjectID[:]),
+		dbx.UsageNotification_Resource(string(notification.Resource)),
+		dbx.UsageNotification_Threshold(notification.Threshold),
+		dbx.UsageNotification_PeriodStart(notification.PeriodStart),
+	)
+}
+
+// InsertUsageNotification is a method for recording that the usage notification was sent.
+func (notifications *notifications) InsertUsageNotification(ctx context.Context, notification console.UsageNotification) error {
+	_, err := notifications.db.Create_UsageNotification(ctx,
+		dbx.UsageNotification_ProjectId(notification.ProjectID[:]),
+		dbx.UsageNotification_Resource(string(notification.Resource)),
+		dbx.UsageNotification_Threshold(notification.Threshold),
+		dbx.UsageNotification_PeriodStart(notification.PeriodStart),
+	)
+	return err
+}
@@ -39,16 +39,17 @@ func (cache *overlaycache) SelectStorageNodes(ctx context.Context, count int, cr
 	nodeType := int(pb.NodeType_STORAGE)
 
 	safeQuery := `
-		WHERE type = ? AND free_bandwidth >= ? AND free_disk >= ?
+		WHERE disqualified IS NULL
+		  AND type = ? AND free_bandwidth >= ? AND free_disk >= ?
 		  AND total_audit_count >= ?
-		  AND audit_success_ratio >= ?
+		  AND audit_reputation_alpha >= ? * (audit_reputation_alpha + audit_reputation_beta)
 		  AND total_uptime_count >= ?
-		  AND uptime_ratio >= ?
+		  AND uptime_reputation_alpha >= ? * (uptime_reputation_alpha + uptime_reputation_beta)
 		  AND last_contact_success > ?
 		  AND last_contact_success > last_contact_failure`
 	args := append(make([]interface{}, 0, 13),
 		nodeType, criteria.FreeBandwidth, criteria.FreeDisk,
-		criteria.AuditCount, criteria.AuditSuccessRatio, criteria.UptimeCount, criteria.UptimeSuccessRatio,
+		criteria.AuditCount, criteria.AuditReputation, criteria.UptimeCount, criteria.UptimeReputation,
 		time.Now().Add(-criteria.OnlineWindow))
 
 	if criteria.MinimumVersion != "" {
@@ -93,12 +94,14 @@ func (cache *overlaycache) SelectNewStorageNodes(ctx context.Context, count int,
 	nodeType := int(pb.NodeType_STORAGE)
 
 	safeQuery := `
-		WHERE type = ? AND free_bandwidth >= ? AND free_disk >= ?
-		  AND total_audit_count < ? AND audit_success_ratio >= ?
+		WHERE disqualified IS NULL
+		  AND type = ? AND free_bandwidth >= ? AND free_disk >= ?
+		  AND total_audit_count < ?
+		  AND audit_reputation_alpha >= ? * (audit_reputation_alpha + audit_reputation_beta)
 		  AND last_contact_success > ?
 		  AND last_contact_success > last_contact_failure`
 	args := append(make([]interface{}, 0, 10),
-		nodeType, criteria.FreeBandwidth, criteria.FreeDisk, criteria.AuditCount, criteria.AuditSuccessRatio, time.Now().Add(-criteria.OnlineWindow))
+		nodeType, criteria.FreeBandwidth, criteria.FreeDisk, criteria.AuditCount, criteria.AuditReputation, time.Now().Add(-criteria.OnlineWindow))
 
 	if criteria.MinimumVersion != "" {
 		v, err := version.NewSemVer(criteria.MinimumVersion)
@@ -353,12 +356,14 @@ func (cache *overlaycache) KnownUnreliableOrOffline(ctx context.Context, criteri
 		for i := range nodeIds {
 			args = append(args, nodeIds[i].Bytes())
 		}
-		args = append(args, criteria.AuditSuccessRatio, criteria.UptimeSuccessRatio, time.Now().Add(-criteria.OnlineWindow))
+		args = append(args, criteria.AuditReputation, criteria.UptimeReputation, time.Now().Add(-criteria.OnlineWindow))
 
 		rows, err = cache.db.Query(cache.db.Rebind(`
 			SELECT id FROM nodes
 			WHERE id IN (?`+strings.Repeat(", ?", len(nodeIds)-1)+`)
-			AND audit_success_ratio >= ? AND uptime_ratio >= ?
+			AND disqualified IS NULL
+			AND audit_reputation_alpha >= ? * (audit_reputation_alpha + audit_reputation_beta)
+			AND uptime_reputation_alpha >= ? * (uptime_reputation_alpha + uptime_reputation_beta)
 			AND last_contact_success > ? AND last_contact_success > last_contact_failure
 		`), args...)
 
@@ -366,10 +371,12 @@ func (cache *overlaycache) KnownUnreliableOrOffline(ctx context.Context, criteri
 		rows, err = cache.db.Query(`
 			SELECT id FROM nodes
 				WHERE id = any($1::bytea[])
-				AND audit_success_ratio >= $2 AND uptime_ratio >= $3
+				AND disqualified IS NULL
+				AND audit_reputation_alpha >= $2 * (audit_reputation_alpha + audit_reputation_beta)
+				AND uptime_reputation_alpha >= $3 * (uptime_reputation_alpha + uptime_reputation_beta)
 				AND last_contact_success > $4 AND last_contact_success > last_contact_failure
 			`, postgresNodeIDList(nodeIds),
-			criteria.AuditSuccessRatio, criteria.UptimeSuccessRatio,
+			criteria.AuditReputation, criteria.UptimeReputation,
 			time.Now().Add(-criteria.OnlineWindow),
 		)
 	default:
@@ -431,7 +438,7 @@ func (cache *overlaycache) Paginate(ctx context.Context, offset int64, limit int
 }
 
 // Update updates node address
-func (cache *overlaycache) UpdateAddress(ctx context.Context, info *pb.Node) (err error) {
+func (cache *overlaycache) UpdateAddress(ctx context.Context, info *pb.Node, defaults overlay.NodeSelectionConfig) (err error) {
 	if info == nil || info.Id.IsZero() {
 		return overlay.ErrEmptyNode
 	}
@@ -474,9 +481,14 @@ func (cache *overlaycache) UpdateAddress(ctx context.Context, info *pb.Node) (er
 			dbx.Node_UptimeSuccessCount(0),
 			dbx.Node_TotalUptimeCount(0),
 			dbx.Node_UptimeRatio(1),
+			dbx.Node_AuditReputationAlpha(defaults.AuditReputationAlpha0),
+			dbx.Node_AuditReputationBeta(defaults.AuditReputationBeta0),
+			dbx.Node_UptimeReputationAlpha(defaults.UptimeReputationAlpha0),
+			dbx.Node_UptimeReputationBeta(defaults.UptimeReputationBeta0),
 			dbx.Node_LastContactSuccess(time.Now()),
 			dbx.Node_LastContactFailure(time.Time{}),
 			dbx.Node_Contained(false),
+			dbx.Node_Create_Fields{},
 		)
 		if err != nil {
 			return Error.Wrap(errs.Combine(err, tx.Rollback()))
@@ -498,7 +510,7 @@ func (cache *overlaycache) UpdateAddress(ctx context.Context, info *pb.Node) (er
 }
 
 // CreateStats initializes the stats the provided storagenode
-func (cache *overlaycache) CreateStats(ctx context.Context, nodeID storj.NodeID, startingStats *overlay.NodeStats) (stats *overlay.NodeStats, err error) {
+func (cache *overlaycache) CreateStats(ctx context.Context, nodeID storj.NodeID, startingStats *overlay.NodeStats, config overlay.NodeSelectionConfig) (stats *overlay.NodeStats, err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	tx, err := cache.db.Open(ctx)
@@ -521,13 +533,20 @@ func (cache *overlaycache) CreateStats(ctx context.Context, nodeID storj.NodeID,
 			return nil, errUptime.Wrap(errs.Combine(err, tx.Rollback()))
 		}
 
+		auditAlpha, auditBeta := initialReputation(auditSuccessRatio, startingStats.AuditCount, config.AuditReputationLambda, config.AuditReputationWeight)
+		uptimeAlpha, uptimeBeta := initialReputation(uptimeRatio, startingStats.UptimeCount, config.UptimeReputationLambda, config.UptimeReputationWeight)
+
 		updateFields := dbx.Node_Update_Fields{
-			AuditSuccessCount:  dbx.Node_AuditSuccessCount(startingStats.AuditSuccessCount),
-			TotalAuditCount:    dbx.Node_TotalAuditCount(startingStats.AuditCount),
-			AuditSuccessRatio:  dbx.Node_AuditSuccessRatio(auditSuccessRatio),
-			UptimeSuccessCount: dbx.Node_UptimeSuccessCount(startingStats.UptimeSuccessCount),
-			TotalUptimeCount:   dbx.Node_TotalUptimeCount(startingStats.UptimeCount),
-			UptimeRatio:        dbx.Node_UptimeRatio(uptimeRatio),
+			AuditSuccessCount:     dbx.Node_AuditSuccessCount(startingStats.AuditSuccessCount),
+			TotalAuditCount:       dbx.Node_TotalAuditCount(startingStats.AuditCount),
+			AuditSuccessRatio:     dbx.Node_AuditSuccessRatio(auditSuccessRatio),
+			UptimeSuccessCount:    dbx.Node_UptimeSuccessCount(startingStats.UptimeSuccessCount),
+			TotalUptimeCount:      dbx.Node_TotalUptimeCount(startingStats.UptimeCount),
+			UptimeRatio:           dbx.Node_UptimeRatio(uptimeRatio),
+			AuditReputationAlpha:  dbx.Node_AuditReputationAlpha(auditAlpha),
+			AuditReputationBeta:   dbx.Node_AuditReputationBeta(auditBeta),
+			UptimeReputationAlpha: dbx.Node_UptimeReputationAlpha(uptimeAlpha),
+			UptimeReputationBeta:  dbx.Node_UptimeReputationBeta(uptimeBeta),
 		}
 
 		dbNode, err = tx.Update_Node_By_Id(ctx, dbx.Node_Id(nodeID.Bytes()), updateFields)
@@ -579,13 +598,34 @@ func (cache *overlaycache) UpdateStats(ctx context.Context, updateReq *overlay.U
 		totalUptimeCount,
 	)
 
+	auditAlpha, auditBeta := updateReputation(
+		updateReq.AuditSuccess,
+		dbNode.AuditReputationAlpha,
+		dbNode.AuditReputationBeta,
+		updateReq.AuditLambda,
+		updateReq.AuditWeight,
+	)
+
+	uptimeAlpha, uptimeBeta := updateReputation(
+		updateReq.IsUp,
+		dbNode.UptimeReputationAlpha,
+		dbNode.UptimeReputationBeta,
+		updateReq.UptimeLambda,
+		updateReq.UptimeWeight,
+	)
+
 	updateFields := dbx.Node_Update_Fields{
-		AuditSuccessCount:  dbx.Node_AuditSuccessCount(auditSuccessCount),
-		TotalAuditCount:    dbx.Node_TotalAuditCount(totalAuditCount),
-		AuditSuccessRatio:  dbx.Node_AuditSuccessRatio(auditSuccessRatio),
-		UptimeSuccessCount: dbx.Node_UptimeSuccessCount(uptimeSuccessCount),
-		TotalUptimeCount:   dbx.Node_TotalUptimeCount(totalUptimeCount),
-		UptimeRatio:        dbx.Node_UptimeRatio(uptimeRatio),
+		AuditSuccessCount:     dbx.Node_AuditSuccessCount(auditSuccessCount),
+		TotalAuditCount:       dbx.Node_TotalAuditCount(totalAuditCount),
+		AuditSuccessRatio:     dbx.Node_AuditSuccessRatio(auditSuccessRatio),
+		UptimeSuccessCount:    dbx.Node_UptimeSuccessCount(uptimeSuccessCount),
+		TotalUptimeCount:      dbx.Node_TotalUptimeCount(totalUptimeCount),
+		UptimeRatio:           dbx.Node_UptimeRatio(uptimeRatio),
+		AuditReputationAlpha:  dbx.Node_AuditReputationAlpha(auditAlpha),
+		AuditReputationBeta:   dbx.Node_AuditReputationBeta(auditBeta),
+		UptimeReputationAlpha: dbx.Node_UptimeReputationAlpha(uptimeAlpha),
+		UptimeReputationBeta:  dbx.Node_UptimeReputationBeta(uptimeBeta),
+		LastAudited:           dbx.Node_LastAudited(time.Now()),
 	}
 
 	if updateReq.IsUp {
@@ -653,7 +693,7 @@ func (cache *overlaycache) UpdateNodeInfo(ctx context.Context, nodeID storj.Node
 }
 
 // UpdateUptime updates a single storagenode's uptime stats in the db
-func (cache *overlaycache) UpdateUptime(ctx context.Context, nodeID storj.NodeID, isUp bool) (stats *overlay.NodeStats, err error) {
+func (cache *overlaycache) UpdateUptime(ctx context.Context, nodeID storj.NodeID, isUp bool, lambda, weight float64) (stats *overlay.NodeStats, err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	tx, err := cache.db.Open(ctx)
@@ -677,9 +717,19 @@ func (cache *overlaycache) UpdateUptime(ctx context.Context, nodeID storj.NodeID
 		totalUptimeCount,
 	)
 
+	uptimeAlpha, uptimeBeta := updateReputation(
+		isUp,
+		dbNode.UptimeReputationAlpha,
+		dbNode.UptimeReputationBeta,
+		lambda,
+		weight,
+	)
+
 	updateFields.UptimeSuccessCount = dbx.Node_UptimeSuccessCount(uptimeSuccessCount)
 	updateFields.TotalUptimeCount = dbx.Node_TotalUptimeCount(totalUptimeCount)
 	updateFields.UptimeRatio = dbx.Node_UptimeRatio(uptimeRatio)
+	updateFields.UptimeReputationAlpha = dbx.Node_UptimeReputationAlpha(uptimeAlpha)
+	updateFields.UptimeReputationBeta = dbx.Node_UptimeReputationBeta(uptimeBeta)
 
 	if isUp {
 		updateFields.LastContactSuccess = dbx.Node_LastContactSuccess(time.Now())
@@ -701,6 +751,44 @@ func (cache *overlaycache) UpdateUptime(ctx context.Context, nodeID storj.NodeID
 	return getNodeStats(dbNode), Error.Wrap(tx.Commit())
 }
 
+// DisqualifyNode marks the node as disqualified at disqualifiedAt, unless it is already disqualified
+func (cache *overlaycache) DisqualifyNode(ctx context.Context, nodeID storj.NodeID, disqualifiedAt time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result, err := cache.db.ExecContext(ctx, cache.db.Rebind(
+		`UPDATE nodes SET disqualified = ? WHERE id = ? AND disqualified IS NULL`),
+		disqualifiedAt.UTC(), nodeID.Bytes())
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if affected == 0 {
+		// either the node is already disqualified or it does not exist
+		_, err = cache.Get(ctx, nodeID)
+		return err
+	}
+	return nil
+}
+
+// ReinstateNode clears the disqualification of the node
+func (cache *overlaycache) ReinstateNode(ctx context.Context, nodeID storj.NodeID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbNode, err := cache.db.Update_Node_By_Id(ctx, dbx.Node_Id(nodeID.Bytes()), dbx.Node_Update_Fields{
+		Disqualified: dbx.Node_Disqualified_Null(),
+	})
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if dbNode == nil {
+		return overlay.ErrNodeNotFound.New(nodeID.String())
+	}
+	return nil
+}
+
 func convertDBNode(info *dbx.Node) (*overlay.NodeDossier, error) {
 	if info == nil {
 		return nil, Error.New("missing info")
@@ -749,6 +837,12 @@ func convertDBNode(info *dbx.Node) (*overlay.NodeDossier, error) {
 			UptimeSuccessCount: info.UptimeSuccessCount,
 			LastContactSuccess: info.LastContactSuccess,
 			LastContactFailure: info.LastContactFailure,
+			LastAudited:        info.LastAudited,
+
+			AuditReputationAlpha:  info.AuditReputationAlpha,
+			AuditReputationBeta:   info.AuditReputationBeta,
+			UptimeReputationAlpha: info.UptimeReputationAlpha,
+			UptimeReputationBeta:  info.UptimeReputationBeta,
 		},
 		Version: pb.NodeVersion{
 			Version:    ver.String(),
@@ -756,7 +850,8 @@ func convertDBNode(info *dbx.Node) (*overlay.NodeDossier, error) {
 			Timestamp:  pbts,
 			Release:    info.Release,
 		},
-		Contained: info.Contained,
+		Contained:    info.Contained,
+		Disqualified: info.Disqualified,
 	}
 
 	return node, nil
@@ -773,6 +868,12 @@ func getNodeStats(dbNode *dbx.Node) *overlay.NodeStats {
 		UptimeCount:        dbNode.TotalUptimeCount,
 		LastContactSuccess: dbNode.LastContactSuccess,
 		LastContactFailure: dbNode.LastContactFailure,
+		LastAudited:        dbNode.LastAudited,
+
+		AuditReputationAlpha:  dbNode.AuditReputationAlpha,
+		AuditReputationBeta:   dbNode.AuditReputationBeta,
+		UptimeReputationAlpha: dbNode.UptimeReputationAlpha,
+		UptimeReputationBeta:  dbNode.UptimeReputationBeta,
 	}
 	return nodeStats
 }
@@ -786,6 +887,42 @@ func updateRatioVars(newStatus bool, successCount, totalCount int64) (int64, int
 	return successCount, totalCount, newRatio
 }
 
+// updateReputation applies a single success or failure to the alpha/beta reputation of a node,
+// older results are forgotten by lambda and new results are weighted by weight
+func updateReputation(isSuccess bool, alpha, beta, lambda, weight float64) (newAlpha, newBeta float64) {
+	alpha, beta = capReputation(alpha, beta, lambda, weight)
+	v := -1.0
+	if isSuccess {
+		v = 1.0
+	}
+	newAlpha = lambda*alpha + weight*(1+v)/2
+	newBeta = lambda*beta + weight*(1-v)/2
+	return newAlpha, newBeta
+}
+
+// initialReputation converts a success ratio over count results into alpha/beta reputation values
+func initialReputation(ratio float64, count int64, lambda, weight float64) (alpha, beta float64) {
+	total := float64(count) * weight
+	if count == 0 {
+		total = 1
+	}
+	return capReputation(ratio*total, (1-ratio)*total, lambda, weight)
+}
+
+// capReputation scales alpha and beta down so that alpha + beta is at most weight / (1 - lambda),
+// which is the most a node accumulates with updateReputation, e.g. for values seeded from lifetime counts
+func capReputation(alpha, beta, lambda, weight float64) (cappedAlpha, cappedBeta float64) {
+	total := alpha + beta
+	if lambda >= 1 || total <= 0 {
+		return alpha, beta
+	}
+	limit := weight / (1 - lambda)
+	if total <= limit {
+		return alpha, beta
+	}
+	return alpha * limit / total, beta * limit / total
+}
+
 func checkRatioVars(successCount, totalCount int64) (ratio float64, err error) {
 	if successCount < 0 {
 		return 0, errs.New("success count less than 0")
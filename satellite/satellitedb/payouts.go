@@ -0,0 +1,134 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/pkg/accounting/payout"
+	"storj.io/storj/pkg/storj"
+	dbx "storj.io/storj/satellite/satellitedb/dbx"
+)
+
+type payouts struct {
+	db *dbx.DB
+}
+
+// Create inserts a payout statement
+func (payouts *payouts) Create(ctx context.Context, statement payout.Statement) error {
+	_, err := payouts.db.Create_PayoutStatement(ctx,
+		dbx.PayoutStatement_NodeId(statement.NodeID.Bytes()),
+		dbx.PayoutStatement_PeriodStart(statement.PeriodStart),
+		dbx.PayoutStatement_PeriodEnd(statement.PeriodEnd),
+		dbx.PayoutStatement_NodeCreatedAt(statement.NodeCreatedAt),
+		dbx.PayoutStatement_Wallet(statement.Wallet),
+		dbx.PayoutStatement_AtRestTotal(statement.AtRestTotal),
+		dbx.PayoutStatement_GetTotal(statement.GetTotal),
+		dbx.PayoutStatement_GetRepairTotal(statement.GetRepairTotal),
+		dbx.PayoutStatement_GetAuditTotal(statement.GetAuditTotal),
+		dbx.PayoutStatement_PutTotal(statement.PutTotal),
+		dbx.PayoutStatement_PutRepairTotal(statement.PutRepairTotal),
+		dbx.PayoutStatement_AtRestAmount(statement.AtRestAmount),
+		dbx.PayoutStatement_GetAmount(statement.GetAmount),
+		dbx.PayoutStatement_RepairAmount(statement.RepairAmount),
+		dbx.PayoutStatement_AuditAmount(statement.AuditAmount),
+		dbx.PayoutStatement_Earned(statement.Earned),
+		dbx.PayoutStatement_HeldPercent(statement.HeldPercent),
+		dbx.PayoutStatement_Held(statement.Held),
+		dbx.PayoutStatement_Disqualified(statement.Disqualified),
+		dbx.PayoutStatement_Paid(statement.Paid),
+	)
+	return Error.Wrap(err)
+}
+
+// Get returns the statement of a node for the period starting at periodStart, it returns nil when the statement does not exist
+func (payouts *payouts) Get(ctx context.Context, nodeID storj.NodeID, periodStart time.Time) (*payout.Statement, error) {
+	dbxStatement, err := payouts.db.Find_PayoutStatement_By_NodeId_And_PeriodStart(ctx,
+		dbx.PayoutStatement_NodeId(nodeID.Bytes()),
+		dbx.PayoutStatement_PeriodStart(periodStart))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if dbxStatement == nil {
+		return nil, nil
+	}
+
+	statement, err := statementFromDBX(dbxStatement)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return &statement, nil
+}
+
+// GetByNodeID returns all statements of a node, newest first
+func (payouts *payouts) GetByNodeID(ctx context.Context, nodeID storj.NodeID) ([]payout.Statement, error) {
+	dbxStatements, err := payouts.db.All_PayoutStatement_By_NodeId_OrderBy_Desc_PeriodStart(ctx,
+		dbx.PayoutStatement_NodeId(nodeID.Bytes()))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return statementsFromDBX(dbxStatements)
+}
+
+// GetByPeriod returns the statements of all nodes for the period starting at periodStart
+func (payouts *payouts) GetByPeriod(ctx context.Context, periodStart time.Time) ([]payout.Statement, error) {
+	dbxStatements, err := payouts.db.All_PayoutStatement_By_PeriodStart_OrderBy_Asc_NodeId(ctx,
+		dbx.PayoutStatement_PeriodStart(periodStart))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return statementsFromDBX(dbxStatements)
+}
+
+func statementsFromDBX(dbxStatements []*dbx.PayoutStatement) ([]payout.Statement, error) {
+	var statements []payout.Statement
+	var errlist errs.Group
+	for _, dbxStatement := range dbxStatements {
+		statement, err := statementFromDBX(dbxStatement)
+		if err != nil {
+			errlist.Add(err)
+			continue
+		}
+		statements = append(statements, statement)
+	}
+	return statements, Error.Wrap(errlist.Err())
+}
+
+func statementFromDBX(statement *dbx.PayoutStatement) (payout.Statement, error) {
+	nodeID, err := storj.NodeIDFromBytes(statement.NodeId)
+	if err != nil {
+		return payout.Statement{}, err
+	}
+
+	return payout.Statement{
+		NodeID:        nodeID,
+		PeriodStart:   statement.PeriodStart,
+		PeriodEnd:     statement.PeriodEnd,
+		NodeCreatedAt: statement.NodeCreatedAt,
+		Wallet:        statement.Wallet,
+
+		AtRestTotal:    statement.AtRestTotal,
+		GetTotal:       statement.GetTotal,
+		GetRepairTotal: statement.GetRepairTotal,
+		GetAuditTotal:  statement.GetAuditTotal,
+		PutTotal:       statement.PutTotal,
+		PutRepairTotal: statement.PutRepairTotal,
+
+		AtRestAmount: statement.AtRestAmount,
+		GetAmount:    statement.GetAmount,
+		RepairAmount: statement.RepairAmount,
+		AuditAmount:  statement.AuditAmount,
+		Earned:       statement.Earned,
+
+		HeldPercent:  statement.HeldPercent,
+		Held:         statement.Held,
+		Disqualified: statement.Disqualified,
+		Paid:         statement.Paid,
+
+		CreatedAt: statement.CreatedAt,
+	}, nil
+}
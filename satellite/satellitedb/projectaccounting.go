@@ -4,13 +4,13 @@
 package satellitedb
 
 import (
-	"bytes"
 	"context"
 	"database/sql"
 	"time"
 
 	"github.com/skyrings/skyring-common/tools/uuid"
 
+	"storj.io/storj/internal/memory"
 	"storj.io/storj/pkg/accounting"
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/storj"
@@ -81,12 +81,10 @@ func (db *ProjectAccounting) CreateStorageTally(ctx context.Context, tally accou
 }
 
 // GetAllocatedBandwidthTotal returns the sum of GET bandwidth usage allocated for a projectID for a time frame
-func (db *ProjectAccounting) GetAllocatedBandwidthTotal(ctx context.Context, bucketID []byte, from time.Time) (int64, error) {
-	pathEl := bytes.Split(bucketID, []byte("/"))
-	_, projectID := pathEl[1], pathEl[0]
+func (db *ProjectAccounting) GetAllocatedBandwidthTotal(ctx context.Context, projectID uuid.UUID, from time.Time) (int64, error) {
 	var sum *int64
 	query := `SELECT SUM(allocated) FROM bucket_bandwidth_rollups WHERE project_id = ? AND action = ? AND interval_start > ?;`
-	err := db.db.QueryRow(db.db.Rebind(query), projectID, pb.PieceAction_GET, from).Scan(&sum)
+	err := db.db.QueryRow(db.db.Rebind(query), []byte(projectID.String()), pb.PieceAction_GET, from).Scan(&sum)
 	if err == sql.ErrNoRows || sum == nil {
 		return 0, nil
 	}
@@ -114,3 +112,12 @@ func (db *ProjectAccounting) GetStorageTotals(ctx context.Context, projectID uui
 	}
 	return inlineSum.Int64, remoteSum.Int64, err
 }
+
+// GetProjectUsageLimits returns the storage and egress limits of a projectID, zero means no custom limit is set
+func (db *ProjectAccounting) GetProjectUsageLimits(ctx context.Context, projectID uuid.UUID) (storageLimit, egressLimit memory.Size, err error) {
+	project, err := db.db.Get_Project_By_Id(ctx, dbx.Project_Id(projectID[:]))
+	if err != nil {
+		return 0, 0, err
+	}
+	return memory.Size(project.StorageLimit), memory.Size(project.EgressLimit), nil
+}
@@ -42,7 +42,7 @@ func (pm *projectMembers) GetByProjectID(ctx context.Context, projectID uuid.UUI
 
 	// TODO: LIKE is case-sensitive postgres, however this should be case-insensitive and possibly allow typos
 	reboundQuery := pm.db.Rebind(`
-		SELECT pm.*
+		SELECT pm.member_id, pm.project_id, pm.role, pm.created_at
 			FROM project_members pm
 				INNER JOIN users u ON pm.member_id = u.id
 					WHERE pm.project_id = ?
@@ -68,7 +68,7 @@ func (pm *projectMembers) GetByProjectID(ctx context.Context, projectID uuid.UUI
 		var memberIDBytes, projectIDBytes []uint8
 		var memberID, projectID uuid.UUID
 
-		err = rows.Scan(&memberIDBytes, &projectIDBytes, &pm.CreatedAt)
+		err = rows.Scan(&memberIDBytes, &projectIDBytes, &pm.Role, &pm.CreatedAt)
 		if err != nil {
 			return nil, err
 		}
@@ -92,11 +92,12 @@ func (pm *projectMembers) GetByProjectID(ctx context.Context, projectID uuid.UUI
 	return projectMembers, err
 }
 
-// Insert is a method for inserting project member into the database.
-func (pm *projectMembers) Insert(ctx context.Context, memberID, projectID uuid.UUID) (*console.ProjectMember, error) {
+// Insert is a method for inserting project member with the given role into the database.
+func (pm *projectMembers) Insert(ctx context.Context, memberID, projectID uuid.UUID, role console.ProjectMemberRole) (*console.ProjectMember, error) {
 	createdProjectMember, err := pm.methods.Create_ProjectMember(ctx,
 		dbx.ProjectMember_MemberId(memberID[:]),
-		dbx.ProjectMember_ProjectId(projectID[:]))
+		dbx.ProjectMember_ProjectId(projectID[:]),
+		dbx.ProjectMember_Role(int(role)))
 	if err != nil {
 		return nil, err
 	}
@@ -104,6 +105,25 @@ func (pm *projectMembers) Insert(ctx context.Context, memberID, projectID uuid.U
 	return projectMemberFromDBX(createdProjectMember)
 }
 
+// UpdateRole is a method for changing the role of project member.
+func (pm *projectMembers) UpdateRole(ctx context.Context, memberID, projectID uuid.UUID, role console.ProjectMemberRole) error {
+	updated, err := pm.methods.Update_ProjectMember_By_MemberId_And_ProjectId(ctx,
+		dbx.ProjectMember_MemberId(memberID[:]),
+		dbx.ProjectMember_ProjectId(projectID[:]),
+		dbx.ProjectMember_Update_Fields{
+			Role: dbx.ProjectMember_Role(int(role)),
+		},
+	)
+	if err != nil {
+		return err
+	}
+	if updated == nil {
+		return errs.New("project member not found")
+	}
+
+	return nil
+}
+
 // Delete is a method for deleting project member by memberID and projectID from the database.
 func (pm *projectMembers) Delete(ctx context.Context, memberID, projectID uuid.UUID) error {
 	_, err := pm.methods.Delete_ProjectMember_By_MemberId_And_ProjectId(
@@ -134,6 +154,7 @@ func projectMemberFromDBX(projectMember *dbx.ProjectMember) (*console.ProjectMem
 	return &console.ProjectMember{
 		MemberID:  memberID,
 		ProjectID: projectID,
+		Role:      console.ProjectMemberRole(projectMember.Role),
 		CreatedAt: projectMember.CreatedAt,
 	}, nil
 }
@@ -9,6 +9,7 @@ import (
 	"github.com/skyrings/skyring-common/tools/uuid"
 	"github.com/zeebo/errs"
 
+	"storj.io/storj/internal/memory"
 	"storj.io/storj/satellite/console"
 	dbx "storj.io/storj/satellite/satellitedb/dbx"
 )
@@ -58,7 +59,9 @@ func (projects *projects) Insert(ctx context.Context, project *console.Project)
 	createdProject, err := projects.db.Create_Project(ctx,
 		dbx.Project_Id(projectID[:]),
 		dbx.Project_Name(project.Name),
-		dbx.Project_Description(project.Description))
+		dbx.Project_Description(project.Description),
+		dbx.Project_StorageLimit(project.StorageLimit.Int64()),
+		dbx.Project_EgressLimit(project.EgressLimit.Int64()))
 
 	if err != nil {
 		return nil, err
@@ -87,6 +90,27 @@ func (projects *projects) Update(ctx context.Context, project *console.Project)
 	return err
 }
 
+// UpdateUsageLimits is a method for updating project storage and egress limits
+func (projects *projects) UpdateUsageLimits(ctx context.Context, id uuid.UUID, storageLimit, egressLimit memory.Size) error {
+	updateFields := dbx.Project_Update_Fields{
+		StorageLimit: dbx.Project_StorageLimit(storageLimit.Int64()),
+		EgressLimit:  dbx.Project_EgressLimit(egressLimit.Int64()),
+	}
+
+	updated, err := projects.db.Update_Project_By_Id(ctx,
+		dbx.Project_Id(id[:]),
+		updateFields)
+	if err != nil {
+		return err
+	}
+	// dbx reports an update that affected no rows as a nil project
+	if updated == nil {
+		return errs.New("project %s not found", id.String())
+	}
+
+	return nil
+}
+
 // projectFromDBX is used for creating Project entity from autogenerated dbx.Project struct
 func projectFromDBX(project *dbx.Project) (*console.Project, error) {
 	if project == nil {
@@ -99,10 +123,12 @@ func projectFromDBX(project *dbx.Project) (*console.Project, error) {
 	}
 
 	u := &console.Project{
-		ID:          id,
-		Name:        project.Name,
-		Description: project.Description,
-		CreatedAt:   project.CreatedAt,
+		ID:           id,
+		Name:         project.Name,
+		Description:  project.Description,
+		StorageLimit: memory.Size(project.StorageLimit),
+		EgressLimit:  memory.Size(project.EgressLimit),
+		CreatedAt:    project.CreatedAt,
 	}
 
 	return u, nil
@@ -36,6 +36,7 @@ func Databases() []Database {
 	return []Database{
 		{"Sqlite", DefaultSqliteConn, ""},
 		{"Postgres", *pgtest.ConnStr, "Postgres flag missing, example: -postgres-test-db=" + pgtest.DefaultConnStr},
+		{"Cockroach", *pgtest.CrdbConnStr, "Cockroach flag missing, example: -cockroach-test-db=" + pgtest.DefaultCrdbConnStr},
 	}
 }
 
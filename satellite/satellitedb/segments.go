@@ -0,0 +1,241 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/satellite/metainfo"
+	dbx "storj.io/storj/satellite/satellitedb/dbx"
+)
+
+type segments struct {
+	db *dbx.DB
+}
+
+// Put stores the metadata of the pointer of the segment, replacing the previous metadata
+func (db *segments) Put(ctx context.Context, location metainfo.SegmentLocation, pointer *pb.Pointer) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var expiresAt *time.Time
+	if pointer.GetExpirationDate() != nil {
+		expiration, err := ptypes.Timestamp(pointer.GetExpirationDate())
+		if err != nil {
+			return errs.Wrap(err)
+		}
+		expiresAt = &expiration
+	}
+
+	remote := pointer.GetType() == pb.Pointer_REMOTE
+	segmentSize := int64(len(pointer.GetInlineSegment()))
+	var rootPieceID []byte
+	if remote {
+		segmentSize = pointer.GetSegmentSize()
+		if pointer.GetRemote() != nil {
+			rootPieceID = pointer.GetRemote().RootPieceId.Bytes()
+		}
+	}
+
+	createdAt := time.Now().UTC()
+	return db.db.WithTx(ctx, func(ctx context.Context, tx *dbx.Tx) error {
+		_, err := tx.Tx.ExecContext(ctx, db.db.Rebind(`
+			INSERT INTO objects ( project_id, bucket_name, object_key, created_at )
+			VALUES ( ?, ?, ?, ? )
+			ON CONFLICT ( project_id, bucket_name, object_key ) DO NOTHING`),
+			location.ProjectID[:], location.BucketName, location.ObjectKey, createdAt,
+		)
+		if err != nil {
+			return err
+		}
+
+		var objectID int64
+		err = tx.Tx.QueryRowContext(ctx, db.db.Rebind(`
+			SELECT id FROM objects
+			WHERE project_id = ? AND bucket_name = ? AND object_key = ?`),
+			location.ProjectID[:], location.BucketName, location.ObjectKey,
+		).Scan(&objectID)
+		if err != nil {
+			return err
+		}
+
+		// the pieces of the replaced segment are deleted by the cascade
+		_, err = tx.Tx.ExecContext(ctx, db.db.Rebind(`
+			DELETE FROM segments WHERE object_id = ? AND segment_index = ?`),
+			objectID, location.SegmentIndex,
+		)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.Tx.ExecContext(ctx, db.db.Rebind(`
+			INSERT INTO segments ( object_id, segment_index, remote, segment_size, root_piece_id, expires_at, created_at )
+			VALUES ( ?, ?, ?, ?, ?, ?, ? )`),
+			objectID, location.SegmentIndex, remote, segmentSize, rootPieceID, expiresAt, createdAt,
+		)
+		if err != nil {
+			return err
+		}
+
+		pieces := pointer.GetRemote().GetRemotePieces()
+		if !remote || len(pieces) == 0 {
+			return nil
+		}
+
+		var segmentID int64
+		err = tx.Tx.QueryRowContext(ctx, db.db.Rebind(`
+			SELECT id FROM segments WHERE object_id = ? AND segment_index = ?`),
+			objectID, location.SegmentIndex,
+		).Scan(&segmentID)
+		if err != nil {
+			return err
+		}
+
+		insertPiece := db.db.Rebind(`INSERT INTO segment_pieces ( segment_id, piece_num, node_id ) VALUES ( ?, ?, ? )`)
+		for _, piece := range pieces {
+			_, err = tx.Tx.ExecContext(ctx, insertPiece, segmentID, piece.GetPieceNum(), piece.NodeId.Bytes())
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Delete deletes the metadata of the segment, the object is deleted together with its last segment
+func (db *segments) Delete(ctx context.Context, location metainfo.SegmentLocation) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return db.db.WithTx(ctx, func(ctx context.Context, tx *dbx.Tx) error {
+		_, err := tx.Tx.ExecContext(ctx, db.db.Rebind(`
+			DELETE FROM segments
+			WHERE segment_index = ? AND object_id = (
+				SELECT id FROM objects
+				WHERE project_id = ? AND bucket_name = ? AND object_key = ?
+			)`),
+			location.SegmentIndex, location.ProjectID[:], location.BucketName, location.ObjectKey,
+		)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.Tx.ExecContext(ctx, db.db.Rebind(`
+			DELETE FROM objects
+			WHERE project_id = ? AND bucket_name = ? AND object_key = ?
+				AND NOT EXISTS ( SELECT 1 FROM segments WHERE segments.object_id = objects.id )`),
+			location.ProjectID[:], location.BucketName, location.ObjectKey,
+		)
+		return err
+	})
+}
+
+// List lists up to limit segments, which come after the segment with the given id
+func (db *segments) List(ctx context.Context, afterID int64, limit int) (_ []metainfo.Segment, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := db.db.QueryContext(ctx, db.db.Rebind(`
+		SELECT segments.id, objects.project_id, segments.segment_index, objects.bucket_name, objects.object_key
+		FROM segments
+			JOIN objects ON objects.id = segments.object_id
+		WHERE segments.id > ?
+		ORDER BY segments.id
+		LIMIT ?`),
+		afterID, limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var list []metainfo.Segment
+	for rows.Next() {
+		var segment metainfo.Segment
+		var projectID []byte
+		err := rows.Scan(&segment.ID, &projectID, &segment.Location.SegmentIndex,
+			&segment.Location.BucketName, &segment.Location.ObjectKey)
+		if err != nil {
+			return nil, err
+		}
+		segment.Location.ProjectID, err = bytesToUUID(projectID)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, segment)
+	}
+	return list, rows.Err()
+}
+
+// ListByNode lists up to limit segments with a piece on the node, which come after the segment with the given id
+func (db *segments) ListByNode(ctx context.Context, nodeID storj.NodeID, afterID int64, limit int) (_ []metainfo.NodeSegment, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := db.db.QueryContext(ctx, db.db.Rebind(`
+		SELECT segments.id, objects.project_id, segments.segment_index, objects.bucket_name, objects.object_key, segment_pieces.piece_num
+		FROM segment_pieces
+			JOIN segments ON segments.id = segment_pieces.segment_id
+			JOIN objects ON objects.id = segments.object_id
+		WHERE segment_pieces.node_id = ? AND segment_pieces.segment_id > ?
+		ORDER BY segment_pieces.segment_id
+		LIMIT ?`),
+		nodeID.Bytes(), afterID, limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var list []metainfo.NodeSegment
+	for rows.Next() {
+		var segment metainfo.NodeSegment
+		var projectID []byte
+		err := rows.Scan(&segment.ID, &projectID, &segment.Location.SegmentIndex,
+			&segment.Location.BucketName, &segment.Location.ObjectKey, &segment.PieceNum)
+		if err != nil {
+			return nil, err
+		}
+		segment.Location.ProjectID, err = bytesToUUID(projectID)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, segment)
+	}
+	return list, rows.Err()
+}
+
+// Expiring returns the number of segments and their total size, which expire in [from, to)
+func (db *segments) Expiring(ctx context.Context, from, to time.Time) (segments int64, size int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = db.db.QueryRowContext(ctx, db.db.Rebind(`
+		SELECT COUNT(*), COALESCE(SUM(segment_size), 0) FROM segments
+		WHERE expires_at >= ? AND expires_at < ?`),
+		from.UTC(), to.UTC(),
+	).Scan(&segments, &size)
+	return segments, size, err
+}
+
+// BucketSize returns the amount of data stored in a bucket
+func (db *segments) BucketSize(ctx context.Context, projectID uuid.UUID, bucketName []byte) (size metainfo.BucketSize, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = db.db.QueryRowContext(ctx, db.db.Rebind(`
+		SELECT
+			COUNT(DISTINCT objects.id),
+			COALESCE(SUM(CASE WHEN segments.remote THEN 0 ELSE 1 END), 0),
+			COALESCE(SUM(CASE WHEN segments.remote THEN 1 ELSE 0 END), 0),
+			COALESCE(SUM(CASE WHEN segments.remote THEN 0 ELSE segments.segment_size END), 0),
+			COALESCE(SUM(CASE WHEN segments.remote THEN segments.segment_size ELSE 0 END), 0)
+		FROM objects
+			JOIN segments ON segments.object_id = objects.id
+		WHERE objects.project_id = ? AND objects.bucket_name = ?`),
+		projectID[:], bucketName,
+	).Scan(&size.Objects, &size.InlineSegments, &size.RemoteSegments, &size.InlineBytes, &size.RemoteBytes)
+	return size, err
+}
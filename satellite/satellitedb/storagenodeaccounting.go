@@ -158,7 +158,52 @@ func (db *StoragenodeAccounting) QueryPaymentInfo(ctx context.Context, start tim
 		) r
 		LEFT JOIN nodes n ON n.id = r.node_id
 	    ORDER BY n.id`
-	rows, err := db.db.DB.QueryContext(ctx, db.db.Rebind(sqlStmt), start.UTC(), end.UTC())
+	return db.queryPaymentInfo(ctx, sqlStmt, start.UTC(), end.UTC())
+}
+
+// QueryPayoutInfo queries Overlay, Accounting Rollup on nodeID, rollups of nodes missing from the overlay are skipped
+func (db *StoragenodeAccounting) QueryPayoutInfo(ctx context.Context, start time.Time, end time.Time) ([]*accounting.CSVRow, error) {
+	var sqlStmt = `SELECT n.id, n.created_at, n.audit_success_ratio, r.at_rest_total, r.get_repair_total,
+	    r.put_repair_total, r.get_audit_total, r.put_total, r.get_total, n.wallet
+	    FROM (
+			SELECT node_id, SUM(at_rest_total) AS at_rest_total, SUM(get_repair_total) AS get_repair_total,
+			SUM(put_repair_total) AS put_repair_total, SUM(get_audit_total) AS get_audit_total,
+			SUM(put_total) AS put_total, SUM(get_total) AS get_total
+			FROM accounting_rollups
+			WHERE start_time >= ? AND start_time < ?
+			GROUP BY node_id
+		) r
+		JOIN nodes n ON n.id = r.node_id
+	    ORDER BY n.id`
+	return db.queryPaymentInfo(ctx, sqlStmt, start.UTC(), end.UTC())
+}
+
+// QueryNodePaymentInfo queries Overlay, Accounting Rollup for a single node, it returns nil when the node has no rollups
+func (db *StoragenodeAccounting) QueryNodePaymentInfo(ctx context.Context, nodeID storj.NodeID, start time.Time, end time.Time) (*accounting.CSVRow, error) {
+	var sqlStmt = `SELECT n.id, n.created_at, n.audit_success_ratio, r.at_rest_total, r.get_repair_total,
+	    r.put_repair_total, r.get_audit_total, r.put_total, r.get_total, n.wallet
+	    FROM (
+			SELECT node_id, SUM(at_rest_total) AS at_rest_total, SUM(get_repair_total) AS get_repair_total,
+			SUM(put_repair_total) AS put_repair_total, SUM(get_audit_total) AS get_audit_total,
+			SUM(put_total) AS put_total, SUM(get_total) AS get_total
+			FROM accounting_rollups
+			WHERE node_id = ? AND start_time >= ? AND start_time < ?
+			GROUP BY node_id
+		) r
+		JOIN nodes n ON n.id = r.node_id`
+	rows, err := db.queryPaymentInfo(ctx, sqlStmt, nodeID.Bytes(), start.UTC(), end.UTC())
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	return rows[0], nil
+}
+
+// queryPaymentInfo runs a payment info query and scans the resulting rows
+func (db *StoragenodeAccounting) queryPaymentInfo(ctx context.Context, sqlStmt string, args ...interface{}) (_ []*accounting.CSVRow, err error) {
+	rows, err := db.db.DB.QueryContext(ctx, db.db.Rebind(sqlStmt), args...)
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
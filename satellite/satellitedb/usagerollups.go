@@ -5,6 +5,7 @@ package satellitedb
 
 import (
 	"context"
+	"database/sql"
 	"time"
 
 	"github.com/skyrings/skyring-common/tools/uuid"
@@ -25,8 +26,6 @@ type usagerollups struct {
 func (db *usagerollups) GetProjectTotal(ctx context.Context, projectID uuid.UUID, since, before time.Time) (usage *console.ProjectUsage, err error) {
 	since = timeTruncateDown(since)
 
-	storageQuery := db.db.All_BucketStorageTally_By_ProjectId_And_BucketName_And_IntervalStart_GreaterOrEqual_And_IntervalStart_LessOrEqual_OrderBy_Desc_IntervalStart
-
 	roullupsQuery := db.db.Rebind(`SELECT SUM(settled), SUM(inline), action
 			FROM bucket_bandwidth_rollups 
 			WHERE project_id = ? AND interval_start >= ? AND interval_start <= ?
@@ -54,45 +53,160 @@ func (db *usagerollups) GetProjectTotal(ctx context.Context, projectID uuid.UUID
 		}
 	}
 
-	buckets, err := db.getBuckets(ctx, projectID, since, before)
+	usage = new(console.ProjectUsage)
+	usage.Egress = memory.Size(totalEgress).GB()
+
+	err = db.sumStorage(ctx, projectID, since, before, usage)
 	if err != nil {
 		return nil, err
 	}
 
-	bucketsTallies := make(map[string]*[]*dbx.BucketStorageTally)
+	usage.Since = since
+	usage.Before = before
+	return usage, nil
+}
+
+// GetProjectBillableUsage retrieves the usage of a project that is charged for during [since, before),
+// egress only includes the data downloaded by customers, not the audit and repair traffic
+func (db *usagerollups) GetProjectBillableUsage(ctx context.Context, projectID uuid.UUID, since, before time.Time) (usage *console.ProjectUsage, err error) {
+	since = timeTruncateDown(since)
+
+	var settled, inline sql.NullInt64
+	err = db.db.QueryRowContext(ctx, db.db.Rebind(`SELECT SUM(settled), SUM(inline)
+			FROM bucket_bandwidth_rollups
+			WHERE project_id = ? AND action = ? AND interval_start >= ? AND interval_start < ?`),
+		[]byte(projectID.String()), pb.PieceAction_GET, since, before).Scan(&settled, &inline)
+	if err != nil {
+		return nil, err
+	}
+
+	usage = new(console.ProjectUsage)
+	usage.Egress = memory.Size(settled.Int64 + inline.Int64).GB()
+
+	err = db.sumBillableStorage(ctx, projectID, since, before, usage)
+	if err != nil {
+		return nil, err
+	}
+
+	usage.Since = since
+	usage.Before = before
+	return usage, nil
+}
+
+// sumStorage adds the storage, objects and segments stored by the project between the tallies in [since, before] to usage
+func (db *usagerollups) sumStorage(ctx context.Context, projectID uuid.UUID, since, before time.Time, usage *console.ProjectUsage) error {
+	storageQuery := db.db.All_BucketStorageTally_By_ProjectId_And_BucketName_And_IntervalStart_GreaterOrEqual_And_IntervalStart_LessOrEqual_OrderBy_Desc_IntervalStart
+
+	buckets, err := db.getBuckets(ctx, projectID, since, before)
+	if err != nil {
+		return err
+	}
+
 	for _, bucket := range buckets {
-		storageTallies, err := storageQuery(ctx,
+		tallies, err := storageQuery(ctx,
 			dbx.BucketStorageTally_ProjectId([]byte(projectID.String())),
 			dbx.BucketStorageTally_BucketName([]byte(bucket)),
 			dbx.BucketStorageTally_IntervalStart(since),
 			dbx.BucketStorageTally_IntervalStart(before))
 
 		if err != nil {
-			return nil, err
+			return err
 		}
 
-		bucketsTallies[bucket] = &storageTallies
+		for i := len(tallies) - 1; i > 0; i-- {
+			current := tallies[i]
+
+			hours := tallies[i-1].IntervalStart.Sub(current.IntervalStart).Hours()
+			addStorage(usage, current, hours)
+		}
 	}
 
-	usage = new(console.ProjectUsage)
-	usage.Egress = memory.Size(totalEgress).GB()
+	return nil
+}
 
-	// sum up storage and objects
-	for _, tallies := range bucketsTallies {
-		for i := len(*tallies) - 1; i > 0; i-- {
-			current := (*tallies)[i]
+// sumBillableStorage adds the storage, objects and segments stored by the project during [since, before) to usage,
+// every tally is billed until the next one, so the last tally before since is carried forward to the first tally
+// of the period and the newest tally of the period is extended to before
+func (db *usagerollups) sumBillableStorage(ctx context.Context, projectID uuid.UUID, since, before time.Time, usage *console.ProjectUsage) (err error) {
+	storageQuery := db.db.All_BucketStorageTally_By_ProjectId_And_BucketName_And_IntervalStart_GreaterOrEqual_And_IntervalStart_LessOrEqual_OrderBy_Desc_IntervalStart
 
-			hours := (*tallies)[i-1].IntervalStart.Sub(current.IntervalStart).Hours()
+	bucketRows, err := db.db.QueryContext(ctx, db.db.Rebind(`SELECT DISTINCT bucket_name
+			FROM bucket_storage_tallies
+			WHERE project_id = ? AND interval_start >= ? AND interval_start < ?`),
+		[]byte(projectID.String()), since, before)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errs.Combine(err, bucketRows.Close()) }()
 
-			usage.Storage += memory.Size(current.Inline).GB() * hours
-			usage.Storage += memory.Size(current.Remote).GB() * hours
-			usage.ObjectCount += float64(current.ObjectCount) * hours
+	var buckets [][]byte
+	for bucketRows.Next() {
+		var bucket []byte
+		if err := bucketRows.Scan(&bucket); err != nil {
+			return err
 		}
+		buckets = append(buckets, bucket)
 	}
 
-	usage.Since = since
-	usage.Before = before
-	return usage, nil
+	for _, bucket := range buckets {
+		tallies, err := storageQuery(ctx,
+			dbx.BucketStorageTally_ProjectId([]byte(projectID.String())),
+			dbx.BucketStorageTally_BucketName(bucket),
+			dbx.BucketStorageTally_IntervalStart(since),
+			dbx.BucketStorageTally_IntervalStart(before))
+		if err != nil {
+			return err
+		}
+
+		// tallies are ordered from the newest, the billed interval ends where the next tally starts
+		end := before
+		for _, tally := range tallies {
+			if !tally.IntervalStart.Before(before) {
+				// the tally at before is billed from the next period on
+				continue
+			}
+			addStorage(usage, tally, end.Sub(tally.IntervalStart).Hours())
+			end = tally.IntervalStart
+		}
+
+		previous, err := db.lastTallyBefore(ctx, projectID, bucket, since)
+		if err != nil {
+			return err
+		}
+		if previous != nil {
+			addStorage(usage, previous, end.Sub(since).Hours())
+		}
+	}
+
+	return nil
+}
+
+// lastTallyBefore returns the newest storage tally of the bucket before the given time, nil when there is none
+func (db *usagerollups) lastTallyBefore(ctx context.Context, projectID uuid.UUID, bucket []byte, before time.Time) (*dbx.BucketStorageTally, error) {
+	tally := &dbx.BucketStorageTally{}
+	err := db.db.QueryRowContext(ctx, db.db.Rebind(`SELECT interval_start, inline, remote, remote_segments_count, inline_segments_count, object_count
+			FROM bucket_storage_tallies
+			WHERE project_id = ? AND bucket_name = ? AND interval_start < ?
+			ORDER BY interval_start DESC
+			LIMIT 1`),
+		[]byte(projectID.String()), bucket, before).Scan(
+		&tally.IntervalStart, &tally.Inline, &tally.Remote,
+		&tally.RemoteSegmentsCount, &tally.InlineSegmentsCount, &tally.ObjectCount)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return tally, nil
+}
+
+// addStorage adds the storage, objects and segments of the tally kept for the given hours to usage
+func addStorage(usage *console.ProjectUsage, tally *dbx.BucketStorageTally, hours float64) {
+	usage.Storage += memory.Size(tally.Inline).GB() * hours
+	usage.Storage += memory.Size(tally.Remote).GB() * hours
+	usage.ObjectCount += float64(tally.ObjectCount) * hours
+	usage.SegmentCount += float64(tally.RemoteSegmentsCount+tally.InlineSegmentsCount) * hours
 }
 
 // GetBucketUsageRollups retrieves summed usage rollups for every bucket of particular project for a given period
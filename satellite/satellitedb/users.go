@@ -5,6 +5,7 @@ package satellitedb
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/skyrings/skyring-common/tools/uuid"
 	"github.com/zeebo/errs"
@@ -72,22 +73,42 @@ func (users *users) Delete(ctx context.Context, id uuid.UUID) error {
 
 // Update is a method for updating user entity
 func (users *users) Update(ctx context.Context, user *console.User) error {
-	_, err := users.db.Update_User_By_Id(
+	update, err := toUpdateUser(user)
+	if err != nil {
+		return err
+	}
+
+	_, err = users.db.Update_User_By_Id(
 		ctx,
 		dbx.User_Id(user.ID[:]),
-		toUpdateUser(user),
+		update,
 	)
 
 	return err
 }
 
 // toUpdateUser creates dbx.User_Update_Fields with only non-empty fields as updatable
-func toUpdateUser(user *console.User) dbx.User_Update_Fields {
+func toUpdateUser(user *console.User) (dbx.User_Update_Fields, error) {
 	update := dbx.User_Update_Fields{
-		FullName:  dbx.User_FullName(user.FullName),
-		ShortName: dbx.User_ShortName(user.ShortName),
-		Email:     dbx.User_Email(user.Email),
-		Status:    dbx.User_Status(int(user.Status)),
+		FullName:         dbx.User_FullName(user.FullName),
+		ShortName:        dbx.User_ShortName(user.ShortName),
+		Email:            dbx.User_Email(user.Email),
+		Status:           dbx.User_Status(int(user.Status)),
+		MfaEnabled:       dbx.User_MfaEnabled(user.MFAEnabled),
+		MfaSecretKey:     dbx.User_MfaSecretKey_Null(),
+		MfaRecoveryCodes: dbx.User_MfaRecoveryCodes_Null(),
+	}
+
+	if user.MFASecretKey != "" {
+		update.MfaSecretKey = dbx.User_MfaSecretKey(user.MFASecretKey)
+	}
+
+	if len(user.MFARecoveryCodes) != 0 {
+		recoveryCodes, err := json.Marshal(user.MFARecoveryCodes)
+		if err != nil {
+			return update, err
+		}
+		update.MfaRecoveryCodes = dbx.User_MfaRecoveryCodes(string(recoveryCodes))
 	}
 
 	// extra password check to update only calculated hash from service
@@ -95,7 +116,7 @@ func toUpdateUser(user *console.User) dbx.User_Update_Fields {
 		update.PasswordHash = dbx.User_PasswordHash(user.PasswordHash)
 	}
 
-	return update
+	return update, nil
 }
 
 // userFromDBX is used for creating User entity from autogenerated dbx.User struct
@@ -115,6 +136,7 @@ func userFromDBX(user *dbx.User) (*console.User, error) {
 		Email:        user.Email,
 		PasswordHash: user.PasswordHash,
 		Status:       console.UserStatus(user.Status),
+		MFAEnabled:   user.MfaEnabled,
 		CreatedAt:    user.CreatedAt,
 	}
 
@@ -122,5 +144,16 @@ func userFromDBX(user *dbx.User) (*console.User, error) {
 		result.ShortName = *user.ShortName
 	}
 
+	if user.MfaSecretKey != nil {
+		result.MFASecretKey = *user.MfaSecretKey
+	}
+
+	if user.MfaRecoveryCodes != nil {
+		err = json.Unmarshal([]byte(*user.MfaRecoveryCodes), &result.MFARecoveryCodes)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return &result, nil
 }
@@ -5,6 +5,7 @@ package boltdb
 
 import (
 	"bytes"
+	"context"
 	"sync/atomic"
 	"time"
 
@@ -126,13 +127,14 @@ func (client *Client) view(fn func(*bolt.Bucket) error) error {
 // Ref: https://github.com/boltdb/bolt/blob/master/db.go#L160
 // Note: when using this method, check if it need to be executed asynchronously
 // since it blocks for the duration db.MaxBatchDelay.
-func (client *Client) Put(key storage.Key, value storage.Value) error {
+func (client *Client) Put(ctx context.Context, key storage.Key, value storage.Value) (err error) {
+	defer mon.Task()(&ctx)(&err)
 	start := time.Now()
 	if key.IsZero() {
 		return storage.ErrEmptyKey.New("")
 	}
 
-	err := client.batch(func(bucket *bolt.Bucket) error {
+	err = client.batch(func(bucket *bolt.Bucket) error {
 		return bucket.Put(key, value)
 	})
 	mon.IntVal("boltdb_batch_time_elapsed").Observe(int64(time.Since(start)))
@@ -140,7 +142,8 @@ func (client *Client) Put(key storage.Key, value storage.Value) error {
 }
 
 // PutAndCommit adds a key/value to BoltDB and writes it to disk.
-func (client *Client) PutAndCommit(key storage.Key, value storage.Value) error {
+func (client *Client) PutAndCommit(ctx context.Context, key storage.Key, value storage.Value) (err error) {
+	defer mon.Task()(&ctx)(&err)
 	if key.IsZero() {
 		return storage.ErrEmptyKey.New("")
 	}
@@ -151,13 +154,14 @@ func (client *Client) PutAndCommit(key storage.Key, value storage.Value) error {
 }
 
 // Get looks up the provided key from boltdb returning either an error or the result.
-func (client *Client) Get(key storage.Key) (storage.Value, error) {
+func (client *Client) Get(ctx context.Context, key storage.Key) (_ storage.Value, err error) {
+	defer mon.Task()(&ctx)(&err)
 	if key.IsZero() {
 		return nil, storage.ErrEmptyKey.New("")
 	}
 
 	var value storage.Value
-	err := client.view(func(bucket *bolt.Bucket) error {
+	err = client.view(func(bucket *bolt.Bucket) error {
 		data := bucket.Get([]byte(key))
 		if len(data) == 0 {
 			return storage.ErrKeyNotFound.New(key.String())
@@ -169,7 +173,8 @@ func (client *Client) Get(key storage.Key) (storage.Value, error) {
 }
 
 // Delete deletes a key/value pair from boltdb, for a given the key
-func (client *Client) Delete(key storage.Key) error {
+func (client *Client) Delete(ctx context.Context, key storage.Key) (err error) {
+	defer mon.Task()(&ctx)(&err)
 	if key.IsZero() {
 		return storage.ErrEmptyKey.New("")
 	}
@@ -179,9 +184,74 @@ func (client *Client) Delete(key storage.Key) error {
 	})
 }
 
+// CompareAndSwap replaces the value of key with newValue, when its current value is oldValue.
+// A nil oldValue requires that key doesn't exist, and a nil newValue deletes key.
+func (client *Client) CompareAndSwap(ctx context.Context, key storage.Key, oldValue, newValue storage.Value) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	if key.IsZero() {
+		return storage.ErrEmptyKey.New("")
+	}
+
+	return client.update(func(bucket *bolt.Bucket) error {
+		data := bucket.Get([]byte(key))
+		switch {
+		case data == nil && oldValue != nil:
+			return storage.ErrKeyNotFound.New("%s", key)
+		case data != nil && oldValue == nil:
+			return storage.ErrValueChanged.New("%s", key)
+		case data != nil && !bytes.Equal(data, oldValue):
+			return storage.ErrValueChanged.New("%s", key)
+		}
+
+		if newValue == nil {
+			return bucket.Delete(key)
+		}
+		return bucket.Put(key, newValue)
+	})
+}
+
+// PutBatch adds all the items to boltdb in a single transaction
+func (client *Client) PutBatch(ctx context.Context, items storage.Items) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	for _, item := range items {
+		if item.Key.IsZero() {
+			return storage.ErrEmptyKey.New("")
+		}
+	}
+
+	return client.update(func(bucket *bolt.Bucket) error {
+		for _, item := range items {
+			if err := bucket.Put(item.Key, item.Value); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// DeleteBatch deletes all the keys from boltdb in a single transaction, ignoring keys which don't exist
+func (client *Client) DeleteBatch(ctx context.Context, keys storage.Keys) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	for _, key := range keys {
+		if key.IsZero() {
+			return storage.ErrEmptyKey.New("")
+		}
+	}
+
+	return client.update(func(bucket *bolt.Bucket) error {
+		for _, key := range keys {
+			if err := bucket.Delete(key); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
 // List returns either a list of keys for which boltdb has values or an error.
-func (client *Client) List(first storage.Key, limit int) (storage.Keys, error) {
-	rv, err := storage.ListKeys(client, first, limit)
+func (client *Client) List(ctx context.Context, first storage.Key, limit int) (_ storage.Keys, err error) {
+	defer mon.Task()(&ctx)(&err)
+	rv, err := storage.ListKeys(ctx, client, first, limit)
 	return rv, Error.Wrap(err)
 }
 
@@ -195,13 +265,14 @@ func (client *Client) Close() error {
 
 // GetAll finds all values for the provided keys (up to storage.LookupLimit).
 // If more keys are provided than the maximum, an error will be returned.
-func (client *Client) GetAll(keys storage.Keys) (storage.Values, error) {
+func (client *Client) GetAll(ctx context.Context, keys storage.Keys) (_ storage.Values, err error) {
+	defer mon.Task()(&ctx)(&err)
 	if len(keys) > storage.LookupLimit {
 		return nil, storage.ErrLimitExceeded
 	}
 
 	vals := make(storage.Values, 0, len(keys))
-	err := client.view(func(bucket *bolt.Bucket) error {
+	err = client.view(func(bucket *bolt.Bucket) error {
 		for _, key := range keys {
 			val := bucket.Get([]byte(key))
 			if val == nil {
@@ -216,7 +287,8 @@ func (client *Client) GetAll(keys storage.Keys) (storage.Values, error) {
 }
 
 // Iterate iterates over items based on opts
-func (client *Client) Iterate(opts storage.IterateOptions, fn func(storage.Iterator) error) error {
+func (client *Client) Iterate(ctx context.Context, opts storage.IterateOptions, fn func(storage.Iterator) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
 	return client.view(func(bucket *bolt.Bucket) error {
 		var cursor advancer
 		if !opts.Reverse {
@@ -4,6 +4,7 @@
 package boltdb
 
 import (
+	"context"
 	"fmt"
 	"io/ioutil"
 	"os"
@@ -89,7 +90,7 @@ type boltLongBenchmarkStore struct {
 	dirPath string
 }
 
-func (store *boltLongBenchmarkStore) BulkImport(iter storage.Iterator) (err error) {
+func (store *boltLongBenchmarkStore) BulkImport(ctx context.Context, iter storage.Iterator) (err error) {
 	// turn off syncing during import
 	oldval := store.db.NoSync
 	store.db.NoSync = true
@@ -97,7 +98,7 @@ func (store *boltLongBenchmarkStore) BulkImport(iter storage.Iterator) (err erro
 
 	var item storage.ListItem
 	for iter.Next(&item) {
-		if err := store.Put(item.Key, item.Value); err != nil {
+		if err := store.Put(ctx, item.Key, item.Value); err != nil {
 			return fmt.Errorf("Failed to insert data (%q, %q): %v", item.Key, item.Value, err)
 		}
 	}
@@ -172,7 +173,7 @@ func BenchmarkClientWrite(b *testing.B) {
 			wg.Add(1)
 			go func() {
 				defer wg.Done()
-				err := kdb.PutAndCommit(key, value)
+				err := kdb.PutAndCommit(ctx, key, value)
 				if err != nil {
 					b.Fatal("Put err:", err)
 				}
@@ -214,7 +215,7 @@ func BenchmarkClientNoSyncWrite(b *testing.B) {
 			wg.Add(1)
 			go func() {
 				defer wg.Done()
-				err := kdb.PutAndCommit(key, value)
+				err := kdb.PutAndCommit(ctx, key, value)
 				if err != nil {
 					b.Fatal("PutAndCommit Nosync err:", err)
 				}
@@ -262,7 +263,7 @@ func BenchmarkClientBatchWrite(b *testing.B) {
 			wg.Add(1)
 			go func() {
 				defer wg.Done()
-				err := kdb.Put(key, value)
+				err := kdb.Put(ctx, key, value)
 				if err != nil {
 					b.Fatalf("boltDB put: %v\n", err)
 				}
@@ -310,7 +311,7 @@ func BenchmarkClientBatchNoSyncWrite(b *testing.B) {
 			wg.Add(1)
 			go func() {
 				defer wg.Done()
-				err := kdb.Put(key, value)
+				err := kdb.Put(ctx, key, value)
 				if err != nil {
 					b.Fatalf("boltDB put: %v\n", err)
 				}
@@ -0,0 +1,311 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package cockroachkv
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+
+	"github.com/lib/pq"
+	"github.com/zeebo/errs"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+
+	"storj.io/storj/internal/dbutil"
+	"storj.io/storj/internal/dbutil/pgutil"
+	"storj.io/storj/internal/dbutil/txutil"
+	"storj.io/storj/storage"
+)
+
+var mon = monkit.Package()
+
+// Error is the default cockroachkv errs class
+var Error = errs.Class("cockroachkv error")
+
+const defaultBatchSize = 10000
+
+// Client is the entrypoint into a cockroachkv data store
+type Client struct {
+	URL  string
+	conn *sql.DB
+}
+
+// New instantiates a new cockroachkv client given db URL
+func New(dbURL string) (*Client, error) {
+	conn, err := sql.Open("postgres", dbURL)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	conn.SetMaxIdleConns(dbutil.DefaultMaxIdleConns)
+
+	err = prepareDB(conn, dbURL)
+	if err != nil {
+		return nil, Error.Wrap(errs.Combine(err, conn.Close()))
+	}
+
+	return &Client{
+		URL:  dbURL,
+		conn: conn,
+	}, nil
+}
+
+// prepareDB creates the schema and the pathdata table, when they don't exist.
+func prepareDB(conn *sql.DB, dbURL string) error {
+	schema, err := pgutil.ParseSchemaFromConnstr(dbURL)
+	if err != nil {
+		return errs.New("error parsing schema: %+v", err)
+	}
+	if schema != "" {
+		err := pgutil.CreateSchema(conn, schema)
+		if err != nil {
+			return errs.New("error creating schema: %+v", err)
+		}
+	}
+
+	_, err = conn.Exec(`
+		CREATE TABLE IF NOT EXISTS pathdata (
+			fullpath BYTEA PRIMARY KEY,
+			metadata BYTEA NOT NULL
+		)
+	`)
+	return err
+}
+
+// Close closes the client
+func (client *Client) Close() error {
+	return client.conn.Close()
+}
+
+// Put sets the value for the provided key.
+func (client *Client) Put(ctx context.Context, key storage.Key, value storage.Value) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	if key.IsZero() {
+		return storage.ErrEmptyKey.New("")
+	}
+
+	q := "UPSERT INTO pathdata (fullpath, metadata) VALUES ($1::BYTEA, $2::BYTEA)"
+	_, err = client.conn.ExecContext(ctx, q, []byte(key), []byte(value))
+	return Error.Wrap(err)
+}
+
+// Get looks up the provided key and returns its value (or an error).
+func (client *Client) Get(ctx context.Context, key storage.Key) (_ storage.Value, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if key.IsZero() {
+		return nil, storage.ErrEmptyKey.New("")
+	}
+
+	q := "SELECT metadata FROM pathdata WHERE fullpath = $1::BYTEA"
+	var value []byte
+	err = client.conn.QueryRowContext(ctx, q, []byte(key)).Scan(&value)
+	if err == sql.ErrNoRows {
+		return nil, storage.ErrKeyNotFound.New("%s", key)
+	}
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return storage.Value(value), nil
+}
+
+// GetAll finds all values for the provided keys (up to storage.LookupLimit).
+// If more keys are provided than the maximum, an error will be returned.
+func (client *Client) GetAll(ctx context.Context, keys storage.Keys) (_ storage.Values, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if len(keys) > storage.LookupLimit {
+		return nil, storage.ErrLimitExceeded
+	}
+
+	q := "SELECT fullpath, metadata FROM pathdata WHERE fullpath = ANY($1::BYTEA[])"
+	rows, err := client.conn.QueryContext(ctx, q, pq.ByteaArray(keys.ByteSlices()))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, Error.Wrap(rows.Close())) }()
+
+	found := make(map[string]storage.Value, len(keys))
+	for rows.Next() {
+		var key, value []byte
+		if err := rows.Scan(&key, &value); err != nil {
+			return nil, Error.Wrap(err)
+		}
+		found[string(key)] = storage.Value(value)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	values := make(storage.Values, 0, len(keys))
+	for _, key := range keys {
+		values = append(values, found[string(key)])
+	}
+	return values, nil
+}
+
+// Delete deletes the given key and its associated value.
+func (client *Client) Delete(ctx context.Context, key storage.Key) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	if key.IsZero() {
+		return storage.ErrEmptyKey.New("")
+	}
+
+	q := "DELETE FROM pathdata WHERE fullpath = $1::BYTEA"
+	result, err := client.conn.ExecContext(ctx, q, []byte(key))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	numRows, err := result.RowsAffected()
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if numRows == 0 {
+		return storage.ErrKeyNotFound.New("%s", key)
+	}
+	return nil
+}
+
+// CompareAndSwap replaces the value of key with newValue, when its current value is oldValue.
+// A nil oldValue requires that key doesn't exist, and a nil newValue deletes key.
+func (client *Client) CompareAndSwap(ctx context.Context, key storage.Key, oldValue, newValue storage.Value) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	if key.IsZero() {
+		return storage.ErrEmptyKey.New("")
+	}
+
+	return txutil.WithTx(ctx, client.conn, nil, func(ctx context.Context, tx *sql.Tx) error {
+		q := "SELECT metadata FROM pathdata WHERE fullpath = $1::BYTEA FOR UPDATE"
+		var current []byte
+		err := tx.QueryRowContext(ctx, q, []byte(key)).Scan(&current)
+		switch {
+		case err == sql.ErrNoRows:
+			if oldValue != nil {
+				return storage.ErrKeyNotFound.New("%s", key)
+			}
+			if newValue == nil {
+				return nil
+			}
+			q = "INSERT INTO pathdata (fullpath, metadata) VALUES ($1::BYTEA, $2::BYTEA)"
+			_, err = tx.ExecContext(ctx, q, []byte(key), []byte(newValue))
+			if pgutil.IsConstraintError(err) {
+				// a concurrent transaction created the key
+				return storage.ErrValueChanged.New("%s", key)
+			}
+			return err
+		case err != nil:
+			return err
+		case oldValue == nil || !bytes.Equal(current, oldValue):
+			return storage.ErrValueChanged.New("%s", key)
+		case newValue == nil:
+			q = "DELETE FROM pathdata WHERE fullpath = $1::BYTEA"
+			_, err = tx.ExecContext(ctx, q, []byte(key))
+			return err
+		default:
+			q = "UPDATE pathdata SET metadata = $2::BYTEA WHERE fullpath = $1::BYTEA"
+			_, err = tx.ExecContext(ctx, q, []byte(key), []byte(newValue))
+			return err
+		}
+	})
+}
+
+// PutBatch adds all the items in a single transaction
+func (client *Client) PutBatch(ctx context.Context, items storage.Items) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	for _, item := range items {
+		if item.Key.IsZero() {
+			return storage.ErrEmptyKey.New("")
+		}
+	}
+
+	return Error.Wrap(txutil.WithTx(ctx, client.conn, nil, func(ctx context.Context, tx *sql.Tx) error {
+		q := "UPSERT INTO pathdata (fullpath, metadata) VALUES ($1::BYTEA, $2::BYTEA)"
+		for _, item := range items {
+			_, err := tx.ExecContext(ctx, q, []byte(item.Key), []byte(item.Value))
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
+}
+
+// DeleteBatch deletes all the keys at once, ignoring keys which don't exist
+func (client *Client) DeleteBatch(ctx context.Context, keys storage.Keys) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	for _, key := range keys {
+		if key.IsZero() {
+			return storage.ErrEmptyKey.New("")
+		}
+	}
+
+	q := "DELETE FROM pathdata WHERE fullpath = ANY($1::BYTEA[])"
+	_, err = client.conn.ExecContext(ctx, q, pq.ByteaArray(keys.ByteSlices()))
+	return Error.Wrap(err)
+}
+
+// List returns either a list of known keys, in order, or an error.
+func (client *Client) List(ctx context.Context, first storage.Key, limit int) (_ storage.Keys, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return storage.ListKeys(ctx, client, first, limit)
+}
+
+// Iterate iterates over items based on opts
+func (client *Client) Iterate(ctx context.Context, opts storage.IterateOptions, fn func(storage.Iterator) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	cursor := &cursor{
+		ctx:       ctx,
+		client:    client,
+		reverse:   opts.Reverse,
+		batchSize: defaultBatchSize,
+	}
+
+	start := true
+	lastPrefix := []byte{}
+	wasPrefix := false
+
+	err = fn(storage.IteratorFunc(func(item *storage.ListItem) bool {
+		var key, value []byte
+		if start {
+			key, value = cursor.PositionToFirst(opts.Prefix, opts.First)
+			start = false
+		} else {
+			key, value = cursor.Advance()
+		}
+
+		if !opts.Recurse {
+			// when non-recursive skip all items that have the same prefix
+			if wasPrefix && bytes.HasPrefix(key, lastPrefix) {
+				key, value = cursor.SkipPrefix(lastPrefix)
+				wasPrefix = false
+			}
+		}
+
+		if len(key) == 0 || !bytes.HasPrefix(key, opts.Prefix) {
+			return false
+		}
+
+		if !opts.Recurse {
+			// check whether the entry is a proper prefix
+			if p := bytes.IndexByte(key[len(opts.Prefix):], storage.Delimiter); p >= 0 {
+				key = key[:len(opts.Prefix)+p+1]
+				lastPrefix = append(lastPrefix[:0], key...)
+
+				item.Key = append(item.Key[:0], storage.Key(lastPrefix)...)
+				item.Value = item.Value[:0]
+				item.IsPrefix = true
+
+				wasPrefix = true
+				return true
+			}
+		}
+
+		item.Key = append(item.Key[:0], storage.Key(key)...)
+		item.Value = append(item.Value[:0], storage.Value(value)...)
+		item.IsPrefix = false
+
+		return true
+	}))
+
+	return errs.Combine(err, cursor.err)
+}
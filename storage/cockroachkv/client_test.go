@@ -0,0 +1,53 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package cockroachkv
+
+import (
+	"testing"
+
+	"storj.io/storj/internal/dbutil"
+	"storj.io/storj/internal/dbutil/pgutil"
+	"storj.io/storj/internal/dbutil/pgutil/pgtest"
+	"storj.io/storj/storage/testsuite"
+)
+
+func newTestCockroach(t testing.TB) (store *Client, cleanup func()) {
+	if *pgtest.CrdbConnStr == "" {
+		t.Skipf("cockroach flag missing, example:\n-cockroach-test-db=%s", pgtest.DefaultCrdbConnStr)
+	}
+
+	_, source, err := dbutil.SplitConnstr(*pgtest.CrdbConnStr)
+	if err != nil {
+		t.Fatalf("invalid connstr: %v", err)
+	}
+
+	schema := "cockroachkv-" + pgutil.CreateRandomTestingSchemaName(8)
+	db, err := New(pgutil.ConnstrWithSchema(source, schema))
+	if err != nil {
+		t.Fatalf("init: %v", err)
+	}
+
+	return db, func() {
+		if err := pgutil.DropSchema(db.conn, schema); err != nil {
+			t.Fatalf("failed to drop schema: %v", err)
+		}
+		if err := db.Close(); err != nil {
+			t.Fatalf("failed to close db: %v", err)
+		}
+	}
+}
+
+func TestSuite(t *testing.T) {
+	store, cleanup := newTestCockroach(t)
+	defer cleanup()
+
+	testsuite.RunTests(t, store)
+}
+
+func BenchmarkSuite(b *testing.B) {
+	store, cleanup := newTestCockroach(b)
+	defer cleanup()
+
+	testsuite.RunBenchmarks(b, store)
+}
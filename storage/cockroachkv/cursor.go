@@ -0,0 +1,135 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package cockroachkv
+
+import (
+	"context"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/storage"
+)
+
+// cursor walks over pathdata in either direction, fetching the rows in batches.
+//
+// It mirrors the bolt cursor, such that the iteration logic can be shared.
+type cursor struct {
+	ctx       context.Context
+	client    *Client
+	reverse   bool
+	batchSize int
+
+	items []storage.ListItem
+	index int
+	err   error
+}
+
+// PositionToFirst positions the cursor on the first item to return.
+func (cursor *cursor) PositionToFirst(prefix, first storage.Key) (key, value []byte) {
+	if !cursor.reverse {
+		if first.IsZero() || first.Less(prefix) {
+			return cursor.seek(">=", prefix)
+		}
+		return cursor.seek(">=", first)
+	}
+
+	if prefix.IsZero() {
+		// there's no prefix
+		if first.IsZero() {
+			// and no first item, so start from the end
+			return cursor.seek("", nil)
+		}
+	} else {
+		// there's a prefix
+		if first.IsZero() || storage.AfterPrefix(prefix).Less(first) {
+			// there's no first, or it's after our prefix
+			return cursor.seek("<", storage.AfterPrefix(prefix))
+		}
+	}
+	// otherwise position on first or one before that
+	return cursor.seek("<=", first)
+}
+
+// SkipPrefix positions the cursor on the first item which doesn't have the prefix.
+func (cursor *cursor) SkipPrefix(prefix storage.Key) (key, value []byte) {
+	if !cursor.reverse {
+		return cursor.seek(">=", storage.AfterPrefix(prefix))
+	}
+	return cursor.seek("<", prefix)
+}
+
+// Advance moves the cursor to the next item.
+func (cursor *cursor) Advance() (key, value []byte) {
+	cursor.index++
+	if cursor.index < len(cursor.items) {
+		return cursor.current()
+	}
+	if len(cursor.items) < cursor.batchSize {
+		// the last batch wasn't full, so there are no more items
+		cursor.items = nil
+		return nil, nil
+	}
+
+	last := cursor.items[len(cursor.items)-1].Key
+	if !cursor.reverse {
+		return cursor.seek(">", last)
+	}
+	return cursor.seek("<", last)
+}
+
+// current returns the item the cursor is positioned on.
+func (cursor *cursor) current() (key, value []byte) {
+	if cursor.index >= len(cursor.items) {
+		return nil, nil
+	}
+	item := cursor.items[cursor.index]
+	return item.Key, item.Value
+}
+
+// seek loads the batch of items, which compare to key with cmp, in iteration order.
+// An empty cmp loads the items from the start.
+func (cursor *cursor) seek(cmp string, key storage.Key) (_ []byte, _ []byte) {
+	cursor.items, cursor.index = cursor.items[:0], 0
+	if cursor.err != nil {
+		return nil, nil
+	}
+
+	order := "ASC"
+	if cursor.reverse {
+		order = "DESC"
+	}
+
+	var where string
+	args := []interface{}{cursor.batchSize}
+	if cmp != "" {
+		where = "WHERE fullpath " + cmp + " $2::BYTEA"
+		args = append(args, []byte(key))
+	}
+
+	q := "SELECT fullpath, metadata FROM pathdata " + where + " ORDER BY fullpath " + order + " LIMIT $1"
+	rows, err := cursor.client.conn.QueryContext(cursor.ctx, q, args...)
+	if err != nil {
+		cursor.err = Error.Wrap(err)
+		return nil, nil
+	}
+
+	for rows.Next() {
+		var key, value []byte
+		if err := rows.Scan(&key, &value); err != nil {
+			cursor.err = Error.Wrap(errs.Combine(err, rows.Close()))
+			return nil, nil
+		}
+		cursor.items = append(cursor.items, storage.ListItem{
+			Key:   storage.Key(key),
+			Value: storage.Value(value),
+		})
+	}
+
+	if err := errs.Combine(rows.Err(), rows.Close()); err != nil {
+		cursor.err = Error.Wrap(err)
+		return nil, nil
+	}
+
+	return cursor.current()
+}
@@ -5,6 +5,7 @@ package storage
 
 import (
 	"bytes"
+	"context"
 	"errors"
 
 	"github.com/zeebo/errs"
@@ -13,7 +14,7 @@ import (
 // Delimiter separates nested paths in storage
 const Delimiter = '/'
 
-//ErrKeyNotFound used When something doesn't exist
+// ErrKeyNotFound used When something doesn't exist
 var ErrKeyNotFound = errs.Class("key not found")
 
 // ErrEmptyKey is returned when an empty key is used in Put
@@ -22,6 +23,9 @@ var ErrEmptyKey = errs.Class("empty key")
 // ErrEmptyQueue is returned when attempting to Dequeue from an empty queue
 var ErrEmptyQueue = errs.Class("empty queue")
 
+// ErrValueChanged is returned when the current value of the key doesn't match the old value in CompareAndSwap
+var ErrValueChanged = errs.Class("value changed")
+
 // ErrLimitExceeded is returned when request limit is exceeded
 var ErrLimitExceeded = errors.New("limit exceeded")
 
@@ -53,17 +57,26 @@ type ListItem struct {
 // KeyValueStore describes key/value stores like redis and boltdb
 type KeyValueStore interface {
 	// Put adds a value to store
-	Put(Key, Value) error
+	Put(context.Context, Key, Value) error
 	// Get gets a value to store
-	Get(Key) (Value, error)
+	Get(context.Context, Key) (Value, error)
 	// GetAll gets all values from the store
-	GetAll(Keys) (Values, error)
+	GetAll(context.Context, Keys) (Values, error)
 	// Delete deletes key and the value
-	Delete(Key) error
+	Delete(context.Context, Key) error
 	// List lists all keys starting from start and upto limit items
-	List(start Key, limit int) (Keys, error)
+	List(ctx context.Context, start Key, limit int) (Keys, error)
 	// Iterate iterates over items based on opts
-	Iterate(opts IterateOptions, fn func(Iterator) error) error
+	Iterate(ctx context.Context, opts IterateOptions, fn func(Iterator) error) error
+	// CompareAndSwap replaces the value of key with newValue, when its current value is oldValue.
+	// A nil oldValue requires that key doesn't exist, and a nil newValue deletes key.
+	// It returns ErrValueChanged when the current value is different,
+	// and ErrKeyNotFound when key doesn't exist but oldValue isn't nil.
+	CompareAndSwap(ctx context.Context, key Key, oldValue, newValue Value) error
+	// PutBatch adds all the items to store at once
+	PutBatch(context.Context, Items) error
+	// DeleteBatch deletes all the keys at once, ignoring keys which don't exist
+	DeleteBatch(context.Context, Keys) error
 	// Close closes the store
 	Close() error
 }
@@ -4,6 +4,7 @@
 package storage
 
 import (
+	"context"
 	"errors"
 )
 
@@ -24,7 +25,7 @@ type ListOptions struct {
 // then the result []ListItem includes all requested keys.
 // If true then the caller must call List again to get more
 // results by setting `StartAfter` or `EndBefore` appropriately.
-func ListV2(store KeyValueStore, opts ListOptions) (result Items, more bool, err error) {
+func ListV2(ctx context.Context, store KeyValueStore, opts ListOptions) (result Items, more bool, err error) {
 	if !opts.StartAfter.IsZero() && !opts.EndBefore.IsZero() {
 		return nil, false, errors.New("start-after and end-before cannot be combined")
 	}
@@ -90,7 +91,7 @@ func ListV2(store KeyValueStore, opts ListOptions) (result Items, more bool, err
 	if reverse && !opts.EndBefore.IsZero() {
 		firstFull = joinKey(opts.Prefix, opts.EndBefore)
 	}
-	err = store.Iterate(IterateOptions{
+	err = store.Iterate(ctx, IterateOptions{
 		Prefix:  opts.Prefix,
 		First:   firstFull,
 		Reverse: reverse,
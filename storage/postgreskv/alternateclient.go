@@ -5,6 +5,7 @@
 package postgreskv
 
 import (
+	"context"
 	"database/sql"
 
 	"github.com/zeebo/errs"
@@ -124,10 +125,10 @@ func (opi *alternateOrderedPostgresIterator) doNextQuery() (*sql.Rows, error) {
 	} else {
 		query = alternateForwardQuery
 	}
-	return opi.client.pgConn.Query(query, []byte(opi.bucket), []byte(opi.opts.Prefix), []byte(start), opi.batchSize+1)
+	return opi.client.pgConn.QueryContext(opi.ctx, query, []byte(opi.bucket), []byte(opi.opts.Prefix), []byte(start), opi.batchSize+1)
 }
 
-func newAlternateOrderedPostgresIterator(altClient *AlternateClient, opts storage.IterateOptions, batchSize int) (*alternateOrderedPostgresIterator, error) {
+func newAlternateOrderedPostgresIterator(ctx context.Context, altClient *AlternateClient, opts storage.IterateOptions, batchSize int) (*alternateOrderedPostgresIterator, error) {
 	if opts.Prefix == nil {
 		opts.Prefix = storage.Key("")
 	}
@@ -135,6 +136,7 @@ func newAlternateOrderedPostgresIterator(altClient *AlternateClient, opts storag
 		opts.First = storage.Key("")
 	}
 	opi1 := &orderedPostgresIterator{
+		ctx:       ctx,
 		client:    altClient.Client,
 		opts:      &opts,
 		bucket:    storage.Key(defaultBucket),
@@ -153,8 +155,9 @@ func newAlternateOrderedPostgresIterator(altClient *AlternateClient, opts storag
 }
 
 // Iterate iterates over items based on opts
-func (altClient *AlternateClient) Iterate(opts storage.IterateOptions, fn func(storage.Iterator) error) (err error) {
-	opi, err := newAlternateOrderedPostgresIterator(altClient, opts, defaultBatchSize)
+func (altClient *AlternateClient) Iterate(ctx context.Context, opts storage.IterateOptions, fn func(storage.Iterator) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	opi, err := newAlternateOrderedPostgresIterator(ctx, altClient, opts, defaultBatchSize)
 	if err != nil {
 		return err
 	}
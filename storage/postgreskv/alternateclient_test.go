@@ -4,6 +4,7 @@
 package postgreskv
 
 import (
+	"context"
 	"flag"
 	"testing"
 
@@ -56,7 +57,7 @@ type pgAltLongBenchmarkStore struct {
 	*AlternateClient
 }
 
-func (store *pgAltLongBenchmarkStore) BulkImport(iter storage.Iterator) error {
+func (store *pgAltLongBenchmarkStore) BulkImport(ctx context.Context, iter storage.Iterator) error {
 	return bulkImport(store.pgConn, iter)
 }
 
@@ -4,17 +4,22 @@
 package postgreskv
 
 import (
+	"bytes"
+	"context"
 	"database/sql"
 	"fmt"
 
 	"github.com/lib/pq"
 	"github.com/zeebo/errs"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
 
 	"storj.io/storj/internal/dbutil"
 	"storj.io/storj/storage"
 	"storj.io/storj/storage/postgreskv/schema"
 )
 
+var mon = monkit.Package()
+
 const (
 	defaultBatchSize = 10000
 	defaultBucket    = ""
@@ -46,12 +51,14 @@ func New(dbURL string) (*Client, error) {
 }
 
 // Put sets the value for the provided key.
-func (client *Client) Put(key storage.Key, value storage.Value) error {
-	return client.PutPath(storage.Key(defaultBucket), key, value)
+func (client *Client) Put(ctx context.Context, key storage.Key, value storage.Value) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return client.PutPath(ctx, storage.Key(defaultBucket), key, value)
 }
 
 // PutPath sets the value for the provided key (in the given bucket).
-func (client *Client) PutPath(bucket, key storage.Key, value storage.Value) error {
+func (client *Client) PutPath(ctx context.Context, bucket, key storage.Key, value storage.Value) (err error) {
+	defer mon.Task()(&ctx)(&err)
 	if key.IsZero() {
 		return storage.ErrEmptyKey.New("")
 	}
@@ -60,25 +67,27 @@ func (client *Client) PutPath(bucket, key storage.Key, value storage.Value) erro
 			VALUES ($1::BYTEA, $2::BYTEA, $3::BYTEA)
 			ON CONFLICT (bucket, fullpath) DO UPDATE SET metadata = EXCLUDED.metadata
 	`
-	_, err := client.pgConn.Exec(q, []byte(bucket), []byte(key), []byte(value))
+	_, err = client.pgConn.ExecContext(ctx, q, []byte(bucket), []byte(key), []byte(value))
 	return err
 }
 
 // Get looks up the provided key and returns its value (or an error).
-func (client *Client) Get(key storage.Key) (storage.Value, error) {
-	return client.GetPath(storage.Key(defaultBucket), key)
+func (client *Client) Get(ctx context.Context, key storage.Key) (_ storage.Value, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return client.GetPath(ctx, storage.Key(defaultBucket), key)
 }
 
 // GetPath looks up the provided key (in the given bucket) and returns its value (or an error).
-func (client *Client) GetPath(bucket, key storage.Key) (storage.Value, error) {
+func (client *Client) GetPath(ctx context.Context, bucket, key storage.Key) (_ storage.Value, err error) {
+	defer mon.Task()(&ctx)(&err)
 	if key.IsZero() {
 		return nil, storage.ErrEmptyKey.New("")
 	}
 
 	q := "SELECT metadata FROM pathdata WHERE bucket = $1::BYTEA AND fullpath = $2::BYTEA"
-	row := client.pgConn.QueryRow(q, []byte(bucket), []byte(key))
+	row := client.pgConn.QueryRowContext(ctx, q, []byte(bucket), []byte(key))
 	var val []byte
-	err := row.Scan(&val)
+	err = row.Scan(&val)
 	if err == sql.ErrNoRows {
 		return nil, storage.ErrKeyNotFound.New(key.String())
 	}
@@ -89,18 +98,20 @@ func (client *Client) GetPath(bucket, key storage.Key) (storage.Value, error) {
 }
 
 // Delete deletes the given key and its associated value.
-func (client *Client) Delete(key storage.Key) error {
-	return client.DeletePath(storage.Key(defaultBucket), key)
+func (client *Client) Delete(ctx context.Context, key storage.Key) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return client.DeletePath(ctx, storage.Key(defaultBucket), key)
 }
 
 // DeletePath deletes the given key (in the given bucket) and its associated value.
-func (client *Client) DeletePath(bucket, key storage.Key) error {
+func (client *Client) DeletePath(ctx context.Context, bucket, key storage.Key) (err error) {
+	defer mon.Task()(&ctx)(&err)
 	if key.IsZero() {
 		return storage.ErrEmptyKey.New("")
 	}
 
 	q := "DELETE FROM pathdata WHERE bucket = $1::BYTEA AND fullpath = $2::BYTEA"
-	result, err := client.pgConn.Exec(q, []byte(bucket), []byte(key))
+	result, err := client.pgConn.ExecContext(ctx, q, []byte(bucket), []byte(key))
 	if err != nil {
 		return err
 	}
@@ -114,9 +125,137 @@ func (client *Client) DeletePath(bucket, key storage.Key) error {
 	return nil
 }
 
+// CompareAndSwap replaces the value of key with newValue, when its current value is oldValue.
+// A nil oldValue requires that key doesn't exist, and a nil newValue deletes key.
+func (client *Client) CompareAndSwap(ctx context.Context, key storage.Key, oldValue, newValue storage.Value) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return client.CompareAndSwapPath(ctx, storage.Key(defaultBucket), key, oldValue, newValue)
+}
+
+// CompareAndSwapPath replaces the value of key (in the given bucket) with newValue, when its current value is oldValue.
+func (client *Client) CompareAndSwapPath(ctx context.Context, bucket, key storage.Key, oldValue, newValue storage.Value) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	if key.IsZero() {
+		return storage.ErrEmptyKey.New("")
+	}
+
+	if oldValue == nil {
+		if newValue == nil {
+			// nothing to swap, the key just must not exist
+			_, err := client.GetPath(ctx, bucket, key)
+			if storage.ErrKeyNotFound.Has(err) {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			return storage.ErrValueChanged.New("%s", key)
+		}
+
+		q := `
+			INSERT INTO pathdata (bucket, fullpath, metadata)
+				VALUES ($1::BYTEA, $2::BYTEA, $3::BYTEA)
+				ON CONFLICT (bucket, fullpath) DO NOTHING
+		`
+		result, err := client.pgConn.ExecContext(ctx, q, []byte(bucket), []byte(key), []byte(newValue))
+		if err != nil {
+			return err
+		}
+		numRows, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if numRows == 0 {
+			return storage.ErrValueChanged.New("%s", key)
+		}
+		return nil
+	}
+
+	return client.withTx(ctx, func(tx *sql.Tx) error {
+		q := "SELECT metadata FROM pathdata WHERE bucket = $1::BYTEA AND fullpath = $2::BYTEA FOR UPDATE"
+		var current []byte
+		err := tx.QueryRowContext(ctx, q, []byte(bucket), []byte(key)).Scan(&current)
+		if err == sql.ErrNoRows {
+			return storage.ErrKeyNotFound.New("%s", key)
+		}
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(current, oldValue) {
+			return storage.ErrValueChanged.New("%s", key)
+		}
+
+		if newValue == nil {
+			q = "DELETE FROM pathdata WHERE bucket = $1::BYTEA AND fullpath = $2::BYTEA"
+			_, err = tx.ExecContext(ctx, q, []byte(bucket), []byte(key))
+			return err
+		}
+
+		q = "UPDATE pathdata SET metadata = $3::BYTEA WHERE bucket = $1::BYTEA AND fullpath = $2::BYTEA"
+		_, err = tx.ExecContext(ctx, q, []byte(bucket), []byte(key), []byte(newValue))
+		return err
+	})
+}
+
+// PutBatch adds all the items in a single transaction
+func (client *Client) PutBatch(ctx context.Context, items storage.Items) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	for _, item := range items {
+		if item.Key.IsZero() {
+			return storage.ErrEmptyKey.New("")
+		}
+	}
+
+	return client.withTx(ctx, func(tx *sql.Tx) error {
+		q := `
+			INSERT INTO pathdata (bucket, fullpath, metadata)
+				VALUES ($1::BYTEA, $2::BYTEA, $3::BYTEA)
+				ON CONFLICT (bucket, fullpath) DO UPDATE SET metadata = EXCLUDED.metadata
+		`
+		for _, item := range items {
+			_, err := tx.ExecContext(ctx, q, []byte(defaultBucket), []byte(item.Key), []byte(item.Value))
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// DeleteBatch deletes all the keys at once, ignoring keys which don't exist
+func (client *Client) DeleteBatch(ctx context.Context, keys storage.Keys) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	for _, key := range keys {
+		if key.IsZero() {
+			return storage.ErrEmptyKey.New("")
+		}
+	}
+
+	q := "DELETE FROM pathdata WHERE bucket = $1::BYTEA AND fullpath = ANY($2::BYTEA[])"
+	_, err = client.pgConn.ExecContext(ctx, q, []byte(defaultBucket), pq.ByteaArray(keys.ByteSlices()))
+	return err
+}
+
+// withTx runs fn in a transaction, which is committed when fn succeeds
+func (client *Client) withTx(ctx context.Context, fn func(*sql.Tx) error) (err error) {
+	tx, err := client.pgConn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+		} else {
+			err = errs.Combine(err, tx.Rollback())
+		}
+	}()
+	return fn(tx)
+}
+
 // List returns either a list of known keys, in order, or an error.
-func (client *Client) List(first storage.Key, limit int) (storage.Keys, error) {
-	return storage.ListKeys(client, first, limit)
+func (client *Client) List(ctx context.Context, first storage.Key, limit int) (_ storage.Keys, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return storage.ListKeys(ctx, client, first, limit)
 }
 
 // Close closes the client
@@ -126,14 +265,16 @@ func (client *Client) Close() error {
 
 // GetAll finds all values for the provided keys (up to storage.LookupLimit).
 // If more keys are provided than the maximum, an error will be returned.
-func (client *Client) GetAll(keys storage.Keys) (storage.Values, error) {
-	return client.GetAllPath(storage.Key(defaultBucket), keys)
+func (client *Client) GetAll(ctx context.Context, keys storage.Keys) (_ storage.Values, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return client.GetAllPath(ctx, storage.Key(defaultBucket), keys)
 }
 
 // GetAllPath finds all values for the provided keys (up to storage.LookupLimit)
 // in the given bucket. if more keys are provided than the maximum, an error
 // will be returned.
-func (client *Client) GetAllPath(bucket storage.Key, keys storage.Keys) (storage.Values, error) {
+func (client *Client) GetAllPath(ctx context.Context, bucket storage.Key, keys storage.Keys) (_ storage.Values, err error) {
+	defer mon.Task()(&ctx)(&err)
 	if len(keys) > storage.LookupLimit {
 		return nil, storage.ErrLimitExceeded
 	}
@@ -146,7 +287,7 @@ func (client *Client) GetAllPath(bucket storage.Key, keys storage.Keys) (storage
 			ON (pd.fullpath = pk.request AND pd.bucket = $1::BYTEA)
 		ORDER BY pk.ord
 	`
-	rows, err := client.pgConn.Query(q, []byte(bucket), pq.ByteaArray(keys.ByteSlices()))
+	rows, err := client.pgConn.QueryContext(ctx, q, []byte(bucket), pq.ByteaArray(keys.ByteSlices()))
 	if err != nil {
 		return nil, errs.Wrap(err)
 	}
@@ -162,6 +303,7 @@ func (client *Client) GetAllPath(bucket storage.Key, keys storage.Keys) (storage
 }
 
 type orderedPostgresIterator struct {
+	ctx            context.Context
 	client         *Client
 	opts           *storage.IterateOptions
 	bucket         storage.Key
@@ -255,14 +397,14 @@ func (opi *orderedPostgresIterator) doNextQuery() (*sql.Rows, error) {
 			 LIMIT $4
 		`, startCmp, orderDir)
 	}
-	return opi.client.pgConn.Query(query, []byte(opi.bucket), []byte(opi.opts.Prefix), []byte(start), opi.batchSize+1)
+	return opi.client.pgConn.QueryContext(opi.ctx, query, []byte(opi.bucket), []byte(opi.opts.Prefix), []byte(start), opi.batchSize+1)
 }
 
 func (opi *orderedPostgresIterator) Close() error {
 	return errs.Combine(opi.errEncountered, opi.curRows.Close())
 }
 
-func newOrderedPostgresIterator(pgClient *Client, opts storage.IterateOptions, batchSize int) (*orderedPostgresIterator, error) {
+func newOrderedPostgresIterator(ctx context.Context, pgClient *Client, opts storage.IterateOptions, batchSize int) (*orderedPostgresIterator, error) {
 	if opts.Prefix == nil {
 		opts.Prefix = storage.Key("")
 	}
@@ -270,6 +412,7 @@ func newOrderedPostgresIterator(pgClient *Client, opts storage.IterateOptions, b
 		opts.First = storage.Key("")
 	}
 	opi := &orderedPostgresIterator{
+		ctx:       ctx,
 		client:    pgClient,
 		opts:      &opts,
 		bucket:    storage.Key(defaultBucket),
@@ -287,8 +430,9 @@ func newOrderedPostgresIterator(pgClient *Client, opts storage.IterateOptions, b
 }
 
 // Iterate iterates over items based on opts
-func (client *Client) Iterate(opts storage.IterateOptions, fn func(storage.Iterator) error) (err error) {
-	opi, err := newOrderedPostgresIterator(client, opts, defaultBatchSize)
+func (client *Client) Iterate(ctx context.Context, opts storage.IterateOptions, fn func(storage.Iterator) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	opi, err := newOrderedPostgresIterator(ctx, client, opts, defaultBatchSize)
 	if err != nil {
 		return err
 	}
@@ -4,6 +4,7 @@
 package postgreskv
 
 import (
+	"context"
 	"database/sql"
 	"testing"
 
@@ -96,7 +97,7 @@ type pgLongBenchmarkStore struct {
 	*Client
 }
 
-func (store *pgLongBenchmarkStore) BulkImport(iter storage.Iterator) error {
+func (store *pgLongBenchmarkStore) BulkImport(ctx context.Context, iter storage.Iterator) error {
 	return bulkImport(store.pgConn, iter)
 }
 
@@ -4,6 +4,7 @@
 package redis
 
 import (
+	"context"
 	"net/url"
 	"sort"
 	"strconv"
@@ -11,11 +12,14 @@ import (
 
 	"github.com/go-redis/redis"
 	"github.com/zeebo/errs"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
 
 	"storj.io/storj/storage"
 )
 
 var (
+	mon = monkit.Package()
+
 	// Error is a redis error
 	Error = errs.Class("redis error")
 )
@@ -71,12 +75,13 @@ func NewClientFrom(address string) (*Client, error) {
 }
 
 // Get looks up the provided key from redis returning either an error or the result.
-func (client *Client) Get(key storage.Key) (storage.Value, error) {
+func (client *Client) Get(ctx context.Context, key storage.Key) (_ storage.Value, err error) {
+	defer mon.Task()(&ctx)(&err)
 	if key.IsZero() {
 		return nil, storage.ErrEmptyKey.New("")
 	}
 
-	value, err := client.db.Get(string(key)).Bytes()
+	value, err := client.db.WithContext(ctx).Get(string(key)).Bytes()
 	if err == redis.Nil {
 		return nil, storage.ErrKeyNotFound.New(key.String())
 	}
@@ -87,12 +92,13 @@ func (client *Client) Get(key storage.Key) (storage.Value, error) {
 }
 
 // Put adds a value to the provided key in redis, returning an error on failure.
-func (client *Client) Put(key storage.Key, value storage.Value) error {
+func (client *Client) Put(ctx context.Context, key storage.Key, value storage.Value) (err error) {
+	defer mon.Task()(&ctx)(&err)
 	if key.IsZero() {
 		return storage.ErrEmptyKey.New("")
 	}
 
-	err := client.db.Set(key.String(), []byte(value), client.TTL).Err()
+	err = client.db.WithContext(ctx).Set(key.String(), []byte(value), client.TTL).Err()
 	if err != nil {
 		return Error.New("put error: %v", err)
 	}
@@ -100,23 +106,132 @@ func (client *Client) Put(key storage.Key, value storage.Value) error {
 }
 
 // List returns either a list of keys for which boltdb has values or an error.
-func (client *Client) List(first storage.Key, limit int) (storage.Keys, error) {
-	return storage.ListKeys(client, first, limit)
+func (client *Client) List(ctx context.Context, first storage.Key, limit int) (_ storage.Keys, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return storage.ListKeys(ctx, client, first, limit)
 }
 
 // Delete deletes a key/value pair from redis, for a given the key
-func (client *Client) Delete(key storage.Key) error {
+func (client *Client) Delete(ctx context.Context, key storage.Key) (err error) {
+	defer mon.Task()(&ctx)(&err)
 	if key.IsZero() {
 		return storage.ErrEmptyKey.New("")
 	}
 
-	err := client.db.Del(key.String()).Err()
+	err = client.db.WithContext(ctx).Del(key.String()).Err()
 	if err != nil {
 		return Error.New("delete error: %v", err)
 	}
 	return nil
 }
 
+// compareAndSwapScript atomically checks the current value of KEYS[1] before replacing it.
+//
+// ARGV[1] and ARGV[3] are "1" when the old and new values are present,
+// ARGV[2] and ARGV[4] are the old and new values and ARGV[5] is the TTL in milliseconds.
+var compareAndSwapScript = redis.NewScript(`
+local current = redis.call("GET", KEYS[1])
+if ARGV[1] == "1" then
+	if not current then
+		return "missing"
+	end
+	if current ~= ARGV[2] then
+		return "changed"
+	end
+elseif current then
+	return "changed"
+end
+
+if ARGV[3] ~= "1" then
+	redis.call("DEL", KEYS[1])
+elseif tonumber(ARGV[5]) > 0 then
+	redis.call("SET", KEYS[1], ARGV[4], "PX", ARGV[5])
+else
+	redis.call("SET", KEYS[1], ARGV[4])
+end
+return "ok"
+`)
+
+// CompareAndSwap replaces the value of key with newValue, when its current value is oldValue.
+// A nil oldValue requires that key doesn't exist, and a nil newValue deletes key.
+func (client *Client) CompareAndSwap(ctx context.Context, key storage.Key, oldValue, newValue storage.Value) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	if key.IsZero() {
+		return storage.ErrEmptyKey.New("")
+	}
+
+	result, err := compareAndSwapScript.Run(client.db.WithContext(ctx), []string{key.String()},
+		present(oldValue), []byte(oldValue),
+		present(newValue), []byte(newValue),
+		int64(client.TTL/time.Millisecond),
+	).Result()
+	if err != nil {
+		return Error.New("compare and swap error: %v", err)
+	}
+
+	switch result {
+	case "ok":
+		return nil
+	case "missing":
+		return storage.ErrKeyNotFound.New("%s", key)
+	case "changed":
+		return storage.ErrValueChanged.New("%s", key)
+	default:
+		return Error.New("compare and swap error: unexpected result %v", result)
+	}
+}
+
+// present returns the script flag for whether value is non-nil
+func present(value storage.Value) string {
+	if value == nil {
+		return "0"
+	}
+	return "1"
+}
+
+// PutBatch adds all the items to redis in a single transaction
+func (client *Client) PutBatch(ctx context.Context, items storage.Items) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	for _, item := range items {
+		if item.Key.IsZero() {
+			return storage.ErrEmptyKey.New("")
+		}
+	}
+
+	_, err = client.db.WithContext(ctx).TxPipelined(func(pipe redis.Pipeliner) error {
+		for _, item := range items {
+			pipe.Set(item.Key.String(), []byte(item.Value), client.TTL)
+		}
+		return nil
+	})
+	if err != nil {
+		return Error.New("put batch error: %v", err)
+	}
+	return nil
+}
+
+// DeleteBatch deletes all the keys from redis at once, ignoring keys which don't exist
+func (client *Client) DeleteBatch(ctx context.Context, keys storage.Keys) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	if len(keys) == 0 {
+		return nil
+	}
+
+	keyStrings := make([]string, len(keys))
+	for i, key := range keys {
+		if key.IsZero() {
+			return storage.ErrEmptyKey.New("")
+		}
+		keyStrings[i] = key.String()
+	}
+
+	err = client.db.WithContext(ctx).Del(keyStrings...).Err()
+	if err != nil {
+		return Error.New("delete batch error: %v", err)
+	}
+	return nil
+}
+
 // Close closes a redis client
 func (client *Client) Close() error {
 	return client.db.Close()
@@ -125,7 +240,8 @@ func (client *Client) Close() error {
 // GetAll is the bulk method for gets from the redis data store.
 // The maximum keys returned will be storage.LookupLimit. If more than that
 // is requested, an error will be returned
-func (client *Client) GetAll(keys storage.Keys) (storage.Values, error) {
+func (client *Client) GetAll(ctx context.Context, keys storage.Keys) (_ storage.Values, err error) {
+	defer mon.Task()(&ctx)(&err)
 	if len(keys) > storage.LookupLimit {
 		return nil, storage.ErrLimitExceeded
 	}
@@ -135,7 +251,7 @@ func (client *Client) GetAll(keys storage.Keys) (storage.Values, error) {
 		keyStrings[i] = v.String()
 	}
 
-	results, err := client.db.MGet(keyStrings...).Result()
+	results, err := client.db.WithContext(ctx).MGet(keyStrings...).Result()
 	if err != nil {
 		return nil, err
 	}
@@ -156,13 +272,13 @@ func (client *Client) GetAll(keys storage.Keys) (storage.Values, error) {
 }
 
 // Iterate iterates over items based on opts
-func (client *Client) Iterate(opts storage.IterateOptions, fn func(it storage.Iterator) error) error {
+func (client *Client) Iterate(ctx context.Context, opts storage.IterateOptions, fn func(it storage.Iterator) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
 	var all storage.Items
-	var err error
 	if !opts.Reverse {
-		all, err = client.allPrefixedItems(opts.Prefix, opts.First, nil)
+		all, err = client.allPrefixedItems(ctx, opts.Prefix, opts.First, nil)
 	} else {
-		all, err = client.allPrefixedItems(opts.Prefix, nil, opts.First)
+		all, err = client.allPrefixedItems(ctx, opts.Prefix, nil, opts.First)
 	}
 	if err != nil {
 		return err
@@ -184,12 +300,13 @@ func (client *Client) FlushDB() error {
 	return err
 }
 
-func (client *Client) allPrefixedItems(prefix, first, last storage.Key) (storage.Items, error) {
+func (client *Client) allPrefixedItems(ctx context.Context, prefix, first, last storage.Key) (storage.Items, error) {
+	db := client.db.WithContext(ctx)
 	var all storage.Items
 	seen := map[string]struct{}{}
 
 	match := string(escapeMatch([]byte(prefix))) + "*"
-	it := client.db.Scan(0, match, 0).Iterator()
+	it := db.Scan(0, match, 0).Iterator()
 	for it.Next() {
 		key := it.Val()
 		if !first.IsZero() && storage.Key(key).Less(first) {
@@ -204,7 +321,7 @@ func (client *Client) allPrefixedItems(prefix, first, last storage.Key) (storage
 		}
 		seen[key] = struct{}{}
 
-		value, err := client.db.Get(key).Bytes()
+		value, err := db.Get(key).Bytes()
 		if err != nil {
 			return nil, err
 		}
@@ -17,7 +17,7 @@ func TestSuite(t *testing.T) {
 	}
 	defer cleanup()
 
-	client, err := NewClient(addr, "", 1)
+	client, err := NewClient(addr, "", 0)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -39,7 +39,7 @@ func BenchmarkSuite(b *testing.B) {
 	}
 	defer cleanup()
 
-	client, err := NewClient(addr, "", 1)
+	client, err := NewClient(addr, "", 0)
 	if err != nil {
 		b.Fatal(err)
 	}
@@ -146,6 +146,9 @@ func pingServer(addr string) error {
 }
 
 // Mini starts miniredis server
+//
+// miniredis runs scripts in database 0 regardless of the database selected by
+// the client, so tests that use scripts should use database 0.
 func Mini() (addr string, cleanup func(), err error) {
 	server, err := miniredis.Run()
 	if err != nil {
@@ -0,0 +1,135 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package shardkv
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/internal/errs2"
+	"storj.io/storj/storage"
+)
+
+// Iterate iterates over items based on opts.
+//
+// When the prefix is within a single project, only the shard of that project is iterated,
+// otherwise the items of all shards are merged in order.
+func (store *Store) Iterate(ctx context.Context, opts storage.IterateOptions, fn func(storage.Iterator) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if bytes.IndexByte(opts.Prefix, storage.Delimiter) >= 0 {
+		return store.lookup(projectOf(opts.Prefix)).Iterate(ctx, opts, fn)
+	}
+
+	store.mu.RLock()
+	shardMap := store.shardMap.Clone()
+	shards := store.shards
+	store.mu.RUnlock()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var group errs2.Group
+	merged := &mergedIterator{reverse: opts.Reverse}
+	for _, name := range shardMap.Names() {
+		name := name
+		it := &shardIterator{items: make(chan storage.ListItem)}
+		merged.iterators = append(merged.iterators, it)
+
+		group.Go(func() error {
+			defer close(it.items)
+			// only items of the projects on this shard are returned, other shards
+			// may have leftovers of projects, which were moved away from them
+			return it.run(ctx, shards[name], opts, func(key storage.Key) bool {
+				return shardMap.Lookup(projectOf(key)) == name
+			})
+		})
+	}
+
+	err = fn(merged)
+
+	cancel()
+	for _, it := range merged.iterators {
+		for range it.items {
+		}
+	}
+	return errs.Combine(err, errs.Combine(group.Wait()...))
+}
+
+// shardIterator sends the items of a single shard to the merged iterator.
+type shardIterator struct {
+	items chan storage.ListItem
+
+	head   storage.ListItem
+	loaded bool
+	done   bool
+}
+
+// run iterates over shard and sends the items for which owns returns true.
+func (it *shardIterator) run(ctx context.Context, shard storage.KeyValueStore, opts storage.IterateOptions, owns func(storage.Key) bool) error {
+	err := shard.Iterate(ctx, opts, func(iterator storage.Iterator) error {
+		var item storage.ListItem
+		for iterator.Next(&item) {
+			if !owns(item.Key) {
+				continue
+			}
+			select {
+			case it.items <- storage.CloneItem(item):
+			case <-ctx.Done():
+				return nil
+			}
+		}
+		return nil
+	})
+	if ctx.Err() != nil {
+		// the iteration was stopped, so the error is caused by the cancellation
+		return nil
+	}
+	return err
+}
+
+// peek loads the next item of the shard, when it hasn't been loaded yet.
+func (it *shardIterator) peek() bool {
+	if !it.loaded && !it.done {
+		it.head, it.loaded = <-it.items
+		it.done = !it.loaded
+	}
+	return it.loaded
+}
+
+// mergedIterator returns the items of several shards in order.
+type mergedIterator struct {
+	reverse   bool
+	iterators []*shardIterator
+}
+
+// Next returns the next item from the shard, which has the smallest key (largest when reversed).
+func (merged *mergedIterator) Next(item *storage.ListItem) bool {
+	var next *shardIterator
+	for _, it := range merged.iterators {
+		if !it.peek() {
+			continue
+		}
+		if next == nil || merged.before(it.head.Key, next.head.Key) {
+			next = it
+		}
+	}
+	if next == nil {
+		return false
+	}
+
+	*item = next.head
+	next.loaded = false
+	return true
+}
+
+// before returns whether a should be returned before b.
+func (merged *mergedIterator) before(a, b storage.Key) bool {
+	if merged.reverse {
+		return b.Less(a)
+	}
+	return a.Less(b)
+}
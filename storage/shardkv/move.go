@@ -0,0 +1,202 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package shardkv
+
+import (
+	"context"
+	"sync"
+
+	"storj.io/storj/storage"
+)
+
+// moveBatchSize is how many keys are copied at once, while other writes of the project wait
+const moveBatchSize = storage.LookupLimit
+
+// move tracks a project, which is being copied to another shard.
+type move struct {
+	target storage.KeyValueStore
+
+	mu        sync.Mutex
+	mirroring bool
+	err       error
+}
+
+// mirror applies a write to the target shard. When it fails the move cannot be completed.
+// The caller must hold move.mu.
+func (move *move) mirror(fn func(target storage.KeyValueStore) error) {
+	if !move.mirroring || move.err != nil {
+		return
+	}
+	move.err = fn(move.target)
+}
+
+// MoveProject moves all keys of project to the target shard, while the store remains in use.
+//
+// The writes of the project are mirrored to the target shard while its keys are copied in batches.
+// After everything has been copied, the project is switched to the target shard in the shard map
+// and its keys are deleted from the source shard.
+func (store *Store) MoveProject(ctx context.Context, project, target string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	if project == "" {
+		return Error.New("project is missing")
+	}
+
+	store.mu.Lock()
+	sourceName := store.shardMap.Lookup(project)
+	source := store.shards[sourceName]
+	targetShard, ok := store.shards[target]
+	_, moving := store.moves[project]
+	switch {
+	case !ok:
+		store.mu.Unlock()
+		return Error.New("shard %q does not exist", target)
+	case moving:
+		store.mu.Unlock()
+		return Error.New("project %q is already being moved", project)
+	case sourceName == target:
+		store.mu.Unlock()
+		return nil
+	}
+	move := &move{target: targetShard}
+	store.moves[project] = move
+	store.mu.Unlock()
+
+	defer func() {
+		if err != nil {
+			store.mu.Lock()
+			delete(store.moves, project)
+			store.mu.Unlock()
+		}
+	}()
+
+	// an earlier move to the target may have left some keys behind, they must be
+	// removed before the writes are mirrored, otherwise they could be resurrected
+	if err := deleteProject(ctx, targetShard, project); err != nil {
+		return Error.New("cleaning up target shard failed: %v", err)
+	}
+
+	move.mu.Lock()
+	move.mirroring = true
+	move.mu.Unlock()
+
+	if err := copyProject(ctx, source, move, project); err != nil {
+		return Error.New("copying project failed: %v", err)
+	}
+
+	err = store.switchProject(project, target, move)
+	if err != nil {
+		return err
+	}
+
+	if err := deleteProject(ctx, source, project); err != nil {
+		// the leftovers are ignored by iteration and removed when the project is moved back
+		return Error.New("project was moved, but cleaning up shard %q failed: %v", sourceName, err)
+	}
+	return nil
+}
+
+// switchProject routes project to the target shard, after all the keys have been copied.
+func (store *Store) switchProject(project, target string, move *move) error {
+	// taking the lock waits for all in-flight writes to finish
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	if move.err != nil {
+		return Error.New("mirroring writes failed: %v", move.err)
+	}
+
+	shardMap := store.shardMap.Clone()
+	if target == shardMap.Default {
+		delete(shardMap.Projects, project)
+	} else {
+		shardMap.Projects[project] = target
+	}
+
+	if store.path != "" {
+		if err := shardMap.Save(store.path); err != nil {
+			return Error.New("saving shard map failed: %v", err)
+		}
+	}
+
+	store.shardMap = shardMap
+	delete(store.moves, project)
+	return nil
+}
+
+// copyProject copies all keys of project from source to the target of move.
+func copyProject(ctx context.Context, source storage.KeyValueStore, move *move, project string) error {
+	var after storage.Key
+	for {
+		keys, err := listProject(ctx, source, project, after, moveBatchSize)
+		if err != nil || len(keys) == 0 {
+			return err
+		}
+		after = keys[len(keys)-1]
+
+		// writes of the project are blocked, so the values can't change while they are copied
+		move.mu.Lock()
+		err = copyKeys(ctx, source, move.target, keys)
+		move.mu.Unlock()
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// copyKeys copies the current values of keys from source to target.
+func copyKeys(ctx context.Context, source, target storage.KeyValueStore, keys storage.Keys) error {
+	values, err := source.GetAll(ctx, keys)
+	if err != nil {
+		return err
+	}
+
+	items := make(storage.Items, 0, len(keys))
+	for i, value := range values {
+		// keys deleted since listing have already been deleted from the target
+		if value != nil {
+			items = append(items, storage.ListItem{Key: keys[i], Value: value})
+		}
+	}
+	if len(items) == 0 {
+		return nil
+	}
+	return target.PutBatch(ctx, items)
+}
+
+// deleteProject deletes all keys of project from shard.
+func deleteProject(ctx context.Context, shard storage.KeyValueStore, project string) error {
+	for {
+		keys, err := listProject(ctx, shard, project, nil, moveBatchSize)
+		if err != nil || len(keys) == 0 {
+			return err
+		}
+		if err := shard.DeleteBatch(ctx, keys); err != nil {
+			return err
+		}
+	}
+}
+
+// listProject lists up to limit keys of project in shard, which come after the given key.
+func listProject(ctx context.Context, shard storage.KeyValueStore, project string, after storage.Key, limit int) (storage.Keys, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	keys := make(storage.Keys, 0, limit)
+	err := shard.Iterate(ctx, storage.IterateOptions{
+		Prefix:  storage.Key(project),
+		First:   after,
+		Recurse: true,
+	}, func(it storage.Iterator) error {
+		var item storage.ListItem
+		for len(keys) < limit && it.Next(&item) {
+			if item.Key.Equal(after) || projectOf(item.Key) != project {
+				continue
+			}
+			keys = append(keys, storage.CloneKey(item.Key))
+		}
+		return nil
+	})
+	return keys, err
+}
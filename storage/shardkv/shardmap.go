@@ -0,0 +1,113 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package shardkv
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// ShardMap describes the shards and which projects are stored on them.
+type ShardMap struct {
+	// Shards maps shard names to database urls
+	Shards map[string]string `json:"shards"`
+	// Default is the shard for projects that aren't listed in Projects
+	Default string `json:"default"`
+	// Projects maps project IDs to shard names
+	Projects map[string]string `json:"projects,omitempty"`
+}
+
+// LoadShardMap reads the shard map from a json file.
+func LoadShardMap(path string) (*ShardMap, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	shardMap := &ShardMap{}
+	if err := json.Unmarshal(data, shardMap); err != nil {
+		return nil, Error.New("invalid shard map %q: %v", path, err)
+	}
+	return shardMap, shardMap.Verify()
+}
+
+// Save writes the shard map to a json file, replacing it atomically.
+func (shardMap *ShardMap) Save(path string) (err error) {
+	data, err := json.MarshalIndent(shardMap, "", "\t")
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	tmp, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path)+".tmp")
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() {
+		if err != nil {
+			_ = os.Remove(tmp.Name())
+		}
+	}()
+
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return Error.Wrap(err)
+	}
+	if err := tmp.Close(); err != nil {
+		return Error.Wrap(err)
+	}
+	return Error.Wrap(os.Rename(tmp.Name(), path))
+}
+
+// Verify checks that the default shard and all the project shards exist.
+func (shardMap *ShardMap) Verify() error {
+	if len(shardMap.Shards) == 0 {
+		return Error.New("no shards")
+	}
+	if _, ok := shardMap.Shards[shardMap.Default]; !ok {
+		return Error.New("default shard %q does not exist", shardMap.Default)
+	}
+	for project, shard := range shardMap.Projects {
+		if _, ok := shardMap.Shards[shard]; !ok {
+			return Error.New("shard %q of project %q does not exist", shard, project)
+		}
+	}
+	return nil
+}
+
+// Lookup returns the shard of project.
+func (shardMap *ShardMap) Lookup(project string) string {
+	if shard, ok := shardMap.Projects[project]; ok {
+		return shard
+	}
+	return shardMap.Default
+}
+
+// Names returns the names of all shards in sorted order.
+func (shardMap *ShardMap) Names() []string {
+	names := make([]string, 0, len(shardMap.Shards))
+	for name := range shardMap.Shards {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Clone returns a deep copy of the shard map.
+func (shardMap *ShardMap) Clone() *ShardMap {
+	clone := &ShardMap{
+		Shards:   make(map[string]string, len(shardMap.Shards)),
+		Default:  shardMap.Default,
+		Projects: make(map[string]string, len(shardMap.Projects)),
+	}
+	for name, url := range shardMap.Shards {
+		clone.Shards[name] = url
+	}
+	for project, shard := range shardMap.Projects {
+		clone.Projects[project] = shard
+	}
+	return clone
+}
@@ -0,0 +1,316 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package shardkv
+
+import (
+	"bytes"
+	"context"
+	"sync"
+
+	"github.com/zeebo/errs"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+
+	"storj.io/storj/storage"
+)
+
+var mon = monkit.Package()
+
+// Error is the default shardkv errs class
+var Error = errs.Class("shardkv error")
+
+// Store is a KeyValueStore, which routes keys by their project ID to one of several shards.
+//
+// The project ID is the first path component of the key, as in pointer paths.
+type Store struct {
+	// path is where the shard map is saved after moving projects
+	path string
+
+	mu       sync.RWMutex
+	shardMap *ShardMap
+	shards   map[string]storage.KeyValueStore
+	moves    map[string]*move
+}
+
+// New creates a store, which routes keys to shards using shardMap.
+func New(shardMap *ShardMap, shards map[string]storage.KeyValueStore) (*Store, error) {
+	if err := shardMap.Verify(); err != nil {
+		return nil, err
+	}
+	for name := range shardMap.Shards {
+		if _, ok := shards[name]; !ok {
+			return nil, Error.New("shard %q is not opened", name)
+		}
+	}
+
+	return &Store{
+		shardMap: shardMap.Clone(),
+		shards:   shards,
+		moves:    map[string]*move{},
+	}, nil
+}
+
+// Open loads the shard map from path and opens every shard using open.
+// The shard map is saved back to path, when projects are moved.
+func Open(path string, open func(url string) (storage.KeyValueStore, error)) (_ *Store, err error) {
+	shardMap, err := LoadShardMap(path)
+	if err != nil {
+		return nil, err
+	}
+
+	shards := map[string]storage.KeyValueStore{}
+	defer func() {
+		if err != nil {
+			for _, shard := range shards {
+				err = errs.Combine(err, shard.Close())
+			}
+		}
+	}()
+
+	for _, name := range shardMap.Names() {
+		shard, err := open(shardMap.Shards[name])
+		if err != nil {
+			return nil, Error.New("opening shard %q failed: %v", name, err)
+		}
+		shards[name] = shard
+	}
+
+	store, err := New(shardMap, shards)
+	if err != nil {
+		return nil, err
+	}
+	store.path = path
+	return store, nil
+}
+
+// ShardMap returns a copy of the current shard map.
+func (store *Store) ShardMap() *ShardMap {
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+	return store.shardMap.Clone()
+}
+
+// projectOf returns the project ID of key.
+func projectOf(key []byte) string {
+	if p := bytes.IndexByte(key, storage.Delimiter); p >= 0 {
+		return string(key[:p])
+	}
+	return string(key)
+}
+
+// lookup returns the shard, which serves the reads of project.
+func (store *Store) lookup(project string) storage.KeyValueStore {
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+	return store.shards[store.shardMap.Lookup(project)]
+}
+
+// write runs fn on the shard of project. When the project is being moved,
+// fn is also applied to the target shard with mirror.
+func (store *Store) write(project string, fn, mirror func(storage.KeyValueStore) error) error {
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+
+	shard := store.shards[store.shardMap.Lookup(project)]
+	move, ok := store.moves[project]
+	if !ok {
+		return fn(shard)
+	}
+
+	move.mu.Lock()
+	defer move.mu.Unlock()
+
+	if err := fn(shard); err != nil {
+		return err
+	}
+	move.mirror(mirror)
+	return nil
+}
+
+// writeBatch runs fn for the indices of keys, grouped by shard. When some of the
+// projects are being moved, fn is also applied to the target shard of each of them.
+func (store *Store) writeBatch(keys storage.Keys, fn func(shard storage.KeyValueStore, indices []int) error) (err error) {
+	for _, key := range keys {
+		if key.IsZero() {
+			return storage.ErrEmptyKey.New("")
+		}
+	}
+
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+
+	byShard := map[string][]int{}
+	byMovingProject := map[string][]int{}
+	for i, key := range keys {
+		project := projectOf(key)
+		if _, ok := store.moves[project]; ok {
+			byMovingProject[project] = append(byMovingProject[project], i)
+			continue
+		}
+		shard := store.shardMap.Lookup(project)
+		byShard[shard] = append(byShard[shard], i)
+	}
+
+	var errlist errs.Group
+	for _, name := range store.shardMap.Names() {
+		if indices, ok := byShard[name]; ok {
+			errlist.Add(fn(store.shards[name], indices))
+		}
+	}
+	for project, indices := range byMovingProject {
+		indices := indices
+		move := store.moves[project]
+		shard := store.shards[store.shardMap.Lookup(project)]
+
+		move.mu.Lock()
+		err := fn(shard, indices)
+		if err == nil {
+			move.mirror(func(target storage.KeyValueStore) error {
+				return fn(target, indices)
+			})
+		}
+		move.mu.Unlock()
+
+		errlist.Add(err)
+	}
+	return errlist.Err()
+}
+
+// Put adds a value to store
+func (store *Store) Put(ctx context.Context, key storage.Key, value storage.Value) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	if key.IsZero() {
+		return storage.ErrEmptyKey.New("")
+	}
+
+	put := func(shard storage.KeyValueStore) error {
+		return shard.Put(ctx, key, value)
+	}
+	return store.write(projectOf(key), put, put)
+}
+
+// Get gets a value to store
+func (store *Store) Get(ctx context.Context, key storage.Key) (_ storage.Value, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if key.IsZero() {
+		return nil, storage.ErrEmptyKey.New("")
+	}
+	return store.lookup(projectOf(key)).Get(ctx, key)
+}
+
+// GetAll gets all values from the store
+func (store *Store) GetAll(ctx context.Context, keys storage.Keys) (_ storage.Values, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if len(keys) > storage.LookupLimit {
+		return nil, storage.ErrLimitExceeded
+	}
+
+	byShard := map[storage.KeyValueStore][]int{}
+	for i, key := range keys {
+		shard := store.lookup(projectOf(key))
+		byShard[shard] = append(byShard[shard], i)
+	}
+
+	values := make(storage.Values, len(keys))
+	for shard, indices := range byShard {
+		shardKeys := make(storage.Keys, 0, len(indices))
+		for _, i := range indices {
+			shardKeys = append(shardKeys, keys[i])
+		}
+
+		shardValues, err := shard.GetAll(ctx, shardKeys)
+		if err != nil {
+			return nil, err
+		}
+		for k, i := range indices {
+			values[i] = shardValues[k]
+		}
+	}
+	return values, nil
+}
+
+// Delete deletes key and the value
+func (store *Store) Delete(ctx context.Context, key storage.Key) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	if key.IsZero() {
+		return storage.ErrEmptyKey.New("")
+	}
+
+	return store.write(projectOf(key),
+		func(shard storage.KeyValueStore) error {
+			return shard.Delete(ctx, key)
+		},
+		func(target storage.KeyValueStore) error {
+			return ignoreNotFound(target.Delete(ctx, key))
+		},
+	)
+}
+
+// CompareAndSwap replaces the value of key with newValue, when its current value is oldValue.
+// A nil oldValue requires that key doesn't exist, and a nil newValue deletes key.
+func (store *Store) CompareAndSwap(ctx context.Context, key storage.Key, oldValue, newValue storage.Value) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	if key.IsZero() {
+		return storage.ErrEmptyKey.New("")
+	}
+
+	return store.write(projectOf(key),
+		func(shard storage.KeyValueStore) error {
+			return shard.CompareAndSwap(ctx, key, oldValue, newValue)
+		},
+		func(target storage.KeyValueStore) error {
+			if newValue == nil {
+				return ignoreNotFound(target.Delete(ctx, key))
+			}
+			return target.Put(ctx, key, newValue)
+		},
+	)
+}
+
+// PutBatch adds all the items to store. The items are put atomically,
+// only when they are all stored on the same shard.
+func (store *Store) PutBatch(ctx context.Context, items storage.Items) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return store.writeBatch(items.GetKeys(), func(shard storage.KeyValueStore, indices []int) error {
+		batch := make(storage.Items, 0, len(indices))
+		for _, i := range indices {
+			batch = append(batch, items[i])
+		}
+		return shard.PutBatch(ctx, batch)
+	})
+}
+
+// DeleteBatch deletes all the keys, ignoring keys which don't exist
+func (store *Store) DeleteBatch(ctx context.Context, keys storage.Keys) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return store.writeBatch(keys, func(shard storage.KeyValueStore, indices []int) error {
+		batch := make(storage.Keys, 0, len(indices))
+		for _, i := range indices {
+			batch = append(batch, keys[i])
+		}
+		return shard.DeleteBatch(ctx, batch)
+	})
+}
+
+// List lists all keys starting from start and upto limit items
+func (store *Store) List(ctx context.Context, first storage.Key, limit int) (_ storage.Keys, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return storage.ListKeys(ctx, store, first, limit)
+}
+
+// Close closes all the shards
+func (store *Store) Close() error {
+	var errlist errs.Group
+	for _, name := range store.shardMap.Names() {
+		errlist.Add(store.shards[name].Close())
+	}
+	return errlist.Err()
+}
+
+// ignoreNotFound returns nil for key not found errors.
+func ignoreNotFound(err error) error {
+	if storage.ErrKeyNotFound.Has(err) {
+		return nil
+	}
+	return err
+}
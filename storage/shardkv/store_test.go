@@ -0,0 +1,192 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package shardkv_test
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/storage"
+	"storj.io/storj/storage/shardkv"
+	"storj.io/storj/storage/teststore"
+	"storj.io/storj/storage/testsuite"
+)
+
+func newTestStore(t *testing.T) (*shardkv.Store, map[string]storage.KeyValueStore) {
+	shardMap := &shardkv.ShardMap{
+		Shards:  map[string]string{"a": "", "b": "", "c": ""},
+		Default: "a",
+		Projects: map[string]string{
+			"b":     "b",
+			"c":     "c",
+			"music": "b",
+			"x-b":   "c",
+			"y-c":   "b",
+			"batch": "c",
+		},
+	}
+	shards := map[string]storage.KeyValueStore{
+		"a": teststore.New(),
+		"b": teststore.New(),
+		"c": teststore.New(),
+	}
+
+	store, err := shardkv.New(shardMap, shards)
+	require.NoError(t, err)
+	return store, shards
+}
+
+func TestSuite(t *testing.T) {
+	store, _ := newTestStore(t)
+	testsuite.RunTests(t, store)
+}
+
+func TestIterateSkipsLeftovers(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	store, shards := newTestStore(t)
+
+	require.NoError(t, store.Put(ctx, storage.Key("b/1"), storage.Value("b1")))
+	require.NoError(t, store.Put(ctx, storage.Key("c/1"), storage.Value("c1")))
+	// a copy of project b, which isn't stored on shard c
+	require.NoError(t, shards["c"].Put(ctx, storage.Key("b/1"), storage.Value("leftover")))
+	require.NoError(t, shards["c"].Put(ctx, storage.Key("b/2"), storage.Value("leftover")))
+
+	items, err := iterateAll(ctx, store, storage.IterateOptions{Recurse: true})
+	require.NoError(t, err)
+	assert.Equal(t, storage.Items{
+		{Key: storage.Key("b/1"), Value: storage.Value("b1")},
+		{Key: storage.Key("c/1"), Value: storage.Value("c1")},
+	}, items)
+
+	items, err = iterateAll(ctx, store, storage.IterateOptions{Recurse: true, Reverse: true})
+	require.NoError(t, err)
+	assert.Equal(t, storage.Items{
+		{Key: storage.Key("c/1"), Value: storage.Value("c1")},
+		{Key: storage.Key("b/1"), Value: storage.Value("b1")},
+	}, items)
+}
+
+func TestMoveProject(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	store, shards := newTestStore(t)
+
+	const project = "b"
+	const count = 3*storage.LookupLimit + 5
+
+	var items storage.Items
+	for i := 0; i < count; i++ {
+		items = append(items, storage.ListItem{
+			Key:   storage.Key(fmt.Sprintf("%s/%05d", project, i)),
+			Value: storage.Value(strconv.Itoa(i)),
+		})
+	}
+	require.NoError(t, storage.PutAll(ctx, store, items...))
+	// a leftover of an earlier move, which must not be resurrected
+	require.NoError(t, shards["c"].Put(ctx, storage.Key(project+"/deleted"), storage.Value("leftover")))
+
+	// modify the project while it's being moved
+	done := make(chan struct{})
+	ctx.Go(func() error {
+		defer close(done)
+		for i := 0; i < count; i += 7 {
+			key := items[i].Key
+			if err := store.Put(ctx, key, storage.Value("modified")); err != nil {
+				return err
+			}
+			items[i].Value = storage.Value("modified")
+		}
+		return nil
+	})
+
+	require.NoError(t, store.MoveProject(ctx, project, "c"))
+	<-done
+
+	assert.Equal(t, "c", store.ShardMap().Lookup(project))
+
+	// the source shard no longer has the project
+	keys, err := shards["b"].List(ctx, nil, 0)
+	require.NoError(t, err)
+	assert.Empty(t, keys)
+
+	// all values are served from the target shard
+	_, err = store.Get(ctx, storage.Key(project+"/deleted"))
+	assert.True(t, storage.ErrKeyNotFound.Has(err), err)
+	for _, item := range items {
+		value, err := shards["c"].Get(ctx, item.Key)
+		require.NoError(t, err)
+		assert.Equal(t, item.Value, value, item.Key)
+	}
+
+	listed, err := iterateAll(ctx, store, storage.IterateOptions{Recurse: true})
+	require.NoError(t, err)
+	assert.Equal(t, items, listed)
+
+	// moving back to the default shard removes the project from the shard map
+	require.NoError(t, store.MoveProject(ctx, project, "a"))
+	_, ok := store.ShardMap().Projects[project]
+	assert.False(t, ok)
+
+	err = store.MoveProject(ctx, project, "missing")
+	assert.Error(t, err)
+}
+
+func TestOpenSavesShardMap(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	path := ctx.File("shards.json")
+	shardMap := &shardkv.ShardMap{
+		Shards:  map[string]string{"a": "first", "b": "second"},
+		Default: "a",
+	}
+	require.NoError(t, shardMap.Save(path))
+
+	opened := map[string]bool{}
+	store, err := shardkv.Open(path, func(url string) (storage.KeyValueStore, error) {
+		opened[url] = true
+		return teststore.New(), nil
+	})
+	require.NoError(t, err)
+	defer ctx.Check(store.Close)
+
+	assert.Equal(t, map[string]bool{"first": true, "second": true}, opened)
+
+	require.NoError(t, store.Put(ctx, storage.Key("project/path"), storage.Value("value")))
+	require.NoError(t, store.MoveProject(ctx, "project", "b"))
+
+	loaded, err := shardkv.LoadShardMap(path)
+	require.NoError(t, err)
+	assert.Equal(t, "b", loaded.Lookup("project"))
+
+	value, err := store.Get(ctx, storage.Key("project/path"))
+	require.NoError(t, err)
+	assert.Equal(t, storage.Value("value"), value)
+
+	// the shard map is verified when loaded
+	shardMap.Default = "missing"
+	require.NoError(t, shardMap.Save(path))
+	_, err = shardkv.LoadShardMap(path)
+	assert.Error(t, err)
+}
+
+func iterateAll(ctx *testcontext.Context, store storage.KeyValueStore, opts storage.IterateOptions) (storage.Items, error) {
+	var items storage.Items
+	err := store.Iterate(ctx, opts, func(it storage.Iterator) error {
+		var item storage.ListItem
+		for it.Next(&item) {
+			items = append(items, storage.CloneItem(item))
+		}
+		return nil
+	})
+	return items, err
+}
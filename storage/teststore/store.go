@@ -5,6 +5,7 @@ package teststore
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"sort"
 	"sync"
@@ -22,14 +23,17 @@ type Client struct {
 	ForceError int
 
 	CallCount struct {
-		Get         int
-		Put         int
-		List        int
-		GetAll      int
-		ReverseList int
-		Delete      int
-		Close       int
-		Iterate     int
+		Get            int
+		Put            int
+		List           int
+		GetAll         int
+		ReverseList    int
+		Delete         int
+		Close          int
+		Iterate        int
+		CompareAndSwap int
+		PutBatch       int
+		DeleteBatch    int
 	}
 
 	version int
@@ -64,7 +68,7 @@ func (store *Client) forcedError() bool {
 }
 
 // Put adds a value to store
-func (store *Client) Put(key storage.Key, value storage.Value) error {
+func (store *Client) Put(ctx context.Context, key storage.Key, value storage.Value) error {
 	defer store.locked()()
 
 	store.version++
@@ -77,11 +81,17 @@ func (store *Client) Put(key storage.Key, value storage.Value) error {
 		return storage.ErrEmptyKey.New("")
 	}
 
+	store.put(key, value)
+	return nil
+}
+
+// put adds a value to store, the store must be locked
+func (store *Client) put(key storage.Key, value storage.Value) {
 	keyIndex, found := store.indexOf(key)
 	if found {
 		kv := &store.Items[keyIndex]
 		kv.Value = storage.CloneValue(value)
-		return nil
+		return
 	}
 
 	store.Items = append(store.Items, storage.ListItem{})
@@ -90,12 +100,10 @@ func (store *Client) Put(key storage.Key, value storage.Value) error {
 		Key:   storage.CloneKey(key),
 		Value: storage.CloneValue(value),
 	}
-
-	return nil
 }
 
 // Get gets a value to store
-func (store *Client) Get(key storage.Key) (storage.Value, error) {
+func (store *Client) Get(ctx context.Context, key storage.Key) (storage.Value, error) {
 	defer store.locked()()
 
 	store.CallCount.Get++
@@ -117,7 +125,7 @@ func (store *Client) Get(key storage.Key) (storage.Value, error) {
 }
 
 // GetAll gets all values from the store
-func (store *Client) GetAll(keys storage.Keys) (storage.Values, error) {
+func (store *Client) GetAll(ctx context.Context, keys storage.Keys) (storage.Values, error) {
 	defer store.locked()()
 
 	store.CallCount.GetAll++
@@ -142,7 +150,7 @@ func (store *Client) GetAll(keys storage.Keys) (storage.Values, error) {
 }
 
 // Delete deletes key and the value
-func (store *Client) Delete(key storage.Key) error {
+func (store *Client) Delete(ctx context.Context, key storage.Key) error {
 	defer store.locked()()
 
 	store.version++
@@ -156,18 +164,103 @@ func (store *Client) Delete(key storage.Key) error {
 		return storage.ErrEmptyKey.New("")
 	}
 
+	if !store.delete(key) {
+		return storage.ErrKeyNotFound.New("%s", key)
+	}
+	return nil
+}
+
+// delete deletes key and the value, the store must be locked
+func (store *Client) delete(key storage.Key) bool {
 	keyIndex, found := store.indexOf(key)
 	if !found {
-		return storage.ErrKeyNotFound.New(key.String())
+		return false
 	}
 
 	copy(store.Items[keyIndex:], store.Items[keyIndex+1:])
 	store.Items = store.Items[:len(store.Items)-1]
+	return true
+}
+
+// CompareAndSwap replaces the value of key with newValue, when its current value is oldValue
+func (store *Client) CompareAndSwap(ctx context.Context, key storage.Key, oldValue, newValue storage.Value) error {
+	defer store.locked()()
+
+	store.version++
+	store.CallCount.CompareAndSwap++
+	if store.forcedError() {
+		return errInternal
+	}
+
+	if key.IsZero() {
+		return storage.ErrEmptyKey.New("")
+	}
+
+	keyIndex, found := store.indexOf(key)
+	switch {
+	case !found && oldValue != nil:
+		return storage.ErrKeyNotFound.New("%s", key)
+	case found && oldValue == nil:
+		return storage.ErrValueChanged.New("%s", key)
+	case found && !bytes.Equal(store.Items[keyIndex].Value, oldValue):
+		return storage.ErrValueChanged.New("%s", key)
+	}
+
+	if newValue == nil {
+		store.delete(key)
+		return nil
+	}
+
+	store.put(key, newValue)
+	return nil
+}
+
+// PutBatch adds all the items to store at once
+func (store *Client) PutBatch(ctx context.Context, items storage.Items) error {
+	defer store.locked()()
+
+	store.version++
+	store.CallCount.PutBatch++
+	if store.forcedError() {
+		return errInternal
+	}
+
+	for _, item := range items {
+		if item.Key.IsZero() {
+			return storage.ErrEmptyKey.New("")
+		}
+	}
+
+	for _, item := range items {
+		store.put(item.Key, item.Value)
+	}
+	return nil
+}
+
+// DeleteBatch deletes all the keys at once, ignoring keys which don't exist
+func (store *Client) DeleteBatch(ctx context.Context, keys storage.Keys) error {
+	defer store.locked()()
+
+	store.version++
+	store.CallCount.DeleteBatch++
+	if store.forcedError() {
+		return errInternal
+	}
+
+	for _, key := range keys {
+		if key.IsZero() {
+			return storage.ErrEmptyKey.New("")
+		}
+	}
+
+	for _, key := range keys {
+		store.delete(key)
+	}
 	return nil
 }
 
 // List lists all keys starting from start and upto limit items
-func (store *Client) List(first storage.Key, limit int) (storage.Keys, error) {
+func (store *Client) List(ctx context.Context, first storage.Key, limit int) (storage.Keys, error) {
 	store.mu.Lock()
 	store.CallCount.List++
 	if store.forcedError() {
@@ -175,7 +268,7 @@ func (store *Client) List(first storage.Key, limit int) (storage.Keys, error) {
 		return nil, errors.New("internal error")
 	}
 	store.mu.Unlock()
-	return storage.ListKeys(store, first, limit)
+	return storage.ListKeys(ctx, store, first, limit)
 }
 
 // Close closes the store
@@ -190,7 +283,7 @@ func (store *Client) Close() error {
 }
 
 // Iterate iterates over items based on opts
-func (store *Client) Iterate(opts storage.IterateOptions, fn func(storage.Iterator) error) error {
+func (store *Client) Iterate(ctx context.Context, opts storage.IterateOptions, fn func(storage.Iterator) error) error {
 	defer store.locked()()
 
 	store.CallCount.Iterate++
@@ -342,6 +435,11 @@ func (cursor *cursor) positionLast() {
 	store := cursor.store
 	cursor.version = store.version
 	cursor.nextIndex = len(store.Items) - 1
+	if cursor.nextIndex < 0 {
+		// the store is empty
+		cursor.lastKey = nil
+		return
+	}
 	cursor.lastKey = storage.NextKey(store.Items[cursor.nextIndex].Key)
 }
 
@@ -4,6 +4,7 @@
 package testsuite
 
 import (
+	"context"
 	"path"
 	"strconv"
 	"sync"
@@ -14,6 +15,8 @@ import (
 
 // RunBenchmarks runs common storage.KeyValueStore benchmarks
 func RunBenchmarks(b *testing.B, store storage.KeyValueStore) {
+	ctx := context.Background()
+
 	var words = []string{
 		"alpha", "beta", "gamma", "delta", "iota", "kappa", "lambda", "mu",
 		"άλφα", "βήτα", "γάμμα", "δέλτα", "έψιλον", "ζήτα", "ήτα", "θήτα", "ιώτα", "κάππα", "λάμδα", "μυ",
@@ -38,7 +41,7 @@ func RunBenchmarks(b *testing.B, store storage.KeyValueStore) {
 		}
 	}
 
-	defer cleanupItems(store, items)
+	defer cleanupItems(ctx, store, items)
 
 	b.Run("Put", func(b *testing.B) {
 		b.SetBytes(int64(len(items)))
@@ -51,7 +54,7 @@ func RunBenchmarks(b *testing.B, store storage.KeyValueStore) {
 				wg.Add(1)
 				go func() {
 					defer wg.Done()
-					err := store.Put(key, value)
+					err := store.Put(ctx, key, value)
 					if err != nil {
 						b.Fatal("store.Put err", err)
 					}
@@ -65,7 +68,7 @@ func RunBenchmarks(b *testing.B, store storage.KeyValueStore) {
 		b.SetBytes(int64(len(items)))
 		for k := 0; k < b.N; k++ {
 			for _, item := range items {
-				_, err := store.Get(item.Key)
+				_, err := store.Get(ctx, item.Key)
 				if err != nil {
 					b.Fatal(err)
 				}
@@ -76,7 +79,7 @@ func RunBenchmarks(b *testing.B, store storage.KeyValueStore) {
 	b.Run("ListV2 5", func(b *testing.B) {
 		b.SetBytes(int64(len(items)))
 		for k := 0; k < b.N; k++ {
-			_, _, err := storage.ListV2(store, storage.ListOptions{
+			_, _, err := storage.ListV2(ctx, store, storage.ListOptions{
 				StartAfter: storage.Key("gamma"),
 				Limit:      5,
 			})
@@ -7,6 +7,7 @@ import (
 	"bufio"
 	"bytes"
 	"compress/gzip"
+	"context"
 	"flag"
 	"fmt"
 	"io"
@@ -168,7 +169,7 @@ func openTestData(tb testing.TB) *KVInputIterator {
 // BulkImporter identifies KV storage facilities that can do bulk importing of items more
 // efficiently than inserting one-by-one.
 type BulkImporter interface {
-	BulkImport(storage.Iterator) error
+	BulkImport(context.Context, storage.Iterator) error
 }
 
 // BulkCleaner identifies KV storage facilities that can delete all items efficiently.
@@ -211,8 +212,9 @@ func BenchmarkPathOperationsInLargeDb(b *testing.B, store storage.KeyValueStore)
 }
 
 func importBigPathset(tb testing.TB, store storage.KeyValueStore) {
+	ctx := context.Background()
 	// make sure this is an empty db, or else refuse to run
-	if !isEmptyKVStore(tb, store) {
+	if !isEmptyKVStore(ctx, tb, store) {
 		tb.Fatal("Provided KeyValueStore is not empty. The long benchmarks are destructive. Not running!")
 	}
 
@@ -226,7 +228,7 @@ func importBigPathset(tb testing.TB, store storage.KeyValueStore) {
 	importer, ok := store.(BulkImporter)
 	if ok {
 		tb.Log("Performing bulk import...")
-		err := importer.BulkImport(inputIter)
+		err := importer.BulkImport(ctx, inputIter)
 
 		if err != nil {
 			errStr := "Provided KeyValueStore failed to import data"
@@ -242,7 +244,7 @@ func importBigPathset(tb testing.TB, store storage.KeyValueStore) {
 
 		var item storage.ListItem
 		for inputIter.Next(&item) {
-			if err := store.Put(item.Key, item.Value); err != nil {
+			if err := store.Put(ctx, item.Key, item.Value); err != nil {
 				tb.Fatalf("Provided KeyValueStore failed to insert data (%q, %q): %v", item.Key, item.Value, err)
 			}
 		}
@@ -290,6 +292,7 @@ type verifyOpts struct {
 }
 
 func benchAndVerifyIteration(b *testing.B, store storage.KeyValueStore, opts *verifyOpts) {
+	ctx := context.Background()
 	problems := 0
 	iteration := 0
 
@@ -317,16 +320,16 @@ func benchAndVerifyIteration(b *testing.B, store storage.KeyValueStore, opts *ve
 	lookupSize := opts.batchSize
 
 	for iteration = 1; iteration <= opts.doIterations; iteration++ {
-		results, err := iterateItems(store, opts.iterateOpts, lookupSize)
+		results, err := iterateItems(ctx, store, opts.iterateOpts, lookupSize)
 		if err != nil {
-			fatalf("Failed to call iterateItems(): %v", err)
+			fatalf("Failed to call iterateItems(ctx, ): %v", err)
 		}
 		if len(results) == 0 {
 			// we can't continue to iterate
-			fatalf("iterateItems() got 0 items")
+			fatalf("iterateItems(ctx, ) got 0 items")
 		}
 		if len(results) > lookupSize {
-			fatalf("iterateItems() returned _more_ items than limit: %d>%d", len(results), lookupSize)
+			fatalf("iterateItems(ctx, ) returned _more_ items than limit: %d>%d", len(results), lookupSize)
 		}
 		if iteration > 0 && results[0].Key.Equal(lastKey) {
 			// fine and normal
@@ -739,6 +742,7 @@ func topNonRecursiveReverse(b *testing.B, store storage.KeyValueStore) {
 }
 
 func cleanupBigPathset(tb testing.TB, store storage.KeyValueStore) {
+	ctx := context.Background()
 	if *noCleanDb {
 		tb.Skip("Instructed not to clean up this KeyValueStore after long benchmarks are complete.")
 	}
@@ -763,7 +767,7 @@ func cleanupBigPathset(tb testing.TB, store storage.KeyValueStore) {
 
 		var item storage.ListItem
 		for inputIter.Next(&item) {
-			if err := store.Delete(item.Key); err != nil {
+			if err := store.Delete(ctx, item.Key); err != nil {
 				tb.Fatalf("Provided KeyValueStore failed to delete item %q during cleanup: %v", item.Key, err)
 			}
 		}
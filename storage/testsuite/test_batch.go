@@ -0,0 +1,87 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package testsuite
+
+import (
+	"bytes"
+	"math/rand"
+	"testing"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/storage"
+)
+
+func testBatch(t *testing.T, store storage.KeyValueStore) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	items := storage.Items{
+		newItem("batch/a", "\x00", false),
+		newItem("batch/b", "\x01\x00", false),
+		newItem("batch/c", "\xFF", false),
+		newItem("batch/d/1", "\x00\xFF\xFF\x00", false),
+		newItem("batch/d/2", "\x00\xFF\xFF\x01", false),
+	}
+	rand.Shuffle(len(items), items.Swap)
+	defer cleanupItems(ctx, store, items)
+
+	t.Run("PutBatch", func(t *testing.T) {
+		err := store.PutBatch(ctx, items)
+		if err != nil {
+			t.Fatalf("failed to put batch: %v", err)
+		}
+
+		values, err := store.GetAll(ctx, items.GetKeys())
+		if err != nil {
+			t.Fatalf("failed to GetAll: %v", err)
+		}
+		for i, item := range items {
+			if !bytes.Equal(values[i], item.Value) {
+				t.Fatalf("invalid value for %q = %v: got %v", item.Key, item.Value, values[i])
+			}
+		}
+	})
+
+	t.Run("PutBatch Empty Key", func(t *testing.T) {
+		err := store.PutBatch(ctx, storage.Items{
+			newItem("batch/valid", "value", false),
+			newItem("", "value", false),
+		})
+		if err == nil {
+			t.Fatal("putting batch with empty key should fail")
+		}
+
+		_, err = store.Get(ctx, storage.Key("batch/valid"))
+		if !storage.ErrKeyNotFound.Has(err) {
+			t.Fatalf("failed batch should not store any items: %v", err)
+		}
+	})
+
+	t.Run("DeleteBatch", func(t *testing.T) {
+		deleted := items[:len(items)/2]
+		kept := items[len(items)/2:]
+
+		keys := append(deleted.GetKeys(), storage.Key("batch/missing"))
+		err := store.DeleteBatch(ctx, keys)
+		if err != nil {
+			t.Fatalf("failed to delete batch: %v", err)
+		}
+
+		for _, item := range deleted {
+			_, err := store.Get(ctx, item.Key)
+			if !storage.ErrKeyNotFound.Has(err) {
+				t.Fatalf("deleted %q should not be found: %v", item.Key, err)
+			}
+		}
+		for _, item := range kept {
+			value, err := store.Get(ctx, item.Key)
+			if err != nil {
+				t.Fatalf("failed to get %q: %v", item.Key, err)
+			}
+			if !bytes.Equal(value, item.Value) {
+				t.Fatalf("invalid value for %q = %v: got %v", item.Key, item.Value, value)
+			}
+		}
+	})
+}
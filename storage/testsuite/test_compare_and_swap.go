@@ -0,0 +1,120 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package testsuite
+
+import (
+	"bytes"
+	"sync"
+	"testing"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/storage"
+)
+
+func testCompareAndSwap(t *testing.T, store storage.KeyValueStore) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	key := storage.Key("cas/key")
+	defer func() { _ = store.Delete(ctx, key) }()
+
+	t.Run("Create", func(t *testing.T) {
+		err := store.CompareAndSwap(ctx, key, nil, storage.Value("first"))
+		if err != nil {
+			t.Fatalf("failed to create %q: %v", key, err)
+		}
+
+		err = store.CompareAndSwap(ctx, key, nil, storage.Value("other"))
+		if !storage.ErrValueChanged.Has(err) {
+			t.Fatalf("creating existing %q should fail with value changed: %v", key, err)
+		}
+
+		value, err := store.Get(ctx, key)
+		if err != nil {
+			t.Fatalf("failed to get %q: %v", key, err)
+		}
+		if !bytes.Equal(value, storage.Value("first")) {
+			t.Fatalf("invalid value for %q: got %q", key, value)
+		}
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		err := store.CompareAndSwap(ctx, key, storage.Value("wrong"), storage.Value("second"))
+		if !storage.ErrValueChanged.Has(err) {
+			t.Fatalf("swapping with wrong old value should fail with value changed: %v", err)
+		}
+
+		err = store.CompareAndSwap(ctx, key, storage.Value("first"), storage.Value("second"))
+		if err != nil {
+			t.Fatalf("failed to swap %q: %v", key, err)
+		}
+
+		value, err := store.Get(ctx, key)
+		if err != nil {
+			t.Fatalf("failed to get %q: %v", key, err)
+		}
+		if !bytes.Equal(value, storage.Value("second")) {
+			t.Fatalf("invalid value for %q: got %q", key, value)
+		}
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		err := store.CompareAndSwap(ctx, key, storage.Value("first"), nil)
+		if !storage.ErrValueChanged.Has(err) {
+			t.Fatalf("deleting with wrong old value should fail with value changed: %v", err)
+		}
+
+		err = store.CompareAndSwap(ctx, key, storage.Value("second"), nil)
+		if err != nil {
+			t.Fatalf("failed to delete %q: %v", key, err)
+		}
+
+		_, err = store.Get(ctx, key)
+		if !storage.ErrKeyNotFound.Has(err) {
+			t.Fatalf("deleted %q should not be found: %v", key, err)
+		}
+
+		err = store.CompareAndSwap(ctx, key, storage.Value("second"), storage.Value("third"))
+		if !storage.ErrKeyNotFound.Has(err) {
+			t.Fatalf("swapping missing %q should fail with key not found: %v", key, err)
+		}
+	})
+
+	t.Run("Empty Key", func(t *testing.T) {
+		err := store.CompareAndSwap(ctx, nil, nil, storage.Value("value"))
+		if err == nil {
+			t.Fatal("swapping empty key should fail")
+		}
+	})
+
+	t.Run("Concurrent", func(t *testing.T) {
+		const n = 10
+
+		var wg sync.WaitGroup
+		var mu sync.Mutex
+		succeeded := 0
+		for i := 0; i < n; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				err := store.CompareAndSwap(ctx, key, nil, storage.Value("concurrent"))
+				if err != nil && !storage.ErrValueChanged.Has(err) {
+					t.Errorf("unexpected error: %v", err)
+					return
+				}
+
+				mu.Lock()
+				defer mu.Unlock()
+				if err == nil {
+					succeeded++
+				}
+			}()
+		}
+		wg.Wait()
+
+		if succeeded != 1 {
+			t.Fatalf("expected exactly one successful swap, got %d", succeeded)
+		}
+	})
+}
@@ -7,10 +7,14 @@ import (
 	"math/rand"
 	"testing"
 
+	"storj.io/storj/internal/testcontext"
 	"storj.io/storj/storage"
 )
 
 func testIterate(t *testing.T, store storage.KeyValueStore) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
 	items := storage.Items{
 		newItem("a", "a", false),
 		newItem("b/1", "b/1", false),
@@ -24,12 +28,12 @@ func testIterate(t *testing.T, store storage.KeyValueStore) {
 		newItem("h", "h", false),
 	}
 	rand.Shuffle(len(items), items.Swap)
-	defer cleanupItems(store, items)
-	if err := storage.PutAll(store, items...); err != nil {
+	defer cleanupItems(ctx, store, items)
+	if err := storage.PutAll(ctx, store, items...); err != nil {
 		t.Fatalf("failed to setup: %v", err)
 	}
 
-	testIterations(t, store, []iterationTest{
+	testIterations(ctx, t, store, []iterationTest{
 		{"no limits",
 			storage.IterateOptions{}, storage.Items{
 				newItem("a", "a", false),
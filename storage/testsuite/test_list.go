@@ -10,10 +10,14 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 
+	"storj.io/storj/internal/testcontext"
 	"storj.io/storj/storage"
 )
 
 func testList(t *testing.T, store storage.KeyValueStore) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
 	items := storage.Items{
 		newItem("path/0", "\x00\xFF\x00", false),
 		newItem("path/1", "\x01\xFF\x01", false),
@@ -24,8 +28,8 @@ func testList(t *testing.T, store storage.KeyValueStore) {
 	}
 	rand.Shuffle(len(items), items.Swap)
 
-	defer cleanupItems(store, items)
-	if err := storage.PutAll(store, items...); err != nil {
+	defer cleanupItems(ctx, store, items)
+	if err := storage.PutAll(ctx, store, items...); err != nil {
 		t.Fatalf("failed to setup: %v", err)
 	}
 
@@ -62,7 +66,7 @@ func testList(t *testing.T, store storage.KeyValueStore) {
 	for _, test := range tests {
 		var keys storage.Keys
 		var err error
-		keys, err = store.List(test.First, test.Limit)
+		keys, err = store.List(ctx, test.First, test.Limit)
 		if err != nil {
 			t.Errorf("%s: %s", test.Name, err)
 			continue
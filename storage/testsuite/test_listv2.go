@@ -11,10 +11,14 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 
+	"storj.io/storj/internal/testcontext"
 	"storj.io/storj/storage"
 )
 
 func testListV2(t *testing.T, store storage.KeyValueStore) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
 	items := storage.Items{
 		newItem("music/a-song1.mp3", "1", false),
 		newItem("music/a-song2.mp3", "2", false),
@@ -25,8 +29,8 @@ func testListV2(t *testing.T, store storage.KeyValueStore) {
 		newItem("videos/movie.mkv", "7", false),
 	}
 	rand.Shuffle(len(items), items.Swap)
-	defer cleanupItems(store, items)
-	if err := storage.PutAll(store, items...); err != nil {
+	defer cleanupItems(ctx, store, items)
+	if err := storage.PutAll(ctx, store, items...); err != nil {
 		t.Fatalf("failed to setup: %v", err)
 	}
 
@@ -168,7 +172,7 @@ func testListV2(t *testing.T, store storage.KeyValueStore) {
 	}
 
 	for _, test := range tests {
-		got, more, err := storage.ListV2(store, test.Options)
+		got, more, err := storage.ListV2(ctx, store, test.Options)
 		if err != nil {
 			t.Errorf("%v: %v", test.Name, err)
 			continue
@@ -7,10 +7,14 @@ import (
 	"math/rand"
 	"testing"
 
+	"storj.io/storj/internal/testcontext"
 	"storj.io/storj/storage"
 )
 
 func testPrefix(t *testing.T, store storage.KeyValueStore) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
 	items := storage.Items{
 		newItem("x-a", "a", false),
 		newItem("x-b/1", "b/1", false),
@@ -24,12 +28,12 @@ func testPrefix(t *testing.T, store storage.KeyValueStore) {
 		newItem("y-h", "h", false),
 	}
 	rand.Shuffle(len(items), items.Swap)
-	defer cleanupItems(store, items)
-	if err := storage.PutAll(store, items...); err != nil {
+	defer cleanupItems(ctx, store, items)
+	if err := storage.PutAll(ctx, store, items...); err != nil {
 		t.Fatalf("failed to setup: %v", err)
 	}
 
-	testIterations(t, store, []iterationTest{
+	testIterations(ctx, t, store, []iterationTest{
 		{"prefix x dash b slash",
 			storage.IterateOptions{
 				Prefix: storage.Key("x-"), First: storage.Key("x-b"),
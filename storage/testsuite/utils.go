@@ -4,6 +4,7 @@
 package testsuite
 
 import (
+	"context"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -20,9 +21,9 @@ func newItem(key, value string, isPrefix bool) storage.ListItem {
 	}
 }
 
-func cleanupItems(store storage.KeyValueStore, items storage.Items) {
+func cleanupItems(ctx context.Context, store storage.KeyValueStore, items storage.Items) {
 	for _, item := range items {
-		_ = store.Delete(item.Key)
+		_ = store.Delete(ctx, item.Key)
 	}
 }
 
@@ -32,10 +33,10 @@ type iterationTest struct {
 	Expected storage.Items
 }
 
-func testIterations(t *testing.T, store storage.KeyValueStore, tests []iterationTest) {
+func testIterations(ctx context.Context, t *testing.T, store storage.KeyValueStore, tests []iterationTest) {
 	t.Helper()
 	for _, test := range tests {
-		items, err := iterateItems(store, test.Options, -1)
+		items, err := iterateItems(ctx, store, test.Options, -1)
 		if err != nil {
 			t.Errorf("%s: %v", test.Name, err)
 			continue
@@ -46,9 +47,9 @@ func testIterations(t *testing.T, store storage.KeyValueStore, tests []iteration
 	}
 }
 
-func isEmptyKVStore(tb testing.TB, store storage.KeyValueStore) bool {
+func isEmptyKVStore(ctx context.Context, tb testing.TB, store storage.KeyValueStore) bool {
 	tb.Helper()
-	keys, err := store.List(storage.Key(""), 1)
+	keys, err := store.List(ctx, storage.Key(""), 1)
 	if err != nil {
 		tb.Fatalf("Failed to check if KeyValueStore is empty: %v", err)
 	}
@@ -68,9 +69,9 @@ func (collect *collector) include(it storage.Iterator) error {
 	return nil
 }
 
-func iterateItems(store storage.KeyValueStore, opts storage.IterateOptions, limit int) (storage.Items, error) {
+func iterateItems(ctx context.Context, store storage.KeyValueStore, opts storage.IterateOptions, limit int) (storage.Items, error) {
 	collect := &collector{Limit: limit}
-	err := store.Iterate(opts, collect.include)
+	err := store.Iterate(ctx, opts, collect.include)
 	if err != nil {
 		return nil, err
 	}
@@ -6,12 +6,15 @@ package piecestore
 import (
 	"context"
 	"io"
+	"os"
 	"time"
 
 	"github.com/golang/protobuf/ptypes"
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	monkit "gopkg.in/spacemonkeygo/monkit.v2"
 
 	"storj.io/storj/internal/memory"
@@ -335,6 +338,9 @@ func (endpoint *Endpoint) Download(stream pb.Piecestore_DownloadServer) (err err
 
 	pieceReader, err := endpoint.store.Reader(ctx, limit.SatelliteId, limit.PieceId)
 	if err != nil {
+		if os.IsNotExist(errs.Unwrap(err)) {
+			return status.Error(codes.NotFound, err.Error())
+		}
 		return ErrInternal.Wrap(err) // TODO: report grpc status internal server error
 	}
 	defer func() {
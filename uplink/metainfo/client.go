@@ -57,6 +57,7 @@ type Client interface {
 	ReadSegment(ctx context.Context, bucket string, path storj.Path, segmentIndex int64) (*pb.Pointer, []*pb.AddressedOrderLimit, error)
 	DeleteSegment(ctx context.Context, bucket string, path storj.Path, segmentIndex int64) ([]*pb.AddressedOrderLimit, error)
 	ListSegments(ctx context.Context, bucket string, prefix, startAfter, endBefore storj.Path, recursive bool, limit int32, metaFlags uint32) (items []ListItem, more bool, err error)
+	MoveSegment(ctx context.Context, bucket string, path storj.Path, segmentIndex int64, newPath storj.Path, newSegmentIndex int64, newMetadata []byte) (*pb.Pointer, error)
 }
 
 // NewClient initializes a new metainfo client
@@ -223,3 +224,25 @@ func (metainfo *Metainfo) ListSegments(ctx context.Context, bucket string, prefi
 
 	return items, response.GetMore(), nil
 }
+
+// MoveSegment requests to move the segment to another path in the same bucket, replacing its metadata
+func (metainfo *Metainfo) MoveSegment(ctx context.Context, bucket string, path storj.Path, segmentIndex int64, newPath storj.Path, newSegmentIndex int64, newMetadata []byte) (pointer *pb.Pointer, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	response, err := metainfo.client.MoveSegment(ctx, &pb.SegmentMoveRequest{
+		Bucket:      []byte(bucket),
+		Path:        []byte(path),
+		Segment:     segmentIndex,
+		NewPath:     []byte(newPath),
+		NewSegment:  newSegmentIndex,
+		NewMetadata: newMetadata,
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, storage.ErrKeyNotFound.Wrap(err)
+		}
+		return nil, Error.Wrap(err)
+	}
+
+	return response.GetPointer(), nil
+}
@@ -20,6 +20,7 @@ export async function fetchAPIKeys(projectID: string) {
                     apiKeys {
                         id,
                         name,
+                        ${restrictionsFields},
                         createdAt
                     }
                 }
@@ -39,7 +40,7 @@ export async function fetchAPIKeys(projectID: string) {
     return result;
 }
 
-export async function createAPIKey(projectID: string, name: string) {
+export async function createAPIKey(projectID: string, name: string, restrictions: ApiKeyRestrictions | null = null) {
     let result: RequestResponse<any> = {
         errorMessage: '',
         isSuccess: false,
@@ -51,12 +52,13 @@ export async function createAPIKey(projectID: string, name: string) {
             `mutation {
                 createAPIKey(
                     projectID: "${projectID}",
-                    name: "${name}"
+                    name: "${name}"${prepareRestrictions(restrictions)}
                 ) {
                     key,
                     keyInfo {
                         id,
                         name,
+                        ${restrictionsFields},
                         createdAt
                     }
                 }
@@ -108,6 +110,47 @@ export async function deleteAPIKeys(ids: string[]) {
     return result;
 }
 
+const restrictionsFields: string = `restrictions {
+    disallowReads,
+    disallowWrites,
+    disallowLists,
+    disallowDeletes,
+    allowedPaths {
+        bucket,
+        encryptedPathPrefix
+    },
+    notBefore,
+    notAfter
+}`;
+
+function prepareRestrictions(restrictions: ApiKeyRestrictions | null): string {
+    if (!restrictions) {
+        return '';
+    }
+
+    let paths: string = '';
+    restrictions.allowedPaths.forEach(path => {
+        paths += `{bucket: "${path.bucket}", encryptedPathPrefix: "${path.encryptedPathPrefix}"}, `;
+    });
+
+    let window: string = '';
+    if (restrictions.notBefore) {
+        window += `, notBefore: "${restrictions.notBefore.toISOString()}"`;
+    }
+    if (restrictions.notAfter) {
+        window += `, notAfter: "${restrictions.notAfter.toISOString()}"`;
+    }
+
+    return `,
+                    restrictions: {
+                        disallowReads: ${restrictions.disallowReads},
+                        disallowWrites: ${restrictions.disallowWrites},
+                        disallowLists: ${restrictions.disallowLists},
+                        disallowDeletes: ${restrictions.disallowDeletes},
+                        allowedPaths: [${paths}]${window}
+                    }`;
+}
+
 function prepareIdList(ids: string[]): string {
     let idString: string = '';
 
@@ -0,0 +1,19 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// ApiKeyPath is a bucket and encrypted path prefix an api key is allowed to access
+declare type ApiKeyPath = {
+    bucket: string,
+    encryptedPathPrefix: string
+};
+
+// ApiKeyRestrictions holds the permissions, paths and validity window of an api key
+declare type ApiKeyRestrictions = {
+    disallowReads: boolean,
+    disallowWrites: boolean,
+    disallowLists: boolean,
+    disallowDeletes: boolean,
+    allowedPaths: ApiKeyPath[],
+    notBefore: Date | null,
+    notAfter: Date | null
+};